@@ -96,6 +96,7 @@ type DocumentChunk struct {
 	RelevanceScore       float32        `protobuf:"fixed32,5,opt,name=relevance_score,json=relevanceScore,proto3" json:"relevance_score,omitempty"`
 	PositionInDocument   int32          `protobuf:"varint,6,opt,name=position_in_document,json=positionInDocument,proto3" json:"position_in_document,omitempty"`
 	Metadata             *ChunkMetadata `protobuf:"bytes,7,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	Embedding            []float32      `protobuf:"fixed32,8,rep,packed,name=embedding,proto3" json:"embedding,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
 	XXX_unrecognized     []byte         `json:"-"`
 	XXX_sizecache        int32          `json:"-"`
@@ -175,6 +176,13 @@ func (m *DocumentChunk) GetMetadata() *ChunkMetadata {
 	return nil
 }
 
+func (m *DocumentChunk) GetEmbedding() []float32 {
+	if m != nil {
+		return m.Embedding
+	}
+	return nil
+}
+
 type ChunkMetadata struct {
 	DocumentType         string                 `protobuf:"bytes,1,opt,name=document_type,json=documentType,proto3" json:"document_type,omitempty"`
 	Author               string                 `protobuf:"bytes,2,opt,name=author,proto3" json:"author,omitempty"`
@@ -264,6 +272,7 @@ type AssemblyOptions struct {
 	IncludeMetadata            bool                        `protobuf:"varint,7,opt,name=include_metadata,json=includeMetadata,proto3" json:"include_metadata,omitempty"`
 	DiversityThreshold         float32                     `protobuf:"fixed32,8,opt,name=diversity_threshold,json=diversityThreshold,proto3" json:"diversity_threshold,omitempty"`
 	CustomOptions              map[string]string           `protobuf:"bytes,9,rep,name=custom_options,json=customOptions,proto3" json:"custom_options,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	Deduplication              *DeduplicationOptions       `protobuf:"bytes,10,opt,name=deduplication,proto3" json:"deduplication,omitempty"`
 	XXX_NoUnkeyedLiteral       struct{}                    `json:"-"`
 	XXX_unrecognized           []byte                      `json:"-"`
 	XXX_sizecache              int32                       `json:"-"`
@@ -357,6 +366,84 @@ func (m *AssemblyOptions) GetCustomOptions() map[string]string {
 	return nil
 }
 
+func (m *AssemblyOptions) GetDeduplication() *DeduplicationOptions {
+	if m != nil {
+		return m.Deduplication
+	}
+	return nil
+}
+
+type DeduplicationOptions struct {
+	Method               string   `protobuf:"bytes,1,opt,name=method,proto3" json:"method,omitempty"`
+	SimilarityThreshold  float32  `protobuf:"fixed32,2,opt,name=similarity_threshold,json=similarityThreshold,proto3" json:"similarity_threshold,omitempty"`
+	SimhashMaxDistance   int32    `protobuf:"varint,3,opt,name=simhash_max_distance,json=simhashMaxDistance,proto3" json:"simhash_max_distance,omitempty"`
+	UseEmbeddings        bool     `protobuf:"varint,4,opt,name=use_embeddings,json=useEmbeddings,proto3" json:"use_embeddings,omitempty"`
+	EmbeddingThreshold   float32  `protobuf:"fixed32,5,opt,name=embedding_threshold,json=embeddingThreshold,proto3" json:"embedding_threshold,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DeduplicationOptions) Reset()         { *m = DeduplicationOptions{} }
+func (m *DeduplicationOptions) String() string { return proto.CompactTextString(m) }
+func (*DeduplicationOptions) ProtoMessage()    {}
+func (*DeduplicationOptions) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a5015857536f2ae4, []int{4}
+}
+
+func (m *DeduplicationOptions) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DeduplicationOptions.Unmarshal(m, b)
+}
+func (m *DeduplicationOptions) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DeduplicationOptions.Marshal(b, m, deterministic)
+}
+func (m *DeduplicationOptions) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeduplicationOptions.Merge(m, src)
+}
+func (m *DeduplicationOptions) XXX_Size() int {
+	return xxx_messageInfo_DeduplicationOptions.Size(m)
+}
+func (m *DeduplicationOptions) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeduplicationOptions.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DeduplicationOptions proto.InternalMessageInfo
+
+func (m *DeduplicationOptions) GetMethod() string {
+	if m != nil {
+		return m.Method
+	}
+	return ""
+}
+
+func (m *DeduplicationOptions) GetSimilarityThreshold() float32 {
+	if m != nil {
+		return m.SimilarityThreshold
+	}
+	return 0
+}
+
+func (m *DeduplicationOptions) GetSimhashMaxDistance() int32 {
+	if m != nil {
+		return m.SimhashMaxDistance
+	}
+	return 0
+}
+
+func (m *DeduplicationOptions) GetUseEmbeddings() bool {
+	if m != nil {
+		return m.UseEmbeddings
+	}
+	return false
+}
+
+func (m *DeduplicationOptions) GetEmbeddingThreshold() float32 {
+	if m != nil {
+		return m.EmbeddingThreshold
+	}
+	return 0
+}
+
 type ContentSelectionStrategy struct {
 	StrategyType          string   `protobuf:"bytes,1,opt,name=strategy_type,json=strategyType,proto3" json:"strategy_type,omitempty"`
 	MaxChunks             int32    `protobuf:"varint,2,opt,name=max_chunks,json=maxChunks,proto3" json:"max_chunks,omitempty"`
@@ -373,7 +460,7 @@ func (m *ContentSelectionStrategy) Reset()         { *m = ContentSelectionStrate
 func (m *ContentSelectionStrategy) String() string { return proto.CompactTextString(m) }
 func (*ContentSelectionStrategy) ProtoMessage()    {}
 func (*ContentSelectionStrategy) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a5015857536f2ae4, []int{4}
+	return fileDescriptor_a5015857536f2ae4, []int{5}
 }
 
 func (m *ContentSelectionStrategy) XXX_Unmarshal(b []byte) error {
@@ -451,7 +538,7 @@ func (m *ContentArrangementStrategy) Reset()         { *m = ContentArrangementSt
 func (m *ContentArrangementStrategy) String() string { return proto.CompactTextString(m) }
 func (*ContentArrangementStrategy) ProtoMessage()    {}
 func (*ContentArrangementStrategy) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a5015857536f2ae4, []int{5}
+	return fileDescriptor_a5015857536f2ae4, []int{6}
 }
 
 func (m *ContentArrangementStrategy) XXX_Unmarshal(b []byte) error {
@@ -520,7 +607,7 @@ func (m *SectionPriority) Reset()         { *m = SectionPriority{} }
 func (m *SectionPriority) String() string { return proto.CompactTextString(m) }
 func (*SectionPriority) ProtoMessage()    {}
 func (*SectionPriority) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a5015857536f2ae4, []int{6}
+	return fileDescriptor_a5015857536f2ae4, []int{7}
 }
 
 func (m *SectionPriority) XXX_Unmarshal(b []byte) error {
@@ -577,7 +664,7 @@ func (m *ContextTemplate) Reset()         { *m = ContextTemplate{} }
 func (m *ContextTemplate) String() string { return proto.CompactTextString(m) }
 func (*ContextTemplate) ProtoMessage()    {}
 func (*ContextTemplate) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a5015857536f2ae4, []int{7}
+	return fileDescriptor_a5015857536f2ae4, []int{8}
 }
 
 func (m *ContextTemplate) XXX_Unmarshal(b []byte) error {
@@ -648,7 +735,7 @@ func (m *TemplateSection) Reset()         { *m = TemplateSection{} }
 func (m *TemplateSection) String() string { return proto.CompactTextString(m) }
 func (*TemplateSection) ProtoMessage()    {}
 func (*TemplateSection) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a5015857536f2ae4, []int{8}
+	return fileDescriptor_a5015857536f2ae4, []int{9}
 }
 
 func (m *TemplateSection) XXX_Unmarshal(b []byte) error {
@@ -709,6 +796,7 @@ type AssembleContextResponse struct {
 	ContextMetadata      *ContextMetadata `protobuf:"bytes,2,opt,name=context_metadata,json=contextMetadata,proto3" json:"context_metadata,omitempty"`
 	UsedChunks           []*UsedChunk     `protobuf:"bytes,3,rep,name=used_chunks,json=usedChunks,proto3" json:"used_chunks,omitempty"`
 	Warnings             []string         `protobuf:"bytes,4,rep,name=warnings,proto3" json:"warnings,omitempty"`
+	Citations            []*v1.Citation   `protobuf:"bytes,5,rep,name=citations,proto3" json:"citations,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
 	XXX_unrecognized     []byte           `json:"-"`
 	XXX_sizecache        int32            `json:"-"`
@@ -718,7 +806,7 @@ func (m *AssembleContextResponse) Reset()         { *m = AssembleContextResponse
 func (m *AssembleContextResponse) String() string { return proto.CompactTextString(m) }
 func (*AssembleContextResponse) ProtoMessage()    {}
 func (*AssembleContextResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a5015857536f2ae4, []int{9}
+	return fileDescriptor_a5015857536f2ae4, []int{10}
 }
 
 func (m *AssembleContextResponse) XXX_Unmarshal(b []byte) error {
@@ -767,6 +855,13 @@ func (m *AssembleContextResponse) GetWarnings() []string {
 	return nil
 }
 
+func (m *AssembleContextResponse) GetCitations() []*v1.Citation {
+	if m != nil {
+		return m.Citations
+	}
+	return nil
+}
+
 type ContextMetadata struct {
 	TotalTokens          int32                  `protobuf:"varint,1,opt,name=total_tokens,json=totalTokens,proto3" json:"total_tokens,omitempty"`
 	ChunksUsed           int32                  `protobuf:"varint,2,opt,name=chunks_used,json=chunksUsed,proto3" json:"chunks_used,omitempty"`
@@ -784,7 +879,7 @@ func (m *ContextMetadata) Reset()         { *m = ContextMetadata{} }
 func (m *ContextMetadata) String() string { return proto.CompactTextString(m) }
 func (*ContextMetadata) ProtoMessage()    {}
 func (*ContextMetadata) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a5015857536f2ae4, []int{10}
+	return fileDescriptor_a5015857536f2ae4, []int{11}
 }
 
 func (m *ContextMetadata) XXX_Unmarshal(b []byte) error {
@@ -869,7 +964,7 @@ func (m *AssemblyStatistics) Reset()         { *m = AssemblyStatistics{} }
 func (m *AssemblyStatistics) String() string { return proto.CompactTextString(m) }
 func (*AssemblyStatistics) ProtoMessage()    {}
 func (*AssemblyStatistics) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a5015857536f2ae4, []int{11}
+	return fileDescriptor_a5015857536f2ae4, []int{12}
 }
 
 func (m *AssemblyStatistics) XXX_Unmarshal(b []byte) error {
@@ -941,7 +1036,7 @@ func (m *UsedChunk) Reset()         { *m = UsedChunk{} }
 func (m *UsedChunk) String() string { return proto.CompactTextString(m) }
 func (*UsedChunk) ProtoMessage()    {}
 func (*UsedChunk) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a5015857536f2ae4, []int{12}
+	return fileDescriptor_a5015857536f2ae4, []int{13}
 }
 
 func (m *UsedChunk) XXX_Unmarshal(b []byte) error {
@@ -1015,7 +1110,7 @@ func (m *TextList) Reset()         { *m = TextList{} }
 func (m *TextList) String() string { return proto.CompactTextString(m) }
 func (*TextList) ProtoMessage()    {}
 func (*TextList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a5015857536f2ae4, []int{13}
+	return fileDescriptor_a5015857536f2ae4, []int{14}
 }
 
 func (m *TextList) XXX_Unmarshal(b []byte) error {
@@ -1045,7 +1140,6 @@ func (m *TextList) GetTexts() []string {
 
 type EstimateTokensRequest struct {
 	// Types that are valid to be assigned to ContentSource:
-	//
 	//	*EstimateTokensRequest_Text
 	//	*EstimateTokensRequest_Texts
 	//	*EstimateTokensRequest_ContextRequest
@@ -1061,7 +1155,7 @@ func (m *EstimateTokensRequest) Reset()         { *m = EstimateTokensRequest{} }
 func (m *EstimateTokensRequest) String() string { return proto.CompactTextString(m) }
 func (*EstimateTokensRequest) ProtoMessage()    {}
 func (*EstimateTokensRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a5015857536f2ae4, []int{14}
+	return fileDescriptor_a5015857536f2ae4, []int{15}
 }
 
 func (m *EstimateTokensRequest) XXX_Unmarshal(b []byte) error {
@@ -1168,7 +1262,7 @@ func (m *TokenEstimationOptions) Reset()         { *m = TokenEstimationOptions{}
 func (m *TokenEstimationOptions) String() string { return proto.CompactTextString(m) }
 func (*TokenEstimationOptions) ProtoMessage()    {}
 func (*TokenEstimationOptions) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a5015857536f2ae4, []int{15}
+	return fileDescriptor_a5015857536f2ae4, []int{16}
 }
 
 func (m *TokenEstimationOptions) XXX_Unmarshal(b []byte) error {
@@ -1212,7 +1306,6 @@ func (m *TokenEstimationOptions) GetTokenTypesToCount() []string {
 
 type EstimateTokensResponse struct {
 	// Types that are valid to be assigned to Result:
-	//
 	//	*EstimateTokensResponse_SingleCount
 	//	*EstimateTokensResponse_BatchCounts
 	//	*EstimateTokensResponse_ContextEstimation
@@ -1227,7 +1320,7 @@ func (m *EstimateTokensResponse) Reset()         { *m = EstimateTokensResponse{}
 func (m *EstimateTokensResponse) String() string { return proto.CompactTextString(m) }
 func (*EstimateTokensResponse) ProtoMessage()    {}
 func (*EstimateTokensResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a5015857536f2ae4, []int{16}
+	return fileDescriptor_a5015857536f2ae4, []int{17}
 }
 
 func (m *EstimateTokensResponse) XXX_Unmarshal(b []byte) error {
@@ -1326,7 +1419,7 @@ func (m *TokenCount) Reset()         { *m = TokenCount{} }
 func (m *TokenCount) String() string { return proto.CompactTextString(m) }
 func (*TokenCount) ProtoMessage()    {}
 func (*TokenCount) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a5015857536f2ae4, []int{17}
+	return fileDescriptor_a5015857536f2ae4, []int{18}
 }
 
 func (m *TokenCount) XXX_Unmarshal(b []byte) error {
@@ -1374,7 +1467,7 @@ func (m *BatchTokenCounts) Reset()         { *m = BatchTokenCounts{} }
 func (m *BatchTokenCounts) String() string { return proto.CompactTextString(m) }
 func (*BatchTokenCounts) ProtoMessage()    {}
 func (*BatchTokenCounts) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a5015857536f2ae4, []int{18}
+	return fileDescriptor_a5015857536f2ae4, []int{19}
 }
 
 func (m *BatchTokenCounts) XXX_Unmarshal(b []byte) error {
@@ -1431,7 +1524,7 @@ func (m *ContextTokenEstimation) Reset()         { *m = ContextTokenEstimation{}
 func (m *ContextTokenEstimation) String() string { return proto.CompactTextString(m) }
 func (*ContextTokenEstimation) ProtoMessage()    {}
 func (*ContextTokenEstimation) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a5015857536f2ae4, []int{19}
+	return fileDescriptor_a5015857536f2ae4, []int{20}
 }
 
 func (m *ContextTokenEstimation) XXX_Unmarshal(b []byte) error {
@@ -1502,7 +1595,7 @@ func (m *TokenBreakdown) Reset()         { *m = TokenBreakdown{} }
 func (m *TokenBreakdown) String() string { return proto.CompactTextString(m) }
 func (*TokenBreakdown) ProtoMessage()    {}
 func (*TokenBreakdown) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a5015857536f2ae4, []int{20}
+	return fileDescriptor_a5015857536f2ae4, []int{21}
 }
 
 func (m *TokenBreakdown) XXX_Unmarshal(b []byte) error {
@@ -1572,7 +1665,7 @@ func (m *TokenStatistics) Reset()         { *m = TokenStatistics{} }
 func (m *TokenStatistics) String() string { return proto.CompactTextString(m) }
 func (*TokenStatistics) ProtoMessage()    {}
 func (*TokenStatistics) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a5015857536f2ae4, []int{21}
+	return fileDescriptor_a5015857536f2ae4, []int{22}
 }
 
 func (m *TokenStatistics) XXX_Unmarshal(b []byte) error {
@@ -1634,7 +1727,7 @@ func (m *ChunkTokenInfo) Reset()         { *m = ChunkTokenInfo{} }
 func (m *ChunkTokenInfo) String() string { return proto.CompactTextString(m) }
 func (*ChunkTokenInfo) ProtoMessage()    {}
 func (*ChunkTokenInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a5015857536f2ae4, []int{22}
+	return fileDescriptor_a5015857536f2ae4, []int{23}
 }
 
 func (m *ChunkTokenInfo) XXX_Unmarshal(b []byte) error {
@@ -1690,7 +1783,7 @@ func (m *TokenizationMetadata) Reset()         { *m = TokenizationMetadata{} }
 func (m *TokenizationMetadata) String() string { return proto.CompactTextString(m) }
 func (*TokenizationMetadata) ProtoMessage()    {}
 func (*TokenizationMetadata) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a5015857536f2ae4, []int{23}
+	return fileDescriptor_a5015857536f2ae4, []int{24}
 }
 
 func (m *TokenizationMetadata) XXX_Unmarshal(b []byte) error {
@@ -1743,6 +1836,7 @@ type OptimizeContentRequest struct {
 	Content              string               `protobuf:"bytes,1,opt,name=content,proto3" json:"content,omitempty"`
 	Options              *OptimizationOptions `protobuf:"bytes,2,opt,name=options,proto3" json:"options,omitempty"`
 	TargetLength         int32                `protobuf:"varint,3,opt,name=target_length,json=targetLength,proto3" json:"target_length,omitempty"`
+	Query                string               `protobuf:"bytes,4,opt,name=query,proto3" json:"query,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
 	XXX_unrecognized     []byte               `json:"-"`
 	XXX_sizecache        int32                `json:"-"`
@@ -1752,7 +1846,7 @@ func (m *OptimizeContentRequest) Reset()         { *m = OptimizeContentRequest{}
 func (m *OptimizeContentRequest) String() string { return proto.CompactTextString(m) }
 func (*OptimizeContentRequest) ProtoMessage()    {}
 func (*OptimizeContentRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a5015857536f2ae4, []int{24}
+	return fileDescriptor_a5015857536f2ae4, []int{25}
 }
 
 func (m *OptimizeContentRequest) XXX_Unmarshal(b []byte) error {
@@ -1794,6 +1888,13 @@ func (m *OptimizeContentRequest) GetTargetLength() int32 {
 	return 0
 }
 
+func (m *OptimizeContentRequest) GetQuery() string {
+	if m != nil {
+		return m.Query
+	}
+	return ""
+}
+
 type OptimizationOptions struct {
 	OptimizationGoal       string   `protobuf:"bytes,1,opt,name=optimization_goal,json=optimizationGoal,proto3" json:"optimization_goal,omitempty"`
 	OptimizationMethod     string   `protobuf:"bytes,2,opt,name=optimization_method,json=optimizationMethod,proto3" json:"optimization_method,omitempty"`
@@ -1811,7 +1912,7 @@ func (m *OptimizationOptions) Reset()         { *m = OptimizationOptions{} }
 func (m *OptimizationOptions) String() string { return proto.CompactTextString(m) }
 func (*OptimizationOptions) ProtoMessage()    {}
 func (*OptimizationOptions) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a5015857536f2ae4, []int{25}
+	return fileDescriptor_a5015857536f2ae4, []int{26}
 }
 
 func (m *OptimizationOptions) XXX_Unmarshal(b []byte) error {
@@ -1894,7 +1995,7 @@ func (m *OptimizeContentResponse) Reset()         { *m = OptimizeContentResponse
 func (m *OptimizeContentResponse) String() string { return proto.CompactTextString(m) }
 func (*OptimizeContentResponse) ProtoMessage()    {}
 func (*OptimizeContentResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a5015857536f2ae4, []int{26}
+	return fileDescriptor_a5015857536f2ae4, []int{27}
 }
 
 func (m *OptimizeContentResponse) XXX_Unmarshal(b []byte) error {
@@ -1952,7 +2053,7 @@ func (m *OptimizationResult) Reset()         { *m = OptimizationResult{} }
 func (m *OptimizationResult) String() string { return proto.CompactTextString(m) }
 func (*OptimizationResult) ProtoMessage()    {}
 func (*OptimizationResult) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a5015857536f2ae4, []int{27}
+	return fileDescriptor_a5015857536f2ae4, []int{28}
 }
 
 func (m *OptimizationResult) XXX_Unmarshal(b []byte) error {
@@ -2030,7 +2131,7 @@ func (m *OptimizationStep) Reset()         { *m = OptimizationStep{} }
 func (m *OptimizationStep) String() string { return proto.CompactTextString(m) }
 func (*OptimizationStep) ProtoMessage()    {}
 func (*OptimizationStep) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a5015857536f2ae4, []int{28}
+	return fileDescriptor_a5015857536f2ae4, []int{29}
 }
 
 func (m *OptimizationStep) XXX_Unmarshal(b []byte) error {
@@ -2098,7 +2199,7 @@ func (m *SplitContentRequest) Reset()         { *m = SplitContentRequest{} }
 func (m *SplitContentRequest) String() string { return proto.CompactTextString(m) }
 func (*SplitContentRequest) ProtoMessage()    {}
 func (*SplitContentRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a5015857536f2ae4, []int{29}
+	return fileDescriptor_a5015857536f2ae4, []int{30}
 }
 
 func (m *SplitContentRequest) XXX_Unmarshal(b []byte) error {
@@ -2149,7 +2250,7 @@ func (m *SplitOptions) Reset()         { *m = SplitOptions{} }
 func (m *SplitOptions) String() string { return proto.CompactTextString(m) }
 func (*SplitOptions) ProtoMessage()    {}
 func (*SplitOptions) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a5015857536f2ae4, []int{30}
+	return fileDescriptor_a5015857536f2ae4, []int{31}
 }
 
 func (m *SplitOptions) XXX_Unmarshal(b []byte) error {
@@ -2224,7 +2325,7 @@ func (m *SplitContentResponse) Reset()         { *m = SplitContentResponse{} }
 func (m *SplitContentResponse) String() string { return proto.CompactTextString(m) }
 func (*SplitContentResponse) ProtoMessage()    {}
 func (*SplitContentResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a5015857536f2ae4, []int{31}
+	return fileDescriptor_a5015857536f2ae4, []int{32}
 }
 
 func (m *SplitContentResponse) XXX_Unmarshal(b []byte) error {
@@ -2275,7 +2376,7 @@ func (m *ContentChunk) Reset()         { *m = ContentChunk{} }
 func (m *ContentChunk) String() string { return proto.CompactTextString(m) }
 func (*ContentChunk) ProtoMessage()    {}
 func (*ContentChunk) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a5015857536f2ae4, []int{32}
+	return fileDescriptor_a5015857536f2ae4, []int{33}
 }
 
 func (m *ContentChunk) XXX_Unmarshal(b []byte) error {
@@ -2353,7 +2454,7 @@ func (m *SplitMetadata) Reset()         { *m = SplitMetadata{} }
 func (m *SplitMetadata) String() string { return proto.CompactTextString(m) }
 func (*SplitMetadata) ProtoMessage()    {}
 func (*SplitMetadata) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a5015857536f2ae4, []int{33}
+	return fileDescriptor_a5015857536f2ae4, []int{34}
 }
 
 func (m *SplitMetadata) XXX_Unmarshal(b []byte) error {
@@ -2422,7 +2523,7 @@ func (m *RenderTemplateRequest) Reset()         { *m = RenderTemplateRequest{} }
 func (m *RenderTemplateRequest) String() string { return proto.CompactTextString(m) }
 func (*RenderTemplateRequest) ProtoMessage()    {}
 func (*RenderTemplateRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a5015857536f2ae4, []int{34}
+	return fileDescriptor_a5015857536f2ae4, []int{35}
 }
 
 func (m *RenderTemplateRequest) XXX_Unmarshal(b []byte) error {
@@ -2478,7 +2579,7 @@ func (m *RenderOptions) Reset()         { *m = RenderOptions{} }
 func (m *RenderOptions) String() string { return proto.CompactTextString(m) }
 func (*RenderOptions) ProtoMessage()    {}
 func (*RenderOptions) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a5015857536f2ae4, []int{35}
+	return fileDescriptor_a5015857536f2ae4, []int{36}
 }
 
 func (m *RenderOptions) XXX_Unmarshal(b []byte) error {
@@ -2541,7 +2642,7 @@ func (m *RenderTemplateResponse) Reset()         { *m = RenderTemplateResponse{}
 func (m *RenderTemplateResponse) String() string { return proto.CompactTextString(m) }
 func (*RenderTemplateResponse) ProtoMessage()    {}
 func (*RenderTemplateResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a5015857536f2ae4, []int{36}
+	return fileDescriptor_a5015857536f2ae4, []int{37}
 }
 
 func (m *RenderTemplateResponse) XXX_Unmarshal(b []byte) error {
@@ -2604,7 +2705,7 @@ func (m *TemplateRenderingMetadata) Reset()         { *m = TemplateRenderingMeta
 func (m *TemplateRenderingMetadata) String() string { return proto.CompactTextString(m) }
 func (*TemplateRenderingMetadata) ProtoMessage()    {}
 func (*TemplateRenderingMetadata) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a5015857536f2ae4, []int{37}
+	return fileDescriptor_a5015857536f2ae4, []int{38}
 }
 
 func (m *TemplateRenderingMetadata) XXX_Unmarshal(b []byte) error {
@@ -2665,7 +2766,7 @@ func (m *AssembleBatchContextsRequest) Reset()         { *m = AssembleBatchConte
 func (m *AssembleBatchContextsRequest) String() string { return proto.CompactTextString(m) }
 func (*AssembleBatchContextsRequest) ProtoMessage()    {}
 func (*AssembleBatchContextsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a5015857536f2ae4, []int{38}
+	return fileDescriptor_a5015857536f2ae4, []int{39}
 }
 
 func (m *AssembleBatchContextsRequest) XXX_Unmarshal(b []byte) error {
@@ -2712,7 +2813,7 @@ func (m *BatchContextRequest) Reset()         { *m = BatchContextRequest{} }
 func (m *BatchContextRequest) String() string { return proto.CompactTextString(m) }
 func (*BatchContextRequest) ProtoMessage()    {}
 func (*BatchContextRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a5015857536f2ae4, []int{39}
+	return fileDescriptor_a5015857536f2ae4, []int{40}
 }
 
 func (m *BatchContextRequest) XXX_Unmarshal(b []byte) error {
@@ -2760,7 +2861,7 @@ func (m *AssembleBatchContextsResponse) Reset()         { *m = AssembleBatchCont
 func (m *AssembleBatchContextsResponse) String() string { return proto.CompactTextString(m) }
 func (*AssembleBatchContextsResponse) ProtoMessage()    {}
 func (*AssembleBatchContextsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a5015857536f2ae4, []int{40}
+	return fileDescriptor_a5015857536f2ae4, []int{41}
 }
 
 func (m *AssembleBatchContextsResponse) XXX_Unmarshal(b []byte) error {
@@ -2816,7 +2917,7 @@ func (m *BatchContextResult) Reset()         { *m = BatchContextResult{} }
 func (m *BatchContextResult) String() string { return proto.CompactTextString(m) }
 func (*BatchContextResult) ProtoMessage()    {}
 func (*BatchContextResult) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a5015857536f2ae4, []int{41}
+	return fileDescriptor_a5015857536f2ae4, []int{42}
 }
 
 func (m *BatchContextResult) XXX_Unmarshal(b []byte) error {
@@ -2881,7 +2982,7 @@ func (m *BatchAssemblyMetadata) Reset()         { *m = BatchAssemblyMetadata{} }
 func (m *BatchAssemblyMetadata) String() string { return proto.CompactTextString(m) }
 func (*BatchAssemblyMetadata) ProtoMessage()    {}
 func (*BatchAssemblyMetadata) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a5015857536f2ae4, []int{42}
+	return fileDescriptor_a5015857536f2ae4, []int{43}
 }
 
 func (m *BatchAssemblyMetadata) XXX_Unmarshal(b []byte) error {
@@ -2956,7 +3057,7 @@ func (m *ListTemplatesRequest) Reset()         { *m = ListTemplatesRequest{} }
 func (m *ListTemplatesRequest) String() string { return proto.CompactTextString(m) }
 func (*ListTemplatesRequest) ProtoMessage()    {}
 func (*ListTemplatesRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a5015857536f2ae4, []int{43}
+	return fileDescriptor_a5015857536f2ae4, []int{44}
 }
 
 func (m *ListTemplatesRequest) XXX_Unmarshal(b []byte) error {
@@ -3003,7 +3104,7 @@ func (m *ListTemplatesResponse) Reset()         { *m = ListTemplatesResponse{} }
 func (m *ListTemplatesResponse) String() string { return proto.CompactTextString(m) }
 func (*ListTemplatesResponse) ProtoMessage()    {}
 func (*ListTemplatesResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a5015857536f2ae4, []int{44}
+	return fileDescriptor_a5015857536f2ae4, []int{45}
 }
 
 func (m *ListTemplatesResponse) XXX_Unmarshal(b []byte) error {
@@ -3057,7 +3158,7 @@ func (m *TemplateInfo) Reset()         { *m = TemplateInfo{} }
 func (m *TemplateInfo) String() string { return proto.CompactTextString(m) }
 func (*TemplateInfo) ProtoMessage()    {}
 func (*TemplateInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a5015857536f2ae4, []int{45}
+	return fileDescriptor_a5015857536f2ae4, []int{46}
 }
 
 func (m *TemplateInfo) XXX_Unmarshal(b []byte) error {
@@ -3155,7 +3256,7 @@ func (m *TemplateStatistics) Reset()         { *m = TemplateStatistics{} }
 func (m *TemplateStatistics) String() string { return proto.CompactTextString(m) }
 func (*TemplateStatistics) ProtoMessage()    {}
 func (*TemplateStatistics) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a5015857536f2ae4, []int{46}
+	return fileDescriptor_a5015857536f2ae4, []int{47}
 }
 
 func (m *TemplateStatistics) XXX_Unmarshal(b []byte) error {
@@ -3204,6 +3305,398 @@ func (m *TemplateStatistics) GetVariableUsageFrequency() map[string]int32 {
 	return nil
 }
 
+type GetTemplateRequest struct {
+	Name                 string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetTemplateRequest) Reset()         { *m = GetTemplateRequest{} }
+func (m *GetTemplateRequest) String() string { return proto.CompactTextString(m) }
+func (*GetTemplateRequest) ProtoMessage()    {}
+func (*GetTemplateRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a5015857536f2ae4, []int{48}
+}
+
+func (m *GetTemplateRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetTemplateRequest.Unmarshal(m, b)
+}
+func (m *GetTemplateRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetTemplateRequest.Marshal(b, m, deterministic)
+}
+func (m *GetTemplateRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetTemplateRequest.Merge(m, src)
+}
+func (m *GetTemplateRequest) XXX_Size() int {
+	return xxx_messageInfo_GetTemplateRequest.Size(m)
+}
+func (m *GetTemplateRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetTemplateRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetTemplateRequest proto.InternalMessageInfo
+
+func (m *GetTemplateRequest) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+type GetTemplateResponse struct {
+	Template             *TemplateInfo `protobuf:"bytes,1,opt,name=template,proto3" json:"template,omitempty"`
+	Content              string        `protobuf:"bytes,2,opt,name=content,proto3" json:"content,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
+}
+
+func (m *GetTemplateResponse) Reset()         { *m = GetTemplateResponse{} }
+func (m *GetTemplateResponse) String() string { return proto.CompactTextString(m) }
+func (*GetTemplateResponse) ProtoMessage()    {}
+func (*GetTemplateResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a5015857536f2ae4, []int{49}
+}
+
+func (m *GetTemplateResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetTemplateResponse.Unmarshal(m, b)
+}
+func (m *GetTemplateResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetTemplateResponse.Marshal(b, m, deterministic)
+}
+func (m *GetTemplateResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetTemplateResponse.Merge(m, src)
+}
+func (m *GetTemplateResponse) XXX_Size() int {
+	return xxx_messageInfo_GetTemplateResponse.Size(m)
+}
+func (m *GetTemplateResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetTemplateResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetTemplateResponse proto.InternalMessageInfo
+
+func (m *GetTemplateResponse) GetTemplate() *TemplateInfo {
+	if m != nil {
+		return m.Template
+	}
+	return nil
+}
+
+func (m *GetTemplateResponse) GetContent() string {
+	if m != nil {
+		return m.Content
+	}
+	return ""
+}
+
+type CreateTemplateRequest struct {
+	Name                 string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	DisplayName          string   `protobuf:"bytes,2,opt,name=display_name,json=displayName,proto3" json:"display_name,omitempty"`
+	Description          string   `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	Category             string   `protobuf:"bytes,4,opt,name=category,proto3" json:"category,omitempty"`
+	Content              string   `protobuf:"bytes,5,opt,name=content,proto3" json:"content,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CreateTemplateRequest) Reset()         { *m = CreateTemplateRequest{} }
+func (m *CreateTemplateRequest) String() string { return proto.CompactTextString(m) }
+func (*CreateTemplateRequest) ProtoMessage()    {}
+func (*CreateTemplateRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a5015857536f2ae4, []int{50}
+}
+
+func (m *CreateTemplateRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CreateTemplateRequest.Unmarshal(m, b)
+}
+func (m *CreateTemplateRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CreateTemplateRequest.Marshal(b, m, deterministic)
+}
+func (m *CreateTemplateRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CreateTemplateRequest.Merge(m, src)
+}
+func (m *CreateTemplateRequest) XXX_Size() int {
+	return xxx_messageInfo_CreateTemplateRequest.Size(m)
+}
+func (m *CreateTemplateRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_CreateTemplateRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CreateTemplateRequest proto.InternalMessageInfo
+
+func (m *CreateTemplateRequest) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *CreateTemplateRequest) GetDisplayName() string {
+	if m != nil {
+		return m.DisplayName
+	}
+	return ""
+}
+
+func (m *CreateTemplateRequest) GetDescription() string {
+	if m != nil {
+		return m.Description
+	}
+	return ""
+}
+
+func (m *CreateTemplateRequest) GetCategory() string {
+	if m != nil {
+		return m.Category
+	}
+	return ""
+}
+
+func (m *CreateTemplateRequest) GetContent() string {
+	if m != nil {
+		return m.Content
+	}
+	return ""
+}
+
+type CreateTemplateResponse struct {
+	Template             *TemplateInfo `protobuf:"bytes,1,opt,name=template,proto3" json:"template,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
+}
+
+func (m *CreateTemplateResponse) Reset()         { *m = CreateTemplateResponse{} }
+func (m *CreateTemplateResponse) String() string { return proto.CompactTextString(m) }
+func (*CreateTemplateResponse) ProtoMessage()    {}
+func (*CreateTemplateResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a5015857536f2ae4, []int{51}
+}
+
+func (m *CreateTemplateResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CreateTemplateResponse.Unmarshal(m, b)
+}
+func (m *CreateTemplateResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CreateTemplateResponse.Marshal(b, m, deterministic)
+}
+func (m *CreateTemplateResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CreateTemplateResponse.Merge(m, src)
+}
+func (m *CreateTemplateResponse) XXX_Size() int {
+	return xxx_messageInfo_CreateTemplateResponse.Size(m)
+}
+func (m *CreateTemplateResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_CreateTemplateResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CreateTemplateResponse proto.InternalMessageInfo
+
+func (m *CreateTemplateResponse) GetTemplate() *TemplateInfo {
+	if m != nil {
+		return m.Template
+	}
+	return nil
+}
+
+type UpdateTemplateRequest struct {
+	Name                 string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	DisplayName          string   `protobuf:"bytes,2,opt,name=display_name,json=displayName,proto3" json:"display_name,omitempty"`
+	Description          string   `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	Category             string   `protobuf:"bytes,4,opt,name=category,proto3" json:"category,omitempty"`
+	Content              string   `protobuf:"bytes,5,opt,name=content,proto3" json:"content,omitempty"`
+	UpdateFields         []string `protobuf:"bytes,6,rep,name=update_fields,json=updateFields,proto3" json:"update_fields,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *UpdateTemplateRequest) Reset()         { *m = UpdateTemplateRequest{} }
+func (m *UpdateTemplateRequest) String() string { return proto.CompactTextString(m) }
+func (*UpdateTemplateRequest) ProtoMessage()    {}
+func (*UpdateTemplateRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a5015857536f2ae4, []int{52}
+}
+
+func (m *UpdateTemplateRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_UpdateTemplateRequest.Unmarshal(m, b)
+}
+func (m *UpdateTemplateRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_UpdateTemplateRequest.Marshal(b, m, deterministic)
+}
+func (m *UpdateTemplateRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UpdateTemplateRequest.Merge(m, src)
+}
+func (m *UpdateTemplateRequest) XXX_Size() int {
+	return xxx_messageInfo_UpdateTemplateRequest.Size(m)
+}
+func (m *UpdateTemplateRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_UpdateTemplateRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UpdateTemplateRequest proto.InternalMessageInfo
+
+func (m *UpdateTemplateRequest) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *UpdateTemplateRequest) GetDisplayName() string {
+	if m != nil {
+		return m.DisplayName
+	}
+	return ""
+}
+
+func (m *UpdateTemplateRequest) GetDescription() string {
+	if m != nil {
+		return m.Description
+	}
+	return ""
+}
+
+func (m *UpdateTemplateRequest) GetCategory() string {
+	if m != nil {
+		return m.Category
+	}
+	return ""
+}
+
+func (m *UpdateTemplateRequest) GetContent() string {
+	if m != nil {
+		return m.Content
+	}
+	return ""
+}
+
+func (m *UpdateTemplateRequest) GetUpdateFields() []string {
+	if m != nil {
+		return m.UpdateFields
+	}
+	return nil
+}
+
+type UpdateTemplateResponse struct {
+	Template             *TemplateInfo `protobuf:"bytes,1,opt,name=template,proto3" json:"template,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
+}
+
+func (m *UpdateTemplateResponse) Reset()         { *m = UpdateTemplateResponse{} }
+func (m *UpdateTemplateResponse) String() string { return proto.CompactTextString(m) }
+func (*UpdateTemplateResponse) ProtoMessage()    {}
+func (*UpdateTemplateResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a5015857536f2ae4, []int{53}
+}
+
+func (m *UpdateTemplateResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_UpdateTemplateResponse.Unmarshal(m, b)
+}
+func (m *UpdateTemplateResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_UpdateTemplateResponse.Marshal(b, m, deterministic)
+}
+func (m *UpdateTemplateResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UpdateTemplateResponse.Merge(m, src)
+}
+func (m *UpdateTemplateResponse) XXX_Size() int {
+	return xxx_messageInfo_UpdateTemplateResponse.Size(m)
+}
+func (m *UpdateTemplateResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_UpdateTemplateResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UpdateTemplateResponse proto.InternalMessageInfo
+
+func (m *UpdateTemplateResponse) GetTemplate() *TemplateInfo {
+	if m != nil {
+		return m.Template
+	}
+	return nil
+}
+
+type DeleteTemplateRequest struct {
+	Name                 string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DeleteTemplateRequest) Reset()         { *m = DeleteTemplateRequest{} }
+func (m *DeleteTemplateRequest) String() string { return proto.CompactTextString(m) }
+func (*DeleteTemplateRequest) ProtoMessage()    {}
+func (*DeleteTemplateRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a5015857536f2ae4, []int{54}
+}
+
+func (m *DeleteTemplateRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DeleteTemplateRequest.Unmarshal(m, b)
+}
+func (m *DeleteTemplateRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DeleteTemplateRequest.Marshal(b, m, deterministic)
+}
+func (m *DeleteTemplateRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeleteTemplateRequest.Merge(m, src)
+}
+func (m *DeleteTemplateRequest) XXX_Size() int {
+	return xxx_messageInfo_DeleteTemplateRequest.Size(m)
+}
+func (m *DeleteTemplateRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeleteTemplateRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DeleteTemplateRequest proto.InternalMessageInfo
+
+func (m *DeleteTemplateRequest) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+type DeleteTemplateResponse struct {
+	Status               string   `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DeleteTemplateResponse) Reset()         { *m = DeleteTemplateResponse{} }
+func (m *DeleteTemplateResponse) String() string { return proto.CompactTextString(m) }
+func (*DeleteTemplateResponse) ProtoMessage()    {}
+func (*DeleteTemplateResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a5015857536f2ae4, []int{55}
+}
+
+func (m *DeleteTemplateResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DeleteTemplateResponse.Unmarshal(m, b)
+}
+func (m *DeleteTemplateResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DeleteTemplateResponse.Marshal(b, m, deterministic)
+}
+func (m *DeleteTemplateResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeleteTemplateResponse.Merge(m, src)
+}
+func (m *DeleteTemplateResponse) XXX_Size() int {
+	return xxx_messageInfo_DeleteTemplateResponse.Size(m)
+}
+func (m *DeleteTemplateResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeleteTemplateResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DeleteTemplateResponse proto.InternalMessageInfo
+
+func (m *DeleteTemplateResponse) GetStatus() string {
+	if m != nil {
+		return m.Status
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*AssembleContextRequest)(nil), "api.assembler.v1.AssembleContextRequest")
 	proto.RegisterType((*DocumentChunk)(nil), "api.assembler.v1.DocumentChunk")
@@ -3211,6 +3704,7 @@ func init() {
 	proto.RegisterMapType((map[string]string)(nil), "api.assembler.v1.ChunkMetadata.CustomMetadataEntry")
 	proto.RegisterType((*AssemblyOptions)(nil), "api.assembler.v1.AssemblyOptions")
 	proto.RegisterMapType((map[string]string)(nil), "api.assembler.v1.AssemblyOptions.CustomOptionsEntry")
+	proto.RegisterType((*DeduplicationOptions)(nil), "api.assembler.v1.DeduplicationOptions")
 	proto.RegisterType((*ContentSelectionStrategy)(nil), "api.assembler.v1.ContentSelectionStrategy")
 	proto.RegisterType((*ContentArrangementStrategy)(nil), "api.assembler.v1.ContentArrangementStrategy")
 	proto.RegisterType((*SectionPriority)(nil), "api.assembler.v1.SectionPriority")
@@ -3264,6 +3758,14 @@ func init() {
 	proto.RegisterType((*TemplateInfo)(nil), "api.assembler.v1.TemplateInfo")
 	proto.RegisterType((*TemplateStatistics)(nil), "api.assembler.v1.TemplateStatistics")
 	proto.RegisterMapType((map[string]int32)(nil), "api.assembler.v1.TemplateStatistics.VariableUsageFrequencyEntry")
+	proto.RegisterType((*GetTemplateRequest)(nil), "api.assembler.v1.GetTemplateRequest")
+	proto.RegisterType((*GetTemplateResponse)(nil), "api.assembler.v1.GetTemplateResponse")
+	proto.RegisterType((*CreateTemplateRequest)(nil), "api.assembler.v1.CreateTemplateRequest")
+	proto.RegisterType((*CreateTemplateResponse)(nil), "api.assembler.v1.CreateTemplateResponse")
+	proto.RegisterType((*UpdateTemplateRequest)(nil), "api.assembler.v1.UpdateTemplateRequest")
+	proto.RegisterType((*UpdateTemplateResponse)(nil), "api.assembler.v1.UpdateTemplateResponse")
+	proto.RegisterType((*DeleteTemplateRequest)(nil), "api.assembler.v1.DeleteTemplateRequest")
+	proto.RegisterType((*DeleteTemplateResponse)(nil), "api.assembler.v1.DeleteTemplateResponse")
 }
 
 func init() {
@@ -3271,281 +3773,308 @@ func init() {
 }
 
 var fileDescriptor_a5015857536f2ae4 = []byte{
-	// 4412 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x3b, 0x49, 0x6c, 0x1c, 0x57,
-	0x76, 0xac, 0x26, 0x29, 0x76, 0xbf, 0x6e, 0x76, 0x37, 0x3f, 0x17, 0xb5, 0x5a, 0x1b, 0x55, 0x96,
-	0x46, 0x94, 0x17, 0xd2, 0x92, 0x0d, 0xdb, 0xb2, 0x2d, 0x8f, 0xd8, 0x94, 0x6d, 0x29, 0xb6, 0xc6,
-	0x9c, 0x22, 0xed, 0x64, 0xb2, 0xa0, 0x50, 0xac, 0xfe, 0x6c, 0x16, 0x54, 0x5d, 0xd5, 0xae, 0xfa,
-	0xd5, 0x12, 0x95, 0x8b, 0x91, 0x00, 0x19, 0x04, 0xc1, 0x5c, 0x66, 0x06, 0x48, 0x90, 0x05, 0xb9,
-	0xcf, 0x75, 0x10, 0xe4, 0x14, 0xe4, 0x16, 0xe4, 0x92, 0x20, 0xc0, 0x20, 0x40, 0x10, 0xe4, 0x10,
-	0x04, 0x03, 0xc4, 0xb9, 0xe4, 0x98, 0x83, 0x4e, 0xc1, 0xff, 0xff, 0xfd, 0x5f, 0x4b, 0x57, 0x8b,
-	0x24, 0x7c, 0x12, 0xfb, 0x2d, 0x7f, 0x79, 0xdb, 0x7f, 0x4b, 0x09, 0xd6, 0xc6, 0xb7, 0xb7, 0x9c,
-	0x38, 0xa6, 0xc3, 0x03, 0x9f, 0x46, 0x76, 0x3c, 0x76, 0x37, 0x47, 0x51, 0xc8, 0x42, 0xd2, 0x76,
-	0x46, 0xde, 0xa6, 0x46, 0x6c, 0x8e, 0x6f, 0x77, 0x2f, 0x0d, 0xc2, 0x70, 0xe0, 0xd3, 0x2d, 0x67,
-	0xe4, 0x6d, 0x39, 0x41, 0x10, 0x32, 0x87, 0x79, 0x61, 0x10, 0x4b, 0xfa, 0xee, 0x45, 0xc4, 0x8a,
-	0x5f, 0x07, 0xc9, 0xe1, 0x16, 0x1d, 0x8e, 0xd8, 0x31, 0x22, 0xaf, 0x16, 0x91, 0xcc, 0x1b, 0xd2,
-	0x98, 0x39, 0xc3, 0x11, 0x12, 0x74, 0xf9, 0xa2, 0x6e, 0x38, 0x1c, 0x86, 0xc1, 0xd6, 0xf8, 0x36,
-	0xfe, 0x55, 0x8e, 0xa3, 0x51, 0x14, 0x46, 0x6a, 0xd7, 0xf3, 0x63, 0xc7, 0xf7, 0xfa, 0x0e, 0xa3,
-	0x5b, 0xea, 0x0f, 0x89, 0x30, 0xff, 0xcf, 0x80, 0xb5, 0x6d, 0x3c, 0xfd, 0x4e, 0x18, 0x30, 0xfa,
-	0x8c, 0x59, 0xf4, 0xeb, 0x84, 0xc6, 0x8c, 0x5c, 0x86, 0xf9, 0xaf, 0x13, 0x1a, 0x1d, 0x77, 0x8c,
-	0x75, 0x63, 0xa3, 0xd6, 0x5b, 0x78, 0xd1, 0x9b, 0x8b, 0x2a, 0x6d, 0xc3, 0x92, 0x50, 0xb2, 0x0d,
-	0xe7, 0xdc, 0xa3, 0x24, 0x78, 0x12, 0x77, 0x2a, 0xeb, 0xb3, 0x1b, 0xf5, 0x3b, 0x57, 0x37, 0x8b,
-	0x92, 0xd8, 0x7c, 0x10, 0xba, 0xc9, 0x90, 0x06, 0x6c, 0x87, 0xd3, 0xf5, 0xaa, 0x2f, 0x7a, 0xf3,
-	0x3f, 0x35, 0x2a, 0x55, 0xc3, 0x42, 0x46, 0xf2, 0x01, 0x2c, 0x84, 0x23, 0x21, 0x9c, 0xce, 0xec,
-	0xba, 0xb1, 0x51, 0xbf, 0x73, 0x6d, 0x72, 0x0d, 0x3c, 0xdc, 0xf1, 0x17, 0x92, 0xd0, 0x52, 0x1c,
-	0xe4, 0x1e, 0x54, 0x19, 0x1d, 0x8e, 0x7c, 0x87, 0xd1, 0xce, 0xdc, 0x34, 0x6e, 0xbc, 0xd2, 0x3e,
-	0x12, 0x5a, 0x9a, 0xc5, 0xfc, 0xcb, 0x0a, 0x2c, 0xe6, 0xce, 0x47, 0x2e, 0x40, 0x55, 0x9c, 0xcb,
-	0xf6, 0xfa, 0xf2, 0xca, 0xd6, 0x82, 0xf8, 0xfd, 0xa8, 0x4f, 0xae, 0x42, 0xbd, 0x8f, 0xb4, 0x1c,
-	0x5b, 0x11, 0x58, 0x50, 0xa0, 0x47, 0x7d, 0x72, 0x0d, 0x16, 0x5c, 0xbe, 0x55, 0xc0, 0xc4, 0x4d,
-	0x32, 0xd2, 0x52, 0x70, 0xb2, 0x02, 0xf3, 0xcc, 0x63, 0xbe, 0x3c, 0x6c, 0xcd, 0x92, 0x3f, 0xc8,
-	0x4d, 0x68, 0x45, 0xd4, 0xa7, 0x63, 0x27, 0x70, 0xa9, 0x1d, 0xbb, 0x61, 0x44, 0x3b, 0xf3, 0xeb,
-	0xc6, 0x46, 0xc5, 0x6a, 0x6a, 0xf0, 0x1e, 0x87, 0x92, 0x37, 0x61, 0x65, 0x14, 0xc6, 0x1e, 0xbf,
-	0xbb, 0xed, 0x05, 0xb6, 0xda, 0xbb, 0x73, 0x6e, 0xdd, 0xd8, 0x98, 0xb7, 0x88, 0xc2, 0x3d, 0x0a,
-	0xd4, 0xa5, 0xc8, 0x07, 0x50, 0x1d, 0x52, 0xe6, 0xf4, 0x1d, 0xe6, 0x74, 0x16, 0x84, 0x80, 0x4a,
-	0x54, 0x24, 0xae, 0xfe, 0x18, 0xc9, 0x2c, 0xcd, 0x60, 0x7e, 0x5b, 0x81, 0xc5, 0x1c, 0x8e, 0xbc,
-	0x02, 0x8b, 0x5a, 0x06, 0xec, 0x78, 0x44, 0x51, 0x46, 0x0d, 0x05, 0xdc, 0x3f, 0x1e, 0x51, 0xb2,
-	0x06, 0xe7, 0x9c, 0x84, 0x1d, 0x85, 0x11, 0xca, 0x08, 0x7f, 0x91, 0xbb, 0x00, 0x6e, 0x44, 0x1d,
-	0x46, 0xfb, 0xb6, 0xc3, 0x50, 0xd9, 0xdd, 0x4d, 0x69, 0xed, 0x9b, 0xca, 0xda, 0x37, 0xf7, 0x95,
-	0xb5, 0x5b, 0x35, 0xa4, 0xde, 0xe6, 0x66, 0x08, 0x71, 0x98, 0x44, 0x2e, 0xb5, 0x93, 0xc8, 0x47,
-	0xe1, 0xd5, 0x24, 0xe4, 0xcb, 0xc8, 0x27, 0xbf, 0x0b, 0x2d, 0x37, 0x89, 0x59, 0x38, 0xb4, 0xf5,
-	0x65, 0xe7, 0x85, 0x3d, 0xbe, 0x75, 0xc2, 0x65, 0x37, 0x77, 0x04, 0x9b, 0xfa, 0xf9, 0x71, 0xc0,
-	0xa2, 0x63, 0xab, 0xe9, 0xe6, 0x80, 0x5c, 0xf1, 0x2c, 0x7c, 0x42, 0x03, 0xdb, 0x0d, 0x13, 0x2d,
-	0x6c, 0x10, 0xa0, 0x1d, 0x0e, 0xe9, 0x6e, 0xc3, 0x72, 0xc9, 0x3a, 0xa4, 0x0d, 0xb3, 0x4f, 0x28,
-	0x7a, 0x8e, 0xc5, 0xff, 0xe4, 0xea, 0x1f, 0x3b, 0x7e, 0x42, 0x51, 0x30, 0xf2, 0xc7, 0xfb, 0x95,
-	0xf7, 0x0c, 0xf3, 0x6f, 0xe7, 0xa1, 0x55, 0xb0, 0x72, 0x72, 0x17, 0xc8, 0xd0, 0x79, 0x66, 0xbb,
-	0xd2, 0x7c, 0x6d, 0x9f, 0x06, 0x03, 0x76, 0x24, 0x96, 0x9b, 0xef, 0xd5, 0x5f, 0xf4, 0xaa, 0xdd,
-	0x73, 0x9d, 0x6f, 0x5e, 0x18, 0x1b, 0x7d, 0xab, 0x3d, 0x74, 0x9e, 0xa1, 0x91, 0x7f, 0x2e, 0x88,
-	0xc8, 0x0d, 0x68, 0x8a, 0xf3, 0x79, 0xcf, 0x69, 0x64, 0x07, 0xce, 0x50, 0xed, 0xb8, 0xa8, 0xa1,
-	0x3f, 0x70, 0x86, 0x94, 0xfc, 0x08, 0x48, 0x4c, 0x7d, 0xea, 0x0a, 0x83, 0x8a, 0x59, 0xe4, 0x30,
-	0x3a, 0x38, 0x46, 0xcd, 0xbc, 0x3a, 0xc5, 0x91, 0x02, 0xb6, 0xa7, 0x58, 0xf6, 0x90, 0xc3, 0x5a,
-	0x8a, 0x8b, 0x20, 0x62, 0xc3, 0x8a, 0x13, 0x45, 0x4e, 0x30, 0xa0, 0xc2, 0x58, 0xf4, 0xe2, 0xd2,
-	0x4b, 0x5f, 0x9f, 0xba, 0xf8, 0x76, 0xca, 0xa4, 0x97, 0x5f, 0x76, 0x26, 0x81, 0xe4, 0x3e, 0x5c,
-	0x1a, 0x45, 0x34, 0xa6, 0xd1, 0x98, 0x6a, 0x47, 0xb0, 0x0f, 0xc2, 0x24, 0xe8, 0x3b, 0x91, 0x47,
-	0x63, 0xe1, 0x41, 0x55, 0xab, 0xab, 0x68, 0x94, 0x47, 0xf4, 0x34, 0x05, 0x79, 0x0f, 0x3a, 0x5e,
-	0xe0, 0xfa, 0x49, 0x9f, 0xda, 0x68, 0x5c, 0xae, 0x87, 0x71, 0x5a, 0x28, 0xb9, 0x6a, 0xad, 0x21,
-	0x7e, 0x4f, 0xa0, 0x77, 0x14, 0x96, 0xdc, 0x82, 0xb6, 0xe2, 0xcc, 0x79, 0x57, 0xd5, 0x6a, 0x21,
-	0x5c, 0x1b, 0xcf, 0x7d, 0x58, 0xee, 0x7b, 0x63, 0x1a, 0xc5, 0x1e, 0x3b, 0xb6, 0xd9, 0x51, 0x44,
-	0xe3, 0xa3, 0xd0, 0xef, 0x77, 0xaa, 0xdc, 0xbf, 0x7b, 0xad, 0x17, 0xbd, 0x06, 0xc0, 0xe5, 0x99,
-	0x99, 0x6f, 0xbe, 0xff, 0xc6, 0xcc, 0xcc, 0xcc, 0x8c, 0x45, 0x34, 0xed, 0xbe, 0x22, 0x25, 0xbf,
-	0x03, 0x68, 0x90, 0xb6, 0x8a, 0x93, 0x35, 0x61, 0xdb, 0x6f, 0x9f, 0x18, 0x27, 0xd1, 0xba, 0xf1,
-	0x97, 0x34, 0xee, 0x45, 0x37, 0x0b, 0xeb, 0xde, 0x07, 0x32, 0x49, 0x74, 0x26, 0xcb, 0xfd, 0xab,
-	0x0a, 0x74, 0xa6, 0x19, 0x06, 0x8f, 0x17, 0x4a, 0xf3, 0xb9, 0x78, 0xa1, 0x80, 0x22, 0x5e, 0x5c,
-	0x06, 0x10, 0x76, 0xae, 0x1e, 0x12, 0xee, 0x5e, 0x35, 0x6e, 0xd2, 0xf2, 0x81, 0xb8, 0x09, 0x2d,
-	0x11, 0x13, 0x33, 0xd2, 0x9b, 0x95, 0xd1, 0x51, 0x80, 0x53, 0x41, 0xdd, 0x82, 0x76, 0x2a, 0xea,
-	0xa7, 0xd4, 0x1b, 0x1c, 0x31, 0x61, 0x6e, 0x15, 0xab, 0xa5, 0xe1, 0xbf, 0x29, 0xc0, 0xe4, 0x36,
-	0xac, 0x44, 0xb4, 0xcf, 0x2d, 0x21, 0x70, 0xb3, 0x6a, 0x91, 0x61, 0x77, 0x39, 0xc5, 0xa5, 0xab,
-	0xbf, 0x03, 0xe7, 0x47, 0x91, 0x17, 0x46, 0x7c, 0xf1, 0x5c, 0x0c, 0xe4, 0xc6, 0x32, 0xbb, 0x51,
-	0xb3, 0x56, 0x15, 0xfa, 0x41, 0x26, 0x18, 0xc6, 0xe6, 0x5f, 0x57, 0xa0, 0x3b, 0xdd, 0xb6, 0xf9,
-	0xa1, 0xb3, 0x7e, 0x92, 0x11, 0x52, 0x2b, 0x03, 0x17, 0x72, 0x7a, 0x15, 0x96, 0x06, 0x51, 0x98,
-	0x8c, 0xec, 0x83, 0xf4, 0x04, 0x42, 0x5c, 0x55, 0xab, 0x25, 0x10, 0x3d, 0xbd, 0x35, 0xb9, 0x0e,
-	0x4d, 0x4d, 0xcb, 0xc2, 0x91, 0xe7, 0x0a, 0x99, 0x55, 0xad, 0x06, 0x12, 0xee, 0x73, 0x18, 0xb9,
-	0x03, 0xab, 0x43, 0xc7, 0x0b, 0x98, 0xe3, 0x05, 0x3a, 0xcc, 0x1c, 0xfa, 0xe1, 0x53, 0x21, 0xb6,
-	0xaa, 0xb5, 0xac, 0x90, 0x18, 0x5c, 0x3e, 0xf1, 0xc3, 0xa7, 0x64, 0x97, 0xc7, 0x0c, 0x19, 0x31,
-	0xf0, 0xc2, 0xd2, 0xdb, 0x66, 0xcb, 0x1f, 0xdf, 0x3d, 0x49, 0xbb, 0x8b, 0xb2, 0xe1, 0xa1, 0x22,
-	0x0b, 0xf0, 0x68, 0x6c, 0xc6, 0xd0, 0x2a, 0x50, 0x91, 0x6b, 0xd0, 0x50, 0x9b, 0x64, 0x24, 0x52,
-	0x47, 0x98, 0x90, 0x46, 0x17, 0xaa, 0x4a, 0xe0, 0x68, 0x33, 0xfa, 0x37, 0x67, 0x97, 0xfa, 0xb7,
-	0x0f, 0xc2, 0x30, 0x66, 0x68, 0x2f, 0x75, 0x09, 0xeb, 0x71, 0x90, 0xf9, 0xef, 0x15, 0x68, 0x15,
-	0x12, 0x03, 0x6e, 0xad, 0x2a, 0x35, 0x90, 0x41, 0x13, 0xad, 0x55, 0x01, 0x45, 0xcc, 0xe4, 0x26,
-	0x7d, 0x1c, 0x33, 0x3a, 0xb4, 0x47, 0x51, 0x38, 0x1c, 0x31, 0xf4, 0x88, 0x86, 0x04, 0xee, 0x0a,
-	0x18, 0x7f, 0xa8, 0x93, 0x98, 0x46, 0x48, 0x62, 0xeb, 0x1c, 0x45, 0xe4, 0x05, 0x16, 0xe1, 0x38,
-	0x49, 0xa9, 0xf7, 0xbe, 0x07, 0x55, 0xbc, 0x5d, 0xdc, 0x99, 0x9b, 0x26, 0x4c, 0x45, 0x8d, 0xe2,
-	0xb2, 0x34, 0x0b, 0xf9, 0x01, 0xd4, 0xc6, 0x4e, 0xe4, 0x39, 0x07, 0xbe, 0x56, 0xc6, 0x9b, 0x27,
-	0x66, 0x42, 0x9b, 0x5f, 0x29, 0x16, 0x19, 0x1b, 0xd2, 0x25, 0xba, 0x1f, 0x42, 0x33, 0x8f, 0x3c,
-	0x53, 0x4c, 0xf8, 0xe7, 0x0a, 0xb4, 0x0a, 0x67, 0xcd, 0xaa, 0x34, 0x23, 0x5b, 0xa5, 0x52, 0x21,
-	0xda, 0x5b, 0xd0, 0xd6, 0x5a, 0x57, 0x12, 0x93, 0x6b, 0xb7, 0x94, 0xe6, 0x95, 0xb8, 0xae, 0x42,
-	0xdd, 0x8b, 0xed, 0x88, 0x7e, 0x9d, 0x78, 0x11, 0xed, 0xa3, 0x71, 0x83, 0x17, 0x5b, 0x08, 0x51,
-	0x41, 0x05, 0x1f, 0xcd, 0x39, 0x1d, 0x54, 0xf0, 0x81, 0x1c, 0x00, 0x39, 0x0c, 0xa3, 0xa1, 0xc3,
-	0x98, 0x17, 0x0c, 0x74, 0x60, 0x95, 0x82, 0x7b, 0xef, 0x44, 0xc1, 0x6f, 0x7e, 0xa2, 0x79, 0x73,
-	0xc1, 0x75, 0xe9, 0xb0, 0x08, 0xef, 0x3e, 0x80, 0xb5, 0x72, 0xe2, 0x33, 0x09, 0xf4, 0x7f, 0x0d,
-	0x38, 0x3f, 0x91, 0xa1, 0xc7, 0xa3, 0x30, 0x88, 0x29, 0x79, 0x0d, 0x96, 0xd4, 0x59, 0xfb, 0xca,
-	0x8b, 0x71, 0xd5, 0xb6, 0x46, 0x20, 0x13, 0xf9, 0x1c, 0xda, 0xca, 0xd1, 0xf5, 0xcb, 0x55, 0x39,
-	0x21, 0x71, 0xd6, 0x99, 0x61, 0xcb, 0xcd, 0x03, 0xc8, 0x87, 0x50, 0x4f, 0x62, 0xbe, 0xab, 0x0c,
-	0xdd, 0xb3, 0x42, 0x7c, 0x17, 0x27, 0x17, 0xfa, 0x32, 0xa6, 0x7d, 0x11, 0xcd, 0x2d, 0x48, 0xd4,
-	0x9f, 0x31, 0xf7, 0xe0, 0xa7, 0x4e, 0x14, 0x78, 0xc1, 0x40, 0x9a, 0x7c, 0xcd, 0xd2, 0xbf, 0xb3,
-	0xee, 0xa9, 0x77, 0xbb, 0x06, 0x0d, 0x16, 0x32, 0xc7, 0xb7, 0x45, 0x12, 0x13, 0xcb, 0x4c, 0xc8,
-	0xaa, 0x0b, 0xd8, 0xbe, 0x00, 0x71, 0xb3, 0x90, 0x67, 0xb1, 0xf9, 0x3e, 0x18, 0x17, 0x40, 0x82,
-	0xf8, 0x21, 0xf8, 0x63, 0x82, 0x04, 0x87, 0x9e, 0xcf, 0xa8, 0xb2, 0x9d, 0x79, 0xab, 0x29, 0xc1,
-	0x9f, 0x20, 0x94, 0x6c, 0xc2, 0xb2, 0x33, 0x1e, 0xd8, 0xc5, 0xbc, 0x5c, 0xbe, 0x27, 0x4b, 0xce,
-	0x78, 0x60, 0xe5, 0x53, 0xf3, 0x5c, 0xc6, 0x25, 0x36, 0x9f, 0x2f, 0x64, 0x5c, 0x62, 0xff, 0x07,
-	0x00, 0x31, 0xcf, 0x22, 0x62, 0xe6, 0xb9, 0x32, 0xcb, 0xa8, 0xdf, 0xb9, 0x3e, 0xfd, 0x21, 0xdf,
-	0xd3, 0xb4, 0x56, 0x86, 0x8f, 0xdc, 0x83, 0x46, 0xaa, 0x72, 0x87, 0x61, 0x66, 0xff, 0xb2, 0x5c,
-	0xba, 0xae, 0xe9, 0xb7, 0x99, 0xf9, 0xcb, 0x59, 0x20, 0x93, 0x3b, 0x90, 0x0d, 0x50, 0xf6, 0x72,
-	0x6c, 0xf3, 0x9a, 0xd3, 0x1e, 0x4a, 0x19, 0xcf, 0x5a, 0x4d, 0x05, 0xe7, 0x0b, 0x3e, 0x16, 0x99,
-	0x53, 0x3f, 0x19, 0xf9, 0x9e, 0xcb, 0x23, 0x25, 0xca, 0x33, 0xa2, 0xc3, 0x70, 0xac, 0x65, 0xbe,
-	0xa6, 0xf1, 0x52, 0xd9, 0x96, 0xc4, 0x92, 0x7b, 0x70, 0xd1, 0x0f, 0x9f, 0xda, 0x5f, 0x27, 0x8e,
-	0xcf, 0x1f, 0xd2, 0x72, 0x5d, 0x74, 0xfc, 0xf0, 0xe9, 0x0f, 0x25, 0xc5, 0x4e, 0x5e, 0x2b, 0xef,
-	0xc3, 0x05, 0x2c, 0xa5, 0x6c, 0x37, 0x1c, 0xf2, 0xdc, 0x2e, 0xe6, 0xd1, 0x22, 0xe2, 0x79, 0x19,
-	0xea, 0xe6, 0x3c, 0x12, 0xec, 0xa4, 0x78, 0x8b, 0xa3, 0x49, 0x02, 0xe7, 0x71, 0xbb, 0x83, 0xc2,
-	0x0b, 0x8e, 0x7e, 0xff, 0xd1, 0x69, 0xf4, 0x20, 0xeb, 0x87, 0xb8, 0x97, 0x7b, 0xe5, 0xa5, 0xf7,
-	0xaf, 0xb8, 0x25, 0xa8, 0xee, 0xa7, 0x70, 0x61, 0x2a, 0xcb, 0x49, 0x31, 0x60, 0x3e, 0x1b, 0x03,
-	0x7e, 0x6d, 0x40, 0x4d, 0x3b, 0xd2, 0x77, 0x2a, 0x54, 0x37, 0x61, 0x39, 0x5b, 0x46, 0xaa, 0x98,
-	0x21, 0x85, 0xbf, 0x94, 0x56, 0x91, 0x2a, 0x68, 0x14, 0x0a, 0xa0, 0xb9, 0x62, 0x01, 0x74, 0xfa,
-	0x02, 0xf6, 0x1a, 0x34, 0x92, 0xd8, 0x19, 0x50, 0x3b, 0xa2, 0x4e, 0x1c, 0x06, 0xc2, 0x01, 0x6a,
-	0x56, 0x5d, 0xc0, 0x2c, 0x01, 0x32, 0xd7, 0xa1, 0xba, 0xcf, 0x0b, 0x19, 0x2f, 0x96, 0xe5, 0x32,
-	0x7d, 0xc6, 0xb8, 0x19, 0xce, 0x8a, 0x72, 0x99, 0xff, 0x30, 0x7f, 0x59, 0x81, 0xd5, 0x8f, 0x63,
-	0xe6, 0x0d, 0x1d, 0x46, 0xa5, 0xdf, 0xab, 0x6e, 0xc5, 0x0a, 0xcc, 0xa5, 0xd1, 0xef, 0xe1, 0x8c,
-	0x25, 0x7e, 0x91, 0x3b, 0x6a, 0x95, 0x0a, 0xba, 0x49, 0x49, 0x78, 0x97, 0x1b, 0x3e, 0x9c, 0xc1,
-	0x3d, 0xc8, 0x1e, 0xa8, 0x60, 0x27, 0x1e, 0x19, 0x1a, 0xab, 0x82, 0x75, 0x63, 0xaa, 0x91, 0x14,
-	0x5a, 0x27, 0x0f, 0x67, 0xac, 0xa6, 0x9b, 0x6f, 0xa6, 0x4c, 0x56, 0x65, 0x73, 0x65, 0x55, 0x59,
-	0x2f, 0xed, 0x88, 0xcc, 0x4f, 0xdb, 0x53, 0xdc, 0x1b, 0x85, 0xe0, 0x85, 0x41, 0xb1, 0x31, 0xd2,
-	0x6b, 0x43, 0x53, 0x39, 0x8a, 0xac, 0x6d, 0xcc, 0xbf, 0x33, 0x60, 0xad, 0x9c, 0x8b, 0xbc, 0x0d,
-	0x6b, 0xba, 0x10, 0x1a, 0x51, 0xd7, 0xcb, 0x87, 0xd8, 0xaa, 0xb5, 0xa2, 0xca, 0x20, 0x89, 0xc4,
-	0x58, 0xfb, 0x21, 0x74, 0x15, 0x57, 0x9f, 0x32, 0xc7, 0xe3, 0xb1, 0xe8, 0x20, 0xa2, 0xce, 0x93,
-	0x7e, 0xf8, 0x34, 0xc0, 0xbc, 0x54, 0x15, 0x58, 0x0f, 0x90, 0xa0, 0xa7, 0xf0, 0x64, 0x0b, 0x56,
-	0xa4, 0x4d, 0x89, 0x14, 0xda, 0x66, 0x21, 0x1a, 0xd7, 0xac, 0xd0, 0xf4, 0x92, 0xc0, 0x89, 0x04,
-	0x7a, 0x3f, 0x14, 0x36, 0x66, 0xfe, 0x53, 0x05, 0xd6, 0x8a, 0x5a, 0xc7, 0x17, 0x70, 0x1b, 0x1a,
-	0xb1, 0x17, 0x0c, 0x7c, 0x8a, 0x6b, 0x18, 0x42, 0x6a, 0x97, 0xa6, 0x48, 0x4d, 0x2c, 0xf7, 0x70,
-	0xc6, 0xaa, 0x4b, 0x1e, 0x69, 0xc1, 0x9f, 0x42, 0xe3, 0xc0, 0x61, 0xee, 0x91, 0x5c, 0x41, 0x99,
-	0x8a, 0x39, 0xb9, 0x44, 0x8f, 0x53, 0xa5, 0xeb, 0xc4, 0x7c, 0x21, 0xc1, 0x29, 0x7f, 0xf2, 0x92,
-	0x5a, 0x19, 0x0e, 0xd5, 0x82, 0x9e, 0x6e, 0x3b, 0x2a, 0x23, 0xcb, 0x2b, 0xe6, 0xe1, 0x8c, 0xb5,
-	0x84, 0xab, 0xa4, 0x40, 0xd2, 0xcb, 0xf4, 0x72, 0x64, 0x19, 0xfd, 0xbd, 0x29, 0x57, 0xf4, 0x9e,
-	0x0b, 0x8e, 0xc9, 0x96, 0x4e, 0xaf, 0x0a, 0xe7, 0x22, 0x1a, 0x27, 0x3e, 0x33, 0x43, 0x80, 0xf4,
-	0x1a, 0xa7, 0x79, 0x5b, 0x3f, 0x82, 0x5a, 0x5e, 0xbd, 0xf5, 0x3b, 0xeb, 0x53, 0xf6, 0xd7, 0x6a,
-	0xb6, 0x52, 0x16, 0xf3, 0x6f, 0x0c, 0x68, 0x17, 0xa5, 0x47, 0xde, 0x86, 0x73, 0x28, 0x71, 0x43,
-	0xc4, 0xe0, 0x97, 0x2a, 0xcd, 0x42, 0x5a, 0xfe, 0x52, 0x65, 0x4f, 0x6b, 0x3b, 0xbe, 0x8f, 0xf1,
-	0xb2, 0x99, 0x39, 0xf1, 0xb6, 0xef, 0x93, 0xed, 0xdc, 0x7b, 0x3b, 0xb5, 0xc1, 0x28, 0x18, 0xca,
-	0x1f, 0x5b, 0xf3, 0x27, 0x15, 0x58, 0x2b, 0x57, 0x13, 0x77, 0x1c, 0x54, 0x32, 0xed, 0xdb, 0x25,
-	0xf2, 0x5b, 0xd1, 0xd8, 0xfd, 0x8c, 0x20, 0xaf, 0x41, 0x43, 0x74, 0x4f, 0x15, 0xad, 0x3c, 0x79,
-	0x5d, 0xc0, 0x90, 0xe4, 0x46, 0xea, 0xbe, 0x48, 0x24, 0x83, 0xf3, 0x22, 0x42, 0x91, 0xec, 0x26,
-	0xb4, 0x74, 0xc1, 0x82, 0x74, 0x73, 0x28, 0x06, 0x04, 0x23, 0xe1, 0x6f, 0x40, 0x5b, 0xbe, 0x16,
-	0xd2, 0xe7, 0xbc, 0xe0, 0x30, 0xc4, 0x47, 0x6f, 0x7d, 0x4a, 0x87, 0x4c, 0x30, 0x3e, 0x0a, 0x0e,
-	0x43, 0xcc, 0x8c, 0xf4, 0x6f, 0xf3, 0x3f, 0x2a, 0xd0, 0xcc, 0x6b, 0x99, 0x1f, 0x37, 0xa2, 0x83,
-	0xc4, 0x77, 0xa2, 0xfc, 0xfd, 0x17, 0x11, 0x9a, 0xde, 0xaa, 0x10, 0x5f, 0xe4, 0xd5, 0x17, 0xe3,
-	0x5c, 0x60, 0x79, 0x03, 0xc8, 0x28, 0x09, 0x5c, 0x96, 0x38, 0xb2, 0x14, 0xc8, 0x0a, 0x60, 0x29,
-	0x83, 0x41, 0xf2, 0x57, 0x60, 0x31, 0x48, 0x86, 0x07, 0x34, 0xca, 0x8b, 0xa0, 0x21, 0x81, 0x48,
-	0xe4, 0xc2, 0x12, 0x36, 0x51, 0x78, 0x4e, 0x32, 0x08, 0xa3, 0xb4, 0x68, 0x7d, 0xe7, 0x24, 0x23,
-	0xc6, 0x36, 0xca, 0x8e, 0x66, 0x94, 0xcf, 0x7d, 0xdb, 0x2d, 0x80, 0xbb, 0x3b, 0xb0, 0x5a, 0x4a,
-	0x7a, 0xa6, 0x67, 0xfe, 0xe7, 0x06, 0xb4, 0x0a, 0xe6, 0x28, 0x8a, 0x19, 0x2f, 0xc8, 0xcb, 0xb6,
-	0x36, 0xf4, 0x94, 0x04, 0xb0, 0xd6, 0xc9, 0xc9, 0x94, 0xd7, 0x3a, 0x29, 0x9a, 0xa7, 0xb2, 0x19,
-	0x39, 0x56, 0xac, 0x9a, 0x33, 0x1e, 0x20, 0xfa, 0x3a, 0x34, 0x63, 0xd6, 0xb7, 0xfb, 0x74, 0x9c,
-	0x15, 0x60, 0xc5, 0x6a, 0xc4, 0xac, 0xff, 0x80, 0x8e, 0x25, 0x95, 0xf9, 0x53, 0x03, 0x9a, 0x79,
-	0xc3, 0x38, 0x21, 0x05, 0xc9, 0x66, 0x0c, 0x95, 0x89, 0x8c, 0xe1, 0x01, 0x5c, 0x49, 0x3d, 0x47,
-	0x05, 0x4c, 0xfe, 0x6f, 0xe4, 0x1d, 0x24, 0x3a, 0x64, 0x56, 0xac, 0x4b, 0x9a, 0x0a, 0x5d, 0x70,
-	0x27, 0x43, 0x63, 0xfe, 0x63, 0x05, 0x56, 0xca, 0x02, 0x5e, 0x49, 0x36, 0x6e, 0x94, 0x65, 0xe3,
-	0xaf, 0xc1, 0x52, 0x4a, 0x26, 0x3a, 0x44, 0x61, 0x80, 0xf9, 0x52, 0x5b, 0x23, 0xbe, 0x92, 0x70,
-	0x5e, 0xd3, 0xb3, 0xcc, 0x5e, 0x3a, 0x45, 0x9e, 0x15, 0x29, 0x32, 0xc9, 0xe2, 0x30, 0x4d, 0x3e,
-	0x84, 0x74, 0x15, 0x7e, 0xb9, 0x43, 0x6f, 0x80, 0xb5, 0xfd, 0x07, 0xa7, 0x0b, 0xdc, 0x0a, 0x48,
-	0xa3, 0x1d, 0xc1, 0x2d, 0x0d, 0xaf, 0xc5, 0xf2, 0xd0, 0x6e, 0x4f, 0x4b, 0x21, 0x47, 0x78, 0xa6,
-	0x0a, 0xf3, 0x17, 0x06, 0xac, 0xf1, 0x7c, 0x60, 0xe8, 0x3d, 0xa7, 0xd8, 0xae, 0x52, 0x69, 0x4b,
-	0x66, 0xae, 0x61, 0x4c, 0x99, 0x6b, 0x7c, 0x3f, 0x4d, 0x59, 0xe4, 0xcb, 0x70, 0x63, 0xf2, 0x82,
-	0xb8, 0x7a, 0x69, 0xbe, 0x42, 0x5e, 0x87, 0x45, 0xe6, 0x44, 0x03, 0xaa, 0xdb, 0xdc, 0xc2, 0xdd,
-	0xc5, 0x4e, 0xdd, 0xca, 0x86, 0x61, 0x35, 0x24, 0x56, 0x56, 0xef, 0xe6, 0xff, 0x54, 0x60, 0xb9,
-	0x64, 0x39, 0xae, 0xcf, 0x30, 0x03, 0xb6, 0x07, 0xa1, 0xe3, 0xab, 0x52, 0x38, 0x8b, 0xf8, 0x34,
-	0x74, 0x7c, 0xb2, 0x05, 0xcb, 0x39, 0xe2, 0x21, 0x65, 0x47, 0xa1, 0x4a, 0x97, 0x49, 0x16, 0xf5,
-	0x58, 0x60, 0xc8, 0xbb, 0xd0, 0x74, 0x06, 0x03, 0x51, 0x53, 0x8c, 0x69, 0x40, 0x63, 0xf4, 0xa5,
-	0xc9, 0x2e, 0x6e, 0x81, 0x8c, 0x97, 0x4b, 0xba, 0x55, 0xfd, 0x84, 0x1e, 0x8b, 0xf0, 0x1b, 0x61,
-	0x66, 0x20, 0x3b, 0x6d, 0x6b, 0x0a, 0xff, 0x19, 0x3d, 0x7e, 0x94, 0x62, 0x45, 0x28, 0x54, 0x9c,
-	0x31, 0x8b, 0x12, 0x97, 0x25, 0x98, 0x5b, 0x57, 0xad, 0x25, 0x85, 0xd9, 0x53, 0x08, 0x72, 0x0b,
-	0xda, 0x9a, 0x7c, 0x74, 0x14, 0x39, 0xb1, 0x6e, 0x4e, 0xb6, 0x14, 0x7c, 0x57, 0x82, 0x65, 0xca,
-	0xce, 0x6b, 0x32, 0x7b, 0xe4, 0x30, 0x46, 0xa3, 0x20, 0xee, 0x2c, 0x08, 0xca, 0xa6, 0x04, 0xef,
-	0x22, 0xd4, 0xfc, 0x2f, 0x03, 0xce, 0x4f, 0x18, 0x46, 0xda, 0x7a, 0x40, 0x39, 0x29, 0x2f, 0x0e,
-	0x58, 0x41, 0xde, 0xe8, 0xb8, 0x01, 0x23, 0x5f, 0x16, 0xe4, 0x2d, 0xf3, 0x10, 0xb4, 0x97, 0xeb,
-	0x2f, 0xb7, 0x17, 0x4b, 0xd0, 0xe6, 0xb5, 0x22, 0x61, 0x64, 0x07, 0xea, 0x31, 0xa3, 0xa3, 0xd8,
-	0x66, 0xce, 0x13, 0x1a, 0x60, 0x0f, 0xc2, 0x7c, 0xf9, 0x72, 0x7b, 0x8c, 0x8e, 0xf8, 0x1b, 0x4f,
-	0x47, 0xf1, 0x3e, 0xe7, 0x32, 0x7f, 0x32, 0x0b, 0x64, 0x72, 0x3f, 0x2e, 0xa4, 0x30, 0xf2, 0x06,
-	0x5e, 0xe0, 0xf8, 0xb9, 0xf1, 0x8b, 0xd5, 0x54, 0x60, 0x6c, 0x27, 0xdd, 0x82, 0xf4, 0xbe, 0x8a,
-	0x52, 0x06, 0xbd, 0x96, 0x86, 0x23, 0xe9, 0x6b, 0xb0, 0x34, 0x59, 0xba, 0xca, 0x60, 0xd7, 0x76,
-	0x8b, 0x35, 0xeb, 0x2b, 0xb0, 0xa8, 0x4a, 0xe5, 0x6c, 0xff, 0xa1, 0x81, 0x40, 0x59, 0x54, 0x3d,
-	0x84, 0xf5, 0x8c, 0x45, 0xd9, 0x4a, 0xd3, 0x7d, 0x7b, 0x44, 0x23, 0x97, 0x06, 0xcc, 0x19, 0x48,
-	0x93, 0x99, 0xb7, 0xae, 0x64, 0xe8, 0x76, 0x15, 0xd9, 0xae, 0xa6, 0x22, 0x9f, 0xc1, 0xc2, 0x90,
-	0xb2, 0x48, 0xb6, 0x26, 0xb8, 0x1c, 0x6f, 0x9f, 0x46, 0x2d, 0x9b, 0x8f, 0x25, 0x8f, 0x8c, 0x4e,
-	0x6a, 0x85, 0xee, 0xfb, 0xd0, 0xc8, 0x22, 0xce, 0x14, 0x8d, 0xfe, 0xcd, 0x80, 0x76, 0x51, 0x61,
-	0xe4, 0x22, 0xd4, 0xb8, 0xca, 0xb2, 0xed, 0xc3, 0x2a, 0x07, 0x88, 0xa2, 0xe9, 0x2a, 0xd4, 0xa5,
-	0x03, 0xa7, 0x9d, 0x9f, 0x9a, 0x05, 0x12, 0x24, 0x62, 0xfd, 0x1d, 0x58, 0x55, 0x29, 0x95, 0x54,
-	0x90, 0x7d, 0x40, 0x0f, 0xb9, 0x48, 0x65, 0x62, 0xb1, 0x8c, 0x48, 0xa9, 0xa5, 0x9e, 0x40, 0xf1,
-	0x90, 0x5f, 0xe0, 0x71, 0x0e, 0x19, 0x8d, 0x30, 0xc3, 0x20, 0x39, 0x96, 0x6d, 0x8e, 0x21, 0xeb,
-	0x50, 0xef, 0xd3, 0xd8, 0x8d, 0x3c, 0x11, 0x91, 0xb0, 0x07, 0x94, 0x05, 0x99, 0x11, 0x2c, 0xef,
-	0x8d, 0x7c, 0x8f, 0x9d, 0x3d, 0xc8, 0xbe, 0x57, 0x0c, 0xb2, 0x57, 0x4a, 0xda, 0xed, 0x7c, 0xe9,
-	0x62, 0x74, 0x35, 0xff, 0xa2, 0x02, 0x8d, 0x2c, 0x86, 0xbc, 0x09, 0x4d, 0x3d, 0x72, 0xb1, 0x63,
-	0xef, 0x39, 0xc5, 0xb1, 0x22, 0xbc, 0xe8, 0x2d, 0x74, 0xe7, 0x3b, 0xdf, 0xdc, 0xdf, 0xe8, 0x5b,
-	0x0d, 0x35, 0x82, 0xd9, 0xf3, 0x9e, 0x53, 0xb2, 0x05, 0x8b, 0x92, 0x3a, 0x1c, 0xd3, 0xc8, 0x77,
-	0x46, 0xd2, 0xbc, 0x15, 0xc3, 0xb7, 0x0b, 0x1b, 0x33, 0x56, 0x43, 0x10, 0x7c, 0x21, 0xf1, 0x32,
-	0xd9, 0xf3, 0x3d, 0x96, 0x9f, 0x2b, 0xd6, 0x78, 0xb2, 0xe7, 0x7b, 0xe9, 0xfc, 0xe3, 0x94, 0x35,
-	0xf1, 0x16, 0x2c, 0xeb, 0xc8, 0x36, 0x31, 0xe4, 0xd3, 0x31, 0x32, 0x33, 0xdc, 0xbb, 0x05, 0x6d,
-	0xa4, 0x3b, 0xb6, 0x87, 0x4e, 0xf4, 0x84, 0x46, 0x3a, 0x14, 0x2a, 0xf8, 0x63, 0x09, 0x36, 0xff,
-	0xc4, 0x80, 0x95, 0xbc, 0x4a, 0x30, 0xbc, 0xbd, 0xa3, 0xbf, 0x6e, 0x90, 0xc5, 0xc9, 0x95, 0xa9,
-	0x53, 0x4b, 0xd9, 0xdc, 0x4c, 0x3f, 0x69, 0xa8, 0x16, 0x9a, 0xab, 0x57, 0xa7, 0x68, 0xaa, 0x64,
-	0xe8, 0xfe, 0x2f, 0x15, 0x68, 0x64, 0x57, 0xd5, 0x3d, 0x4d, 0xdb, 0x0b, 0xfa, 0xf4, 0x19, 0x06,
-	0x20, 0xd9, 0xd3, 0x7c, 0xc4, 0x21, 0xa4, 0x93, 0x9a, 0x4e, 0x05, 0xd3, 0x30, 0xb4, 0x18, 0xae,
-	0x03, 0xe6, 0x44, 0xcc, 0x56, 0x3d, 0x1d, 0x55, 0x46, 0x08, 0xe8, 0x2e, 0x02, 0x79, 0x41, 0x42,
-	0x83, 0x7e, 0x4a, 0x24, 0xcd, 0xbb, 0x4e, 0x83, 0xbe, 0x26, 0x29, 0x24, 0x74, 0xf3, 0x13, 0x09,
-	0xdd, 0x6f, 0x81, 0xac, 0x13, 0xd2, 0xb6, 0xf2, 0xd4, 0x08, 0x92, 0xbd, 0x5d, 0x7e, 0x1c, 0xaf,
-	0x46, 0x94, 0x59, 0x98, 0x18, 0x51, 0x4e, 0x10, 0x9d, 0x29, 0x9a, 0xfc, 0xab, 0x01, 0x8b, 0x39,
-	0x71, 0xa7, 0xe5, 0xae, 0xd6, 0x6f, 0x5a, 0xee, 0xa6, 0x63, 0xc7, 0x62, 0xec, 0xaf, 0x94, 0xc6,
-	0xfe, 0xeb, 0xd0, 0xe4, 0xe9, 0x75, 0xc6, 0x97, 0x64, 0x34, 0x6f, 0x38, 0xe3, 0x41, 0xea, 0x3f,
-	0x9b, 0xb0, 0x9c, 0x77, 0x07, 0x19, 0xa7, 0xa4, 0xb1, 0x2f, 0xe5, 0x7c, 0x42, 0x84, 0x2b, 0x13,
-	0xa4, 0xa3, 0xe8, 0x34, 0x73, 0x5e, 0xa4, 0x99, 0x75, 0x01, 0x94, 0xf9, 0xa5, 0xf9, 0xf3, 0x0a,
-	0xac, 0x5a, 0x34, 0xe8, 0xd3, 0x48, 0x7f, 0xdb, 0x82, 0xd1, 0xe4, 0xf5, 0xd2, 0x49, 0x56, 0x1a,
-	0x53, 0xf2, 0x23, 0xad, 0xfd, 0xec, 0xf0, 0xa8, 0x32, 0xad, 0x28, 0x2a, 0xdd, 0x69, 0xfa, 0x08,
-	0x89, 0xdc, 0x2d, 0x7e, 0xd8, 0x73, 0x75, 0xda, 0x9a, 0xc5, 0x78, 0xf5, 0x1d, 0xa7, 0x4f, 0x3f,
-	0xab, 0xc0, 0x62, 0x6e, 0x61, 0x6e, 0xbd, 0x31, 0x7f, 0x89, 0x98, 0x3d, 0x0c, 0xfb, 0x14, 0xbb,
-	0x5a, 0x20, 0x41, 0x8f, 0xc3, 0x3e, 0x25, 0x3f, 0x82, 0x66, 0x9f, 0x1e, 0x3a, 0x89, 0xcf, 0x6c,
-	0xb1, 0x8e, 0x12, 0xc3, 0x9d, 0x13, 0x8e, 0xbc, 0xf9, 0x40, 0x72, 0x7d, 0x25, 0x98, 0xd0, 0x7c,
-	0xfb, 0x59, 0x18, 0xdf, 0x9b, 0xc6, 0xae, 0x33, 0xa2, 0xf6, 0x11, 0x1b, 0xfa, 0x6a, 0x52, 0x25,
-	0x41, 0x0f, 0xd9, 0xd0, 0xe7, 0x6f, 0x7c, 0x98, 0xb0, 0x51, 0xc2, 0x6c, 0xf9, 0x36, 0xa3, 0x4d,
-	0x34, 0x24, 0x50, 0x0e, 0x8f, 0xb8, 0x13, 0x4c, 0x6e, 0x75, 0x26, 0xa9, 0xfc, 0xa7, 0x01, 0x6b,
-	0x45, 0x15, 0x62, 0x9c, 0xbb, 0x05, 0xed, 0x48, 0x60, 0x26, 0xb2, 0xb8, 0x96, 0x82, 0xab, 0x24,
-	0xee, 0xd3, 0x89, 0xd0, 0xf6, 0xda, 0xf4, 0x69, 0x99, 0xdc, 0xce, 0x0b, 0x06, 0x93, 0x61, 0x8e,
-	0x87, 0x26, 0x31, 0x3a, 0x4a, 0x0d, 0x4f, 0x76, 0xfe, 0x16, 0x39, 0x54, 0x2b, 0x9f, 0x67, 0x4b,
-	0x43, 0x2f, 0x8e, 0xbd, 0x60, 0x90, 0xa1, 0x94, 0xc3, 0xa2, 0x36, 0x22, 0x34, 0xb1, 0xf9, 0x0f,
-	0x06, 0x5c, 0x98, 0xba, 0x77, 0x6e, 0xba, 0x9b, 0x29, 0x09, 0xb5, 0x2b, 0x08, 0xb7, 0x7b, 0x0b,
-	0x56, 0xf5, 0x3e, 0x76, 0x9c, 0x1c, 0xc4, 0xcc, 0x63, 0x09, 0xd3, 0x63, 0x8d, 0x15, 0x8d, 0xdc,
-	0x4b, 0x71, 0x19, 0x0d, 0x66, 0x8b, 0x17, 0xa5, 0x41, 0x0c, 0x13, 0xaf, 0xc2, 0x52, 0xa4, 0xce,
-	0xa4, 0x9d, 0x7a, 0x4e, 0x38, 0x75, 0x4b, 0x23, 0xd0, 0xb1, 0xff, 0xc8, 0x80, 0x4b, 0xaa, 0xab,
-	0xdc, 0x93, 0xcd, 0x45, 0x51, 0xfc, 0xea, 0x46, 0xf7, 0x67, 0x50, 0xc5, 0xb6, 0xb4, 0x7a, 0x9b,
-	0x6e, 0x4c, 0x69, 0x55, 0xe6, 0x9b, 0xd2, 0x99, 0xef, 0xef, 0xf4, 0x02, 0xbc, 0x8e, 0x97, 0xbd,
-	0x4f, 0x3d, 0x2c, 0x58, 0x10, 0xbf, 0x1f, 0xf5, 0xcd, 0x1f, 0x1b, 0xb0, 0x5c, 0xb2, 0x0c, 0xb9,
-	0x0c, 0x80, 0xec, 0x69, 0xf1, 0x5f, 0x43, 0xc8, 0xa3, 0x3e, 0xf9, 0xe1, 0x64, 0xf7, 0xbc, 0x72,
-	0xb6, 0xee, 0x79, 0xb1, 0x77, 0x6e, 0xfe, 0xbd, 0x01, 0x97, 0xa7, 0x88, 0x04, 0xad, 0x38, 0x7b,
-	0x0d, 0x23, 0x77, 0x0d, 0xf2, 0x11, 0x2c, 0xc8, 0x6a, 0x43, 0xf9, 0xf5, 0xf5, 0x93, 0xa4, 0x25,
-	0xca, 0x0d, 0xc5, 0x44, 0x76, 0x32, 0x56, 0x2f, 0x63, 0xd9, 0xcd, 0x29, 0x0b, 0xa8, 0x81, 0x51,
-	0xc9, 0xc3, 0xfe, 0xdf, 0x06, 0x90, 0xc9, 0x4d, 0x4e, 0x12, 0xe5, 0x7e, 0x3a, 0xb0, 0x8d, 0xf0,
-	0xa6, 0x28, 0xcb, 0x5b, 0xa7, 0x90, 0xa5, 0x64, 0xd0, 0x83, 0x5b, 0x2d, 0xab, 0x77, 0xe1, 0x5c,
-	0xcc, 0x1c, 0x96, 0xc8, 0xd0, 0xdc, 0xc4, 0xd0, 0x8c, 0x5f, 0x92, 0x8e, 0x6f, 0x6f, 0xee, 0x46,
-	0xa1, 0x4b, 0x85, 0x77, 0xed, 0x09, 0x32, 0x0b, 0xc9, 0xb9, 0xa9, 0x8b, 0x6f, 0x4a, 0xed, 0x21,
-	0x8d, 0x63, 0x5e, 0x58, 0x60, 0xb0, 0x12, 0xc0, 0xc7, 0x12, 0x66, 0xfe, 0xaa, 0x02, 0xab, 0xa5,
-	0xd2, 0x90, 0xd9, 0x1e, 0x7f, 0x77, 0x33, 0xd6, 0x2b, 0x12, 0x12, 0x01, 0xb5, 0x94, 0x45, 0xbe,
-	0x05, 0xab, 0x71, 0xe2, 0xf2, 0x13, 0x1c, 0x26, 0xbe, 0x8d, 0x57, 0xf4, 0xa8, 0xea, 0x6d, 0xad,
-	0xa4, 0xc8, 0x6d, 0x8d, 0xe3, 0xa1, 0xe2, 0x50, 0x4e, 0x21, 0x32, 0x0c, 0xd2, 0x13, 0xdb, 0x12,
-	0x91, 0x21, 0x7e, 0x17, 0x3a, 0xf2, 0x20, 0x23, 0x7d, 0xd3, 0x82, 0x53, 0xae, 0x0a, 0x7c, 0x2a,
-	0x08, 0xec, 0xe9, 0xdc, 0x15, 0x0d, 0xe5, 0x08, 0x3f, 0x62, 0x9c, 0x3f, 0xf9, 0x23, 0x46, 0xa4,
-	0xde, 0x66, 0xe4, 0x1e, 0x34, 0x78, 0x81, 0xe7, 0x53, 0x64, 0x3e, 0x77, 0xf2, 0xd4, 0x56, 0xd3,
-	0x6f, 0x33, 0xf3, 0x8f, 0x0d, 0x58, 0xf9, 0xdc, 0x8b, 0xf5, 0xd7, 0x1b, 0x3a, 0x18, 0xdc, 0x07,
-	0x18, 0x39, 0x3c, 0x23, 0x11, 0xc9, 0x9b, 0x91, 0xe9, 0xcc, 0x67, 0x14, 0xaa, 0x09, 0x94, 0x83,
-	0x65, 0x78, 0xc8, 0x16, 0x2c, 0xc8, 0x11, 0xac, 0xf2, 0x8f, 0xd5, 0x02, 0xbb, 0x1c, 0xc0, 0x5a,
-	0x8a, 0xca, 0xfc, 0x33, 0x03, 0x56, 0x0b, 0x67, 0x41, 0xcb, 0xfa, 0x10, 0x6a, 0x2a, 0xa0, 0xbe,
-	0x24, 0x6d, 0x56, 0x7c, 0xa2, 0xc1, 0x9c, 0x32, 0x90, 0xed, 0xdc, 0x55, 0xb2, 0x1f, 0x26, 0x94,
-	0x5f, 0x05, 0xed, 0x3b, 0xc3, 0x64, 0xfe, 0x62, 0x16, 0x1a, 0xd9, 0xe5, 0x09, 0x81, 0xb9, 0x4c,
-	0xc5, 0x28, 0xfe, 0xe6, 0xf9, 0x5f, 0xdf, 0x8b, 0x47, 0xbe, 0x73, 0x9c, 0xfd, 0x3a, 0xb2, 0x8e,
-	0x30, 0x91, 0x14, 0x15, 0x2a, 0xb9, 0xd9, 0x89, 0x4a, 0x8e, 0x74, 0xa1, 0x8a, 0xcd, 0xe4, 0x63,
-	0x74, 0x03, 0xfd, 0x9b, 0xd7, 0x2b, 0x71, 0x32, 0x1a, 0x85, 0xc2, 0x50, 0xf2, 0x5f, 0xe6, 0xd4,
-	0x2c, 0xa2, 0x51, 0xe9, 0x43, 0xf7, 0x06, 0x10, 0xf5, 0x35, 0x4b, 0x86, 0x5e, 0x56, 0x2c, 0x4b,
-	0x0a, 0x93, 0x92, 0x7f, 0x01, 0x6d, 0x39, 0x48, 0xcd, 0x4c, 0x37, 0x16, 0xa6, 0x75, 0x52, 0xf4,
-	0xd7, 0x2b, 0xe9, 0x80, 0xa3, 0x25, 0xb8, 0x33, 0x2d, 0xe6, 0xfc, 0xc7, 0xb9, 0xd5, 0xb3, 0x7c,
-	0x9c, 0x7b, 0x17, 0x20, 0x19, 0xf5, 0x15, 0x6b, 0xed, 0x64, 0x56, 0xa4, 0xde, 0x66, 0xe6, 0xb7,
-	0x15, 0x20, 0x93, 0xa7, 0xe3, 0x39, 0x93, 0xbc, 0x5d, 0x3a, 0xcf, 0x9b, 0xb7, 0x40, 0x80, 0x64,
-	0xb5, 0x71, 0x1b, 0x56, 0xe5, 0xd7, 0x19, 0xc5, 0x07, 0xb5, 0x22, 0x52, 0x6f, 0x22, 0xbe, 0xcf,
-	0xc8, 0xbd, 0xa9, 0xe4, 0x5d, 0xa8, 0xf9, 0x4e, 0xcc, 0xe4, 0xd3, 0x7f, 0xf2, 0xc7, 0xc7, 0x55,
-	0x4e, 0x2c, 0x52, 0x82, 0xe7, 0xd0, 0x51, 0x0a, 0xb1, 0xe5, 0xa9, 0x0e, 0x45, 0xf4, 0x0a, 0xdc,
-	0x63, 0xec, 0xe6, 0xde, 0x3f, 0x8d, 0xc8, 0x75, 0xa6, 0xfc, 0x25, 0x5f, 0xe3, 0x13, 0xb5, 0x84,
-	0xcc, 0x19, 0xd7, 0xc6, 0xa5, 0xc8, 0xee, 0x23, 0xb8, 0xf8, 0x12, 0xb6, 0xb3, 0xcc, 0x15, 0xee,
-	0xfc, 0x79, 0x15, 0x6a, 0xea, 0x7d, 0x88, 0xc8, 0x8f, 0x0d, 0xfd, 0xbd, 0xb1, 0x7a, 0x2d, 0xc8,
-	0xa9, 0x1f, 0xe7, 0xee, 0xe9, 0x9f, 0x1e, 0xf3, 0xea, 0x1f, 0xfc, 0xea, 0xd7, 0x3f, 0xab, 0x5c,
-	0x30, 0x57, 0xb6, 0x32, 0xff, 0x77, 0x62, 0x0b, 0xdf, 0xa3, 0xf7, 0x8d, 0x57, 0xc9, 0x1f, 0x1a,
-	0xd0, 0xcc, 0xcf, 0x75, 0x49, 0xc9, 0xe3, 0x5a, 0x3a, 0xef, 0xef, 0x6e, 0x9c, 0x4c, 0x88, 0xc7,
-	0xb8, 0x22, 0x8e, 0xd1, 0x31, 0x97, 0xf9, 0x31, 0xe4, 0x98, 0x63, 0x4b, 0x8d, 0x16, 0xf8, 0x29,
-	0xb8, 0x3c, 0x0a, 0x5d, 0xce, 0x32, 0x79, 0x94, 0x77, 0xc8, 0xcb, 0xe4, 0x31, 0xa5, 0x65, 0x9a,
-	0x97, 0x87, 0xea, 0x0d, 0x6e, 0x61, 0xd6, 0xcd, 0x4f, 0xf2, 0xfb, 0xd8, 0xaa, 0x51, 0xa7, 0xb8,
-	0x31, 0xa5, 0x75, 0x50, 0x38, 0xc2, 0xf7, 0x4e, 0x22, 0xc3, 0xfd, 0x2f, 0x89, 0xfd, 0xd7, 0xcc,
-	0x25, 0xbe, 0xbf, 0x28, 0x26, 0xb3, 0x9b, 0x73, 0x65, 0xe4, 0x8b, 0x84, 0x32, 0x65, 0x94, 0x56,
-	0x82, 0x65, 0xca, 0x28, 0xaf, 0x37, 0xf2, 0xca, 0x90, 0x3e, 0xbc, 0xa5, 0xde, 0x00, 0x7e, 0x8a,
-	0x3f, 0x35, 0x60, 0xb5, 0x34, 0xd7, 0x23, 0x9b, 0xd3, 0x0d, 0xaf, 0x2c, 0x4f, 0xee, 0x6e, 0x9d,
-	0x9a, 0x1e, 0x8f, 0x76, 0x59, 0x1c, 0xed, 0xbc, 0x49, 0x72, 0xe6, 0x2a, 0xf2, 0x48, 0x7e, 0xb2,
-	0xa7, 0xb0, 0x98, 0x7b, 0xf6, 0x48, 0x89, 0xd8, 0xcb, 0xde, 0xe8, 0xee, 0xcd, 0x13, 0xe9, 0xf0,
-	0x00, 0xab, 0xe2, 0x00, 0x2d, 0xb2, 0x28, 0x0c, 0x55, 0xef, 0xf3, 0x7b, 0x50, 0x7f, 0x48, 0x1d,
-	0x9f, 0x1d, 0xed, 0x1c, 0x51, 0xf7, 0x09, 0x59, 0x9b, 0x88, 0x5c, 0x1f, 0x0f, 0x47, 0xec, 0xb8,
-	0x6b, 0x16, 0xde, 0xca, 0x0c, 0x8f, 0xde, 0x81, 0x88, 0x1d, 0x1a, 0x04, 0xf8, 0x0e, 0x47, 0x82,
-	0xa0, 0xf7, 0x06, 0x4c, 0xfc, 0x17, 0xa6, 0x5d, 0xe3, 0xb7, 0xcf, 0x47, 0xce, 0x40, 0xfe, 0x0f,
-	0x26, 0x05, 0xdf, 0x1a, 0xdf, 0xfe, 0x60, 0x7c, 0xfb, 0xe0, 0x9c, 0xd8, 0xf6, 0xad, 0xff, 0x0f,
-	0x00, 0x00, 0xff, 0xff, 0x29, 0x33, 0xd6, 0xa7, 0x10, 0x35, 0x00, 0x00,
+	// 4835 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x3b, 0x49, 0x6f, 0x1c, 0x57,
+	0x7a, 0xac, 0x22, 0x9b, 0xec, 0xfe, 0xba, 0xd9, 0xdd, 0x7c, 0x5c, 0xd4, 0x6a, 0xc9, 0x12, 0x55,
+	0x96, 0xc6, 0x94, 0x3c, 0x22, 0x2d, 0xda, 0xb1, 0x2d, 0x79, 0x19, 0xb1, 0x49, 0xdb, 0x52, 0x6c,
+	0x8d, 0x39, 0x45, 0xda, 0x59, 0x26, 0x4e, 0xa1, 0x58, 0xf5, 0xd8, 0x2c, 0xa8, 0xba, 0xaa, 0x5d,
+	0xf5, 0xaa, 0x25, 0x6a, 0x30, 0x80, 0xb3, 0x0e, 0x82, 0x60, 0x2e, 0x33, 0x03, 0x64, 0x80, 0x20,
+	0xc9, 0x29, 0x97, 0x5c, 0x07, 0xb9, 0x04, 0x08, 0x92, 0x53, 0x90, 0x4b, 0x16, 0x60, 0x12, 0x20,
+	0x08, 0x72, 0x08, 0x82, 0x01, 0x32, 0xf9, 0x03, 0x39, 0xe8, 0x14, 0xbc, 0xb5, 0x96, 0xae, 0x76,
+	0x93, 0x70, 0x92, 0x13, 0x59, 0xdf, 0xf2, 0x96, 0x6f, 0x7b, 0xdf, 0xf7, 0xbd, 0xd7, 0xb0, 0x36,
+	0xba, 0xb3, 0x65, 0xc7, 0x31, 0x1e, 0x1c, 0xf9, 0x38, 0xb2, 0xe2, 0x91, 0xb3, 0x39, 0x8c, 0x42,
+	0x12, 0xa2, 0xb6, 0x3d, 0xf4, 0x36, 0x15, 0x62, 0x73, 0x74, 0xa7, 0x7b, 0xb9, 0x1f, 0x86, 0x7d,
+	0x1f, 0x6f, 0xd9, 0x43, 0x6f, 0xcb, 0x0e, 0x82, 0x90, 0xd8, 0xc4, 0x0b, 0x83, 0x98, 0xd3, 0x77,
+	0x2f, 0x09, 0x2c, 0xfb, 0x3a, 0x4a, 0x8e, 0xb7, 0xf0, 0x60, 0x48, 0x4e, 0x05, 0xf2, 0x6a, 0x11,
+	0x49, 0xbc, 0x01, 0x8e, 0x89, 0x3d, 0x18, 0x0a, 0x82, 0x2e, 0x1d, 0xd4, 0x09, 0x07, 0x83, 0x30,
+	0xd8, 0x1a, 0xdd, 0x11, 0xff, 0x95, 0xe3, 0x70, 0x14, 0x85, 0x91, 0x9c, 0xf5, 0xc2, 0xc8, 0xf6,
+	0x3d, 0xd7, 0x26, 0x78, 0x4b, 0xfe, 0xc3, 0x11, 0xc6, 0x7f, 0x6b, 0xb0, 0xb6, 0x23, 0x56, 0xbf,
+	0x1b, 0x06, 0x04, 0x3f, 0x25, 0x26, 0xfe, 0x3c, 0xc1, 0x31, 0x41, 0x2f, 0x40, 0xe5, 0xf3, 0x04,
+	0x47, 0xa7, 0x1d, 0x6d, 0x5d, 0xdb, 0xa8, 0xf5, 0x16, 0x9e, 0xf7, 0xe6, 0x22, 0xbd, 0xad, 0x99,
+	0x1c, 0x8a, 0x76, 0x60, 0xde, 0x39, 0x49, 0x82, 0xc7, 0x71, 0x47, 0x5f, 0x9f, 0xdd, 0xa8, 0x6f,
+	0x5f, 0xdd, 0x2c, 0x4a, 0x62, 0x73, 0x2f, 0x74, 0x92, 0x01, 0x0e, 0xc8, 0x2e, 0xa5, 0xeb, 0x55,
+	0x9f, 0xf7, 0x2a, 0x3f, 0xd0, 0xf4, 0xaa, 0x66, 0x0a, 0x46, 0xf4, 0x16, 0x2c, 0x84, 0x43, 0x26,
+	0x9c, 0xce, 0xec, 0xba, 0xb6, 0x51, 0xdf, 0xbe, 0x36, 0x3e, 0x86, 0x58, 0xdc, 0xe9, 0xc7, 0x9c,
+	0xd0, 0x94, 0x1c, 0xe8, 0x1d, 0xa8, 0x12, 0x3c, 0x18, 0xfa, 0x36, 0xc1, 0x9d, 0xb9, 0x49, 0xdc,
+	0x62, 0x4b, 0x87, 0x82, 0xd0, 0x54, 0x2c, 0xc6, 0x5f, 0xe8, 0xb0, 0x98, 0x5b, 0x1f, 0xba, 0x08,
+	0x55, 0xb6, 0x2e, 0xcb, 0x73, 0xf9, 0x96, 0xcd, 0x05, 0xf6, 0xfd, 0xd0, 0x45, 0x57, 0xa1, 0xee,
+	0x0a, 0x5a, 0x8a, 0xd5, 0x19, 0x16, 0x24, 0xe8, 0xa1, 0x8b, 0xae, 0xc1, 0x82, 0x43, 0xa7, 0x0a,
+	0x48, 0x67, 0x36, 0x2f, 0x2d, 0x09, 0x47, 0x2b, 0x50, 0x21, 0x1e, 0xf1, 0xf9, 0x62, 0x6b, 0x26,
+	0xff, 0x40, 0x2f, 0x41, 0x2b, 0xc2, 0x3e, 0x1e, 0xd9, 0x81, 0x83, 0xad, 0xd8, 0x09, 0x23, 0xdc,
+	0xa9, 0xac, 0x6b, 0x1b, 0xba, 0xd9, 0x54, 0xe0, 0x03, 0x0a, 0x45, 0xaf, 0xc0, 0xca, 0x30, 0x8c,
+	0x3d, 0xba, 0x77, 0xcb, 0x0b, 0x2c, 0x39, 0x77, 0x67, 0x7e, 0x5d, 0xdb, 0xa8, 0x98, 0x48, 0xe2,
+	0x1e, 0x06, 0x72, 0x53, 0xe8, 0x2d, 0xa8, 0x0e, 0x30, 0xb1, 0x5d, 0x9b, 0xd8, 0x9d, 0x85, 0x75,
+	0xad, 0x5c, 0x45, 0x6c, 0xeb, 0x8f, 0x04, 0x99, 0xa9, 0x18, 0xd0, 0x65, 0xa8, 0xe1, 0xc1, 0x11,
+	0x76, 0x5d, 0x2f, 0xe8, 0x77, 0xaa, 0xeb, 0xb3, 0x1b, 0xba, 0x99, 0x02, 0x8c, 0x9f, 0xeb, 0xb0,
+	0x98, 0xe3, 0x44, 0x2f, 0xc2, 0xa2, 0x92, 0x10, 0x39, 0x1d, 0x62, 0x21, 0xc1, 0x86, 0x04, 0x1e,
+	0x9e, 0x0e, 0x31, 0x5a, 0x83, 0x79, 0x3b, 0x21, 0x27, 0x61, 0x24, 0x24, 0x28, 0xbe, 0xd0, 0x5d,
+	0x00, 0x27, 0xc2, 0x36, 0xc1, 0xae, 0x65, 0x13, 0x61, 0x0a, 0xdd, 0x4d, 0xee, 0x0b, 0x9b, 0xd2,
+	0x17, 0x36, 0x0f, 0xa5, 0x2f, 0x98, 0x35, 0x41, 0xbd, 0x43, 0x8d, 0x14, 0xe2, 0x30, 0x89, 0x1c,
+	0x6c, 0x25, 0x91, 0x2f, 0x44, 0x5b, 0xe3, 0x90, 0x4f, 0x22, 0x1f, 0xfd, 0x1a, 0xb4, 0x9c, 0x24,
+	0x26, 0xe1, 0xc0, 0x52, 0xa2, 0xa8, 0x30, 0x6b, 0x7d, 0x75, 0x8a, 0x28, 0x36, 0x77, 0x19, 0x9b,
+	0xfc, 0x7c, 0x2f, 0x20, 0xd1, 0xa9, 0xd9, 0x74, 0x72, 0x40, 0x6a, 0x16, 0x24, 0x7c, 0x8c, 0x03,
+	0xcb, 0x09, 0x13, 0xa5, 0x0a, 0x60, 0xa0, 0x5d, 0x0a, 0xe9, 0xee, 0xc0, 0x72, 0xc9, 0x38, 0xa8,
+	0x0d, 0xb3, 0x8f, 0xb1, 0xf0, 0x2b, 0x93, 0xfe, 0x4b, 0x8d, 0x63, 0x64, 0xfb, 0x09, 0x16, 0x82,
+	0xe1, 0x1f, 0xf7, 0xf4, 0x37, 0x35, 0xe3, 0x77, 0xe6, 0xa1, 0x55, 0xf0, 0x01, 0x74, 0x17, 0xd0,
+	0xc0, 0x7e, 0x6a, 0x39, 0xdc, 0xb8, 0x2d, 0x1f, 0x07, 0x7d, 0x72, 0xc2, 0x86, 0xab, 0xf4, 0xea,
+	0xcf, 0x7b, 0xd5, 0xee, 0x7c, 0xe7, 0x8b, 0xe7, 0xda, 0x86, 0x6b, 0xb6, 0x07, 0xf6, 0x53, 0xe1,
+	0x02, 0x1f, 0x31, 0x22, 0x74, 0x03, 0x9a, 0x6c, 0x7d, 0xde, 0x33, 0x1c, 0x59, 0x81, 0x3d, 0x90,
+	0x33, 0x2e, 0x2a, 0xe8, 0x37, 0xed, 0x01, 0x46, 0xbf, 0x02, 0x28, 0xc6, 0x3e, 0x76, 0x98, 0xb9,
+	0xc5, 0x24, 0xb2, 0x09, 0xee, 0x9f, 0x0a, 0xcd, 0xdc, 0x9a, 0xe0, 0x66, 0x01, 0x39, 0x90, 0x2c,
+	0x07, 0x82, 0xc3, 0x5c, 0x8a, 0x8b, 0x20, 0x64, 0xc1, 0x8a, 0x1d, 0x45, 0x76, 0xd0, 0xc7, 0xcc,
+	0x58, 0xd4, 0xe0, 0xdc, 0x87, 0xbf, 0x3e, 0x71, 0xf0, 0x9d, 0x94, 0x49, 0x0d, 0xbf, 0x6c, 0x8f,
+	0x03, 0xd1, 0x7d, 0xb8, 0x3c, 0x8c, 0x70, 0x8c, 0xa3, 0x11, 0x56, 0x6e, 0x62, 0x1d, 0x85, 0x49,
+	0xe0, 0xda, 0x91, 0x87, 0x63, 0xe6, 0x5f, 0x55, 0xb3, 0x2b, 0x69, 0xa4, 0xbf, 0xf4, 0x14, 0x05,
+	0x7a, 0x13, 0x3a, 0x5e, 0xe0, 0xf8, 0x89, 0x8b, 0x2d, 0x61, 0x5c, 0x8e, 0x27, 0xa2, 0x38, 0x53,
+	0x72, 0xd5, 0x5c, 0x13, 0xf8, 0x03, 0x86, 0xde, 0x95, 0x58, 0x74, 0x13, 0xda, 0x92, 0x33, 0xe7,
+	0x7b, 0x55, 0xb3, 0x25, 0xe0, 0xca, 0x78, 0xee, 0xc3, 0xb2, 0xeb, 0x8d, 0x70, 0x14, 0x7b, 0xe4,
+	0xd4, 0x22, 0x27, 0x11, 0x8e, 0x4f, 0x42, 0xdf, 0xed, 0x54, 0xa9, 0xf7, 0xf7, 0x5a, 0xcf, 0x7b,
+	0x0d, 0x80, 0xdb, 0x33, 0x33, 0x33, 0x33, 0x2f, 0xcc, 0xcc, 0x7c, 0xf1, 0x0d, 0x13, 0x29, 0xda,
+	0x43, 0x49, 0x8a, 0xbe, 0x0d, 0xc2, 0x20, 0x2d, 0x19, 0x45, 0x6b, 0xcc, 0xb6, 0x5f, 0x9b, 0x1a,
+	0x45, 0x85, 0x75, 0x8b, 0x2f, 0x6e, 0xdc, 0x8b, 0x4e, 0x16, 0x86, 0x3e, 0x82, 0x45, 0x17, 0xbb,
+	0xc9, 0xd0, 0xf7, 0x1c, 0xb6, 0xb7, 0x0e, 0x30, 0xfd, 0x7c, 0xad, 0x24, 0xca, 0x67, 0xc9, 0x64,
+	0x98, 0xce, 0x33, 0x77, 0xef, 0x03, 0x1a, 0x9f, 0xf2, 0x5c, 0x7e, 0xf0, 0x63, 0x1d, 0x56, 0xca,
+	0x66, 0xa2, 0x41, 0x65, 0x80, 0xc9, 0x49, 0x28, 0x83, 0xb6, 0xf8, 0x42, 0x3d, 0x58, 0x89, 0xbd,
+	0x81, 0xe7, 0xdb, 0x51, 0x5e, 0xc0, 0x7a, 0xb9, 0x80, 0x97, 0x53, 0xe2, 0x54, 0xc2, 0x6f, 0xb1,
+	0x31, 0x4e, 0xec, 0xf8, 0xc4, 0xa2, 0x0e, 0xe7, 0x7a, 0x31, 0xa1, 0x11, 0x99, 0x39, 0x42, 0xa5,
+	0x57, 0x7b, 0xde, 0x9b, 0xef, 0xce, 0x6d, 0xcc, 0x74, 0xee, 0x9b, 0x48, 0x90, 0x3d, 0xb2, 0x9f,
+	0xee, 0x09, 0x22, 0xea, 0x6a, 0x49, 0x8c, 0x2d, 0x15, 0x35, 0x63, 0x66, 0xe2, 0x55, 0x73, 0x31,
+	0x89, 0xf1, 0x7b, 0x0a, 0x48, 0xed, 0x40, 0x91, 0x64, 0x96, 0x59, 0x99, 0x60, 0x07, 0x8a, 0x56,
+	0xad, 0xd2, 0xf8, 0x23, 0x1d, 0x3a, 0x93, 0x3c, 0x90, 0x06, 0x66, 0xe9, 0x62, 0xb9, 0xc0, 0x2c,
+	0x81, 0x2c, 0x30, 0xbf, 0x00, 0xc0, 0x02, 0x8a, 0x3c, 0xcf, 0x69, 0x1c, 0xab, 0xd1, 0xd8, 0xc1,
+	0x00, 0xf4, 0x90, 0x62, 0x47, 0x53, 0x66, 0x79, 0xb3, 0xfc, 0x90, 0x62, 0xe0, 0x54, 0x5e, 0x37,
+	0xa1, 0x9d, 0xda, 0xf4, 0x13, 0xec, 0xf5, 0x4f, 0x08, 0xdb, 0xb4, 0x6e, 0xb6, 0x14, 0xfc, 0x97,
+	0x18, 0x18, 0xdd, 0x81, 0x95, 0x08, 0xbb, 0xd4, 0xe5, 0x02, 0xe7, 0xb4, 0xb8, 0x6f, 0x73, 0x39,
+	0xc5, 0xa5, 0xa3, 0xbf, 0x0e, 0x17, 0x86, 0x91, 0x17, 0x32, 0x7d, 0xe6, 0x0e, 0x1b, 0xea, 0x95,
+	0xb3, 0x1b, 0x35, 0x73, 0x55, 0xa2, 0xf7, 0x32, 0xa7, 0x4e, 0x6c, 0xfc, 0x89, 0x0e, 0xdd, 0xc9,
+	0x41, 0x84, 0x2e, 0x3a, 0x1b, 0x90, 0x32, 0x42, 0x6a, 0x65, 0xe0, 0x4c, 0x4e, 0xb7, 0x60, 0xa9,
+	0x1f, 0x85, 0xc9, 0xd0, 0x3a, 0x4a, 0x57, 0xc0, 0xc4, 0x55, 0x35, 0x5b, 0x0c, 0xd1, 0x53, 0x53,
+	0xa3, 0xeb, 0xd0, 0x54, 0xb4, 0x24, 0x1c, 0x7a, 0x0e, 0x93, 0x59, 0xd5, 0x6c, 0x08, 0xc2, 0x43,
+	0x0a, 0x43, 0xdb, 0xb0, 0x3a, 0xb0, 0xbd, 0x80, 0xd8, 0x5e, 0xa0, 0xe2, 0xf9, 0xb1, 0x1f, 0x3e,
+	0x11, 0xb6, 0xb2, 0x2c, 0x91, 0x22, 0x8a, 0xbf, 0xef, 0x87, 0x4f, 0xd0, 0x3e, 0x0d, 0xce, 0x3c,
+	0x34, 0x8b, 0x0d, 0xf3, 0xb0, 0x36, 0x5b, 0x9e, 0x03, 0x1d, 0x70, 0xda, 0x7d, 0x21, 0x1b, 0x1a,
+	0x93, 0xb3, 0x00, 0x0f, 0xc7, 0x46, 0x0c, 0xad, 0x02, 0x15, 0xba, 0x06, 0x0d, 0x39, 0x49, 0x46,
+	0x22, 0x75, 0x01, 0x63, 0xd2, 0xe8, 0x42, 0x55, 0x0a, 0x5c, 0xd8, 0x4c, 0x75, 0x98, 0x61, 0xe7,
+	0xfa, 0xb7, 0x8e, 0xc2, 0x30, 0x26, 0xc2, 0x5e, 0xea, 0x1c, 0xd6, 0xa3, 0x20, 0xe3, 0x5f, 0x75,
+	0x68, 0x15, 0xf2, 0x33, 0x6a, 0xad, 0x32, 0x43, 0xe3, 0xa7, 0x93, 0xb0, 0x56, 0x09, 0x64, 0x87,
+	0x13, 0x35, 0xe9, 0xd3, 0x98, 0xe0, 0x81, 0x35, 0x8c, 0xc2, 0xc1, 0x90, 0x88, 0x60, 0xd1, 0xe0,
+	0xc0, 0x7d, 0x06, 0xa3, 0xf9, 0x52, 0x12, 0xe3, 0x48, 0x90, 0x58, 0x2a, 0x55, 0x64, 0xe9, 0x99,
+	0x89, 0x28, 0x8e, 0x53, 0xaa, 0xb9, 0xdf, 0x81, 0xaa, 0xd8, 0x1d, 0xf5, 0xd4, 0x09, 0xc2, 0x94,
+	0xd4, 0x42, 0x5c, 0xa6, 0x62, 0x41, 0xdf, 0x84, 0xda, 0xc8, 0x8e, 0x3c, 0xfb, 0xc8, 0x57, 0xca,
+	0x78, 0x65, 0x6a, 0x42, 0xba, 0xf9, 0xa9, 0x64, 0xe1, 0x41, 0x38, 0x1d, 0xa2, 0xfb, 0x36, 0x34,
+	0xf3, 0xc8, 0x73, 0x85, 0xcb, 0xbf, 0xd7, 0xa1, 0x55, 0x58, 0x6b, 0x56, 0xa5, 0x19, 0xd9, 0x4a,
+	0x95, 0x32, 0xd1, 0xde, 0x84, 0xb6, 0xd2, 0xba, 0x94, 0x18, 0x1f, 0xbb, 0x25, 0x35, 0x2f, 0xc5,
+	0x75, 0x15, 0xea, 0x5e, 0x6c, 0x45, 0xf8, 0xf3, 0xc4, 0x8b, 0xb0, 0x2b, 0x8c, 0x1b, 0xbc, 0xd8,
+	0x14, 0x10, 0x19, 0x54, 0x44, 0x76, 0x32, 0xa7, 0x82, 0x8a, 0xc8, 0x44, 0xfa, 0x80, 0x8e, 0xc3,
+	0x68, 0x60, 0x13, 0x42, 0x03, 0x9f, 0x3c, 0xc1, 0xb8, 0xe0, 0xde, 0x9c, 0x2a, 0xf8, 0xcd, 0xf7,
+	0x15, 0x6f, 0xee, 0x14, 0x5b, 0x3a, 0x2e, 0xc2, 0xbb, 0x7b, 0xb0, 0x56, 0x4e, 0x7c, 0x2e, 0x81,
+	0xfe, 0xa9, 0x0e, 0x17, 0xc6, 0x0a, 0xa5, 0x78, 0x18, 0x06, 0x31, 0x46, 0x2f, 0xc3, 0x92, 0x5c,
+	0xab, 0x2b, 0xbd, 0x58, 0x8c, 0xda, 0x56, 0x08, 0xc1, 0x84, 0x3e, 0x82, 0xb6, 0x74, 0x74, 0x95,
+	0x22, 0xe8, 0x53, 0xea, 0x17, 0x95, 0xa0, 0xb7, 0x9c, 0x3c, 0x00, 0xbd, 0x0d, 0xf5, 0x24, 0xa6,
+	0xb3, 0xf2, 0xd0, 0x3d, 0xcb, 0xc4, 0x77, 0x69, 0x7c, 0xa0, 0x4f, 0x62, 0xec, 0xb2, 0x68, 0x6e,
+	0x42, 0x22, 0xff, 0x8d, 0xa9, 0x07, 0x3f, 0xb1, 0xa3, 0x40, 0x1c, 0x4e, 0x34, 0x84, 0xaa, 0x6f,
+	0xf4, 0x0b, 0x50, 0x4b, 0xb3, 0x1e, 0xae, 0x96, 0x0b, 0x6c, 0x5c, 0x51, 0x74, 0xd2, 0xd5, 0x09,
+	0xbc, 0x99, 0x52, 0x66, 0xbd, 0x5a, 0x2d, 0xf2, 0x1a, 0x34, 0x48, 0x48, 0x6c, 0xdf, 0x62, 0x49,
+	0x66, 0xcc, 0x33, 0x55, 0xb3, 0xce, 0x60, 0x87, 0x0c, 0x44, 0xad, 0x89, 0x6f, 0xc1, 0xa2, 0xcb,
+	0x13, 0xe1, 0x04, 0x38, 0x88, 0xae, 0x9d, 0x9e, 0x41, 0x82, 0xe0, 0xd8, 0xf3, 0x09, 0x96, 0x26,
+	0x57, 0x31, 0x9b, 0x1c, 0xfc, 0xbe, 0x80, 0xa2, 0x4d, 0x58, 0xb6, 0x47, 0x7d, 0xab, 0x58, 0x55,
+	0xf1, 0x63, 0x68, 0xc9, 0x1e, 0xf5, 0xcd, 0x7c, 0x61, 0x95, 0xcb, 0x88, 0xd9, 0xe4, 0x95, 0x42,
+	0x46, 0xcc, 0xe6, 0xdf, 0x03, 0x88, 0xe9, 0x1e, 0x63, 0xe2, 0x39, 0x3c, 0x0b, 0xac, 0x6f, 0x5f,
+	0x9f, 0x9c, 0x68, 0x1d, 0x28, 0x5a, 0x33, 0xc3, 0x87, 0xde, 0x81, 0x46, 0x6a, 0x29, 0x36, 0xe9,
+	0x2c, 0x4c, 0xad, 0x75, 0xea, 0x8a, 0x7e, 0x87, 0x18, 0x3f, 0x99, 0x05, 0x34, 0x3e, 0x03, 0xda,
+	0x00, 0x69, 0x66, 0xa7, 0x16, 0xf1, 0x06, 0xd8, 0x1a, 0x70, 0x19, 0xcf, 0x9a, 0x4d, 0x09, 0xa7,
+	0x03, 0x3e, 0x62, 0x99, 0xad, 0x4c, 0xa1, 0xb0, 0xb0, 0x19, 0x2b, 0xc2, 0x83, 0x70, 0xa4, 0x64,
+	0xbe, 0xa6, 0xf0, 0xdc, 0x46, 0x4c, 0x8e, 0x45, 0xef, 0xc0, 0x25, 0x3f, 0x7c, 0x62, 0x7d, 0x9e,
+	0xd8, 0x3e, 0x3d, 0x7f, 0xcb, 0x75, 0xd1, 0xf1, 0xc3, 0x27, 0xdf, 0xe2, 0x14, 0xbb, 0x79, 0xad,
+	0xdc, 0x83, 0x8b, 0xa2, 0x10, 0xb6, 0x9c, 0x70, 0x30, 0x8c, 0x70, 0x1c, 0xd3, 0x20, 0x13, 0x51,
+	0xab, 0x11, 0xba, 0xb9, 0x20, 0x08, 0x76, 0x53, 0xbc, 0x49, 0xd1, 0x28, 0x81, 0x0b, 0x62, 0xba,
+	0xa3, 0xc2, 0xc1, 0x2f, 0xec, 0xf2, 0xdd, 0xb3, 0xe8, 0x81, 0xd7, 0x77, 0x71, 0x2f, 0x97, 0x1c,
+	0xf0, 0xa0, 0xb1, 0xe2, 0x94, 0xa0, 0xba, 0x1f, 0xc0, 0xc5, 0x89, 0x2c, 0xd3, 0x42, 0x47, 0x25,
+	0x1b, 0x3a, 0x7e, 0xa6, 0x41, 0x4d, 0xf9, 0xdf, 0x57, 0x6a, 0x33, 0x6c, 0xc2, 0x72, 0xb6, 0x09,
+	0x20, 0x43, 0x0d, 0x17, 0xfe, 0x52, 0xda, 0x03, 0x90, 0xb1, 0xa6, 0x50, 0xa0, 0xce, 0x15, 0x0b,
+	0xd4, 0xb3, 0xb7, 0x1f, 0xae, 0x41, 0x23, 0x89, 0xed, 0x3e, 0xb6, 0x22, 0x6c, 0xc7, 0x61, 0xc0,
+	0x1c, 0xa0, 0x66, 0xd6, 0x19, 0xcc, 0x64, 0x20, 0x63, 0x1d, 0xaa, 0x87, 0xb4, 0xd0, 0xf4, 0x62,
+	0xde, 0xec, 0xc0, 0x4f, 0x09, 0x35, 0xc3, 0x59, 0xd6, 0xec, 0xa0, 0x1f, 0xc6, 0x4f, 0x74, 0x58,
+	0x7d, 0x2f, 0x26, 0xde, 0xc0, 0x26, 0x98, 0xfb, 0xbd, 0xec, 0x35, 0xad, 0xc0, 0x5c, 0x1a, 0x34,
+	0x1f, 0xcc, 0x98, 0xec, 0x0b, 0x6d, 0xcb, 0x51, 0x74, 0xe1, 0x26, 0x25, 0xa7, 0x02, 0x9f, 0xf0,
+	0xc1, 0x8c, 0x98, 0x03, 0x1d, 0x80, 0x8c, 0x91, 0xec, 0x6c, 0xc2, 0xb1, 0x6c, 0x28, 0x6c, 0x4c,
+	0x34, 0x92, 0x42, 0xe3, 0xeb, 0xc1, 0x8c, 0xd9, 0x74, 0x72, 0x90, 0x92, 0xaa, 0x79, 0xae, 0xac,
+	0x6a, 0xee, 0xa5, 0xfd, 0xac, 0xca, 0xa4, 0x39, 0xd9, 0xbe, 0x85, 0x10, 0x32, 0xf5, 0x92, 0x64,
+	0xec, 0xb5, 0xa1, 0x29, 0x1d, 0x85, 0xd7, 0x9e, 0xc6, 0x5f, 0x6a, 0xb0, 0x56, 0xce, 0x85, 0x5e,
+	0x83, 0x35, 0x55, 0xa8, 0x0e, 0xb1, 0xe3, 0xe5, 0x43, 0x6c, 0xd5, 0x5c, 0x91, 0x65, 0x2a, 0x47,
+	0x8a, 0x58, 0xfb, 0x36, 0x74, 0x25, 0x97, 0x8b, 0x89, 0xed, 0xd1, 0x58, 0x74, 0x14, 0x61, 0xfb,
+	0xb1, 0x1b, 0x3e, 0x09, 0x44, 0x3a, 0x2b, 0x0b, 0xe0, 0x3d, 0x41, 0xd0, 0x93, 0x78, 0xb4, 0x05,
+	0x2b, 0xdc, 0xa6, 0x58, 0xe6, 0x6d, 0x91, 0x50, 0x18, 0xd7, 0x2c, 0xd3, 0xf4, 0x12, 0xc3, 0xb1,
+	0xbc, 0xfb, 0x30, 0x64, 0x36, 0x66, 0xfc, 0x9d, 0x0e, 0x6b, 0x45, 0xad, 0x8b, 0x83, 0x73, 0x07,
+	0x1a, 0xb1, 0x17, 0xf4, 0x7d, 0x2c, 0xc6, 0xd0, 0x98, 0xd4, 0x2e, 0x4f, 0x90, 0x1a, 0x1b, 0xee,
+	0xc1, 0x8c, 0x59, 0xe7, 0x3c, 0xec, 0x13, 0x7d, 0x00, 0x8d, 0x23, 0x9b, 0x38, 0x27, 0x7c, 0x04,
+	0x69, 0x2a, 0xc6, 0xf8, 0x10, 0x3d, 0x4a, 0x95, 0x8e, 0x13, 0xd3, 0x81, 0x18, 0x27, 0xff, 0xa4,
+	0x2d, 0x0f, 0x69, 0x38, 0x58, 0x09, 0x7a, 0xb2, 0xed, 0xc8, 0x44, 0x2e, 0xaf, 0x98, 0x07, 0x33,
+	0xe6, 0x92, 0x18, 0x25, 0x05, 0xa2, 0x5e, 0xa6, 0x13, 0x37, 0x37, 0xa9, 0x8c, 0x3e, 0xe4, 0xa6,
+	0xc4, 0x38, 0xc6, 0x1b, 0x72, 0xbd, 0x2a, 0xcc, 0x47, 0x38, 0x4e, 0x7c, 0x62, 0x84, 0x00, 0xe9,
+	0x36, 0xce, 0x72, 0xb6, 0xbe, 0x0b, 0xb5, 0xbc, 0x7a, 0xeb, 0xdb, 0xeb, 0x13, 0xe6, 0x57, 0x6a,
+	0x36, 0x53, 0x16, 0xe3, 0xcf, 0x35, 0x68, 0x17, 0xa5, 0x87, 0x5e, 0x83, 0x79, 0x21, 0x71, 0x6d,
+	0x7d, 0x76, 0x9a, 0xd2, 0x4c, 0x41, 0x4b, 0x4f, 0xaa, 0xec, 0x6a, 0x2d, 0xdb, 0xf7, 0x45, 0xbc,
+	0x6c, 0x66, 0x56, 0xbc, 0xe3, 0xfb, 0x68, 0x27, 0x77, 0xde, 0x4e, 0x6c, 0x0f, 0x33, 0x86, 0xf2,
+	0xc3, 0xd6, 0xf8, 0xbe, 0x0e, 0x6b, 0xe5, 0x6a, 0xa2, 0x8e, 0x23, 0x94, 0x8c, 0x5d, 0xab, 0x44,
+	0x7e, 0x2b, 0x0a, 0x7b, 0x98, 0x11, 0xe4, 0x35, 0x68, 0xb0, 0xde, 0xb7, 0xa4, 0xe5, 0x2b, 0xaf,
+	0x33, 0x98, 0x20, 0xb9, 0x91, 0xba, 0xaf, 0x20, 0xe2, 0xc1, 0x79, 0x51, 0x40, 0x05, 0xd9, 0x4b,
+	0xd0, 0x52, 0x75, 0x8e, 0xa0, 0x9b, 0x13, 0x62, 0x10, 0x60, 0x41, 0xf8, 0x8b, 0xd0, 0xe6, 0xa7,
+	0x05, 0xf7, 0x39, 0x2f, 0x38, 0x0e, 0xc5, 0xa1, 0xb7, 0x3e, 0xa1, 0x83, 0xc9, 0x18, 0x1f, 0x06,
+	0xc7, 0xa1, 0xc8, 0x8c, 0xd4, 0xb7, 0xf1, 0x6f, 0x3a, 0x34, 0xf3, 0x5a, 0xa6, 0xcb, 0x8d, 0x70,
+	0x3f, 0xf1, 0xed, 0x28, 0xbf, 0xff, 0x45, 0x01, 0x4d, 0x77, 0x55, 0x88, 0x2f, 0x7c, 0xeb, 0x8b,
+	0x71, 0x2e, 0xb0, 0xdc, 0x06, 0x34, 0x4c, 0x02, 0x87, 0x24, 0x36, 0xaf, 0x20, 0xb2, 0x02, 0x58,
+	0xca, 0x60, 0x04, 0xf9, 0x8b, 0xb0, 0x18, 0x24, 0x83, 0x23, 0x1c, 0xe5, 0x45, 0xd0, 0xe0, 0x40,
+	0x41, 0xe4, 0xc0, 0x92, 0x68, 0x72, 0x39, 0xb4, 0x5c, 0x0f, 0xa3, 0xb4, 0xd6, 0x7d, 0x7d, 0x9a,
+	0x11, 0x8b, 0x36, 0xd7, 0xae, 0x62, 0xe4, 0xc7, 0x7d, 0xdb, 0x29, 0x80, 0xbb, 0xbb, 0xb0, 0x5a,
+	0x4a, 0x7a, 0xae, 0x63, 0xfe, 0x47, 0x1a, 0xb4, 0x0a, 0xe6, 0xc8, 0x6a, 0x20, 0x2f, 0xc8, 0xcb,
+	0xb6, 0x36, 0xf0, 0xa4, 0x04, 0x44, 0x89, 0x94, 0x93, 0x29, 0x2d, 0x91, 0x52, 0x34, 0x4d, 0x65,
+	0x33, 0x72, 0xd4, 0xcd, 0x9a, 0x3d, 0xea, 0x0b, 0xf4, 0x75, 0x68, 0xc6, 0xc4, 0xb5, 0x5c, 0x3c,
+	0xca, 0x0a, 0x50, 0xa7, 0xbd, 0x1d, 0x77, 0x0f, 0x8f, 0x38, 0x95, 0xf1, 0x03, 0x0d, 0x9a, 0x79,
+	0xc3, 0x98, 0x92, 0x82, 0x64, 0x33, 0x06, 0x7d, 0x2c, 0x63, 0xd8, 0x83, 0x2b, 0xa9, 0xe7, 0xc8,
+	0x80, 0x49, 0xff, 0x46, 0xde, 0x51, 0xa2, 0x42, 0xa6, 0x6e, 0x5e, 0x56, 0x54, 0xc2, 0x05, 0x77,
+	0x33, 0x34, 0xc6, 0xdf, 0xea, 0xb0, 0x52, 0x16, 0xf0, 0x4a, 0xb2, 0x71, 0xad, 0x2c, 0x1b, 0x7f,
+	0x19, 0x96, 0x52, 0x32, 0xd6, 0x58, 0x0a, 0x03, 0x91, 0x2f, 0xb5, 0x15, 0xe2, 0x53, 0x0e, 0xa7,
+	0xad, 0x00, 0x92, 0x99, 0x4b, 0xa5, 0xc8, 0xb3, 0x2c, 0x45, 0x46, 0x59, 0x9c, 0x48, 0x93, 0x8f,
+	0x21, 0x1d, 0x85, 0x6e, 0xee, 0xd8, 0xeb, 0x8b, 0x96, 0xc0, 0x5b, 0x67, 0x0b, 0xdc, 0x12, 0x88,
+	0xa3, 0x5d, 0xc6, 0xcd, 0x0d, 0xaf, 0x45, 0xf2, 0xd0, 0x6e, 0x4f, 0x49, 0x21, 0x47, 0x78, 0xae,
+	0xc2, 0xf4, 0xaf, 0x35, 0x58, 0xa3, 0xf9, 0xc0, 0xc0, 0x7b, 0x86, 0x45, 0x97, 0x4b, 0xa6, 0x2d,
+	0x99, 0x5b, 0x29, 0x6d, 0xc2, 0xad, 0xd4, 0x37, 0xd2, 0x94, 0x85, 0x9f, 0x0c, 0x37, 0xc6, 0x37,
+	0x28, 0x46, 0x2f, 0xcd, 0x57, 0xd0, 0xd7, 0x61, 0x91, 0xd8, 0x51, 0x1f, 0xab, 0x6b, 0x08, 0xde,
+	0x1b, 0xa5, 0x33, 0x75, 0xf5, 0x0d, 0xcd, 0x6c, 0x70, 0xac, 0x28, 0xfa, 0x57, 0xe4, 0x9d, 0xa2,
+	0xb8, 0x04, 0x63, 0x1f, 0xc6, 0x7f, 0xe9, 0xb0, 0x5c, 0x32, 0x09, 0xd5, 0x72, 0x98, 0x01, 0x5b,
+	0xfd, 0xd0, 0xf6, 0x65, 0x5d, 0x9d, 0x45, 0x7c, 0x10, 0xda, 0x3e, 0xda, 0x82, 0xe5, 0x1c, 0xb1,
+	0x68, 0x0a, 0x73, 0x79, 0xa1, 0x2c, 0xea, 0x11, 0xc3, 0xa0, 0x37, 0xa0, 0x69, 0xf7, 0xfb, 0xac,
+	0xd2, 0x18, 0xe1, 0x00, 0xc7, 0xc2, 0xc3, 0xc6, 0x7b, 0xae, 0x05, 0x32, 0x5a, 0x44, 0xa9, 0x0b,
+	0x86, 0xc7, 0xf8, 0x94, 0x05, 0xe5, 0x48, 0xe4, 0x0b, 0xbc, 0x6d, 0xb7, 0x26, 0xf1, 0x1f, 0xe2,
+	0xd3, 0x87, 0x29, 0x96, 0x05, 0x48, 0xc9, 0x19, 0x93, 0x28, 0x71, 0x48, 0x22, 0x32, 0xee, 0xaa,
+	0xb9, 0x24, 0x31, 0x07, 0x12, 0x41, 0xbb, 0x31, 0x8a, 0x7c, 0x78, 0x12, 0xd9, 0xb1, 0xea, 0x74,
+	0xb6, 0x24, 0x7c, 0x9f, 0x83, 0x79, 0x22, 0x4f, 0x2b, 0x35, 0x6b, 0x68, 0x13, 0x82, 0xa3, 0x20,
+	0xee, 0x2c, 0x30, 0xca, 0x26, 0x07, 0xef, 0x0b, 0xa8, 0xf1, 0x1f, 0x1a, 0x5c, 0x18, 0x33, 0x97,
+	0xb4, 0x8f, 0x21, 0xe4, 0x24, 0x7d, 0x3b, 0x20, 0x05, 0x79, 0x0b, 0x77, 0x0e, 0x08, 0xfa, 0xa4,
+	0x20, 0x6f, 0x9e, 0x9d, 0x74, 0xf4, 0x49, 0x95, 0x71, 0x56, 0xc1, 0x26, 0xa3, 0xcd, 0x6b, 0x85,
+	0xc3, 0xd0, 0x2e, 0xd4, 0x63, 0x82, 0x87, 0xb1, 0x45, 0xec, 0xc7, 0x38, 0x10, 0x0d, 0x0d, 0xe3,
+	0xcb, 0x87, 0x3b, 0x20, 0x78, 0x48, 0x4f, 0x7e, 0x3c, 0x8c, 0x0f, 0x29, 0x97, 0xf1, 0xfd, 0x59,
+	0x40, 0xe3, 0xf3, 0x51, 0x21, 0x85, 0x91, 0xd7, 0xf7, 0x02, 0xdb, 0xcf, 0x5d, 0x9a, 0x99, 0x4d,
+	0x09, 0x16, 0x66, 0x7a, 0x13, 0xd2, 0xfd, 0x4a, 0x4a, 0x1e, 0x0a, 0x5b, 0x0a, 0x2e, 0x48, 0x5f,
+	0x86, 0xa5, 0xf1, 0x82, 0x96, 0x87, 0xc0, 0xb6, 0x53, 0xac, 0x64, 0x5f, 0x84, 0x45, 0x59, 0x40,
+	0x67, 0xbb, 0x12, 0x0d, 0x01, 0xe4, 0xa5, 0xd6, 0x03, 0x58, 0xcf, 0x58, 0x94, 0x25, 0x35, 0xed,
+	0x5a, 0x43, 0x1c, 0x39, 0x38, 0x20, 0x76, 0x9f, 0x9b, 0x4c, 0xc5, 0xbc, 0x92, 0xa1, 0xdb, 0x97,
+	0x64, 0xfb, 0x8a, 0x0a, 0x7d, 0x08, 0x0b, 0x03, 0x4c, 0x22, 0xde, 0xb0, 0xa0, 0x72, 0xbc, 0x73,
+	0x16, 0xb5, 0x6c, 0x3e, 0xe2, 0x3c, 0x3c, 0x66, 0xc9, 0x11, 0xba, 0xf7, 0xa0, 0x91, 0x45, 0x9c,
+	0x2b, 0x46, 0xfd, 0x8b, 0x06, 0xed, 0xa2, 0xc2, 0xd0, 0x25, 0xa8, 0x51, 0x95, 0x65, 0x7b, 0x91,
+	0x55, 0x0a, 0x60, 0xa5, 0xd4, 0x55, 0xa8, 0x73, 0x07, 0x4e, 0xfb, 0x41, 0x35, 0x13, 0x38, 0x88,
+	0x9d, 0x00, 0xdb, 0xb0, 0x2a, 0x13, 0x2d, 0xae, 0x20, 0xeb, 0x08, 0x1f, 0x53, 0x91, 0xf2, 0x74,
+	0x63, 0x59, 0x20, 0xb9, 0x96, 0x7a, 0x0c, 0x45, 0x0f, 0x82, 0x02, 0x8f, 0x7d, 0x4c, 0x70, 0x24,
+	0xf2, 0x0e, 0x94, 0x63, 0xd9, 0xa1, 0x18, 0xb4, 0x0e, 0x75, 0x17, 0xc7, 0x4e, 0xe4, 0xb1, 0x88,
+	0x24, 0x3a, 0x43, 0x59, 0x90, 0x11, 0xc1, 0xf2, 0xc1, 0xd0, 0xf7, 0xc8, 0xf9, 0x43, 0xef, 0x9b,
+	0xc5, 0xd0, 0x7b, 0xa5, 0xa4, 0x77, 0x4f, 0x87, 0x2e, 0xc6, 0x5c, 0xe3, 0x0f, 0x75, 0x68, 0x64,
+	0x31, 0xe8, 0x15, 0x68, 0xaa, 0xfb, 0x1b, 0x2b, 0xf6, 0x9e, 0x61, 0x71, 0x19, 0x0c, 0xcf, 0x7b,
+	0x0b, 0xdd, 0xca, 0x86, 0xdb, 0xf9, 0xe2, 0xbe, 0xd9, 0x90, 0xf7, 0x39, 0x07, 0xde, 0x33, 0x8c,
+	0xb6, 0x60, 0x91, 0x53, 0x87, 0x23, 0x1c, 0xf9, 0xf6, 0xb0, 0xa3, 0x67, 0x19, 0x66, 0x3a, 0x3f,
+	0x5f, 0x30, 0x1b, 0x8c, 0xe0, 0x63, 0x8e, 0xe7, 0x29, 0xa0, 0xef, 0x91, 0xfc, 0x6d, 0x70, 0x8d,
+	0xa6, 0x80, 0xbe, 0x97, 0x5e, 0xa6, 0x9c, 0xb1, 0x52, 0xde, 0x82, 0x65, 0x15, 0xd9, 0xc6, 0xae,
+	0x66, 0x55, 0x8c, 0xcc, 0x5c, 0xc9, 0xde, 0x84, 0xb6, 0xa0, 0x3b, 0xb5, 0x06, 0x76, 0xf4, 0x18,
+	0x47, 0x2a, 0x14, 0x4a, 0xf8, 0x23, 0x0e, 0x36, 0x7e, 0x5f, 0x83, 0x95, 0xbc, 0x4a, 0x44, 0x78,
+	0x7b, 0x5d, 0xbd, 0x58, 0xe1, 0x25, 0xcb, 0x95, 0x89, 0x77, 0xcd, 0xbc, 0x53, 0x9a, 0x3e, 0x53,
+	0xa9, 0x16, 0x3a, 0xb5, 0x57, 0x27, 0x68, 0x6a, 0xbc, 0x6e, 0x33, 0xfe, 0x51, 0x87, 0x46, 0x76,
+	0x54, 0xd5, 0xe9, 0xb4, 0xbc, 0xc0, 0xc5, 0x4f, 0x3b, 0x5a, 0xa6, 0xd3, 0xf9, 0x90, 0x42, 0x50,
+	0x27, 0x35, 0x1d, 0x5d, 0x24, 0x67, 0xfc, 0x93, 0xe9, 0x80, 0xd8, 0x11, 0xb1, 0x64, 0xa7, 0x47,
+	0x16, 0x17, 0x0c, 0xba, 0x2f, 0x80, 0xb4, 0x4c, 0xc1, 0x81, 0x9b, 0x12, 0x71, 0xf3, 0xae, 0xe3,
+	0xc0, 0x55, 0x24, 0x85, 0x34, 0xaf, 0x32, 0x96, 0xe6, 0xfd, 0x32, 0xf0, 0xea, 0x21, 0xed, 0x51,
+	0x4f, 0x8c, 0x20, 0xd9, 0xdd, 0xe5, 0x1f, 0x51, 0xc8, 0x8b, 0xe5, 0x2c, 0x8c, 0x5d, 0x05, 0x8f,
+	0x11, 0x9d, 0x2b, 0x9a, 0xfc, 0xb3, 0x06, 0x8b, 0x39, 0x71, 0xa7, 0x45, 0xb0, 0xd2, 0x6f, 0x5a,
+	0x04, 0xa7, 0x77, 0x98, 0xc5, 0xd8, 0xaf, 0x97, 0xc6, 0xfe, 0xeb, 0xd0, 0xa4, 0x49, 0x77, 0xc6,
+	0x97, 0x78, 0x34, 0x6f, 0xd8, 0xa3, 0x7e, 0xea, 0x3f, 0x9b, 0xb0, 0x9c, 0x77, 0x07, 0x1e, 0xa7,
+	0xb8, 0xb1, 0x2f, 0xe5, 0x7c, 0x82, 0x85, 0x2b, 0x03, 0xb8, 0xa3, 0xa8, 0xe4, 0xb3, 0xc2, 0x92,
+	0xcf, 0x3a, 0x03, 0xf2, 0xac, 0xd3, 0xf8, 0x91, 0x0e, 0xab, 0x26, 0x0e, 0x5c, 0x1c, 0xa9, 0xf7,
+	0x4a, 0x22, 0x9a, 0x7c, 0xbd, 0xf4, 0x5a, 0x2c, 0x8d, 0x29, 0xf9, 0xfb, 0xb1, 0xc3, 0xec, 0x4d,
+	0x94, 0x3e, 0xa9, 0x54, 0x2a, 0x9d, 0x69, 0xf2, 0x7d, 0x14, 0xba, 0x5b, 0x7c, 0xac, 0x75, 0x75,
+	0xd2, 0x98, 0xc5, 0x78, 0xf5, 0x15, 0xaf, 0xb2, 0x7e, 0xa8, 0xc3, 0x62, 0x6e, 0x60, 0x6a, 0xbd,
+	0x31, 0x3d, 0x89, 0x88, 0x35, 0x08, 0x5d, 0x2c, 0x7a, 0x5d, 0xc0, 0x41, 0x8f, 0x42, 0x97, 0x3e,
+	0x5f, 0x69, 0xba, 0xf8, 0xd8, 0x4e, 0x7c, 0x62, 0xb1, 0x71, 0xa4, 0x18, 0xb6, 0xa7, 0x2c, 0x79,
+	0x73, 0x8f, 0x73, 0x7d, 0xca, 0x98, 0x84, 0xf9, 0xba, 0x59, 0x18, 0x9d, 0x1b, 0xc7, 0x8e, 0x3d,
+	0xc4, 0xd6, 0x09, 0x19, 0xf8, 0xf2, 0xda, 0x8b, 0x83, 0x1e, 0x90, 0x81, 0x4f, 0xcf, 0xf8, 0x30,
+	0x21, 0xc3, 0x84, 0x58, 0xfc, 0x6c, 0x16, 0x36, 0xd1, 0xe0, 0x40, 0x7e, 0x13, 0x45, 0x9d, 0x60,
+	0x7c, 0xaa, 0x73, 0x49, 0xe5, 0xdf, 0x35, 0x58, 0x2b, 0xaa, 0x50, 0xc4, 0xb9, 0x9b, 0xd0, 0x8e,
+	0x18, 0x66, 0x2c, 0x8b, 0x6b, 0x49, 0xb8, 0x4c, 0xe2, 0x3e, 0x18, 0x0b, 0x6d, 0x2f, 0x4f, 0xbe,
+	0x7a, 0xe3, 0xd3, 0x79, 0x41, 0xbf, 0xe4, 0xbd, 0x18, 0x7f, 0xec, 0xe0, 0x5a, 0xa9, 0xe1, 0xf1,
+	0x7e, 0x20, 0x7d, 0xec, 0xe0, 0x2a, 0xe5, 0xd3, 0x6c, 0x69, 0xe0, 0xc5, 0xb4, 0x7f, 0x97, 0xa1,
+	0xe4, 0x37, 0x4f, 0x6d, 0x81, 0x50, 0xc4, 0xc6, 0xdf, 0x68, 0x70, 0x71, 0xe2, 0xdc, 0xb9, 0xab,
+	0xe2, 0x4c, 0xa1, 0xa8, 0x5c, 0x81, 0xb9, 0xdd, 0xab, 0xb0, 0xaa, 0xe6, 0xb1, 0xe2, 0xe4, 0x28,
+	0x26, 0x1e, 0x49, 0x88, 0xba, 0xec, 0x58, 0x51, 0xc8, 0x83, 0x14, 0x97, 0xd1, 0x60, 0xb6, 0xa4,
+	0x91, 0x1a, 0x14, 0x61, 0xe2, 0x16, 0x2c, 0x45, 0x72, 0x4d, 0xca, 0xa9, 0xe7, 0x98, 0x53, 0xb7,
+	0x14, 0x42, 0x38, 0xf6, 0xef, 0x6a, 0x70, 0x59, 0xf6, 0x9a, 0x7b, 0xbc, 0xe5, 0xc8, 0x4a, 0x62,
+	0xd5, 0xfe, 0xfe, 0x10, 0xaa, 0xa2, 0x59, 0x2d, 0xcf, 0xa6, 0x1b, 0x13, 0x1a, 0x98, 0xf9, 0x56,
+	0x75, 0xe6, 0x4d, 0xa5, 0x1a, 0x80, 0x56, 0xf7, 0xbc, 0x23, 0xaa, 0xae, 0x10, 0x16, 0xd8, 0xf7,
+	0x43, 0xd7, 0xf8, 0x9e, 0x06, 0xcb, 0x25, 0xc3, 0xd0, 0x46, 0x83, 0x60, 0x4f, 0x5b, 0x02, 0x35,
+	0x01, 0x79, 0xe8, 0xa2, 0x6f, 0x8d, 0xf7, 0xd4, 0xf5, 0xf3, 0xf5, 0xd4, 0x8b, 0x1d, 0x75, 0xe3,
+	0xaf, 0x34, 0x78, 0x61, 0x82, 0x48, 0x84, 0x15, 0x67, 0xb7, 0xa1, 0xe5, 0xb6, 0x81, 0xde, 0x85,
+	0x05, 0x5e, 0x6d, 0x48, 0xbf, 0xbe, 0x3e, 0x4d, 0x5a, 0x94, 0xd8, 0x94, 0x4c, 0x68, 0x37, 0x63,
+	0xf5, 0x3c, 0x96, 0xbd, 0x34, 0x61, 0x00, 0x79, 0x8d, 0x54, 0x72, 0xb0, 0xff, 0xa7, 0x06, 0x68,
+	0x7c, 0x92, 0x69, 0xa2, 0x3c, 0x4c, 0x6f, 0x7f, 0x23, 0xb1, 0x53, 0x21, 0xcb, 0x9b, 0x67, 0x90,
+	0x25, 0x67, 0x50, 0xb7, 0xc0, 0x4a, 0x56, 0x6f, 0xc0, 0x7c, 0x4c, 0x6c, 0x92, 0xf0, 0xd0, 0xdc,
+	0xdc, 0xbe, 0x5a, 0xb8, 0xa8, 0xdd, 0x8f, 0x42, 0x07, 0x33, 0xef, 0x3a, 0x60, 0x64, 0xa6, 0x20,
+	0xa7, 0xa6, 0xce, 0xde, 0x09, 0x5b, 0x03, 0x1c, 0xc7, 0xb4, 0xb0, 0x10, 0xc1, 0x8a, 0x01, 0x1f,
+	0x71, 0x98, 0xf1, 0x53, 0x1d, 0x56, 0x4b, 0xa5, 0xc1, 0xb3, 0x3d, 0x7a, 0xee, 0x66, 0xac, 0x97,
+	0x25, 0x24, 0x0c, 0x6a, 0x4a, 0x8b, 0x7c, 0x15, 0x56, 0xe3, 0xc4, 0xa1, 0x2b, 0x38, 0x4e, 0x7c,
+	0x4b, 0x6c, 0xd1, 0xc3, 0xb2, 0xe3, 0xb5, 0x92, 0x22, 0x77, 0x14, 0x8e, 0x86, 0x8a, 0x63, 0x7e,
+	0x37, 0x91, 0x61, 0xe0, 0x9e, 0xd8, 0xe6, 0x88, 0x0c, 0xf1, 0x1b, 0xd0, 0xe1, 0x0b, 0x19, 0xaa,
+	0x9d, 0x16, 0x9c, 0x72, 0x95, 0xe1, 0x53, 0x41, 0x88, 0x4e, 0xcf, 0x5d, 0xd6, 0x66, 0x8e, 0xc4,
+	0xd3, 0xd3, 0xca, 0xf4, 0xa7, 0xa7, 0x82, 0x7a, 0x87, 0xd0, 0xbb, 0x5c, 0x5a, 0xe0, 0xf9, 0x58,
+	0x30, 0xcf, 0x4f, 0x65, 0xae, 0x2b, 0xfa, 0x1d, 0x62, 0xfc, 0x9e, 0x06, 0x2b, 0xf4, 0xea, 0x4a,
+	0x46, 0x38, 0x15, 0x0c, 0xee, 0x03, 0x0c, 0x6d, 0x9a, 0x91, 0xb0, 0xe4, 0x4d, 0xcb, 0xf4, 0xeb,
+	0x33, 0x0a, 0x55, 0x04, 0xd2, 0xc1, 0x32, 0x3c, 0x68, 0x0b, 0x16, 0xf8, 0xc5, 0xac, 0xf4, 0x8f,
+	0xd5, 0x02, 0x3b, 0xbf, 0x96, 0x35, 0x25, 0x95, 0xf1, 0x63, 0x0d, 0x56, 0x0b, 0x6b, 0x11, 0x96,
+	0xf5, 0x36, 0xd4, 0x64, 0x40, 0xfd, 0x92, 0xb4, 0x59, 0xf2, 0xb1, 0xb6, 0x73, 0xca, 0x40, 0x9b,
+	0xf8, 0x99, 0xad, 0x64, 0x5f, 0x39, 0x94, 0x6f, 0x45, 0xd8, 0x77, 0x86, 0xc9, 0xf8, 0xb3, 0x59,
+	0x68, 0x64, 0x87, 0x47, 0x08, 0xe6, 0x32, 0x15, 0x23, 0xfb, 0x9f, 0xe6, 0x7f, 0xae, 0x17, 0x0f,
+	0x7d, 0xfb, 0x34, 0xfb, 0xa6, 0xb5, 0x2e, 0x60, 0x2c, 0x29, 0x2a, 0x54, 0x72, 0xb3, 0x63, 0x95,
+	0x1c, 0x7d, 0x0c, 0x21, 0x5a, 0xcc, 0xb2, 0x3d, 0xa5, 0xbe, 0x69, 0xbd, 0x12, 0x27, 0xc3, 0x61,
+	0xc8, 0x0c, 0x25, 0xff, 0xcc, 0xa7, 0x66, 0x22, 0x85, 0x4a, 0x0f, 0xba, 0xdb, 0x80, 0xe4, 0xd3,
+	0x98, 0x0c, 0x3d, 0xaf, 0x58, 0x96, 0x24, 0x26, 0x25, 0xff, 0x18, 0xda, 0xfc, 0x7a, 0x35, 0x73,
+	0xe7, 0xb1, 0x30, 0xa9, 0x93, 0xa2, 0x9e, 0xc2, 0x28, 0x5a, 0xb3, 0xc5, 0xb8, 0x53, 0x40, 0xe1,
+	0x49, 0x75, 0xf5, 0x3c, 0x4f, 0xaa, 0xef, 0x02, 0x24, 0x43, 0x57, 0xb2, 0xd6, 0xa6, 0xb3, 0x0a,
+	0xea, 0x1d, 0x42, 0xdf, 0x85, 0xa3, 0xf1, 0xd5, 0xd1, 0x9c, 0x89, 0xef, 0x2e, 0xbd, 0xe5, 0xab,
+	0xd0, 0x77, 0x28, 0x76, 0x5f, 0x5c, 0xe2, 0xdd, 0x81, 0x55, 0xfe, 0x66, 0xa3, 0x78, 0xa0, 0xb2,
+	0xc7, 0x9a, 0x26, 0x62, 0xaf, 0x36, 0x72, 0x67, 0x2a, 0x7a, 0x03, 0x6a, 0xbe, 0x1d, 0x13, 0x7e,
+	0xf4, 0x4f, 0x7f, 0x32, 0x5e, 0xa5, 0xc4, 0x2c, 0x25, 0x78, 0x06, 0x1d, 0xa9, 0x10, 0x8b, 0xaf,
+	0xea, 0x98, 0x45, 0xaf, 0xc0, 0x39, 0x15, 0x3d, 0xde, 0xfb, 0x67, 0x11, 0xb9, 0xca, 0x94, 0x3f,
+	0xa1, 0x63, 0xbc, 0x2f, 0x87, 0xe0, 0x39, 0xe3, 0xda, 0xa8, 0x14, 0xd9, 0x7d, 0x08, 0x97, 0xbe,
+	0x84, 0xed, 0x5c, 0xb7, 0x0d, 0x77, 0x00, 0x7d, 0x80, 0x49, 0xb1, 0x50, 0xb8, 0x04, 0x73, 0x65,
+	0xf5, 0x01, 0x03, 0x1a, 0x8f, 0x61, 0x39, 0xc7, 0x22, 0x5c, 0xfc, 0x5e, 0xe6, 0x87, 0x14, 0xda,
+	0xa4, 0x46, 0x44, 0xce, 0xc3, 0x15, 0xfd, 0xe4, 0x5a, 0xd5, 0xf8, 0x07, 0x0d, 0x56, 0x77, 0x99,
+	0x4d, 0x15, 0xd7, 0xb8, 0x9d, 0x5b, 0xe3, 0x95, 0xe7, 0xbd, 0x4b, 0xd1, 0xc5, 0xed, 0xd5, 0x5f,
+	0xff, 0xb6, 0x7d, 0xfb, 0xd9, 0x2b, 0xb7, 0xef, 0x7e, 0x26, 0xfe, 0x5a, 0xb7, 0x3f, 0xbb, 0x75,
+	0xbd, 0x73, 0xff, 0xff, 0xcb, 0xc1, 0x33, 0xfd, 0x9a, 0x4a, 0x79, 0xbf, 0xc6, 0x38, 0x84, 0xb5,
+	0xe2, 0x86, 0xbe, 0xba, 0x04, 0x8d, 0x7f, 0xd2, 0x60, 0xf5, 0x13, 0xe6, 0x40, 0xe7, 0xd1, 0xe5,
+	0xff, 0xbd, 0x40, 0x3a, 0x05, 0x81, 0xa4, 0x5d, 0x88, 0x17, 0x61, 0x91, 0x7b, 0xbc, 0x75, 0xec,
+	0x61, 0xdf, 0x95, 0x51, 0xad, 0xc1, 0x81, 0xef, 0x33, 0x18, 0x15, 0x56, 0x71, 0x57, 0xff, 0x0b,
+	0xc2, 0x7a, 0x0d, 0x56, 0xf7, 0xb0, 0x8f, 0xcf, 0x27, 0x2b, 0xe3, 0x15, 0x58, 0x2b, 0x72, 0x89,
+	0xb5, 0xac, 0xa9, 0xbc, 0x49, 0xbc, 0x1d, 0xe7, 0x5f, 0xdb, 0x7f, 0xdc, 0x80, 0x9a, 0x4c, 0xbe,
+	0x22, 0xf4, 0x3d, 0x0d, 0x5a, 0x85, 0x54, 0x0c, 0x9d, 0x39, 0xf3, 0xed, 0x9e, 0x3d, 0xaf, 0x33,
+	0xae, 0xfe, 0xe6, 0x4f, 0x7f, 0xf6, 0x43, 0xfd, 0xa2, 0xb1, 0xb2, 0x95, 0xf9, 0xb1, 0xd9, 0x96,
+	0x48, 0xf6, 0xee, 0x69, 0xb7, 0xd0, 0x6f, 0x69, 0xd0, 0xcc, 0x3f, 0xa5, 0x40, 0x25, 0x99, 0x6b,
+	0xe9, 0x13, 0x9b, 0xee, 0xc6, 0x74, 0x42, 0xb1, 0x8c, 0x2b, 0x6c, 0x19, 0x1d, 0x63, 0x99, 0x2e,
+	0x83, 0xdf, 0x2c, 0x6e, 0xc9, 0xdb, 0x3c, 0xba, 0x0a, 0x2a, 0x8f, 0xc2, 0x15, 0x42, 0x99, 0x3c,
+	0xca, 0x2f, 0xa5, 0xba, 0x37, 0xcf, 0x40, 0x59, 0x26, 0x0f, 0xd9, 0x78, 0xdf, 0x12, 0x76, 0x48,
+	0x57, 0xf2, 0x1d, 0xd1, 0x07, 0x95, 0xab, 0xb8, 0x31, 0xa1, 0x2f, 0x57, 0x58, 0xc2, 0xd7, 0xa6,
+	0x91, 0x89, 0xf9, 0x2f, 0xb3, 0xf9, 0xd7, 0x8c, 0x25, 0x3a, 0x3f, 0xeb, 0xd4, 0x64, 0x27, 0xa7,
+	0xca, 0xc8, 0x57, 0xe0, 0x65, 0xca, 0x28, 0x6d, 0xb3, 0x74, 0x37, 0xa6, 0x13, 0xe6, 0x95, 0x71,
+	0x4f, 0xbb, 0xc5, 0xf5, 0xc1, 0xcf, 0xc8, 0x2d, 0x15, 0x81, 0xff, 0x40, 0x83, 0xd5, 0xd2, 0x42,
+	0x0a, 0x6d, 0x4e, 0x36, 0xbc, 0xb2, 0x22, 0xb4, 0xbb, 0x75, 0x66, 0x7a, 0xb1, 0xb4, 0x17, 0xd8,
+	0xd2, 0x2e, 0x18, 0x28, 0x67, 0xae, 0xac, 0x48, 0xa3, 0xf2, 0x79, 0x02, 0x8b, 0xb9, 0x9c, 0x12,
+	0x95, 0x88, 0xbd, 0x2c, 0x01, 0xee, 0xbe, 0x34, 0x95, 0x4e, 0x2c, 0x60, 0x95, 0x2d, 0xa0, 0x85,
+	0x16, 0x99, 0xa1, 0xaa, 0x79, 0x9e, 0x42, 0x3d, 0x73, 0xce, 0xa1, 0x92, 0x0c, 0x6a, 0xfc, 0xe4,
+	0xec, 0xde, 0x98, 0x42, 0x95, 0x37, 0x09, 0xb4, 0x92, 0x9b, 0x72, 0xeb, 0x3b, 0x34, 0xd0, 0x7c,
+	0x17, 0x7d, 0x17, 0x9a, 0xf9, 0x23, 0xa2, 0xcc, 0x22, 0x4a, 0x4f, 0xc5, 0xee, 0xc6, 0x74, 0x42,
+	0xb1, 0x84, 0x0e, 0x5b, 0x02, 0x32, 0xf2, 0xbb, 0xa6, 0x12, 0xff, 0x6d, 0x0d, 0x9a, 0xf9, 0xa8,
+	0x5b, 0x36, 0x7f, 0xe9, 0x69, 0xd3, 0xdd, 0x98, 0x4e, 0x98, 0xf7, 0xca, 0x6e, 0xa9, 0x08, 0xe8,
+	0x32, 0x7e, 0x43, 0x83, 0x66, 0x3e, 0xe0, 0x96, 0x2d, 0xa3, 0x34, 0x90, 0x77, 0x37, 0xa6, 0x13,
+	0xe6, 0x35, 0x71, 0xab, 0x5c, 0x13, 0x9f, 0x41, 0xfd, 0x01, 0xb6, 0x7d, 0x72, 0xb2, 0x7b, 0x82,
+	0x9d, 0xc7, 0x68, 0x6d, 0x2c, 0x35, 0x7c, 0x8f, 0xfe, 0xec, 0xb6, 0x6b, 0x14, 0x8a, 0x91, 0x0c,
+	0x8f, 0x9a, 0x08, 0xb1, 0x89, 0x1a, 0x08, 0xe8, 0x44, 0x27, 0x8c, 0xa0, 0x77, 0x1b, 0xc6, 0x7e,
+	0xf7, 0xbb, 0xaf, 0xfd, 0xea, 0x85, 0xc8, 0xee, 0xf3, 0x9f, 0xfd, 0x4a, 0xf8, 0xd6, 0xe8, 0xce,
+	0x5b, 0xa3, 0x3b, 0x47, 0xf3, 0x6c, 0xda, 0x57, 0xff, 0x67, 0x00, 0x18, 0x2f, 0x20, 0xfc, 0x45,
+	0x3c, 0x00, 0x00,
 }
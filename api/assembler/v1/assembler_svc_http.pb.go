@@ -23,20 +23,30 @@ const _ = http.SupportPackageIsVersion1
 
 const OperationAssemblerAssembleBatchContexts = "/api.assembler.v1.Assembler/AssembleBatchContexts"
 const OperationAssemblerAssembleContext = "/api.assembler.v1.Assembler/AssembleContext"
+const OperationAssemblerCreateTemplate = "/api.assembler.v1.Assembler/CreateTemplate"
+const OperationAssemblerDeleteTemplate = "/api.assembler.v1.Assembler/DeleteTemplate"
 const OperationAssemblerEstimateTokens = "/api.assembler.v1.Assembler/EstimateTokens"
+const OperationAssemblerGetTemplate = "/api.assembler.v1.Assembler/GetTemplate"
 const OperationAssemblerHealthCheck = "/api.assembler.v1.Assembler/HealthCheck"
 const OperationAssemblerListTemplates = "/api.assembler.v1.Assembler/ListTemplates"
 const OperationAssemblerOptimizeContent = "/api.assembler.v1.Assembler/OptimizeContent"
 const OperationAssemblerRenderTemplate = "/api.assembler.v1.Assembler/RenderTemplate"
 const OperationAssemblerSplitContent = "/api.assembler.v1.Assembler/SplitContent"
+const OperationAssemblerUpdateTemplate = "/api.assembler.v1.Assembler/UpdateTemplate"
 
 type AssemblerHTTPServer interface {
 	// AssembleBatchContexts 批量上下文构建
 	AssembleBatchContexts(context.Context, *AssembleBatchContextsRequest) (*AssembleBatchContextsResponse, error)
 	// AssembleContext 构建上下文
 	AssembleContext(context.Context, *AssembleContextRequest) (*AssembleContextResponse, error)
+	// CreateTemplate 创建模板
+	CreateTemplate(context.Context, *CreateTemplateRequest) (*CreateTemplateResponse, error)
+	// DeleteTemplate 删除模板
+	DeleteTemplate(context.Context, *DeleteTemplateRequest) (*DeleteTemplateResponse, error)
 	// EstimateTokens 估算Token数量
 	EstimateTokens(context.Context, *EstimateTokensRequest) (*EstimateTokensResponse, error)
+	// GetTemplate 获取模板
+	GetTemplate(context.Context, *GetTemplateRequest) (*GetTemplateResponse, error)
 	// HealthCheck 健康检查
 	HealthCheck(context.Context, *emptypb.Empty) (*v1.HealthCheckResponse, error)
 	// ListTemplates 获取模板列表
@@ -47,6 +57,8 @@ type AssemblerHTTPServer interface {
 	RenderTemplate(context.Context, *RenderTemplateRequest) (*RenderTemplateResponse, error)
 	// SplitContent 分割长文本
 	SplitContent(context.Context, *SplitContentRequest) (*SplitContentResponse, error)
+	// UpdateTemplate 更新模板
+	UpdateTemplate(context.Context, *UpdateTemplateRequest) (*UpdateTemplateResponse, error)
 }
 
 func RegisterAssemblerHTTPServer(s *http.Server, srv AssemblerHTTPServer) {
@@ -58,6 +70,10 @@ func RegisterAssemblerHTTPServer(s *http.Server, srv AssemblerHTTPServer) {
 	r.POST("/v1/render/template", _Assembler_RenderTemplate0_HTTP_Handler(srv))
 	r.POST("/v1/assemble/batch", _Assembler_AssembleBatchContexts0_HTTP_Handler(srv))
 	r.GET("/v1/templates", _Assembler_ListTemplates0_HTTP_Handler(srv))
+	r.GET("/v1/templates/{name}", _Assembler_GetTemplate0_HTTP_Handler(srv))
+	r.POST("/v1/templates", _Assembler_CreateTemplate0_HTTP_Handler(srv))
+	r.PUT("/v1/templates/{name}", _Assembler_UpdateTemplate0_HTTP_Handler(srv))
+	r.DELETE("/v1/templates/{name}", _Assembler_DeleteTemplate0_HTTP_Handler(srv))
 	r.GET("/v1/health", _Assembler_HealthCheck0_HTTP_Handler(srv))
 }
 
@@ -212,6 +228,97 @@ func _Assembler_ListTemplates0_HTTP_Handler(srv AssemblerHTTPServer) func(ctx ht
 	}
 }
 
+func _Assembler_GetTemplate0_HTTP_Handler(srv AssemblerHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in GetTemplateRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationAssemblerGetTemplate)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.GetTemplate(ctx, req.(*GetTemplateRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*GetTemplateResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Assembler_CreateTemplate0_HTTP_Handler(srv AssemblerHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in CreateTemplateRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationAssemblerCreateTemplate)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.CreateTemplate(ctx, req.(*CreateTemplateRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*CreateTemplateResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Assembler_UpdateTemplate0_HTTP_Handler(srv AssemblerHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in UpdateTemplateRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationAssemblerUpdateTemplate)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.UpdateTemplate(ctx, req.(*UpdateTemplateRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*UpdateTemplateResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Assembler_DeleteTemplate0_HTTP_Handler(srv AssemblerHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in DeleteTemplateRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationAssemblerDeleteTemplate)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.DeleteTemplate(ctx, req.(*DeleteTemplateRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*DeleteTemplateResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
 func _Assembler_HealthCheck0_HTTP_Handler(srv AssemblerHTTPServer) func(ctx http.Context) error {
 	return func(ctx http.Context) error {
 		var in emptypb.Empty
@@ -236,8 +343,14 @@ type AssemblerHTTPClient interface {
 	AssembleBatchContexts(ctx context.Context, req *AssembleBatchContextsRequest, opts ...http.CallOption) (rsp *AssembleBatchContextsResponse, err error)
 	// AssembleContext 构建上下文
 	AssembleContext(ctx context.Context, req *AssembleContextRequest, opts ...http.CallOption) (rsp *AssembleContextResponse, err error)
+	// CreateTemplate 创建模板
+	CreateTemplate(ctx context.Context, req *CreateTemplateRequest, opts ...http.CallOption) (rsp *CreateTemplateResponse, err error)
+	// DeleteTemplate 删除模板
+	DeleteTemplate(ctx context.Context, req *DeleteTemplateRequest, opts ...http.CallOption) (rsp *DeleteTemplateResponse, err error)
 	// EstimateTokens 估算Token数量
 	EstimateTokens(ctx context.Context, req *EstimateTokensRequest, opts ...http.CallOption) (rsp *EstimateTokensResponse, err error)
+	// GetTemplate 获取模板
+	GetTemplate(ctx context.Context, req *GetTemplateRequest, opts ...http.CallOption) (rsp *GetTemplateResponse, err error)
 	// HealthCheck 健康检查
 	HealthCheck(ctx context.Context, req *emptypb.Empty, opts ...http.CallOption) (rsp *v1.HealthCheckResponse, err error)
 	// ListTemplates 获取模板列表
@@ -248,6 +361,8 @@ type AssemblerHTTPClient interface {
 	RenderTemplate(ctx context.Context, req *RenderTemplateRequest, opts ...http.CallOption) (rsp *RenderTemplateResponse, err error)
 	// SplitContent 分割长文本
 	SplitContent(ctx context.Context, req *SplitContentRequest, opts ...http.CallOption) (rsp *SplitContentResponse, err error)
+	// UpdateTemplate 更新模板
+	UpdateTemplate(ctx context.Context, req *UpdateTemplateRequest, opts ...http.CallOption) (rsp *UpdateTemplateResponse, err error)
 }
 
 type AssemblerHTTPClientImpl struct {
@@ -286,6 +401,34 @@ func (c *AssemblerHTTPClientImpl) AssembleContext(ctx context.Context, in *Assem
 	return &out, nil
 }
 
+// CreateTemplate 创建模板
+func (c *AssemblerHTTPClientImpl) CreateTemplate(ctx context.Context, in *CreateTemplateRequest, opts ...http.CallOption) (*CreateTemplateResponse, error) {
+	var out CreateTemplateResponse
+	pattern := "/v1/templates"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationAssemblerCreateTemplate))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// DeleteTemplate 删除模板
+func (c *AssemblerHTTPClientImpl) DeleteTemplate(ctx context.Context, in *DeleteTemplateRequest, opts ...http.CallOption) (*DeleteTemplateResponse, error) {
+	var out DeleteTemplateResponse
+	pattern := "/v1/templates/{name}"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationAssemblerDeleteTemplate))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "DELETE", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
 // EstimateTokens 估算Token数量
 func (c *AssemblerHTTPClientImpl) EstimateTokens(ctx context.Context, in *EstimateTokensRequest, opts ...http.CallOption) (*EstimateTokensResponse, error) {
 	var out EstimateTokensResponse
@@ -300,6 +443,20 @@ func (c *AssemblerHTTPClientImpl) EstimateTokens(ctx context.Context, in *Estima
 	return &out, nil
 }
 
+// GetTemplate 获取模板
+func (c *AssemblerHTTPClientImpl) GetTemplate(ctx context.Context, in *GetTemplateRequest, opts ...http.CallOption) (*GetTemplateResponse, error) {
+	var out GetTemplateResponse
+	pattern := "/v1/templates/{name}"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationAssemblerGetTemplate))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
 // HealthCheck 健康检查
 func (c *AssemblerHTTPClientImpl) HealthCheck(ctx context.Context, in *emptypb.Empty, opts ...http.CallOption) (*v1.HealthCheckResponse, error) {
 	var out v1.HealthCheckResponse
@@ -369,3 +526,17 @@ func (c *AssemblerHTTPClientImpl) SplitContent(ctx context.Context, in *SplitCon
 	}
 	return &out, nil
 }
+
+// UpdateTemplate 更新模板
+func (c *AssemblerHTTPClientImpl) UpdateTemplate(ctx context.Context, in *UpdateTemplateRequest, opts ...http.CallOption) (*UpdateTemplateResponse, error) {
+	var out UpdateTemplateResponse
+	pattern := "/v1/templates/{name}"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationAssemblerUpdateTemplate))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "PUT", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
@@ -32,6 +32,7 @@ const (
 	ProcessingStatus_PROCESSING_STATUS_COMPLETED   ProcessingStatus = 3
 	ProcessingStatus_PROCESSING_STATUS_FAILED      ProcessingStatus = 4
 	ProcessingStatus_PROCESSING_STATUS_CANCELLED   ProcessingStatus = 5
+	ProcessingStatus_PROCESSING_STATUS_SKIPPED     ProcessingStatus = 6
 )
 
 var ProcessingStatus_name = map[int32]string{
@@ -41,6 +42,7 @@ var ProcessingStatus_name = map[int32]string{
 	3: "PROCESSING_STATUS_COMPLETED",
 	4: "PROCESSING_STATUS_FAILED",
 	5: "PROCESSING_STATUS_CANCELLED",
+	6: "PROCESSING_STATUS_SKIPPED",
 }
 
 var ProcessingStatus_value = map[string]int32{
@@ -50,6 +52,7 @@ var ProcessingStatus_value = map[string]int32{
 	"PROCESSING_STATUS_COMPLETED":   3,
 	"PROCESSING_STATUS_FAILED":      4,
 	"PROCESSING_STATUS_CANCELLED":   5,
+	"PROCESSING_STATUS_SKIPPED":     6,
 }
 
 func (x ProcessingStatus) String() string {
@@ -668,6 +671,94 @@ func (m *ChunkInfo) GetUpdatedAt() *timestamppb.Timestamp {
 	return nil
 }
 
+// 引用信息：上下文或答案中的编号标记与来源分块的对应关系
+type Citation struct {
+	CitationNumber       int32    `protobuf:"varint,1,opt,name=citation_number,json=citationNumber,proto3" json:"citation_number,omitempty"`
+	ChunkId              string   `protobuf:"bytes,2,opt,name=chunk_id,json=chunkId,proto3" json:"chunk_id,omitempty"`
+	DocumentId           string   `protobuf:"bytes,3,opt,name=document_id,json=documentId,proto3" json:"document_id,omitempty"`
+	Title                string   `protobuf:"bytes,4,opt,name=title,proto3" json:"title,omitempty"`
+	SourceUrl            string   `protobuf:"bytes,5,opt,name=source_url,json=sourceUrl,proto3" json:"source_url,omitempty"`
+	StartOffset          int32    `protobuf:"varint,6,opt,name=start_offset,json=startOffset,proto3" json:"start_offset,omitempty"`
+	EndOffset            int32    `protobuf:"varint,7,opt,name=end_offset,json=endOffset,proto3" json:"end_offset,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Citation) Reset()         { *m = Citation{} }
+func (m *Citation) String() string { return proto.CompactTextString(m) }
+func (*Citation) ProtoMessage()    {}
+func (*Citation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_372283428b44e521, []int{9}
+}
+
+func (m *Citation) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Citation.Unmarshal(m, b)
+}
+func (m *Citation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Citation.Marshal(b, m, deterministic)
+}
+func (m *Citation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Citation.Merge(m, src)
+}
+func (m *Citation) XXX_Size() int {
+	return xxx_messageInfo_Citation.Size(m)
+}
+func (m *Citation) XXX_DiscardUnknown() {
+	xxx_messageInfo_Citation.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Citation proto.InternalMessageInfo
+
+func (m *Citation) GetCitationNumber() int32 {
+	if m != nil {
+		return m.CitationNumber
+	}
+	return 0
+}
+
+func (m *Citation) GetChunkId() string {
+	if m != nil {
+		return m.ChunkId
+	}
+	return ""
+}
+
+func (m *Citation) GetDocumentId() string {
+	if m != nil {
+		return m.DocumentId
+	}
+	return ""
+}
+
+func (m *Citation) GetTitle() string {
+	if m != nil {
+		return m.Title
+	}
+	return ""
+}
+
+func (m *Citation) GetSourceUrl() string {
+	if m != nil {
+		return m.SourceUrl
+	}
+	return ""
+}
+
+func (m *Citation) GetStartOffset() int32 {
+	if m != nil {
+		return m.StartOffset
+	}
+	return 0
+}
+
+func (m *Citation) GetEndOffset() int32 {
+	if m != nil {
+		return m.EndOffset
+	}
+	return 0
+}
+
 // 相似度结果
 type SimilarityResult struct {
 	ChunkId              string     `protobuf:"bytes,1,opt,name=chunk_id,json=chunkId,proto3" json:"chunk_id,omitempty"`
@@ -683,7 +774,7 @@ func (m *SimilarityResult) Reset()         { *m = SimilarityResult{} }
 func (m *SimilarityResult) String() string { return proto.CompactTextString(m) }
 func (*SimilarityResult) ProtoMessage()    {}
 func (*SimilarityResult) Descriptor() ([]byte, []int) {
-	return fileDescriptor_372283428b44e521, []int{9}
+	return fileDescriptor_372283428b44e521, []int{10}
 }
 
 func (m *SimilarityResult) XXX_Unmarshal(b []byte) error {
@@ -748,7 +839,7 @@ func (m *QueryContext) Reset()         { *m = QueryContext{} }
 func (m *QueryContext) String() string { return proto.CompactTextString(m) }
 func (*QueryContext) ProtoMessage()    {}
 func (*QueryContext) Descriptor() ([]byte, []int) {
-	return fileDescriptor_372283428b44e521, []int{10}
+	return fileDescriptor_372283428b44e521, []int{11}
 }
 
 func (m *QueryContext) XXX_Unmarshal(b []byte) error {
@@ -822,7 +913,7 @@ func (m *TaskStatus) Reset()         { *m = TaskStatus{} }
 func (m *TaskStatus) String() string { return proto.CompactTextString(m) }
 func (*TaskStatus) ProtoMessage()    {}
 func (*TaskStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_372283428b44e521, []int{11}
+	return fileDescriptor_372283428b44e521, []int{12}
 }
 
 func (m *TaskStatus) XXX_Unmarshal(b []byte) error {
@@ -905,6 +996,7 @@ func init() {
 	proto.RegisterMapType((map[string]string)(nil), "api.common.v1.HealthCheckResponse.DetailsEntry")
 	proto.RegisterType((*DocumentInfo)(nil), "api.common.v1.DocumentInfo")
 	proto.RegisterType((*ChunkInfo)(nil), "api.common.v1.ChunkInfo")
+	proto.RegisterType((*Citation)(nil), "api.common.v1.Citation")
 	proto.RegisterType((*SimilarityResult)(nil), "api.common.v1.SimilarityResult")
 	proto.RegisterType((*QueryContext)(nil), "api.common.v1.QueryContext")
 	proto.RegisterMapType((map[string]string)(nil), "api.common.v1.QueryContext.ParametersEntry")
@@ -916,83 +1008,89 @@ func init() {
 }
 
 var fileDescriptor_372283428b44e521 = []byte{
-	// 1238 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x56, 0xcb, 0x6e, 0xdb, 0x46,
-	0x14, 0x15, 0xa9, 0x27, 0xaf, 0x9c, 0x44, 0x9d, 0x1a, 0x31, 0x23, 0xc7, 0xb0, 0x22, 0x34, 0x81,
-	0xda, 0xa0, 0x12, 0x9c, 0xa0, 0xc8, 0xa3, 0x08, 0x0a, 0xeb, 0x91, 0x94, 0x68, 0xe2, 0xa8, 0x23,
-	0x67, 0xd3, 0x8d, 0x30, 0x26, 0xc7, 0x0a, 0x21, 0x8a, 0x64, 0x38, 0x23, 0x21, 0x0a, 0xba, 0xe8,
-	0xaa, 0xcb, 0x7e, 0x44, 0x81, 0xae, 0xba, 0xeb, 0xc7, 0x74, 0xd1, 0x4d, 0x7f, 0xa1, 0x6b, 0xaf,
-	0x8a, 0x79, 0x88, 0x96, 0x25, 0x1b, 0x8e, 0xb3, 0x12, 0xef, 0xbd, 0x67, 0x86, 0x97, 0xe7, 0xdc,
-	0x39, 0x23, 0xb8, 0x31, 0xdb, 0x6b, 0xb9, 0xd1, 0x64, 0x12, 0x85, 0xcd, 0x38, 0x89, 0x78, 0x84,
-	0xae, 0x91, 0xd8, 0x6f, 0xea, 0xcc, 0x6c, 0xaf, 0xba, 0x3b, 0x8a, 0xa2, 0x51, 0x40, 0x5b, 0xb2,
-	0x78, 0x34, 0x3d, 0x6e, 0x71, 0x7f, 0x42, 0x19, 0x27, 0x93, 0x58, 0xe1, 0xab, 0x5b, 0x33, 0x12,
-	0xf8, 0x1e, 0xe1, 0xb4, 0xb5, 0x78, 0x50, 0x85, 0xfa, 0x7f, 0x06, 0x94, 0x5e, 0x51, 0x4e, 0x3c,
-	0xc2, 0x09, 0xfa, 0x06, 0x72, 0xe2, 0xd7, 0x36, 0x6a, 0xd9, 0x46, 0xf9, 0xc1, 0x9d, 0xe6, 0x99,
-	0x97, 0x34, 0x17, 0xb0, 0x66, 0x97, 0x70, 0xd2, 0x0b, 0x79, 0x32, 0xc7, 0x12, 0x8e, 0x9e, 0x00,
-	0xb8, 0x09, 0x25, 0x9c, 0x7a, 0x43, 0xc2, 0x6d, 0xb3, 0x66, 0x34, 0xca, 0x0f, 0xaa, 0x4d, 0xd5,
-	0x52, 0x73, 0xd1, 0x52, 0xf3, 0x70, 0xd1, 0x12, 0xb6, 0x34, 0x7a, 0x9f, 0x8b, 0xa5, 0xd3, 0xd8,
-	0x5b, 0x2c, 0xcd, 0x5e, 0xbe, 0x54, 0xa3, 0xf7, 0x79, 0xf5, 0x11, 0x58, 0x69, 0x23, 0xa8, 0x02,
-	0xd9, 0x31, 0x9d, 0xdb, 0x46, 0xcd, 0x68, 0x58, 0x58, 0x3c, 0xa2, 0x4d, 0xc8, 0xcf, 0x48, 0x30,
-	0xa5, 0xb2, 0x1f, 0x0b, 0xab, 0xe0, 0xa9, 0xf9, 0xd8, 0xa8, 0xff, 0x66, 0xc0, 0x67, 0x7d, 0x32,
-	0xf2, 0x43, 0xc2, 0xfd, 0x28, 0xc4, 0xf4, 0xdd, 0x94, 0x32, 0x8e, 0xb6, 0x21, 0x17, 0x93, 0x11,
-	0x95, 0x5b, 0xe4, 0xdb, 0xc5, 0x93, 0x76, 0xae, 0x6a, 0x36, 0x0c, 0x2c, 0x93, 0xe8, 0x1e, 0x58,
-	0xe2, 0x77, 0xc8, 0xfc, 0x0f, 0x6a, 0xc3, 0x7c, 0xdb, 0x3a, 0x69, 0x17, 0xaa, 0x39, 0xdb, 0x6b,
-	0x18, 0xb8, 0x24, 0x6a, 0x03, 0xff, 0x03, 0x45, 0x5b, 0x50, 0x64, 0x51, 0xc2, 0x87, 0x47, 0x73,
-	0xf9, 0x2d, 0x16, 0x2e, 0x88, 0xb0, 0x3d, 0x47, 0xdb, 0x60, 0xc9, 0x82, 0x47, 0x99, 0x6b, 0xe7,
-	0x6a, 0x46, 0xa3, 0x84, 0x4b, 0x22, 0xd1, 0xa5, 0xcc, 0xad, 0xff, 0x0c, 0x68, 0xb9, 0x1f, 0x16,
-	0x47, 0x21, 0xa3, 0x08, 0x2d, 0x37, 0xa4, 0xfb, 0xd8, 0x5e, 0xeb, 0x63, 0xe9, 0xe5, 0x9b, 0x90,
-	0xe7, 0x11, 0x27, 0x81, 0x7c, 0x75, 0x16, 0xab, 0x00, 0xed, 0x42, 0x59, 0x3e, 0x0c, 0x05, 0x8e,
-	0xc9, 0x77, 0xe7, 0x31, 0xc8, 0x54, 0x5f, 0x64, 0xea, 0xbf, 0x1a, 0x50, 0x78, 0xee, 0x07, 0x9c,
-	0x26, 0x68, 0x07, 0xf2, 0xc7, 0x3e, 0x0d, 0x3c, 0xc5, 0xa3, 0x24, 0x21, 0x31, 0x2b, 0x06, 0x56,
-	0x59, 0xd4, 0x83, 0x52, 0x14, 0xd3, 0x84, 0xf0, 0x28, 0x51, 0xac, 0xb6, 0xbf, 0x3c, 0x69, 0xdf,
-	0x4b, 0xbe, 0xc0, 0x26, 0x7d, 0x87, 0xcd, 0x90, 0x62, 0x73, 0xc4, 0x71, 0x76, 0xc4, 0x29, 0x36,
-	0x03, 0x8e, 0xb3, 0x01, 0xa7, 0x38, 0x17, 0xf8, 0x63, 0x8a, 0x4d, 0x3f, 0xc4, 0xe9, 0x52, 0x74,
-	0x13, 0x0a, 0x52, 0x0c, 0x66, 0x67, 0x6b, 0x59, 0xc1, 0x91, 0x8a, 0xea, 0x4f, 0x20, 0x37, 0x88,
-	0x12, 0x7e, 0x59, 0x17, 0x08, 0x72, 0x92, 0x45, 0x53, 0xb2, 0x28, 0x9f, 0xeb, 0x9b, 0x80, 0xbe,
-	0xa7, 0x24, 0xe0, 0x6f, 0x3b, 0x6f, 0xa9, 0x3b, 0xd6, 0x92, 0xd6, 0x7f, 0x37, 0xe1, 0xf3, 0x33,
-	0x69, 0xcd, 0xec, 0x4d, 0x28, 0x30, 0x4e, 0xf8, 0x94, 0xe9, 0x79, 0xd1, 0x11, 0xb2, 0xa1, 0xc8,
-	0x68, 0x32, 0xf3, 0xdd, 0xc5, 0xd0, 0x2c, 0x42, 0x51, 0x99, 0xd1, 0x84, 0xf9, 0x51, 0xa8, 0x75,
-	0x5d, 0x84, 0xe8, 0x31, 0x58, 0xe9, 0x59, 0x93, 0xe4, 0x5e, 0x32, 0xbf, 0x29, 0x18, 0x39, 0x50,
-	0xf4, 0x28, 0x27, 0x7e, 0xc0, 0xec, 0xbc, 0x3c, 0x6f, 0xad, 0x95, 0xf3, 0x76, 0x4e, 0xeb, 0xcd,
-	0xae, 0x5a, 0xa1, 0x4e, 0xdf, 0x62, 0x7d, 0xf5, 0x29, 0x6c, 0x2c, 0x17, 0xae, 0x74, 0x1a, 0xfe,
-	0x35, 0x61, 0xa3, 0x1b, 0xb9, 0xd3, 0x09, 0x0d, 0xb9, 0x13, 0x1e, 0x47, 0xa8, 0x01, 0x65, 0x4f,
-	0xc7, 0x43, 0x7f, 0x4d, 0x04, 0x58, 0xd4, 0x1c, 0x4f, 0x08, 0xc5, 0x7d, 0x1e, 0xe8, 0x4d, 0x97,
-	0x84, 0x92, 0x59, 0x31, 0xac, 0xc7, 0x7e, 0x40, 0x87, 0x7c, 0x1e, 0x53, 0x4d, 0x5b, 0x49, 0x24,
-	0x0e, 0xe7, 0xf1, 0x69, 0x51, 0x4e, 0x72, 0x4e, 0x0e, 0xac, 0x2c, 0xca, 0x49, 0xbe, 0x03, 0x1b,
-	0x6a, 0x66, 0xdd, 0xb7, 0xd3, 0x70, 0x2c, 0xf8, 0x11, 0x43, 0xab, 0xe6, 0xb8, 0x23, 0x53, 0xe8,
-	0x21, 0x94, 0x26, 0xda, 0x8f, 0xec, 0x82, 0xa4, 0x7d, 0xeb, 0x02, 0xbb, 0xc2, 0x29, 0x70, 0xc5,
-	0xa8, 0x8a, 0x9f, 0x6e, 0x54, 0xa5, 0x2b, 0x18, 0x55, 0xfd, 0xaf, 0x2c, 0x58, 0xb2, 0x6b, 0x49,
-	0x6f, 0x1d, 0x4a, 0xf2, 0xab, 0xce, 0xe1, 0xb6, 0x28, 0x0b, 0x8e, 0xb7, 0x2a, 0x81, 0x79, 0xb1,
-	0x04, 0x36, 0x14, 0xdd, 0x28, 0xe4, 0x34, 0xe4, 0x8b, 0xc1, 0xd4, 0x21, 0xba, 0x0b, 0xd7, 0x19,
-	0x27, 0x09, 0x1f, 0xc6, 0x11, 0xf3, 0x85, 0xb1, 0xe8, 0xa3, 0x7f, 0x4d, 0x66, 0xfb, 0x3a, 0x29,
-	0xa8, 0xa6, 0xa1, 0x77, 0x0a, 0xd2, 0x54, 0xd3, 0xd0, 0x4b, 0x21, 0xbb, 0x50, 0xd6, 0x1d, 0x87,
-	0x1e, 0x7d, 0x2f, 0xd9, 0xce, 0x63, 0x50, 0xbd, 0x8a, 0x0c, 0xda, 0x01, 0x15, 0x29, 0xa5, 0x8b,
-	0xb2, 0x0f, 0x4b, 0x66, 0xa4, 0xd4, 0xd2, 0x81, 0xc6, 0x34, 0x1c, 0xba, 0xd1, 0x34, 0x54, 0xdc,
-	0x49, 0x07, 0x1a, 0xd3, 0xb0, 0x23, 0x32, 0xe8, 0x36, 0x58, 0x74, 0x72, 0x44, 0x3d, 0xcf, 0x0f,
-	0x47, 0xb6, 0x55, 0xcb, 0x36, 0x4c, 0x7c, 0x9a, 0x58, 0x11, 0x0d, 0x3e, 0x5d, 0xb4, 0xf2, 0x55,
-	0x44, 0xfb, 0xc3, 0x80, 0xca, 0xc0, 0x9f, 0xf8, 0x01, 0x49, 0x7c, 0x3e, 0xc7, 0x94, 0x4d, 0x03,
-	0x8e, 0x6e, 0xad, 0x6a, 0x77, 0x2a, 0xd9, 0xee, 0x39, 0x92, 0x9d, 0x51, 0xea, 0x2e, 0xe4, 0x99,
-	0x1b, 0x25, 0xea, 0x24, 0x98, 0xed, 0x1b, 0x27, 0xed, 0x0d, 0x80, 0x9d, 0x4c, 0xe6, 0x97, 0xef,
-	0xbe, 0xce, 0x64, 0x32, 0x19, 0xac, 0xaa, 0xa8, 0x09, 0x79, 0xb9, 0xa5, 0xf6, 0x12, 0x7b, 0x65,
-	0xa8, 0xd3, 0x39, 0xc2, 0x0a, 0x56, 0xff, 0xd3, 0x84, 0x8d, 0x1f, 0xa7, 0x34, 0x99, 0x77, 0x84,
-	0xee, 0xef, 0xa5, 0x7b, 0xbe, 0x13, 0xf1, 0x9a, 0x7b, 0xca, 0xac, 0xd0, 0x8a, 0x51, 0x26, 0xac,
-	0xeb, 0xb4, 0x4d, 0x4b, 0x67, 0x1c, 0x4f, 0x5c, 0x60, 0x53, 0x46, 0x13, 0x51, 0xd3, 0x17, 0x98,
-	0x08, 0x1d, 0x0f, 0xfd, 0x00, 0x10, 0x93, 0x84, 0x4c, 0x28, 0xa7, 0x89, 0xb8, 0x45, 0x84, 0x61,
-	0xdd, 0x5f, 0x69, 0x6e, 0xb9, 0x8f, 0x66, 0x3f, 0x45, 0x2b, 0xb3, 0x5a, 0x5a, 0x7e, 0xd6, 0x34,
-	0xf3, 0x57, 0x30, 0xcd, 0xea, 0x33, 0xb8, 0xb1, 0xb2, 0xf1, 0x95, 0xcc, 0xee, 0x1f, 0x13, 0xe0,
-	0x90, 0xb0, 0xf1, 0x40, 0x19, 0xfe, 0x16, 0x14, 0x39, 0x61, 0x4b, 0x72, 0x16, 0x44, 0xe8, 0x78,
-	0xe8, 0x51, 0x7a, 0x43, 0x88, 0x2d, 0xae, 0x3f, 0xd8, 0x5d, 0xf9, 0xd2, 0x7e, 0x12, 0xb9, 0x82,
-	0xb3, 0x70, 0xa4, 0x76, 0x4a, 0xaf, 0x90, 0xfb, 0x50, 0x8a, 0x93, 0x68, 0x94, 0x50, 0xc6, 0x2e,
-	0x12, 0x3a, 0x05, 0x88, 0xc3, 0x3b, 0xa1, 0x8c, 0x89, 0x4b, 0x3e, 0xa7, 0xa6, 0x49, 0x87, 0x62,
-	0x70, 0xe5, 0x31, 0x55, 0x83, 0xfb, 0x11, 0x0c, 0x69, 0xf4, 0xda, 0xcc, 0x17, 0xae, 0x30, 0xf3,
-	0xe8, 0x19, 0x6c, 0xb8, 0xd1, 0x24, 0x0e, 0xe8, 0x47, 0x1b, 0x64, 0x39, 0xc5, 0xef, 0xf3, 0xaf,
-	0xfe, 0x36, 0xa0, 0xb2, 0x4a, 0x0c, 0xba, 0x03, 0x3b, 0x7d, 0xfc, 0xba, 0xd3, 0x1b, 0x0c, 0x9c,
-	0x83, 0x17, 0xc3, 0xc1, 0xe1, 0xfe, 0xe1, 0x9b, 0xc1, 0xf0, 0xcd, 0xc1, 0xa0, 0xdf, 0xeb, 0x38,
-	0xcf, 0x9d, 0x5e, 0xb7, 0x92, 0x41, 0x3b, 0x70, 0x6b, 0x1d, 0xd2, 0xef, 0x1d, 0x74, 0x9d, 0x83,
-	0x17, 0x15, 0x03, 0xd5, 0xe0, 0xf6, 0x39, 0xe5, 0x34, 0x53, 0x31, 0xd1, 0x2e, 0x6c, 0xaf, 0x23,
-	0x3a, 0xaf, 0x5f, 0xf5, 0x5f, 0xf6, 0x0e, 0x7b, 0xdd, 0x4a, 0x16, 0xdd, 0x06, 0x7b, 0x1d, 0xf0,
-	0x7c, 0xdf, 0x79, 0xd9, 0xeb, 0x56, 0x72, 0x17, 0x2c, 0xdf, 0x3f, 0xe8, 0xf4, 0x5e, 0x0a, 0x40,
-	0xbe, 0x7d, 0xf3, 0xa7, 0xcd, 0x84, 0x8c, 0x5a, 0x24, 0xf6, 0xf5, 0x9f, 0xf0, 0xd6, 0x6c, 0xef,
-	0xdb, 0xd9, 0xde, 0x51, 0x41, 0x32, 0xf2, 0xf0, 0xff, 0x00, 0x00, 0x00, 0xff, 0xff, 0xac, 0x90,
-	0xcf, 0x21, 0x9e, 0x0b, 0x00, 0x00,
+	// 1339 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x57, 0x4b, 0x6f, 0xdb, 0x46,
+	0x10, 0x36, 0xa9, 0x27, 0x47, 0x4e, 0xac, 0x6e, 0x8d, 0x98, 0x91, 0x63, 0x58, 0x11, 0x9a, 0x54,
+	0x6d, 0x50, 0x09, 0x4e, 0x50, 0xe4, 0x51, 0x04, 0x85, 0xf5, 0x48, 0x2a, 0x24, 0x71, 0xd4, 0x95,
+	0x73, 0xe9, 0x45, 0x58, 0x93, 0x6b, 0x85, 0x10, 0x45, 0x32, 0xdc, 0xa5, 0x10, 0x05, 0x3d, 0xf4,
+	0xd4, 0x63, 0x7f, 0x44, 0x81, 0x9e, 0x7a, 0xeb, 0xcf, 0xe9, 0xa5, 0xb7, 0x9e, 0x7b, 0xe9, 0xc5,
+	0xa7, 0x62, 0x1f, 0x94, 0x65, 0xc9, 0x6e, 0xe2, 0x9c, 0xb4, 0xf3, 0xcd, 0xec, 0x72, 0xf6, 0xfb,
+	0x66, 0x66, 0x6d, 0xd8, 0x98, 0xee, 0x35, 0x9d, 0x70, 0x32, 0x09, 0x83, 0x46, 0x14, 0x87, 0x3c,
+	0x44, 0x57, 0x48, 0xe4, 0x35, 0x34, 0x32, 0xdd, 0xab, 0xec, 0x8e, 0xc2, 0x70, 0xe4, 0xd3, 0xa6,
+	0x74, 0x1e, 0x25, 0xc7, 0x4d, 0xee, 0x4d, 0x28, 0xe3, 0x64, 0x12, 0xa9, 0xf8, 0xca, 0xd6, 0x94,
+	0xf8, 0x9e, 0x4b, 0x38, 0x6d, 0xa6, 0x0b, 0xe5, 0xa8, 0xfd, 0x63, 0x40, 0xf1, 0x05, 0xe5, 0xc4,
+	0x25, 0x9c, 0xa0, 0xaf, 0x21, 0x2b, 0x7e, 0x6d, 0xa3, 0x9a, 0xa9, 0x97, 0xee, 0xde, 0x6c, 0x9c,
+	0xf9, 0x48, 0x23, 0x0d, 0x6b, 0x74, 0x08, 0x27, 0xdd, 0x80, 0xc7, 0x33, 0x2c, 0xc3, 0xd1, 0x43,
+	0x00, 0x27, 0xa6, 0x84, 0x53, 0x77, 0x48, 0xb8, 0x6d, 0x56, 0x8d, 0x7a, 0xe9, 0x6e, 0xa5, 0xa1,
+	0x52, 0x6a, 0xa4, 0x29, 0x35, 0x0e, 0xd3, 0x94, 0xb0, 0xa5, 0xa3, 0xf7, 0xb9, 0xd8, 0x9a, 0x44,
+	0x6e, 0xba, 0x35, 0xf3, 0xfe, 0xad, 0x3a, 0x7a, 0x9f, 0x57, 0xee, 0x83, 0x35, 0x4f, 0x04, 0x95,
+	0x21, 0x33, 0xa6, 0x33, 0xdb, 0xa8, 0x1a, 0x75, 0x0b, 0x8b, 0x25, 0xda, 0x84, 0xdc, 0x94, 0xf8,
+	0x09, 0x95, 0xf9, 0x58, 0x58, 0x19, 0x8f, 0xcc, 0x07, 0x46, 0xed, 0x17, 0x03, 0x3e, 0xe9, 0x93,
+	0x91, 0x17, 0x10, 0xee, 0x85, 0x01, 0xa6, 0x6f, 0x12, 0xca, 0x38, 0xda, 0x86, 0x6c, 0x44, 0x46,
+	0x54, 0x1e, 0x91, 0x6b, 0x15, 0x4e, 0x5a, 0xd9, 0x8a, 0x59, 0x37, 0xb0, 0x04, 0xd1, 0x6d, 0xb0,
+	0xc4, 0xef, 0x90, 0x79, 0xef, 0xd4, 0x81, 0xb9, 0x96, 0x75, 0xd2, 0xca, 0x57, 0xb2, 0x75, 0xc3,
+	0x76, 0x71, 0x51, 0xf8, 0x06, 0xde, 0x3b, 0x8a, 0xb6, 0xa0, 0xc0, 0xc2, 0x98, 0x0f, 0x8f, 0x66,
+	0xf2, 0x2e, 0x16, 0xce, 0x0b, 0xb3, 0x35, 0x43, 0xdb, 0x60, 0x49, 0x87, 0x4b, 0x99, 0x63, 0x67,
+	0xab, 0x46, 0xbd, 0x88, 0x8b, 0x02, 0xe8, 0x50, 0xe6, 0xd4, 0x7e, 0x04, 0xb4, 0x98, 0x0f, 0x8b,
+	0xc2, 0x80, 0x51, 0x84, 0x16, 0x13, 0xd2, 0x79, 0x6c, 0xaf, 0xe4, 0xb1, 0xf0, 0xf1, 0x4d, 0xc8,
+	0xf1, 0x90, 0x13, 0x5f, 0x7e, 0x3a, 0x83, 0x95, 0x81, 0x76, 0xa1, 0x24, 0x17, 0x43, 0x11, 0xc7,
+	0xe4, 0xb7, 0x73, 0x18, 0x24, 0xd4, 0x17, 0x48, 0xed, 0x67, 0x03, 0xf2, 0x4f, 0x3c, 0x9f, 0xd3,
+	0x18, 0xed, 0x40, 0xee, 0xd8, 0xa3, 0xbe, 0xab, 0x78, 0x94, 0x24, 0xc4, 0x66, 0xd9, 0xc0, 0x0a,
+	0x45, 0x5d, 0x28, 0x86, 0x11, 0x8d, 0x09, 0x0f, 0x63, 0xc5, 0x6a, 0xeb, 0x8b, 0x93, 0xd6, 0xed,
+	0xf8, 0x33, 0x6c, 0xd2, 0x37, 0xd8, 0x0c, 0x28, 0x36, 0x47, 0x1c, 0x67, 0x46, 0x9c, 0x62, 0xd3,
+	0xe7, 0x38, 0xe3, 0x73, 0x8a, 0xb3, 0xbe, 0x37, 0xa6, 0xd8, 0xf4, 0x02, 0x3c, 0xdf, 0x8a, 0xae,
+	0x41, 0x5e, 0x8a, 0xc1, 0xec, 0x4c, 0x35, 0x23, 0x38, 0x52, 0x56, 0xed, 0x21, 0x64, 0x07, 0x61,
+	0xcc, 0xdf, 0x97, 0x05, 0x82, 0xac, 0x64, 0xd1, 0x94, 0x2c, 0xca, 0x75, 0x6d, 0x13, 0xd0, 0x77,
+	0x94, 0xf8, 0xfc, 0x75, 0xfb, 0x35, 0x75, 0xc6, 0x5a, 0xd2, 0xda, 0xaf, 0x26, 0x7c, 0x7a, 0x06,
+	0xd6, 0xcc, 0x5e, 0x83, 0x3c, 0xe3, 0x84, 0x27, 0x4c, 0xd7, 0x8b, 0xb6, 0x90, 0x0d, 0x05, 0x46,
+	0xe3, 0xa9, 0xe7, 0xa4, 0x45, 0x93, 0x9a, 0xc2, 0x33, 0xa5, 0x31, 0xf3, 0xc2, 0x40, 0xeb, 0x9a,
+	0x9a, 0xe8, 0x01, 0x58, 0xf3, 0x5e, 0xb3, 0xb3, 0xef, 0xaf, 0xdf, 0x79, 0x30, 0xea, 0x41, 0xc1,
+	0xa5, 0x9c, 0x78, 0x3e, 0xb3, 0x73, 0xb2, 0xdf, 0x9a, 0x4b, 0xfd, 0x76, 0x4e, 0xea, 0x8d, 0x8e,
+	0xda, 0xa1, 0xba, 0x2f, 0xdd, 0x5f, 0x79, 0x04, 0xeb, 0x8b, 0x8e, 0x4b, 0x75, 0xc3, 0x5f, 0x26,
+	0xac, 0x77, 0x42, 0x27, 0x99, 0xd0, 0x80, 0xf7, 0x82, 0xe3, 0x10, 0xd5, 0xa1, 0xe4, 0x6a, 0x7b,
+	0xe8, 0xad, 0x88, 0x00, 0xa9, 0xaf, 0xe7, 0x0a, 0xa1, 0xb8, 0xc7, 0x7d, 0x7d, 0xe8, 0x82, 0x50,
+	0x12, 0x15, 0xc5, 0x7a, 0xec, 0xf9, 0x74, 0xc8, 0x67, 0x11, 0xd5, 0xb4, 0x15, 0x05, 0x70, 0x38,
+	0x8b, 0x4e, 0x9d, 0xb2, 0x92, 0xb3, 0xb2, 0x60, 0xa5, 0x53, 0x56, 0xf2, 0x4d, 0x58, 0x57, 0x35,
+	0xeb, 0xbc, 0x4e, 0x82, 0xb1, 0xe0, 0x47, 0x14, 0xad, 0xaa, 0xe3, 0xb6, 0x84, 0xd0, 0x3d, 0x28,
+	0x4e, 0xf4, 0x3c, 0xb2, 0xf3, 0x92, 0xf6, 0xad, 0x0b, 0xc6, 0x15, 0x9e, 0x07, 0x2e, 0x0d, 0xaa,
+	0xc2, 0xc7, 0x0f, 0xaa, 0xe2, 0x25, 0x06, 0x55, 0xed, 0x8f, 0x0c, 0x58, 0x32, 0x6b, 0x49, 0x6f,
+	0x0d, 0x8a, 0xf2, 0x56, 0xe7, 0x70, 0x5b, 0x90, 0x8e, 0x9e, 0xbb, 0x2c, 0x81, 0x79, 0xb1, 0x04,
+	0x36, 0x14, 0x9c, 0x30, 0xe0, 0x34, 0xe0, 0x69, 0x61, 0x6a, 0x13, 0xdd, 0x82, 0xab, 0x8c, 0x93,
+	0x98, 0x0f, 0xa3, 0x90, 0x79, 0x62, 0xb0, 0xe8, 0xd6, 0xbf, 0x22, 0xd1, 0xbe, 0x06, 0x05, 0xd5,
+	0x34, 0x70, 0x4f, 0x83, 0x34, 0xd5, 0x34, 0x70, 0xe7, 0x21, 0xbb, 0x50, 0xd2, 0x19, 0x07, 0x2e,
+	0x7d, 0x2b, 0xd9, 0xce, 0x61, 0x50, 0xb9, 0x0a, 0x04, 0xed, 0x80, 0xb2, 0x94, 0xd2, 0x05, 0x99,
+	0x87, 0x25, 0x11, 0x29, 0xb5, 0x9c, 0x40, 0x63, 0x1a, 0x0c, 0x9d, 0x30, 0x09, 0x14, 0x77, 0x72,
+	0x02, 0x8d, 0x69, 0xd0, 0x16, 0x08, 0xba, 0x01, 0x16, 0x9d, 0x1c, 0x51, 0xd7, 0xf5, 0x82, 0x91,
+	0x6d, 0x55, 0x33, 0x75, 0x13, 0x9f, 0x02, 0x4b, 0xa2, 0xc1, 0xc7, 0x8b, 0x56, 0xba, 0x8c, 0x68,
+	0x7f, 0x1b, 0x50, 0x6c, 0x7b, 0x5c, 0x8e, 0x64, 0xf4, 0x39, 0x6c, 0x38, 0x7a, 0x3d, 0x0c, 0x92,
+	0xc9, 0x11, 0x8d, 0xf5, 0x54, 0xbe, 0x9a, 0xc2, 0x07, 0x12, 0x45, 0xd7, 0x17, 0xc4, 0xd5, 0x23,
+	0x24, 0xd5, 0x74, 0xf7, 0xac, 0xa6, 0x4a, 0xad, 0x45, 0x29, 0x37, 0xd3, 0x6e, 0xca, 0xaa, 0x16,
+	0x95, 0x86, 0xe0, 0x96, 0x85, 0x49, 0xec, 0xd0, 0x61, 0x12, 0xfb, 0x52, 0x1d, 0x0b, 0x5b, 0x0a,
+	0x79, 0x15, 0xfb, 0x42, 0x3e, 0xa5, 0x72, 0x78, 0x7c, 0xcc, 0x28, 0xd7, 0xe2, 0x94, 0x24, 0xf6,
+	0x52, 0x42, 0xe2, 0x04, 0xa1, 0xb0, 0x0e, 0x28, 0xc8, 0x00, 0x8b, 0x06, 0xae, 0x72, 0xd7, 0x7e,
+	0x33, 0xa0, 0x3c, 0xf0, 0x26, 0x9e, 0x4f, 0x62, 0x8f, 0xcf, 0x30, 0x65, 0x89, 0xcf, 0xcf, 0xdc,
+	0xc3, 0xf8, 0xdf, 0x7b, 0x98, 0x2b, 0xf7, 0xb8, 0x05, 0x39, 0xe6, 0x84, 0xb1, 0x6a, 0x79, 0xb3,
+	0xb5, 0x71, 0xd2, 0x5a, 0x07, 0xf8, 0x6a, 0x6d, 0x6d, 0x6d, 0x6d, 0x67, 0x6d, 0xed, 0xa7, 0x6f,
+	0xb1, 0xf2, 0xa2, 0x06, 0xe4, 0xe4, 0x91, 0x7a, 0x68, 0xda, 0x4b, 0xdd, 0x3b, 0x6f, 0x18, 0xac,
+	0xc2, 0x6a, 0xbf, 0x9b, 0xb0, 0xfe, 0x7d, 0x42, 0xe3, 0x59, 0x5b, 0x14, 0xf8, 0x5b, 0xf9, 0x4c,
+	0xbc, 0x11, 0xf6, 0xca, 0x33, 0x21, 0x51, 0x49, 0x1c, 0x65, 0x62, 0x46, 0x9f, 0xa6, 0x69, 0x69,
+	0xa4, 0xe7, 0x8a, 0x97, 0x3a, 0x61, 0x34, 0x3e, 0x95, 0x22, 0x2f, 0xcc, 0x9e, 0x8b, 0x9e, 0x01,
+	0x44, 0x24, 0x26, 0x13, 0xca, 0x69, 0x2c, 0x9e, 0x4b, 0x31, 0x99, 0xef, 0x2c, 0x25, 0xb7, 0x98,
+	0x47, 0xa3, 0x3f, 0x8f, 0x56, 0x53, 0x79, 0x61, 0xfb, 0xd9, 0xd7, 0x21, 0x77, 0x89, 0xd7, 0xa1,
+	0xf2, 0x18, 0x36, 0x96, 0x0e, 0xbe, 0xd4, 0x54, 0xff, 0xd3, 0x04, 0x38, 0x24, 0x6c, 0x3c, 0x50,
+	0x2f, 0xdb, 0x16, 0x14, 0x38, 0x61, 0x0b, 0x72, 0xe6, 0x85, 0xd9, 0x73, 0xd1, 0xfd, 0xf9, 0x53,
+	0x28, 0x8e, 0xb8, 0x7a, 0x77, 0x77, 0xe9, 0xa6, 0xfd, 0x38, 0x74, 0x04, 0x67, 0xc1, 0x48, 0x9d,
+	0x34, 0x7f, 0x2b, 0xef, 0x40, 0x31, 0x8a, 0xc3, 0x51, 0x4c, 0x19, 0xbb, 0x48, 0xe8, 0x79, 0x80,
+	0x98, 0x52, 0x13, 0xca, 0x98, 0xf8, 0x6b, 0x46, 0x15, 0x77, 0x6a, 0x8a, 0x0e, 0x95, 0xb5, 0xaa,
+	0x3a, 0xf4, 0x03, 0x18, 0xd2, 0xd1, 0x2b, 0xcd, 0x9d, 0xbf, 0x44, 0x73, 0xa3, 0xc7, 0xb0, 0xee,
+	0x84, 0x93, 0xc8, 0xa7, 0x1f, 0xfc, 0x12, 0x94, 0xe6, 0xf1, 0xfb, 0xfc, 0xcb, 0x7f, 0x0d, 0x28,
+	0x2f, 0x13, 0x83, 0x6e, 0xc2, 0x4e, 0x1f, 0xbf, 0x6c, 0x77, 0x07, 0x83, 0xde, 0xc1, 0xd3, 0xe1,
+	0xe0, 0x70, 0xff, 0xf0, 0xd5, 0x60, 0xf8, 0xea, 0x60, 0xd0, 0xef, 0xb6, 0x7b, 0x4f, 0x7a, 0xdd,
+	0x4e, 0x79, 0x0d, 0xed, 0xc0, 0xf5, 0xd5, 0x90, 0x7e, 0xf7, 0xa0, 0xd3, 0x3b, 0x78, 0x5a, 0x36,
+	0x50, 0x15, 0x6e, 0x9c, 0xe3, 0x9e, 0x23, 0x65, 0x13, 0xed, 0xc2, 0xf6, 0x6a, 0x44, 0xfb, 0xe5,
+	0x8b, 0xfe, 0xf3, 0xee, 0x61, 0xb7, 0x53, 0xce, 0xa0, 0x1b, 0x60, 0xaf, 0x06, 0x3c, 0xd9, 0xef,
+	0x3d, 0xef, 0x76, 0xca, 0xd9, 0x0b, 0xb6, 0xef, 0x1f, 0xb4, 0xbb, 0xcf, 0x45, 0x40, 0xee, 0xfc,
+	0x04, 0x07, 0xcf, 0x7a, 0xfd, 0x7e, 0xb7, 0x53, 0xce, 0xb7, 0xae, 0xfd, 0xb0, 0x19, 0x93, 0x51,
+	0x93, 0x44, 0x9e, 0xfe, 0x67, 0xa4, 0x39, 0xdd, 0xfb, 0x66, 0xba, 0x77, 0x94, 0x97, 0x84, 0xdd,
+	0xfb, 0x6f, 0x00, 0x25, 0x79, 0x1f, 0x17, 0xa6, 0x0c, 0x00, 0x00,
 }
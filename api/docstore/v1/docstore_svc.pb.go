@@ -1230,6 +1230,7 @@ type SearchHybridRequest struct {
 	QueryText            string               `protobuf:"bytes,1,opt,name=query_text,json=queryText,proto3" json:"query_text,omitempty"`
 	Options              *HybridSearchOptions `protobuf:"bytes,2,opt,name=options,proto3" json:"options,omitempty"`
 	Filters              []*v1.Filter         `protobuf:"bytes,3,rep,name=filters,proto3" json:"filters,omitempty"`
+	QueryEmbedding       *QueryEmbedding      `protobuf:"bytes,4,opt,name=query_embedding,json=queryEmbedding,proto3" json:"query_embedding,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
 	XXX_unrecognized     []byte               `json:"-"`
 	XXX_sizecache        int32                `json:"-"`
@@ -1281,6 +1282,13 @@ func (m *SearchHybridRequest) GetFilters() []*v1.Filter {
 	return nil
 }
 
+func (m *SearchHybridRequest) GetQueryEmbedding() *QueryEmbedding {
+	if m != nil {
+		return m.QueryEmbedding
+	}
+	return nil
+}
+
 type HybridSearchOptions struct {
 	TopK                 int32                 `protobuf:"varint,1,opt,name=top_k,json=topK,proto3" json:"top_k,omitempty"`
 	VectorWeight         float32               `protobuf:"fixed32,2,opt,name=vector_weight,json=vectorWeight,proto3" json:"vector_weight,omitempty"`
@@ -3370,281 +3378,281 @@ func init() {
 }
 
 var fileDescriptor_cdc8e776ea830228 = []byte{
-	// 4409 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x3b, 0x4b, 0x8f, 0x1b, 0x47,
-	0x7a, 0x6a, 0x72, 0x9e, 0x1f, 0x9f, 0x53, 0x9c, 0x07, 0x97, 0xb6, 0x2c, 0xa9, 0xa5, 0xb1, 0x46,
-	0xb2, 0xcd, 0xb1, 0xe4, 0x5d, 0xaf, 0xb4, 0x1b, 0x3f, 0x86, 0xa3, 0x67, 0x1c, 0xd9, 0x72, 0xcf,
-	0xc8, 0x06, 0x16, 0xd8, 0x30, 0x3d, 0xcd, 0x22, 0xa7, 0xa3, 0x66, 0x37, 0xd5, 0x5d, 0x1c, 0x89,
-	0x32, 0x9c, 0x18, 0x39, 0xe4, 0x10, 0x04, 0xc9, 0xc2, 0x49, 0x16, 0xb9, 0x05, 0x01, 0x72, 0xc9,
-	0x25, 0xa7, 0xbd, 0x18, 0xb9, 0x6c, 0x1e, 0x3f, 0x20, 0x41, 0xb0, 0xc7, 0x24, 0x97, 0x45, 0xb2,
-	0x7f, 0x21, 0xca, 0x03, 0x41, 0xd5, 0x57, 0xd5, 0x6f, 0x0e, 0x67, 0x6c, 0xec, 0x6d, 0x6f, 0xec,
-	0xef, 0xfb, 0xea, 0xab, 0xaa, 0xaf, 0xbe, 0x77, 0x15, 0x61, 0xed, 0xe8, 0xda, 0x76, 0xcf, 0xb3,
-	0x02, 0xe6, 0xf9, 0xb4, 0x1b, 0x1c, 0x59, 0xed, 0x91, 0xef, 0x31, 0x8f, 0xd4, 0xcc, 0x91, 0xdd,
-	0x56, 0xf0, 0xf6, 0xd1, 0xb5, 0xd6, 0xcb, 0x03, 0xcf, 0x1b, 0x38, 0x74, 0xdb, 0x1c, 0xd9, 0xdb,
-	0xa6, 0xeb, 0x7a, 0xcc, 0x64, 0xb6, 0xe7, 0x06, 0x48, 0xde, 0x7a, 0x49, 0x62, 0xc5, 0xd7, 0xc1,
-	0xb8, 0xbf, 0x4d, 0x87, 0x23, 0x36, 0x91, 0xc8, 0x73, 0x69, 0x24, 0xb3, 0x87, 0x34, 0x60, 0xe6,
-	0x70, 0x24, 0x09, 0x5a, 0x9c, 0xa9, 0xe5, 0x0d, 0x87, 0x9e, 0xbb, 0x7d, 0x74, 0x4d, 0xfe, 0xca,
-	0xc7, 0x51, 0xdf, 0xf7, 0x7c, 0x35, 0xeb, 0xc6, 0x91, 0xe9, 0xd8, 0x3d, 0x93, 0xd1, 0x6d, 0xf5,
-	0x03, 0x11, 0xfa, 0x16, 0x54, 0x3f, 0x1e, 0x53, 0x7f, 0x72, 0x7b, 0x78, 0x40, 0x7b, 0x3d, 0xdb,
-	0x1d, 0x90, 0x75, 0x58, 0x38, 0x32, 0x9d, 0x31, 0x0d, 0x9a, 0xda, 0xf9, 0xe2, 0x56, 0xc1, 0x90,
-	0x5f, 0xfa, 0x9f, 0x15, 0x60, 0xed, 0xd1, 0xc8, 0xf1, 0xcc, 0xde, 0x2d, 0xcf, 0x1a, 0x0f, 0xa9,
-	0xcb, 0x0c, 0xfa, 0x64, 0x4c, 0x03, 0x46, 0xae, 0x42, 0xb9, 0x6f, 0x3b, 0xb4, 0x6b, 0x79, 0x2e,
-	0xa3, 0x2e, 0x6b, 0x6a, 0xe7, 0xb5, 0xad, 0x72, 0x67, 0xf1, 0x45, 0x67, 0xee, 0x79, 0xa1, 0xae,
-	0x19, 0x25, 0x8e, 0xdc, 0x45, 0x1c, 0x39, 0x0b, 0xf3, 0xcc, 0x66, 0x0e, 0x6d, 0x16, 0xce, 0x6b,
-	0x5b, 0xcb, 0x82, 0xc8, 0xe7, 0x44, 0x08, 0x25, 0x97, 0x60, 0x59, 0xb0, 0x62, 0x93, 0x11, 0x6d,
-	0x16, 0x93, 0x24, 0x4b, 0x1c, 0xb3, 0x3f, 0x19, 0x51, 0xf2, 0x16, 0x2c, 0x0d, 0x29, 0x33, 0x7b,
-	0x26, 0x33, 0x9b, 0x73, 0xe7, 0xb5, 0xad, 0xd2, 0xf5, 0x8d, 0x36, 0x3f, 0x05, 0x29, 0x8e, 0xa3,
-	0x6b, 0xed, 0x07, 0x12, 0x6d, 0x84, 0x84, 0xe4, 0x13, 0x58, 0x19, 0xf9, 0x9e, 0x45, 0x83, 0xc0,
-	0x76, 0x07, 0x7c, 0xad, 0x7d, 0x7b, 0xd0, 0x9c, 0x17, 0xa3, 0xaf, 0xb4, 0x53, 0x67, 0xd8, 0x56,
-	0x5b, 0x7c, 0x18, 0x8e, 0xd8, 0x15, 0x03, 0x8c, 0xfa, 0x28, 0x05, 0xd1, 0xff, 0xb3, 0x08, 0xcd,
-	0x69, 0xe4, 0xe4, 0x43, 0x58, 0xb1, 0x0e, 0xc7, 0xee, 0x63, 0x3e, 0x65, 0xc0, 0x7c, 0x93, 0xd1,
-	0xc1, 0x44, 0xc8, 0xa7, 0x74, 0xfd, 0x42, 0x66, 0xd2, 0x5d, 0x49, 0xb9, 0x27, 0x09, 0x8d, 0xba,
-	0x95, 0x82, 0x90, 0x0f, 0xa0, 0x4e, 0xd5, 0x49, 0xa9, 0x3d, 0x14, 0x04, 0xbb, 0xf3, 0x19, 0x76,
-	0xe1, 0x91, 0xca, 0xa5, 0xd7, 0x68, 0x12, 0x40, 0xee, 0x41, 0xcd, 0x76, 0x7b, 0xf4, 0x59, 0x8c,
-	0x57, 0x51, 0xf0, 0x3a, 0x97, 0xe1, 0x75, 0x5f, 0xd2, 0x49, 0x56, 0x55, 0x3b, 0xf1, 0x4d, 0xce,
-	0x02, 0x50, 0xd7, 0x3c, 0x70, 0x68, 0xd7, 0xb3, 0x7c, 0x71, 0x24, 0x4b, 0xc6, 0x32, 0x42, 0x3e,
-	0xb2, 0x7c, 0xd2, 0x82, 0x25, 0xc7, 0x74, 0x07, 0x63, 0x73, 0x40, 0x85, 0xc4, 0x97, 0x8d, 0xf0,
-	0x9b, 0xf4, 0xa1, 0x66, 0x8d, 0x03, 0xe6, 0x0d, 0xbb, 0x01, 0x65, 0xcc, 0x76, 0x07, 0x41, 0x73,
-	0xe1, 0x7c, 0x71, 0xab, 0x74, 0xfd, 0x9d, 0x13, 0x1f, 0x4a, 0x7b, 0x57, 0x30, 0xd8, 0x93, 0xe3,
-	0x6f, 0xbb, 0xcc, 0x9f, 0x18, 0x55, 0x2b, 0x01, 0x6c, 0xed, 0x40, 0x23, 0x87, 0x8c, 0xd4, 0xa1,
-	0xf8, 0x98, 0xe2, 0x91, 0x2c, 0x1b, 0xfc, 0x27, 0x59, 0x85, 0x79, 0xa1, 0xf1, 0xa8, 0xa1, 0x06,
-	0x7e, 0x7c, 0xaf, 0x70, 0x43, 0xd3, 0xff, 0xab, 0x00, 0xf5, 0xf4, 0x19, 0x91, 0x8b, 0x50, 0x51,
-	0x07, 0x8b, 0x5a, 0x8b, 0xac, 0xca, 0x0a, 0x28, 0x14, 0xf6, 0x0a, 0x80, 0x38, 0xca, 0x6e, 0x60,
-	0x3f, 0x47, 0xc6, 0xf3, 0x1d, 0x78, 0xd1, 0x59, 0x6c, 0xcd, 0x37, 0xbf, 0x38, 0xbf, 0xd5, 0x33,
-	0x96, 0x05, 0x76, 0xcf, 0x7e, 0x4e, 0xc9, 0x36, 0x54, 0x90, 0xd4, 0x3b, 0xa2, 0xbe, 0x63, 0x8e,
-	0xc4, 0x91, 0x84, 0xd4, 0xbf, 0x58, 0xdc, 0x3a, 0x63, 0x94, 0x05, 0xc1, 0x47, 0x88, 0x27, 0xd7,
-	0x60, 0x35, 0xb0, 0x87, 0xb6, 0x63, 0xfa, 0x36, 0x9b, 0x74, 0xd9, 0xa1, 0x4f, 0x83, 0x43, 0xcf,
-	0xe9, 0x89, 0x53, 0x28, 0x18, 0x8d, 0x08, 0xb7, 0xaf, 0x50, 0xe4, 0xb7, 0xa1, 0x11, 0xae, 0x79,
-	0x64, 0xfa, 0xe6, 0x90, 0x32, 0xea, 0x07, 0xcd, 0x79, 0x21, 0xf7, 0x9b, 0x33, 0xf5, 0xb2, 0xad,
-	0x7e, 0x3c, 0x0c, 0xc7, 0xa2, 0xcc, 0x49, 0x90, 0x41, 0xb4, 0x6e, 0xc3, 0xc6, 0x14, 0xf2, 0x53,
-	0xc9, 0xfe, 0x27, 0x05, 0xa8, 0xa5, 0x14, 0x9a, 0x6b, 0xdd, 0xd0, 0xeb, 0x51, 0xa7, 0xeb, 0x9a,
-	0x43, 0x25, 0xf7, 0x65, 0x01, 0xf9, 0xd0, 0x1c, 0x52, 0x2e, 0x18, 0xd7, 0xf3, 0x87, 0xa6, 0x63,
-	0x3f, 0xa7, 0xdd, 0x50, 0xf7, 0x03, 0xc1, 0x7b, 0xc9, 0x68, 0x84, 0xb8, 0x90, 0x6d, 0x40, 0xae,
-	0x40, 0x7d, 0xe4, 0x79, 0x4e, 0xc2, 0x5a, 0x85, 0x17, 0x32, 0x6a, 0x12, 0x1e, 0x9e, 0xfb, 0x6f,
-	0x41, 0x1d, 0x27, 0x8f, 0x09, 0x70, 0x4e, 0x08, 0xf0, 0x3b, 0xb3, 0x2c, 0xb1, 0xfd, 0x80, 0x0f,
-	0x4c, 0x0b, 0xaf, 0x36, 0x4c, 0x42, 0x5b, 0x1d, 0x58, 0xcd, 0x23, 0x3c, 0x95, 0xd8, 0xfe, 0x57,
-	0x83, 0x6a, 0xd2, 0x76, 0xc9, 0x39, 0x28, 0x09, 0xeb, 0x15, 0xda, 0x8a, 0x4e, 0x7e, 0xd9, 0x00,
-	0x01, 0xe2, 0xba, 0x1a, 0x90, 0xbb, 0x50, 0x39, 0xa2, 0x16, 0xf3, 0xfc, 0xa4, 0x83, 0xd1, 0x33,
-	0xdb, 0xfa, 0x44, 0x50, 0x09, 0xf6, 0xd2, 0x2f, 0x94, 0x71, 0xa0, 0x9c, 0xe9, 0x01, 0xd4, 0xfa,
-	0x63, 0xc7, 0x61, 0xf4, 0x19, 0x4b, 0xfa, 0x97, 0x4b, 0x19, 0x56, 0x77, 0xc6, 0x8e, 0xb3, 0x4f,
-	0x9f, 0xb1, 0x38, 0xb3, 0xaa, 0x1a, 0x2c, 0xd9, 0x5d, 0x87, 0x35, 0xe9, 0x64, 0xcc, 0x60, 0xe2,
-	0x5a, 0x5d, 0xe5, 0x83, 0xa4, 0xbf, 0x69, 0x20, 0x72, 0x87, 0xe3, 0xd4, 0x96, 0xf5, 0x2f, 0x0b,
-	0xb0, 0x92, 0x59, 0x26, 0x57, 0x9c, 0x48, 0x04, 0x4a, 0x71, 0x42, 0x09, 0x70, 0x71, 0xba, 0x8e,
-	0x1d, 0x30, 0x34, 0x54, 0x03, 0x3f, 0x48, 0x19, 0xb4, 0x21, 0x1a, 0xa3, 0xa1, 0x0d, 0xc9, 0x65,
-	0xa8, 0xd1, 0x3e, 0xdf, 0x55, 0xc0, 0xfc, 0xb1, 0xc5, 0x03, 0xbc, 0x58, 0xc6, 0xbc, 0x51, 0xa5,
-	0xfd, 0xdd, 0x18, 0x94, 0x18, 0x00, 0x19, 0x13, 0xbb, 0x3e, 0x5b, 0x94, 0xed, 0xb4, 0x7a, 0xc4,
-	0xb8, 0xb4, 0xde, 0x81, 0xda, 0x37, 0x51, 0x8a, 0xaf, 0x0a, 0xd0, 0xc8, 0x11, 0x38, 0x79, 0x19,
-	0x96, 0x4d, 0xd7, 0x74, 0x26, 0xcf, 0xf9, 0x4a, 0x51, 0x2f, 0x22, 0x00, 0x17, 0x5a, 0xc0, 0xbc,
-	0x51, 0xf7, 0xa9, 0xe7, 0xf7, 0xb8, 0x11, 0x09, 0x34, 0x87, 0x7c, 0xca, 0x01, 0x42, 0x20, 0x78,
-	0x3a, 0x01, 0xa3, 0xc3, 0x21, 0x3f, 0x97, 0xa2, 0x38, 0x97, 0x2a, 0x82, 0xf7, 0x24, 0x34, 0x4e,
-	0x38, 0x71, 0x3d, 0x77, 0x32, 0x0c, 0xe4, 0x01, 0x2a, 0x42, 0x09, 0x25, 0xfb, 0x39, 0x92, 0xfb,
-	0xf6, 0x49, 0x34, 0xe7, 0x97, 0x29, 0xbb, 0x3f, 0x2c, 0xc0, 0x7a, 0x3a, 0x0b, 0x0a, 0x46, 0x9e,
-	0x1b, 0x50, 0x6e, 0x58, 0x3d, 0x09, 0xeb, 0xda, 0x3d, 0xc9, 0x0e, 0x14, 0xe8, 0x7e, 0x8f, 0x7c,
-	0x17, 0x16, 0x02, 0x66, 0xb2, 0x31, 0xba, 0xa0, 0xaa, 0x0c, 0xb3, 0x51, 0xd2, 0x12, 0xc5, 0xb5,
-	0x3d, 0x41, 0x66, 0x48, 0xf2, 0x54, 0xea, 0xe2, 0xd3, 0x60, 0xec, 0x30, 0x69, 0x4a, 0x27, 0x49,
-	0x5d, 0x0c, 0x31, 0x20, 0x9e, 0xba, 0x20, 0x84, 0xbc, 0x0f, 0x95, 0x68, 0xc5, 0x6e, 0xdf, 0x93,
-	0xc9, 0xd4, 0x4b, 0xa9, 0x75, 0x29, 0x8e, 0xf7, 0xdd, 0xbe, 0x67, 0x94, 0x7b, 0xb1, 0x2f, 0xfd,
-	0x9f, 0x73, 0x93, 0x1f, 0xc9, 0xfe, 0x4d, 0x58, 0x65, 0x1e, 0x33, 0x9d, 0xae, 0x88, 0x57, 0x41,
-	0xd7, 0xf2, 0xa9, 0xc9, 0x28, 0x4a, 0x66, 0xde, 0x20, 0x02, 0x27, 0x62, 0x4b, 0xb0, 0x8b, 0x18,
-	0xee, 0xb2, 0x23, 0x47, 0xdd, 0x1d, 0x50, 0x97, 0xfa, 0x62, 0x04, 0x1a, 0x62, 0x23, 0xc2, 0xdd,
-	0x55, 0x28, 0xae, 0x4e, 0xc2, 0x72, 0x69, 0xc4, 0x1f, 0x8d, 0xb4, 0x2a, 0xc1, 0x8a, 0xf7, 0xeb,
-	0x40, 0x62, 0x42, 0xe4, 0x89, 0x75, 0x57, 0xaa, 0x5e, 0x31, 0x2e, 0x9a, 0x7d, 0x7b, 0x48, 0x1f,
-	0x04, 0xe4, 0x5d, 0x58, 0x7a, 0x6a, 0xfa, 0xae, 0x08, 0x18, 0xa8, 0x7a, 0x59, 0xff, 0x17, 0x6d,
-	0xf8, 0x53, 0x24, 0x35, 0xc2, 0x31, 0xc4, 0x87, 0x46, 0x6c, 0xb6, 0x30, 0x5b, 0xc5, 0xd4, 0x66,
-	0xe7, 0xc4, 0x87, 0x16, 0x9b, 0x43, 0xa5, 0xb4, 0x32, 0xd4, 0x8e, 0x32, 0x08, 0x1e, 0x6a, 0xa7,
-	0x90, 0x9f, 0x4a, 0xc5, 0x47, 0xb0, 0x92, 0xd9, 0x19, 0xb9, 0x00, 0x65, 0xb9, 0xb7, 0xb8, 0xd3,
-	0x2c, 0x49, 0x98, 0x70, 0x9b, 0x4d, 0x58, 0x1c, 0xd2, 0x20, 0xe0, 0x49, 0x1e, 0xf2, 0x54, 0x9f,
-	0xe4, 0x15, 0x80, 0x60, 0x3c, 0x18, 0xd0, 0x40, 0xf8, 0x49, 0x0c, 0xa8, 0x31, 0x88, 0xfe, 0xf3,
-	0x02, 0xb4, 0x92, 0x46, 0x25, 0xbc, 0xf8, 0xaf, 0xea, 0x0b, 0xee, 0x95, 0x2f, 0x40, 0xd9, 0x32,
-	0x1d, 0xe7, 0xc0, 0xb4, 0x1e, 0x77, 0xc7, 0xbe, 0xd3, 0x5c, 0x40, 0xc9, 0x2b, 0xd8, 0x23, 0xdf,
-	0x21, 0x9b, 0xb0, 0x34, 0xf2, 0x6d, 0x8f, 0x27, 0x79, 0xcd, 0x45, 0x91, 0x2e, 0x2e, 0xbf, 0xe8,
-	0x2c, 0xb4, 0xe6, 0x9a, 0xb0, 0xa5, 0x19, 0x21, 0x4a, 0xff, 0x71, 0x01, 0x5e, 0xca, 0x15, 0xb3,
-	0x74, 0x60, 0x1b, 0xb0, 0xc8, 0xcc, 0xe0, 0x71, 0xe4, 0xbc, 0x16, 0xf8, 0xe7, 0xfd, 0x5e, 0xda,
-	0xb3, 0x15, 0x8e, 0xf1, 0x6c, 0xc5, 0xd3, 0x79, 0xb6, 0xfb, 0x70, 0x81, 0xeb, 0xc0, 0x90, 0x5b,
-	0x68, 0x37, 0x6d, 0x9e, 0x01, 0xb5, 0x3c, 0xb7, 0x17, 0xc8, 0xc0, 0xfa, 0x4a, 0x48, 0xf8, 0x30,
-	0x61, 0xac, 0x7b, 0x48, 0x45, 0x6e, 0x02, 0x48, 0x07, 0xd0, 0x35, 0x99, 0x14, 0x7c, 0xab, 0x8d,
-	0x05, 0x75, 0x5b, 0x15, 0xd4, 0xed, 0x7d, 0x55, 0x50, 0x1b, 0xcb, 0x92, 0x7a, 0x87, 0xe9, 0xff,
-	0xa7, 0xc1, 0xea, 0x1e, 0x35, 0x7d, 0xeb, 0x70, 0x0f, 0xb3, 0x65, 0xa5, 0x79, 0xe7, 0x00, 0x9e,
-	0xf0, 0xea, 0xb8, 0xcb, 0xd3, 0x10, 0x14, 0xca, 0xbd, 0x33, 0xc6, 0xb2, 0x80, 0xf1, 0xa0, 0x43,
-	0x7e, 0x1d, 0x6a, 0x48, 0x10, 0xba, 0x26, 0x99, 0x2d, 0x65, 0x4b, 0xa8, 0x64, 0x99, 0x7d, 0xef,
-	0x8c, 0x51, 0x7d, 0x92, 0x2c, 0xbc, 0x6f, 0xc0, 0xa2, 0x37, 0x12, 0xad, 0x02, 0xe9, 0xdb, 0x5f,
-	0xc9, 0xf0, 0xc0, 0x45, 0x7e, 0x84, 0x54, 0x86, 0x22, 0x27, 0xdb, 0xb0, 0xd8, 0xb7, 0x9d, 0x58,
-	0x0a, 0xba, 0x96, 0x92, 0xff, 0x1d, 0x81, 0x35, 0x14, 0x55, 0xa7, 0x0a, 0x65, 0x5c, 0x76, 0xe0,
-	0x8d, 0x7d, 0x8b, 0xea, 0xbf, 0x28, 0x40, 0x25, 0xc1, 0x9b, 0x9c, 0x83, 0x79, 0x1e, 0xec, 0x1f,
-	0xa3, 0xb3, 0x8e, 0x95, 0x1f, 0x9a, 0x31, 0xc7, 0xbc, 0xd1, 0x07, 0xa4, 0x33, 0xa5, 0xec, 0xe0,
-	0xdb, 0x2f, 0x74, 0x6a, 0x2f, 0x3a, 0x65, 0x80, 0xb3, 0x67, 0xce, 0x7c, 0xf1, 0xde, 0x1b, 0x67,
-	0xce, 0x9c, 0x39, 0x93, 0x5f, 0x87, 0xbc, 0x06, 0x2b, 0x31, 0x1e, 0x43, 0xca, 0x7c, 0xdb, 0x92,
-	0xee, 0xa1, 0x1e, 0x21, 0x1e, 0x08, 0x38, 0xb7, 0x83, 0x98, 0x12, 0xe2, 0x4e, 0x97, 0x8d, 0x52,
-	0xa4, 0x85, 0x7c, 0xd1, 0x25, 0xac, 0x9d, 0x30, 0xb5, 0x9d, 0xc7, 0xd4, 0x56, 0x80, 0x30, 0xb5,
-	0xbd, 0x02, 0x75, 0xdb, 0xb5, 0x9c, 0x71, 0x8f, 0xc6, 0x5d, 0x32, 0x4f, 0x3e, 0x6a, 0x12, 0xae,
-	0x0c, 0x9b, 0xbc, 0x01, 0x44, 0x91, 0xc6, 0x6a, 0x87, 0x45, 0x41, 0xbc, 0x22, 0x31, 0xb1, 0xca,
-	0x81, 0x67, 0x35, 0x61, 0x61, 0x2e, 0x32, 0xf9, 0xe6, 0x92, 0xd8, 0x48, 0x35, 0x04, 0x8b, 0x64,
-	0x5e, 0xff, 0x63, 0x0d, 0xd6, 0x52, 0xba, 0x26, 0xcd, 0xef, 0x26, 0x2c, 0x62, 0x68, 0xc7, 0xe4,
-	0xab, 0x94, 0xb1, 0xa2, 0xbd, 0x50, 0x24, 0x32, 0xa2, 0x2b, 0x7a, 0xf2, 0xfd, 0x98, 0xc3, 0x9a,
-	0xa6, 0x7f, 0x38, 0x69, 0xd6, 0x71, 0xe9, 0xff, 0x5a, 0x80, 0x6a, 0x12, 0x49, 0x36, 0xa1, 0x8a,
-	0x91, 0x3b, 0x10, 0xf0, 0x30, 0x66, 0x57, 0x04, 0x74, 0x4f, 0x02, 0x23, 0x32, 0x9f, 0xb2, 0xb1,
-	0xef, 0x86, 0x81, 0x1a, 0xc9, 0x0c, 0x09, 0x24, 0x97, 0xa0, 0x8a, 0x7c, 0xc2, 0xa8, 0x5b, 0x14,
-	0x51, 0xb7, 0x8c, 0x50, 0x19, 0x71, 0xc3, 0x6a, 0x6e, 0x1c, 0x50, 0xac, 0x5e, 0x55, 0x35, 0xf7,
-	0x28, 0xa0, 0x53, 0x74, 0x65, 0x7e, 0x8a, 0xae, 0x3c, 0x00, 0xe8, 0xd1, 0x83, 0xf1, 0x00, 0xb3,
-	0x1a, 0x0c, 0xba, 0xed, 0x19, 0x12, 0x69, 0xdf, 0xe2, 0x23, 0x78, 0x56, 0x83, 0x11, 0x76, 0xb9,
-	0xa7, 0xbe, 0x5b, 0xbf, 0x06, 0xd5, 0x24, 0xf2, 0x54, 0xf1, 0xf4, 0x6f, 0x34, 0x68, 0xe0, 0x54,
-	0xf7, 0x26, 0x07, 0xbe, 0xdd, 0x53, 0xce, 0xe5, 0xd5, 0xac, 0x73, 0x89, 0x82, 0x51, 0xcc, 0xc7,
-	0xbc, 0x1b, 0xf9, 0x85, 0xc2, 0x94, 0xf2, 0x09, 0x19, 0xcf, 0xf6, 0x0e, 0xc5, 0x93, 0x78, 0x07,
-	0xfd, 0xab, 0x22, 0x34, 0x72, 0x38, 0xce, 0xf6, 0x09, 0xdf, 0x0e, 0x2b, 0xc7, 0xa7, 0xd4, 0x1e,
-	0x1c, 0xb2, 0x69, 0xce, 0x40, 0x96, 0x89, 0x9f, 0x0a, 0x22, 0x72, 0x23, 0x56, 0x26, 0xca, 0x71,
-	0xc5, 0xfc, 0x71, 0x61, 0x45, 0x28, 0x47, 0xde, 0x4b, 0x57, 0xaa, 0x18, 0xac, 0x2f, 0x4e, 0x29,
-	0xaf, 0x70, 0x37, 0xb9, 0xa5, 0xea, 0x87, 0xd9, 0x52, 0x15, 0x23, 0xc8, 0xe6, 0xd4, 0x82, 0x23,
-	0xc1, 0x2d, 0x5d, 0xab, 0x5e, 0x84, 0x4a, 0x7f, 0x1c, 0xd8, 0x9e, 0xcb, 0x35, 0xf5, 0xd0, 0xeb,
-	0xc9, 0xa8, 0x5d, 0x46, 0xe0, 0x03, 0x01, 0xcb, 0xf5, 0x46, 0x8b, 0xf9, 0xde, 0xe8, 0xc4, 0xee,
-	0xe5, 0x2f, 0x34, 0x20, 0xd9, 0xdd, 0x4e, 0x6d, 0x12, 0x69, 0xd3, 0x9b, 0x44, 0xb9, 0x06, 0x57,
-	0x98, 0x62, 0x70, 0x57, 0xa0, 0x1e, 0xf5, 0x5a, 0x02, 0xcb, 0xf3, 0x69, 0x20, 0xcb, 0xbf, 0x5a,
-	0x08, 0xdf, 0x13, 0x60, 0xfd, 0x67, 0x1a, 0xac, 0xe6, 0xc9, 0x90, 0xb4, 0x60, 0x49, 0x55, 0x9b,
-	0xd2, 0xb0, 0xc2, 0x6f, 0xb2, 0x0e, 0x0b, 0x7d, 0x9b, 0x3a, 0x61, 0xe1, 0x29, 0xbf, 0x48, 0x1b,
-	0x64, 0xd9, 0xdf, 0xed, 0x8f, 0x9f, 0x3f, 0x9f, 0x48, 0x7f, 0x25, 0xa7, 0x5e, 0x41, 0xd4, 0x1d,
-	0x8e, 0xc1, 0x99, 0xb8, 0x1c, 0x91, 0x30, 0xdd, 0x27, 0xab, 0x0a, 0x70, 0xb4, 0xfb, 0x37, 0x61,
-	0x55, 0x32, 0x1e, 0x1d, 0xfa, 0x66, 0x40, 0x15, 0xe7, 0x79, 0xc1, 0x99, 0x20, 0xee, 0xa1, 0x40,
-	0x21, 0x6b, 0xfd, 0xcf, 0xc3, 0x24, 0x42, 0x99, 0xb9, 0xf4, 0xeb, 0xef, 0xa4, 0xfd, 0xfa, 0xc5,
-	0x63, 0xed, 0x37, 0xed, 0xdb, 0x77, 0x32, 0xbe, 0x7d, 0xf3, 0xd8, 0xf1, 0x39, 0x1e, 0xfe, 0x7f,
-	0x34, 0x20, 0xd9, 0x29, 0x48, 0x1b, 0xe6, 0x45, 0x6c, 0x94, 0x0d, 0xe9, 0x66, 0xca, 0x2d, 0x88,
-	0xd2, 0x4c, 0xd4, 0x7c, 0x48, 0xc6, 0xc3, 0x6b, 0xdf, 0x76, 0x79, 0x54, 0xe0, 0x27, 0x89, 0xc6,
-	0x6d, 0x80, 0x00, 0x89, 0xb3, 0xe5, 0x21, 0x5a, 0xda, 0x23, 0x52, 0x08, 0x33, 0x36, 0x4a, 0x08,
-	0x43, 0x92, 0x4d, 0x08, 0x4d, 0x45, 0x12, 0xa1, 0xfc, 0x2b, 0x0a, 0x8a, 0x64, 0x1f, 0x40, 0x5d,
-	0x60, 0xbb, 0x96, 0x37, 0x1c, 0x79, 0x2e, 0x75, 0x59, 0x20, 0x0d, 0x32, 0xdb, 0xe7, 0x16, 0x23,
-	0x76, 0x43, 0x3a, 0xa3, 0x16, 0x24, 0x01, 0xfa, 0xbf, 0x17, 0xa0, 0x96, 0x22, 0x22, 0xdb, 0xd0,
-	0x88, 0x5c, 0x07, 0xf3, 0xed, 0x83, 0xb1, 0xa8, 0x4d, 0xd0, 0x1e, 0x48, 0xe8, 0x1b, 0x42, 0x0c,
-	0x79, 0x0b, 0xd6, 0xe2, 0x1e, 0x22, 0x1a, 0x82, 0x62, 0x58, 0x8d, 0x39, 0x80, 0x68, 0xd0, 0x26,
-	0x54, 0xd5, 0x21, 0x74, 0x0f, 0x3c, 0x2f, 0x90, 0x9e, 0xcd, 0xa8, 0x28, 0x68, 0x87, 0x03, 0x39,
-	0xd9, 0xc8, 0x0b, 0x6c, 0x3e, 0x44, 0x92, 0x49, 0xa1, 0x28, 0x28, 0x92, 0x7d, 0x0a, 0x15, 0xd5,
-	0x2a, 0x47, 0x0b, 0x9b, 0xd6, 0x4d, 0x4a, 0x6d, 0x56, 0xf5, 0xc7, 0xc5, 0x20, 0x0c, 0x6e, 0x65,
-	0x2b, 0x06, 0x6a, 0xbd, 0x07, 0x2b, 0x19, 0x92, 0x59, 0x21, 0xae, 0x10, 0x0f, 0x71, 0x5f, 0x14,
-	0x60, 0x35, 0x4f, 0x07, 0xc9, 0x3d, 0xa8, 0x49, 0x31, 0x87, 0x3a, 0xac, 0x9d, 0x2c, 0x3f, 0xa9,
-	0xe2, 0xb8, 0x90, 0xd3, 0x3e, 0xac, 0x84, 0xf2, 0x4f, 0xd9, 0xc3, 0xe5, 0x19, 0x3e, 0x3a, 0xe4,
-	0x59, 0x57, 0x1c, 0xe2, 0xeb, 0x8b, 0xfc, 0x34, 0xf2, 0x9c, 0x76, 0x05, 0x72, 0x47, 0xb9, 0x6e,
-	0xb9, 0xbe, 0x7e, 0xe2, 0x5b, 0xff, 0xc7, 0x02, 0xac, 0xe7, 0x4f, 0xcb, 0x83, 0x01, 0xa6, 0x49,
-	0x43, 0x93, 0x59, 0x87, 0xa2, 0xe7, 0xca, 0xb3, 0xa4, 0xb2, 0x00, 0x3e, 0x40, 0x58, 0x4e, 0x92,
-	0x54, 0xc8, 0x49, 0x92, 0x2e, 0x42, 0x45, 0xf9, 0x44, 0xcc, 0x93, 0x30, 0x5b, 0x2e, 0x2b, 0xa0,
-	0x48, 0x95, 0x36, 0xa1, 0x4a, 0x9f, 0x8d, 0x4c, 0xb7, 0x47, 0x7b, 0x5d, 0x46, 0xfd, 0xa1, 0xca,
-	0x95, 0x2b, 0x0a, 0xba, 0xcf, 0x81, 0xe4, 0x51, 0x22, 0x49, 0x42, 0x5d, 0x7a, 0xfb, 0x84, 0xa2,
-	0xfc, 0xa5, 0x25, 0x4b, 0x7f, 0xad, 0x41, 0x35, 0x29, 0xe9, 0x6c, 0x2c, 0xd5, 0x72, 0x62, 0xe9,
-	0xc5, 0xdc, 0xd4, 0x23, 0x95, 0x69, 0x5c, 0x9e, 0x92, 0x69, 0x64, 0x12, 0x8b, 0x4b, 0x20, 0x8f,
-	0x37, 0xd5, 0x27, 0x92, 0x73, 0xe2, 0x61, 0xe8, 0x7f, 0xab, 0x01, 0xb9, 0x4b, 0x59, 0xfa, 0x3a,
-	0x74, 0x2b, 0xa7, 0x0f, 0x18, 0x25, 0x76, 0xf1, 0xb2, 0x79, 0x13, 0xaa, 0x2a, 0x01, 0x48, 0x44,
-	0xb7, 0x8a, 0x84, 0xde, 0xc1, 0x20, 0x17, 0x23, 0xc3, 0x4e, 0x9a, 0x8c, 0x6f, 0x8a, 0x0c, 0x7b,
-	0x68, 0x53, 0x2a, 0x96, 0xb9, 0x29, 0x15, 0x8b, 0xfe, 0x6f, 0x1a, 0x34, 0x12, 0xab, 0x97, 0xe1,
-	0x2a, 0xd3, 0x14, 0xd4, 0x4e, 0xd9, 0x14, 0x24, 0x4d, 0x58, 0x54, 0xad, 0x1a, 0xd9, 0x08, 0x92,
-	0x9f, 0xe4, 0x4d, 0x58, 0x08, 0x77, 0x50, 0x3c, 0x36, 0xe4, 0x48, 0x3a, 0x72, 0x13, 0xe6, 0x03,
-	0x66, 0xb2, 0x60, 0x6a, 0x6a, 0xa7, 0xd6, 0xb1, 0xc7, 0x4c, 0x66, 0x07, 0xcc, 0xb6, 0x02, 0x03,
-	0x47, 0xe8, 0x3f, 0x2b, 0x02, 0xc9, 0x62, 0x79, 0x90, 0x8a, 0x77, 0x25, 0xa5, 0x31, 0x96, 0x62,
-	0xdd, 0xc8, 0x88, 0x84, 0x79, 0x8f, 0xa9, 0x4c, 0xbb, 0x15, 0xc9, 0xbe, 0x00, 0x91, 0xd7, 0x81,
-	0x98, 0x47, 0xd4, 0x37, 0x07, 0xf2, 0x4c, 0xf0, 0x66, 0x0f, 0x3b, 0x8f, 0x75, 0x89, 0xd9, 0x0d,
-	0x2f, 0xf5, 0xce, 0x41, 0x09, 0x53, 0x7d, 0xcb, 0x1b, 0xbb, 0x4c, 0x36, 0x34, 0x30, 0xfb, 0xdf,
-	0xe5, 0x10, 0xf2, 0x1e, 0x54, 0x1c, 0x33, 0x60, 0x5d, 0xd3, 0xb2, 0x68, 0xc0, 0xed, 0x7a, 0x76,
-	0xff, 0xa2, 0xcc, 0x07, 0xec, 0x48, 0x7a, 0x72, 0x04, 0x1b, 0x51, 0xe9, 0xdb, 0xed, 0xd9, 0x41,
-	0x14, 0xa0, 0xb0, 0xfc, 0x79, 0xf7, 0x04, 0x92, 0x43, 0xf9, 0xf3, 0x52, 0xf9, 0x56, 0x8c, 0x01,
-	0x5a, 0xf8, 0x9a, 0x95, 0x87, 0x4b, 0x26, 0xa6, 0x78, 0x52, 0x8b, 0xe2, 0xd9, 0x40, 0x94, 0x98,
-	0x72, 0xfe, 0x41, 0xeb, 0x1e, 0xb4, 0xa6, 0x73, 0x9f, 0xe5, 0x22, 0xe6, 0xe3, 0x2e, 0xe2, 0x3f,
-	0x34, 0x68, 0xc6, 0x14, 0x17, 0xcf, 0xec, 0xf4, 0xc6, 0xf7, 0x3e, 0xc0, 0xc8, 0x1c, 0xd8, 0xae,
-	0x19, 0x46, 0x71, 0x95, 0x5c, 0xc4, 0xfa, 0x56, 0x21, 0x81, 0xe4, 0x6f, 0xc4, 0xc6, 0x9c, 0xba,
-	0xb0, 0x3a, 0xad, 0x85, 0xfe, 0x48, 0x83, 0x6f, 0xe5, 0x6c, 0x54, 0xda, 0x69, 0x64, 0x4b, 0xda,
-	0x09, 0x6d, 0x69, 0x27, 0x67, 0xc7, 0x17, 0x8e, 0xd9, 0x31, 0x4e, 0x14, 0xdf, 0x32, 0x4f, 0x72,
-	0xd7, 0xee, 0x52, 0xb9, 0x94, 0xce, 0xe4, 0x7e, 0x2f, 0x14, 0xfc, 0x26, 0xe0, 0x25, 0xb6, 0xe8,
-	0xcd, 0x88, 0xcb, 0xa3, 0xce, 0xd2, 0x8b, 0xce, 0xfc, 0x97, 0x5a, 0x61, 0x49, 0x33, 0x96, 0x04,
-	0xea, 0x7e, 0x6f, 0x9a, 0x08, 0x0a, 0xd3, 0xda, 0x2a, 0x79, 0x25, 0x52, 0x31, 0xb7, 0x44, 0xd2,
-	0x27, 0xb0, 0x9e, 0x5e, 0xd9, 0xd7, 0x96, 0xd4, 0x1b, 0xd0, 0x70, 0x3d, 0xd6, 0xed, 0x7b, 0x63,
-	0xb7, 0xd7, 0x8d, 0xb6, 0x85, 0xde, 0xb9, 0xee, 0x7a, 0xec, 0x0e, 0xc7, 0xec, 0xca, 0x4d, 0xe9,
-	0x9f, 0xc1, 0xda, 0x2d, 0xea, 0x50, 0x46, 0xbf, 0x7e, 0x28, 0xb8, 0x91, 0x2e, 0xf2, 0xb3, 0xcd,
-	0x3f, 0x9c, 0x22, 0x5d, 0xde, 0xeb, 0xff, 0xa0, 0x41, 0x25, 0x81, 0xe2, 0xee, 0xab, 0xef, 0xf9,
-	0x16, 0xed, 0xf6, 0x04, 0x58, 0x4c, 0xbb, 0x64, 0x94, 0x04, 0x0c, 0x29, 0x79, 0xb8, 0x44, 0xa4,
-	0xf2, 0x82, 0x78, 0x02, 0x65, 0x04, 0x4a, 0x37, 0xf8, 0x1a, 0xac, 0x48, 0xa2, 0xd8, 0x51, 0xa1,
-	0xf4, 0xeb, 0x88, 0x88, 0x9d, 0xd4, 0x26, 0x54, 0x25, 0xb1, 0xbc, 0x77, 0x91, 0x7a, 0x2d, 0xe7,
-	0xb9, 0x8f, 0x40, 0x5e, 0xc8, 0xf9, 0xd4, 0x0c, 0x3c, 0x57, 0xf6, 0x6e, 0xe4, 0x17, 0x57, 0xac,
-	0xf5, 0xb4, 0x0c, 0xe5, 0xf1, 0x45, 0xcd, 0x65, 0xed, 0x74, 0xcd, 0xe5, 0xdb, 0x50, 0xb5, 0x1c,
-	0x6a, 0xba, 0xe3, 0x91, 0xba, 0x33, 0x9b, 0x26, 0xda, 0x5d, 0x24, 0x93, 0xa5, 0x57, 0xc5, 0x8a,
-	0x7f, 0xea, 0x7f, 0x54, 0x80, 0x4a, 0x82, 0x80, 0xef, 0x55, 0x5e, 0x69, 0xe1, 0xe6, 0xc2, 0xf6,
-	0x18, 0x42, 0x71, 0x1f, 0x3d, 0xae, 0xeb, 0xb1, 0xdb, 0x2c, 0x45, 0x8a, 0xfe, 0x6c, 0x25, 0xc2,
-	0x28, 0xf2, 0xd8, 0x4d, 0x96, 0xa2, 0x4d, 0xde, 0x64, 0x29, 0xc2, 0x36, 0x34, 0xf8, 0xca, 0x79,
-	0xec, 0xe9, 0xfb, 0x94, 0xf6, 0xba, 0x07, 0x13, 0x46, 0x55, 0x8a, 0xb2, 0x22, 0x51, 0x77, 0x38,
-	0xa6, 0xc3, 0x11, 0xe4, 0x43, 0x58, 0x13, 0x0c, 0x79, 0x3e, 0xc3, 0xeb, 0x29, 0xc1, 0xe5, 0x64,
-	0x4d, 0xf2, 0x86, 0x1a, 0xb8, 0xab, 0xc6, 0xed, 0x30, 0xfd, 0xef, 0x35, 0x58, 0x7b, 0x34, 0xea,
-	0x99, 0x2c, 0x34, 0xbe, 0xd3, 0xeb, 0xfb, 0x5b, 0x99, 0xaa, 0xf6, 0x04, 0x57, 0x2c, 0x17, 0xa1,
-	0x32, 0x16, 0xf3, 0xaa, 0x74, 0xa9, 0x28, 0x0c, 0xb2, 0x8c, 0xc0, 0x28, 0x5b, 0x1a, 0x52, 0x7f,
-	0x10, 0x73, 0x18, 0x52, 0x11, 0x05, 0x34, 0x74, 0x17, 0x3f, 0xd5, 0x60, 0x3d, 0xbd, 0x89, 0x6f,
-	0xaa, 0x70, 0x1d, 0xa8, 0xe3, 0x52, 0x7a, 0xdd, 0x93, 0x6e, 0xae, 0x26, 0x07, 0x84, 0xd9, 0xee,
-	0x15, 0xa8, 0x87, 0x25, 0xe3, 0x11, 0xf5, 0x03, 0x75, 0x63, 0x36, 0x6f, 0xd4, 0x14, 0xfc, 0x13,
-	0x04, 0xeb, 0x9f, 0xc3, 0xba, 0x41, 0x85, 0x6e, 0x7c, 0x7d, 0xbf, 0x73, 0x33, 0xed, 0x77, 0xb2,
-	0x85, 0x8f, 0x9c, 0x23, 0xe3, 0x78, 0xfe, 0x5b, 0x83, 0x6a, 0x12, 0x37, 0xfb, 0x6d, 0x09, 0xcf,
-	0xe5, 0x85, 0x6b, 0xf2, 0x71, 0xa0, 0xf2, 0x3b, 0x02, 0x28, 0x99, 0x11, 0x03, 0x56, 0x5d, 0xfa,
-	0xb4, 0x9b, 0x79, 0xe8, 0x56, 0x3c, 0xe1, 0x43, 0x37, 0xe2, 0xd2, 0xa7, 0xe9, 0xb7, 0x42, 0x1f,
-	0x41, 0x83, 0xf3, 0x4c, 0xbf, 0x77, 0x9b, 0x3b, 0xd9, 0x7b, 0xb7, 0x15, 0x97, 0x3e, 0x4d, 0x82,
-	0xf4, 0x3f, 0xd0, 0x60, 0x23, 0x23, 0xfd, 0x6f, 0xaa, 0x40, 0x6f, 0x73, 0xef, 0x78, 0xac, 0xa7,
-	0x92, 0x53, 0x4a, 0x4f, 0x25, 0xa9, 0xf5, 0x7f, 0xd2, 0xa0, 0x92, 0xc0, 0xc4, 0x9d, 0x89, 0x4f,
-	0x0f, 0xc6, 0xb6, 0xc3, 0xe4, 0x69, 0x28, 0x67, 0x62, 0x20, 0x94, 0xeb, 0x9b, 0xf4, 0x65, 0xf2,
-	0x48, 0x42, 0x17, 0x55, 0xb3, 0x64, 0xc2, 0x21, 0xc1, 0x3c, 0xe7, 0x95, 0x34, 0xf1, 0x1b, 0x74,
-	0xec, 0xe5, 0xd7, 0x23, 0x8c, 0x2c, 0x55, 0xdf, 0x81, 0x72, 0xc2, 0xd9, 0xcc, 0xcd, 0x74, 0x36,
-	0x25, 0x2b, 0xe6, 0x64, 0x7e, 0x20, 0xc2, 0xf9, 0x1e, 0x3a, 0x33, 0x91, 0x42, 0x2a, 0xe5, 0x3e,
-	0x0b, 0x20, 0xe6, 0xf6, 0x4d, 0x77, 0x10, 0xbe, 0xde, 0xe1, 0x10, 0x83, 0x03, 0x78, 0xf4, 0xc3,
-	0x66, 0xa5, 0x54, 0x42, 0x0c, 0xda, 0x25, 0x84, 0x09, 0x2d, 0xd4, 0xbf, 0x2a, 0xc0, 0x46, 0x86,
-	0xb9, 0x3c, 0xbb, 0xbb, 0x50, 0x51, 0xce, 0x15, 0xd3, 0x59, 0x6d, 0xca, 0xeb, 0xa7, 0xd8, 0x68,
-	0x59, 0x77, 0x94, 0x83, 0x18, 0x43, 0xb2, 0x07, 0x2b, 0x23, 0xea, 0xf7, 0x3d, 0x7f, 0x68, 0xba,
-	0x96, 0x62, 0x86, 0xc7, 0xfa, 0x6a, 0xf6, 0x29, 0x41, 0x44, 0x19, 0x63, 0x58, 0x1f, 0x25, 0xc1,
-	0x3c, 0x85, 0x2b, 0x8d, 0x83, 0x68, 0x6d, 0xd3, 0x2c, 0xe2, 0x51, 0x90, 0x5c, 0x19, 0x8c, 0x83,
-	0x70, 0x5d, 0xe2, 0x5c, 0x1c, 0x87, 0x5a, 0xa7, 0x39, 0x17, 0x49, 0xbf, 0xc3, 0xf4, 0x9f, 0xcc,
-	0xc1, 0x4a, 0x66, 0xeb, 0x5c, 0xdd, 0xb0, 0x62, 0x52, 0xae, 0x05, 0xe5, 0x56, 0x34, 0xf0, 0x82,
-	0x48, 0x59, 0x48, 0xb6, 0xfa, 0xc2, 0x26, 0x47, 0xa2, 0xfa, 0xba, 0x02, 0x75, 0x24, 0x49, 0x65,
-	0x1d, 0x45, 0x03, 0xe7, 0x88, 0x25, 0x1d, 0xaf, 0x03, 0x51, 0x87, 0x35, 0x0e, 0x52, 0x81, 0xb0,
-	0x2e, 0x31, 0x8f, 0x02, 0x15, 0x07, 0xb7, 0x78, 0x32, 0xc9, 0xbd, 0x13, 0xaf, 0xd5, 0x24, 0xed,
-	0x3c, 0xae, 0x52, 0xc0, 0x79, 0xa9, 0x86, 0x94, 0x3f, 0x84, 0x9a, 0xe2, 0x7b, 0x20, 0x9f, 0x75,
-	0x2e, 0x4c, 0x79, 0xdb, 0x97, 0x91, 0x85, 0x82, 0x74, 0xc4, 0xd3, 0x4f, 0x2c, 0x9e, 0x94, 0x4a,
-	0x21, 0x8c, 0xd8, 0xd0, 0x08, 0xe5, 0xc4, 0x27, 0x90, 0xce, 0x62, 0x71, 0xca, 0xfb, 0xcb, 0xec,
-	0x14, 0xa1, 0x3c, 0x3b, 0x13, 0xf4, 0x20, 0x38, 0xcd, 0x4a, 0x2f, 0x0d, 0x6f, 0xbd, 0x0f, 0x24,
-	0xbb, 0x9e, 0x59, 0xe5, 0x56, 0x31, 0x56, 0x6e, 0xb5, 0x6e, 0xc1, 0x7a, 0xfe, 0x74, 0xa7, 0xe1,
-	0xa2, 0xff, 0x65, 0x11, 0xd6, 0x72, 0x95, 0x9c, 0x5c, 0x83, 0x35, 0xf3, 0x68, 0x20, 0xfb, 0xeb,
-	0x5d, 0xc7, 0x64, 0xd4, 0xb5, 0x26, 0xdc, 0xb1, 0xc8, 0x5e, 0xac, 0x79, 0x34, 0xc0, 0xde, 0xd3,
-	0x6f, 0x20, 0xea, 0x41, 0x40, 0xbe, 0x03, 0x1b, 0x7c, 0x48, 0xe8, 0x8a, 0x62, 0x83, 0x64, 0x37,
-	0xd6, 0x3c, 0x1a, 0x28, 0x7f, 0x1d, 0x0d, 0x3b, 0x0b, 0x20, 0x67, 0x79, 0x32, 0x0a, 0x64, 0x50,
-	0x5d, 0x46, 0xc8, 0xc7, 0x23, 0xa1, 0x9a, 0x21, 0x47, 0x4e, 0x80, 0x55, 0x7a, 0x49, 0xc1, 0x38,
-	0xc9, 0x25, 0xa8, 0x5a, 0xa6, 0x75, 0x48, 0xbb, 0x87, 0x36, 0xeb, 0xfa, 0x26, 0xc3, 0xe7, 0xcc,
-	0x05, 0xa3, 0x2c, 0xa0, 0xf7, 0x6c, 0x66, 0x98, 0x8c, 0x12, 0x0f, 0x1a, 0x6a, 0x45, 0x23, 0xea,
-	0x5b, 0xd4, 0x65, 0xb6, 0x43, 0x83, 0xa9, 0x75, 0x78, 0xae, 0x58, 0xda, 0x72, 0xd9, 0x0f, 0x23,
-	0x06, 0xf2, 0xe1, 0x8f, 0x93, 0x41, 0xb4, 0x6e, 0xc3, 0xc6, 0x14, 0xf2, 0x53, 0x75, 0x71, 0x7f,
-	0x3a, 0x07, 0xb5, 0x94, 0xe7, 0xc8, 0xdc, 0x04, 0x2b, 0xbb, 0x4e, 0xdc, 0x04, 0x07, 0xe4, 0x06,
-	0x34, 0x53, 0xf6, 0xdf, 0x1d, 0x8b, 0x97, 0x26, 0x32, 0x9a, 0x14, 0x8d, 0xf5, 0xa4, 0x23, 0x78,
-	0x24, 0xb1, 0xe4, 0x6d, 0xd8, 0x48, 0x8f, 0x8c, 0x67, 0xbf, 0x45, 0x63, 0x2d, 0x39, 0x50, 0x25,
-	0xc1, 0xbf, 0x09, 0x75, 0xb5, 0xa4, 0xd0, 0x46, 0xe7, 0xa6, 0xbc, 0x11, 0x4c, 0x6d, 0xaa, 0xad,
-	0x96, 0x1d, 0x37, 0xd1, 0x6a, 0x90, 0x00, 0x92, 0x43, 0x58, 0xc5, 0x1d, 0x08, 0xf6, 0xd1, 0xa3,
-	0x21, 0xec, 0x93, 0x7e, 0x77, 0xe6, 0x1c, 0xb8, 0xc1, 0xa0, 0x33, 0xb9, 0x23, 0x5f, 0x15, 0x49,
-	0x13, 0x1d, 0xa7, 0xe1, 0xdc, 0xa7, 0x33, 0x6f, 0xd4, 0x7d, 0x32, 0xa6, 0xbe, 0x1d, 0xaa, 0x49,
-	0xd6, 0xa7, 0xef, 0x7b, 0xa3, 0x8f, 0x91, 0x04, 0x03, 0x16, 0xb0, 0x10, 0xd0, 0xda, 0x51, 0x37,
-	0xcc, 0x89, 0x3d, 0x9d, 0xd6, 0xcc, 0xf3, 0x97, 0x7c, 0x2a, 0x33, 0xff, 0x2b, 0x0d, 0x6a, 0xa9,
-	0x85, 0x72, 0x6a, 0xd1, 0xe9, 0x92, 0x1c, 0xf0, 0x83, 0x43, 0xb1, 0x19, 0x26, 0xfb, 0x3b, 0xe2,
-	0x83, 0x1b, 0x18, 0xb7, 0xec, 0x98, 0x41, 0x63, 0x83, 0xb6, 0x6c, 0x1e, 0xc5, 0x0c, 0x59, 0x75,
-	0xcb, 0xe8, 0x33, 0x6a, 0x8d, 0x99, 0x7c, 0x2d, 0x70, 0x82, 0x6e, 0xd9, 0x6d, 0x49, 0x7f, 0xfd,
-	0xef, 0xca, 0xb0, 0x74, 0xcb, 0xb3, 0xb8, 0x67, 0xa4, 0xe4, 0x33, 0xa8, 0x26, 0x5f, 0x45, 0x91,
-	0x6c, 0x7c, 0xce, 0xfd, 0xe3, 0x4b, 0xeb, 0xf2, 0x4c, 0x3a, 0x4c, 0x2a, 0xf4, 0xe6, 0xef, 0xfd,
-	0xcb, 0xcf, 0xff, 0xa4, 0x40, 0xf4, 0xca, 0x36, 0xfe, 0x87, 0x08, 0x55, 0xf9, 0x7b, 0xda, 0x55,
-	0xf2, 0x23, 0x0d, 0x1a, 0x39, 0x6f, 0xb2, 0xc8, 0x6b, 0x33, 0x58, 0xc7, 0x1f, 0xc8, 0xb5, 0x5e,
-	0x3f, 0x19, 0xb1, 0x5c, 0xcc, 0x2b, 0x62, 0x31, 0x4d, 0xbd, 0x91, 0x58, 0xcc, 0xb6, 0x78, 0x54,
-	0xcd, 0x97, 0xf4, 0xb9, 0x7a, 0x0b, 0x24, 0xdf, 0x9b, 0x90, 0xcd, 0x29, 0x77, 0x35, 0xc9, 0xc7,
-	0x52, 0xad, 0x57, 0x67, 0x91, 0xc9, 0xf9, 0xcf, 0x8a, 0xf9, 0x37, 0x74, 0xc2, 0xe7, 0x47, 0xab,
-	0xdb, 0x96, 0x17, 0xca, 0x7c, 0xfa, 0x09, 0x94, 0xe3, 0xd7, 0xa8, 0xe4, 0xd2, 0x14, 0xb6, 0x89,
-	0xc7, 0x14, 0xad, 0xcd, 0x19, 0x54, 0x72, 0xee, 0x97, 0xc5, 0xdc, 0xeb, 0xfa, 0x4a, 0x6c, 0xee,
-	0x43, 0x41, 0x82, 0x3b, 0x2f, 0xc5, 0xfa, 0x6d, 0x24, 0xdb, 0x6c, 0xce, 0x76, 0xfb, 0x5b, 0x97,
-	0x8e, 0x27, 0x92, 0xf3, 0x5e, 0x14, 0xf3, 0x9e, 0x25, 0x2f, 0x25, 0x65, 0xfe, 0x59, 0xac, 0x4a,
-	0xfb, 0x9c, 0xfc, 0x58, 0x83, 0x95, 0x4c, 0xbf, 0x8f, 0x5c, 0x39, 0x6e, 0x82, 0x44, 0xf3, 0xb3,
-	0x75, 0xf5, 0x24, 0xa4, 0x72, 0x45, 0x57, 0xc5, 0x8a, 0x2e, 0x11, 0xfd, 0x98, 0x15, 0x6d, 0xcb,
-	0x76, 0xd8, 0xef, 0x40, 0x35, 0xd9, 0x5a, 0xcb, 0xb1, 0x90, 0xdc, 0xae, 0x60, 0x8e, 0x85, 0xe4,
-	0xf7, 0xe8, 0xf4, 0x97, 0xc4, 0x72, 0xd6, 0xf4, 0xba, 0xf8, 0x5b, 0x9b, 0x20, 0xd8, 0x3e, 0x30,
-	0x99, 0x75, 0xc8, 0xcf, 0xe5, 0xf7, 0x35, 0xa8, 0x26, 0x9b, 0x43, 0x39, 0x0b, 0xc8, 0xed, 0xc0,
-	0xe5, 0x2c, 0x20, 0xbf, 0xcb, 0xa4, 0x4e, 0xe8, 0xea, 0xb1, 0x27, 0xf4, 0xa5, 0xc6, 0x7d, 0x45,
-	0xbc, 0x69, 0x90, 0xeb, 0x2b, 0x72, 0x5a, 0x23, 0xb9, 0xbe, 0x22, 0xaf, 0xfb, 0xa0, 0x6f, 0x8b,
-	0x85, 0x5c, 0x69, 0x5d, 0x3a, 0xee, 0x60, 0x54, 0x2b, 0x80, 0x4b, 0xe7, 0x4f, 0x35, 0xa8, 0xa5,
-	0x2a, 0x51, 0x72, 0x79, 0x5a, 0xe1, 0x98, 0x96, 0xcf, 0xd6, 0x6c, 0x42, 0xb9, 0xae, 0xb6, 0x58,
-	0xd7, 0x96, 0x7e, 0xf1, 0xb8, 0x75, 0xc9, 0x2a, 0x90, 0x2f, 0xeb, 0x77, 0xa1, 0x96, 0xaa, 0xb1,
-	0x48, 0xae, 0x36, 0xe4, 0x94, 0x78, 0x39, 0xab, 0x9a, 0x52, 0xae, 0xe9, 0xdf, 0x12, 0xab, 0x6a,
-	0x10, 0x34, 0x68, 0x8e, 0xda, 0x96, 0xb9, 0x36, 0xf9, 0x21, 0x94, 0xee, 0x51, 0xd3, 0x61, 0x87,
-	0xbb, 0x87, 0xd4, 0x7a, 0x4c, 0xd6, 0x33, 0xd1, 0xe1, 0xf6, 0x70, 0xc4, 0x26, 0x2d, 0x3d, 0x55,
-	0x9c, 0xc7, 0xc6, 0x84, 0xb3, 0x10, 0x31, 0x4b, 0x99, 0x00, 0x9f, 0xe5, 0x50, 0x10, 0x74, 0x5e,
-	0x83, 0xf4, 0x3f, 0x3f, 0x1f, 0x6a, 0x3f, 0x58, 0xf7, 0xcd, 0x81, 0xf8, 0xe3, 0xa7, 0x02, 0x6f,
-	0x1f, 0x5d, 0xfb, 0xfe, 0xd1, 0xb5, 0x83, 0x05, 0x31, 0xe9, 0x5b, 0xff, 0x1f, 0x00, 0x00, 0xff,
-	0xff, 0x14, 0xa7, 0x51, 0x61, 0x44, 0x3a, 0x00, 0x00,
+	// 4415 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x7b, 0x5b, 0x8f, 0x1b, 0x47,
+	0x76, 0xb0, 0x9a, 0x1c, 0x6a, 0x66, 0xce, 0xf0, 0x36, 0xc5, 0xb9, 0x70, 0x69, 0xcb, 0x92, 0x5a,
+	0x1a, 0x6b, 0x24, 0xdb, 0x1c, 0x4b, 0xde, 0xf5, 0x4a, 0xde, 0xcf, 0x17, 0x71, 0x74, 0xfd, 0x1c,
+	0xd9, 0x72, 0xcf, 0xc8, 0x06, 0x16, 0xd8, 0x74, 0x7a, 0x9a, 0x45, 0x4e, 0x47, 0xcd, 0x6e, 0xaa,
+	0xab, 0x39, 0x12, 0x65, 0x38, 0x31, 0xf2, 0x90, 0x87, 0x20, 0x48, 0x16, 0x4e, 0xb2, 0xc8, 0x5b,
+	0x10, 0x20, 0x2f, 0x79, 0xdf, 0x97, 0x45, 0x5e, 0x36, 0x97, 0x1f, 0x90, 0x20, 0xd8, 0xc7, 0x24,
+	0x2f, 0x8b, 0xc4, 0x3f, 0x21, 0x51, 0x2e, 0x08, 0xaa, 0x4e, 0x55, 0xb3, 0x6f, 0x1c, 0x72, 0x6c,
+	0xec, 0x5b, 0xde, 0xd8, 0xe7, 0x9c, 0x3a, 0x55, 0x75, 0xea, 0xdc, 0xab, 0x08, 0xeb, 0x47, 0x57,
+	0x77, 0xba, 0xbe, 0xcd, 0x42, 0x3f, 0xa0, 0x26, 0x3b, 0xb2, 0xdb, 0xc3, 0xc0, 0x0f, 0x7d, 0x52,
+	0xb3, 0x86, 0x4e, 0x5b, 0xc1, 0xdb, 0x47, 0x57, 0x5b, 0x2f, 0xf7, 0x7d, 0xbf, 0xef, 0xd2, 0x1d,
+	0x6b, 0xe8, 0xec, 0x58, 0x9e, 0xe7, 0x87, 0x56, 0xe8, 0xf8, 0x1e, 0x43, 0xf2, 0xd6, 0x4b, 0x12,
+	0x2b, 0xbe, 0x0e, 0x46, 0xbd, 0x1d, 0x3a, 0x18, 0x86, 0x63, 0x89, 0x3c, 0x9b, 0x46, 0x86, 0xce,
+	0x80, 0xb2, 0xd0, 0x1a, 0x0c, 0x25, 0x41, 0x8b, 0x33, 0xb5, 0xfd, 0xc1, 0xc0, 0xf7, 0x76, 0x8e,
+	0xae, 0xca, 0x5f, 0xf9, 0x38, 0x1a, 0x04, 0x7e, 0xa0, 0x66, 0xdd, 0x3c, 0xb2, 0x5c, 0xa7, 0x6b,
+	0x85, 0x74, 0x47, 0xfd, 0x40, 0x84, 0xbe, 0x0d, 0xd5, 0x4f, 0x46, 0x34, 0x18, 0xdf, 0x1e, 0x1c,
+	0xd0, 0x6e, 0xd7, 0xf1, 0xfa, 0x64, 0x03, 0x4e, 0x1f, 0x59, 0xee, 0x88, 0xb2, 0xa6, 0x76, 0xae,
+	0xb8, 0x5d, 0x30, 0xe4, 0x97, 0xfe, 0x27, 0x05, 0x58, 0x7f, 0x34, 0x74, 0x7d, 0xab, 0x7b, 0xcb,
+	0xb7, 0x47, 0x03, 0xea, 0x85, 0x06, 0x7d, 0x32, 0xa2, 0x2c, 0x24, 0x57, 0xa0, 0xdc, 0x73, 0x5c,
+	0x6a, 0xda, 0xbe, 0x17, 0x52, 0x2f, 0x6c, 0x6a, 0xe7, 0xb4, 0xed, 0x72, 0x67, 0xf1, 0x45, 0x67,
+	0xe1, 0x79, 0xa1, 0xae, 0x19, 0x2b, 0x1c, 0xb9, 0x8b, 0x38, 0x72, 0x06, 0x4a, 0xa1, 0x13, 0xba,
+	0xb4, 0x59, 0x38, 0xa7, 0x6d, 0x2f, 0x0b, 0xa2, 0x80, 0x13, 0x21, 0x94, 0x5c, 0x84, 0x65, 0xc1,
+	0x2a, 0x1c, 0x0f, 0x69, 0xb3, 0x98, 0x24, 0x59, 0xe2, 0x98, 0xfd, 0xf1, 0x90, 0x92, 0xb7, 0x60,
+	0x69, 0x40, 0x43, 0xab, 0x6b, 0x85, 0x56, 0x73, 0xe1, 0x9c, 0xb6, 0xbd, 0x72, 0x6d, 0xb3, 0xcd,
+	0x4f, 0x41, 0x8a, 0xe3, 0xe8, 0x6a, 0xfb, 0x81, 0x44, 0x1b, 0x11, 0x21, 0xf9, 0x14, 0x56, 0x87,
+	0x81, 0x6f, 0x53, 0xc6, 0x1c, 0xaf, 0xcf, 0xd7, 0xda, 0x73, 0xfa, 0xcd, 0x92, 0x18, 0x7d, 0xb9,
+	0x9d, 0x3a, 0xc3, 0xb6, 0xda, 0xe2, 0xc3, 0x68, 0xc4, 0xae, 0x18, 0x60, 0xd4, 0x87, 0x29, 0x88,
+	0xfe, 0x6f, 0x45, 0x68, 0x4e, 0x23, 0x27, 0x1f, 0xc1, 0xaa, 0x7d, 0x38, 0xf2, 0x1e, 0xf3, 0x29,
+	0x59, 0x18, 0x58, 0x21, 0xed, 0x8f, 0x85, 0x7c, 0x56, 0xae, 0x9d, 0xcf, 0x4c, 0xba, 0x2b, 0x29,
+	0xf7, 0x24, 0xa1, 0x51, 0xb7, 0x53, 0x10, 0xf2, 0x21, 0xd4, 0xa9, 0x3a, 0x29, 0xb5, 0x87, 0x82,
+	0x60, 0x77, 0x2e, 0xc3, 0x2e, 0x3a, 0x52, 0xb9, 0xf4, 0x1a, 0x4d, 0x02, 0xc8, 0x3d, 0xa8, 0x39,
+	0x5e, 0x97, 0x3e, 0x8b, 0xf1, 0x2a, 0x0a, 0x5e, 0x67, 0x33, 0xbc, 0xee, 0x4b, 0x3a, 0xc9, 0xaa,
+	0xea, 0x24, 0xbe, 0xc9, 0x19, 0x00, 0xea, 0x59, 0x07, 0x2e, 0x35, 0x7d, 0x3b, 0x10, 0x47, 0xb2,
+	0x64, 0x2c, 0x23, 0xe4, 0x63, 0x3b, 0x20, 0x2d, 0x58, 0x72, 0x2d, 0xaf, 0x3f, 0xb2, 0xfa, 0x54,
+	0x48, 0x7c, 0xd9, 0x88, 0xbe, 0x49, 0x0f, 0x6a, 0xf6, 0x88, 0x85, 0xfe, 0xc0, 0x64, 0x34, 0x0c,
+	0x1d, 0xaf, 0xcf, 0x9a, 0xa7, 0xcf, 0x15, 0xb7, 0x57, 0xae, 0xbd, 0x3b, 0xf7, 0xa1, 0xb4, 0x77,
+	0x05, 0x83, 0x3d, 0x39, 0xfe, 0xb6, 0x17, 0x06, 0x63, 0xa3, 0x6a, 0x27, 0x80, 0xad, 0x9b, 0xd0,
+	0xc8, 0x21, 0x23, 0x75, 0x28, 0x3e, 0xa6, 0x78, 0x24, 0xcb, 0x06, 0xff, 0x49, 0xd6, 0xa0, 0x24,
+	0x34, 0x1e, 0x35, 0xd4, 0xc0, 0x8f, 0x77, 0x0a, 0xd7, 0x35, 0xfd, 0x3f, 0x0a, 0x50, 0x4f, 0x9f,
+	0x11, 0xb9, 0x00, 0x15, 0x75, 0xb0, 0xa8, 0xb5, 0xc8, 0xaa, 0xac, 0x80, 0x42, 0x61, 0x2f, 0x03,
+	0x88, 0xa3, 0x34, 0x99, 0xf3, 0x1c, 0x19, 0x97, 0x3a, 0xf0, 0xa2, 0xb3, 0xd8, 0x2a, 0x6d, 0x77,
+	0x9b, 0x5f, 0x9e, 0x33, 0x96, 0x05, 0x76, 0xcf, 0x79, 0x4e, 0xc9, 0x0e, 0x54, 0x90, 0xd4, 0x3f,
+	0xa2, 0x81, 0x6b, 0x0d, 0x9b, 0xc5, 0x18, 0x75, 0xf3, 0xeb, 0xc5, 0xed, 0x53, 0x46, 0x59, 0x10,
+	0x7c, 0x8c, 0x78, 0x72, 0x15, 0xd6, 0x98, 0x33, 0x70, 0x5c, 0x2b, 0x70, 0xc2, 0xb1, 0x19, 0x1e,
+	0x06, 0x94, 0x1d, 0xfa, 0x6e, 0x57, 0x9c, 0x42, 0xc1, 0x68, 0x4c, 0x70, 0xfb, 0x0a, 0x45, 0x7e,
+	0x13, 0x1a, 0xd1, 0x9a, 0x87, 0x56, 0x60, 0x0d, 0x68, 0x48, 0x03, 0xd6, 0x2c, 0x09, 0xb9, 0xdf,
+	0x98, 0xa9, 0x97, 0x6d, 0xf5, 0xe3, 0x61, 0x34, 0x16, 0x65, 0x4e, 0x58, 0x06, 0xd1, 0xba, 0x0d,
+	0x9b, 0x53, 0xc8, 0x4f, 0x24, 0xfb, 0x9f, 0x16, 0xa0, 0x96, 0x52, 0x68, 0xae, 0x75, 0x03, 0xbf,
+	0x4b, 0x5d, 0xd3, 0xb3, 0x06, 0x4a, 0xee, 0xcb, 0x02, 0xf2, 0x91, 0x35, 0xa0, 0x5c, 0x30, 0x9e,
+	0x1f, 0x0c, 0x2c, 0xd7, 0x79, 0x4e, 0xcd, 0x48, 0xf7, 0x99, 0xe0, 0xbd, 0x64, 0x34, 0x22, 0x5c,
+	0xc4, 0x96, 0x91, 0xcb, 0x50, 0x1f, 0xfa, 0xbe, 0x9b, 0xb0, 0x56, 0xe1, 0x85, 0x8c, 0x9a, 0x84,
+	0x47, 0xe7, 0xfe, 0x1b, 0x50, 0xc7, 0xc9, 0x63, 0x02, 0x5c, 0x10, 0x02, 0xfc, 0xde, 0x2c, 0x4b,
+	0x6c, 0x3f, 0xe0, 0x03, 0xd3, 0xc2, 0xab, 0x0d, 0x92, 0xd0, 0x56, 0x07, 0xd6, 0xf2, 0x08, 0x4f,
+	0x24, 0xb6, 0xff, 0xd6, 0xa0, 0x9a, 0xb4, 0x5d, 0x72, 0x16, 0x56, 0x84, 0xf5, 0x0a, 0x6d, 0x45,
+	0x27, 0xbf, 0x6c, 0x80, 0x00, 0x71, 0x5d, 0x65, 0xe4, 0x2e, 0x54, 0x8e, 0xa8, 0x1d, 0xfa, 0x41,
+	0xd2, 0xc1, 0xe8, 0x99, 0x6d, 0x7d, 0x2a, 0xa8, 0x04, 0x7b, 0xe9, 0x17, 0xca, 0x38, 0x50, 0xce,
+	0xf4, 0x00, 0x6a, 0xbd, 0x91, 0xeb, 0x86, 0xf4, 0x59, 0x98, 0xf4, 0x2f, 0x17, 0x33, 0xac, 0xee,
+	0x8c, 0x5c, 0x77, 0x9f, 0x3e, 0x0b, 0xe3, 0xcc, 0xaa, 0x6a, 0xb0, 0x64, 0x77, 0x0d, 0xd6, 0xa5,
+	0x93, 0xb1, 0xd8, 0xd8, 0xb3, 0x4d, 0xe5, 0x83, 0xa4, 0xbf, 0x69, 0x20, 0xf2, 0x26, 0xc7, 0xa9,
+	0x2d, 0xeb, 0x5f, 0x15, 0x60, 0x35, 0xb3, 0x4c, 0xae, 0x38, 0x13, 0x11, 0x28, 0xc5, 0x89, 0x24,
+	0xc0, 0xc5, 0xe9, 0xb9, 0x0e, 0x0b, 0xd1, 0x50, 0x0d, 0xfc, 0x20, 0x65, 0xd0, 0x06, 0x68, 0x8c,
+	0x86, 0x36, 0x20, 0x97, 0xa0, 0x46, 0x7b, 0x7c, 0x57, 0x2c, 0x0c, 0x46, 0x36, 0x0f, 0xf0, 0x62,
+	0x19, 0x25, 0xa3, 0x4a, 0x7b, 0xbb, 0x31, 0x28, 0x31, 0x00, 0x32, 0x26, 0x76, 0x6d, 0xb6, 0x28,
+	0xdb, 0x69, 0xf5, 0x88, 0x71, 0x69, 0xbd, 0x0b, 0xb5, 0x6f, 0xa3, 0x14, 0x3f, 0x2b, 0x40, 0x23,
+	0x47, 0xe0, 0xe4, 0x65, 0x58, 0xb6, 0x3c, 0xcb, 0x1d, 0x3f, 0xa7, 0x81, 0xd2, 0x8b, 0x09, 0x80,
+	0x0b, 0x8d, 0x85, 0xfe, 0xd0, 0x7c, 0xea, 0x07, 0x5d, 0x6e, 0x44, 0x02, 0xcd, 0x21, 0x9f, 0x71,
+	0x80, 0x10, 0x08, 0x9e, 0x0e, 0x0b, 0xe9, 0x60, 0xc0, 0xcf, 0xa5, 0x28, 0xce, 0xa5, 0x8a, 0xe0,
+	0x3d, 0x09, 0x8d, 0x13, 0x8e, 0x3d, 0xdf, 0x1b, 0x0f, 0x58, 0x73, 0x21, 0x41, 0x28, 0xa1, 0x64,
+	0x3f, 0x47, 0x72, 0xdf, 0x9d, 0x47, 0x73, 0x7e, 0x95, 0xb2, 0xfb, 0xfd, 0x02, 0x6c, 0xa4, 0xb3,
+	0x20, 0x36, 0xf4, 0x3d, 0x46, 0xb9, 0x61, 0x75, 0x25, 0xcc, 0x74, 0xba, 0x92, 0x1d, 0x28, 0xd0,
+	0xfd, 0x2e, 0xf9, 0x3e, 0x9c, 0x66, 0xa1, 0x15, 0x8e, 0xd0, 0x05, 0x55, 0xaf, 0x9d, 0x4d, 0x25,
+	0x2d, 0x93, 0xb8, 0xb6, 0x27, 0xc8, 0x0c, 0x49, 0x9e, 0x4a, 0x5d, 0x02, 0xca, 0x46, 0x6e, 0xd8,
+	0x2c, 0xce, 0x9d, 0xba, 0x18, 0x62, 0x40, 0x3c, 0x75, 0x41, 0x08, 0xf9, 0x00, 0x2a, 0x93, 0x15,
+	0x7b, 0x3d, 0x5f, 0x26, 0x53, 0x2f, 0xa5, 0xd6, 0xa5, 0x38, 0xde, 0xf7, 0x7a, 0xbe, 0x51, 0xee,
+	0xc6, 0xbe, 0xf4, 0x7f, 0xc8, 0x4d, 0x7e, 0x24, 0xfb, 0x37, 0x61, 0x2d, 0xf4, 0x43, 0xcb, 0x35,
+	0x45, 0xbc, 0x62, 0xa6, 0x1d, 0x50, 0x2b, 0xa4, 0x28, 0x99, 0x92, 0x41, 0x04, 0x4e, 0xc4, 0x16,
+	0xb6, 0x8b, 0x18, 0xee, 0xb2, 0x27, 0x8e, 0xda, 0xec, 0x53, 0x8f, 0x06, 0x62, 0x04, 0x1a, 0x62,
+	0x63, 0x82, 0xbb, 0xab, 0x50, 0x5c, 0x9d, 0x84, 0xe5, 0xd2, 0x09, 0x7f, 0x34, 0xd2, 0xaa, 0x04,
+	0x2b, 0xde, 0xaf, 0x03, 0x89, 0x09, 0x91, 0x27, 0xd6, 0xa6, 0x54, 0xbd, 0x62, 0x5c, 0x34, 0xfb,
+	0xce, 0x80, 0x3e, 0x60, 0xe4, 0x3d, 0x58, 0x7a, 0x6a, 0x05, 0x9e, 0x08, 0x18, 0xa8, 0x7a, 0x59,
+	0xff, 0x37, 0xd9, 0xf0, 0x67, 0x48, 0x6a, 0x44, 0x63, 0x48, 0x00, 0x8d, 0xd8, 0x6c, 0x51, 0xb6,
+	0x8a, 0xa9, 0xcd, 0xcd, 0xb9, 0x0f, 0x2d, 0x36, 0x87, 0x4a, 0x69, 0x65, 0xa8, 0x1d, 0x66, 0x10,
+	0x3c, 0xd4, 0x4e, 0x21, 0x3f, 0x91, 0x8a, 0x0f, 0x61, 0x35, 0xb3, 0x33, 0x72, 0x1e, 0xca, 0x72,
+	0x6f, 0x71, 0xa7, 0xb9, 0x22, 0x61, 0xc2, 0x6d, 0x36, 0x61, 0x71, 0x40, 0x19, 0xb3, 0xfa, 0x8a,
+	0xa7, 0xfa, 0x24, 0xaf, 0x00, 0xb0, 0x51, 0xbf, 0x4f, 0x99, 0xf0, 0x93, 0x18, 0x50, 0x63, 0x10,
+	0xfd, 0x97, 0x05, 0x68, 0x25, 0x8d, 0x4a, 0x78, 0xf1, 0xff, 0xab, 0x2f, 0xb8, 0x57, 0x3e, 0x0f,
+	0x65, 0xdb, 0x72, 0xdd, 0x03, 0xcb, 0x7e, 0x6c, 0x8e, 0x02, 0xb7, 0x79, 0x1a, 0x25, 0xaf, 0x60,
+	0x8f, 0x02, 0x97, 0x6c, 0xc1, 0xd2, 0x30, 0x70, 0x7c, 0x9e, 0xe4, 0x35, 0x17, 0x45, 0xba, 0xb8,
+	0xfc, 0xa2, 0x73, 0xba, 0xb5, 0xb0, 0xad, 0x35, 0xc1, 0x88, 0x50, 0xfa, 0x4f, 0x0a, 0xf0, 0x52,
+	0xae, 0x98, 0xa5, 0x03, 0xdb, 0x84, 0xc5, 0xd0, 0x62, 0x8f, 0x27, 0xce, 0xeb, 0x34, 0xff, 0xbc,
+	0xdf, 0x4d, 0x7b, 0xb6, 0xc2, 0x31, 0x9e, 0xad, 0x78, 0x32, 0xcf, 0x76, 0x1f, 0xce, 0x73, 0x1d,
+	0x18, 0x70, 0x0b, 0x35, 0xd3, 0xe6, 0xc9, 0xa8, 0xed, 0x7b, 0x5d, 0x26, 0x03, 0xeb, 0x2b, 0x11,
+	0xe1, 0xc3, 0x84, 0xb1, 0xee, 0x21, 0x15, 0xb9, 0x01, 0x20, 0x1d, 0x80, 0x69, 0x85, 0x52, 0xf0,
+	0xad, 0x36, 0x16, 0xd4, 0x6d, 0x55, 0x50, 0xb7, 0xf7, 0x55, 0x41, 0x6d, 0x2c, 0x4b, 0xea, 0x9b,
+	0xa1, 0xfe, 0x3f, 0x1a, 0xac, 0xed, 0x51, 0x2b, 0xb0, 0x0f, 0xf7, 0x30, 0x5b, 0x56, 0x9a, 0x77,
+	0x16, 0xe0, 0x09, 0xaf, 0x8e, 0x4d, 0x9e, 0x86, 0xa0, 0x50, 0xee, 0x9d, 0x32, 0x96, 0x05, 0x8c,
+	0x07, 0x1d, 0xf2, 0xff, 0xa1, 0x86, 0x04, 0x91, 0x6b, 0x92, 0xd9, 0x52, 0xb6, 0x84, 0x4a, 0x96,
+	0xd9, 0xf7, 0x4e, 0x19, 0xd5, 0x27, 0x09, 0x08, 0xb9, 0x0e, 0x8b, 0xfe, 0x50, 0xb4, 0x0a, 0xa4,
+	0x6f, 0x7f, 0x25, 0xc3, 0x03, 0x17, 0xf9, 0x31, 0x52, 0x19, 0x8a, 0x9c, 0xec, 0xc0, 0x62, 0xcf,
+	0x71, 0x63, 0x29, 0xe8, 0x7a, 0x4a, 0xfe, 0x77, 0x04, 0xd6, 0x50, 0x54, 0x9d, 0x2a, 0x94, 0x71,
+	0xd9, 0xcc, 0x1f, 0x05, 0x36, 0xd5, 0xbf, 0x2e, 0x40, 0x25, 0xc1, 0x9b, 0x9c, 0x85, 0x12, 0x0f,
+	0xf6, 0x8f, 0x9b, 0x5a, 0xac, 0xfc, 0xd8, 0xd6, 0x9a, 0x5f, 0x2f, 0x1a, 0x0b, 0xa1, 0x3f, 0xfc,
+	0x90, 0x74, 0xa6, 0x94, 0x1d, 0x7c, 0xfb, 0x85, 0x4e, 0xed, 0x45, 0xa7, 0x0c, 0xf0, 0xc6, 0xa9,
+	0x53, 0xa7, 0x4e, 0x9d, 0x39, 0x75, 0xea, 0xcb, 0xf7, 0xf3, 0xeb, 0x90, 0xd7, 0x60, 0x35, 0xc6,
+	0x63, 0x40, 0xc3, 0xc0, 0xb1, 0xa5, 0x7b, 0xa8, 0x4f, 0x10, 0x0f, 0x04, 0x9c, 0xdb, 0x41, 0x4c,
+	0x09, 0x71, 0xa7, 0xcb, 0xc6, 0xca, 0x44, 0x0b, 0xf9, 0xa2, 0x57, 0xb0, 0x76, 0xc2, 0xd4, 0xb6,
+	0x24, 0x28, 0xb0, 0xf2, 0xc2, 0xd4, 0xf6, 0x32, 0xd4, 0x1d, 0xcf, 0x76, 0x47, 0x5d, 0x1a, 0x77,
+	0xc9, 0x3c, 0xf9, 0xa8, 0x49, 0xb8, 0x32, 0x6c, 0xf2, 0x06, 0x10, 0x45, 0x1a, 0xab, 0x1d, 0x16,
+	0x05, 0xf1, 0xaa, 0xc4, 0xc4, 0x2a, 0x07, 0x9e, 0xd5, 0xa8, 0x2f, 0x53, 0x64, 0xf2, 0xcd, 0x25,
+	0xb1, 0x91, 0x6a, 0x04, 0x16, 0xc9, 0xbc, 0xfe, 0x87, 0x1a, 0xac, 0xa7, 0x74, 0x4d, 0x9a, 0xdf,
+	0x0d, 0x58, 0xc4, 0xd0, 0x8e, 0xc9, 0xd7, 0x4a, 0xc6, 0x8a, 0xf6, 0x22, 0x91, 0xc8, 0x88, 0xae,
+	0xe8, 0xc9, 0x0f, 0x62, 0x0e, 0x6b, 0x9a, 0xfe, 0xe1, 0xa4, 0x59, 0xc7, 0xa5, 0xff, 0x53, 0x01,
+	0xaa, 0x49, 0x24, 0xd9, 0x82, 0x2a, 0x46, 0x6e, 0x26, 0xe0, 0x51, 0xcc, 0xae, 0x08, 0xe8, 0x9e,
+	0x04, 0x4e, 0xc8, 0x02, 0x1a, 0x8e, 0x02, 0x2f, 0x0a, 0xd4, 0x48, 0x66, 0x48, 0x20, 0xb9, 0x08,
+	0x55, 0xe4, 0x13, 0x45, 0xdd, 0xa2, 0x88, 0xba, 0x65, 0x84, 0xca, 0x88, 0x1b, 0x55, 0x73, 0x23,
+	0x46, 0xb1, 0x7a, 0x55, 0xd5, 0xdc, 0x23, 0x46, 0xa7, 0xe8, 0x4a, 0x69, 0x8a, 0xae, 0x3c, 0x00,
+	0xe8, 0xd2, 0x83, 0x51, 0x1f, 0xb3, 0x1a, 0x0c, 0xba, 0xed, 0x19, 0x12, 0x69, 0xdf, 0xe2, 0x23,
+	0x78, 0x56, 0x83, 0x11, 0x76, 0xb9, 0xab, 0xbe, 0x5b, 0xff, 0x0f, 0xaa, 0x49, 0xe4, 0x89, 0xe2,
+	0xe9, 0xbf, 0x6b, 0xd0, 0xc0, 0xa9, 0xee, 0x8d, 0x0f, 0x02, 0xa7, 0xab, 0x9c, 0xcb, 0xab, 0x59,
+	0xe7, 0x32, 0x09, 0x46, 0x31, 0x1f, 0xf3, 0xde, 0xc4, 0x2f, 0x14, 0xa6, 0x94, 0x4f, 0xc8, 0x78,
+	0xb6, 0x77, 0x28, 0xce, 0xe3, 0x1d, 0x78, 0x5f, 0x28, 0xed, 0xd4, 0x16, 0xe6, 0x72, 0x6a, 0x69,
+	0x97, 0xa6, 0xff, 0xac, 0x08, 0x8d, 0x9c, 0xb5, 0xcd, 0xf6, 0x2e, 0xdf, 0x8d, 0x6a, 0xd0, 0xa7,
+	0xd4, 0xe9, 0x1f, 0x86, 0xd3, 0xdc, 0x8a, 0x2c, 0x38, 0x3f, 0x13, 0x44, 0xe4, 0x7a, 0xac, 0xe0,
+	0x94, 0xe3, 0x8a, 0xf9, 0xe3, 0xa2, 0xda, 0x52, 0x8e, 0xbc, 0x97, 0xae, 0x79, 0x71, 0xc3, 0x17,
+	0xa6, 0x14, 0x6a, 0xb8, 0x9b, 0xdc, 0xa2, 0xf7, 0xa3, 0x6c, 0xd1, 0x8b, 0xb1, 0x68, 0x6b, 0x6a,
+	0xe9, 0x92, 0xe0, 0x96, 0xae, 0x7a, 0x2f, 0x40, 0xa5, 0x37, 0x62, 0x8e, 0xef, 0x71, 0x9d, 0x3f,
+	0xf4, 0xbb, 0x32, 0xfe, 0x97, 0x11, 0xf8, 0x40, 0xc0, 0x72, 0xfd, 0xda, 0x62, 0xbe, 0x5f, 0x9b,
+	0xdb, 0x51, 0xfd, 0x99, 0x06, 0x24, 0xbb, 0xdb, 0xa9, 0xed, 0x26, 0x6d, 0x7a, 0xbb, 0x29, 0xd7,
+	0x74, 0x0b, 0x53, 0x4c, 0xf7, 0x32, 0xd4, 0x27, 0x5d, 0x1b, 0x66, 0xfb, 0x01, 0x65, 0xb2, 0x90,
+	0xac, 0x45, 0xf0, 0x3d, 0x01, 0xd6, 0x7f, 0xa1, 0xc1, 0x5a, 0x9e, 0x0c, 0x79, 0xbf, 0x51, 0xd5,
+	0xad, 0xd2, 0x44, 0xa3, 0x6f, 0xde, 0xde, 0xee, 0x39, 0xd4, 0x8d, 0x4a, 0x58, 0xf9, 0x45, 0xda,
+	0x20, 0x1b, 0x08, 0x66, 0x6f, 0xf4, 0xfc, 0xf9, 0x58, 0x7a, 0x3e, 0x39, 0xf5, 0x2a, 0xa2, 0xee,
+	0x70, 0x0c, 0xce, 0xc4, 0xe5, 0x88, 0x84, 0xe9, 0x8e, 0x5b, 0x55, 0x80, 0x27, 0xbb, 0x7f, 0x13,
+	0xd6, 0x24, 0xe3, 0xe1, 0x61, 0x60, 0x31, 0xaa, 0x38, 0x97, 0x04, 0x67, 0x82, 0xb8, 0x87, 0x02,
+	0x85, 0xac, 0xf5, 0x3f, 0x8d, 0xd2, 0x11, 0xe5, 0x30, 0x64, 0x84, 0x78, 0x37, 0x1d, 0x21, 0x2e,
+	0x1c, 0xeb, 0x09, 0xd2, 0x51, 0xe2, 0x66, 0x26, 0x4a, 0x6c, 0x1d, 0x3b, 0x3e, 0x27, 0x56, 0xfc,
+	0x97, 0x06, 0x24, 0x3b, 0x05, 0x69, 0x43, 0x49, 0x44, 0x59, 0xd9, 0xda, 0x6e, 0xa6, 0x1c, 0x8c,
+	0x28, 0xf2, 0x44, 0xf5, 0x88, 0x64, 0x3c, 0x50, 0xf7, 0x1c, 0x8f, 0xc7, 0x17, 0x7e, 0x92, 0x68,
+	0xdc, 0x06, 0x08, 0x90, 0x38, 0x5b, 0x1e, 0xec, 0xa5, 0x3d, 0x22, 0x85, 0x30, 0x63, 0x63, 0x05,
+	0x61, 0x48, 0xb2, 0x05, 0x91, 0xa9, 0x48, 0x22, 0x94, 0x7f, 0x45, 0x41, 0x91, 0xec, 0x43, 0xa8,
+	0x0b, 0xac, 0x69, 0xfb, 0x83, 0xa1, 0xef, 0x51, 0x2f, 0x64, 0xcd, 0xd2, 0x94, 0x8e, 0xb9, 0x18,
+	0xb1, 0x1b, 0xd1, 0x19, 0x35, 0x96, 0x04, 0xe8, 0xff, 0x52, 0x80, 0x5a, 0x8a, 0x88, 0xec, 0x40,
+	0x63, 0xe2, 0x3a, 0xc2, 0xc0, 0x39, 0x18, 0x89, 0x2a, 0x07, 0xed, 0x81, 0x44, 0xbe, 0x21, 0xc2,
+	0x90, 0xb7, 0x60, 0x3d, 0xee, 0x21, 0x26, 0x43, 0x50, 0x0c, 0x6b, 0x31, 0x07, 0x30, 0x19, 0xb4,
+	0x05, 0x55, 0x75, 0x08, 0xe6, 0x81, 0xef, 0x33, 0xe9, 0xd9, 0x8c, 0x8a, 0x82, 0x76, 0x38, 0x90,
+	0x93, 0x0d, 0x7d, 0xe6, 0xf0, 0x21, 0x92, 0x4c, 0x0a, 0x45, 0x41, 0x91, 0xec, 0x33, 0xa8, 0xa8,
+	0xa6, 0x3b, 0x5a, 0xd8, 0xb4, 0xbe, 0x54, 0x6a, 0xb3, 0xaa, 0xd3, 0x2e, 0x06, 0x61, 0x98, 0x2c,
+	0xdb, 0x31, 0x50, 0xeb, 0x7d, 0x58, 0xcd, 0x90, 0xcc, 0x0a, 0x96, 0x85, 0x78, 0xb0, 0xfc, 0xb2,
+	0x00, 0x6b, 0x79, 0x3a, 0xc8, 0x83, 0x92, 0x14, 0x73, 0xa4, 0xc3, 0xda, 0x7c, 0x99, 0x4e, 0x15,
+	0xc7, 0x45, 0x9c, 0xf6, 0x61, 0x35, 0x92, 0x7f, 0xca, 0x1e, 0x2e, 0xcd, 0xf0, 0xd1, 0x11, 0xcf,
+	0xba, 0xe2, 0x10, 0x5f, 0xdf, 0xc4, 0x4f, 0x23, 0xcf, 0x69, 0x97, 0x29, 0x77, 0x94, 0xeb, 0x96,
+	0xeb, 0xeb, 0x25, 0xbe, 0xf5, 0xbf, 0x2b, 0xc0, 0x46, 0xfe, 0xb4, 0x3c, 0x18, 0x60, 0xc2, 0x35,
+	0xb0, 0x42, 0xfb, 0x50, 0x74, 0x6f, 0x79, 0xbe, 0x55, 0x16, 0xc0, 0x07, 0x08, 0xcb, 0x49, 0xb7,
+	0x0a, 0x39, 0xe9, 0xd6, 0x05, 0xa8, 0x28, 0x9f, 0x88, 0x19, 0x17, 0xe6, 0xdd, 0x65, 0x05, 0x14,
+	0x49, 0xd7, 0x16, 0x54, 0xe9, 0xb3, 0xa1, 0xe5, 0x75, 0x69, 0xd7, 0x0c, 0x69, 0x30, 0x50, 0x59,
+	0x77, 0x45, 0x41, 0xf7, 0x39, 0x90, 0x3c, 0x4a, 0xa4, 0x5b, 0xa8, 0x4b, 0x6f, 0xcf, 0x29, 0xca,
+	0x5f, 0x59, 0xda, 0xf5, 0x97, 0x1a, 0x54, 0x93, 0x92, 0xce, 0xc6, 0x52, 0x2d, 0x27, 0x96, 0x5e,
+	0xc8, 0x4d, 0x3d, 0x52, 0x99, 0xc6, 0xa5, 0x29, 0x99, 0x46, 0x26, 0xb1, 0xb8, 0x08, 0xf2, 0x78,
+	0x53, 0x1d, 0x27, 0x39, 0x27, 0x1e, 0x86, 0xfe, 0x57, 0x1a, 0x90, 0xbb, 0x34, 0x4c, 0x5f, 0xac,
+	0x6e, 0xe7, 0x74, 0x14, 0x27, 0x29, 0x62, 0xbc, 0x00, 0xdf, 0x82, 0xaa, 0x4a, 0x00, 0x12, 0xd1,
+	0xad, 0x22, 0xa1, 0x77, 0x04, 0x30, 0x4e, 0x86, 0x3d, 0x39, 0x19, 0xdf, 0x14, 0x19, 0x76, 0xe3,
+	0xa6, 0xd4, 0x3e, 0x0b, 0x53, 0x6a, 0x1f, 0xfd, 0x9f, 0x35, 0x68, 0x24, 0x56, 0x2f, 0xc3, 0x55,
+	0xa6, 0xbd, 0xa8, 0x9d, 0xb0, 0xbd, 0xc8, 0x5b, 0x4a, 0xaa, 0xe9, 0x23, 0x5b, 0x4a, 0xf2, 0x93,
+	0xbc, 0x09, 0xa7, 0xa3, 0x1d, 0x14, 0x8f, 0x0d, 0x39, 0x92, 0x8e, 0xdc, 0x80, 0x12, 0x0b, 0xad,
+	0x90, 0x4d, 0x4d, 0xed, 0xd4, 0x3a, 0x78, 0x8b, 0xc2, 0x61, 0xa1, 0x63, 0x33, 0x03, 0x47, 0xe8,
+	0xbf, 0x28, 0x02, 0xc9, 0x62, 0x79, 0x90, 0x8a, 0xf7, 0x37, 0xa5, 0x31, 0xae, 0xc4, 0xfa, 0x9a,
+	0x13, 0x92, 0xd0, 0x7f, 0x4c, 0x65, 0x02, 0xaf, 0x48, 0xf6, 0x05, 0x88, 0xf7, 0x25, 0xad, 0x23,
+	0x1a, 0x58, 0x7d, 0x6a, 0xc6, 0xee, 0x08, 0xb1, 0x87, 0x59, 0x97, 0x98, 0xdd, 0xe8, 0x7a, 0xf0,
+	0x2c, 0xac, 0x60, 0x6e, 0x6e, 0xfb, 0x23, 0x2f, 0x94, 0xad, 0x11, 0xac, 0x23, 0x76, 0x39, 0x84,
+	0xbc, 0x0f, 0x15, 0xd7, 0x62, 0xa1, 0x69, 0xd9, 0x36, 0x65, 0xdc, 0xae, 0x67, 0x77, 0x42, 0xca,
+	0x7c, 0xc0, 0x4d, 0x49, 0x4f, 0x8e, 0x60, 0x73, 0x52, 0x44, 0x9b, 0x5d, 0x87, 0x4d, 0x02, 0x14,
+	0x16, 0x52, 0xef, 0xcd, 0x21, 0xb9, 0xf6, 0xae, 0x2a, 0xba, 0x6f, 0xc5, 0x18, 0xa0, 0x85, 0xaf,
+	0xdb, 0x79, 0xb8, 0x64, 0x62, 0x8a, 0x27, 0xb5, 0x28, 0x1e, 0x20, 0x4c, 0x12, 0x53, 0xce, 0x9f,
+	0xb5, 0xee, 0x41, 0x6b, 0x3a, 0xf7, 0x59, 0x2e, 0xa2, 0x14, 0x77, 0x11, 0xff, 0xaa, 0x41, 0x33,
+	0xa6, 0xb8, 0x78, 0x66, 0x27, 0x37, 0xbe, 0x0f, 0xf8, 0x45, 0x45, 0xdf, 0xf1, 0xac, 0x28, 0x8a,
+	0xab, 0xe4, 0x22, 0xd6, 0x01, 0x8b, 0x08, 0x24, 0x7f, 0x23, 0x36, 0xe6, 0xe4, 0x25, 0xda, 0x09,
+	0x2d, 0xf4, 0xc7, 0x1a, 0x7c, 0x27, 0x67, 0xa3, 0xd2, 0x4e, 0x27, 0xb6, 0xa4, 0xcd, 0x69, 0x4b,
+	0x37, 0x73, 0x76, 0x7c, 0xfe, 0x98, 0x1d, 0xe3, 0x44, 0xf1, 0x2d, 0xf3, 0x24, 0x77, 0xfd, 0x2e,
+	0x95, 0x4b, 0xe9, 0x8c, 0xef, 0x77, 0x23, 0xc1, 0x6f, 0x01, 0x5e, 0x87, 0x8b, 0x2e, 0x8f, 0xb8,
+	0x86, 0xea, 0x2c, 0xbd, 0xe8, 0x94, 0xbe, 0xd2, 0x0a, 0x4b, 0x9a, 0xb1, 0x24, 0x50, 0xf7, 0xbb,
+	0xd3, 0x44, 0x50, 0x98, 0xd6, 0xa0, 0xc9, 0x2b, 0x91, 0x8a, 0xb9, 0x25, 0x92, 0x3e, 0x86, 0x8d,
+	0xf4, 0xca, 0xbe, 0xb1, 0xa4, 0xde, 0x80, 0x86, 0xe7, 0x87, 0x66, 0xcf, 0x1f, 0x79, 0x5d, 0x73,
+	0xb2, 0x2d, 0xf4, 0xce, 0x75, 0xcf, 0x0f, 0xef, 0x70, 0xcc, 0xae, 0xdc, 0x94, 0xfe, 0x39, 0xac,
+	0xdf, 0xa2, 0x2e, 0x0d, 0xe9, 0x37, 0x0f, 0x05, 0xd7, 0xd3, 0xed, 0x82, 0x6c, 0x1b, 0x11, 0xa7,
+	0x48, 0x37, 0x0a, 0xf4, 0xbf, 0xd5, 0xa0, 0x92, 0x40, 0x71, 0xf7, 0xd5, 0xf3, 0x03, 0x9b, 0x9a,
+	0x5d, 0x01, 0x16, 0xd3, 0x2e, 0x19, 0x2b, 0x02, 0x86, 0x94, 0x3c, 0x5c, 0x22, 0x52, 0x79, 0x41,
+	0x3c, 0x81, 0x32, 0x02, 0xa5, 0x1b, 0x7c, 0x0d, 0x56, 0x25, 0x51, 0xec, 0xa8, 0x50, 0xfa, 0x75,
+	0x44, 0xc4, 0x4e, 0x6a, 0x0b, 0xaa, 0x92, 0x58, 0xde, 0xe0, 0x48, 0xbd, 0x96, 0xf3, 0xdc, 0x47,
+	0x20, 0x2f, 0xe4, 0x02, 0x6a, 0x31, 0xdf, 0x93, 0x5d, 0x20, 0xf9, 0xc5, 0x15, 0x6b, 0x23, 0x2d,
+	0x43, 0x79, 0x7c, 0x93, 0x36, 0xb5, 0x76, 0xb2, 0x36, 0xf5, 0x6d, 0xa8, 0xda, 0x2e, 0xb5, 0xbc,
+	0xd1, 0x50, 0xdd, 0xbe, 0x4d, 0x13, 0xed, 0x2e, 0x92, 0xc9, 0xd2, 0xab, 0x62, 0xc7, 0x3f, 0xf5,
+	0x3f, 0x28, 0x40, 0x25, 0x41, 0xc0, 0xf7, 0x2a, 0x2f, 0xc7, 0x70, 0x73, 0x51, 0xa3, 0x0d, 0xa1,
+	0xb8, 0x8f, 0x2e, 0xd7, 0xf5, 0xd8, 0xbd, 0x98, 0x22, 0x45, 0x7f, 0xb6, 0x3a, 0xc1, 0x28, 0xf2,
+	0xd8, 0x9d, 0x98, 0xa2, 0x4d, 0xde, 0x89, 0x29, 0xc2, 0x36, 0x7f, 0x08, 0xe2, 0x8b, 0xd8, 0xd3,
+	0x0b, 0x28, 0xed, 0x9a, 0x07, 0xe3, 0x90, 0xaa, 0x14, 0x65, 0x55, 0xa2, 0xee, 0x70, 0x4c, 0x87,
+	0x23, 0xc8, 0x47, 0xb0, 0x2e, 0x18, 0xf2, 0x7c, 0x86, 0xd7, 0x53, 0x2e, 0x9d, 0xbb, 0xdd, 0xde,
+	0x50, 0x03, 0x77, 0xd5, 0xb8, 0x9b, 0xa1, 0xfe, 0x37, 0x1a, 0x7f, 0x53, 0xd6, 0xb5, 0xc2, 0xc8,
+	0xf8, 0x4e, 0xae, 0xef, 0x6f, 0x65, 0xaa, 0xda, 0x39, 0x2e, 0x6b, 0x2e, 0x40, 0x65, 0x24, 0xe6,
+	0x55, 0xe9, 0x52, 0x51, 0x18, 0x64, 0x19, 0x81, 0x93, 0x6c, 0x69, 0x40, 0x83, 0x7e, 0xcc, 0x61,
+	0x48, 0x45, 0x14, 0xd0, 0xc8, 0x5d, 0xfc, 0x5c, 0xe3, 0x57, 0xc2, 0xc9, 0x4d, 0x7c, 0x5b, 0x85,
+	0xeb, 0x40, 0x1d, 0x97, 0xd2, 0x35, 0xe7, 0xdd, 0x5c, 0x4d, 0x0e, 0x50, 0x00, 0xee, 0xf1, 0xd4,
+	0x58, 0xf3, 0x88, 0x06, 0x4c, 0xdd, 0xbd, 0x95, 0x8c, 0x9a, 0x82, 0x7f, 0x8a, 0x60, 0xfd, 0x0b,
+	0xd8, 0x30, 0xa8, 0xd0, 0x8d, 0x6f, 0xee, 0x77, 0x6e, 0xa4, 0xfd, 0x4e, 0xb6, 0xf0, 0x91, 0x73,
+	0x64, 0x1c, 0xcf, 0x7f, 0x6a, 0x50, 0x4d, 0xe2, 0x66, 0xbf, 0x52, 0xe1, 0xb9, 0xbc, 0x70, 0x4d,
+	0x01, 0x0e, 0x54, 0x7e, 0x47, 0x00, 0x25, 0x33, 0x62, 0xc0, 0x9a, 0x47, 0x9f, 0x9a, 0x99, 0x27,
+	0x73, 0xc5, 0x39, 0x9f, 0xcc, 0x11, 0x8f, 0x3e, 0x4d, 0xc1, 0xc8, 0xc7, 0xd0, 0xe0, 0x3c, 0xd3,
+	0x2f, 0xe7, 0x16, 0xe6, 0x7b, 0x39, 0xb7, 0xea, 0xd1, 0xa7, 0x49, 0x90, 0xfe, 0x7b, 0x1a, 0x6c,
+	0x66, 0xa4, 0xff, 0x6d, 0x15, 0xe8, 0x6d, 0xee, 0x1d, 0x8f, 0xf5, 0x54, 0x72, 0x4a, 0xe9, 0xa9,
+	0x24, 0xb5, 0xfe, 0xf7, 0x1a, 0x54, 0x12, 0x98, 0xb8, 0x33, 0x09, 0xe8, 0xc1, 0xc8, 0x71, 0x43,
+	0x79, 0x1a, 0xca, 0x99, 0x18, 0x08, 0xe5, 0xfa, 0x26, 0x7d, 0x99, 0x3c, 0x92, 0xc8, 0x45, 0xd5,
+	0x6c, 0x99, 0x70, 0x48, 0x30, 0xcf, 0x79, 0x03, 0x1a, 0x49, 0x30, 0x79, 0x2b, 0x50, 0x9f, 0x60,
+	0x64, 0xa9, 0xfa, 0x2e, 0x94, 0x13, 0xce, 0x66, 0x61, 0xa6, 0xb3, 0x59, 0xb1, 0x63, 0x4e, 0xe6,
+	0x87, 0x22, 0x9c, 0xef, 0xa1, 0x33, 0x13, 0x29, 0xa4, 0x52, 0xee, 0x33, 0x00, 0x62, 0xee, 0xc0,
+	0xf2, 0xfa, 0xd1, 0x3b, 0x20, 0x0e, 0x31, 0x38, 0x80, 0x47, 0x3f, 0x6c, 0x56, 0x4a, 0x25, 0xc4,
+	0xa0, 0xbd, 0x82, 0x30, 0xa1, 0x85, 0xfc, 0x29, 0xcd, 0x66, 0x86, 0xb9, 0x3c, 0xbb, 0xbb, 0xfc,
+	0x65, 0xa0, 0x80, 0xcb, 0x74, 0x56, 0x9b, 0xf2, 0x8e, 0x2a, 0x36, 0x5a, 0xd6, 0x1d, 0x65, 0x16,
+	0x63, 0x48, 0xf6, 0x60, 0x75, 0x48, 0x83, 0x1e, 0xef, 0x7e, 0x7a, 0xb6, 0x62, 0x86, 0xc7, 0xfa,
+	0x6a, 0xf6, 0x51, 0xc2, 0x84, 0x32, 0xc6, 0xb0, 0x3e, 0x4c, 0x82, 0x79, 0x0a, 0xb7, 0x32, 0x62,
+	0x93, 0xb5, 0x4d, 0xb3, 0x88, 0x47, 0x2c, 0xb9, 0x32, 0x18, 0xb1, 0x68, 0x5d, 0xe2, 0x5c, 0x5c,
+	0x97, 0xda, 0x27, 0x39, 0x17, 0x49, 0x7f, 0x33, 0xd4, 0x7f, 0xba, 0x00, 0xab, 0x99, 0xad, 0x73,
+	0x75, 0xc3, 0x8a, 0x49, 0xb9, 0x16, 0x94, 0x5b, 0xd1, 0xc0, 0xab, 0x26, 0x65, 0x21, 0xd9, 0xea,
+	0x0b, 0x9b, 0x1c, 0x89, 0xea, 0xeb, 0x32, 0xd4, 0x91, 0x24, 0x95, 0x75, 0x14, 0x0d, 0x9c, 0x23,
+	0x96, 0x74, 0xbc, 0x0e, 0x44, 0x1d, 0xd6, 0x88, 0xa5, 0x02, 0x61, 0x5d, 0x62, 0x1e, 0x31, 0x15,
+	0x07, 0xb7, 0xa1, 0x8e, 0xde, 0x89, 0xd7, 0x6a, 0x92, 0xb6, 0x84, 0xab, 0x14, 0x70, 0x5e, 0xaa,
+	0x21, 0xe5, 0x8f, 0xa0, 0xa6, 0xf8, 0x1e, 0xc8, 0x07, 0xa2, 0xa7, 0xa7, 0xbc, 0x12, 0xcc, 0xc8,
+	0x42, 0x41, 0x3a, 0xe2, 0x11, 0x29, 0x16, 0x4f, 0x15, 0x16, 0x87, 0x11, 0x07, 0x1a, 0x91, 0x9c,
+	0xf8, 0x04, 0xd2, 0x59, 0x2c, 0x4e, 0x79, 0xc9, 0x99, 0x9d, 0x22, 0x92, 0x67, 0x67, 0x8c, 0x1e,
+	0x04, 0xa7, 0x59, 0xed, 0xa6, 0xe1, 0xad, 0x0f, 0x80, 0x64, 0xd7, 0x33, 0xab, 0xdc, 0x2a, 0xc6,
+	0xca, 0xad, 0xd6, 0x2d, 0xd8, 0xc8, 0x9f, 0xee, 0x24, 0x5c, 0xf4, 0x3f, 0x2f, 0xc2, 0x7a, 0xae,
+	0x92, 0x93, 0xab, 0xb0, 0x6e, 0x1d, 0xf5, 0x65, 0x7f, 0xdd, 0x74, 0xad, 0x90, 0x7a, 0xf6, 0x98,
+	0x3b, 0x16, 0xd9, 0x8b, 0xb5, 0x8e, 0xfa, 0xd8, 0x7b, 0xfa, 0x35, 0x44, 0x3d, 0x60, 0xe4, 0x7b,
+	0xb0, 0xc9, 0x87, 0x44, 0xae, 0x28, 0x36, 0x48, 0x76, 0x63, 0xad, 0xa3, 0xbe, 0xf2, 0xd7, 0x93,
+	0x61, 0xfc, 0x2d, 0x1c, 0xce, 0xf2, 0x64, 0xc8, 0x64, 0x50, 0x5d, 0x46, 0xc8, 0x27, 0x43, 0xa1,
+	0x9a, 0x11, 0xc7, 0x27, 0x43, 0x54, 0xa3, 0x92, 0xb1, 0xa2, 0x60, 0x9c, 0xe4, 0x22, 0x54, 0x6d,
+	0xcb, 0x3e, 0xa4, 0xe6, 0xa1, 0x13, 0x9a, 0x81, 0x15, 0xe2, 0xc3, 0xe8, 0x82, 0x51, 0x16, 0xd0,
+	0x7b, 0x4e, 0x68, 0x58, 0x21, 0x25, 0x3e, 0x34, 0xd4, 0x8a, 0x86, 0x34, 0xb0, 0xa9, 0x17, 0x3a,
+	0x2e, 0x65, 0x53, 0xeb, 0xf0, 0x5c, 0xb1, 0xb4, 0xe5, 0xb2, 0x1f, 0x4e, 0x18, 0xc8, 0x27, 0x44,
+	0x6e, 0x06, 0xc1, 0x9f, 0x10, 0x4d, 0x21, 0x3f, 0x51, 0x17, 0xf7, 0xe7, 0x0b, 0x50, 0x4b, 0x79,
+	0x8e, 0xcc, 0x9d, 0xb2, 0xb2, 0xeb, 0xc4, 0x9d, 0x32, 0x23, 0xd7, 0xa1, 0x99, 0xb2, 0x7f, 0x73,
+	0x24, 0xde, 0xac, 0xc8, 0x68, 0x52, 0x34, 0x36, 0x92, 0x8e, 0xe0, 0x91, 0xc4, 0x92, 0xb7, 0x61,
+	0x33, 0x3d, 0x32, 0x9e, 0xfd, 0x16, 0x8d, 0xf5, 0xe4, 0x40, 0x95, 0x04, 0xff, 0x3a, 0xd4, 0xd5,
+	0x92, 0x22, 0x1b, 0x5d, 0x98, 0xf2, 0xda, 0x30, 0xb5, 0xa9, 0xb6, 0x5a, 0x76, 0xdc, 0x44, 0xab,
+	0x2c, 0x01, 0x24, 0x87, 0xb0, 0x86, 0x3b, 0x10, 0xec, 0x27, 0xcf, 0x8f, 0xb0, 0x4f, 0xfa, 0xfd,
+	0x99, 0x73, 0xe0, 0x06, 0x59, 0x67, 0x7c, 0x47, 0xbe, 0x4f, 0x92, 0x26, 0x3a, 0x4a, 0xc3, 0xb9,
+	0x4f, 0xe7, 0xd7, 0xaa, 0xbc, 0x1b, 0xe4, 0x44, 0x6a, 0x92, 0xf5, 0xe9, 0xfb, 0xfe, 0xf0, 0x13,
+	0x24, 0xc1, 0x80, 0x05, 0x61, 0x04, 0xe0, 0xcf, 0xe4, 0x73, 0xf6, 0x74, 0x52, 0x33, 0xcf, 0x5f,
+	0xf2, 0x89, 0xcc, 0xfc, 0x2f, 0x34, 0xa8, 0xa5, 0x16, 0xca, 0xa9, 0x45, 0xa7, 0x4b, 0x72, 0xc0,
+	0x0f, 0x0e, 0xc5, 0x66, 0x98, 0xec, 0xef, 0x88, 0x0f, 0x6e, 0x60, 0xdc, 0xb2, 0x63, 0x06, 0x8d,
+	0x0d, 0xda, 0xb2, 0x75, 0x14, 0x33, 0x64, 0xd5, 0x2d, 0xa3, 0xcf, 0xa8, 0x3d, 0x0a, 0x69, 0x77,
+	0x8e, 0x18, 0x26, 0xba, 0x65, 0xb7, 0x25, 0xfd, 0xb5, 0xbf, 0x2e, 0xc3, 0xd2, 0x2d, 0xdf, 0xe6,
+	0x9e, 0x91, 0x92, 0xcf, 0xa1, 0x9a, 0x7c, 0x5f, 0x45, 0xb2, 0xf1, 0x39, 0xf7, 0x2f, 0x34, 0xad,
+	0x4b, 0x33, 0xe9, 0x30, 0xa9, 0xd0, 0x9b, 0xbf, 0xf3, 0x8f, 0xbf, 0xfc, 0xa3, 0x02, 0xd1, 0x2b,
+	0x3b, 0xf8, 0x6f, 0x24, 0x81, 0x65, 0xef, 0x68, 0x57, 0xc8, 0x8f, 0x35, 0x68, 0xe4, 0xbc, 0xee,
+	0x22, 0xaf, 0xcd, 0x60, 0x1d, 0x7f, 0x6a, 0xd7, 0x7a, 0x7d, 0x3e, 0x62, 0xb9, 0x98, 0x57, 0xc4,
+	0x62, 0x9a, 0x7a, 0x23, 0xb1, 0x98, 0x1d, 0xf1, 0x3c, 0x9b, 0x2f, 0xe9, 0x0b, 0xf5, 0xaa, 0x48,
+	0xbe, 0x5c, 0x21, 0x5b, 0x53, 0xee, 0x6a, 0x92, 0xcf, 0xae, 0x5a, 0xaf, 0xce, 0x22, 0x93, 0xf3,
+	0x9f, 0x11, 0xf3, 0x6f, 0xbe, 0xa3, 0x5d, 0xd1, 0x09, 0x5f, 0x02, 0x1a, 0xde, 0x8e, 0xbc, 0x53,
+	0x26, 0x63, 0x28, 0xc7, 0xaf, 0x51, 0xc9, 0xc5, 0x29, 0x6c, 0x13, 0xcf, 0x32, 0x5a, 0x5b, 0x33,
+	0xa8, 0xe4, 0xdc, 0x2f, 0x8b, 0xb9, 0x37, 0xf4, 0xd5, 0xd8, 0xc4, 0x87, 0x82, 0x04, 0x77, 0xbe,
+	0x12, 0xeb, 0xb7, 0x91, 0x6c, 0xb3, 0x39, 0xdb, 0xed, 0x6f, 0x5d, 0x3c, 0x9e, 0x48, 0xce, 0x7b,
+	0x41, 0xcc, 0x7b, 0x86, 0xbc, 0x94, 0x94, 0xf9, 0xe7, 0xb1, 0x2a, 0xed, 0x0b, 0xf2, 0x13, 0x0d,
+	0x56, 0x33, 0xfd, 0x3e, 0x72, 0xf9, 0xb8, 0x09, 0x12, 0xcd, 0xcf, 0xd6, 0x95, 0x79, 0x48, 0xe5,
+	0x8a, 0xae, 0x88, 0x15, 0x5d, 0x24, 0xfa, 0x31, 0x2b, 0xda, 0x91, 0xed, 0xb0, 0xdf, 0x82, 0x6a,
+	0xb2, 0xb5, 0x96, 0x63, 0x21, 0xb9, 0x5d, 0xc1, 0xd6, 0xa5, 0x99, 0x74, 0x72, 0x39, 0x2f, 0x89,
+	0xe5, 0xac, 0xeb, 0x75, 0xbe, 0x1c, 0x9c, 0x76, 0xe7, 0x80, 0x5f, 0x8d, 0xf1, 0x73, 0xf9, 0x5d,
+	0x0d, 0xaa, 0xe8, 0xf7, 0x8f, 0x31, 0xd1, 0xdc, 0x0e, 0x5c, 0xeb, 0xd2, 0x4c, 0xba, 0xe4, 0x09,
+	0x5d, 0x39, 0xf6, 0x84, 0xbe, 0xd2, 0xb8, 0xaf, 0x88, 0x37, 0x0d, 0x72, 0x7d, 0x45, 0x4e, 0x6b,
+	0xa4, 0x75, 0x69, 0x26, 0x9d, 0x5c, 0xc8, 0x8e, 0x58, 0xc8, 0xe5, 0xd6, 0xc5, 0xe3, 0x0e, 0x46,
+	0xb5, 0x02, 0xb8, 0x74, 0xfe, 0x58, 0x83, 0x5a, 0xaa, 0x12, 0x25, 0x97, 0xa6, 0x15, 0x8e, 0x69,
+	0xf9, 0x6c, 0xcf, 0x26, 0x94, 0xeb, 0x6a, 0x8b, 0x75, 0x6d, 0xeb, 0x17, 0x8e, 0x5b, 0x97, 0xac,
+	0x02, 0xf9, 0xb2, 0x7e, 0x1b, 0x6a, 0xa9, 0x1a, 0x8b, 0xe4, 0x6a, 0x43, 0x4e, 0x89, 0xd7, 0xda,
+	0x9e, 0x4d, 0x28, 0x57, 0xf5, 0x1d, 0xb1, 0xaa, 0x06, 0x41, 0x83, 0xe6, 0xa8, 0x1d, 0x99, 0x6b,
+	0x93, 0x1f, 0xc1, 0xca, 0x3d, 0x6a, 0xb9, 0xe1, 0xe1, 0xee, 0x21, 0xb5, 0x1f, 0x93, 0x8d, 0x4c,
+	0x74, 0xb8, 0xcd, 0xff, 0xc3, 0xd9, 0xd2, 0x53, 0xc5, 0x79, 0x6c, 0x4c, 0x34, 0x0b, 0x11, 0xb3,
+	0x94, 0x09, 0xf0, 0x59, 0x0e, 0x05, 0x41, 0xe7, 0x35, 0x48, 0xff, 0x87, 0xf4, 0xa1, 0xf6, 0xc3,
+	0x8d, 0xc0, 0xea, 0x8b, 0xbf, 0x90, 0x2a, 0xf0, 0xce, 0xd1, 0xd5, 0x1f, 0x1c, 0x5d, 0x3d, 0x38,
+	0x2d, 0x26, 0x7d, 0xeb, 0x7f, 0x07, 0x00, 0xf1, 0x20, 0xe9, 0x41, 0x8e, 0x3a, 0x00, 0x00,
 }
@@ -1,369 +0,0 @@
-// Code generated by protoc-gen-go. DO NOT EDIT.
-// source: api/embedding/v1/embedding.proto
-
-package v1
-
-import (
-	fmt "fmt"
-	proto "github.com/golang/protobuf/proto"
-	math "math"
-)
-
-// Reference imports to suppress errors if they are not otherwise used.
-var _ = proto.Marshal
-var _ = fmt.Errorf
-var _ = math.Inf
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the proto package it is being compiled against.
-// A compilation error at this line likely means your copy of the
-// proto package needs to be updated.
-const _ = proto.ProtoPackageIsVersion3 // please upgrade the proto package
-
-type CreateEmbeddingRequest struct {
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
-}
-
-func (m *CreateEmbeddingRequest) Reset()         { *m = CreateEmbeddingRequest{} }
-func (m *CreateEmbeddingRequest) String() string { return proto.CompactTextString(m) }
-func (*CreateEmbeddingRequest) ProtoMessage()    {}
-func (*CreateEmbeddingRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d9e33339e8a1530f, []int{0}
-}
-
-func (m *CreateEmbeddingRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_CreateEmbeddingRequest.Unmarshal(m, b)
-}
-func (m *CreateEmbeddingRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_CreateEmbeddingRequest.Marshal(b, m, deterministic)
-}
-func (m *CreateEmbeddingRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_CreateEmbeddingRequest.Merge(m, src)
-}
-func (m *CreateEmbeddingRequest) XXX_Size() int {
-	return xxx_messageInfo_CreateEmbeddingRequest.Size(m)
-}
-func (m *CreateEmbeddingRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_CreateEmbeddingRequest.DiscardUnknown(m)
-}
-
-var xxx_messageInfo_CreateEmbeddingRequest proto.InternalMessageInfo
-
-type CreateEmbeddingReply struct {
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
-}
-
-func (m *CreateEmbeddingReply) Reset()         { *m = CreateEmbeddingReply{} }
-func (m *CreateEmbeddingReply) String() string { return proto.CompactTextString(m) }
-func (*CreateEmbeddingReply) ProtoMessage()    {}
-func (*CreateEmbeddingReply) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d9e33339e8a1530f, []int{1}
-}
-
-func (m *CreateEmbeddingReply) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_CreateEmbeddingReply.Unmarshal(m, b)
-}
-func (m *CreateEmbeddingReply) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_CreateEmbeddingReply.Marshal(b, m, deterministic)
-}
-func (m *CreateEmbeddingReply) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_CreateEmbeddingReply.Merge(m, src)
-}
-func (m *CreateEmbeddingReply) XXX_Size() int {
-	return xxx_messageInfo_CreateEmbeddingReply.Size(m)
-}
-func (m *CreateEmbeddingReply) XXX_DiscardUnknown() {
-	xxx_messageInfo_CreateEmbeddingReply.DiscardUnknown(m)
-}
-
-var xxx_messageInfo_CreateEmbeddingReply proto.InternalMessageInfo
-
-type UpdateEmbeddingRequest struct {
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
-}
-
-func (m *UpdateEmbeddingRequest) Reset()         { *m = UpdateEmbeddingRequest{} }
-func (m *UpdateEmbeddingRequest) String() string { return proto.CompactTextString(m) }
-func (*UpdateEmbeddingRequest) ProtoMessage()    {}
-func (*UpdateEmbeddingRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d9e33339e8a1530f, []int{2}
-}
-
-func (m *UpdateEmbeddingRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_UpdateEmbeddingRequest.Unmarshal(m, b)
-}
-func (m *UpdateEmbeddingRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_UpdateEmbeddingRequest.Marshal(b, m, deterministic)
-}
-func (m *UpdateEmbeddingRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_UpdateEmbeddingRequest.Merge(m, src)
-}
-func (m *UpdateEmbeddingRequest) XXX_Size() int {
-	return xxx_messageInfo_UpdateEmbeddingRequest.Size(m)
-}
-func (m *UpdateEmbeddingRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_UpdateEmbeddingRequest.DiscardUnknown(m)
-}
-
-var xxx_messageInfo_UpdateEmbeddingRequest proto.InternalMessageInfo
-
-type UpdateEmbeddingReply struct {
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
-}
-
-func (m *UpdateEmbeddingReply) Reset()         { *m = UpdateEmbeddingReply{} }
-func (m *UpdateEmbeddingReply) String() string { return proto.CompactTextString(m) }
-func (*UpdateEmbeddingReply) ProtoMessage()    {}
-func (*UpdateEmbeddingReply) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d9e33339e8a1530f, []int{3}
-}
-
-func (m *UpdateEmbeddingReply) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_UpdateEmbeddingReply.Unmarshal(m, b)
-}
-func (m *UpdateEmbeddingReply) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_UpdateEmbeddingReply.Marshal(b, m, deterministic)
-}
-func (m *UpdateEmbeddingReply) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_UpdateEmbeddingReply.Merge(m, src)
-}
-func (m *UpdateEmbeddingReply) XXX_Size() int {
-	return xxx_messageInfo_UpdateEmbeddingReply.Size(m)
-}
-func (m *UpdateEmbeddingReply) XXX_DiscardUnknown() {
-	xxx_messageInfo_UpdateEmbeddingReply.DiscardUnknown(m)
-}
-
-var xxx_messageInfo_UpdateEmbeddingReply proto.InternalMessageInfo
-
-type DeleteEmbeddingRequest struct {
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
-}
-
-func (m *DeleteEmbeddingRequest) Reset()         { *m = DeleteEmbeddingRequest{} }
-func (m *DeleteEmbeddingRequest) String() string { return proto.CompactTextString(m) }
-func (*DeleteEmbeddingRequest) ProtoMessage()    {}
-func (*DeleteEmbeddingRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d9e33339e8a1530f, []int{4}
-}
-
-func (m *DeleteEmbeddingRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_DeleteEmbeddingRequest.Unmarshal(m, b)
-}
-func (m *DeleteEmbeddingRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_DeleteEmbeddingRequest.Marshal(b, m, deterministic)
-}
-func (m *DeleteEmbeddingRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_DeleteEmbeddingRequest.Merge(m, src)
-}
-func (m *DeleteEmbeddingRequest) XXX_Size() int {
-	return xxx_messageInfo_DeleteEmbeddingRequest.Size(m)
-}
-func (m *DeleteEmbeddingRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_DeleteEmbeddingRequest.DiscardUnknown(m)
-}
-
-var xxx_messageInfo_DeleteEmbeddingRequest proto.InternalMessageInfo
-
-type DeleteEmbeddingReply struct {
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
-}
-
-func (m *DeleteEmbeddingReply) Reset()         { *m = DeleteEmbeddingReply{} }
-func (m *DeleteEmbeddingReply) String() string { return proto.CompactTextString(m) }
-func (*DeleteEmbeddingReply) ProtoMessage()    {}
-func (*DeleteEmbeddingReply) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d9e33339e8a1530f, []int{5}
-}
-
-func (m *DeleteEmbeddingReply) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_DeleteEmbeddingReply.Unmarshal(m, b)
-}
-func (m *DeleteEmbeddingReply) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_DeleteEmbeddingReply.Marshal(b, m, deterministic)
-}
-func (m *DeleteEmbeddingReply) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_DeleteEmbeddingReply.Merge(m, src)
-}
-func (m *DeleteEmbeddingReply) XXX_Size() int {
-	return xxx_messageInfo_DeleteEmbeddingReply.Size(m)
-}
-func (m *DeleteEmbeddingReply) XXX_DiscardUnknown() {
-	xxx_messageInfo_DeleteEmbeddingReply.DiscardUnknown(m)
-}
-
-var xxx_messageInfo_DeleteEmbeddingReply proto.InternalMessageInfo
-
-type GetEmbeddingRequest struct {
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
-}
-
-func (m *GetEmbeddingRequest) Reset()         { *m = GetEmbeddingRequest{} }
-func (m *GetEmbeddingRequest) String() string { return proto.CompactTextString(m) }
-func (*GetEmbeddingRequest) ProtoMessage()    {}
-func (*GetEmbeddingRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d9e33339e8a1530f, []int{6}
-}
-
-func (m *GetEmbeddingRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_GetEmbeddingRequest.Unmarshal(m, b)
-}
-func (m *GetEmbeddingRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_GetEmbeddingRequest.Marshal(b, m, deterministic)
-}
-func (m *GetEmbeddingRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GetEmbeddingRequest.Merge(m, src)
-}
-func (m *GetEmbeddingRequest) XXX_Size() int {
-	return xxx_messageInfo_GetEmbeddingRequest.Size(m)
-}
-func (m *GetEmbeddingRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_GetEmbeddingRequest.DiscardUnknown(m)
-}
-
-var xxx_messageInfo_GetEmbeddingRequest proto.InternalMessageInfo
-
-type GetEmbeddingReply struct {
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
-}
-
-func (m *GetEmbeddingReply) Reset()         { *m = GetEmbeddingReply{} }
-func (m *GetEmbeddingReply) String() string { return proto.CompactTextString(m) }
-func (*GetEmbeddingReply) ProtoMessage()    {}
-func (*GetEmbeddingReply) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d9e33339e8a1530f, []int{7}
-}
-
-func (m *GetEmbeddingReply) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_GetEmbeddingReply.Unmarshal(m, b)
-}
-func (m *GetEmbeddingReply) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_GetEmbeddingReply.Marshal(b, m, deterministic)
-}
-func (m *GetEmbeddingReply) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GetEmbeddingReply.Merge(m, src)
-}
-func (m *GetEmbeddingReply) XXX_Size() int {
-	return xxx_messageInfo_GetEmbeddingReply.Size(m)
-}
-func (m *GetEmbeddingReply) XXX_DiscardUnknown() {
-	xxx_messageInfo_GetEmbeddingReply.DiscardUnknown(m)
-}
-
-var xxx_messageInfo_GetEmbeddingReply proto.InternalMessageInfo
-
-type ListEmbeddingRequest struct {
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
-}
-
-func (m *ListEmbeddingRequest) Reset()         { *m = ListEmbeddingRequest{} }
-func (m *ListEmbeddingRequest) String() string { return proto.CompactTextString(m) }
-func (*ListEmbeddingRequest) ProtoMessage()    {}
-func (*ListEmbeddingRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d9e33339e8a1530f, []int{8}
-}
-
-func (m *ListEmbeddingRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_ListEmbeddingRequest.Unmarshal(m, b)
-}
-func (m *ListEmbeddingRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_ListEmbeddingRequest.Marshal(b, m, deterministic)
-}
-func (m *ListEmbeddingRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ListEmbeddingRequest.Merge(m, src)
-}
-func (m *ListEmbeddingRequest) XXX_Size() int {
-	return xxx_messageInfo_ListEmbeddingRequest.Size(m)
-}
-func (m *ListEmbeddingRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_ListEmbeddingRequest.DiscardUnknown(m)
-}
-
-var xxx_messageInfo_ListEmbeddingRequest proto.InternalMessageInfo
-
-type ListEmbeddingReply struct {
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
-}
-
-func (m *ListEmbeddingReply) Reset()         { *m = ListEmbeddingReply{} }
-func (m *ListEmbeddingReply) String() string { return proto.CompactTextString(m) }
-func (*ListEmbeddingReply) ProtoMessage()    {}
-func (*ListEmbeddingReply) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d9e33339e8a1530f, []int{9}
-}
-
-func (m *ListEmbeddingReply) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_ListEmbeddingReply.Unmarshal(m, b)
-}
-func (m *ListEmbeddingReply) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_ListEmbeddingReply.Marshal(b, m, deterministic)
-}
-func (m *ListEmbeddingReply) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ListEmbeddingReply.Merge(m, src)
-}
-func (m *ListEmbeddingReply) XXX_Size() int {
-	return xxx_messageInfo_ListEmbeddingReply.Size(m)
-}
-func (m *ListEmbeddingReply) XXX_DiscardUnknown() {
-	xxx_messageInfo_ListEmbeddingReply.DiscardUnknown(m)
-}
-
-var xxx_messageInfo_ListEmbeddingReply proto.InternalMessageInfo
-
-func init() {
-	proto.RegisterType((*CreateEmbeddingRequest)(nil), "api.embedding.v1.CreateEmbeddingRequest")
-	proto.RegisterType((*CreateEmbeddingReply)(nil), "api.embedding.v1.CreateEmbeddingReply")
-	proto.RegisterType((*UpdateEmbeddingRequest)(nil), "api.embedding.v1.UpdateEmbeddingRequest")
-	proto.RegisterType((*UpdateEmbeddingReply)(nil), "api.embedding.v1.UpdateEmbeddingReply")
-	proto.RegisterType((*DeleteEmbeddingRequest)(nil), "api.embedding.v1.DeleteEmbeddingRequest")
-	proto.RegisterType((*DeleteEmbeddingReply)(nil), "api.embedding.v1.DeleteEmbeddingReply")
-	proto.RegisterType((*GetEmbeddingRequest)(nil), "api.embedding.v1.GetEmbeddingRequest")
-	proto.RegisterType((*GetEmbeddingReply)(nil), "api.embedding.v1.GetEmbeddingReply")
-	proto.RegisterType((*ListEmbeddingRequest)(nil), "api.embedding.v1.ListEmbeddingRequest")
-	proto.RegisterType((*ListEmbeddingReply)(nil), "api.embedding.v1.ListEmbeddingReply")
-}
-
-func init() {
-	proto.RegisterFile("api/embedding/v1/embedding.proto", fileDescriptor_d9e33339e8a1530f)
-}
-
-var fileDescriptor_d9e33339e8a1530f = []byte{
-	// 264 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0x48, 0x2c, 0xc8, 0xd4,
-	0x4f, 0xcd, 0x4d, 0x4a, 0x4d, 0x49, 0xc9, 0xcc, 0x4b, 0xd7, 0x2f, 0x33, 0x44, 0x70, 0xf4, 0x0a,
-	0x8a, 0xf2, 0x4b, 0xf2, 0x85, 0x04, 0x12, 0x0b, 0x32, 0xf5, 0x10, 0x82, 0x65, 0x86, 0x4a, 0x12,
-	0x5c, 0x62, 0xce, 0x45, 0xa9, 0x89, 0x25, 0xa9, 0xae, 0x30, 0xd1, 0xa0, 0xd4, 0xc2, 0xd2, 0xd4,
-	0xe2, 0x12, 0x25, 0x31, 0x2e, 0x11, 0x0c, 0x99, 0x82, 0x9c, 0x4a, 0x90, 0x8e, 0xd0, 0x82, 0x14,
-	0x1c, 0x3a, 0x30, 0x64, 0xa0, 0x3a, 0x5c, 0x52, 0x73, 0x52, 0xb1, 0xeb, 0xc0, 0x90, 0x01, 0xe9,
-	0x10, 0xe5, 0x12, 0x76, 0x4f, 0x2d, 0xc1, 0x50, 0x2e, 0xcc, 0x25, 0x88, 0x2a, 0x0c, 0x52, 0x2b,
-	0xc6, 0x25, 0xe2, 0x93, 0x59, 0x8c, 0xa9, 0x58, 0x84, 0x4b, 0x08, 0x4d, 0xbc, 0x20, 0xa7, 0xd2,
-	0xe8, 0x2b, 0x33, 0x17, 0x27, 0x5c, 0x48, 0x28, 0x99, 0x8b, 0x1f, 0xcd, 0x8f, 0x42, 0x1a, 0x7a,
-	0xe8, 0x61, 0xa4, 0x87, 0x3d, 0x80, 0xa4, 0xd4, 0x88, 0x50, 0x59, 0x90, 0x53, 0x09, 0xb2, 0x04,
-	0x2d, 0x58, 0xb0, 0x59, 0x82, 0x3d, 0x4c, 0xb1, 0x59, 0x82, 0x2d, 0x8c, 0x41, 0x96, 0xa0, 0x85,
-	0x24, 0x36, 0x4b, 0xb0, 0x47, 0x03, 0x36, 0x4b, 0xb0, 0x45, 0x8b, 0x50, 0x14, 0x17, 0x0f, 0x72,
-	0xf8, 0x0b, 0xa9, 0x62, 0xea, 0xc3, 0x12, 0x6d, 0x52, 0xca, 0x84, 0x94, 0x81, 0xcc, 0x8e, 0xe5,
-	0xe2, 0x45, 0x89, 0x2e, 0x21, 0x2c, 0x8e, 0xc2, 0x16, 0xcf, 0x52, 0x2a, 0x04, 0xd5, 0x15, 0xe4,
-	0x54, 0x3a, 0xe9, 0x72, 0x61, 0xa4, 0xfd, 0x00, 0xc6, 0x28, 0xf1, 0xa2, 0xc4, 0x74, 0x7d, 0xf4,
-	0x5c, 0x63, 0x5d, 0x66, 0x98, 0xc4, 0x06, 0xce, 0x2f, 0xc6, 0x80, 0x00, 0x00, 0x00, 0xff, 0xff,
-	0x27, 0xae, 0x41, 0x8f, 0x53, 0x03, 0x00, 0x00,
-}
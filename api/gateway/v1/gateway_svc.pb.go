@@ -521,6 +521,7 @@ type QueryResponse struct {
 	RelatedDocuments     []*RelatedDocument `protobuf:"bytes,3,rep,name=related_documents,json=relatedDocuments,proto3" json:"related_documents,omitempty"`
 	Metadata             *QueryMetadata     `protobuf:"bytes,4,opt,name=metadata,proto3" json:"metadata,omitempty"`
 	Suggestions          []string           `protobuf:"bytes,5,rep,name=suggestions,proto3" json:"suggestions,omitempty"`
+	Citations            []*v1.Citation     `protobuf:"bytes,6,rep,name=citations,proto3" json:"citations,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
 	XXX_unrecognized     []byte             `json:"-"`
 	XXX_sizecache        int32              `json:"-"`
@@ -586,6 +587,203 @@ func (m *QueryResponse) GetSuggestions() []string {
 	return nil
 }
 
+func (m *QueryResponse) GetCitations() []*v1.Citation {
+	if m != nil {
+		return m.Citations
+	}
+	return nil
+}
+
+// 流式查询的事件，依次为 retrieval、若干 answer_delta 和 completed
+type QueryStreamEvent struct {
+	QueryId string `protobuf:"bytes,1,opt,name=query_id,json=queryId,proto3" json:"query_id,omitempty"`
+	// Types that are valid to be assigned to Event:
+	//	*QueryStreamEvent_Retrieval
+	//	*QueryStreamEvent_AnswerDelta
+	//	*QueryStreamEvent_Completed
+	Event                isQueryStreamEvent_Event `protobuf_oneof:"event"`
+	XXX_NoUnkeyedLiteral struct{}                 `json:"-"`
+	XXX_unrecognized     []byte                   `json:"-"`
+	XXX_sizecache        int32                    `json:"-"`
+}
+
+func (m *QueryStreamEvent) Reset()         { *m = QueryStreamEvent{} }
+func (m *QueryStreamEvent) String() string { return proto.CompactTextString(m) }
+func (*QueryStreamEvent) ProtoMessage()    {}
+func (*QueryStreamEvent) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9a22994c644cc368, []int{8}
+}
+
+func (m *QueryStreamEvent) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_QueryStreamEvent.Unmarshal(m, b)
+}
+func (m *QueryStreamEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_QueryStreamEvent.Marshal(b, m, deterministic)
+}
+func (m *QueryStreamEvent) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryStreamEvent.Merge(m, src)
+}
+func (m *QueryStreamEvent) XXX_Size() int {
+	return xxx_messageInfo_QueryStreamEvent.Size(m)
+}
+func (m *QueryStreamEvent) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryStreamEvent.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryStreamEvent proto.InternalMessageInfo
+
+func (m *QueryStreamEvent) GetQueryId() string {
+	if m != nil {
+		return m.QueryId
+	}
+	return ""
+}
+
+type isQueryStreamEvent_Event interface {
+	isQueryStreamEvent_Event()
+}
+
+type QueryStreamEvent_Retrieval struct {
+	Retrieval *QueryRetrieval `protobuf:"bytes,2,opt,name=retrieval,proto3,oneof"`
+}
+
+type QueryStreamEvent_AnswerDelta struct {
+	AnswerDelta *QueryAnswerDelta `protobuf:"bytes,3,opt,name=answer_delta,json=answerDelta,proto3,oneof"`
+}
+
+type QueryStreamEvent_Completed struct {
+	Completed *QueryResponse `protobuf:"bytes,4,opt,name=completed,proto3,oneof"`
+}
+
+func (*QueryStreamEvent_Retrieval) isQueryStreamEvent_Event() {}
+
+func (*QueryStreamEvent_AnswerDelta) isQueryStreamEvent_Event() {}
+
+func (*QueryStreamEvent_Completed) isQueryStreamEvent_Event() {}
+
+func (m *QueryStreamEvent) GetEvent() isQueryStreamEvent_Event {
+	if m != nil {
+		return m.Event
+	}
+	return nil
+}
+
+func (m *QueryStreamEvent) GetRetrieval() *QueryRetrieval {
+	if x, ok := m.GetEvent().(*QueryStreamEvent_Retrieval); ok {
+		return x.Retrieval
+	}
+	return nil
+}
+
+func (m *QueryStreamEvent) GetAnswerDelta() *QueryAnswerDelta {
+	if x, ok := m.GetEvent().(*QueryStreamEvent_AnswerDelta); ok {
+		return x.AnswerDelta
+	}
+	return nil
+}
+
+func (m *QueryStreamEvent) GetCompleted() *QueryResponse {
+	if x, ok := m.GetEvent().(*QueryStreamEvent_Completed); ok {
+		return x.Completed
+	}
+	return nil
+}
+
+// XXX_OneofWrappers is for the internal use of the proto package.
+func (*QueryStreamEvent) XXX_OneofWrappers() []interface{} {
+	return []interface{}{
+		(*QueryStreamEvent_Retrieval)(nil),
+		(*QueryStreamEvent_AnswerDelta)(nil),
+		(*QueryStreamEvent_Completed)(nil),
+	}
+}
+
+type QueryRetrieval struct {
+	RelatedDocuments       []*RelatedDocument `protobuf:"bytes,1,rep,name=related_documents,json=relatedDocuments,proto3" json:"related_documents,omitempty"`
+	TotalDocumentsSearched int32              `protobuf:"varint,2,opt,name=total_documents_searched,json=totalDocumentsSearched,proto3" json:"total_documents_searched,omitempty"`
+	XXX_NoUnkeyedLiteral   struct{}           `json:"-"`
+	XXX_unrecognized       []byte             `json:"-"`
+	XXX_sizecache          int32              `json:"-"`
+}
+
+func (m *QueryRetrieval) Reset()         { *m = QueryRetrieval{} }
+func (m *QueryRetrieval) String() string { return proto.CompactTextString(m) }
+func (*QueryRetrieval) ProtoMessage()    {}
+func (*QueryRetrieval) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9a22994c644cc368, []int{9}
+}
+
+func (m *QueryRetrieval) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_QueryRetrieval.Unmarshal(m, b)
+}
+func (m *QueryRetrieval) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_QueryRetrieval.Marshal(b, m, deterministic)
+}
+func (m *QueryRetrieval) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRetrieval.Merge(m, src)
+}
+func (m *QueryRetrieval) XXX_Size() int {
+	return xxx_messageInfo_QueryRetrieval.Size(m)
+}
+func (m *QueryRetrieval) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRetrieval.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryRetrieval proto.InternalMessageInfo
+
+func (m *QueryRetrieval) GetRelatedDocuments() []*RelatedDocument {
+	if m != nil {
+		return m.RelatedDocuments
+	}
+	return nil
+}
+
+func (m *QueryRetrieval) GetTotalDocumentsSearched() int32 {
+	if m != nil {
+		return m.TotalDocumentsSearched
+	}
+	return 0
+}
+
+type QueryAnswerDelta struct {
+	Text                 string   `protobuf:"bytes,1,opt,name=text,proto3" json:"text,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *QueryAnswerDelta) Reset()         { *m = QueryAnswerDelta{} }
+func (m *QueryAnswerDelta) String() string { return proto.CompactTextString(m) }
+func (*QueryAnswerDelta) ProtoMessage()    {}
+func (*QueryAnswerDelta) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9a22994c644cc368, []int{10}
+}
+
+func (m *QueryAnswerDelta) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_QueryAnswerDelta.Unmarshal(m, b)
+}
+func (m *QueryAnswerDelta) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_QueryAnswerDelta.Marshal(b, m, deterministic)
+}
+func (m *QueryAnswerDelta) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAnswerDelta.Merge(m, src)
+}
+func (m *QueryAnswerDelta) XXX_Size() int {
+	return xxx_messageInfo_QueryAnswerDelta.Size(m)
+}
+func (m *QueryAnswerDelta) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAnswerDelta.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAnswerDelta proto.InternalMessageInfo
+
+func (m *QueryAnswerDelta) GetText() string {
+	if m != nil {
+		return m.Text
+	}
+	return ""
+}
+
 type RelatedDocument struct {
 	DocumentId           string          `protobuf:"bytes,1,opt,name=document_id,json=documentId,proto3" json:"document_id,omitempty"`
 	Title                string          `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
@@ -602,7 +800,7 @@ func (m *RelatedDocument) Reset()         { *m = RelatedDocument{} }
 func (m *RelatedDocument) String() string { return proto.CompactTextString(m) }
 func (*RelatedDocument) ProtoMessage()    {}
 func (*RelatedDocument) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9a22994c644cc368, []int{8}
+	return fileDescriptor_9a22994c644cc368, []int{11}
 }
 
 func (m *RelatedDocument) XXX_Unmarshal(b []byte) error {
@@ -681,7 +879,7 @@ func (m *QueryMetadata) Reset()         { *m = QueryMetadata{} }
 func (m *QueryMetadata) String() string { return proto.CompactTextString(m) }
 func (*QueryMetadata) ProtoMessage()    {}
 func (*QueryMetadata) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9a22994c644cc368, []int{9}
+	return fileDescriptor_9a22994c644cc368, []int{12}
 }
 
 func (m *QueryMetadata) XXX_Unmarshal(b []byte) error {
@@ -759,7 +957,7 @@ func (m *UploadDocumentRequest) Reset()         { *m = UploadDocumentRequest{} }
 func (m *UploadDocumentRequest) String() string { return proto.CompactTextString(m) }
 func (*UploadDocumentRequest) ProtoMessage()    {}
 func (*UploadDocumentRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9a22994c644cc368, []int{10}
+	return fileDescriptor_9a22994c644cc368, []int{13}
 }
 
 func (m *UploadDocumentRequest) XXX_Unmarshal(b []byte) error {
@@ -831,7 +1029,7 @@ func (m *DocumentProcessingOptions) Reset()         { *m = DocumentProcessingOpt
 func (m *DocumentProcessingOptions) String() string { return proto.CompactTextString(m) }
 func (*DocumentProcessingOptions) ProtoMessage()    {}
 func (*DocumentProcessingOptions) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9a22994c644cc368, []int{11}
+	return fileDescriptor_9a22994c644cc368, []int{14}
 }
 
 func (m *DocumentProcessingOptions) XXX_Unmarshal(b []byte) error {
@@ -908,7 +1106,7 @@ func (m *UploadDocumentResponse) Reset()         { *m = UploadDocumentResponse{}
 func (m *UploadDocumentResponse) String() string { return proto.CompactTextString(m) }
 func (*UploadDocumentResponse) ProtoMessage()    {}
 func (*UploadDocumentResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9a22994c644cc368, []int{12}
+	return fileDescriptor_9a22994c644cc368, []int{15}
 }
 
 func (m *UploadDocumentResponse) XXX_Unmarshal(b []byte) error {
@@ -972,7 +1170,7 @@ func (m *DocumentProcessingProgress) Reset()         { *m = DocumentProcessingPr
 func (m *DocumentProcessingProgress) String() string { return proto.CompactTextString(m) }
 func (*DocumentProcessingProgress) ProtoMessage()    {}
 func (*DocumentProcessingProgress) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9a22994c644cc368, []int{13}
+	return fileDescriptor_9a22994c644cc368, []int{16}
 }
 
 func (m *DocumentProcessingProgress) XXX_Unmarshal(b []byte) error {
@@ -1040,7 +1238,7 @@ func (m *GetDocumentRequest) Reset()         { *m = GetDocumentRequest{} }
 func (m *GetDocumentRequest) String() string { return proto.CompactTextString(m) }
 func (*GetDocumentRequest) ProtoMessage()    {}
 func (*GetDocumentRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9a22994c644cc368, []int{14}
+	return fileDescriptor_9a22994c644cc368, []int{17}
 }
 
 func (m *GetDocumentRequest) XXX_Unmarshal(b []byte) error {
@@ -1089,7 +1287,7 @@ func (m *GetDocumentResponse) Reset()         { *m = GetDocumentResponse{} }
 func (m *GetDocumentResponse) String() string { return proto.CompactTextString(m) }
 func (*GetDocumentResponse) ProtoMessage()    {}
 func (*GetDocumentResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9a22994c644cc368, []int{15}
+	return fileDescriptor_9a22994c644cc368, []int{18}
 }
 
 func (m *GetDocumentResponse) XXX_Unmarshal(b []byte) error {
@@ -1153,7 +1351,7 @@ func (m *DocumentStats) Reset()         { *m = DocumentStats{} }
 func (m *DocumentStats) String() string { return proto.CompactTextString(m) }
 func (*DocumentStats) ProtoMessage()    {}
 func (*DocumentStats) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9a22994c644cc368, []int{16}
+	return fileDescriptor_9a22994c644cc368, []int{19}
 }
 
 func (m *DocumentStats) XXX_Unmarshal(b []byte) error {
@@ -1221,7 +1419,7 @@ func (m *DeleteDocumentRequest) Reset()         { *m = DeleteDocumentRequest{} }
 func (m *DeleteDocumentRequest) String() string { return proto.CompactTextString(m) }
 func (*DeleteDocumentRequest) ProtoMessage()    {}
 func (*DeleteDocumentRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9a22994c644cc368, []int{17}
+	return fileDescriptor_9a22994c644cc368, []int{20}
 }
 
 func (m *DeleteDocumentRequest) XXX_Unmarshal(b []byte) error {
@@ -1270,7 +1468,7 @@ func (m *DeleteOptions) Reset()         { *m = DeleteOptions{} }
 func (m *DeleteOptions) String() string { return proto.CompactTextString(m) }
 func (*DeleteOptions) ProtoMessage()    {}
 func (*DeleteOptions) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9a22994c644cc368, []int{18}
+	return fileDescriptor_9a22994c644cc368, []int{21}
 }
 
 func (m *DeleteOptions) XXX_Unmarshal(b []byte) error {
@@ -1331,7 +1529,7 @@ func (m *DeleteDocumentResponse) Reset()         { *m = DeleteDocumentResponse{}
 func (m *DeleteDocumentResponse) String() string { return proto.CompactTextString(m) }
 func (*DeleteDocumentResponse) ProtoMessage()    {}
 func (*DeleteDocumentResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9a22994c644cc368, []int{19}
+	return fileDescriptor_9a22994c644cc368, []int{22}
 }
 
 func (m *DeleteDocumentResponse) XXX_Unmarshal(b []byte) error {
@@ -1380,7 +1578,7 @@ func (m *CleanupInfo) Reset()         { *m = CleanupInfo{} }
 func (m *CleanupInfo) String() string { return proto.CompactTextString(m) }
 func (*CleanupInfo) ProtoMessage()    {}
 func (*CleanupInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9a22994c644cc368, []int{20}
+	return fileDescriptor_9a22994c644cc368, []int{23}
 }
 
 func (m *CleanupInfo) XXX_Unmarshal(b []byte) error {
@@ -1443,7 +1641,7 @@ func (m *ListDocumentsRequest) Reset()         { *m = ListDocumentsRequest{} }
 func (m *ListDocumentsRequest) String() string { return proto.CompactTextString(m) }
 func (*ListDocumentsRequest) ProtoMessage()    {}
 func (*ListDocumentsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9a22994c644cc368, []int{21}
+	return fileDescriptor_9a22994c644cc368, []int{24}
 }
 
 func (m *ListDocumentsRequest) XXX_Unmarshal(b []byte) error {
@@ -1505,7 +1703,7 @@ func (m *ListDocumentsResponse) Reset()         { *m = ListDocumentsResponse{} }
 func (m *ListDocumentsResponse) String() string { return proto.CompactTextString(m) }
 func (*ListDocumentsResponse) ProtoMessage()    {}
 func (*ListDocumentsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9a22994c644cc368, []int{22}
+	return fileDescriptor_9a22994c644cc368, []int{25}
 }
 
 func (m *ListDocumentsResponse) XXX_Unmarshal(b []byte) error {
@@ -1561,7 +1759,7 @@ func (m *DocumentListStats) Reset()         { *m = DocumentListStats{} }
 func (m *DocumentListStats) String() string { return proto.CompactTextString(m) }
 func (*DocumentListStats) ProtoMessage()    {}
 func (*DocumentListStats) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9a22994c644cc368, []int{23}
+	return fileDescriptor_9a22994c644cc368, []int{26}
 }
 
 func (m *DocumentListStats) XXX_Unmarshal(b []byte) error {
@@ -1623,7 +1821,7 @@ func (m *UpdateDocumentMetadataRequest) Reset()         { *m = UpdateDocumentMet
 func (m *UpdateDocumentMetadataRequest) String() string { return proto.CompactTextString(m) }
 func (*UpdateDocumentMetadataRequest) ProtoMessage()    {}
 func (*UpdateDocumentMetadataRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9a22994c644cc368, []int{24}
+	return fileDescriptor_9a22994c644cc368, []int{27}
 }
 
 func (m *UpdateDocumentMetadataRequest) XXX_Unmarshal(b []byte) error {
@@ -1678,7 +1876,7 @@ func (m *UpdateDocumentMetadataResponse) Reset()         { *m = UpdateDocumentMe
 func (m *UpdateDocumentMetadataResponse) String() string { return proto.CompactTextString(m) }
 func (*UpdateDocumentMetadataResponse) ProtoMessage()    {}
 func (*UpdateDocumentMetadataResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9a22994c644cc368, []int{25}
+	return fileDescriptor_9a22994c644cc368, []int{28}
 }
 
 func (m *UpdateDocumentMetadataResponse) XXX_Unmarshal(b []byte) error {
@@ -1731,6 +1929,9 @@ func init() {
 	proto.RegisterType((*QueryParameters)(nil), "api.gateway.v1.QueryParameters")
 	proto.RegisterMapType((map[string]string)(nil), "api.gateway.v1.QueryParameters.FiltersEntry")
 	proto.RegisterType((*QueryResponse)(nil), "api.gateway.v1.QueryResponse")
+	proto.RegisterType((*QueryStreamEvent)(nil), "api.gateway.v1.QueryStreamEvent")
+	proto.RegisterType((*QueryRetrieval)(nil), "api.gateway.v1.QueryRetrieval")
+	proto.RegisterType((*QueryAnswerDelta)(nil), "api.gateway.v1.QueryAnswerDelta")
 	proto.RegisterType((*RelatedDocument)(nil), "api.gateway.v1.RelatedDocument")
 	proto.RegisterType((*QueryMetadata)(nil), "api.gateway.v1.QueryMetadata")
 	proto.RegisterMapType((map[string]string)(nil), "api.gateway.v1.QueryMetadata.DebugInfoEntry")
@@ -1758,166 +1959,175 @@ func init() {
 }
 
 var fileDescriptor_9a22994c644cc368 = []byte{
-	// 2563 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x59, 0xcd, 0x6f, 0x23, 0x49,
-	0x15, 0x4f, 0xdb, 0x71, 0x62, 0x3f, 0x3b, 0x89, 0x53, 0xf9, 0x18, 0x8f, 0x67, 0xc3, 0x64, 0x3b,
-	0x3b, 0x90, 0x0c, 0xbb, 0x36, 0xc9, 0x8a, 0xfd, 0x18, 0x60, 0x67, 0xe3, 0xcc, 0xcc, 0x6e, 0xc4,
-	0x0c, 0x13, 0x3a, 0x33, 0x08, 0xad, 0x84, 0x5a, 0x95, 0xee, 0x8a, 0xd3, 0x9a, 0x76, 0x77, 0x6f,
-	0x55, 0xd9, 0x33, 0x9e, 0x15, 0x12, 0x5a, 0x71, 0xe3, 0xc8, 0x01, 0x09, 0x71, 0x5b, 0x09, 0x90,
-	0x38, 0x71, 0xe7, 0x6f, 0x40, 0x48, 0x5c, 0x90, 0xb8, 0x20, 0x3e, 0x24, 0x2e, 0x88, 0x0b, 0xa7,
-	0x39, 0xa1, 0xfa, 0x6a, 0xb7, 0xdb, 0x4e, 0x32, 0xc3, 0x89, 0x9b, 0xeb, 0xbd, 0xdf, 0xab, 0x57,
-	0xf5, 0xea, 0x57, 0xef, 0xbd, 0x6a, 0xc3, 0xea, 0x60, 0xb7, 0xdd, 0xc5, 0x9c, 0x3c, 0xc5, 0x43,
-	0x97, 0x0d, 0xbc, 0x56, 0x42, 0x63, 0x1e, 0xa3, 0x45, 0x9c, 0x04, 0x2d, 0x2d, 0x6e, 0x0d, 0x76,
-	0x9b, 0xaf, 0x75, 0xe3, 0xb8, 0x1b, 0x92, 0x36, 0x4e, 0x82, 0x36, 0x8e, 0xa2, 0x98, 0x63, 0x1e,
-	0xc4, 0x11, 0x53, 0xe8, 0xe6, 0xd5, 0x8c, 0xf6, 0x8c, 0xf3, 0xe4, 0x24, 0xf6, 0x87, 0x5a, 0x75,
-	0x4d, 0xab, 0xe4, 0xe8, 0xa4, 0x7f, 0xda, 0x26, 0xbd, 0x84, 0x1b, 0xe5, 0xf5, 0xbc, 0x92, 0x07,
-	0x3d, 0xc2, 0x38, 0xee, 0x25, 0x1a, 0xd0, 0x14, 0x33, 0x7a, 0x71, 0xaf, 0x17, 0x47, 0xed, 0xc1,
-	0xae, 0xfe, 0x35, 0x5d, 0x47, 0x28, 0x8d, 0xa9, 0x59, 0xd0, 0x95, 0x01, 0x0e, 0x03, 0x1f, 0x73,
-	0xd2, 0x36, 0x3f, 0x94, 0xc2, 0xfe, 0xb9, 0x05, 0xb5, 0xfb, 0x71, 0x37, 0x88, 0x1c, 0xf2, 0x69,
-	0x9f, 0x30, 0x8e, 0xb6, 0xa0, 0xdc, 0x67, 0x84, 0x46, 0xb8, 0x47, 0x1a, 0xd6, 0xa6, 0xb5, 0x5d,
-	0xe9, 0xcc, 0xbf, 0xe8, 0xcc, 0xd2, 0x42, 0xdd, 0x72, 0x52, 0x85, 0x00, 0x25, 0x98, 0xb1, 0xa7,
-	0x31, 0xf5, 0x1b, 0x85, 0x1c, 0xc8, 0x28, 0xd0, 0x35, 0xa8, 0x78, 0x61, 0x40, 0x22, 0xee, 0x06,
-	0x7e, 0xa3, 0x28, 0x50, 0x4e, 0x59, 0x09, 0x0e, 0x7d, 0xb4, 0x05, 0x0b, 0x5a, 0xc9, 0x88, 0x47,
-	0x09, 0x6f, 0xcc, 0x4a, 0x40, 0x4d, 0x09, 0x8f, 0xa5, 0xcc, 0xfe, 0xab, 0x05, 0x0b, 0x7a, 0x71,
-	0x2c, 0x89, 0x23, 0x46, 0xd0, 0xeb, 0x50, 0xc3, 0x9e, 0x47, 0x18, 0x73, 0x79, 0xfc, 0x84, 0x44,
-	0x6a, 0x85, 0x4e, 0x55, 0xc9, 0x1e, 0x09, 0x91, 0x98, 0x99, 0x92, 0x53, 0x4a, 0xd8, 0x99, 0xc6,
-	0x14, 0xd4, 0xcc, 0x5a, 0xa8, 0x40, 0x1b, 0x00, 0x52, 0xe9, 0xf2, 0x61, 0x42, 0xf4, 0xe2, 0x2a,
-	0x52, 0xf2, 0x68, 0x98, 0x10, 0xa1, 0x26, 0xcf, 0x92, 0x80, 0x12, 0xe6, 0x06, 0x91, 0x5c, 0x5a,
-	0xd1, 0xa9, 0x68, 0xc9, 0x61, 0x84, 0x56, 0xa1, 0xc4, 0xbc, 0x38, 0x21, 0x8d, 0x92, 0x34, 0x54,
-	0x03, 0xf4, 0x75, 0xa8, 0x88, 0x00, 0xb9, 0x41, 0x74, 0x1a, 0x37, 0xe6, 0x36, 0xad, 0xed, 0xea,
-	0x5e, 0xa3, 0x35, 0x4e, 0x9b, 0xd6, 0x63, 0x46, 0xe8, 0x61, 0x74, 0x1a, 0xab, 0x58, 0x8a, 0x5f,
-	0xf6, 0x01, 0xac, 0x38, 0x99, 0xa5, 0x99, 0x73, 0x78, 0x33, 0xbf, 0x8d, 0xdc, 0x61, 0x8c, 0xed,
-	0xc7, 0xfe, 0x85, 0x05, 0xab, 0xe3, 0xb3, 0xfc, 0x3f, 0x05, 0xcc, 0xfe, 0x77, 0x01, 0xca, 0x66,
-	0xeb, 0xe8, 0x0a, 0xcc, 0xab, 0x38, 0xf9, 0x7a, 0x35, 0x73, 0x32, 0x16, 0x3e, 0x6a, 0x66, 0xa8,
-	0xa7, 0xd6, 0x30, 0x62, 0xdc, 0x2a, 0x94, 0x48, 0x0f, 0x07, 0xa1, 0x76, 0xad, 0x06, 0x42, 0x4a,
-	0xe3, 0x90, 0xb0, 0xc6, 0xec, 0x66, 0x51, 0x48, 0xe5, 0x00, 0x7d, 0x0c, 0x80, 0x39, 0xa7, 0xc1,
-	0x49, 0x9f, 0x13, 0xd6, 0x28, 0x6d, 0x16, 0xb7, 0xab, 0x7b, 0xdb, 0xe7, 0x9d, 0x44, 0x6b, 0x3f,
-	0x85, 0xde, 0x8d, 0x38, 0x1d, 0x3a, 0x19, 0x5b, 0xf4, 0x3e, 0x80, 0x47, 0x09, 0xe6, 0xc4, 0x77,
-	0x31, 0xd7, 0x67, 0xda, 0x6c, 0xa9, 0x4b, 0xda, 0x32, 0x97, 0xb4, 0xf5, 0xc8, 0x5c, 0x52, 0xa7,
-	0xa2, 0xd1, 0xfb, 0x1c, 0x7d, 0x00, 0x0b, 0x21, 0x66, 0xdc, 0x0d, 0x05, 0x7f, 0x85, 0xf5, 0xfc,
-	0xa5, 0xd6, 0x55, 0x61, 0x20, 0xf9, 0xbe, 0xcf, 0x9b, 0xdf, 0x82, 0xa5, 0xdc, 0xca, 0x50, 0x1d,
-	0x8a, 0x4f, 0xc8, 0x50, 0x07, 0x4d, 0xfc, 0x14, 0xfb, 0x1f, 0xe0, 0xb0, 0x6f, 0xc2, 0xa5, 0x06,
-	0xb7, 0x0a, 0xef, 0x59, 0xf6, 0x2f, 0x2d, 0xa8, 0x7d, 0xb7, 0x4f, 0xe8, 0xd0, 0xf0, 0x69, 0x03,
-	0x4a, 0x9f, 0x8a, 0x71, 0x9e, 0x47, 0x4a, 0x2a, 0x0e, 0x90, 0x11, 0xc6, 0x82, 0x38, 0x12, 0xe7,
-	0xa2, 0xa6, 0xab, 0x68, 0xc9, 0xa1, 0x8f, 0x6e, 0x03, 0x24, 0x98, 0xe2, 0x1e, 0xe1, 0x84, 0x32,
-	0x79, 0x06, 0xd5, 0xbd, 0xeb, 0xf9, 0x90, 0x4a, 0x7f, 0x47, 0x29, 0xcc, 0xc9, 0x98, 0x64, 0x0f,
-	0x7d, 0x36, 0x7b, 0xe8, 0xf6, 0x5f, 0x8a, 0xb0, 0x94, 0x33, 0x44, 0x37, 0xa1, 0xda, 0xc3, 0xcf,
-	0x5c, 0x4a, 0x58, 0x3f, 0xe4, 0x4c, 0xae, 0xb8, 0xd4, 0xa9, 0xbc, 0xe8, 0xcc, 0x35, 0x67, 0x1b,
-	0xfe, 0xb6, 0xe5, 0x40, 0x0f, 0x3f, 0x73, 0x94, 0x12, 0x75, 0x60, 0x95, 0x05, 0xbd, 0x20, 0xc4,
-	0x34, 0xe0, 0x43, 0x97, 0x9f, 0x09, 0xca, 0xc6, 0xa1, 0xda, 0x42, 0xa1, 0xb3, 0xf4, 0xa2, 0x53,
-	0x03, 0xd8, 0x98, 0x99, 0xf9, 0xd1, 0xed, 0xb7, 0x66, 0x66, 0x66, 0x66, 0x9c, 0x95, 0x11, 0xf8,
-	0x91, 0xc1, 0x8a, 0x4b, 0xe2, 0xc7, 0x5e, 0xbf, 0xa7, 0x72, 0x95, 0xd8, 0x9f, 0x60, 0x53, 0xd5,
-	0xc8, 0x0e, 0x7d, 0x86, 0x6e, 0xc0, 0x62, 0x0a, 0x11, 0x57, 0xc0, 0x50, 0x6e, 0xc1, 0x48, 0xc5,
-	0x35, 0x60, 0xe8, 0x1e, 0xcc, 0x9f, 0x06, 0xa1, 0x0c, 0x92, 0xe2, 0xdd, 0x9b, 0x97, 0x04, 0xa9,
-	0x75, 0x4f, 0xc1, 0x15, 0xf7, 0x8c, 0x31, 0xda, 0x81, 0x3a, 0x89, 0xf0, 0x49, 0x48, 0x5c, 0x4a,
-	0x28, 0x8e, 0x9e, 0x04, 0x51, 0x57, 0xd2, 0xaf, 0xec, 0x2c, 0x29, 0xb9, 0x63, 0xc4, 0xe8, 0x1d,
-	0xb8, 0xa2, 0xa1, 0x5e, 0x1c, 0x71, 0xf2, 0x8c, 0xbb, 0x98, 0x31, 0xd2, 0x3b, 0x09, 0x87, 0x92,
-	0x72, 0x65, 0x67, 0x4d, 0xa9, 0x0f, 0x94, 0x76, 0x5f, 0x2b, 0xd1, 0x9b, 0x80, 0x44, 0x90, 0x8d,
-	0x51, 0x48, 0xa2, 0x2e, 0x3f, 0x6b, 0x94, 0x45, 0xac, 0x9d, 0x7a, 0x0f, 0x3f, 0xd3, 0xf8, 0xfb,
-	0x52, 0xde, 0xbc, 0x05, 0xb5, 0xec, 0x4a, 0x5f, 0x89, 0x8b, 0xff, 0xb2, 0x60, 0x41, 0x73, 0x51,
-	0x67, 0xa5, 0xab, 0x50, 0x96, 0xb4, 0x1b, 0xe5, 0x80, 0x79, 0x39, 0x3e, 0xf4, 0xd1, 0x3a, 0xcc,
-	0xe1, 0x88, 0x3d, 0x25, 0x54, 0xcf, 0xa3, 0x47, 0xe8, 0x3e, 0x2c, 0x53, 0x12, 0xca, 0xab, 0x68,
-	0x42, 0xae, 0x0e, 0x6a, 0x0a, 0x11, 0x1d, 0x05, 0xbc, 0xa3, 0x71, 0x4e, 0x9d, 0x8e, 0x0b, 0xc4,
-	0xc5, 0x2e, 0xf7, 0x08, 0xc7, 0x3e, 0xe6, 0x58, 0xf2, 0xb1, 0xba, 0xb7, 0x31, 0xf5, 0xa0, 0x1e,
-	0x68, 0x90, 0x93, 0xc2, 0xd1, 0x26, 0x54, 0x59, 0xbf, 0xdb, 0x25, 0x4c, 0x16, 0x7c, 0x79, 0xcc,
-	0x15, 0x27, 0x2b, 0x12, 0x65, 0x6b, 0x29, 0xb7, 0x04, 0x74, 0x1d, 0xaa, 0x19, 0x8a, 0xe9, 0x4d,
-	0xc3, 0x88, 0x61, 0x22, 0x7c, 0x3c, 0xe0, 0x61, 0x1a, 0x3e, 0x39, 0x40, 0x0d, 0x98, 0x67, 0x51,
-	0x90, 0x24, 0x84, 0xeb, 0xc4, 0x67, 0x86, 0xe8, 0x2b, 0xb0, 0x44, 0x49, 0x48, 0x06, 0x38, 0xf2,
-	0x88, 0xcb, 0xbc, 0x98, 0x12, 0xb9, 0x91, 0x82, 0xb3, 0x98, 0x8a, 0x8f, 0x85, 0x54, 0xa4, 0xf7,
-	0x31, 0xe6, 0xea, 0xa2, 0x55, 0xcb, 0x12, 0x17, 0x7d, 0x0d, 0xe6, 0xbc, 0xb3, 0x7e, 0xf4, 0x84,
-	0x35, 0xe6, 0x64, 0x48, 0x55, 0xe1, 0xd2, 0xed, 0xc5, 0x60, 0xb7, 0x75, 0x20, 0x94, 0xb2, 0x70,
-	0x69, 0x9c, 0xfd, 0x93, 0xa2, 0x3e, 0x54, 0x13, 0x22, 0x91, 0x2c, 0xd5, 0xa1, 0x8a, 0xa6, 0x45,
-	0xee, 0xf0, 0x92, 0x64, 0x29, 0xd1, 0x62, 0x2c, 0xb8, 0x98, 0xd0, 0x58, 0x94, 0xa4, 0x20, 0xea,
-	0x4a, 0x7b, 0xb7, 0xc7, 0x64, 0x24, 0x8a, 0x4e, 0x7d, 0xa4, 0x11, 0xd8, 0x07, 0x0c, 0xbd, 0x07,
-	0x0d, 0x1e, 0x73, 0x1c, 0x8e, 0x88, 0xe0, 0x32, 0x82, 0xa9, 0x77, 0x46, 0x54, 0x9f, 0x51, 0x72,
-	0xd6, 0xa5, 0x3e, 0x3d, 0xee, 0x63, 0xad, 0x45, 0x6f, 0x01, 0x1a, 0xd9, 0x50, 0xc2, 0xfb, 0x34,
-	0x22, 0x2a, 0x21, 0x95, 0x9c, 0xe5, 0x54, 0xe3, 0x68, 0x85, 0x48, 0x8a, 0xbd, 0xd8, 0x27, 0xa1,
-	0xdb, 0x67, 0xc4, 0xd7, 0x71, 0xab, 0x48, 0xc9, 0x63, 0x46, 0x7c, 0xf4, 0x6d, 0x00, 0x9f, 0x9c,
-	0xf4, 0xbb, 0xa6, 0xe2, 0x9f, 0x7f, 0xdf, 0x4d, 0x8c, 0x5a, 0x77, 0x04, 0x5e, 0xc4, 0x51, 0xdd,
-	0xf7, 0x8a, 0x6f, 0xc6, 0xcd, 0x6f, 0xc2, 0xe2, 0xb8, 0xf2, 0x95, 0xae, 0xd8, 0xcf, 0x0a, 0xb0,
-	0xf6, 0x38, 0x09, 0x63, 0x3c, 0x22, 0xbd, 0xce, 0xfb, 0x37, 0xa1, 0x76, 0x1a, 0x98, 0xe4, 0x10,
-	0x71, 0x39, 0x5d, 0x4d, 0xa6, 0xff, 0xe7, 0x22, 0xfd, 0x57, 0x85, 0xf2, 0x40, 0xe9, 0x44, 0x8d,
-	0xc8, 0x70, 0x30, 0x53, 0x23, 0x14, 0x19, 0xdf, 0x80, 0x8a, 0x9c, 0x6a, 0xd4, 0x02, 0x64, 0xda,
-	0x3e, 0xa1, 0x91, 0x54, 0x7a, 0x7b, 0xe2, 0x6a, 0x5d, 0xc9, 0x91, 0x69, 0xca, 0xa5, 0xfa, 0xfe,
-	0x18, 0x01, 0xe2, 0xc4, 0xdc, 0x2d, 0x61, 0xbe, 0x93, 0x0f, 0xa9, 0xd9, 0xe2, 0x51, 0x6a, 0xf1,
-	0x50, 0x19, 0x38, 0xcb, 0x49, 0x5e, 0x64, 0x7f, 0x5e, 0x80, 0xab, 0xe7, 0x1a, 0x88, 0xb4, 0x2e,
-	0xf9, 0xec, 0x32, 0x4e, 0x31, 0x27, 0x5d, 0x13, 0xee, 0x05, 0x29, 0x3d, 0xd6, 0x42, 0xb4, 0x03,
-	0xa0, 0x61, 0xc1, 0x73, 0x15, 0x9d, 0x52, 0x07, 0x5e, 0x74, 0xe6, 0x9b, 0xa5, 0xc6, 0x1f, 0x96,
-	0xb6, 0x7d, 0xa7, 0xa2, 0xe0, 0xc1, 0x73, 0x82, 0xda, 0xa0, 0x6c, 0xdd, 0x78, 0x40, 0x68, 0x88,
-	0x13, 0xc5, 0x48, 0x83, 0xfe, 0x4f, 0x71, 0x7b, 0xc6, 0xa9, 0x49, 0xc0, 0x43, 0xa5, 0x17, 0x17,
-	0x99, 0xf4, 0x4e, 0x88, 0xef, 0x8b, 0x9d, 0x4b, 0x72, 0xe9, 0x0a, 0xb9, 0x98, 0x8a, 0x1f, 0x08,
-	0xa9, 0xec, 0xb1, 0x54, 0xa2, 0x8f, 0x3d, 0x2a, 0x63, 0x53, 0x76, 0x2a, 0x4a, 0xf2, 0xd0, 0xa3,
-	0xa2, 0x7b, 0x0a, 0x71, 0xd4, 0xed, 0xe3, 0x2e, 0x91, 0xa5, 0xa2, 0xe2, 0xa4, 0x63, 0xfb, 0x9f,
-	0x16, 0xac, 0xe7, 0xe9, 0xa1, 0x53, 0xf1, 0xa5, 0x89, 0x69, 0x0b, 0x16, 0xfa, 0xd2, 0xd4, 0x65,
-	0x1c, 0xf3, 0x3e, 0x33, 0xed, 0xa1, 0x12, 0x1e, 0x4b, 0x19, 0xba, 0x07, 0xe5, 0x84, 0xc6, 0x5d,
-	0x4a, 0x98, 0xe9, 0x0e, 0x6e, 0x5e, 0x7e, 0x6a, 0x47, 0xda, 0xc2, 0x49, 0x6d, 0xd1, 0x87, 0x99,
-	0x64, 0x25, 0x6f, 0x95, 0x62, 0xd0, 0xb5, 0x1c, 0x83, 0xcc, 0x5c, 0x32, 0x23, 0xa5, 0x99, 0x4c,
-	0xb6, 0xd3, 0x5f, 0x14, 0xa0, 0x79, 0xbe, 0x2b, 0xd4, 0x86, 0x15, 0xe3, 0xcc, 0x4d, 0x08, 0xf5,
-	0x48, 0xc4, 0x45, 0xc0, 0x2c, 0x99, 0x3a, 0x91, 0x51, 0x1d, 0xa5, 0x1a, 0xf9, 0x50, 0xe9, 0x53,
-	0x2a, 0x5f, 0x2a, 0x12, 0xaa, 0xb7, 0xaf, 0x85, 0xc7, 0x12, 0x74, 0x03, 0x16, 0x55, 0x70, 0xdc,
-	0x1e, 0x61, 0x4c, 0xa0, 0x54, 0xb6, 0x5e, 0x50, 0xd2, 0x07, 0x4a, 0x28, 0x32, 0x24, 0xe3, 0x98,
-	0xea, 0x76, 0x72, 0xf6, 0xf2, 0x0c, 0xa9, 0xd1, 0xfb, 0x1c, 0x3d, 0x80, 0x55, 0x51, 0x5e, 0x7a,
-	0xb2, 0x00, 0x7a, 0x71, 0x2f, 0x09, 0x89, 0x60, 0xb0, 0xbe, 0x22, 0x17, 0x4d, 0xb2, 0x92, 0xda,
-	0x1d, 0xa4, 0x66, 0x36, 0x01, 0xf4, 0x11, 0xe1, 0xf9, 0x5c, 0xb1, 0x3d, 0x85, 0x0b, 0xa3, 0x2b,
-	0x9e, 0x25, 0xc5, 0x0d, 0x58, 0x0c, 0x22, 0x2f, 0xec, 0xfb, 0xc4, 0x3d, 0x0d, 0x48, 0xe8, 0x0b,
-	0x56, 0xc8, 0x76, 0x48, 0x4b, 0xef, 0x49, 0xa1, 0xfd, 0x27, 0x0b, 0x56, 0xc6, 0xfc, 0x68, 0xd2,
-	0x4d, 0x1c, 0xb3, 0xf5, 0x8a, 0xc7, 0x2c, 0x0a, 0xa3, 0xc9, 0x68, 0xea, 0x40, 0xcc, 0x30, 0x53,
-	0xca, 0x8a, 0x2f, 0x57, 0xca, 0xd0, 0xdb, 0x50, 0x12, 0xe7, 0xc4, 0xce, 0xeb, 0x04, 0xcc, 0x32,
-	0x04, 0xd7, 0x99, 0xa3, 0xb0, 0xf6, 0x3f, 0x2c, 0x58, 0x18, 0x53, 0x88, 0x2e, 0x52, 0x95, 0x25,
-	0xed, 0x5e, 0xb6, 0xad, 0x4e, 0x55, 0xca, 0x0e, 0x94, 0xa7, 0x14, 0x22, 0x5f, 0x4e, 0xea, 0x2a,
-	0x19, 0x88, 0x7c, 0x67, 0x31, 0x51, 0x0a, 0xf1, 0x80, 0x50, 0xdc, 0x25, 0x6e, 0x26, 0xe5, 0xa8,
-	0xb2, 0x56, 0xd7, 0x9a, 0x83, 0x34, 0xdb, 0x5c, 0x87, 0xaa, 0xaa, 0xb9, 0x5e, 0xdc, 0x8f, 0xb8,
-	0xae, 0x64, 0xaa, 0x0c, 0x1f, 0x08, 0x09, 0xba, 0xad, 0x9f, 0x21, 0xea, 0xc1, 0xa7, 0xab, 0xd8,
-	0xc5, 0x84, 0xa9, 0x09, 0x83, 0x7d, 0x8d, 0xb7, 0x9f, 0xc3, 0xda, 0x1d, 0x12, 0x12, 0x4e, 0xfe,
-	0x77, 0xb2, 0xbc, 0x0b, 0xf3, 0x26, 0xa3, 0x17, 0xce, 0x89, 0xb0, 0xf4, 0x60, 0xb2, 0xb8, 0x41,
-	0xdb, 0xbf, 0x11, 0x31, 0xce, 0xaa, 0x44, 0x00, 0x4f, 0x63, 0xea, 0x11, 0xd7, 0x97, 0x62, 0xe9,
-	0xb5, 0xec, 0x54, 0xa5, 0x4c, 0x21, 0xd1, 0x1e, 0xac, 0x29, 0xa5, 0x6b, 0xfa, 0x45, 0x7d, 0x1e,
-	0x05, 0x89, 0x5d, 0x51, 0x4a, 0xdd, 0x9f, 0xe9, 0x73, 0xf9, 0x2a, 0x2c, 0x6b, 0x9b, 0x34, 0xe7,
-	0xaa, 0x3c, 0x56, 0x76, 0xea, 0x4a, 0x71, 0x37, 0x95, 0x8b, 0x0e, 0x95, 0x12, 0xcc, 0xe2, 0xc8,
-	0xbc, 0x64, 0xd4, 0xc8, 0x4e, 0x60, 0x3d, 0x1f, 0x29, 0x4d, 0xf7, 0x75, 0x98, 0xd3, 0xb9, 0x53,
-	0x3f, 0x78, 0xd5, 0x08, 0x7d, 0x00, 0x35, 0x2f, 0x24, 0x38, 0xea, 0x27, 0xea, 0x16, 0x14, 0x32,
-	0xb7, 0x20, 0x13, 0x9d, 0x03, 0x85, 0x91, 0x9c, 0xad, 0x7a, 0xa3, 0x81, 0xfd, 0x37, 0x0b, 0xaa,
-	0x19, 0x65, 0x5a, 0xcd, 0x98, 0x0e, 0x8f, 0xaf, 0x39, 0xa8, 0x2a, 0x12, 0x53, 0xab, 0x93, 0x5d,
-	0xd0, 0x68, 0x9b, 0x29, 0x54, 0x71, 0x71, 0x79, 0xa4, 0x31, 0xf0, 0x16, 0xac, 0x30, 0x1e, 0x4b,
-	0x46, 0x9e, 0x52, 0x42, 0x7c, 0xf7, 0x64, 0x28, 0xde, 0xd5, 0x45, 0xd9, 0x9d, 0x2d, 0x6b, 0xd5,
-	0x3d, 0xa1, 0xe9, 0x08, 0x05, 0xfa, 0x8e, 0x3e, 0x00, 0xf1, 0x96, 0xd4, 0x99, 0xea, 0x65, 0x13,
-	0xde, 0x8a, 0x31, 0x3c, 0x30, 0x76, 0xfb, 0xdc, 0xfe, 0xb3, 0x05, 0xab, 0xf7, 0x03, 0x96, 0x66,
-	0x11, 0x66, 0x18, 0xf8, 0xa1, 0x78, 0x94, 0x76, 0x83, 0x48, 0x7e, 0x7a, 0xd3, 0x29, 0x64, 0x33,
-	0x77, 0xdb, 0x8f, 0x52, 0x80, 0xb6, 0x72, 0x32, 0x36, 0xa8, 0x3d, 0x7a, 0xae, 0x15, 0x64, 0xb2,
-	0x58, 0xcb, 0x99, 0xab, 0x37, 0x4f, 0xf6, 0x5d, 0x56, 0x62, 0x31, 0x4d, 0x5f, 0x1e, 0x2b, 0x39,
-	0xf8, 0x71, 0x4c, 0xb9, 0xa3, 0x10, 0x53, 0x52, 0xe4, 0xec, 0xb4, 0x14, 0xf9, 0x7b, 0x0b, 0xd6,
-	0x72, 0xbb, 0xd3, 0xac, 0x79, 0x1f, 0x2a, 0xa3, 0x97, 0x8e, 0x25, 0xfd, 0x5d, 0x98, 0x20, 0x47,
-	0x68, 0xb4, 0x3f, 0x16, 0x19, 0x45, 0xab, 0xd7, 0x2f, 0x88, 0x8c, 0xf2, 0x38, 0x16, 0x9a, 0x77,
-	0x4d, 0x52, 0x2c, 0x66, 0xac, 0xa7, 0x24, 0x45, 0xb1, 0xf6, 0xb1, 0xc4, 0xf8, 0xdb, 0x02, 0x2c,
-	0x4f, 0x28, 0x45, 0x97, 0x93, 0xeb, 0xd9, 0xe5, 0x81, 0x15, 0x9d, 0xc5, 0xf1, 0x56, 0x1d, 0x6d,
-	0x43, 0x5d, 0x01, 0x45, 0xde, 0xd3, 0x54, 0x2b, 0x64, 0x90, 0x22, 0xed, 0x29, 0x9e, 0xe5, 0xf3,
-	0x6d, 0x71, 0x32, 0xdf, 0x9e, 0xc0, 0xa8, 0xab, 0x77, 0x4f, 0x86, 0xaa, 0x73, 0x9d, 0x95, 0xa1,
-	0x7c, 0xe7, 0xd2, 0x0d, 0xa5, 0x12, 0xd6, 0x19, 0x8a, 0xce, 0x56, 0xb5, 0xec, 0x4b, 0xfe, 0xb8,
-	0xb4, 0xd9, 0x81, 0xd5, 0x69, 0xc0, 0xcb, 0xda, 0xf7, 0x52, 0xb6, 0x7d, 0xff, 0xc2, 0x82, 0x8d,
-	0xc7, 0x89, 0x8f, 0x47, 0xb9, 0x23, 0xed, 0x91, 0x5f, 0x39, 0xdb, 0x66, 0xfb, 0xef, 0xc2, 0xcb,
-	0xf6, 0xdf, 0xb2, 0xc9, 0x13, 0xfe, 0x0d, 0x57, 0xd5, 0x27, 0x90, 0x9a, 0x12, 0x6a, 0xaa, 0xfe,
-	0xca, 0x82, 0x2f, 0x9d, 0xb7, 0xca, 0x4b, 0x32, 0x5d, 0x07, 0xea, 0x6a, 0x2a, 0xdf, 0x7d, 0xd9,
-	0xc5, 0x2d, 0x69, 0x83, 0xf4, 0x7d, 0xb9, 0x03, 0x75, 0x63, 0xeb, 0x0e, 0x08, 0x65, 0x82, 0xda,
-	0xea, 0xcc, 0x97, 0x8c, 0xfc, 0x7b, 0x4a, 0xbc, 0xf7, 0xbb, 0x32, 0xcc, 0x7f, 0xa4, 0x8e, 0x16,
-	0xb9, 0x50, 0x92, 0xdf, 0xd4, 0xd0, 0x6b, 0xf9, 0x13, 0xcf, 0x7e, 0xf7, 0x6e, 0x6e, 0x9c, 0xa3,
-	0x55, 0x1b, 0xb3, 0xaf, 0x7e, 0xfe, 0xc7, 0xbf, 0xff, 0xb4, 0xb0, 0x62, 0x2f, 0xb6, 0x07, 0xbb,
-	0x6d, 0xdc, 0xe7, 0x67, 0x6d, 0xf9, 0x61, 0xef, 0x96, 0x75, 0x13, 0x0d, 0xa0, 0x96, 0xfd, 0xf4,
-	0x8a, 0xb6, 0x26, 0x3f, 0x47, 0x4c, 0x7c, 0xde, 0x6d, 0xbe, 0x71, 0x31, 0x48, 0x7b, 0xbd, 0x26,
-	0xbd, 0xae, 0xd9, 0xf5, 0xd4, 0xab, 0xfe, 0x28, 0x2b, 0xfc, 0x7e, 0x02, 0x25, 0xf9, 0xb8, 0x9c,
-	0xdc, 0x58, 0xf6, 0xc3, 0x5f, 0x73, 0xe3, 0x1c, 0xad, 0x76, 0xb1, 0x2a, 0x5d, 0x2c, 0xda, 0x15,
-	0xe1, 0x42, 0x36, 0x0e, 0x62, 0xee, 0x21, 0x2c, 0x8e, 0xbf, 0x17, 0xd0, 0x8d, 0x89, 0x0f, 0xa8,
-	0xd3, 0x9e, 0x9b, 0xcd, 0x2f, 0x5f, 0x06, 0xd3, 0x6e, 0x1b, 0xd2, 0x2d, 0xb2, 0x17, 0x84, 0xdb,
-	0xf4, 0x4a, 0x09, 0xd7, 0x9f, 0x41, 0x35, 0xd3, 0x32, 0x22, 0x3b, 0x3f, 0xe1, 0x64, 0xdf, 0xda,
-	0xdc, 0xba, 0x10, 0xa3, 0x3d, 0x6e, 0x49, 0x8f, 0x1b, 0xe8, 0xda, 0x98, 0xc7, 0xf6, 0x67, 0x99,
-	0x6b, 0xf5, 0x43, 0xf4, 0x63, 0x4b, 0x3c, 0xc3, 0xb3, 0x45, 0x7c, 0x72, 0xe3, 0x53, 0xdb, 0xa1,
-	0xc9, 0x8d, 0x4f, 0xef, 0x05, 0xcc, 0x32, 0x6e, 0x5e, 0xb8, 0x0c, 0x06, 0x0b, 0x63, 0x35, 0x01,
-	0x4d, 0xd0, 0x65, 0x5a, 0x41, 0x6c, 0xde, 0xb8, 0x04, 0xa5, 0x97, 0xb0, 0x26, 0x97, 0xb0, 0x84,
-	0xc6, 0x63, 0x8f, 0x7e, 0x2d, 0x1f, 0x89, 0xd3, 0xae, 0x37, 0x7a, 0x6b, 0xf2, 0x54, 0x2f, 0x48,
-	0x56, 0xcd, 0xd6, 0xcb, 0xc2, 0xf5, 0x82, 0xda, 0x72, 0x41, 0x3b, 0xcd, 0x37, 0x2e, 0x88, 0x49,
-	0xdb, 0xdc, 0x71, 0xc1, 0x91, 0x1f, 0x40, 0xf5, 0x63, 0x82, 0x43, 0x7e, 0x76, 0x70, 0x46, 0xbc,
-	0x27, 0x68, 0x7d, 0xa2, 0xa5, 0xb8, 0xdb, 0x4b, 0xf8, 0xb0, 0x69, 0xe7, 0x72, 0x4b, 0xc6, 0x26,
-	0xf5, 0x8d, 0xa4, 0xef, 0x1a, 0x02, 0xe1, 0xfb, 0x4c, 0x02, 0x3a, 0x3b, 0x90, 0xfb, 0xbb, 0xef,
-	0xc8, 0xfa, 0x64, 0x8d, 0xe2, 0xae, 0xfc, 0x3f, 0x4f, 0x4b, 0xdb, 0x83, 0xdd, 0x6f, 0x0c, 0x76,
-	0x4f, 0xe6, 0xa4, 0xcb, 0xb7, 0xff, 0x1b, 0x00, 0x00, 0xff, 0xff, 0x06, 0xd0, 0x6f, 0x17, 0x36,
-	0x1c, 0x00, 0x00,
+	// 2713 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x59, 0xcb, 0x6f, 0x1b, 0xc9,
+	0xd1, 0xd7, 0x90, 0xa2, 0x24, 0x16, 0x1f, 0xa2, 0x5a, 0x92, 0x4d, 0xd3, 0xab, 0xb5, 0x77, 0xbc,
+	0xde, 0x4f, 0xf6, 0xb7, 0x4b, 0xae, 0xb5, 0xc8, 0x3e, 0x9c, 0xec, 0x43, 0x94, 0xed, 0xb5, 0x11,
+	0x3b, 0x76, 0x46, 0x76, 0x10, 0x2c, 0x10, 0x0c, 0x5a, 0x33, 0x2d, 0x6a, 0xe0, 0xe1, 0xcc, 0x6c,
+	0x77, 0x93, 0x6b, 0x7a, 0x11, 0x20, 0x58, 0xe4, 0x16, 0xe4, 0x94, 0x43, 0x82, 0x20, 0xb7, 0x0d,
+	0x92, 0x00, 0x39, 0xe5, 0x1f, 0x09, 0x02, 0xe4, 0x12, 0x20, 0x97, 0x20, 0x0f, 0x20, 0xb7, 0x5c,
+	0x72, 0x32, 0x72, 0x08, 0xfa, 0x35, 0x1c, 0x0e, 0x29, 0xd2, 0xde, 0x53, 0x6e, 0xec, 0xaa, 0x5f,
+	0x4d, 0x77, 0x57, 0xfd, 0xba, 0xaa, 0xba, 0x09, 0x5b, 0xc3, 0x6b, 0x9d, 0x1e, 0xe6, 0xe4, 0x33,
+	0x3c, 0x72, 0xd9, 0xd0, 0x6b, 0x27, 0x34, 0xe6, 0x31, 0xaa, 0xe3, 0x24, 0x68, 0x6b, 0x71, 0x7b,
+	0x78, 0xad, 0xf5, 0x52, 0x2f, 0x8e, 0x7b, 0x21, 0xe9, 0xe0, 0x24, 0xe8, 0xe0, 0x28, 0x8a, 0x39,
+	0xe6, 0x41, 0x1c, 0x31, 0x85, 0x6e, 0x9d, 0xcb, 0x68, 0x4f, 0x38, 0x4f, 0x8e, 0x62, 0x7f, 0xa4,
+	0x55, 0xe7, 0xb5, 0x4a, 0x8e, 0x8e, 0x06, 0xc7, 0x1d, 0xd2, 0x4f, 0xb8, 0x51, 0x5e, 0xc8, 0x2b,
+	0x79, 0xd0, 0x27, 0x8c, 0xe3, 0x7e, 0xa2, 0x01, 0x2d, 0xf1, 0x45, 0x2f, 0xee, 0xf7, 0xe3, 0xa8,
+	0x33, 0xbc, 0xa6, 0x7f, 0xcd, 0xd6, 0x11, 0x4a, 0x63, 0x6a, 0x16, 0x74, 0x76, 0x88, 0xc3, 0xc0,
+	0xc7, 0x9c, 0x74, 0xcc, 0x0f, 0xa5, 0xb0, 0x7f, 0x6e, 0x41, 0xf5, 0x6e, 0xdc, 0x0b, 0x22, 0x87,
+	0x7c, 0x3a, 0x20, 0x8c, 0xa3, 0x4b, 0xb0, 0x36, 0x60, 0x84, 0x46, 0xb8, 0x4f, 0x9a, 0xd6, 0x45,
+	0x6b, 0xb7, 0xdc, 0x5d, 0x7d, 0xd6, 0x5d, 0xa6, 0x85, 0x86, 0xe5, 0xa4, 0x0a, 0x01, 0x4a, 0x30,
+	0x63, 0x9f, 0xc5, 0xd4, 0x6f, 0x16, 0x72, 0x20, 0xa3, 0x40, 0xe7, 0xa1, 0xec, 0x85, 0x01, 0x89,
+	0xb8, 0x1b, 0xf8, 0xcd, 0xa2, 0x40, 0x39, 0x6b, 0x4a, 0x70, 0xc7, 0x47, 0x97, 0xa0, 0xa6, 0x95,
+	0x8c, 0x78, 0x94, 0xf0, 0xe6, 0xb2, 0x04, 0x54, 0x95, 0xf0, 0x50, 0xca, 0xec, 0xbf, 0x5a, 0x50,
+	0xd3, 0x8b, 0x63, 0x49, 0x1c, 0x31, 0x82, 0x5e, 0x81, 0x2a, 0xf6, 0x3c, 0xc2, 0x98, 0xcb, 0xe3,
+	0xc7, 0x24, 0x52, 0x2b, 0x74, 0x2a, 0x4a, 0xf6, 0x50, 0x88, 0xc4, 0x97, 0x29, 0x39, 0xa6, 0x84,
+	0x9d, 0x68, 0x4c, 0x41, 0x7d, 0x59, 0x0b, 0x15, 0x68, 0x07, 0x40, 0x2a, 0x5d, 0x3e, 0x4a, 0x88,
+	0x5e, 0x5c, 0x59, 0x4a, 0x1e, 0x8e, 0x12, 0x22, 0xd4, 0xe4, 0x49, 0x12, 0x50, 0xc2, 0xdc, 0x20,
+	0x92, 0x4b, 0x2b, 0x3a, 0x65, 0x2d, 0xb9, 0x13, 0xa1, 0x2d, 0x28, 0x31, 0x2f, 0x4e, 0x48, 0xb3,
+	0x24, 0x0d, 0xd5, 0x00, 0x7d, 0x0d, 0xca, 0xc2, 0x41, 0x6e, 0x10, 0x1d, 0xc7, 0xcd, 0x95, 0x8b,
+	0xd6, 0x6e, 0x65, 0xaf, 0xd9, 0x9e, 0xa4, 0x4d, 0xfb, 0x11, 0x23, 0xf4, 0x4e, 0x74, 0x1c, 0x2b,
+	0x5f, 0x8a, 0x5f, 0xf6, 0x01, 0x6c, 0x3a, 0x99, 0xa5, 0x99, 0x38, 0xbc, 0x9e, 0xdf, 0x46, 0x2e,
+	0x18, 0x13, 0xfb, 0xb1, 0x7f, 0x61, 0xc1, 0xd6, 0xe4, 0x57, 0xfe, 0x97, 0x1c, 0x66, 0xff, 0xab,
+	0x00, 0x6b, 0x66, 0xeb, 0xe8, 0x2c, 0xac, 0x2a, 0x3f, 0xf9, 0x7a, 0x35, 0x2b, 0xd2, 0x17, 0x3e,
+	0x6a, 0x65, 0xa8, 0xa7, 0xd6, 0x30, 0x66, 0xdc, 0x16, 0x94, 0x48, 0x1f, 0x07, 0xa1, 0x9e, 0x5a,
+	0x0d, 0x84, 0x94, 0xc6, 0x21, 0x61, 0xcd, 0xe5, 0x8b, 0x45, 0x21, 0x95, 0x03, 0x74, 0x1b, 0x00,
+	0x73, 0x4e, 0x83, 0xa3, 0x01, 0x27, 0xac, 0x59, 0xba, 0x58, 0xdc, 0xad, 0xec, 0xed, 0x9e, 0x16,
+	0x89, 0xf6, 0x7e, 0x0a, 0xbd, 0x19, 0x71, 0x3a, 0x72, 0x32, 0xb6, 0xe8, 0x3d, 0x00, 0x8f, 0x12,
+	0xcc, 0x89, 0xef, 0x62, 0xae, 0x63, 0xda, 0x6a, 0xab, 0x43, 0xda, 0x36, 0x87, 0xb4, 0xfd, 0xd0,
+	0x1c, 0x52, 0xa7, 0xac, 0xd1, 0xfb, 0x1c, 0x7d, 0x00, 0xb5, 0x10, 0x33, 0xee, 0x86, 0x82, 0xbf,
+	0xc2, 0x7a, 0x75, 0xa1, 0x75, 0x45, 0x18, 0x48, 0xbe, 0xef, 0xf3, 0xd6, 0xfb, 0xb0, 0x9e, 0x5b,
+	0x19, 0x6a, 0x40, 0xf1, 0x31, 0x19, 0x69, 0xa7, 0x89, 0x9f, 0x62, 0xff, 0x43, 0x1c, 0x0e, 0x8c,
+	0xbb, 0xd4, 0xe0, 0x7a, 0xe1, 0x5d, 0xcb, 0xfe, 0x95, 0x05, 0xd5, 0x6f, 0x0f, 0x08, 0x1d, 0x19,
+	0x3e, 0xed, 0x40, 0xe9, 0x53, 0x31, 0xce, 0xf3, 0x48, 0x49, 0x45, 0x00, 0x19, 0x61, 0x2c, 0x88,
+	0x23, 0x11, 0x17, 0xf5, 0xb9, 0xb2, 0x96, 0xdc, 0xf1, 0xd1, 0x87, 0x00, 0x09, 0xa6, 0xb8, 0x4f,
+	0x38, 0xa1, 0x4c, 0xc6, 0xa0, 0xb2, 0x77, 0x21, 0xef, 0x52, 0x39, 0xdf, 0x83, 0x14, 0xe6, 0x64,
+	0x4c, 0xb2, 0x41, 0x5f, 0xce, 0x06, 0xdd, 0xfe, 0x4b, 0x11, 0xd6, 0x73, 0x86, 0xe8, 0x2a, 0x54,
+	0xfa, 0xf8, 0x89, 0x4b, 0x09, 0x1b, 0x84, 0x9c, 0xc9, 0x15, 0x97, 0xba, 0xe5, 0x67, 0xdd, 0x95,
+	0xd6, 0x72, 0xd3, 0xdf, 0xb5, 0x1c, 0xe8, 0xe3, 0x27, 0x8e, 0x52, 0xa2, 0x2e, 0x6c, 0xb1, 0xa0,
+	0x1f, 0x84, 0x98, 0x06, 0x7c, 0xe4, 0xf2, 0x13, 0x41, 0xd9, 0x38, 0x54, 0x5b, 0x28, 0x74, 0xd7,
+	0x9f, 0x75, 0xab, 0x00, 0x6f, 0x2c, 0x2d, 0x2d, 0x2d, 0xed, 0x2c, 0x2d, 0xfd, 0xe0, 0x43, 0x67,
+	0x73, 0x0c, 0x7e, 0x68, 0xb0, 0xe2, 0x90, 0xf8, 0xb1, 0x37, 0xe8, 0xab, 0x5c, 0x25, 0xf6, 0x27,
+	0xd8, 0x54, 0x31, 0xb2, 0x3b, 0x3e, 0x43, 0x97, 0xa1, 0x9e, 0x42, 0xc4, 0x11, 0x30, 0x94, 0xab,
+	0x19, 0xa9, 0x38, 0x06, 0x0c, 0xdd, 0x82, 0xd5, 0xe3, 0x20, 0x94, 0x4e, 0x52, 0xbc, 0x7b, 0x7d,
+	0x81, 0x93, 0xda, 0xb7, 0x14, 0x5c, 0x71, 0xcf, 0x18, 0xa3, 0x2b, 0xd0, 0x20, 0x11, 0x3e, 0x0a,
+	0x89, 0x4b, 0x09, 0xc5, 0xd1, 0xe3, 0x20, 0xea, 0x49, 0xfa, 0xad, 0x39, 0xeb, 0x4a, 0xee, 0x18,
+	0x31, 0x7a, 0x1b, 0xce, 0x6a, 0xa8, 0x17, 0x47, 0x9c, 0x3c, 0xe1, 0x2e, 0x66, 0x8c, 0xf4, 0x8f,
+	0xc2, 0x91, 0xa4, 0xdc, 0x9a, 0xb3, 0xad, 0xd4, 0x07, 0x4a, 0xbb, 0xaf, 0x95, 0xe8, 0x75, 0x40,
+	0xc2, 0xc9, 0xc6, 0x28, 0x24, 0x51, 0x8f, 0x9f, 0x34, 0xd7, 0x84, 0xaf, 0x9d, 0x46, 0x1f, 0x3f,
+	0xd1, 0xf8, 0xbb, 0x52, 0xde, 0xba, 0x0e, 0xd5, 0xec, 0x4a, 0x5f, 0x88, 0x8b, 0xbf, 0x2c, 0x40,
+	0x4d, 0x73, 0x51, 0x67, 0xa5, 0x73, 0xb0, 0x26, 0x69, 0x37, 0xce, 0x01, 0xab, 0x72, 0x7c, 0xc7,
+	0x47, 0x67, 0x60, 0x05, 0x47, 0xec, 0x33, 0x42, 0xf5, 0x77, 0xf4, 0x08, 0xdd, 0x85, 0x0d, 0x4a,
+	0x42, 0x79, 0x14, 0x8d, 0xcb, 0x55, 0xa0, 0x66, 0x10, 0xd1, 0x51, 0xc0, 0x1b, 0x1a, 0xe7, 0x34,
+	0xe8, 0xa4, 0x40, 0x1c, 0xec, 0xb5, 0x3e, 0xe1, 0xd8, 0xc7, 0x1c, 0x4b, 0x3e, 0x56, 0xf6, 0x76,
+	0x66, 0x06, 0xea, 0x9e, 0x06, 0x39, 0x29, 0x1c, 0x5d, 0x84, 0x0a, 0x1b, 0xf4, 0x7a, 0x84, 0xc9,
+	0x82, 0x2f, 0xc3, 0x5c, 0x76, 0xb2, 0x22, 0x51, 0x08, 0xbc, 0x40, 0x37, 0x04, 0xcd, 0x15, 0xb9,
+	0xc4, 0xb3, 0xf2, 0xeb, 0xba, 0x5c, 0x0f, 0xaf, 0xb5, 0x0f, 0xb4, 0xde, 0x19, 0x23, 0xed, 0xff,
+	0x58, 0xd0, 0x90, 0x93, 0x1e, 0x72, 0x4a, 0x70, 0xff, 0xe6, 0x90, 0x44, 0x7c, 0x9e, 0xa7, 0x3e,
+	0x80, 0x32, 0x25, 0x9c, 0x06, 0x64, 0x88, 0x43, 0xe9, 0xac, 0xca, 0xde, 0xcb, 0x33, 0x37, 0xe1,
+	0x18, 0xd4, 0xed, 0x25, 0x67, 0x6c, 0x82, 0x6e, 0x42, 0x55, 0xf9, 0xd6, 0xf5, 0x49, 0xc8, 0xb1,
+	0x3e, 0xd5, 0x17, 0x67, 0x7e, 0x62, 0x5f, 0x02, 0x6f, 0x08, 0xdc, 0xed, 0x25, 0xa7, 0x82, 0xc7,
+	0x43, 0xf4, 0x3e, 0x94, 0xbd, 0xb8, 0x9f, 0x84, 0x84, 0x13, 0x7f, 0xae, 0x2f, 0x4d, 0xf4, 0xc5,
+	0x2a, 0x52, 0x8b, 0xee, 0x2a, 0x94, 0x88, 0xd8, 0xa9, 0xfd, 0x33, 0x0b, 0xea, 0x93, 0xcb, 0x9d,
+	0x1d, 0x73, 0xeb, 0xab, 0xc6, 0xfc, 0x5d, 0x68, 0xf2, 0x98, 0xe3, 0x70, 0xfc, 0x2d, 0x97, 0x11,
+	0x4c, 0xbd, 0x13, 0xa2, 0xb2, 0x45, 0xc9, 0x39, 0x23, 0xf5, 0xa9, 0xc5, 0xa1, 0xd6, 0xda, 0xaf,
+	0x41, 0x23, 0xef, 0x05, 0x84, 0x60, 0x59, 0x1c, 0x0f, 0x1d, 0x14, 0xf9, 0x5b, 0xf4, 0x2b, 0xeb,
+	0xb9, 0x75, 0xa0, 0x0b, 0x50, 0xc9, 0xe4, 0x16, 0x0d, 0x87, 0x71, 0x6a, 0x11, 0xe7, 0x86, 0x07,
+	0x3c, 0x4c, 0xcf, 0x8d, 0x1c, 0xa0, 0x26, 0xac, 0xb2, 0x28, 0x48, 0x12, 0xc2, 0x75, 0xc5, 0x33,
+	0x43, 0xf4, 0x7f, 0xb0, 0x4e, 0x49, 0x48, 0x86, 0x38, 0xf2, 0x88, 0xcb, 0xbc, 0x98, 0x12, 0xe9,
+	0xf5, 0x82, 0x53, 0x4f, 0xc5, 0x87, 0x42, 0x2a, 0xea, 0xfa, 0x44, 0xca, 0xd2, 0xdd, 0x4a, 0x35,
+	0x9b, 0xb1, 0xd0, 0x9b, 0xb0, 0xe2, 0x9d, 0x0c, 0xa2, 0xc7, 0x86, 0xa8, 0xcd, 0x3c, 0x51, 0x85,
+	0x52, 0x76, 0x2c, 0x1a, 0x67, 0xff, 0xa8, 0x08, 0xb5, 0x89, 0xb3, 0x21, 0xaa, 0xa4, 0xe2, 0xa8,
+	0xe8, 0x56, 0x9b, 0xd6, 0xc2, 0x3a, 0x57, 0x96, 0x68, 0x31, 0x16, 0x49, 0x28, 0xa1, 0xb1, 0xe8,
+	0x45, 0x82, 0xa8, 0x27, 0xed, 0xdd, 0x3e, 0x93, 0x9e, 0x28, 0x3a, 0x8d, 0xb1, 0x46, 0x60, 0xef,
+	0xcd, 0x8f, 0x60, 0x71, 0x5e, 0x04, 0xd1, 0x1b, 0x80, 0xc6, 0x36, 0x94, 0xf0, 0x01, 0x8d, 0x34,
+	0x5b, 0x4b, 0xce, 0x46, 0xaa, 0x71, 0xb4, 0x42, 0x54, 0xc3, 0x7e, 0xec, 0x93, 0xd0, 0x1d, 0x30,
+	0xe2, 0x6b, 0xbf, 0x95, 0xa5, 0xe4, 0x11, 0x23, 0x3e, 0xfa, 0x26, 0x80, 0x4f, 0x8e, 0x06, 0x3d,
+	0xd3, 0xea, 0x9d, 0x9e, 0xe8, 0x8d, 0x8f, 0xda, 0x37, 0x04, 0x5e, 0xf8, 0x51, 0x25, 0xfa, 0xb2,
+	0x6f, 0xc6, 0xad, 0x6f, 0x40, 0x7d, 0x52, 0xf9, 0x42, 0xb9, 0xf5, 0xa7, 0x05, 0xd8, 0x7e, 0x94,
+	0x84, 0x31, 0x1e, 0x33, 0x5f, 0x17, 0xfc, 0xab, 0x50, 0x3d, 0x0e, 0x4c, 0x55, 0x88, 0x14, 0x51,
+	0xab, 0xb2, 0xee, 0x3f, 0x15, 0x75, 0xbf, 0x22, 0x94, 0x07, 0x4a, 0x27, 0x9a, 0x83, 0x0c, 0x07,
+	0x33, 0xcd, 0x81, 0x22, 0xe3, 0xab, 0x50, 0x96, 0x9f, 0x1a, 0xf7, 0x7e, 0x99, 0x7e, 0x5f, 0x68,
+	0x24, 0x95, 0xde, 0x9a, 0xca, 0xa9, 0xf9, 0xac, 0x37, 0x23, 0x9b, 0x7e, 0x77, 0x82, 0x00, 0x71,
+	0x62, 0x92, 0xaa, 0x30, 0xbf, 0x92, 0x77, 0xa9, 0xd9, 0xe2, 0x83, 0xd4, 0xe2, 0xbe, 0x32, 0x70,
+	0x36, 0x92, 0xbc, 0xc8, 0xfe, 0xa2, 0x00, 0xe7, 0x4e, 0x35, 0x10, 0xf5, 0x5c, 0xf2, 0xd9, 0x65,
+	0x9c, 0x62, 0x4e, 0x7a, 0xc6, 0xdd, 0x35, 0x29, 0x3d, 0xd4, 0x42, 0x74, 0x05, 0x40, 0xc3, 0x82,
+	0xa7, 0xca, 0x3b, 0xa5, 0x2e, 0x3c, 0xeb, 0xae, 0xb6, 0x4a, 0xcd, 0x3f, 0xac, 0xef, 0xfa, 0x4e,
+	0x59, 0xc1, 0x83, 0xa7, 0x04, 0x75, 0x40, 0xd9, 0xba, 0xf1, 0x90, 0xd0, 0x10, 0x27, 0xcd, 0x62,
+	0x06, 0xbd, 0xbb, 0xd4, 0xfc, 0x77, 0xd1, 0xa9, 0x4a, 0xc0, 0x7d, 0xa5, 0x17, 0x07, 0x99, 0xf4,
+	0x8f, 0x88, 0xef, 0x8b, 0x9d, 0x4b, 0x72, 0xe9, 0xd6, 0xa8, 0x9e, 0x8a, 0xef, 0x09, 0xa9, 0x6c,
+	0xae, 0x55, 0x85, 0x8f, 0x3d, 0x2a, 0x7d, 0xb3, 0xe6, 0x94, 0x95, 0xe4, 0xbe, 0x47, 0x45, 0xdb,
+	0x1c, 0xe2, 0xa8, 0x37, 0xc0, 0x3d, 0x22, 0x7b, 0x84, 0xb2, 0x93, 0x8e, 0xed, 0x7f, 0x5a, 0x70,
+	0x26, 0x4f, 0x0f, 0x5d, 0x83, 0x17, 0x26, 0xa6, 0x4b, 0x50, 0x1b, 0x48, 0x53, 0x97, 0x71, 0xcc,
+	0x07, 0xcc, 0xdc, 0x0b, 0x94, 0xf0, 0x50, 0xca, 0xd0, 0x2d, 0x58, 0x4b, 0x68, 0xdc, 0xa3, 0x84,
+	0x99, 0xb6, 0xf0, 0xea, 0xe2, 0xa8, 0x3d, 0xd0, 0x16, 0x4e, 0x6a, 0x8b, 0x3e, 0xca, 0x24, 0x2b,
+	0x79, 0xaa, 0x14, 0x83, 0xce, 0xe7, 0x18, 0x64, 0xbe, 0x25, 0x33, 0x52, 0xd5, 0xcf, 0x8c, 0xec,
+	0x2f, 0x0b, 0xd0, 0x3a, 0x7d, 0x2a, 0xd4, 0x81, 0x4d, 0x33, 0x99, 0x9b, 0x10, 0xea, 0x91, 0x88,
+	0x0b, 0x87, 0x59, 0x32, 0x75, 0x22, 0xa3, 0x7a, 0x90, 0x6a, 0xe4, 0x0d, 0x75, 0x40, 0xa9, 0xbc,
+	0xa2, 0x4a, 0xa8, 0xde, 0xbe, 0x16, 0x1e, 0x4a, 0xd0, 0x65, 0xa8, 0x2b, 0xe7, 0xb8, 0x7d, 0xc2,
+	0x98, 0x40, 0xa9, 0x6c, 0x5d, 0x53, 0xd2, 0x7b, 0x4a, 0x28, 0x32, 0x24, 0xe3, 0x98, 0xea, 0x7b,
+	0xc4, 0xf2, 0xe2, 0x0c, 0xa9, 0xd1, 0xfb, 0x1c, 0xdd, 0x83, 0x2d, 0xd1, 0x57, 0xf4, 0x65, 0x15,
+	0xd4, 0x65, 0x33, 0x88, 0xa3, 0x66, 0x69, 0xe1, 0x47, 0x36, 0x53, 0xbb, 0x83, 0xd4, 0xcc, 0x26,
+	0x80, 0x3e, 0x26, 0x3c, 0x9f, 0x2b, 0x76, 0x67, 0x70, 0x61, 0x7c, 0xc4, 0xb3, 0xa4, 0xb8, 0x0c,
+	0xf5, 0x20, 0xf2, 0xc2, 0x81, 0x4f, 0xdc, 0xe3, 0x80, 0x84, 0xbe, 0x60, 0x85, 0xec, 0x83, 0xb5,
+	0xf4, 0x96, 0x14, 0xda, 0x7f, 0xb2, 0x60, 0x73, 0x62, 0x1e, 0x4d, 0xba, 0xa9, 0x30, 0x5b, 0x2f,
+	0x18, 0x66, 0x51, 0x18, 0x4d, 0x46, 0x53, 0x01, 0x31, 0xc3, 0x4c, 0x29, 0x2b, 0x3e, 0x5f, 0x29,
+	0x43, 0x6f, 0x41, 0x49, 0xc4, 0x89, 0x9d, 0xd6, 0xb6, 0x98, 0x65, 0x08, 0xae, 0x33, 0x47, 0x61,
+	0xed, 0x7f, 0x58, 0x50, 0x9b, 0x50, 0x88, 0xeb, 0x83, 0x2a, 0x4b, 0x7a, 0x7a, 0x79, 0x5f, 0x71,
+	0x2a, 0x52, 0x76, 0xa0, 0x66, 0x4a, 0x21, 0xf2, 0xca, 0xcc, 0x9a, 0x85, 0x0c, 0x44, 0x5e, 0xb0,
+	0x99, 0x28, 0x85, 0x78, 0x48, 0x28, 0xee, 0x11, 0x37, 0x93, 0x72, 0x54, 0x59, 0x6b, 0x68, 0xcd,
+	0x41, 0x9a, 0x6d, 0x2e, 0x40, 0x45, 0xd5, 0x5c, 0x2f, 0x1e, 0x44, 0x5c, 0x57, 0x32, 0x55, 0x86,
+	0x0f, 0x84, 0x04, 0x7d, 0xa8, 0xef, 0x9f, 0xea, 0xa6, 0x4f, 0xfc, 0xe7, 0x20, 0x4c, 0x55, 0x18,
+	0xec, 0x6b, 0xbc, 0xfd, 0x14, 0xb6, 0x6f, 0x10, 0xd1, 0xa3, 0x7d, 0x75, 0xb2, 0xbc, 0x03, 0xab,
+	0x26, 0xa3, 0x17, 0x4e, 0xf1, 0xb0, 0x9c, 0xc1, 0x64, 0x71, 0x83, 0xb6, 0x7f, 0x2b, 0x7c, 0x9c,
+	0x55, 0x09, 0x07, 0x1e, 0xc7, 0xd4, 0x23, 0xa2, 0x57, 0x25, 0x5c, 0x9d, 0xdb, 0x35, 0xa7, 0x22,
+	0x65, 0x0a, 0x89, 0xf6, 0x60, 0x5b, 0x29, 0x5d, 0xd3, 0x34, 0xea, 0x78, 0x14, 0x24, 0x76, 0x53,
+	0x29, 0x75, 0x7f, 0xa6, 0xe3, 0xf2, 0xff, 0xb0, 0xa1, 0x6d, 0xd2, 0x9c, 0xab, 0xf2, 0xd8, 0x9a,
+	0xd3, 0x50, 0x8a, 0x9b, 0xa9, 0x5c, 0x5c, 0x4d, 0x28, 0xc1, 0x2c, 0x8e, 0xcc, 0x15, 0x56, 0x8d,
+	0xec, 0x04, 0xce, 0xe4, 0x3d, 0xa5, 0xe9, 0x7e, 0x06, 0x56, 0x74, 0xee, 0xd4, 0x2f, 0x1d, 0x6a,
+	0x84, 0x3e, 0x80, 0xaa, 0x17, 0x12, 0x1c, 0x0d, 0x12, 0x75, 0x0a, 0x0a, 0x99, 0x53, 0x90, 0xf1,
+	0xce, 0x81, 0xc2, 0x48, 0xce, 0x56, 0xbc, 0xf1, 0xc0, 0xfe, 0x9b, 0x05, 0x95, 0x8c, 0x32, 0xad,
+	0x66, 0x4c, 0xbb, 0xc7, 0xd7, 0x1c, 0x54, 0x15, 0x89, 0xa9, 0xd5, 0xc9, 0x2e, 0x68, 0xbc, 0xcd,
+	0x14, 0xaa, 0xb8, 0xb8, 0x31, 0xd6, 0x18, 0x78, 0x1b, 0x36, 0x19, 0x8f, 0x25, 0x23, 0x8f, 0x29,
+	0x21, 0xbe, 0x7b, 0x34, 0xe2, 0x44, 0xb9, 0xa7, 0xe8, 0x6c, 0x68, 0xd5, 0x2d, 0xa1, 0xe9, 0x0a,
+	0x05, 0xfa, 0x96, 0x0e, 0x80, 0x78, 0x44, 0x48, 0x1b, 0xfc, 0xe7, 0x4b, 0x78, 0x9b, 0xc6, 0xf0,
+	0xc0, 0xd8, 0xed, 0x73, 0xfb, 0xcf, 0x16, 0x6c, 0xdd, 0x0d, 0x58, 0x9a, 0x45, 0x98, 0x61, 0xe0,
+	0x47, 0xe2, 0x35, 0xa2, 0x17, 0x44, 0xf2, 0xe2, 0xd4, 0xb4, 0x32, 0xf7, 0x96, 0xf1, 0x69, 0x7f,
+	0x90, 0x02, 0xb4, 0x95, 0x93, 0xb1, 0x41, 0x9d, 0xf1, 0x3d, 0xbd, 0x20, 0x93, 0xc5, 0x76, 0xce,
+	0x5c, 0x5d, 0x76, 0xb3, 0x17, 0xf2, 0x12, 0x8b, 0x69, 0x7a, 0xe5, 0xdc, 0xcc, 0xc1, 0x0f, 0x63,
+	0xca, 0x1d, 0x85, 0x98, 0x91, 0x22, 0x97, 0x67, 0xa5, 0xc8, 0xdf, 0x5b, 0xb0, 0x9d, 0xdb, 0x9d,
+	0x66, 0xcd, 0x7b, 0x50, 0xce, 0x5f, 0x77, 0xe6, 0x26, 0xc8, 0x31, 0x1a, 0xed, 0x4f, 0x78, 0x46,
+	0xd1, 0xea, 0x95, 0x39, 0x9e, 0x51, 0x33, 0x4e, 0xb8, 0xe6, 0x1d, 0x93, 0x14, 0x8b, 0x19, 0xeb,
+	0x19, 0x49, 0x51, 0xac, 0x7d, 0x22, 0x31, 0xfe, 0xae, 0x00, 0x1b, 0x53, 0x4a, 0xd1, 0xe5, 0xe4,
+	0x7a, 0x76, 0x19, 0xb0, 0xa2, 0x53, 0x9f, 0x6c, 0xd5, 0xd1, 0x2e, 0x34, 0x14, 0x50, 0xe4, 0x3d,
+	0x4d, 0xb5, 0x42, 0x06, 0x29, 0xd2, 0x9e, 0xe2, 0x59, 0x3e, 0xdf, 0x16, 0xa7, 0xf3, 0xed, 0x11,
+	0x8c, 0xbb, 0x7a, 0xf7, 0x68, 0xa4, 0x3a, 0xd7, 0x65, 0xe9, 0xca, 0xb7, 0x17, 0x6e, 0x28, 0x95,
+	0xb0, 0xee, 0x48, 0x74, 0xb6, 0xaa, 0x65, 0x5f, 0xf7, 0x27, 0xa5, 0xad, 0x2e, 0x6c, 0xcd, 0x02,
+	0x2e, 0x6a, 0xdf, 0x4b, 0xd9, 0xf6, 0xfd, 0x4b, 0x0b, 0x76, 0x1e, 0x25, 0x3e, 0x1e, 0xe7, 0x8e,
+	0xb4, 0x47, 0x7e, 0xe1, 0x6c, 0x9b, 0xed, 0xbf, 0x0b, 0xcf, 0xdb, 0x7f, 0xcb, 0x26, 0x4f, 0xcc,
+	0x6f, 0xb8, 0xaa, 0xde, 0xbe, 0xaa, 0x4a, 0xa8, 0xa9, 0xfa, 0x6b, 0x0b, 0x5e, 0x3e, 0x6d, 0x95,
+	0x0b, 0x32, 0x5d, 0x17, 0x1a, 0xea, 0x53, 0xbe, 0xfb, 0xbc, 0x8b, 0x5b, 0xd7, 0x06, 0x46, 0x20,
+	0x1e, 0xc3, 0x8c, 0xad, 0x3b, 0x24, 0x54, 0x3c, 0x49, 0xea, 0x98, 0xaf, 0x1b, 0xf9, 0x77, 0x94,
+	0x78, 0xef, 0xc7, 0x65, 0x58, 0xfd, 0x58, 0x85, 0x16, 0xb9, 0x50, 0x92, 0x8f, 0xa9, 0xe8, 0xa5,
+	0x7c, 0xc4, 0xb3, 0x7f, 0x78, 0xb4, 0x76, 0x4e, 0xd1, 0xaa, 0x8d, 0xd9, 0xe7, 0xbe, 0xf8, 0xe3,
+	0xdf, 0x7f, 0x52, 0xd8, 0xb4, 0xeb, 0xe2, 0x3f, 0x15, 0x3c, 0xe0, 0x27, 0x1d, 0xf9, 0xa2, 0x7b,
+	0xdd, 0xba, 0x8a, 0x86, 0x50, 0xcd, 0xbe, 0xb9, 0xa3, 0x4b, 0xd3, 0x6f, 0x12, 0x53, 0xef, 0xfa,
+	0xad, 0x57, 0xe7, 0x83, 0xf4, 0xac, 0xe7, 0xe5, 0xac, 0xdb, 0x76, 0x23, 0x9d, 0x55, 0xbf, 0xc6,
+	0x8b, 0x79, 0x3f, 0x81, 0x92, 0xbc, 0x5c, 0x4e, 0x6f, 0x2c, 0xfb, 0xe2, 0xdb, 0x9a, 0xff, 0x0a,
+	0x63, 0x6f, 0xc9, 0x29, 0xea, 0x76, 0x59, 0x4c, 0x21, 0x1b, 0x07, 0xf1, 0xed, 0xfb, 0x50, 0xc9,
+	0xbc, 0x41, 0x2d, 0x98, 0x61, 0xf6, 0x5b, 0x51, 0xe6, 0xf9, 0xea, 0x4d, 0x0b, 0x8d, 0xa0, 0x3e,
+	0x79, 0x01, 0x41, 0x97, 0xa7, 0x9e, 0xe2, 0x67, 0xdd, 0x5f, 0x5b, 0xaf, 0x2d, 0x82, 0xe9, 0x7d,
+	0x34, 0xe5, 0x3e, 0x90, 0x5d, 0x13, 0xfb, 0x48, 0xcf, 0xa8, 0xd8, 0xcb, 0xe7, 0x50, 0xc9, 0xf4,
+	0xa0, 0xc8, 0xce, 0x7f, 0x70, 0xba, 0x11, 0x6e, 0x5d, 0x9a, 0x8b, 0xd1, 0x33, 0x5e, 0x92, 0x33,
+	0xee, 0xa0, 0xf3, 0x13, 0x33, 0x76, 0x3e, 0xcf, 0x9c, 0xd3, 0xef, 0xa3, 0x1f, 0x5a, 0xe2, 0x5e,
+	0x9f, 0xed, 0x0a, 0xa6, 0x37, 0x3e, 0xb3, 0xbf, 0x6a, 0xbd, 0xb6, 0x08, 0x36, 0xb9, 0x8c, 0xab,
+	0x73, 0x97, 0xc1, 0xa0, 0x36, 0x51, 0x64, 0xd0, 0x14, 0xff, 0x66, 0x55, 0xd8, 0xd6, 0xe5, 0x05,
+	0x28, 0xbd, 0x84, 0x6d, 0xb9, 0x84, 0x75, 0x34, 0xe9, 0x7b, 0xf4, 0x1b, 0x79, 0xeb, 0x9c, 0x95,
+	0x2f, 0xd0, 0x1b, 0xd3, 0x51, 0x9d, 0x93, 0xfd, 0x5a, 0xed, 0xe7, 0x85, 0xeb, 0x05, 0x75, 0xe4,
+	0x82, 0xae, 0x5c, 0xb7, 0xae, 0xb6, 0x5e, 0x9d, 0xe3, 0x96, 0x4e, 0x9a, 0xff, 0xbe, 0x07, 0x95,
+	0xdb, 0x04, 0x87, 0xfc, 0xe4, 0xe0, 0x84, 0x78, 0x8f, 0xd1, 0x99, 0xa9, 0x1e, 0xe5, 0xa6, 0xf8,
+	0x7b, 0xb6, 0x65, 0xe7, 0x92, 0x55, 0xc6, 0x26, 0x9d, 0x1b, 0xc9, 0xb9, 0xab, 0x08, 0xc4, 0xc4,
+	0x27, 0x12, 0xd0, 0xbd, 0x02, 0xb9, 0x3f, 0x8e, 0x1f, 0x58, 0x9f, 0x6c, 0x53, 0xdc, 0x93, 0xff,
+	0x0c, 0x6b, 0x69, 0x67, 0x78, 0xed, 0xeb, 0xc3, 0x6b, 0x47, 0x2b, 0x72, 0xca, 0xb7, 0xfe, 0x3b,
+	0x00, 0x0a, 0x7b, 0x26, 0x9b, 0x80, 0x1e, 0x00, 0x00,
 }
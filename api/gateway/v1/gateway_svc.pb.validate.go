@@ -1049,6 +1049,40 @@ func (m *QueryResponse) validate(all bool) error {
 		}
 	}
 
+	for idx, item := range m.GetCitations() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, QueryResponseValidationError{
+						field:  fmt.Sprintf("Citations[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, QueryResponseValidationError{
+						field:  fmt.Sprintf("Citations[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return QueryResponseValidationError{
+					field:  fmt.Sprintf("Citations[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
 	if len(errors) > 0 {
 		return QueryResponseMultiError(errors)
 	}
@@ -1127,6 +1161,474 @@ var _ interface {
 	ErrorName() string
 } = QueryResponseValidationError{}
 
+// Validate checks the field values on QueryStreamEvent with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *QueryStreamEvent) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on QueryStreamEvent with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// QueryStreamEventMultiError, or nil if none found.
+func (m *QueryStreamEvent) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *QueryStreamEvent) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for QueryId
+
+	switch v := m.Event.(type) {
+	case *QueryStreamEvent_Retrieval:
+		if v == nil {
+			err := QueryStreamEventValidationError{
+				field:  "Event",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if all {
+			switch v := interface{}(m.GetRetrieval()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, QueryStreamEventValidationError{
+						field:  "Retrieval",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, QueryStreamEventValidationError{
+						field:  "Retrieval",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetRetrieval()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return QueryStreamEventValidationError{
+					field:  "Retrieval",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	case *QueryStreamEvent_AnswerDelta:
+		if v == nil {
+			err := QueryStreamEventValidationError{
+				field:  "Event",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if all {
+			switch v := interface{}(m.GetAnswerDelta()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, QueryStreamEventValidationError{
+						field:  "AnswerDelta",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, QueryStreamEventValidationError{
+						field:  "AnswerDelta",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetAnswerDelta()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return QueryStreamEventValidationError{
+					field:  "AnswerDelta",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	case *QueryStreamEvent_Completed:
+		if v == nil {
+			err := QueryStreamEventValidationError{
+				field:  "Event",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if all {
+			switch v := interface{}(m.GetCompleted()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, QueryStreamEventValidationError{
+						field:  "Completed",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, QueryStreamEventValidationError{
+						field:  "Completed",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetCompleted()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return QueryStreamEventValidationError{
+					field:  "Completed",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	default:
+		_ = v // ensures v is used
+	}
+
+	if len(errors) > 0 {
+		return QueryStreamEventMultiError(errors)
+	}
+
+	return nil
+}
+
+// QueryStreamEventMultiError is an error wrapping multiple validation errors
+// returned by QueryStreamEvent.ValidateAll() if the designated constraints
+// aren't met.
+type QueryStreamEventMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m QueryStreamEventMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m QueryStreamEventMultiError) AllErrors() []error { return m }
+
+// QueryStreamEventValidationError is the validation error returned by
+// QueryStreamEvent.Validate if the designated constraints aren't met.
+type QueryStreamEventValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e QueryStreamEventValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e QueryStreamEventValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e QueryStreamEventValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e QueryStreamEventValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e QueryStreamEventValidationError) ErrorName() string { return "QueryStreamEventValidationError" }
+
+// Error satisfies the builtin error interface
+func (e QueryStreamEventValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sQueryStreamEvent.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = QueryStreamEventValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = QueryStreamEventValidationError{}
+
+// Validate checks the field values on QueryRetrieval with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *QueryRetrieval) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on QueryRetrieval with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in QueryRetrievalMultiError,
+// or nil if none found.
+func (m *QueryRetrieval) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *QueryRetrieval) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetRelatedDocuments() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, QueryRetrievalValidationError{
+						field:  fmt.Sprintf("RelatedDocuments[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, QueryRetrievalValidationError{
+						field:  fmt.Sprintf("RelatedDocuments[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return QueryRetrievalValidationError{
+					field:  fmt.Sprintf("RelatedDocuments[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for TotalDocumentsSearched
+
+	if len(errors) > 0 {
+		return QueryRetrievalMultiError(errors)
+	}
+
+	return nil
+}
+
+// QueryRetrievalMultiError is an error wrapping multiple validation errors
+// returned by QueryRetrieval.ValidateAll() if the designated constraints
+// aren't met.
+type QueryRetrievalMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m QueryRetrievalMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m QueryRetrievalMultiError) AllErrors() []error { return m }
+
+// QueryRetrievalValidationError is the validation error returned by
+// QueryRetrieval.Validate if the designated constraints aren't met.
+type QueryRetrievalValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e QueryRetrievalValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e QueryRetrievalValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e QueryRetrievalValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e QueryRetrievalValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e QueryRetrievalValidationError) ErrorName() string { return "QueryRetrievalValidationError" }
+
+// Error satisfies the builtin error interface
+func (e QueryRetrievalValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sQueryRetrieval.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = QueryRetrievalValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = QueryRetrievalValidationError{}
+
+// Validate checks the field values on QueryAnswerDelta with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *QueryAnswerDelta) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on QueryAnswerDelta with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// QueryAnswerDeltaMultiError, or nil if none found.
+func (m *QueryAnswerDelta) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *QueryAnswerDelta) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Text
+
+	if len(errors) > 0 {
+		return QueryAnswerDeltaMultiError(errors)
+	}
+
+	return nil
+}
+
+// QueryAnswerDeltaMultiError is an error wrapping multiple validation errors
+// returned by QueryAnswerDelta.ValidateAll() if the designated constraints
+// aren't met.
+type QueryAnswerDeltaMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m QueryAnswerDeltaMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m QueryAnswerDeltaMultiError) AllErrors() []error { return m }
+
+// QueryAnswerDeltaValidationError is the validation error returned by
+// QueryAnswerDelta.Validate if the designated constraints aren't met.
+type QueryAnswerDeltaValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e QueryAnswerDeltaValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e QueryAnswerDeltaValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e QueryAnswerDeltaValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e QueryAnswerDeltaValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e QueryAnswerDeltaValidationError) ErrorName() string { return "QueryAnswerDeltaValidationError" }
+
+// Error satisfies the builtin error interface
+func (e QueryAnswerDeltaValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sQueryAnswerDelta.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = QueryAnswerDeltaValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = QueryAnswerDeltaValidationError{}
+
 // Validate checks the field values on RelatedDocument with the rules defined
 // in the proto definition for this message. If any rules are violated, the
 // first error encountered is returned, or nil if there are no violations.
@@ -24,6 +24,7 @@ const (
 	Gateway_Login_FullMethodName                  = "/api.gateway.v1.Gateway/Login"
 	Gateway_RefreshToken_FullMethodName           = "/api.gateway.v1.Gateway/RefreshToken"
 	Gateway_Query_FullMethodName                  = "/api.gateway.v1.Gateway/Query"
+	Gateway_QueryStream_FullMethodName            = "/api.gateway.v1.Gateway/QueryStream"
 	Gateway_UploadDocument_FullMethodName         = "/api.gateway.v1.Gateway/UploadDocument"
 	Gateway_GetDocument_FullMethodName            = "/api.gateway.v1.Gateway/GetDocument"
 	Gateway_DeleteDocument_FullMethodName         = "/api.gateway.v1.Gateway/DeleteDocument"
@@ -43,6 +44,9 @@ type GatewayClient interface {
 	RefreshToken(ctx context.Context, in *RefreshTokenRequest, opts ...grpc.CallOption) (*RefreshTokenResponse, error)
 	// 智能问答查询
 	Query(ctx context.Context, in *QueryRequest, opts ...grpc.CallOption) (*QueryResponse, error)
+	// 流式智能问答：依次返回检索到的文档、生成中的答案片段和完整结果
+	// HTTP 以 Server-Sent Events 提供：POST /v1/query/stream（请求体同 Query）或 GET /v1/query/stream?query=...
+	QueryStream(ctx context.Context, in *QueryRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[QueryStreamEvent], error)
 	// 文档管理
 	UploadDocument(ctx context.Context, in *UploadDocumentRequest, opts ...grpc.CallOption) (*UploadDocumentResponse, error)
 	GetDocument(ctx context.Context, in *GetDocumentRequest, opts ...grpc.CallOption) (*GetDocumentResponse, error)
@@ -91,6 +95,25 @@ func (c *gatewayClient) Query(ctx context.Context, in *QueryRequest, opts ...grp
 	return out, nil
 }
 
+func (c *gatewayClient) QueryStream(ctx context.Context, in *QueryRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[QueryStreamEvent], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &Gateway_ServiceDesc.Streams[0], Gateway_QueryStream_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[QueryRequest, QueryStreamEvent]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type Gateway_QueryStreamClient = grpc.ServerStreamingClient[QueryStreamEvent]
+
 func (c *gatewayClient) UploadDocument(ctx context.Context, in *UploadDocumentRequest, opts ...grpc.CallOption) (*UploadDocumentResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(UploadDocumentResponse)
@@ -162,6 +185,9 @@ type GatewayServer interface {
 	RefreshToken(context.Context, *RefreshTokenRequest) (*RefreshTokenResponse, error)
 	// 智能问答查询
 	Query(context.Context, *QueryRequest) (*QueryResponse, error)
+	// 流式智能问答：依次返回检索到的文档、生成中的答案片段和完整结果
+	// HTTP 以 Server-Sent Events 提供：POST /v1/query/stream（请求体同 Query）或 GET /v1/query/stream?query=...
+	QueryStream(*QueryRequest, grpc.ServerStreamingServer[QueryStreamEvent]) error
 	// 文档管理
 	UploadDocument(context.Context, *UploadDocumentRequest) (*UploadDocumentResponse, error)
 	GetDocument(context.Context, *GetDocumentRequest) (*GetDocumentResponse, error)
@@ -189,6 +215,9 @@ func (UnimplementedGatewayServer) RefreshToken(context.Context, *RefreshTokenReq
 func (UnimplementedGatewayServer) Query(context.Context, *QueryRequest) (*QueryResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Query not implemented")
 }
+func (UnimplementedGatewayServer) QueryStream(*QueryRequest, grpc.ServerStreamingServer[QueryStreamEvent]) error {
+	return status.Errorf(codes.Unimplemented, "method QueryStream not implemented")
+}
 func (UnimplementedGatewayServer) UploadDocument(context.Context, *UploadDocumentRequest) (*UploadDocumentResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UploadDocument not implemented")
 }
@@ -282,6 +311,17 @@ func _Gateway_Query_Handler(srv interface{}, ctx context.Context, dec func(inter
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Gateway_QueryStream_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(QueryRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(GatewayServer).QueryStream(m, &grpc.GenericServerStream[QueryRequest, QueryStreamEvent]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type Gateway_QueryStreamServer = grpc.ServerStreamingServer[QueryStreamEvent]
+
 func _Gateway_UploadDocument_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(UploadDocumentRequest)
 	if err := dec(in); err != nil {
@@ -434,6 +474,12 @@ var Gateway_ServiceDesc = grpc.ServiceDesc{
 			Handler:    _Gateway_HealthCheck_Handler,
 		},
 	},
-	Streams:  []grpc.StreamDesc{},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "QueryStream",
+			Handler:       _Gateway_QueryStream_Handler,
+			ServerStreams: true,
+		},
+	},
 	Metadata: "v1/gateway_svc.proto",
 }
@@ -182,6 +182,7 @@ type ProcessQueryResponse struct {
 	ContextDocuments     []*ContextDocument       `protobuf:"bytes,3,rep,name=context_documents,json=contextDocuments,proto3" json:"context_documents,omitempty"`
 	Metadata             *QueryProcessingMetadata `protobuf:"bytes,4,opt,name=metadata,proto3" json:"metadata,omitempty"`
 	ExecutionTrace       *WorkflowExecutionTrace  `protobuf:"bytes,5,opt,name=execution_trace,json=executionTrace,proto3" json:"execution_trace,omitempty"`
+	Citations            []*v1.Citation           `protobuf:"bytes,6,rep,name=citations,proto3" json:"citations,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}                 `json:"-"`
 	XXX_unrecognized     []byte                   `json:"-"`
 	XXX_sizecache        int32                    `json:"-"`
@@ -247,6 +248,13 @@ func (m *ProcessQueryResponse) GetExecutionTrace() *WorkflowExecutionTrace {
 	return nil
 }
 
+func (m *ProcessQueryResponse) GetCitations() []*v1.Citation {
+	if m != nil {
+		return m.Citations
+	}
+	return nil
+}
+
 type ContextDocument struct {
 	DocumentId           string            `protobuf:"bytes,1,opt,name=document_id,json=documentId,proto3" json:"document_id,omitempty"`
 	ChunkId              string            `protobuf:"bytes,2,opt,name=chunk_id,json=chunkId,proto3" json:"chunk_id,omitempty"`
@@ -437,6 +445,196 @@ func (m *QueryProcessingMetadata) GetCompletedAt() *timestamppb.Timestamp {
 	return nil
 }
 
+// 流式查询的事件，依次为 retrieval、若干 answer_delta 和 completed
+type ProcessQueryEvent struct {
+	QueryId string `protobuf:"bytes,1,opt,name=query_id,json=queryId,proto3" json:"query_id,omitempty"`
+	// Types that are valid to be assigned to Event:
+	//	*ProcessQueryEvent_Retrieval
+	//	*ProcessQueryEvent_AnswerDelta
+	//	*ProcessQueryEvent_Completed
+	Event                isProcessQueryEvent_Event `protobuf_oneof:"event"`
+	XXX_NoUnkeyedLiteral struct{}                  `json:"-"`
+	XXX_unrecognized     []byte                    `json:"-"`
+	XXX_sizecache        int32                     `json:"-"`
+}
+
+func (m *ProcessQueryEvent) Reset()         { *m = ProcessQueryEvent{} }
+func (m *ProcessQueryEvent) String() string { return proto.CompactTextString(m) }
+func (*ProcessQueryEvent) ProtoMessage()    {}
+func (*ProcessQueryEvent) Descriptor() ([]byte, []int) {
+	return fileDescriptor_446cc1513e4cd66f, []int{5}
+}
+
+func (m *ProcessQueryEvent) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ProcessQueryEvent.Unmarshal(m, b)
+}
+func (m *ProcessQueryEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ProcessQueryEvent.Marshal(b, m, deterministic)
+}
+func (m *ProcessQueryEvent) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ProcessQueryEvent.Merge(m, src)
+}
+func (m *ProcessQueryEvent) XXX_Size() int {
+	return xxx_messageInfo_ProcessQueryEvent.Size(m)
+}
+func (m *ProcessQueryEvent) XXX_DiscardUnknown() {
+	xxx_messageInfo_ProcessQueryEvent.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ProcessQueryEvent proto.InternalMessageInfo
+
+func (m *ProcessQueryEvent) GetQueryId() string {
+	if m != nil {
+		return m.QueryId
+	}
+	return ""
+}
+
+type isProcessQueryEvent_Event interface {
+	isProcessQueryEvent_Event()
+}
+
+type ProcessQueryEvent_Retrieval struct {
+	Retrieval *QueryRetrieval `protobuf:"bytes,2,opt,name=retrieval,proto3,oneof"`
+}
+
+type ProcessQueryEvent_AnswerDelta struct {
+	AnswerDelta *QueryAnswerDelta `protobuf:"bytes,3,opt,name=answer_delta,json=answerDelta,proto3,oneof"`
+}
+
+type ProcessQueryEvent_Completed struct {
+	Completed *ProcessQueryResponse `protobuf:"bytes,4,opt,name=completed,proto3,oneof"`
+}
+
+func (*ProcessQueryEvent_Retrieval) isProcessQueryEvent_Event() {}
+
+func (*ProcessQueryEvent_AnswerDelta) isProcessQueryEvent_Event() {}
+
+func (*ProcessQueryEvent_Completed) isProcessQueryEvent_Event() {}
+
+func (m *ProcessQueryEvent) GetEvent() isProcessQueryEvent_Event {
+	if m != nil {
+		return m.Event
+	}
+	return nil
+}
+
+func (m *ProcessQueryEvent) GetRetrieval() *QueryRetrieval {
+	if x, ok := m.GetEvent().(*ProcessQueryEvent_Retrieval); ok {
+		return x.Retrieval
+	}
+	return nil
+}
+
+func (m *ProcessQueryEvent) GetAnswerDelta() *QueryAnswerDelta {
+	if x, ok := m.GetEvent().(*ProcessQueryEvent_AnswerDelta); ok {
+		return x.AnswerDelta
+	}
+	return nil
+}
+
+func (m *ProcessQueryEvent) GetCompleted() *ProcessQueryResponse {
+	if x, ok := m.GetEvent().(*ProcessQueryEvent_Completed); ok {
+		return x.Completed
+	}
+	return nil
+}
+
+// XXX_OneofWrappers is for the internal use of the proto package.
+func (*ProcessQueryEvent) XXX_OneofWrappers() []interface{} {
+	return []interface{}{
+		(*ProcessQueryEvent_Retrieval)(nil),
+		(*ProcessQueryEvent_AnswerDelta)(nil),
+		(*ProcessQueryEvent_Completed)(nil),
+	}
+}
+
+type QueryRetrieval struct {
+	ContextDocuments       []*ContextDocument `protobuf:"bytes,1,rep,name=context_documents,json=contextDocuments,proto3" json:"context_documents,omitempty"`
+	TotalDocumentsSearched int32              `protobuf:"varint,2,opt,name=total_documents_searched,json=totalDocumentsSearched,proto3" json:"total_documents_searched,omitempty"`
+	XXX_NoUnkeyedLiteral   struct{}           `json:"-"`
+	XXX_unrecognized       []byte             `json:"-"`
+	XXX_sizecache          int32              `json:"-"`
+}
+
+func (m *QueryRetrieval) Reset()         { *m = QueryRetrieval{} }
+func (m *QueryRetrieval) String() string { return proto.CompactTextString(m) }
+func (*QueryRetrieval) ProtoMessage()    {}
+func (*QueryRetrieval) Descriptor() ([]byte, []int) {
+	return fileDescriptor_446cc1513e4cd66f, []int{6}
+}
+
+func (m *QueryRetrieval) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_QueryRetrieval.Unmarshal(m, b)
+}
+func (m *QueryRetrieval) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_QueryRetrieval.Marshal(b, m, deterministic)
+}
+func (m *QueryRetrieval) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRetrieval.Merge(m, src)
+}
+func (m *QueryRetrieval) XXX_Size() int {
+	return xxx_messageInfo_QueryRetrieval.Size(m)
+}
+func (m *QueryRetrieval) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRetrieval.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryRetrieval proto.InternalMessageInfo
+
+func (m *QueryRetrieval) GetContextDocuments() []*ContextDocument {
+	if m != nil {
+		return m.ContextDocuments
+	}
+	return nil
+}
+
+func (m *QueryRetrieval) GetTotalDocumentsSearched() int32 {
+	if m != nil {
+		return m.TotalDocumentsSearched
+	}
+	return 0
+}
+
+type QueryAnswerDelta struct {
+	Text                 string   `protobuf:"bytes,1,opt,name=text,proto3" json:"text,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *QueryAnswerDelta) Reset()         { *m = QueryAnswerDelta{} }
+func (m *QueryAnswerDelta) String() string { return proto.CompactTextString(m) }
+func (*QueryAnswerDelta) ProtoMessage()    {}
+func (*QueryAnswerDelta) Descriptor() ([]byte, []int) {
+	return fileDescriptor_446cc1513e4cd66f, []int{7}
+}
+
+func (m *QueryAnswerDelta) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_QueryAnswerDelta.Unmarshal(m, b)
+}
+func (m *QueryAnswerDelta) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_QueryAnswerDelta.Marshal(b, m, deterministic)
+}
+func (m *QueryAnswerDelta) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAnswerDelta.Merge(m, src)
+}
+func (m *QueryAnswerDelta) XXX_Size() int {
+	return xxx_messageInfo_QueryAnswerDelta.Size(m)
+}
+func (m *QueryAnswerDelta) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAnswerDelta.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAnswerDelta proto.InternalMessageInfo
+
+func (m *QueryAnswerDelta) GetText() string {
+	if m != nil {
+		return m.Text
+	}
+	return ""
+}
+
 type ExecuteWorkflowRequest struct {
 	WorkflowDefinitionId string                `protobuf:"bytes,1,opt,name=workflow_definition_id,json=workflowDefinitionId,proto3" json:"workflow_definition_id,omitempty"`
 	InputParameters      map[string]*anypb.Any `protobuf:"bytes,2,rep,name=input_parameters,json=inputParameters,proto3" json:"input_parameters,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
@@ -451,7 +649,7 @@ func (m *ExecuteWorkflowRequest) Reset()         { *m = ExecuteWorkflowRequest{}
 func (m *ExecuteWorkflowRequest) String() string { return proto.CompactTextString(m) }
 func (*ExecuteWorkflowRequest) ProtoMessage()    {}
 func (*ExecuteWorkflowRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_446cc1513e4cd66f, []int{5}
+	return fileDescriptor_446cc1513e4cd66f, []int{8}
 }
 
 func (m *ExecuteWorkflowRequest) XXX_Unmarshal(b []byte) error {
@@ -516,7 +714,7 @@ func (m *ExecutionOptions) Reset()         { *m = ExecutionOptions{} }
 func (m *ExecutionOptions) String() string { return proto.CompactTextString(m) }
 func (*ExecutionOptions) ProtoMessage()    {}
 func (*ExecutionOptions) Descriptor() ([]byte, []int) {
-	return fileDescriptor_446cc1513e4cd66f, []int{6}
+	return fileDescriptor_446cc1513e4cd66f, []int{9}
 }
 
 func (m *ExecutionOptions) XXX_Unmarshal(b []byte) error {
@@ -594,7 +792,7 @@ func (m *ExecuteWorkflowResponse) Reset()         { *m = ExecuteWorkflowResponse
 func (m *ExecuteWorkflowResponse) String() string { return proto.CompactTextString(m) }
 func (*ExecuteWorkflowResponse) ProtoMessage()    {}
 func (*ExecuteWorkflowResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_446cc1513e4cd66f, []int{7}
+	return fileDescriptor_446cc1513e4cd66f, []int{10}
 }
 
 func (m *ExecuteWorkflowResponse) XXX_Unmarshal(b []byte) error {
@@ -665,7 +863,7 @@ func (m *ExecuteWorkflowAsyncRequest) Reset()         { *m = ExecuteWorkflowAsyn
 func (m *ExecuteWorkflowAsyncRequest) String() string { return proto.CompactTextString(m) }
 func (*ExecuteWorkflowAsyncRequest) ProtoMessage()    {}
 func (*ExecuteWorkflowAsyncRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_446cc1513e4cd66f, []int{8}
+	return fileDescriptor_446cc1513e4cd66f, []int{11}
 }
 
 func (m *ExecuteWorkflowAsyncRequest) XXX_Unmarshal(b []byte) error {
@@ -735,7 +933,7 @@ func (m *ExecuteWorkflowAsyncResponse) Reset()         { *m = ExecuteWorkflowAsy
 func (m *ExecuteWorkflowAsyncResponse) String() string { return proto.CompactTextString(m) }
 func (*ExecuteWorkflowAsyncResponse) ProtoMessage()    {}
 func (*ExecuteWorkflowAsyncResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_446cc1513e4cd66f, []int{9}
+	return fileDescriptor_446cc1513e4cd66f, []int{12}
 }
 
 func (m *ExecuteWorkflowAsyncResponse) XXX_Unmarshal(b []byte) error {
@@ -797,7 +995,7 @@ func (m *GetWorkflowStatusRequest) Reset()         { *m = GetWorkflowStatusReque
 func (m *GetWorkflowStatusRequest) String() string { return proto.CompactTextString(m) }
 func (*GetWorkflowStatusRequest) ProtoMessage()    {}
 func (*GetWorkflowStatusRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_446cc1513e4cd66f, []int{10}
+	return fileDescriptor_446cc1513e4cd66f, []int{13}
 }
 
 func (m *GetWorkflowStatusRequest) XXX_Unmarshal(b []byte) error {
@@ -845,6 +1043,7 @@ type GetWorkflowStatusResponse struct {
 	Metadata             *WorkflowExecutionMetadata `protobuf:"bytes,3,opt,name=metadata,proto3" json:"metadata,omitempty"`
 	ExecutionTrace       *WorkflowExecutionTrace    `protobuf:"bytes,4,opt,name=execution_trace,json=executionTrace,proto3" json:"execution_trace,omitempty"`
 	Logs                 []*WorkflowLog             `protobuf:"bytes,5,rep,name=logs,proto3" json:"logs,omitempty"`
+	OutputResults        map[string]*anypb.Any      `protobuf:"bytes,6,rep,name=output_results,json=outputResults,proto3" json:"output_results,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
 	XXX_NoUnkeyedLiteral struct{}                   `json:"-"`
 	XXX_unrecognized     []byte                     `json:"-"`
 	XXX_sizecache        int32                      `json:"-"`
@@ -854,7 +1053,7 @@ func (m *GetWorkflowStatusResponse) Reset()         { *m = GetWorkflowStatusResp
 func (m *GetWorkflowStatusResponse) String() string { return proto.CompactTextString(m) }
 func (*GetWorkflowStatusResponse) ProtoMessage()    {}
 func (*GetWorkflowStatusResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_446cc1513e4cd66f, []int{11}
+	return fileDescriptor_446cc1513e4cd66f, []int{14}
 }
 
 func (m *GetWorkflowStatusResponse) XXX_Unmarshal(b []byte) error {
@@ -910,6 +1109,13 @@ func (m *GetWorkflowStatusResponse) GetLogs() []*WorkflowLog {
 	return nil
 }
 
+func (m *GetWorkflowStatusResponse) GetOutputResults() map[string]*anypb.Any {
+	if m != nil {
+		return m.OutputResults
+	}
+	return nil
+}
+
 type CancelWorkflowRequest struct {
 	WorkflowId           string   `protobuf:"bytes,1,opt,name=workflow_id,json=workflowId,proto3" json:"workflow_id,omitempty"`
 	Reason               string   `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
@@ -923,7 +1129,7 @@ func (m *CancelWorkflowRequest) Reset()         { *m = CancelWorkflowRequest{} }
 func (m *CancelWorkflowRequest) String() string { return proto.CompactTextString(m) }
 func (*CancelWorkflowRequest) ProtoMessage()    {}
 func (*CancelWorkflowRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_446cc1513e4cd66f, []int{12}
+	return fileDescriptor_446cc1513e4cd66f, []int{15}
 }
 
 func (m *CancelWorkflowRequest) XXX_Unmarshal(b []byte) error {
@@ -979,7 +1185,7 @@ func (m *CancelWorkflowResponse) Reset()         { *m = CancelWorkflowResponse{}
 func (m *CancelWorkflowResponse) String() string { return proto.CompactTextString(m) }
 func (*CancelWorkflowResponse) ProtoMessage()    {}
 func (*CancelWorkflowResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_446cc1513e4cd66f, []int{13}
+	return fileDescriptor_446cc1513e4cd66f, []int{16}
 }
 
 func (m *CancelWorkflowResponse) XXX_Unmarshal(b []byte) error {
@@ -1031,6 +1237,9 @@ func (m *CancelWorkflowResponse) GetCancelledAt() *timestamppb.Timestamp {
 type CreateWorkflowDefinitionRequest struct {
 	Definition           *WorkflowDefinition `protobuf:"bytes,1,opt,name=definition,proto3" json:"definition,omitempty"`
 	MakeDefault          bool                `protobuf:"varint,2,opt,name=make_default,json=makeDefault,proto3" json:"make_default,omitempty"`
+	Tags                 []string            `protobuf:"bytes,3,rep,name=tags,proto3" json:"tags,omitempty"`
+	CustomMetadata       map[string]string   `protobuf:"bytes,4,rep,name=custom_metadata,json=customMetadata,proto3" json:"custom_metadata,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	CreatedBy            string              `protobuf:"bytes,5,opt,name=created_by,json=createdBy,proto3" json:"created_by,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}            `json:"-"`
 	XXX_unrecognized     []byte              `json:"-"`
 	XXX_sizecache        int32               `json:"-"`
@@ -1040,7 +1249,7 @@ func (m *CreateWorkflowDefinitionRequest) Reset()         { *m = CreateWorkflowD
 func (m *CreateWorkflowDefinitionRequest) String() string { return proto.CompactTextString(m) }
 func (*CreateWorkflowDefinitionRequest) ProtoMessage()    {}
 func (*CreateWorkflowDefinitionRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_446cc1513e4cd66f, []int{14}
+	return fileDescriptor_446cc1513e4cd66f, []int{17}
 }
 
 func (m *CreateWorkflowDefinitionRequest) XXX_Unmarshal(b []byte) error {
@@ -1075,6 +1284,27 @@ func (m *CreateWorkflowDefinitionRequest) GetMakeDefault() bool {
 	return false
 }
 
+func (m *CreateWorkflowDefinitionRequest) GetTags() []string {
+	if m != nil {
+		return m.Tags
+	}
+	return nil
+}
+
+func (m *CreateWorkflowDefinitionRequest) GetCustomMetadata() map[string]string {
+	if m != nil {
+		return m.CustomMetadata
+	}
+	return nil
+}
+
+func (m *CreateWorkflowDefinitionRequest) GetCreatedBy() string {
+	if m != nil {
+		return m.CreatedBy
+	}
+	return ""
+}
+
 type CreateWorkflowDefinitionResponse struct {
 	DefinitionId         string                 `protobuf:"bytes,1,opt,name=definition_id,json=definitionId,proto3" json:"definition_id,omitempty"`
 	CreatedDefinition    *WorkflowDefinition    `protobuf:"bytes,2,opt,name=created_definition,json=createdDefinition,proto3" json:"created_definition,omitempty"`
@@ -1089,7 +1319,7 @@ func (m *CreateWorkflowDefinitionResponse) Reset()         { *m = CreateWorkflow
 func (m *CreateWorkflowDefinitionResponse) String() string { return proto.CompactTextString(m) }
 func (*CreateWorkflowDefinitionResponse) ProtoMessage()    {}
 func (*CreateWorkflowDefinitionResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_446cc1513e4cd66f, []int{15}
+	return fileDescriptor_446cc1513e4cd66f, []int{18}
 }
 
 func (m *CreateWorkflowDefinitionResponse) XXX_Unmarshal(b []byte) error {
@@ -1150,7 +1380,7 @@ func (m *GetWorkflowDefinitionRequest) Reset()         { *m = GetWorkflowDefinit
 func (m *GetWorkflowDefinitionRequest) String() string { return proto.CompactTextString(m) }
 func (*GetWorkflowDefinitionRequest) ProtoMessage()    {}
 func (*GetWorkflowDefinitionRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_446cc1513e4cd66f, []int{16}
+	return fileDescriptor_446cc1513e4cd66f, []int{19}
 }
 
 func (m *GetWorkflowDefinitionRequest) XXX_Unmarshal(b []byte) error {
@@ -1197,7 +1427,7 @@ func (m *GetWorkflowDefinitionResponse) Reset()         { *m = GetWorkflowDefini
 func (m *GetWorkflowDefinitionResponse) String() string { return proto.CompactTextString(m) }
 func (*GetWorkflowDefinitionResponse) ProtoMessage()    {}
 func (*GetWorkflowDefinitionResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_446cc1513e4cd66f, []int{17}
+	return fileDescriptor_446cc1513e4cd66f, []int{20}
 }
 
 func (m *GetWorkflowDefinitionResponse) XXX_Unmarshal(b []byte) error {
@@ -1245,7 +1475,7 @@ func (m *ListWorkflowDefinitionsRequest) Reset()         { *m = ListWorkflowDefi
 func (m *ListWorkflowDefinitionsRequest) String() string { return proto.CompactTextString(m) }
 func (*ListWorkflowDefinitionsRequest) ProtoMessage()    {}
 func (*ListWorkflowDefinitionsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_446cc1513e4cd66f, []int{18}
+	return fileDescriptor_446cc1513e4cd66f, []int{21}
 }
 
 func (m *ListWorkflowDefinitionsRequest) XXX_Unmarshal(b []byte) error {
@@ -1299,7 +1529,7 @@ func (m *ListWorkflowDefinitionsResponse) Reset()         { *m = ListWorkflowDef
 func (m *ListWorkflowDefinitionsResponse) String() string { return proto.CompactTextString(m) }
 func (*ListWorkflowDefinitionsResponse) ProtoMessage()    {}
 func (*ListWorkflowDefinitionsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_446cc1513e4cd66f, []int{19}
+	return fileDescriptor_446cc1513e4cd66f, []int{22}
 }
 
 func (m *ListWorkflowDefinitionsResponse) XXX_Unmarshal(b []byte) error {
@@ -1338,6 +1568,9 @@ type UpdateWorkflowDefinitionRequest struct {
 	DefinitionId         string              `protobuf:"bytes,1,opt,name=definition_id,json=definitionId,proto3" json:"definition_id,omitempty"`
 	Definition           *WorkflowDefinition `protobuf:"bytes,2,opt,name=definition,proto3" json:"definition,omitempty"`
 	CreateNewVersion     bool                `protobuf:"varint,3,opt,name=create_new_version,json=createNewVersion,proto3" json:"create_new_version,omitempty"`
+	MakeDefault          bool                `protobuf:"varint,4,opt,name=make_default,json=makeDefault,proto3" json:"make_default,omitempty"`
+	Tags                 []string            `protobuf:"bytes,5,rep,name=tags,proto3" json:"tags,omitempty"`
+	CustomMetadata       map[string]string   `protobuf:"bytes,6,rep,name=custom_metadata,json=customMetadata,proto3" json:"custom_metadata,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
 	XXX_NoUnkeyedLiteral struct{}            `json:"-"`
 	XXX_unrecognized     []byte              `json:"-"`
 	XXX_sizecache        int32               `json:"-"`
@@ -1347,7 +1580,7 @@ func (m *UpdateWorkflowDefinitionRequest) Reset()         { *m = UpdateWorkflowD
 func (m *UpdateWorkflowDefinitionRequest) String() string { return proto.CompactTextString(m) }
 func (*UpdateWorkflowDefinitionRequest) ProtoMessage()    {}
 func (*UpdateWorkflowDefinitionRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_446cc1513e4cd66f, []int{20}
+	return fileDescriptor_446cc1513e4cd66f, []int{23}
 }
 
 func (m *UpdateWorkflowDefinitionRequest) XXX_Unmarshal(b []byte) error {
@@ -1389,6 +1622,27 @@ func (m *UpdateWorkflowDefinitionRequest) GetCreateNewVersion() bool {
 	return false
 }
 
+func (m *UpdateWorkflowDefinitionRequest) GetMakeDefault() bool {
+	if m != nil {
+		return m.MakeDefault
+	}
+	return false
+}
+
+func (m *UpdateWorkflowDefinitionRequest) GetTags() []string {
+	if m != nil {
+		return m.Tags
+	}
+	return nil
+}
+
+func (m *UpdateWorkflowDefinitionRequest) GetCustomMetadata() map[string]string {
+	if m != nil {
+		return m.CustomMetadata
+	}
+	return nil
+}
+
 type UpdateWorkflowDefinitionResponse struct {
 	DefinitionId         string                 `protobuf:"bytes,1,opt,name=definition_id,json=definitionId,proto3" json:"definition_id,omitempty"`
 	UpdatedDefinition    *WorkflowDefinition    `protobuf:"bytes,2,opt,name=updated_definition,json=updatedDefinition,proto3" json:"updated_definition,omitempty"`
@@ -1403,7 +1657,7 @@ func (m *UpdateWorkflowDefinitionResponse) Reset()         { *m = UpdateWorkflow
 func (m *UpdateWorkflowDefinitionResponse) String() string { return proto.CompactTextString(m) }
 func (*UpdateWorkflowDefinitionResponse) ProtoMessage()    {}
 func (*UpdateWorkflowDefinitionResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_446cc1513e4cd66f, []int{21}
+	return fileDescriptor_446cc1513e4cd66f, []int{24}
 }
 
 func (m *UpdateWorkflowDefinitionResponse) XXX_Unmarshal(b []byte) error {
@@ -1468,7 +1722,7 @@ func (m *WorkflowDefinition) Reset()         { *m = WorkflowDefinition{} }
 func (m *WorkflowDefinition) String() string { return proto.CompactTextString(m) }
 func (*WorkflowDefinition) ProtoMessage()    {}
 func (*WorkflowDefinition) Descriptor() ([]byte, []int) {
-	return fileDescriptor_446cc1513e4cd66f, []int{22}
+	return fileDescriptor_446cc1513e4cd66f, []int{25}
 }
 
 func (m *WorkflowDefinition) XXX_Unmarshal(b []byte) error {
@@ -1541,6 +1795,7 @@ type WorkflowStep struct {
 	StepConfig           *StepConfiguration    `protobuf:"bytes,7,opt,name=step_config,json=stepConfig,proto3" json:"step_config,omitempty"`
 	DependsOn            []string              `protobuf:"bytes,8,rep,name=depends_on,json=dependsOn,proto3" json:"depends_on,omitempty"`
 	Conditional          *ConditionalExecution `protobuf:"bytes,9,opt,name=conditional,proto3" json:"conditional,omitempty"`
+	Compensation         *CompensationAction   `protobuf:"bytes,10,opt,name=compensation,proto3" json:"compensation,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
 	XXX_unrecognized     []byte                `json:"-"`
 	XXX_sizecache        int32                 `json:"-"`
@@ -1550,7 +1805,7 @@ func (m *WorkflowStep) Reset()         { *m = WorkflowStep{} }
 func (m *WorkflowStep) String() string { return proto.CompactTextString(m) }
 func (*WorkflowStep) ProtoMessage()    {}
 func (*WorkflowStep) Descriptor() ([]byte, []int) {
-	return fileDescriptor_446cc1513e4cd66f, []int{23}
+	return fileDescriptor_446cc1513e4cd66f, []int{26}
 }
 
 func (m *WorkflowStep) XXX_Unmarshal(b []byte) error {
@@ -1634,6 +1889,13 @@ func (m *WorkflowStep) GetConditional() *ConditionalExecution {
 	return nil
 }
 
+func (m *WorkflowStep) GetCompensation() *CompensationAction {
+	if m != nil {
+		return m.Compensation
+	}
+	return nil
+}
+
 type StepConfiguration struct {
 	TimeoutSeconds       int32                  `protobuf:"varint,1,opt,name=timeout_seconds,json=timeoutSeconds,proto3" json:"timeout_seconds,omitempty"`
 	RetryAttempts        int32                  `protobuf:"varint,2,opt,name=retry_attempts,json=retryAttempts,proto3" json:"retry_attempts,omitempty"`
@@ -1651,7 +1913,7 @@ func (m *StepConfiguration) Reset()         { *m = StepConfiguration{} }
 func (m *StepConfiguration) String() string { return proto.CompactTextString(m) }
 func (*StepConfiguration) ProtoMessage()    {}
 func (*StepConfiguration) Descriptor() ([]byte, []int) {
-	return fileDescriptor_446cc1513e4cd66f, []int{24}
+	return fileDescriptor_446cc1513e4cd66f, []int{27}
 }
 
 func (m *StepConfiguration) XXX_Unmarshal(b []byte) error {
@@ -1735,7 +1997,7 @@ func (m *ConditionalExecution) Reset()         { *m = ConditionalExecution{} }
 func (m *ConditionalExecution) String() string { return proto.CompactTextString(m) }
 func (*ConditionalExecution) ProtoMessage()    {}
 func (*ConditionalExecution) Descriptor() ([]byte, []int) {
-	return fileDescriptor_446cc1513e4cd66f, []int{25}
+	return fileDescriptor_446cc1513e4cd66f, []int{28}
 }
 
 func (m *ConditionalExecution) XXX_Unmarshal(b []byte) error {
@@ -1784,6 +2046,69 @@ func (m *ConditionalExecution) GetConditionType() string {
 	return ""
 }
 
+type CompensationAction struct {
+	ServiceName          string                `protobuf:"bytes,1,opt,name=service_name,json=serviceName,proto3" json:"service_name,omitempty"`
+	MethodName           string                `protobuf:"bytes,2,opt,name=method_name,json=methodName,proto3" json:"method_name,omitempty"`
+	InputMapping         map[string]*anypb.Any `protobuf:"bytes,3,rep,name=input_mapping,json=inputMapping,proto3" json:"input_mapping,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	TimeoutSeconds       int32                 `protobuf:"varint,4,opt,name=timeout_seconds,json=timeoutSeconds,proto3" json:"timeout_seconds,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
+	XXX_unrecognized     []byte                `json:"-"`
+	XXX_sizecache        int32                 `json:"-"`
+}
+
+func (m *CompensationAction) Reset()         { *m = CompensationAction{} }
+func (m *CompensationAction) String() string { return proto.CompactTextString(m) }
+func (*CompensationAction) ProtoMessage()    {}
+func (*CompensationAction) Descriptor() ([]byte, []int) {
+	return fileDescriptor_446cc1513e4cd66f, []int{29}
+}
+
+func (m *CompensationAction) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CompensationAction.Unmarshal(m, b)
+}
+func (m *CompensationAction) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CompensationAction.Marshal(b, m, deterministic)
+}
+func (m *CompensationAction) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CompensationAction.Merge(m, src)
+}
+func (m *CompensationAction) XXX_Size() int {
+	return xxx_messageInfo_CompensationAction.Size(m)
+}
+func (m *CompensationAction) XXX_DiscardUnknown() {
+	xxx_messageInfo_CompensationAction.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CompensationAction proto.InternalMessageInfo
+
+func (m *CompensationAction) GetServiceName() string {
+	if m != nil {
+		return m.ServiceName
+	}
+	return ""
+}
+
+func (m *CompensationAction) GetMethodName() string {
+	if m != nil {
+		return m.MethodName
+	}
+	return ""
+}
+
+func (m *CompensationAction) GetInputMapping() map[string]*anypb.Any {
+	if m != nil {
+		return m.InputMapping
+	}
+	return nil
+}
+
+func (m *CompensationAction) GetTimeoutSeconds() int32 {
+	if m != nil {
+		return m.TimeoutSeconds
+	}
+	return 0
+}
+
 type FallbackConfiguration struct {
 	FallbackType         string     `protobuf:"bytes,1,opt,name=fallback_type,json=fallbackType,proto3" json:"fallback_type,omitempty"`
 	FallbackValue        *anypb.Any `protobuf:"bytes,2,opt,name=fallback_value,json=fallbackValue,proto3" json:"fallback_value,omitempty"`
@@ -1797,7 +2122,7 @@ func (m *FallbackConfiguration) Reset()         { *m = FallbackConfiguration{} }
 func (m *FallbackConfiguration) String() string { return proto.CompactTextString(m) }
 func (*FallbackConfiguration) ProtoMessage()    {}
 func (*FallbackConfiguration) Descriptor() ([]byte, []int) {
-	return fileDescriptor_446cc1513e4cd66f, []int{26}
+	return fileDescriptor_446cc1513e4cd66f, []int{30}
 }
 
 func (m *FallbackConfiguration) XXX_Unmarshal(b []byte) error {
@@ -1854,7 +2179,7 @@ func (m *WorkflowConfiguration) Reset()         { *m = WorkflowConfiguration{} }
 func (m *WorkflowConfiguration) String() string { return proto.CompactTextString(m) }
 func (*WorkflowConfiguration) ProtoMessage()    {}
 func (*WorkflowConfiguration) Descriptor() ([]byte, []int) {
-	return fileDescriptor_446cc1513e4cd66f, []int{27}
+	return fileDescriptor_446cc1513e4cd66f, []int{31}
 }
 
 func (m *WorkflowConfiguration) XXX_Unmarshal(b []byte) error {
@@ -1924,7 +2249,7 @@ func (m *ErrorHandlingStrategy) Reset()         { *m = ErrorHandlingStrategy{} }
 func (m *ErrorHandlingStrategy) String() string { return proto.CompactTextString(m) }
 func (*ErrorHandlingStrategy) ProtoMessage()    {}
 func (*ErrorHandlingStrategy) Descriptor() ([]byte, []int) {
-	return fileDescriptor_446cc1513e4cd66f, []int{28}
+	return fileDescriptor_446cc1513e4cd66f, []int{32}
 }
 
 func (m *ErrorHandlingStrategy) XXX_Unmarshal(b []byte) error {
@@ -1977,6 +2302,7 @@ type WorkflowExecutionTrace struct {
 	ExecutionId          string                    `protobuf:"bytes,1,opt,name=execution_id,json=executionId,proto3" json:"execution_id,omitempty"`
 	StepTraces           []*StepExecutionTrace     `protobuf:"bytes,2,rep,name=step_traces,json=stepTraces,proto3" json:"step_traces,omitempty"`
 	Summary              *WorkflowExecutionSummary `protobuf:"bytes,3,opt,name=summary,proto3" json:"summary,omitempty"`
+	CompensationTraces   []*StepExecutionTrace     `protobuf:"bytes,4,rep,name=compensation_traces,json=compensationTraces,proto3" json:"compensation_traces,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}                  `json:"-"`
 	XXX_unrecognized     []byte                    `json:"-"`
 	XXX_sizecache        int32                     `json:"-"`
@@ -1986,7 +2312,7 @@ func (m *WorkflowExecutionTrace) Reset()         { *m = WorkflowExecutionTrace{}
 func (m *WorkflowExecutionTrace) String() string { return proto.CompactTextString(m) }
 func (*WorkflowExecutionTrace) ProtoMessage()    {}
 func (*WorkflowExecutionTrace) Descriptor() ([]byte, []int) {
-	return fileDescriptor_446cc1513e4cd66f, []int{29}
+	return fileDescriptor_446cc1513e4cd66f, []int{33}
 }
 
 func (m *WorkflowExecutionTrace) XXX_Unmarshal(b []byte) error {
@@ -2028,6 +2354,13 @@ func (m *WorkflowExecutionTrace) GetSummary() *WorkflowExecutionSummary {
 	return nil
 }
 
+func (m *WorkflowExecutionTrace) GetCompensationTraces() []*StepExecutionTrace {
+	if m != nil {
+		return m.CompensationTraces
+	}
+	return nil
+}
+
 type StepExecutionTrace struct {
 	StepId               string                 `protobuf:"bytes,1,opt,name=step_id,json=stepId,proto3" json:"step_id,omitempty"`
 	StepName             string                 `protobuf:"bytes,2,opt,name=step_name,json=stepName,proto3" json:"step_name,omitempty"`
@@ -2040,6 +2373,8 @@ type StepExecutionTrace struct {
 	ErrorMessage         string                 `protobuf:"bytes,9,opt,name=error_message,json=errorMessage,proto3" json:"error_message,omitempty"`
 	RetryCount           int32                  `protobuf:"varint,10,opt,name=retry_count,json=retryCount,proto3" json:"retry_count,omitempty"`
 	Events               []*StepExecutionEvent  `protobuf:"bytes,11,rep,name=events,proto3" json:"events,omitempty"`
+	RetryInfo            *v1.RetryInfo          `protobuf:"bytes,12,opt,name=retry_info,json=retryInfo,proto3" json:"retry_info,omitempty"`
+	Fallback             *v1.FallbackResponse   `protobuf:"bytes,13,opt,name=fallback,proto3" json:"fallback,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}               `json:"-"`
 	XXX_unrecognized     []byte                 `json:"-"`
 	XXX_sizecache        int32                  `json:"-"`
@@ -2049,7 +2384,7 @@ func (m *StepExecutionTrace) Reset()         { *m = StepExecutionTrace{} }
 func (m *StepExecutionTrace) String() string { return proto.CompactTextString(m) }
 func (*StepExecutionTrace) ProtoMessage()    {}
 func (*StepExecutionTrace) Descriptor() ([]byte, []int) {
-	return fileDescriptor_446cc1513e4cd66f, []int{30}
+	return fileDescriptor_446cc1513e4cd66f, []int{34}
 }
 
 func (m *StepExecutionTrace) XXX_Unmarshal(b []byte) error {
@@ -2147,6 +2482,20 @@ func (m *StepExecutionTrace) GetEvents() []*StepExecutionEvent {
 	return nil
 }
 
+func (m *StepExecutionTrace) GetRetryInfo() *v1.RetryInfo {
+	if m != nil {
+		return m.RetryInfo
+	}
+	return nil
+}
+
+func (m *StepExecutionTrace) GetFallback() *v1.FallbackResponse {
+	if m != nil {
+		return m.Fallback
+	}
+	return nil
+}
+
 type StepExecutionEvent struct {
 	EventType            string                 `protobuf:"bytes,1,opt,name=event_type,json=eventType,proto3" json:"event_type,omitempty"`
 	EventMessage         string                 `protobuf:"bytes,2,opt,name=event_message,json=eventMessage,proto3" json:"event_message,omitempty"`
@@ -2161,7 +2510,7 @@ func (m *StepExecutionEvent) Reset()         { *m = StepExecutionEvent{} }
 func (m *StepExecutionEvent) String() string { return proto.CompactTextString(m) }
 func (*StepExecutionEvent) ProtoMessage()    {}
 func (*StepExecutionEvent) Descriptor() ([]byte, []int) {
-	return fileDescriptor_446cc1513e4cd66f, []int{31}
+	return fileDescriptor_446cc1513e4cd66f, []int{35}
 }
 
 func (m *StepExecutionEvent) XXX_Unmarshal(b []byte) error {
@@ -2218,6 +2567,8 @@ type WorkflowExecutionSummary struct {
 	SkippedSteps         int32               `protobuf:"varint,5,opt,name=skipped_steps,json=skippedSteps,proto3" json:"skipped_steps,omitempty"`
 	TotalExecutionTimeMs int64               `protobuf:"varint,6,opt,name=total_execution_time_ms,json=totalExecutionTimeMs,proto3" json:"total_execution_time_ms,omitempty"`
 	FailureReason        string              `protobuf:"bytes,7,opt,name=failure_reason,json=failureReason,proto3" json:"failure_reason,omitempty"`
+	CompensatedSteps     int32               `protobuf:"varint,8,opt,name=compensated_steps,json=compensatedSteps,proto3" json:"compensated_steps,omitempty"`
+	FailedCompensations  int32               `protobuf:"varint,9,opt,name=failed_compensations,json=failedCompensations,proto3" json:"failed_compensations,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}            `json:"-"`
 	XXX_unrecognized     []byte              `json:"-"`
 	XXX_sizecache        int32               `json:"-"`
@@ -2227,7 +2578,7 @@ func (m *WorkflowExecutionSummary) Reset()         { *m = WorkflowExecutionSumma
 func (m *WorkflowExecutionSummary) String() string { return proto.CompactTextString(m) }
 func (*WorkflowExecutionSummary) ProtoMessage()    {}
 func (*WorkflowExecutionSummary) Descriptor() ([]byte, []int) {
-	return fileDescriptor_446cc1513e4cd66f, []int{32}
+	return fileDescriptor_446cc1513e4cd66f, []int{36}
 }
 
 func (m *WorkflowExecutionSummary) XXX_Unmarshal(b []byte) error {
@@ -2297,6 +2648,20 @@ func (m *WorkflowExecutionSummary) GetFailureReason() string {
 	return ""
 }
 
+func (m *WorkflowExecutionSummary) GetCompensatedSteps() int32 {
+	if m != nil {
+		return m.CompensatedSteps
+	}
+	return 0
+}
+
+func (m *WorkflowExecutionSummary) GetFailedCompensations() int32 {
+	if m != nil {
+		return m.FailedCompensations
+	}
+	return 0
+}
+
 type WorkflowExecutionMetadata struct {
 	WorkflowDefinitionId string                 `protobuf:"bytes,1,opt,name=workflow_definition_id,json=workflowDefinitionId,proto3" json:"workflow_definition_id,omitempty"`
 	WorkflowVersion      string                 `protobuf:"bytes,2,opt,name=workflow_version,json=workflowVersion,proto3" json:"workflow_version,omitempty"`
@@ -2314,7 +2679,7 @@ func (m *WorkflowExecutionMetadata) Reset()         { *m = WorkflowExecutionMeta
 func (m *WorkflowExecutionMetadata) String() string { return proto.CompactTextString(m) }
 func (*WorkflowExecutionMetadata) ProtoMessage()    {}
 func (*WorkflowExecutionMetadata) Descriptor() ([]byte, []int) {
-	return fileDescriptor_446cc1513e4cd66f, []int{33}
+	return fileDescriptor_446cc1513e4cd66f, []int{37}
 }
 
 func (m *WorkflowExecutionMetadata) XXX_Unmarshal(b []byte) error {
@@ -2399,7 +2764,7 @@ func (m *WorkflowLog) Reset()         { *m = WorkflowLog{} }
 func (m *WorkflowLog) String() string { return proto.CompactTextString(m) }
 func (*WorkflowLog) ProtoMessage()    {}
 func (*WorkflowLog) Descriptor() ([]byte, []int) {
-	return fileDescriptor_446cc1513e4cd66f, []int{34}
+	return fileDescriptor_446cc1513e4cd66f, []int{38}
 }
 
 func (m *WorkflowLog) XXX_Unmarshal(b []byte) error {
@@ -2472,7 +2837,7 @@ func (m *WorkflowDefinitionInfo) Reset()         { *m = WorkflowDefinitionInfo{}
 func (m *WorkflowDefinitionInfo) String() string { return proto.CompactTextString(m) }
 func (*WorkflowDefinitionInfo) ProtoMessage()    {}
 func (*WorkflowDefinitionInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_446cc1513e4cd66f, []int{35}
+	return fileDescriptor_446cc1513e4cd66f, []int{39}
 }
 
 func (m *WorkflowDefinitionInfo) XXX_Unmarshal(b []byte) error {
@@ -2560,7 +2925,7 @@ func (m *WorkflowDefinitionMetadata) Reset()         { *m = WorkflowDefinitionMe
 func (m *WorkflowDefinitionMetadata) String() string { return proto.CompactTextString(m) }
 func (*WorkflowDefinitionMetadata) ProtoMessage()    {}
 func (*WorkflowDefinitionMetadata) Descriptor() ([]byte, []int) {
-	return fileDescriptor_446cc1513e4cd66f, []int{36}
+	return fileDescriptor_446cc1513e4cd66f, []int{40}
 }
 
 func (m *WorkflowDefinitionMetadata) XXX_Unmarshal(b []byte) error {
@@ -2649,7 +3014,7 @@ func (m *GetServicesHealthRequest) Reset()         { *m = GetServicesHealthReque
 func (m *GetServicesHealthRequest) String() string { return proto.CompactTextString(m) }
 func (*GetServicesHealthRequest) ProtoMessage()    {}
 func (*GetServicesHealthRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_446cc1513e4cd66f, []int{37}
+	return fileDescriptor_446cc1513e4cd66f, []int{41}
 }
 
 func (m *GetServicesHealthRequest) XXX_Unmarshal(b []byte) error {
@@ -2697,7 +3062,7 @@ func (m *GetServicesHealthResponse) Reset()         { *m = GetServicesHealthResp
 func (m *GetServicesHealthResponse) String() string { return proto.CompactTextString(m) }
 func (*GetServicesHealthResponse) ProtoMessage()    {}
 func (*GetServicesHealthResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_446cc1513e4cd66f, []int{38}
+	return fileDescriptor_446cc1513e4cd66f, []int{42}
 }
 
 func (m *GetServicesHealthResponse) XXX_Unmarshal(b []byte) error {
@@ -2754,7 +3119,7 @@ func (m *ServiceHealthStatus) Reset()         { *m = ServiceHealthStatus{} }
 func (m *ServiceHealthStatus) String() string { return proto.CompactTextString(m) }
 func (*ServiceHealthStatus) ProtoMessage()    {}
 func (*ServiceHealthStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_446cc1513e4cd66f, []int{39}
+	return fileDescriptor_446cc1513e4cd66f, []int{43}
 }
 
 func (m *ServiceHealthStatus) XXX_Unmarshal(b []byte) error {
@@ -2824,7 +3189,7 @@ func (m *ServiceHealthDetails) Reset()         { *m = ServiceHealthDetails{} }
 func (m *ServiceHealthDetails) String() string { return proto.CompactTextString(m) }
 func (*ServiceHealthDetails) ProtoMessage()    {}
 func (*ServiceHealthDetails) Descriptor() ([]byte, []int) {
-	return fileDescriptor_446cc1513e4cd66f, []int{40}
+	return fileDescriptor_446cc1513e4cd66f, []int{44}
 }
 
 func (m *ServiceHealthDetails) XXX_Unmarshal(b []byte) error {
@@ -2888,7 +3253,7 @@ func (m *OverallHealthStatus) Reset()         { *m = OverallHealthStatus{} }
 func (m *OverallHealthStatus) String() string { return proto.CompactTextString(m) }
 func (*OverallHealthStatus) ProtoMessage()    {}
 func (*OverallHealthStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_446cc1513e4cd66f, []int{41}
+	return fileDescriptor_446cc1513e4cd66f, []int{45}
 }
 
 func (m *OverallHealthStatus) XXX_Unmarshal(b []byte) error {
@@ -2955,6 +3320,9 @@ func init() {
 	proto.RegisterType((*QueryProcessingMetadata)(nil), "api.orchestrator.v1.QueryProcessingMetadata")
 	proto.RegisterMapType((map[string]string)(nil), "api.orchestrator.v1.QueryProcessingMetadata.DebugInfoEntry")
 	proto.RegisterMapType((map[string]int64)(nil), "api.orchestrator.v1.QueryProcessingMetadata.ServiceProcessingTimesEntry")
+	proto.RegisterType((*ProcessQueryEvent)(nil), "api.orchestrator.v1.ProcessQueryEvent")
+	proto.RegisterType((*QueryRetrieval)(nil), "api.orchestrator.v1.QueryRetrieval")
+	proto.RegisterType((*QueryAnswerDelta)(nil), "api.orchestrator.v1.QueryAnswerDelta")
 	proto.RegisterType((*ExecuteWorkflowRequest)(nil), "api.orchestrator.v1.ExecuteWorkflowRequest")
 	proto.RegisterMapType((map[string]*anypb.Any)(nil), "api.orchestrator.v1.ExecuteWorkflowRequest.InputParametersEntry")
 	proto.RegisterType((*ExecutionOptions)(nil), "api.orchestrator.v1.ExecutionOptions")
@@ -2966,15 +3334,18 @@ func init() {
 	proto.RegisterType((*ExecuteWorkflowAsyncResponse)(nil), "api.orchestrator.v1.ExecuteWorkflowAsyncResponse")
 	proto.RegisterType((*GetWorkflowStatusRequest)(nil), "api.orchestrator.v1.GetWorkflowStatusRequest")
 	proto.RegisterType((*GetWorkflowStatusResponse)(nil), "api.orchestrator.v1.GetWorkflowStatusResponse")
+	proto.RegisterMapType((map[string]*anypb.Any)(nil), "api.orchestrator.v1.GetWorkflowStatusResponse.OutputResultsEntry")
 	proto.RegisterType((*CancelWorkflowRequest)(nil), "api.orchestrator.v1.CancelWorkflowRequest")
 	proto.RegisterType((*CancelWorkflowResponse)(nil), "api.orchestrator.v1.CancelWorkflowResponse")
 	proto.RegisterType((*CreateWorkflowDefinitionRequest)(nil), "api.orchestrator.v1.CreateWorkflowDefinitionRequest")
+	proto.RegisterMapType((map[string]string)(nil), "api.orchestrator.v1.CreateWorkflowDefinitionRequest.CustomMetadataEntry")
 	proto.RegisterType((*CreateWorkflowDefinitionResponse)(nil), "api.orchestrator.v1.CreateWorkflowDefinitionResponse")
 	proto.RegisterType((*GetWorkflowDefinitionRequest)(nil), "api.orchestrator.v1.GetWorkflowDefinitionRequest")
 	proto.RegisterType((*GetWorkflowDefinitionResponse)(nil), "api.orchestrator.v1.GetWorkflowDefinitionResponse")
 	proto.RegisterType((*ListWorkflowDefinitionsRequest)(nil), "api.orchestrator.v1.ListWorkflowDefinitionsRequest")
 	proto.RegisterType((*ListWorkflowDefinitionsResponse)(nil), "api.orchestrator.v1.ListWorkflowDefinitionsResponse")
 	proto.RegisterType((*UpdateWorkflowDefinitionRequest)(nil), "api.orchestrator.v1.UpdateWorkflowDefinitionRequest")
+	proto.RegisterMapType((map[string]string)(nil), "api.orchestrator.v1.UpdateWorkflowDefinitionRequest.CustomMetadataEntry")
 	proto.RegisterType((*UpdateWorkflowDefinitionResponse)(nil), "api.orchestrator.v1.UpdateWorkflowDefinitionResponse")
 	proto.RegisterType((*WorkflowDefinition)(nil), "api.orchestrator.v1.WorkflowDefinition")
 	proto.RegisterMapType((map[string]*anypb.Any)(nil), "api.orchestrator.v1.WorkflowDefinition.DefaultParametersEntry")
@@ -2984,6 +3355,8 @@ func init() {
 	proto.RegisterType((*StepConfiguration)(nil), "api.orchestrator.v1.StepConfiguration")
 	proto.RegisterMapType((map[string]string)(nil), "api.orchestrator.v1.StepConfiguration.CustomConfigEntry")
 	proto.RegisterType((*ConditionalExecution)(nil), "api.orchestrator.v1.ConditionalExecution")
+	proto.RegisterType((*CompensationAction)(nil), "api.orchestrator.v1.CompensationAction")
+	proto.RegisterMapType((map[string]*anypb.Any)(nil), "api.orchestrator.v1.CompensationAction.InputMappingEntry")
 	proto.RegisterType((*FallbackConfiguration)(nil), "api.orchestrator.v1.FallbackConfiguration")
 	proto.RegisterType((*WorkflowConfiguration)(nil), "api.orchestrator.v1.WorkflowConfiguration")
 	proto.RegisterMapType((map[string]string)(nil), "api.orchestrator.v1.WorkflowConfiguration.GlobalSettingsEntry")
@@ -3016,263 +3389,284 @@ func init() {
 }
 
 var fileDescriptor_446cc1513e4cd66f = []byte{
-	// 4123 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x3b, 0x4d, 0x8c, 0x1c, 0xc7,
-	0x5a, 0xea, 0x99, 0x9d, 0xdd, 0x9d, 0x6f, 0x7e, 0x76, 0xb7, 0xf6, 0xc7, 0xe3, 0xb6, 0xfd, 0xbc,
-	0x9e, 0xc4, 0xc9, 0x3a, 0xb6, 0x67, 0x58, 0xc7, 0x21, 0x89, 0x03, 0x71, 0x76, 0xd7, 0x8e, 0xbd,
-	0x10, 0xc7, 0x4e, 0xaf, 0x9d, 0x17, 0x05, 0x3d, 0xb5, 0xda, 0x3d, 0x35, 0xb3, 0xad, 0xed, 0xe9,
-	0x9e, 0x74, 0x75, 0x8f, 0xbd, 0x7a, 0xbc, 0xc3, 0x7b, 0x47, 0xc4, 0x83, 0x03, 0xd2, 0x13, 0x27,
-	0x84, 0xc4, 0x01, 0x81, 0x78, 0x87, 0x27, 0x84, 0xe0, 0x02, 0x02, 0x81, 0xe0, 0x80, 0x40, 0x48,
-	0x41, 0x42, 0x5c, 0x11, 0x27, 0x24, 0x6e, 0x20, 0x44, 0x2e, 0xa0, 0xaa, 0xfa, 0xaa, 0xa7, 0x7b,
-	0xa6, 0x67, 0xb7, 0x27, 0x76, 0x72, 0x9b, 0xfe, 0xea, 0xab, 0xaf, 0xbe, 0xfa, 0xfe, 0xea, 0xab,
-	0xaf, 0xbe, 0x81, 0xb3, 0xc3, 0xed, 0xb6, 0x1f, 0xd8, 0x87, 0x94, 0x85, 0x81, 0x15, 0xfa, 0x81,
-	0xc9, 0x86, 0x76, 0x6b, 0x10, 0xf8, 0xa1, 0x4f, 0x56, 0xad, 0x81, 0xd3, 0x4a, 0x8e, 0xb5, 0x86,
-	0xdb, 0xfa, 0xf9, 0x9e, 0xef, 0xf7, 0x5c, 0xda, 0xb6, 0x06, 0x4e, 0xdb, 0xf2, 0x3c, 0x3f, 0xb4,
-	0x42, 0xc7, 0xf7, 0x98, 0x9c, 0xa2, 0x9f, 0xc3, 0x51, 0xf1, 0xf5, 0x34, 0xea, 0xb6, 0x69, 0x7f,
-	0x10, 0x1e, 0xe3, 0xe0, 0xc5, 0xf1, 0xc1, 0xd0, 0xe9, 0x53, 0x16, 0x5a, 0xfd, 0x01, 0x22, 0x9c,
-	0x1d, 0x47, 0xb0, 0x3c, 0x35, 0x57, 0xe7, 0xeb, 0xd9, 0x7e, 0xbf, 0xef, 0x7b, 0xed, 0xe1, 0x36,
-	0xfe, 0xca, 0x1e, 0xa3, 0x41, 0xe0, 0x07, 0x8a, 0xa1, 0x33, 0x43, 0xcb, 0x75, 0x3a, 0x56, 0x48,
-	0xdb, 0xea, 0x87, 0x1c, 0x68, 0xfe, 0x65, 0x01, 0x56, 0x1f, 0x05, 0xbe, 0x4d, 0x19, 0xfb, 0x24,
-	0xa2, 0xc1, 0xb1, 0x41, 0xbf, 0x88, 0x28, 0x0b, 0xc9, 0x05, 0x28, 0x7d, 0xc1, 0xbf, 0x1b, 0xda,
-	0xa6, 0xb6, 0x55, 0xde, 0x5d, 0xf8, 0x6a, 0x77, 0x2e, 0x28, 0x2c, 0x6b, 0x86, 0x84, 0x92, 0x0b,
-	0x00, 0x8c, 0x32, 0xe6, 0xf8, 0x9e, 0xe9, 0x74, 0x1a, 0x05, 0x8e, 0x63, 0x94, 0x11, 0xb2, 0xdf,
-	0x21, 0x67, 0x60, 0x21, 0x62, 0x34, 0xe0, 0x63, 0x45, 0x31, 0x36, 0xcf, 0x3f, 0xf7, 0x3b, 0xe4,
-	0x2e, 0x2c, 0xf8, 0x03, 0x21, 0xa9, 0xc6, 0xdc, 0xa6, 0xb6, 0x55, 0xb9, 0x71, 0xb5, 0x95, 0x21,
-	0xdd, 0x96, 0x60, 0x05, 0xd9, 0x72, 0xbc, 0xde, 0x43, 0x39, 0xc5, 0x50, 0x73, 0xc9, 0x43, 0x58,
-	0xb0, 0x7d, 0x2f, 0xa4, 0xcf, 0xc3, 0x46, 0x69, 0xb3, 0xb8, 0x55, 0xb9, 0xf1, 0x56, 0x26, 0x99,
-	0x8c, 0x8d, 0xb5, 0xf6, 0xe4, 0xbc, 0xbb, 0x5e, 0x18, 0x1c, 0x1b, 0x8a, 0x8a, 0x7e, 0x0b, 0xaa,
-	0xc9, 0x01, 0xb2, 0x0c, 0xc5, 0x23, 0x8a, 0x9b, 0x37, 0xf8, 0x4f, 0xb2, 0x06, 0xa5, 0xa1, 0xe5,
-	0x46, 0x14, 0x37, 0x2b, 0x3f, 0x6e, 0x15, 0xde, 0xd1, 0x9a, 0x5f, 0x16, 0x61, 0x23, 0x9b, 0x61,
-	0x72, 0x11, 0x2a, 0xcf, 0xfc, 0xe0, 0xa8, 0xeb, 0xfa, 0xcf, 0xb8, 0x2c, 0x24, 0x39, 0x50, 0xa0,
-	0xfd, 0x0e, 0x79, 0x04, 0x4b, 0x76, 0xc4, 0x42, 0xbf, 0x6f, 0x2a, 0xa0, 0xa0, 0x5f, 0xb9, 0xf1,
-	0x7a, 0xe6, 0x86, 0xbe, 0x8b, 0x48, 0x77, 0x68, 0xd7, 0xf1, 0x1c, 0xbe, 0x86, 0x51, 0x97, 0xf3,
-	0xd5, 0x08, 0xb9, 0x0c, 0x75, 0xea, 0x59, 0x4f, 0x5d, 0x6a, 0xda, 0x96, 0x7d, 0xe8, 0x78, 0x3d,
-	0xa1, 0x81, 0x45, 0xa3, 0x26, 0xa1, 0x7b, 0x12, 0x48, 0xde, 0x84, 0x25, 0x6e, 0x76, 0x7e, 0x14,
-	0x9a, 0x8c, 0xda, 0xbe, 0xd7, 0x91, 0x0a, 0x29, 0xed, 0xc2, 0x57, 0xbb, 0x0b, 0x7a, 0xa9, 0xf1,
-	0x47, 0x85, 0x2d, 0xcd, 0xa8, 0x23, 0xca, 0x81, 0xc4, 0x20, 0xaf, 0xc3, 0x12, 0xd2, 0xee, 0x5a,
-	0xae, 0xfb, 0xd4, 0xb2, 0x8f, 0x1a, 0x25, 0x41, 0x1c, 0x97, 0xfc, 0x10, 0xa1, 0xe4, 0x10, 0x96,
-	0x18, 0x0d, 0x86, 0x8e, 0x4d, 0x4d, 0xa5, 0xee, 0x79, 0xa1, 0xa7, 0xdb, 0x33, 0xa8, 0xbb, 0x75,
-	0x20, 0x49, 0xe0, 0xa7, 0xd4, 0x58, 0x9d, 0xa5, 0x80, 0xfa, 0x77, 0x61, 0x35, 0x03, 0x2d, 0x43,
-	0x7f, 0x6f, 0x24, 0xf5, 0x57, 0xb9, 0xb1, 0xd6, 0x92, 0x4e, 0xd6, 0x52, 0x4e, 0xd6, 0xda, 0xf1,
-	0x8e, 0x93, 0x5a, 0xfd, 0xbb, 0x02, 0xac, 0xa5, 0xed, 0x87, 0x0d, 0x7c, 0x8f, 0x51, 0x72, 0x16,
-	0x16, 0x85, 0x0f, 0x8c, 0x14, 0xba, 0x20, 0xbe, 0xf7, 0x3b, 0xe4, 0x12, 0x54, 0xbb, 0x8e, 0x67,
-	0xb9, 0xa6, 0xe5, 0xb1, 0x67, 0x34, 0x40, 0x53, 0xa9, 0x08, 0xd8, 0x8e, 0x00, 0x91, 0x4f, 0x60,
-	0x05, 0x6d, 0xce, 0xec, 0xf8, 0x76, 0xd4, 0xa7, 0x5e, 0xc8, 0x1a, 0x45, 0x21, 0x9b, 0x57, 0x33,
-	0x65, 0x83, 0x66, 0x79, 0x07, 0x91, 0x8d, 0x65, 0x3b, 0x0d, 0x60, 0xe4, 0x3e, 0x2c, 0xf6, 0x69,
-	0x68, 0x75, 0xac, 0xd0, 0x42, 0xa7, 0xba, 0x96, 0x47, 0xca, 0x0f, 0x70, 0x8e, 0x11, 0xcf, 0x26,
-	0x8f, 0x61, 0x89, 0x3e, 0xa7, 0x76, 0xc4, 0x05, 0x69, 0x86, 0x81, 0x65, 0x53, 0xa1, 0xdf, 0x69,
-	0x5e, 0xaa, 0x6c, 0xee, 0xae, 0x9a, 0xf3, 0x98, 0x4f, 0x31, 0xea, 0x34, 0xf5, 0xdd, 0xfc, 0xfb,
-	0x02, 0x2c, 0x8d, 0xed, 0x82, 0x3b, 0x86, 0xda, 0x7e, 0xc2, 0x31, 0x14, 0x68, 0xbf, 0xc3, 0xa5,
-	0x6c, 0x1f, 0x46, 0xde, 0xd1, 0x28, 0xbc, 0x2c, 0x88, 0xef, 0xfd, 0x0e, 0xf7, 0xc4, 0xd0, 0x09,
-	0x5d, 0x8a, 0xa1, 0x45, 0x7e, 0x90, 0x06, 0x86, 0x04, 0x2f, 0x14, 0x42, 0x28, 0x1b, 0xea, 0x93,
-	0x5b, 0x6d, 0x40, 0x5d, 0x3a, 0xb4, 0x3c, 0x9b, 0x9a, 0xcc, 0xf6, 0x03, 0xb9, 0xab, 0x82, 0x51,
-	0x8f, 0xc1, 0x07, 0x1c, 0x4a, 0x36, 0x60, 0x9e, 0xf9, 0x51, 0x60, 0xd3, 0xc6, 0xbc, 0x0c, 0x5a,
-	0xf2, 0x8b, 0x7c, 0x9c, 0x10, 0xf0, 0x82, 0x50, 0xd5, 0x8d, 0x3c, 0xaa, 0x6a, 0x29, 0x09, 0x4b,
-	0xcb, 0x8d, 0x69, 0xe8, 0xef, 0x41, 0x2d, 0x35, 0x34, 0x53, 0xb4, 0xf9, 0xcf, 0x12, 0x9c, 0x99,
-	0xa2, 0x49, 0xf2, 0x36, 0x34, 0x42, 0x3f, 0xb4, 0x5c, 0x73, 0x10, 0x8f, 0x99, 0xdc, 0x85, 0xcd,
-	0x3e, 0x13, 0xc4, 0x8b, 0xc6, 0xba, 0x18, 0x1f, 0x4d, 0x7d, 0xec, 0xf4, 0xe9, 0x03, 0x46, 0x7e,
-	0xa4, 0x41, 0x43, 0x39, 0xec, 0xd8, 0x5c, 0xd6, 0x28, 0x88, 0x2d, 0xdf, 0x9f, 0xc5, 0xa6, 0x94,
-	0xeb, 0xa6, 0x17, 0x42, 0x17, 0xde, 0x60, 0x99, 0x83, 0xe4, 0x15, 0xa8, 0xc5, 0xc1, 0x32, 0x62,
-	0x54, 0x1d, 0x1d, 0x55, 0x05, 0x7c, 0xc2, 0x68, 0x87, 0xbc, 0xa3, 0xb6, 0x18, 0x7b, 0x8f, 0xc9,
-	0xa8, 0xc5, 0xb9, 0xea, 0xc8, 0x00, 0x66, 0x6c, 0x88, 0xf1, 0xd8, 0x3d, 0x0e, 0x70, 0x94, 0x5c,
-	0x07, 0x32, 0x9a, 0x13, 0xd0, 0x30, 0x0a, 0x3c, 0xda, 0x11, 0x96, 0x50, 0x32, 0x56, 0xe2, 0x11,
-	0x03, 0x07, 0xb8, 0xd5, 0x20, 0x9f, 0xcc, 0xb4, 0x2d, 0xd7, 0xa5, 0x1d, 0x11, 0xc2, 0xca, 0x71,
-	0x04, 0x62, 0x7b, 0x02, 0x4a, 0x3e, 0x07, 0xe8, 0xd0, 0xa7, 0x51, 0xcf, 0x74, 0xbc, 0xae, 0x8f,
-	0xf6, 0xf1, 0xde, 0x4c, 0xc2, 0xba, 0xc3, 0xa7, 0xef, 0x7b, 0x5d, 0x5f, 0xca, 0xa7, 0xdc, 0x51,
-	0xdf, 0xe4, 0x5d, 0x00, 0x16, 0x5a, 0x41, 0x48, 0x3b, 0xa6, 0x15, 0x36, 0x16, 0x85, 0x2f, 0xea,
-	0x13, 0x91, 0xeb, 0xb1, 0xca, 0x1f, 0x8c, 0x32, 0x62, 0xef, 0x84, 0xe4, 0x17, 0xa1, 0x6a, 0xfb,
-	0xfd, 0x81, 0x4b, 0x71, 0x72, 0xf9, 0xd4, 0xc9, 0x95, 0x18, 0x7f, 0x27, 0xd4, 0xf7, 0xe1, 0xdc,
-	0x09, 0x3a, 0x3c, 0xcd, 0x62, 0x8b, 0x09, 0x8b, 0xd5, 0x7f, 0x01, 0xea, 0xe9, 0x1d, 0xce, 0x64,
-	0xef, 0xff, 0x5b, 0x80, 0x0d, 0x19, 0x60, 0xa8, 0x8a, 0x37, 0x2a, 0x47, 0xb9, 0x09, 0x1b, 0xb1,
-	0xc1, 0x74, 0xe2, 0x13, 0x71, 0x14, 0x4f, 0xd6, 0x9e, 0x4d, 0x1c, 0x97, 0xfb, 0x1d, 0x72, 0x04,
-	0xcb, 0x8e, 0x37, 0x88, 0x42, 0x73, 0x60, 0x05, 0x56, 0x9f, 0x86, 0x34, 0x50, 0x26, 0xfe, 0x41,
-	0xa6, 0xd6, 0xb2, 0x17, 0x6f, 0xed, 0x73, 0x1a, 0x8f, 0x62, 0x12, 0x52, 0x75, 0x4b, 0x4e, 0x1a,
-	0x4a, 0x6e, 0x8f, 0xf2, 0x9d, 0xa2, 0x50, 0xc0, 0xe5, 0x13, 0xd6, 0x70, 0x7c, 0x6f, 0x22, 0xd3,
-	0xb9, 0x04, 0xd5, 0x51, 0x48, 0x76, 0x3a, 0x18, 0xdb, 0x2a, 0x31, 0x6c, 0xbf, 0xa3, 0x7f, 0x06,
-	0x6b, 0x59, 0xcc, 0xbc, 0x84, 0x33, 0xf0, 0xc7, 0x45, 0x58, 0x1e, 0x67, 0x8d, 0x3b, 0xc6, 0x78,
-	0xe6, 0xa0, 0x09, 0x27, 0x1a, 0xcf, 0x16, 0x38, 0xeb, 0x32, 0x5b, 0x08, 0x68, 0x18, 0x1c, 0x8b,
-	0x45, 0x17, 0x8d, 0x8a, 0x84, 0x19, 0x1c, 0xc4, 0x8f, 0x81, 0xbe, 0xf5, 0x5c, 0x8c, 0x3b, 0x54,
-	0x8a, 0xa8, 0x64, 0x40, 0xdf, 0x7a, 0x6e, 0x48, 0x48, 0x56, 0xc6, 0x31, 0x97, 0x99, 0x71, 0xdc,
-	0x84, 0x0d, 0x95, 0xf6, 0x38, 0x81, 0x1d, 0x39, 0xa1, 0xf9, 0x34, 0xa0, 0xd6, 0x11, 0x0d, 0x30,
-	0x43, 0x59, 0xc3, 0xf4, 0x47, 0x0e, 0xee, 0xca, 0x31, 0x72, 0x08, 0x2b, 0x23, 0xe9, 0xaa, 0x8c,
-	0x72, 0xfe, 0x04, 0x17, 0x1e, 0x97, 0xc6, 0x08, 0x90, 0xca, 0x2b, 0x97, 0xe9, 0x18, 0x58, 0xdf,
-	0x83, 0xf5, 0x4c, 0xd4, 0x99, 0x7c, 0xe1, 0x5f, 0x8a, 0x70, 0x66, 0xc2, 0x1c, 0x31, 0x2d, 0x19,
-	0x37, 0x14, 0x6d, 0xc2, 0x50, 0xc8, 0xdb, 0x30, 0xcf, 0x42, 0x2b, 0x8c, 0x98, 0xa0, 0x5c, 0xbf,
-	0x71, 0x51, 0x6c, 0x11, 0xef, 0x10, 0xa3, 0x74, 0xd9, 0xf1, 0x7a, 0x07, 0x02, 0xcd, 0x40, 0x74,
-	0xd2, 0x85, 0xba, 0x1f, 0x85, 0xdc, 0x67, 0x02, 0xca, 0x22, 0x37, 0xce, 0x58, 0x6e, 0xe7, 0x73,
-	0x18, 0xc9, 0x61, 0xeb, 0xa1, 0x20, 0x61, 0x48, 0x0a, 0x52, 0x4e, 0x35, 0x3f, 0x09, 0x23, 0xbf,
-	0x34, 0x91, 0xc9, 0xb4, 0xf2, 0x25, 0x1e, 0xdf, 0x56, 0x2e, 0xa3, 0x7f, 0x0a, 0x64, 0x72, 0x1b,
-	0x2f, 0xc1, 0xd3, 0xfe, 0xb0, 0x08, 0xe7, 0xc6, 0xe4, 0xb6, 0xc3, 0x8e, 0x3d, 0xfb, 0xc5, 0x42,
-	0xdd, 0x60, 0x6a, 0xa8, 0xbb, 0x9b, 0x47, 0x73, 0x49, 0x0e, 0xbe, 0xc5, 0x78, 0x67, 0xa3, 0x4f,
-	0x9b, 0x51, 0xe0, 0xaa, 0x78, 0xa7, 0x60, 0x4f, 0x02, 0x97, 0x5c, 0x86, 0xc5, 0x41, 0xe0, 0xf8,
-	0x81, 0x13, 0x1e, 0xcb, 0xe3, 0x7b, 0xb7, 0xfc, 0xd5, 0xee, 0xbc, 0x3e, 0xd7, 0x80, 0x2d, 0xcd,
-	0x88, 0x87, 0xbe, 0xc1, 0xb0, 0xf8, 0x3f, 0x1a, 0x9c, 0xcf, 0x16, 0xd5, 0xb7, 0xe0, 0x8b, 0x1f,
-	0xc0, 0x79, 0xca, 0x42, 0xa7, 0x6f, 0xf1, 0x73, 0x1d, 0x4f, 0x6c, 0xbe, 0x8c, 0x8a, 0xc5, 0x32,
-	0x86, 0xea, 0x31, 0xce, 0x5e, 0x8c, 0xa2, 0xe2, 0xf2, 0xbb, 0x00, 0x76, 0x40, 0x2d, 0xcc, 0x0b,
-	0xe6, 0x4e, 0x4f, 0x2a, 0x10, 0x7b, 0x27, 0x6c, 0xfe, 0xba, 0x06, 0x8d, 0x7b, 0x34, 0x54, 0xbb,
-	0x46, 0xd6, 0xd0, 0x46, 0xb7, 0x32, 0x2e, 0xbb, 0xa3, 0xc2, 0x41, 0xf2, 0xd6, 0xfb, 0x0a, 0xd4,
-	0x1c, 0xcf, 0x76, 0xa3, 0x0e, 0x45, 0xcf, 0x94, 0x47, 0x43, 0x15, 0x81, 0xc2, 0xd5, 0xb8, 0x10,
-	0x15, 0x92, 0xeb, 0xf7, 0x18, 0x5e, 0x63, 0x2b, 0x08, 0xfb, 0xc8, 0xef, 0xb1, 0xe6, 0x3f, 0x14,
-	0xe0, 0x6c, 0x06, 0x3b, 0xa8, 0x85, 0x53, 0x2f, 0xdf, 0x5f, 0x5b, 0x07, 0xc9, 0x38, 0x55, 0x7c,
-	0xf9, 0x71, 0x6a, 0xee, 0x85, 0xe3, 0x14, 0xb9, 0x09, 0x73, 0x42, 0x68, 0xb2, 0x3a, 0xb2, 0x79,
-	0x22, 0xa9, 0x8f, 0xfc, 0x9e, 0x21, 0xb0, 0x9b, 0xbf, 0x0a, 0xeb, 0x7b, 0xfc, 0x3a, 0xe4, 0x8e,
-	0x67, 0x5a, 0xf9, 0x55, 0xbb, 0x01, 0xf3, 0x01, 0xb5, 0x98, 0xef, 0xe1, 0xe9, 0x85, 0x5f, 0xe2,
-	0x6a, 0xec, 0x07, 0x36, 0x35, 0x6d, 0xb1, 0x80, 0xd2, 0xa6, 0x80, 0xc9, 0x35, 0x9b, 0xff, 0xa6,
-	0xc1, 0xc6, 0xf8, 0xf2, 0xdf, 0xb8, 0x2a, 0xdb, 0xb0, 0x2a, 0x39, 0x72, 0x45, 0x01, 0xcf, 0x44,
-	0xe6, 0xe5, 0xd5, 0x83, 0x24, 0x87, 0x0c, 0xb9, 0x11, 0x9e, 0x57, 0x4b, 0x68, 0x5e, 0xff, 0xa9,
-	0xc4, 0xf8, 0x3b, 0x61, 0xf3, 0xc7, 0x1a, 0x5c, 0xdc, 0x13, 0xfe, 0x94, 0x51, 0xcb, 0x41, 0x69,
-	0xdf, 0xe3, 0x37, 0x0a, 0x05, 0x14, 0x9b, 0x9d, 0xa1, 0x1e, 0x94, 0x98, 0xca, 0x85, 0xde, 0xb7,
-	0x8e, 0x28, 0x3f, 0x31, 0xac, 0xc8, 0x0d, 0x55, 0x06, 0xc6, 0x61, 0x77, 0x24, 0xa8, 0xf9, 0x5f,
-	0x1a, 0x6c, 0x4e, 0xe7, 0x07, 0xc5, 0xff, 0x0a, 0xd4, 0xb2, 0x0e, 0x9d, 0x6a, 0x27, 0x79, 0xd8,
-	0x7c, 0x0a, 0x44, 0x85, 0x95, 0x04, 0xf7, 0x33, 0x56, 0xb3, 0x56, 0x90, 0xc4, 0x08, 0xc4, 0x2f,
-	0xf6, 0x43, 0x1a, 0x30, 0x27, 0xd6, 0x8a, 0xfa, 0x7c, 0x91, 0x40, 0xd6, 0x85, 0xf3, 0x89, 0xc0,
-	0x31, 0xa9, 0x82, 0x6b, 0x99, 0x3b, 0x1e, 0x99, 0x7c, 0x7a, 0xeb, 0x09, 0x16, 0x0b, 0x29, 0x16,
-	0x9b, 0x7f, 0xa2, 0xc1, 0x85, 0x29, 0x0b, 0xa1, 0x6c, 0x5f, 0x9a, 0xb2, 0x7f, 0x39, 0x11, 0x94,
-	0xa4, 0xd4, 0xdb, 0x39, 0xc9, 0x4c, 0x46, 0xa5, 0xe6, 0x5f, 0x68, 0xf0, 0x9d, 0x8f, 0x1c, 0x96,
-	0xc1, 0x78, 0x1c, 0xee, 0x3f, 0x00, 0x18, 0x58, 0x3d, 0xc7, 0xb3, 0x12, 0x8c, 0x6f, 0x8e, 0xbb,
-	0x5d, 0x8c, 0x80, 0xb3, 0x8c, 0xc4, 0x1c, 0xd2, 0x86, 0x85, 0xae, 0xe3, 0x26, 0xb2, 0x92, 0xf5,
-	0xb1, 0xe9, 0x1f, 0x8a, 0x51, 0x43, 0x61, 0x91, 0x2b, 0x3c, 0x9f, 0x91, 0x47, 0x42, 0x2a, 0xfe,
-	0x2e, 0xf2, 0x44, 0x44, 0xc0, 0xd5, 0x56, 0x9a, 0x7f, 0xac, 0xc1, 0xc5, 0xa9, 0x1b, 0x40, 0xd1,
-	0x3f, 0x80, 0xca, 0x48, 0x7e, 0xfc, 0x16, 0x53, 0x3c, 0x35, 0xec, 0x26, 0xd2, 0x2b, 0xaf, 0xeb,
-	0x1b, 0xc9, 0xf9, 0x64, 0x27, 0x25, 0x10, 0xa9, 0x82, 0x4b, 0x27, 0x08, 0x44, 0x72, 0x91, 0x94,
-	0x48, 0xf3, 0x6f, 0x35, 0xb8, 0xf8, 0x64, 0xd0, 0x39, 0x31, 0x3a, 0xcc, 0x66, 0x9a, 0x69, 0xf3,
-	0x2a, 0x7c, 0x7d, 0xf3, 0xba, 0xa6, 0xdc, 0xdb, 0xf4, 0xe8, 0x33, 0x33, 0xe9, 0x91, 0x8b, 0xc6,
-	0xb2, 0x1c, 0xf9, 0x98, 0x3e, 0xfb, 0x14, 0xed, 0x9e, 0x87, 0x95, 0xe9, 0x1b, 0x99, 0x31, 0xac,
-	0x44, 0x82, 0xd0, 0x0b, 0x85, 0x15, 0x24, 0x91, 0x37, 0xac, 0xa8, 0x15, 0xf3, 0x85, 0x15, 0xc4,
-	0xde, 0x09, 0x9b, 0x7f, 0x5d, 0x04, 0x32, 0xb9, 0x3c, 0x39, 0x07, 0x73, 0x9e, 0xd5, 0xa7, 0xe3,
-	0x9a, 0x12, 0x40, 0xb2, 0xc9, 0xad, 0x90, 0xd9, 0x81, 0x33, 0x08, 0x47, 0x01, 0x24, 0x09, 0x3a,
-	0x81, 0xd5, 0xb7, 0xa1, 0xc4, 0x42, 0x3a, 0x60, 0x8d, 0x39, 0x61, 0xbb, 0x97, 0x4e, 0x94, 0xc7,
-	0x41, 0x48, 0x07, 0x86, 0xc4, 0x27, 0x8f, 0xa0, 0x66, 0xfb, 0x5e, 0xd7, 0xe9, 0x45, 0x81, 0x34,
-	0x57, 0x79, 0x37, 0x7a, 0xe3, 0x44, 0x02, 0x7b, 0xc9, 0x19, 0x46, 0x9a, 0x00, 0xe9, 0x03, 0xc1,
-	0x63, 0x26, 0x79, 0xdb, 0x90, 0x77, 0xe9, 0xf7, 0x73, 0xea, 0xa9, 0x85, 0xa7, 0xd2, 0xf8, 0x35,
-	0x63, 0xa5, 0x33, 0x0e, 0xd7, 0x3f, 0x87, 0x8d, 0x6c, 0xe4, 0x97, 0x90, 0xdf, 0xff, 0xa4, 0x04,
-	0xd5, 0xa4, 0xd0, 0xc8, 0x26, 0x2c, 0x70, 0xb1, 0x65, 0x38, 0xdb, 0x3c, 0x87, 0xef, 0x77, 0xc8,
-	0x39, 0x28, 0x0b, 0x0c, 0xa1, 0x66, 0xa9, 0xc2, 0x45, 0x0e, 0xf8, 0x98, 0x6b, 0xf8, 0x12, 0x54,
-	0x55, 0x71, 0x55, 0x8c, 0x4b, 0x25, 0x56, 0x10, 0x26, 0x50, 0x2e, 0x42, 0xa5, 0x4f, 0xc3, 0x43,
-	0xbf, 0x23, 0x31, 0xe4, 0xad, 0x07, 0x24, 0x48, 0x20, 0x7c, 0xc6, 0x53, 0x66, 0x7e, 0x95, 0xeb,
-	0x5b, 0x83, 0x81, 0xe3, 0xf5, 0x30, 0xb3, 0x7b, 0xf3, 0x54, 0x8d, 0xcb, 0x8b, 0xdb, 0x03, 0x39,
-	0x4b, 0x8a, 0xb3, 0xea, 0x24, 0x40, 0xe4, 0x57, 0xe2, 0xcb, 0xbd, 0x22, 0x2d, 0x95, 0x76, 0xf3,
-	0x74, 0xd2, 0xf2, 0x2a, 0x9c, 0xa2, 0x8d, 0x37, 0x7a, 0x45, 0xfc, 0x1e, 0x54, 0x84, 0x5c, 0xa4,
-	0xad, 0x34, 0x16, 0x84, 0x02, 0x5e, 0xcb, 0xa4, 0xcc, 0x29, 0xa6, 0x2d, 0x0c, 0x58, 0x0c, 0x22,
-	0x17, 0x78, 0x1c, 0x1b, 0x50, 0xaf, 0xc3, 0x4c, 0xdf, 0x6b, 0x2c, 0x8a, 0x4a, 0x6c, 0x19, 0x21,
-	0x0f, 0xf9, 0xe1, 0x57, 0xe1, 0x97, 0x1b, 0x61, 0x45, 0x96, 0x8b, 0xc5, 0xce, 0x2b, 0xd3, 0xaa,
-	0xf4, 0x0a, 0x2f, 0x4e, 0xa2, 0x8d, 0xe4, 0x6c, 0xfd, 0x09, 0xac, 0x4c, 0x08, 0xed, 0xc5, 0xcd,
-	0x4a, 0xff, 0x40, 0xd5, 0x0e, 0x4e, 0xa1, 0x3b, 0xbd, 0xfe, 0xf3, 0x4f, 0x45, 0x58, 0x99, 0x10,
-	0x53, 0xfe, 0x82, 0xdc, 0x65, 0xa8, 0x8b, 0x4a, 0x9c, 0x69, 0x85, 0x21, 0xed, 0x0f, 0x42, 0x99,
-	0x2c, 0x97, 0x8c, 0x9a, 0x80, 0xee, 0x20, 0x70, 0x84, 0x26, 0x84, 0x46, 0x7b, 0xc7, 0x68, 0xb0,
-	0x12, 0xed, 0x00, 0x81, 0xf9, 0x4b, 0x73, 0x07, 0xb0, 0xa4, 0x30, 0x94, 0x1d, 0x9c, 0x14, 0x6d,
-	0xd4, 0xbc, 0xb4, 0x2d, 0xd4, 0xbb, 0x29, 0x30, 0x79, 0x0d, 0x96, 0x82, 0xc8, 0x33, 0x1d, 0x4f,
-	0x44, 0x1b, 0xd7, 0xa5, 0xae, 0x78, 0xb4, 0x59, 0x34, 0x6a, 0x41, 0xe4, 0xed, 0x7b, 0x8f, 0x10,
-	0x48, 0xbe, 0x07, 0x35, 0x7c, 0x60, 0x8d, 0x4d, 0x90, 0x1b, 0xf7, 0x3b, 0xf9, 0x4c, 0xb0, 0xb5,
-	0x27, 0xe6, 0x4a, 0x18, 0x3a, 0x8f, 0x9d, 0x00, 0xe9, 0xb7, 0x61, 0x65, 0x02, 0x65, 0x26, 0x95,
-	0xfe, 0x54, 0x83, 0xb5, 0x2c, 0x8b, 0x24, 0xe7, 0xa1, 0x1c, 0xdb, 0x24, 0x92, 0x1a, 0x01, 0xf8,
-	0xf6, 0xe5, 0x8d, 0x8f, 0x9a, 0x4e, 0xd7, 0x0c, 0x03, 0x41, 0x9a, 0xfb, 0x44, 0x0d, 0xc1, 0xfb,
-	0xdd, 0xc7, 0x41, 0x44, 0xc9, 0x16, 0x2c, 0x27, 0xf0, 0xba, 0x96, 0xcb, 0xa8, 0xa8, 0xdd, 0x95,
-	0xd5, 0x8d, 0x91, 0xee, 0x77, 0x3f, 0xe4, 0x50, 0xae, 0xf5, 0x98, 0xbc, 0x19, 0x1e, 0x0f, 0x54,
-	0x10, 0xaa, 0xc5, 0xd0, 0xc7, 0xc7, 0x03, 0xda, 0xfc, 0x03, 0x0d, 0xd6, 0x33, 0x35, 0xc4, 0x4f,
-	0xf3, 0x58, 0xcd, 0x62, 0x3e, 0x9e, 0xe6, 0x0a, 0xc8, 0xa7, 0x93, 0xf7, 0x20, 0x56, 0xa4, 0x79,
-	0xba, 0xf3, 0xc4, 0x04, 0x3f, 0xe5, 0xa8, 0xa4, 0x05, 0xab, 0x16, 0x4f, 0x04, 0x79, 0xb2, 0x34,
-	0xa4, 0xa6, 0x0a, 0xc9, 0xd2, 0x3a, 0x57, 0x12, 0x43, 0x07, 0x22, 0x28, 0x37, 0xff, 0xb4, 0x08,
-	0xeb, 0x99, 0x67, 0x17, 0xb9, 0x09, 0x1b, 0x3d, 0xd7, 0x7f, 0x6a, 0xb9, 0x66, 0xb6, 0xe7, 0xac,
-	0xc9, 0xd1, 0xc7, 0x69, 0xff, 0xb9, 0x05, 0x67, 0xd1, 0xe2, 0x95, 0xcd, 0x99, 0xf1, 0xb5, 0x1b,
-	0xef, 0x56, 0x67, 0x24, 0x82, 0x32, 0xbf, 0x91, 0x3a, 0xaf, 0x03, 0x19, 0x5d, 0xf3, 0xc7, 0x1c,
-	0x6b, 0x54, 0x83, 0x8e, 0x9d, 0xeb, 0x13, 0xa8, 0x8b, 0xfe, 0x0d, 0xf3, 0xd0, 0xf2, 0x3a, 0x2e,
-	0x0f, 0xca, 0x73, 0x27, 0xb8, 0xcc, 0x5d, 0x8e, 0x7a, 0x1f, 0x31, 0x15, 0x0d, 0xa3, 0x46, 0x93,
-	0x60, 0xd2, 0x83, 0x25, 0xdc, 0x33, 0xa3, 0x61, 0xe8, 0x78, 0x71, 0x75, 0xe0, 0xfd, 0xfc, 0x87,
-	0x7e, 0xeb, 0x9e, 0xa0, 0x70, 0x80, 0x04, 0xf0, 0x49, 0xbe, 0x97, 0x02, 0xea, 0x3b, 0xb0, 0x9a,
-	0x81, 0x36, 0x93, 0x57, 0xfc, 0x4c, 0x83, 0xf5, 0xcc, 0x4d, 0x11, 0x1d, 0x16, 0x63, 0xe9, 0x69,
-	0xea, 0x9c, 0xc5, 0xb1, 0x6b, 0x40, 0xfa, 0xd6, 0x73, 0x13, 0x77, 0xa9, 0x1e, 0x15, 0x64, 0x8c,
-	0x5b, 0xee, 0x5b, 0xcf, 0x25, 0x57, 0x93, 0x4f, 0x0b, 0x81, 0x8f, 0xf1, 0xab, 0x98, 0x8c, 0x5f,
-	0x06, 0x42, 0x85, 0x67, 0x04, 0x4e, 0xe8, 0xd8, 0x5c, 0x74, 0x71, 0xb6, 0xc5, 0x3d, 0x03, 0xa1,
-	0xdc, 0xdc, 0x58, 0xf3, 0x1f, 0x35, 0xd8, 0xc8, 0xae, 0xce, 0xe4, 0xa9, 0x07, 0xde, 0xc7, 0x83,
-	0x52, 0x54, 0x80, 0xd4, 0x7d, 0xe8, 0xf5, 0xa9, 0x51, 0x6a, 0xac, 0xfc, 0x23, 0x4e, 0x4a, 0xf1,
-	0x93, 0x91, 0x7b, 0xb0, 0xc0, 0xa2, 0x7e, 0xdf, 0x0a, 0x8e, 0xb1, 0x36, 0x75, 0x3d, 0x5f, 0x21,
-	0xe9, 0x40, 0x4e, 0x32, 0xd4, 0xec, 0xe6, 0xff, 0x95, 0x80, 0x4c, 0xae, 0x45, 0xce, 0x8c, 0x25,
-	0x43, 0xf9, 0x72, 0xa0, 0x51, 0x81, 0xa6, 0x38, 0x5b, 0x81, 0x26, 0xfd, 0x04, 0x3a, 0xf7, 0x22,
-	0x4f, 0xa0, 0xa5, 0x99, 0x9e, 0x40, 0x45, 0x8f, 0x02, 0xda, 0xbd, 0xd9, 0x67, 0xe2, 0x78, 0x29,
-	0x1a, 0xa0, 0x40, 0x0f, 0x18, 0x79, 0x02, 0x20, 0x73, 0xb2, 0x44, 0x67, 0xc0, 0xcf, 0xe7, 0x54,
-	0x99, 0x4c, 0xcb, 0xee, 0xc4, 0xdd, 0x01, 0x65, 0x47, 0x7d, 0x93, 0xcf, 0xa0, 0x82, 0x09, 0x99,
-	0xa0, 0xbb, 0x28, 0xe8, 0xbe, 0x9d, 0x97, 0xae, 0x4c, 0x31, 0x46, 0x84, 0xc1, 0x8f, 0x01, 0x3c,
-	0x44, 0xcb, 0xa8, 0xd2, 0xa7, 0x8c, 0x59, 0x3d, 0x2a, 0xf2, 0xa4, 0xb2, 0x51, 0x15, 0xc0, 0x07,
-	0x12, 0xc6, 0xb7, 0x2d, 0x8f, 0x7f, 0xdb, 0x8f, 0xbc, 0xb0, 0x01, 0xf2, 0x4d, 0x4e, 0x80, 0xf6,
-	0x38, 0x84, 0xdc, 0x86, 0x79, 0x3a, 0x14, 0x7d, 0x2b, 0x95, 0xbc, 0x56, 0x7a, 0x97, 0xe3, 0x1b,
-	0x38, 0x4d, 0x37, 0xa0, 0x9e, 0xde, 0xfd, 0x4b, 0x48, 0xae, 0x0e, 0x60, 0x69, 0x6c, 0xe7, 0x2f,
-	0xe1, 0x22, 0xf0, 0x67, 0x85, 0x31, 0x0f, 0x10, 0xfb, 0xe0, 0xb9, 0xa8, 0xd8, 0x49, 0xf2, 0x98,
-	0x2b, 0x0b, 0x88, 0x38, 0xe3, 0xb8, 0x94, 0xc5, 0xb0, 0x92, 0x72, 0x01, 0xa5, 0xcc, 0x81, 0x4a,
-	0xca, 0xef, 0xc6, 0x34, 0x1c, 0xbc, 0x11, 0x9c, 0x62, 0xd6, 0x92, 0xbe, 0xd3, 0xa7, 0xc4, 0x82,
-	0xba, 0xa2, 0x1f, 0xbf, 0x95, 0x71, 0x3d, 0xdc, 0xca, 0xa9, 0x87, 0xd6, 0x5d, 0xc9, 0x48, 0xb2,
-	0x39, 0xa5, 0x46, 0x93, 0x30, 0x9e, 0xaa, 0x4e, 0x22, 0xcd, 0x14, 0xc1, 0xff, 0xb9, 0x00, 0x8d,
-	0x69, 0x21, 0x86, 0x7c, 0x08, 0x75, 0x7f, 0x48, 0xf9, 0x11, 0x69, 0x62, 0x50, 0xd0, 0xf2, 0x05,
-	0x85, 0x1a, 0x4e, 0x93, 0x9f, 0xdc, 0x54, 0x65, 0x33, 0x88, 0x0c, 0xcb, 0x32, 0xd2, 0x83, 0x00,
-	0x89, 0x98, 0x4c, 0xae, 0xc0, 0x32, 0x8b, 0x6c, 0x4e, 0xa3, 0x1b, 0x29, 0x2c, 0xf9, 0x40, 0xb2,
-	0x34, 0x82, 0x4b, 0xd4, 0x4b, 0x50, 0xed, 0x5a, 0x8e, 0x4b, 0x3b, 0x71, 0x8c, 0xe7, 0x68, 0x15,
-	0x09, 0x93, 0x28, 0xaf, 0x40, 0x8d, 0x1d, 0x39, 0x83, 0x41, 0x8c, 0x23, 0x9b, 0x47, 0xaa, 0x08,
-	0x94, 0x48, 0x6f, 0xc1, 0x19, 0xc9, 0x53, 0xa2, 0xaa, 0x8f, 0x2d, 0x38, 0x32, 0x82, 0xac, 0x89,
-	0xe1, 0x91, 0xd7, 0xca, 0x0e, 0x9c, 0xcb, 0x3c, 0x31, 0x72, 0xdc, 0x28, 0xa0, 0xaa, 0x04, 0xbd,
-	0x20, 0xd3, 0x2f, 0x84, 0xca, 0xea, 0x73, 0xf3, 0xd7, 0xe6, 0xe0, 0xec, 0xd4, 0x57, 0x85, 0xaf,
-	0xf9, 0x4a, 0x78, 0x05, 0x96, 0xe3, 0x59, 0xe9, 0x32, 0xe6, 0x92, 0x82, 0x63, 0x59, 0x87, 0x0b,
-	0x5c, 0x6e, 0xcb, 0x4f, 0xf4, 0x76, 0x82, 0x02, 0xed, 0x77, 0xc8, 0x17, 0x59, 0x0f, 0xea, 0xd2,
-	0x3c, 0xef, 0xcc, 0xf6, 0x44, 0x92, 0xf7, 0x65, 0x7d, 0xac, 0x0a, 0x5c, 0x9a, 0xa1, 0x0a, 0x3c,
-	0x76, 0xb6, 0xcc, 0xbf, 0xc8, 0xd9, 0xb2, 0x30, 0x5b, 0x7b, 0xcd, 0x4b, 0x69, 0x07, 0xf8, 0xdd,
-	0x02, 0x54, 0x12, 0x8f, 0x38, 0xfc, 0x00, 0x76, 0xfd, 0x9e, 0xe9, 0xd2, 0x21, 0x75, 0x55, 0x72,
-	0xe4, 0xfa, 0xbd, 0x8f, 0xf8, 0x37, 0x69, 0xc0, 0x42, 0x3a, 0x1e, 0xa9, 0xcf, 0xe4, 0x81, 0x5e,
-	0x4c, 0x1d, 0xe8, 0xef, 0x40, 0x39, 0x6e, 0x4d, 0xce, 0x73, 0xf2, 0xc6, 0xc8, 0xa9, 0x07, 0x32,
-	0x99, 0x64, 0xb6, 0x4e, 0x7b, 0x82, 0xfa, 0x66, 0xba, 0xe5, 0x7e, 0x52, 0x18, 0x25, 0x65, 0xe9,
-	0xda, 0x6d, 0xbe, 0xea, 0x23, 0xc1, 0xca, 0x9d, 0x24, 0x9c, 0x59, 0xb0, 0x2b, 0x9e, 0x58, 0xb0,
-	0x9b, 0x4b, 0x17, 0xec, 0x2e, 0x00, 0x38, 0x2c, 0x7e, 0x8f, 0x91, 0xad, 0x29, 0x65, 0x87, 0x61,
-	0x29, 0x8b, 0x6b, 0xd0, 0x61, 0xa6, 0x65, 0xf3, 0x4b, 0x0c, 0xde, 0x67, 0x17, 0x1d, 0xb6, 0x23,
-	0xbe, 0x53, 0x05, 0xfe, 0x85, 0x17, 0x2d, 0xf0, 0xff, 0x77, 0x11, 0xf4, 0xe9, 0x88, 0x9c, 0x4f,
-	0xe5, 0x54, 0x4f, 0x8f, 0xe3, 0xeb, 0xa7, 0x84, 0xec, 0x1e, 0x8f, 0xf9, 0x5c, 0x61, 0x46, 0x9f,
-	0x4b, 0x54, 0x57, 0x8b, 0x33, 0x54, 0x57, 0x45, 0xc6, 0x9e, 0x08, 0x2e, 0x11, 0xb6, 0x7a, 0x96,
-	0x12, 0xaf, 0x9f, 0x32, 0x43, 0xb9, 0x04, 0x55, 0x0c, 0xef, 0x26, 0xbf, 0x1a, 0x60, 0xbb, 0x67,
-	0x05, 0x61, 0x86, 0x15, 0x52, 0xb2, 0x0d, 0xeb, 0xd6, 0xb0, 0x37, 0x25, 0x48, 0x17, 0x0c, 0x62,
-	0x0d, 0x7b, 0xe3, 0x21, 0x9a, 0xc0, 0x5c, 0x68, 0xf5, 0x98, 0x48, 0xf4, 0xca, 0x86, 0xf8, 0x4d,
-	0xdc, 0xb8, 0x7f, 0x3b, 0x56, 0x8d, 0xcc, 0xd7, 0xf6, 0x66, 0x54, 0x0d, 0x56, 0x1a, 0xd2, 0x4e,
-	0x80, 0xbd, 0xdd, 0xf1, 0xb1, 0xbc, 0x03, 0xab, 0x19, 0x68, 0x33, 0x39, 0xc4, 0xa1, 0x78, 0xc0,
-	0xc7, 0xd6, 0x3e, 0x76, 0x9f, 0x5a, 0x6e, 0x78, 0xa8, 0x5e, 0x16, 0xf8, 0xf9, 0x96, 0xa8, 0x53,
-	0xca, 0x17, 0x91, 0xb2, 0x51, 0x4d, 0x14, 0x2a, 0xc5, 0xb5, 0x49, 0xbd, 0xc1, 0x74, 0x68, 0x68,
-	0x39, 0x2e, 0xc3, 0xab, 0x6f, 0x1d, 0xc1, 0x77, 0x24, 0xb4, 0xf9, 0xc3, 0xa2, 0x78, 0x9c, 0x1f,
-	0x5f, 0x0a, 0x6b, 0xff, 0x47, 0x89, 0xf6, 0xca, 0x43, 0x31, 0x84, 0xef, 0x2f, 0xbb, 0x99, 0x82,
-	0x9b, 0x4a, 0xa8, 0x95, 0x06, 0xa7, 0x9b, 0xc4, 0x11, 0x48, 0x1e, 0x4e, 0xe4, 0x1b, 0xd2, 0x64,
-	0xb7, 0x32, 0xd7, 0x7a, 0x28, 0x51, 0xe5, 0xdc, 0xec, 0xc4, 0x83, 0xdb, 0xff, 0x21, 0xb5, 0x8f,
-	0x72, 0x1b, 0x31, 0x62, 0xef, 0x84, 0xfa, 0x51, 0xdc, 0xb0, 0x9e, 0x64, 0x39, 0x43, 0x87, 0xef,
-	0xa7, 0x93, 0xd5, 0x6c, 0x5e, 0x91, 0x54, 0x8a, 0xd7, 0x84, 0xb6, 0xff, 0x43, 0x8b, 0x57, 0x4b,
-	0xa2, 0x4c, 0x54, 0xa4, 0xb5, 0xc9, 0x8a, 0xf4, 0x46, 0xea, 0x45, 0xbd, 0x1c, 0xdf, 0xc7, 0x4e,
-	0x7c, 0x37, 0x71, 0x2d, 0x16, 0x9a, 0x62, 0xaf, 0x79, 0xce, 0x0b, 0x8e, 0xbd, 0xc7, 0x91, 0xc9,
-	0x1e, 0x2c, 0x28, 0x63, 0x2a, 0x9d, 0x50, 0xba, 0x4d, 0x6d, 0x05, 0xed, 0xcc, 0x50, 0x33, 0x9b,
-	0x7f, 0x55, 0x80, 0xb5, 0x2c, 0x0c, 0xb2, 0x05, 0xcb, 0x01, 0x9a, 0xcb, 0x58, 0x3b, 0x74, 0x5d,
-	0xc1, 0xd1, 0xc5, 0x27, 0x2e, 0x48, 0x85, 0x8c, 0x0b, 0x92, 0x03, 0xcb, 0x4a, 0x78, 0x89, 0x57,
-	0xc8, 0xe9, 0x95, 0x94, 0x2c, 0x9e, 0x14, 0x30, 0xed, 0xef, 0xca, 0x25, 0xe2, 0x30, 0x7c, 0x15,
-	0x56, 0xac, 0xa1, 0xe5, 0xb8, 0xa2, 0x4c, 0x21, 0x5f, 0x03, 0x54, 0xf5, 0x61, 0x39, 0x1e, 0x78,
-	0x20, 0xe1, 0xfa, 0x6e, 0xbc, 0xfd, 0xaf, 0x1f, 0x1e, 0xfe, 0x55, 0x83, 0xd5, 0x0c, 0xfb, 0x4f,
-	0x58, 0x83, 0x96, 0xb2, 0x86, 0x2b, 0xb0, 0x2c, 0xbd, 0xf7, 0xd8, 0x54, 0x3e, 0x87, 0x69, 0xf8,
-	0x12, 0xc2, 0x95, 0xb1, 0xf3, 0x0c, 0x17, 0x93, 0x75, 0x85, 0x28, 0x33, 0xf1, 0x9a, 0xcc, 0xd7,
-	0x15, 0xda, 0x36, 0xac, 0x45, 0xde, 0x68, 0xd3, 0x31, 0xb2, 0xdc, 0xf5, 0x6a, 0x62, 0x2c, 0x49,
-	0x59, 0x2e, 0x66, 0xaa, 0xc2, 0x47, 0x49, 0xe6, 0xce, 0x12, 0x8a, 0xb7, 0x8e, 0x1b, 0x7f, 0x53,
-	0x83, 0xea, 0xc3, 0x84, 0x6e, 0xc8, 0x0f, 0x35, 0xa8, 0x26, 0xff, 0xe2, 0x41, 0xb6, 0xf2, 0xfe,
-	0x8b, 0x48, 0xbf, 0x92, 0x03, 0x53, 0x5a, 0x56, 0xf3, 0xfc, 0x8f, 0xbe, 0xfc, 0xf7, 0xdf, 0x2a,
-	0x6c, 0x34, 0x57, 0xda, 0xc3, 0xed, 0x36, 0x36, 0xd7, 0xb7, 0xc5, 0x3f, 0x46, 0x6e, 0x69, 0x6f,
-	0x90, 0xdf, 0xd0, 0x60, 0x69, 0xac, 0x97, 0x8c, 0x5c, 0x9d, 0xa1, 0x0f, 0x59, 0xbf, 0x36, 0x4b,
-	0x0f, 0x66, 0x73, 0x53, 0x30, 0xa3, 0x37, 0xd7, 0x39, 0x33, 0x2a, 0xbb, 0x67, 0x6d, 0xac, 0x05,
-	0x73, 0x86, 0x7e, 0x4f, 0x83, 0xb5, 0xac, 0xe6, 0x36, 0xf2, 0x73, 0xb3, 0xb6, 0x0c, 0xea, 0xdb,
-	0x33, 0xcc, 0x40, 0xfe, 0x5e, 0x13, 0xfc, 0x6d, 0x36, 0xcf, 0x65, 0xf2, 0x77, 0xdd, 0xe2, 0xc8,
-	0x9c, 0xcb, 0xdf, 0xd1, 0x60, 0x65, 0xa2, 0xf3, 0x8b, 0x5c, 0x9f, 0x76, 0x76, 0x64, 0x36, 0xac,
-	0xe9, 0xad, 0xbc, 0xe8, 0xc8, 0xdc, 0x1b, 0x82, 0xb9, 0x57, 0x49, 0x33, 0xcd, 0xdc, 0xf7, 0x13,
-	0xad, 0x49, 0x3f, 0x68, 0xa3, 0x63, 0xfc, 0xb6, 0x06, 0xf5, 0x74, 0x33, 0x13, 0xc9, 0xae, 0xdd,
-	0x66, 0x36, 0x5c, 0xe9, 0x57, 0x73, 0xe1, 0x22, 0x5f, 0xd7, 0x05, 0x5f, 0xaf, 0x37, 0x4f, 0xe4,
-	0x4b, 0x36, 0x21, 0x71, 0xd9, 0xfd, 0x54, 0x83, 0xc6, 0xb4, 0x96, 0x1f, 0x92, 0xfd, 0xea, 0x77,
-	0x4a, 0xc7, 0x92, 0xfe, 0xd6, 0x8c, 0xb3, 0x90, 0xf1, 0x57, 0x05, 0xe3, 0xdf, 0x69, 0x9e, 0x4d,
-	0x33, 0x9e, 0x68, 0xaa, 0xe0, 0xfc, 0xfe, 0x4c, 0x83, 0xf5, 0xcc, 0x1e, 0x1a, 0xb2, 0x7d, 0x9a,
-	0x02, 0x27, 0x39, 0xbd, 0x31, 0xcb, 0x14, 0x64, 0x73, 0x5b, 0xb0, 0x79, 0x95, 0x5c, 0x99, 0xca,
-	0x66, 0xfb, 0xfb, 0xa9, 0xab, 0xc4, 0x0f, 0xc8, 0xef, 0x6b, 0x70, 0x66, 0x4a, 0xfb, 0x09, 0xc9,
-	0x7e, 0xb3, 0x3d, 0xb9, 0xdb, 0x46, 0xbf, 0x39, 0xdb, 0x24, 0xe4, 0xfc, 0x92, 0xe0, 0xfc, 0x1c,
-	0x99, 0x2e, 0x60, 0xf2, 0xe7, 0x1a, 0x34, 0xa6, 0x75, 0x6a, 0x4c, 0xb1, 0x86, 0x53, 0x3a, 0x54,
-	0xa6, 0x58, 0xc3, 0x69, 0xed, 0x20, 0xcd, 0x9b, 0x82, 0xd9, 0x96, 0x9e, 0x5f, 0xcc, 0xdc, 0x3a,
-	0x7e, 0x53, 0x46, 0x82, 0x74, 0x4e, 0x35, 0x3d, 0x12, 0x64, 0x66, 0xbe, 0xd3, 0x23, 0x41, 0x76,
-	0xd2, 0xd9, 0x3c, 0x27, 0x58, 0x5d, 0x27, 0xab, 0x9c, 0x55, 0x75, 0x44, 0xb5, 0xe5, 0x71, 0x43,
-	0xbe, 0x07, 0x15, 0x89, 0x2e, 0x73, 0x9b, 0x8d, 0x89, 0x14, 0xe8, 0x6e, 0x7f, 0x10, 0x1e, 0xeb,
-	0xcd, 0xb1, 0x62, 0x57, 0x62, 0x4e, 0xbc, 0x0e, 0x11, 0xeb, 0x54, 0x09, 0xf0, 0x75, 0x24, 0xf9,
-	0xdd, 0x37, 0x21, 0xeb, 0x0f, 0xc9, 0x8f, 0xb4, 0xcf, 0xf5, 0xc0, 0xea, 0x89, 0xff, 0x23, 0x27,
-	0x87, 0xda, 0xc3, 0xed, 0xf7, 0x86, 0xdb, 0x4f, 0xe7, 0xc5, 0xe2, 0x6f, 0xfe, 0x7f, 0x00, 0x00,
-	0x00, 0xff, 0xff, 0xf4, 0x83, 0x4a, 0x73, 0xe7, 0x3c, 0x00, 0x00,
+	// 4463 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x3c, 0x4b, 0x6c, 0x1b, 0x49,
+	0x76, 0xdb, 0xa4, 0x28, 0x8a, 0x8f, 0x1f, 0x49, 0xa5, 0x8f, 0xe9, 0xb6, 0x67, 0x2d, 0xd3, 0xe3,
+	0xbf, 0x4d, 0xad, 0x3c, 0x76, 0x3c, 0x63, 0x27, 0xe3, 0x91, 0x64, 0x8f, 0xad, 0xdd, 0xf1, 0xd8,
+	0xd3, 0xb2, 0x67, 0x07, 0x13, 0x6c, 0x1a, 0xed, 0x66, 0x89, 0x6a, 0xa8, 0xd9, 0xcd, 0xe9, 0x6a,
+	0xd2, 0x16, 0x36, 0x7b, 0xd8, 0x3d, 0x06, 0x49, 0xf6, 0xb0, 0x40, 0x90, 0x4b, 0x82, 0x00, 0x39,
+	0xe4, 0x83, 0x6c, 0x80, 0x60, 0x13, 0x24, 0x97, 0x04, 0x09, 0x72, 0xcc, 0x25, 0xc0, 0x1e, 0x82,
+	0x5c, 0x93, 0x9c, 0x02, 0xe4, 0x96, 0x49, 0x90, 0x39, 0x04, 0x8b, 0xfa, 0x35, 0xab, 0x9b, 0x4d,
+	0xb2, 0x69, 0xc9, 0x73, 0x63, 0xbf, 0x7a, 0xf5, 0xfa, 0xd5, 0xab, 0xf7, 0xab, 0x57, 0xaf, 0x09,
+	0x27, 0xfb, 0x1b, 0xeb, 0x7e, 0x60, 0xef, 0x63, 0x12, 0x06, 0x56, 0xe8, 0x07, 0x26, 0xe9, 0xdb,
+	0xcd, 0x6e, 0xe0, 0x87, 0x3e, 0x5a, 0xb2, 0xba, 0x4e, 0x53, 0x1d, 0x6b, 0xf6, 0x37, 0xf4, 0xd3,
+	0x6d, 0xdf, 0x6f, 0xbb, 0x78, 0xdd, 0xea, 0x3a, 0xeb, 0x96, 0xe7, 0xf9, 0xa1, 0x15, 0x3a, 0xbe,
+	0x47, 0xf8, 0x14, 0xfd, 0x94, 0x18, 0x65, 0x4f, 0x2f, 0x7a, 0x7b, 0xeb, 0xb8, 0xd3, 0x0d, 0x0f,
+	0xc5, 0xe0, 0x99, 0xe4, 0x60, 0xe8, 0x74, 0x30, 0x09, 0xad, 0x4e, 0x57, 0x20, 0x9c, 0x4c, 0x22,
+	0x58, 0x9e, 0x9c, 0xab, 0xd3, 0xf7, 0xd9, 0x7e, 0xa7, 0xe3, 0x7b, 0xeb, 0xfd, 0x0d, 0xf1, 0x2b,
+	0x7d, 0x0c, 0x07, 0x81, 0x1f, 0x48, 0x86, 0x4e, 0xf4, 0x2d, 0xd7, 0x69, 0x59, 0x21, 0x5e, 0x97,
+	0x3f, 0xf8, 0x40, 0xe3, 0xef, 0x73, 0xb0, 0xf4, 0x34, 0xf0, 0x6d, 0x4c, 0xc8, 0x27, 0x3d, 0x1c,
+	0x1c, 0x1a, 0xf8, 0x8b, 0x1e, 0x26, 0x21, 0x7a, 0x0b, 0x0a, 0x5f, 0xd0, 0xe7, 0xba, 0xb6, 0xa6,
+	0x5d, 0x2a, 0x6d, 0x15, 0xbf, 0xda, 0x9a, 0x09, 0x72, 0x0b, 0x9a, 0xc1, 0xa1, 0xe8, 0x2d, 0x00,
+	0x82, 0x09, 0x71, 0x7c, 0xcf, 0x74, 0x5a, 0xf5, 0x1c, 0xc5, 0x31, 0x4a, 0x02, 0xb2, 0xd3, 0x42,
+	0x27, 0xa0, 0xd8, 0x23, 0x38, 0xa0, 0x63, 0x79, 0x36, 0x36, 0x4b, 0x1f, 0x77, 0x5a, 0xe8, 0x01,
+	0x14, 0xfd, 0x2e, 0x93, 0x54, 0x7d, 0x66, 0x4d, 0xbb, 0x54, 0xbe, 0x71, 0xb5, 0x99, 0x22, 0xdd,
+	0x26, 0x63, 0x45, 0xb0, 0xe5, 0x78, 0xed, 0x27, 0x7c, 0x8a, 0x21, 0xe7, 0xa2, 0x27, 0x50, 0xb4,
+	0x7d, 0x2f, 0xc4, 0xaf, 0xc2, 0x7a, 0x61, 0x2d, 0x7f, 0xa9, 0x7c, 0xe3, 0x56, 0x2a, 0x99, 0x94,
+	0x85, 0x35, 0xb7, 0xf9, 0xbc, 0x07, 0x5e, 0x18, 0x1c, 0x1a, 0x92, 0x8a, 0x7e, 0x07, 0x2a, 0xea,
+	0x00, 0x5a, 0x80, 0xfc, 0x01, 0x16, 0x8b, 0x37, 0xe8, 0x4f, 0xb4, 0x0c, 0x85, 0xbe, 0xe5, 0xf6,
+	0xb0, 0x58, 0x2c, 0x7f, 0xb8, 0x93, 0x7b, 0x57, 0x6b, 0xfc, 0x3c, 0x0f, 0xab, 0xe9, 0x0c, 0xa3,
+	0x33, 0x50, 0x7e, 0xe9, 0x07, 0x07, 0x7b, 0xae, 0xff, 0x92, 0xca, 0x82, 0x93, 0x03, 0x09, 0xda,
+	0x69, 0xa1, 0xa7, 0x30, 0x6f, 0xf7, 0x48, 0xe8, 0x77, 0x4c, 0x09, 0x64, 0xf4, 0xcb, 0x37, 0x2e,
+	0xa6, 0x2e, 0xe8, 0xbb, 0x02, 0xe9, 0x3e, 0xde, 0x73, 0x3c, 0x87, 0xbe, 0xc3, 0xa8, 0xf1, 0xf9,
+	0x72, 0x04, 0x9d, 0x87, 0x1a, 0xf6, 0xac, 0x17, 0x2e, 0x36, 0x6d, 0xcb, 0xde, 0x77, 0xbc, 0x36,
+	0xdb, 0x81, 0x39, 0xa3, 0xca, 0xa1, 0xdb, 0x1c, 0x88, 0xde, 0x81, 0x79, 0xaa, 0x76, 0x7e, 0x2f,
+	0x34, 0x09, 0xb6, 0x7d, 0xaf, 0xc5, 0x37, 0xa4, 0xb0, 0x05, 0x5f, 0x6d, 0x15, 0xf5, 0xc2, 0x25,
+	0xad, 0xfe, 0x67, 0x39, 0xa3, 0x26, 0x50, 0x76, 0x39, 0x06, 0xba, 0x08, 0xf3, 0x82, 0xf6, 0x9e,
+	0xe5, 0xba, 0x2f, 0x2c, 0xfb, 0xa0, 0x5e, 0x60, 0xc4, 0xc5, 0x2b, 0x3f, 0x14, 0x50, 0xb4, 0x0f,
+	0xf3, 0x04, 0x07, 0x7d, 0xc7, 0xc6, 0xa6, 0xdc, 0xee, 0x59, 0xb6, 0x4f, 0xf7, 0xa6, 0xd8, 0xee,
+	0xe6, 0x2e, 0x27, 0x21, 0x1e, 0xf9, 0x8e, 0xd5, 0x48, 0x0c, 0xa8, 0x7f, 0x17, 0x96, 0x52, 0xd0,
+	0x52, 0xf6, 0xef, 0x8a, 0xba, 0x7f, 0xe5, 0x1b, 0xcb, 0x4d, 0x6e, 0x64, 0x4d, 0x69, 0x64, 0xcd,
+	0x4d, 0xef, 0x50, 0xdd, 0xd5, 0xff, 0xcf, 0xc1, 0x72, 0x5c, 0x7f, 0x48, 0xd7, 0xf7, 0x08, 0x46,
+	0x27, 0x61, 0x8e, 0xd9, 0xc0, 0x60, 0x43, 0x8b, 0xec, 0x79, 0xa7, 0x85, 0xce, 0x42, 0x65, 0xcf,
+	0xf1, 0x2c, 0xd7, 0xb4, 0x3c, 0xf2, 0x12, 0x07, 0x42, 0x55, 0xca, 0x0c, 0xb6, 0xc9, 0x40, 0xe8,
+	0x13, 0x58, 0x14, 0x3a, 0x67, 0xb6, 0x7c, 0xbb, 0xd7, 0xc1, 0x5e, 0x48, 0xea, 0x79, 0x26, 0x9b,
+	0xb7, 0x53, 0x65, 0x23, 0xd4, 0xf2, 0xbe, 0x40, 0x36, 0x16, 0xec, 0x38, 0x80, 0xa0, 0x47, 0x30,
+	0xd7, 0xc1, 0xa1, 0xd5, 0xb2, 0x42, 0x4b, 0x18, 0xd5, 0xb5, 0x2c, 0x52, 0x7e, 0x2c, 0xe6, 0x18,
+	0xd1, 0x6c, 0xf4, 0x0c, 0xe6, 0xf1, 0x2b, 0x6c, 0xf7, 0xa8, 0x20, 0xcd, 0x30, 0xb0, 0x6c, 0x5c,
+	0x2f, 0x8c, 0xb1, 0x52, 0xa9, 0x73, 0x0f, 0xe4, 0x9c, 0x67, 0x74, 0x8a, 0x51, 0xc3, 0xb1, 0x67,
+	0x74, 0x0b, 0x4a, 0xb6, 0x13, 0x5a, 0xaa, 0x1a, 0x9c, 0x60, 0xf4, 0x84, 0xf7, 0xa2, 0x8b, 0x14,
+	0xe3, 0xc6, 0x00, 0xb3, 0xf1, 0x4f, 0x39, 0x98, 0x4f, 0x2c, 0x9e, 0xda, 0x93, 0x94, 0x9a, 0x62,
+	0x4f, 0x12, 0xb4, 0xd3, 0xa2, 0x9b, 0x63, 0xef, 0xf7, 0xbc, 0x83, 0x81, 0x57, 0x2a, 0xb2, 0xe7,
+	0x9d, 0x16, 0x35, 0xe0, 0xd0, 0x09, 0x5d, 0x2c, 0x3c, 0x12, 0x7f, 0x40, 0x75, 0xe1, 0x49, 0xbc,
+	0xb0, 0x3e, 0x23, 0xf0, 0xf9, 0x23, 0x55, 0xf6, 0x00, 0xbb, 0xb8, 0x6f, 0x79, 0x36, 0x36, 0x89,
+	0xed, 0x07, 0x5c, 0x18, 0x39, 0xa3, 0x16, 0x81, 0x77, 0x29, 0x14, 0xad, 0xc2, 0x2c, 0xf1, 0x7b,
+	0x81, 0x8d, 0xeb, 0xb3, 0xdc, 0xd7, 0xf1, 0x27, 0xf4, 0xb1, 0xb2, 0x2f, 0x45, 0xb6, 0xec, 0x1b,
+	0x59, 0x76, 0xb8, 0x29, 0x37, 0x86, 0x2b, 0x7c, 0x44, 0x43, 0xbf, 0x0b, 0xd5, 0xd8, 0xd0, 0x54,
+	0x4e, 0xea, 0xbf, 0x0a, 0x70, 0x62, 0x84, 0x02, 0xa0, 0xdb, 0x50, 0x0f, 0xfd, 0xd0, 0x72, 0xcd,
+	0x6e, 0x34, 0x66, 0x52, 0xcb, 0x37, 0x3b, 0x84, 0x11, 0xcf, 0x1b, 0x2b, 0x6c, 0x7c, 0x30, 0xf5,
+	0x99, 0xd3, 0xc1, 0x8f, 0x09, 0xfa, 0x91, 0x06, 0x75, 0x69, 0xe7, 0x89, 0xb9, 0xa4, 0x9e, 0x63,
+	0x4b, 0x7e, 0x34, 0x8d, 0x2a, 0x4a, 0x8b, 0x8f, 0xbf, 0x48, 0x58, 0xfe, 0x2a, 0x49, 0x1d, 0x44,
+	0xe7, 0xa0, 0x1a, 0xf9, 0xd8, 0x1e, 0xc1, 0x32, 0xe2, 0x54, 0x24, 0xf0, 0x39, 0xc1, 0x2d, 0xf4,
+	0xae, 0x5c, 0x62, 0x64, 0x74, 0x26, 0xc1, 0x16, 0xe5, 0xaa, 0xc5, 0xfd, 0x9e, 0xb1, 0xca, 0xc6,
+	0x23, 0xab, 0xda, 0x15, 0xa3, 0xe8, 0x3a, 0xa0, 0xc1, 0x9c, 0x00, 0x87, 0xbd, 0xc0, 0xc3, 0x2d,
+	0xa6, 0x09, 0x05, 0x63, 0x31, 0x1a, 0x31, 0xc4, 0x00, 0xd5, 0x1a, 0xc1, 0x27, 0x31, 0x6d, 0xcb,
+	0x75, 0x71, 0x8b, 0xa9, 0x7c, 0x29, 0x72, 0x5c, 0x64, 0x9b, 0x41, 0xd1, 0xe7, 0x00, 0x2d, 0xfc,
+	0xa2, 0xd7, 0x36, 0x1d, 0x6f, 0xcf, 0x17, 0xfa, 0x71, 0x77, 0x2a, 0x61, 0xdd, 0xa7, 0xd3, 0x77,
+	0xbc, 0x3d, 0x9f, 0xcb, 0xa7, 0xd4, 0x92, 0xcf, 0xe8, 0x3d, 0x00, 0x12, 0x5a, 0x41, 0x88, 0x5b,
+	0xa6, 0x15, 0xd6, 0xe7, 0x98, 0x09, 0xeb, 0x43, 0x0e, 0xef, 0x99, 0x4c, 0x3b, 0x8c, 0x92, 0xc0,
+	0xde, 0x0c, 0xd1, 0xaf, 0x40, 0xc5, 0xf6, 0x3b, 0x5d, 0x17, 0x8b, 0xc9, 0xa5, 0x89, 0x93, 0xcb,
+	0x11, 0xfe, 0x66, 0xa8, 0xef, 0xc0, 0xa9, 0x31, 0x7b, 0x38, 0x49, 0x63, 0xf3, 0x8a, 0xc6, 0xea,
+	0xbf, 0x0c, 0xb5, 0xf8, 0x0a, 0xa7, 0xd2, 0xf7, 0x9f, 0xe4, 0x60, 0x51, 0x75, 0xdf, 0x0f, 0xfa,
+	0xd4, 0xa6, 0xc7, 0xf8, 0xee, 0x6d, 0x28, 0x05, 0x38, 0x0c, 0x1c, 0xdc, 0xb7, 0x5c, 0x11, 0x23,
+	0xce, 0x8d, 0xde, 0x0e, 0x43, 0xa2, 0x3e, 0xfa, 0x86, 0x31, 0x98, 0x87, 0xbe, 0x0d, 0x15, 0xee,
+	0xfa, 0xcd, 0x16, 0x76, 0x43, 0x8b, 0xa9, 0x62, 0xf9, 0xc6, 0xf9, 0xd1, 0x74, 0x78, 0x54, 0xb8,
+	0x4f, 0x91, 0x1f, 0x7d, 0xc3, 0x28, 0x5b, 0x83, 0x47, 0xb4, 0x03, 0xa5, 0x48, 0xb2, 0xc2, 0xaf,
+	0x5f, 0xce, 0x90, 0xe5, 0xf0, 0x28, 0x45, 0xd9, 0x8a, 0x66, 0x6f, 0x15, 0xa1, 0x80, 0xe9, 0xfa,
+	0x1b, 0xbf, 0xa7, 0x41, 0x2d, 0xce, 0x7f, 0x7a, 0x40, 0xd2, 0x8e, 0x14, 0x90, 0xc6, 0x19, 0x5b,
+	0x6e, 0x9c, 0xb1, 0x35, 0x2e, 0xc0, 0x42, 0x52, 0x2c, 0x08, 0xc1, 0x0c, 0x4b, 0xf4, 0xf8, 0x7e,
+	0xb1, 0xdf, 0x8d, 0xff, 0xcb, 0xc1, 0x2a, 0x8f, 0x3a, 0x58, 0x06, 0x21, 0x99, 0xb8, 0xde, 0x84,
+	0xd5, 0xc8, 0x1d, 0xb4, 0xa2, 0x34, 0x69, 0xb0, 0xe1, 0xcb, 0x2f, 0x87, 0x72, 0xa8, 0x9d, 0x16,
+	0x3a, 0x80, 0x05, 0xc7, 0xeb, 0xf6, 0x42, 0xb3, 0x6b, 0x05, 0x56, 0x07, 0x87, 0x38, 0x90, 0x0e,
+	0xec, 0x83, 0x54, 0x21, 0xa4, 0xbf, 0xbc, 0xb9, 0x43, 0x69, 0x3c, 0x8d, 0x48, 0x70, 0xc3, 0x9c,
+	0x77, 0xe2, 0x50, 0x74, 0x6f, 0x90, 0x04, 0x8f, 0x53, 0x90, 0x28, 0xac, 0x0e, 0xa5, 0xbf, 0x67,
+	0xa1, 0x32, 0x88, 0xd3, 0x4e, 0x4b, 0x44, 0xae, 0x72, 0x04, 0xdb, 0x69, 0xe9, 0x9f, 0xc1, 0x72,
+	0x1a, 0x33, 0xc7, 0x90, 0x18, 0xfd, 0x56, 0x1e, 0x16, 0x92, 0xac, 0x51, 0xb7, 0x97, 0x4c, 0x27,
+	0x35, 0xb6, 0xd3, 0xc9, 0x14, 0x92, 0xb2, 0xce, 0x53, 0x48, 0x6a, 0x35, 0x87, 0xec, 0xa5, 0x73,
+	0x46, 0x99, 0xc3, 0xa8, 0x56, 0x1e, 0xd2, 0x20, 0xdf, 0xb1, 0x5e, 0x99, 0xdc, 0xaa, 0xb8, 0x88,
+	0x0a, 0x06, 0x74, 0xac, 0x57, 0x5c, 0x69, 0x53, 0xd3, 0xd0, 0x99, 0xd4, 0x34, 0xf4, 0x26, 0xac,
+	0x0a, 0x44, 0xdb, 0x09, 0xec, 0x9e, 0x13, 0x9a, 0x2f, 0x02, 0x6c, 0x1d, 0xe0, 0x40, 0xa4, 0xad,
+	0xcb, 0x22, 0x27, 0xe6, 0x83, 0x5b, 0x7c, 0x0c, 0xed, 0xc3, 0xe2, 0x40, 0xba, 0xf2, 0x98, 0x31,
+	0x3b, 0xc6, 0x41, 0x27, 0xa5, 0x31, 0x00, 0xc4, 0x0e, 0x1b, 0x0b, 0x38, 0x01, 0xd6, 0xb7, 0x61,
+	0x25, 0x15, 0x75, 0x2a, 0x4f, 0xf7, 0x2f, 0x79, 0x38, 0x31, 0xa4, 0x8e, 0x22, 0x57, 0x4d, 0x2a,
+	0x8a, 0x36, 0xa4, 0x28, 0xe8, 0x36, 0xcc, 0x92, 0xd0, 0x0a, 0x7b, 0x84, 0x51, 0xae, 0xdd, 0x38,
+	0x93, 0x48, 0xcd, 0x06, 0x7e, 0x7c, 0x97, 0xa1, 0x19, 0x02, 0x1d, 0xed, 0x41, 0xcd, 0xef, 0x85,
+	0xd4, 0x66, 0x02, 0x4c, 0x7a, 0x6e, 0x94, 0xc6, 0xde, 0xcb, 0x66, 0x30, 0x9c, 0xc3, 0xe6, 0x13,
+	0x46, 0xc2, 0xe0, 0x14, 0xb8, 0x9c, 0xaa, 0xbe, 0x0a, 0x43, 0xdf, 0x1e, 0x4a, 0x6f, 0x9b, 0xd9,
+	0xb2, 0xd1, 0xaf, 0x2b, 0xc1, 0xd5, 0x3f, 0x05, 0x34, 0xbc, 0x8c, 0x63, 0xb0, 0xb4, 0x3f, 0xcd,
+	0xc3, 0xa9, 0x84, 0xdc, 0x36, 0xc9, 0xa1, 0x67, 0x1f, 0xcd, 0xd5, 0x75, 0x47, 0xba, 0xba, 0x07,
+	0x59, 0x76, 0x4e, 0xe5, 0xe0, 0x6b, 0xf4, 0x77, 0xb6, 0xb0, 0x69, 0xb3, 0x17, 0xb8, 0xd2, 0xdf,
+	0x49, 0xd8, 0xf3, 0xc0, 0x45, 0xe7, 0x61, 0xae, 0x1b, 0x38, 0x7e, 0xe0, 0x84, 0x87, 0x3c, 0x39,
+	0xdb, 0x2a, 0x7d, 0xb5, 0x35, 0xab, 0xcf, 0x5c, 0xd2, 0xea, 0x60, 0x44, 0x43, 0x6f, 0xd0, 0x2d,
+	0xfe, 0xaf, 0x06, 0xa7, 0xd3, 0x45, 0xf5, 0x35, 0xd8, 0xe2, 0x07, 0x70, 0x1a, 0x93, 0xd0, 0xe9,
+	0x58, 0x34, 0x6b, 0x13, 0x71, 0x9f, 0xbe, 0x46, 0xfa, 0x62, 0xee, 0x43, 0xf5, 0x08, 0x67, 0x3b,
+	0x42, 0x91, 0x7e, 0xf9, 0x3d, 0x00, 0x3b, 0xc0, 0x96, 0xc8, 0xfa, 0x66, 0x26, 0xa7, 0x8c, 0x02,
+	0x7b, 0x33, 0x6c, 0xfc, 0xa6, 0x06, 0xf5, 0x87, 0x38, 0x94, 0xab, 0x16, 0xac, 0x09, 0x1d, 0xbd,
+	0x94, 0x52, 0x01, 0x19, 0x54, 0x93, 0xd4, 0x52, 0xc8, 0x39, 0xa8, 0x3a, 0x9e, 0xed, 0xf6, 0x5a,
+	0x58, 0x58, 0x26, 0x0f, 0x0d, 0x15, 0x01, 0xe4, 0x67, 0xc9, 0xb3, 0x20, 0x9f, 0x4d, 0xd7, 0x6f,
+	0x13, 0x51, 0xdb, 0x28, 0x0b, 0xd8, 0x47, 0x7e, 0x9b, 0x34, 0x7e, 0x3c, 0x03, 0x27, 0x53, 0xd8,
+	0x11, 0xbb, 0x30, 0xb1, 0x22, 0xf3, 0xda, 0x7b, 0xa0, 0xfa, 0xa9, 0xfc, 0xf1, 0xfb, 0xa9, 0x99,
+	0xa3, 0x1f, 0xc4, 0x6f, 0xc2, 0x0c, 0x13, 0x1a, 0x2f, 0x99, 0xad, 0x8d, 0x25, 0xf5, 0x91, 0xdf,
+	0x36, 0x18, 0x36, 0xda, 0x1f, 0xf2, 0xf3, 0x3c, 0x16, 0x6e, 0xa6, 0xce, 0x1f, 0x29, 0xf9, 0xc9,
+	0x9e, 0xfe, 0x8d, 0xf9, 0xd1, 0x5f, 0x87, 0x95, 0x6d, 0x7a, 0x5c, 0x77, 0x93, 0xb9, 0x62, 0x76,
+	0xe5, 0x5c, 0x85, 0xd9, 0x00, 0x5b, 0xc4, 0xf7, 0x44, 0xfc, 0x15, 0x4f, 0xac, 0xe2, 0xe3, 0x07,
+	0x36, 0x2d, 0xb6, 0xd1, 0x17, 0x48, 0x7d, 0x64, 0x30, 0xfe, 0xce, 0xc6, 0xbf, 0x69, 0xb0, 0x9a,
+	0x7c, 0xfd, 0x1b, 0x57, 0xc6, 0x75, 0x58, 0xe2, 0x1c, 0xb9, 0xac, 0x9a, 0x62, 0x0a, 0xe6, 0xf9,
+	0xd1, 0x18, 0xa9, 0x43, 0x06, 0x5f, 0x08, 0x3d, 0xf7, 0x71, 0x68, 0x56, 0x0f, 0x50, 0x8e, 0xf0,
+	0x37, 0xc3, 0xc6, 0xff, 0xe4, 0xe0, 0xcc, 0x36, 0xf3, 0x08, 0x29, 0x25, 0x4a, 0x21, 0xed, 0x87,
+	0xf4, 0xc4, 0x2b, 0x81, 0x75, 0x6d, 0xba, 0x32, 0xa7, 0x32, 0x95, 0x0a, 0xbd, 0x63, 0x1d, 0x60,
+	0x1a, 0xf3, 0xac, 0x9e, 0x1b, 0xca, 0x1c, 0x92, 0xc2, 0xee, 0x73, 0x10, 0x3b, 0x34, 0x58, 0x6d,
+	0x9e, 0x92, 0xd0, 0x43, 0x83, 0xd5, 0x26, 0xe8, 0x8b, 0xa8, 0xd6, 0xaa, 0xe4, 0x13, 0xa3, 0x6b,
+	0x14, 0x13, 0x96, 0xd3, 0xdc, 0x66, 0xb4, 0xe2, 0xc5, 0x9a, 0x9a, 0x1d, 0x03, 0xd2, 0x32, 0xb9,
+	0xf4, 0xaa, 0x2f, 0x78, 0x5c, 0x2a, 0x45, 0x9e, 0x73, 0xeb, 0x50, 0xdf, 0x84, 0xa5, 0x14, 0x2a,
+	0x53, 0x65, 0x7f, 0xff, 0xad, 0xc1, 0xda, 0x68, 0x4e, 0x85, 0x9e, 0x9d, 0x83, 0x6a, 0x5a, 0x7e,
+	0x50, 0x69, 0xa9, 0x79, 0xc1, 0xa7, 0x80, 0x24, 0xaf, 0xca, 0x36, 0x4d, 0x59, 0x8d, 0x5e, 0x14,
+	0x24, 0x06, 0x20, 0x5a, 0x61, 0xeb, 0xe3, 0x80, 0x38, 0x91, 0xfa, 0xc9, 0xc7, 0xa3, 0xc4, 0x9c,
+	0x3d, 0x38, 0xad, 0x78, 0x9a, 0x61, 0x5d, 0xbb, 0x96, 0xba, 0xe2, 0x81, 0x6d, 0xc7, 0x97, 0xae,
+	0xb0, 0x98, 0x8b, 0xb1, 0xd8, 0xf8, 0x2b, 0x0d, 0xde, 0x1a, 0xf1, 0x22, 0x21, 0xdb, 0x63, 0xd3,
+	0xea, 0xef, 0x28, 0xf1, 0x83, 0x4b, 0x7d, 0x3d, 0x23, 0x99, 0xe1, 0x00, 0xd2, 0xf8, 0x3b, 0x0d,
+	0xbe, 0xf9, 0x91, 0x43, 0x52, 0x18, 0x8f, 0x22, 0xf3, 0x07, 0x00, 0x5d, 0xab, 0xed, 0x78, 0x96,
+	0xc2, 0xf8, 0x5a, 0xd2, 0xbf, 0x44, 0x08, 0x62, 0x96, 0xa1, 0xcc, 0x41, 0xeb, 0x50, 0xdc, 0x73,
+	0x5c, 0x25, 0x81, 0x5c, 0x49, 0x4c, 0xff, 0x90, 0x8d, 0x1a, 0x12, 0x0b, 0x5d, 0x86, 0x05, 0x11,
+	0xa9, 0xcd, 0x58, 0xa8, 0x9c, 0x33, 0xe6, 0x05, 0x5c, 0x2e, 0xa5, 0xf1, 0x33, 0x0d, 0xce, 0x8c,
+	0x5c, 0x80, 0x10, 0xfd, 0x63, 0x28, 0x0f, 0xe4, 0x27, 0x8b, 0x16, 0x57, 0x33, 0x0a, 0x8d, 0x96,
+	0x95, 0x0c, 0x75, 0x3e, 0xda, 0x8c, 0x09, 0x84, 0x6f, 0xc1, 0xd9, 0x31, 0x02, 0xe1, 0x5c, 0xa8,
+	0x12, 0x69, 0xfc, 0x2c, 0x0f, 0x67, 0x9e, 0x77, 0x5b, 0x63, 0xdd, 0xe0, 0x74, 0xaa, 0x19, 0x57,
+	0xaf, 0xdc, 0xeb, 0xab, 0xd7, 0x35, 0x69, 0xde, 0xa6, 0x87, 0x5f, 0x9a, 0xaa, 0x45, 0xce, 0x19,
+	0x0b, 0x7c, 0xe4, 0x63, 0xfc, 0xf2, 0x53, 0x61, 0x9a, 0x49, 0x17, 0x3b, 0x33, 0xda, 0xc5, 0x16,
+	0xc6, 0xbb, 0xd8, 0xd9, 0x31, 0x2e, 0x76, 0x82, 0xa8, 0xb2, 0xb8, 0xd8, 0xe3, 0xf2, 0xa1, 0xa3,
+	0x59, 0x99, 0xd2, 0x87, 0xf6, 0xba, 0xad, 0xa3, 0xfa, 0x50, 0x41, 0x22, 0xab, 0x0f, 0x95, 0x6f,
+	0xcc, 0xe6, 0x43, 0x05, 0xf6, 0x66, 0xd8, 0xf8, 0xc7, 0x3c, 0xa0, 0xe1, 0xd7, 0xa3, 0x53, 0x30,
+	0xe3, 0x59, 0x1d, 0x9c, 0x54, 0x4b, 0x06, 0x44, 0x6b, 0xd4, 0xe4, 0x88, 0x1d, 0x38, 0xdd, 0x70,
+	0xe0, 0x2d, 0x55, 0xd0, 0x18, 0x56, 0x6f, 0x43, 0x81, 0x84, 0xb8, 0x4b, 0x44, 0xd4, 0x3d, 0x3b,
+	0x56, 0x1e, 0xbb, 0x21, 0xee, 0x1a, 0x1c, 0x1f, 0x3d, 0x85, 0xaa, 0xed, 0x7b, 0x7b, 0x4e, 0xbb,
+	0x17, 0x70, 0xdb, 0xe4, 0x67, 0xf6, 0x2b, 0x63, 0x09, 0x6c, 0xab, 0x33, 0x8c, 0x38, 0x01, 0xd4,
+	0x01, 0x24, 0x34, 0x5b, 0x3d, 0x05, 0x73, 0x55, 0x7d, 0x3f, 0xe3, 0x3e, 0x35, 0x85, 0x21, 0x24,
+	0x8f, 0xbf, 0x8b, 0xad, 0x24, 0x5c, 0xff, 0x1c, 0x56, 0xd3, 0x91, 0x8f, 0x21, 0xb9, 0xfd, 0xf7,
+	0x02, 0x54, 0x54, 0xa1, 0xa1, 0x35, 0x28, 0x52, 0xb1, 0xa5, 0x78, 0x96, 0x59, 0x0a, 0xdf, 0x69,
+	0xa1, 0x53, 0x50, 0x62, 0x18, 0x6c, 0x9b, 0xf9, 0x16, 0xce, 0x51, 0xc0, 0xc7, 0x74, 0x87, 0xcf,
+	0x42, 0x45, 0x5e, 0xe9, 0xb0, 0x71, 0xbe, 0x89, 0x65, 0x01, 0x63, 0x28, 0xb4, 0x40, 0x87, 0xc3,
+	0x7d, 0xbf, 0xc5, 0x31, 0xf8, 0x69, 0x1c, 0x38, 0x88, 0x21, 0x7c, 0x46, 0x8f, 0x72, 0xf4, 0xc4,
+	0xd0, 0xb1, 0xba, 0x5d, 0x7a, 0x05, 0xcd, 0x4f, 0x1c, 0xef, 0x4c, 0xdc, 0x71, 0x5e, 0x50, 0x78,
+	0xcc, 0x67, 0x71, 0x71, 0x56, 0x1c, 0x05, 0x84, 0x7e, 0x35, 0x3a, 0x8c, 0x48, 0xd2, 0x7c, 0xd3,
+	0x6e, 0x4e, 0x26, 0xcd, 0x8f, 0x16, 0x31, 0xda, 0x55, 0x5f, 0x85, 0xa1, 0x87, 0x50, 0x66, 0x72,
+	0xe1, 0xba, 0x52, 0x2f, 0xb2, 0x0d, 0xb8, 0x90, 0x4a, 0x99, 0x52, 0x8c, 0x6b, 0x18, 0x90, 0x08,
+	0x44, 0xd3, 0xbe, 0x16, 0xee, 0x62, 0xaf, 0x45, 0x4c, 0xdf, 0xab, 0xcf, 0x31, 0x07, 0x59, 0x12,
+	0x90, 0x27, 0x34, 0xd2, 0x97, 0xe9, 0xa1, 0x9b, 0x69, 0x91, 0xe5, 0xd6, 0x4b, 0x63, 0x6a, 0xfb,
+	0xdb, 0x03, 0xbc, 0xe8, 0x70, 0x67, 0xa8, 0xb3, 0xd1, 0x77, 0xf8, 0x85, 0x0d, 0xf6, 0x08, 0xb7,
+	0x0d, 0x18, 0xe3, 0x6c, 0xb6, 0x15, 0xc4, 0x4d, 0x9b, 0xd1, 0x8a, 0x4d, 0xd6, 0x9f, 0xc3, 0xe2,
+	0xd0, 0x0e, 0x1c, 0x5d, 0x47, 0xf5, 0x0f, 0xe4, 0xc1, 0x6e, 0x02, 0xdd, 0xd1, 0x2e, 0xfa, 0x9f,
+	0xf3, 0xb0, 0x38, 0x24, 0xf3, 0xec, 0x55, 0xe7, 0xf3, 0x50, 0x63, 0xe5, 0x66, 0xd3, 0x0a, 0x43,
+	0xdc, 0xe9, 0x86, 0x44, 0xdc, 0x43, 0x54, 0x19, 0x74, 0x53, 0x00, 0x07, 0x68, 0x4c, 0x66, 0xb8,
+	0x7d, 0x28, 0xb4, 0x9f, 0xa3, 0xed, 0x0a, 0x60, 0xf6, 0xfa, 0xf3, 0x2e, 0xcc, 0x4b, 0x0c, 0xa9,
+	0x54, 0xe3, 0x5c, 0x97, 0x9c, 0x17, 0x57, 0xac, 0xda, 0x5e, 0x0c, 0x8c, 0x2e, 0xc0, 0x7c, 0xd0,
+	0xf3, 0x4c, 0xc7, 0x63, 0xae, 0xcb, 0x75, 0xb1, 0xcb, 0xee, 0x9d, 0xe7, 0x8c, 0x6a, 0xd0, 0xf3,
+	0x76, 0xbc, 0xa7, 0x02, 0x88, 0xbe, 0x07, 0x55, 0x11, 0x8b, 0x23, 0x7d, 0xa6, 0x96, 0xf2, 0x6e,
+	0x36, 0x7d, 0x16, 0xb1, 0x97, 0xc3, 0x84, 0x25, 0xda, 0x0a, 0x48, 0xbf, 0x07, 0x8b, 0x43, 0x28,
+	0x53, 0x6d, 0xe9, 0x4f, 0x35, 0x58, 0x4e, 0x53, 0x6f, 0x74, 0x9a, 0x5e, 0x7c, 0x09, 0xb8, 0x20,
+	0x35, 0x00, 0xd0, 0xe5, 0xf3, 0xb2, 0x06, 0x36, 0x9d, 0x3d, 0x33, 0x0c, 0x18, 0x69, 0x6a, 0x60,
+	0x55, 0x01, 0xde, 0xd9, 0x7b, 0x16, 0xf4, 0x30, 0xba, 0x04, 0x0b, 0x0a, 0xde, 0x9e, 0xe5, 0x12,
+	0x2c, 0x4e, 0x83, 0xb5, 0x08, 0xf1, 0x43, 0x0a, 0xa5, 0xbb, 0x1e, 0x91, 0x37, 0xc3, 0xc3, 0xae,
+	0xf4, 0x68, 0xd5, 0x08, 0xfa, 0xec, 0xb0, 0x8b, 0x1b, 0x7f, 0x93, 0x03, 0x34, 0x6c, 0x40, 0x43,
+	0xfe, 0x52, 0x9b, 0xe8, 0x2f, 0x73, 0x43, 0xfe, 0xf2, 0xd7, 0x92, 0xfe, 0x92, 0x57, 0xd2, 0xdf,
+	0xcb, 0x68, 0xc4, 0x13, 0xbd, 0xe6, 0xc5, 0x11, 0xcd, 0x3e, 0x49, 0x3b, 0x79, 0x43, 0xf6, 0xdf,
+	0xf8, 0x13, 0x0d, 0x56, 0x52, 0x95, 0x9b, 0x66, 0x55, 0x91, 0x85, 0x30, 0xd1, 0x8b, 0xac, 0x4a,
+	0x02, 0xa9, 0xe4, 0xd1, 0x5d, 0x88, 0x6c, 0xc0, 0x9c, 0xfc, 0xde, 0x88, 0xe0, 0xa7, 0x14, 0x15,
+	0x35, 0x61, 0xc9, 0x72, 0x43, 0x1c, 0xd0, 0x0c, 0xbd, 0x8f, 0x4d, 0x19, 0x1a, 0xb9, 0x61, 0x2f,
+	0x2a, 0x43, 0xbb, 0x2c, 0x38, 0x36, 0xfe, 0x3a, 0x0f, 0x2b, 0xa9, 0x39, 0x04, 0x2d, 0xb7, 0xb7,
+	0x5d, 0xff, 0x85, 0xe5, 0x9a, 0xe9, 0x4e, 0x67, 0x99, 0x8f, 0x3e, 0x8b, 0xbb, 0x9e, 0x3b, 0x70,
+	0x52, 0x38, 0x0b, 0x69, 0xae, 0x66, 0x54, 0x96, 0x13, 0x95, 0x8b, 0x13, 0x1c, 0x41, 0x5a, 0xee,
+	0xc0, 0x12, 0xae, 0x03, 0x8a, 0x70, 0x93, 0x3e, 0x69, 0x70, 0x47, 0x15, 0xf9, 0xa5, 0x4f, 0xa0,
+	0xc6, 0x9a, 0xfe, 0xcc, 0x7d, 0xcb, 0x6b, 0xb9, 0x54, 0x8f, 0x66, 0xc6, 0x78, 0x9b, 0x07, 0x14,
+	0xf5, 0x91, 0xc0, 0x94, 0x34, 0x8c, 0x2a, 0x56, 0xc1, 0xa8, 0x0d, 0xf3, 0x62, 0xcd, 0x04, 0x87,
+	0xa1, 0xe3, 0x45, 0xd5, 0xc3, 0xf7, 0xb3, 0x27, 0x5f, 0xcd, 0x87, 0x8c, 0xc2, 0xae, 0x20, 0x20,
+	0xd2, 0xf8, 0x76, 0x0c, 0x48, 0xd3, 0xf8, 0x14, 0xb4, 0xa9, 0x1c, 0xca, 0x5f, 0x68, 0xb0, 0x92,
+	0xba, 0x28, 0xa4, 0xc3, 0x5c, 0x24, 0x3d, 0x4d, 0xe6, 0x3b, 0x62, 0xec, 0x1a, 0x20, 0x7a, 0xdb,
+	0x28, 0x56, 0x29, 0x2f, 0x1d, 0x79, 0x78, 0x58, 0xe8, 0x58, 0xaf, 0x38, 0x57, 0xc3, 0x57, 0x8f,
+	0x81, 0x2f, 0x5c, 0x7f, 0x5e, 0x75, 0xfd, 0x86, 0x80, 0x32, 0xa7, 0x12, 0x38, 0xa1, 0x63, 0x53,
+	0xd1, 0x45, 0x59, 0x2f, 0x75, 0x2a, 0x02, 0x4a, 0xd5, 0x8d, 0x34, 0xfe, 0x38, 0x07, 0xab, 0xe9,
+	0xd5, 0xdb, 0x2c, 0xf7, 0x05, 0x8f, 0x44, 0xc2, 0xc2, 0x2a, 0xc4, 0xf2, 0x10, 0x7e, 0x71, 0xa4,
+	0x83, 0x8f, 0xbf, 0x80, 0x67, 0x2c, 0xec, 0x27, 0x41, 0x0f, 0xa1, 0x48, 0x7a, 0x9d, 0x8e, 0x15,
+	0x1c, 0x8a, 0xda, 0xf5, 0xf5, 0x6c, 0x85, 0xe6, 0x5d, 0x3e, 0xc9, 0x90, 0xb3, 0xd1, 0x67, 0xb0,
+	0xa4, 0x66, 0x14, 0x92, 0xb5, 0x99, 0xe9, 0x58, 0x43, 0x2a, 0x0d, 0xce, 0x62, 0xe3, 0xcb, 0x59,
+	0x40, 0xc3, 0xa8, 0xb4, 0xd5, 0x34, 0x96, 0xee, 0x66, 0xcb, 0x72, 0x07, 0x85, 0xd5, 0xfc, 0x74,
+	0x85, 0xd5, 0x78, 0x6b, 0xcd, 0xcc, 0x51, 0x5a, 0x6b, 0x0a, 0x53, 0xb5, 0xd6, 0xb0, 0xde, 0x37,
+	0x61, 0x51, 0xb4, 0x31, 0x6b, 0x96, 0xf5, 0xcb, 0x80, 0x04, 0x3d, 0x26, 0xe8, 0x39, 0x00, 0x8f,
+	0x22, 0x4a, 0xc7, 0xd9, 0x2f, 0x65, 0x94, 0x38, 0x0f, 0x21, 0xf7, 0xa3, 0x53, 0x76, 0xc9, 0x91,
+	0xcf, 0xe8, 0x33, 0x28, 0x8b, 0x94, 0x9b, 0xd1, 0x9d, 0x63, 0x74, 0x6f, 0x67, 0xa5, 0xcb, 0xf3,
+	0xbe, 0x01, 0x61, 0xf0, 0x23, 0x00, 0x75, 0xfe, 0xdc, 0x5f, 0x75, 0x30, 0x21, 0x56, 0x1b, 0xb3,
+	0x4c, 0xb8, 0x64, 0x54, 0x18, 0xf0, 0x31, 0x87, 0xd1, 0x65, 0xf3, 0x9c, 0xcc, 0xf6, 0x7b, 0x5e,
+	0xc8, 0xd2, 0xdb, 0x82, 0x01, 0x0c, 0xb4, 0x4d, 0x21, 0xe8, 0x1e, 0xcc, 0xb2, 0xe6, 0x16, 0x52,
+	0x2f, 0x67, 0x55, 0x32, 0xd6, 0x0c, 0x64, 0x88, 0x69, 0xe8, 0x36, 0x70, 0x72, 0xbc, 0x13, 0xab,
+	0xc2, 0x76, 0xa5, 0x9e, 0xd0, 0x07, 0xd6, 0x99, 0xc0, 0x4a, 0x46, 0xa5, 0x40, 0xfe, 0x44, 0x77,
+	0x61, 0x2e, 0x4a, 0x00, 0xab, 0x6c, 0x5a, 0x52, 0x8d, 0x64, 0xd0, 0x8b, 0x8a, 0x45, 0xd1, 0x04,
+	0xdd, 0x80, 0x5a, 0x5c, 0xe6, 0xc7, 0x90, 0x67, 0xef, 0xc2, 0x7c, 0x42, 0xde, 0xc7, 0x10, 0xbc,
+	0x69, 0xde, 0x33, 0x2c, 0x3d, 0x7a, 0xc6, 0x61, 0xf2, 0x53, 0xc3, 0x76, 0x89, 0x41, 0x58, 0xcc,
+	0xa6, 0x7b, 0xcb, 0x86, 0xe5, 0xde, 0xe6, 0xc4, 0xde, 0x52, 0xa0, 0xdc, 0xdb, 0xf7, 0x22, 0x1a,
+	0x8e, 0x38, 0x69, 0x4e, 0x30, 0x26, 0x4e, 0xdf, 0xe9, 0x60, 0x64, 0x41, 0x4d, 0xd2, 0x8f, 0xd5,
+	0xf2, 0xef, 0x64, 0xdc, 0xfd, 0xe6, 0x03, 0xce, 0x88, 0x5a, 0x5a, 0xaa, 0x62, 0x15, 0x46, 0x4f,
+	0x2d, 0xc3, 0x48, 0x53, 0x45, 0xa4, 0xbf, 0xcc, 0x43, 0x7d, 0x94, 0xcb, 0x44, 0x1f, 0x42, 0xcd,
+	0xef, 0x63, 0x1a, 0xf2, 0x4d, 0xe1, 0x8a, 0xb4, 0x6c, 0xae, 0xa8, 0x2a, 0xa6, 0xf1, 0x47, 0x6a,
+	0x20, 0xbc, 0xdb, 0x8a, 0x87, 0x19, 0x1e, 0xb9, 0x80, 0x81, 0x58, 0x8c, 0xa1, 0x55, 0x57, 0xd2,
+	0xb3, 0x29, 0x8d, 0xbd, 0x9e, 0xc4, 0xe2, 0x17, 0xc2, 0xf3, 0x03, 0x38, 0x47, 0xa5, 0xd7, 0x59,
+	0x96, 0x43, 0xaf, 0x80, 0x64, 0xcc, 0xa2, 0x68, 0x65, 0x0e, 0xe3, 0x28, 0xe7, 0xa0, 0x4a, 0x0e,
+	0x9c, 0x6e, 0x37, 0xc2, 0xe1, 0xad, 0x90, 0x15, 0x01, 0xe4, 0x48, 0xb7, 0xe0, 0x04, 0xe7, 0x49,
+	0xb9, 0xc5, 0x14, 0x0d, 0xa5, 0xdc, 0x6f, 0x2d, 0xb3, 0xe1, 0x81, 0xaf, 0xe0, 0xfd, 0xa4, 0xe7,
+	0x69, 0xa2, 0xe7, 0xb8, 0xbd, 0x00, 0xcb, 0x0b, 0xab, 0x22, 0xcf, 0xc4, 0x05, 0x54, 0xdc, 0x55,
+	0x5d, 0x85, 0xc5, 0x28, 0x3e, 0x44, 0x6c, 0xcc, 0xf1, 0x88, 0xad, 0x0c, 0x70, 0x56, 0x36, 0x60,
+	0x59, 0x2c, 0x49, 0x8d, 0x29, 0x84, 0xf9, 0x9a, 0x82, 0xb1, 0xc4, 0xc7, 0xd4, 0x9c, 0x9a, 0x34,
+	0x7e, 0x63, 0x06, 0x4e, 0x8e, 0xbc, 0xa5, 0x7d, 0xcd, 0xae, 0x8b, 0xcb, 0xb0, 0x10, 0xcd, 0x8a,
+	0xdf, 0x35, 0xcc, 0x4b, 0xb8, 0xac, 0xbd, 0x9e, 0x01, 0x11, 0xe4, 0x7d, 0xe5, 0x03, 0x0a, 0x90,
+	0xa0, 0x9d, 0x16, 0xfa, 0x22, 0xad, 0x41, 0x89, 0xab, 0xff, 0xfd, 0xe9, 0xae, 0x9c, 0xb3, 0x76,
+	0x2a, 0x25, 0xae, 0x6a, 0x0a, 0x53, 0x5c, 0xd5, 0x24, 0x22, 0xe6, 0xec, 0x51, 0x22, 0x66, 0x71,
+	0xba, 0x66, 0xd4, 0x63, 0x69, 0xaf, 0xfa, 0x83, 0x1c, 0x94, 0x95, 0x4b, 0x71, 0x9a, 0x56, 0xb8,
+	0x7e, 0xdb, 0x74, 0x71, 0x1f, 0xbb, 0x32, 0x99, 0x74, 0xfd, 0xf6, 0x47, 0xf4, 0x99, 0x16, 0x3f,
+	0xe3, 0xfe, 0x4e, 0x3e, 0xaa, 0x69, 0x4a, 0x3e, 0x96, 0xa6, 0xbc, 0x0b, 0xa5, 0xe8, 0xfb, 0x9f,
+	0x2c, 0xf9, 0x44, 0x84, 0x1c, 0x6b, 0x38, 0xe0, 0x49, 0x79, 0x73, 0xd2, 0x95, 0xfe, 0x9b, 0xe9,
+	0x2d, 0xff, 0x1d, 0x25, 0x89, 0x8d, 0x5f, 0xb0, 0x64, 0xab, 0x9a, 0x23, 0x51, 0x71, 0xe6, 0x84,
+	0x53, 0x0b, 0xcd, 0xf9, 0xb1, 0x85, 0xe6, 0x99, 0x78, 0xa1, 0xf9, 0x2d, 0x00, 0x87, 0x44, 0x57,
+	0x17, 0xbc, 0xd5, 0xaf, 0xe4, 0x10, 0x79, 0x71, 0x71, 0x0a, 0x4a, 0x0e, 0x31, 0x2d, 0x9b, 0x1e,
+	0xfa, 0x44, 0xe9, 0x64, 0xce, 0x21, 0x9b, 0xec, 0x39, 0x76, 0x0b, 0x57, 0x3c, 0xea, 0x2d, 0xdc,
+	0x97, 0x79, 0xd0, 0x47, 0x23, 0x26, 0x6e, 0x87, 0xb5, 0xc4, 0xed, 0x70, 0xc2, 0xe6, 0x72, 0x53,
+	0xda, 0x9c, 0x72, 0x2b, 0x90, 0x9f, 0xe2, 0x56, 0x80, 0x9d, 0x70, 0x14, 0xe7, 0xd2, 0x13, 0x1f,
+	0x46, 0x14, 0x94, 0x6e, 0x12, 0x9e, 0x77, 0xd1, 0xc2, 0x07, 0x0f, 0x1f, 0x66, 0x60, 0x85, 0xf2,
+	0xe3, 0x88, 0xb2, 0x80, 0x19, 0x56, 0x88, 0xd1, 0x06, 0xac, 0x58, 0xfd, 0xf6, 0x88, 0x20, 0x90,
+	0x33, 0x90, 0xd5, 0x6f, 0x27, 0x43, 0x80, 0xbc, 0x55, 0x2a, 0x2a, 0xb7, 0x4a, 0xee, 0xf0, 0xad,
+	0x12, 0xcf, 0x42, 0xb7, 0xa7, 0xdc, 0x9a, 0xaf, 0xeb, 0x42, 0x69, 0x9f, 0x35, 0x44, 0x89, 0x46,
+	0x78, 0xf2, 0x08, 0x5b, 0x6e, 0xb8, 0x2f, 0xaf, 0xff, 0x68, 0xfc, 0x54, 0xea, 0x45, 0xfc, 0xda,
+	0xb2, 0x64, 0x54, 0x94, 0x82, 0x11, 0x3b, 0x66, 0xca, 0x8b, 0xd2, 0x16, 0x0e, 0x2d, 0xc7, 0x25,
+	0xa2, 0x54, 0x50, 0x13, 0xe0, 0xfb, 0x1c, 0xda, 0xf8, 0x61, 0x1e, 0x4e, 0xa6, 0xbc, 0x4a, 0xdc,
+	0x59, 0x1d, 0x28, 0x1f, 0x23, 0xec, 0xb3, 0x21, 0x71, 0x49, 0xba, 0x35, 0xaa, 0x77, 0x27, 0x9d,
+	0x50, 0x33, 0x0e, 0x8e, 0x7f, 0x89, 0x25, 0x80, 0xe8, 0xc9, 0x50, 0x3e, 0xc3, 0x55, 0xf6, 0x52,
+	0xea, 0xbb, 0x9e, 0x70, 0x54, 0x3e, 0x37, 0x3d, 0xb1, 0xa1, 0xfa, 0xbf, 0x8f, 0xed, 0x83, 0xcc,
+	0x4a, 0x2c, 0xb0, 0x37, 0x43, 0xfd, 0x20, 0xfa, 0x2a, 0x4c, 0x65, 0x39, 0x65, 0x0f, 0xdf, 0x8f,
+	0x27, 0xc3, 0xe9, 0xbc, 0x0a, 0x52, 0x31, 0x5e, 0x95, 0xdd, 0xfe, 0x4f, 0x0d, 0x96, 0x52, 0x50,
+	0xb2, 0x54, 0x06, 0x57, 0x63, 0xfd, 0x3d, 0xa5, 0xe8, 0x94, 0x39, 0xf6, 0xbe, 0xcf, 0xb5, 0x48,
+	0x68, 0xb2, 0xb5, 0x66, 0x89, 0x17, 0x14, 0x7b, 0x9b, 0x22, 0xa3, 0x6d, 0x28, 0x4a, 0x65, 0x2a,
+	0x8c, 0xb9, 0x72, 0x88, 0x2d, 0x45, 0xe8, 0x99, 0x21, 0x67, 0x36, 0xfe, 0x21, 0x07, 0xcb, 0x69,
+	0x18, 0xb4, 0xde, 0x1a, 0x08, 0x75, 0x49, 0x7c, 0x3c, 0x54, 0x93, 0x70, 0x61, 0xe2, 0x43, 0xc7,
+	0xbe, 0x5c, 0xca, 0xb1, 0xcf, 0x81, 0x05, 0x29, 0x3c, 0xa5, 0x55, 0x60, 0x74, 0xe5, 0x29, 0x8d,
+	0x27, 0x09, 0x8c, 0xdb, 0xfb, 0x3c, 0x89, 0x43, 0x69, 0x3a, 0x69, 0xf5, 0x2d, 0xc7, 0x65, 0x65,
+	0x1d, 0x5e, 0x95, 0x95, 0xd5, 0x9a, 0x85, 0x68, 0xe0, 0x31, 0x87, 0xeb, 0x5b, 0xd1, 0xf2, 0x5f,
+	0xdf, 0x3d, 0xfc, 0xab, 0x06, 0x4b, 0x29, 0xfa, 0xaf, 0x68, 0x83, 0x16, 0xd3, 0x86, 0xcb, 0xb0,
+	0xc0, 0xad, 0xf7, 0xd0, 0x94, 0x36, 0x27, 0xd2, 0xfc, 0x79, 0x01, 0x97, 0xca, 0x4e, 0x33, 0x68,
+	0x71, 0x18, 0x90, 0x88, 0x3c, 0xd3, 0xaf, 0xf2, 0xf3, 0x80, 0x44, 0xdb, 0x80, 0xe5, 0x9e, 0x37,
+	0x58, 0x74, 0x84, 0xcc, 0x57, 0xbd, 0xa4, 0x8c, 0xa9, 0x94, 0xf9, 0xcb, 0x4c, 0x59, 0x28, 0xe2,
+	0xed, 0x4c, 0x55, 0x0e, 0x15, 0xa7, 0x9a, 0x1b, 0x7f, 0x5e, 0x83, 0xca, 0x13, 0x65, 0x6f, 0xd0,
+	0x0f, 0x35, 0xa8, 0xa8, 0x5f, 0xa8, 0xa0, 0x4b, 0x59, 0x3f, 0xd5, 0xd5, 0xb3, 0x7f, 0xee, 0xd2,
+	0x38, 0xfd, 0xa3, 0x9f, 0xff, 0xc7, 0x4f, 0x72, 0xab, 0x8d, 0x45, 0xfa, 0xe5, 0xb3, 0xf8, 0x14,
+	0x6d, 0x9d, 0x7d, 0xda, 0x73, 0x47, 0xbb, 0x82, 0xf6, 0x01, 0xa9, 0xb3, 0x76, 0xc3, 0x00, 0x5b,
+	0x9d, 0x29, 0x18, 0xb9, 0x30, 0x11, 0x93, 0x1d, 0x14, 0xbf, 0xa5, 0xa1, 0xdf, 0xd6, 0x60, 0x3e,
+	0xd1, 0x05, 0x8c, 0xae, 0x4e, 0xf1, 0x05, 0x89, 0x7e, 0x6d, 0x9a, 0xee, 0xf9, 0xc6, 0x1a, 0x5b,
+	0xb6, 0xde, 0x58, 0xa1, 0xcb, 0x96, 0xe7, 0x08, 0xb2, 0x2e, 0x2e, 0x38, 0xe8, 0xd2, 0xff, 0x50,
+	0x83, 0xe5, 0xb4, 0xb6, 0x64, 0xf4, 0xad, 0x69, 0x9b, 0xbd, 0xf5, 0x8d, 0x29, 0x66, 0x08, 0xfe,
+	0x2e, 0x30, 0xfe, 0xd6, 0xee, 0x68, 0x57, 0x1a, 0xa7, 0x52, 0x59, 0xbc, 0x6e, 0x31, 0x66, 0x7e,
+	0x5f, 0x83, 0xc5, 0xa1, 0xce, 0x51, 0x74, 0x3d, 0x6b, 0x87, 0x29, 0xe7, 0xaf, 0x39, 0x5d, 0x43,
+	0x6a, 0xe3, 0x0a, 0x63, 0xee, 0x6d, 0xd4, 0x88, 0x73, 0xf6, 0x7d, 0xa5, 0x25, 0xf3, 0x07, 0xeb,
+	0xc2, 0x04, 0x7f, 0x57, 0x83, 0x5a, 0xbc, 0x89, 0x13, 0xa5, 0x57, 0xd5, 0x53, 0x1b, 0x4d, 0xf5,
+	0xab, 0x99, 0x70, 0x05, 0x5f, 0xd7, 0x19, 0x5f, 0x17, 0x1b, 0x63, 0xf9, 0xe2, 0xcd, 0x97, 0x74,
+	0x87, 0x7f, 0xaa, 0x41, 0x7d, 0x54, 0x07, 0x20, 0xba, 0xf9, 0x3a, 0xad, 0x8d, 0xfa, 0xad, 0x29,
+	0x67, 0x09, 0xc6, 0xdf, 0x66, 0x8c, 0x7f, 0xb3, 0x71, 0x32, 0xce, 0xb8, 0xd2, 0x63, 0x45, 0xf9,
+	0xa5, 0x65, 0xfa, 0xd4, 0x96, 0x3a, 0xb4, 0x31, 0x69, 0x03, 0x87, 0x39, 0xbd, 0x31, 0xcd, 0x14,
+	0xc1, 0xe6, 0x06, 0x63, 0xf3, 0x2a, 0xba, 0x3c, 0x92, 0xcd, 0xf5, 0xef, 0xc7, 0x0e, 0x2d, 0x3f,
+	0x40, 0x7f, 0xa4, 0xc1, 0x89, 0x11, 0xdd, 0x68, 0x28, 0xbd, 0xab, 0x61, 0x7c, 0xf3, 0x9d, 0x7e,
+	0x73, 0xba, 0x49, 0x82, 0xf3, 0xb3, 0x8c, 0xf3, 0x53, 0x68, 0xb4, 0x80, 0xd1, 0xdf, 0x6a, 0x50,
+	0x1f, 0xd5, 0xcb, 0x34, 0x42, 0x1b, 0x26, 0x74, 0x61, 0xe9, 0xb7, 0xa6, 0x9c, 0x25, 0x98, 0xbd,
+	0xc9, 0x98, 0x6d, 0xea, 0xd9, 0xc5, 0x4c, 0xb5, 0xe3, 0xc7, 0xdc, 0x13, 0xc4, 0xb3, 0xb7, 0xd1,
+	0x9e, 0x20, 0x35, 0xc7, 0xd6, 0x9b, 0x59, 0xd1, 0x05, 0xab, 0xa7, 0x18, 0xab, 0x2b, 0x68, 0x89,
+	0xb2, 0x2a, 0x83, 0xe1, 0x3a, 0x0f, 0x6c, 0xe8, 0x7b, 0x50, 0xe6, 0xe8, 0x3c, 0x8b, 0x5a, 0x1d,
+	0x4a, 0xb6, 0x1e, 0xd0, 0xff, 0xf6, 0xd0, 0x1b, 0x89, 0xb2, 0x9d, 0x32, 0x27, 0x7a, 0x0f, 0x62,
+	0xef, 0xa9, 0x20, 0xa0, 0xef, 0xe1, 0xe4, 0xb7, 0xde, 0x81, 0xb4, 0xff, 0x17, 0x79, 0xaa, 0x7d,
+	0xae, 0x07, 0x56, 0x9b, 0xfd, 0xbd, 0x88, 0x3a, 0xb4, 0xde, 0xdf, 0xb8, 0xdb, 0xdf, 0x78, 0x31,
+	0xcb, 0x5e, 0xfe, 0xce, 0x2f, 0x06, 0x00, 0x28, 0x16, 0xd8, 0x32, 0xb6, 0x44, 0x00, 0x00,
 }
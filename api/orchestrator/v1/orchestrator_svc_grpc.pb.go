@@ -22,6 +22,7 @@ const _ = grpc.SupportPackageIsVersion9
 
 const (
 	Orchestrator_ProcessQuery_FullMethodName             = "/api.orchestrator.v1.Orchestrator/ProcessQuery"
+	Orchestrator_ProcessQueryStream_FullMethodName       = "/api.orchestrator.v1.Orchestrator/ProcessQueryStream"
 	Orchestrator_ExecuteWorkflow_FullMethodName          = "/api.orchestrator.v1.Orchestrator/ExecuteWorkflow"
 	Orchestrator_ExecuteWorkflowAsync_FullMethodName     = "/api.orchestrator.v1.Orchestrator/ExecuteWorkflowAsync"
 	Orchestrator_GetWorkflowStatus_FullMethodName        = "/api.orchestrator.v1.Orchestrator/GetWorkflowStatus"
@@ -42,6 +43,8 @@ const (
 type OrchestratorClient interface {
 	// 处理查询请求
 	ProcessQuery(ctx context.Context, in *ProcessQueryRequest, opts ...grpc.CallOption) (*ProcessQueryResponse, error)
+	// 流式处理查询：先返回检索到的文档，再返回生成中的答案片段，最后返回完整结果
+	ProcessQueryStream(ctx context.Context, in *ProcessQueryRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[ProcessQueryEvent], error)
 	// 执行工作流
 	ExecuteWorkflow(ctx context.Context, in *ExecuteWorkflowRequest, opts ...grpc.CallOption) (*ExecuteWorkflowResponse, error)
 	// 异步执行工作流
@@ -82,6 +85,25 @@ func (c *orchestratorClient) ProcessQuery(ctx context.Context, in *ProcessQueryR
 	return out, nil
 }
 
+func (c *orchestratorClient) ProcessQueryStream(ctx context.Context, in *ProcessQueryRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[ProcessQueryEvent], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &Orchestrator_ServiceDesc.Streams[0], Orchestrator_ProcessQueryStream_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[ProcessQueryRequest, ProcessQueryEvent]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type Orchestrator_ProcessQueryStreamClient = grpc.ServerStreamingClient[ProcessQueryEvent]
+
 func (c *orchestratorClient) ExecuteWorkflow(ctx context.Context, in *ExecuteWorkflowRequest, opts ...grpc.CallOption) (*ExecuteWorkflowResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(ExecuteWorkflowResponse)
@@ -190,6 +212,8 @@ func (c *orchestratorClient) HealthCheck(ctx context.Context, in *emptypb.Empty,
 type OrchestratorServer interface {
 	// 处理查询请求
 	ProcessQuery(context.Context, *ProcessQueryRequest) (*ProcessQueryResponse, error)
+	// 流式处理查询：先返回检索到的文档，再返回生成中的答案片段，最后返回完整结果
+	ProcessQueryStream(*ProcessQueryRequest, grpc.ServerStreamingServer[ProcessQueryEvent]) error
 	// 执行工作流
 	ExecuteWorkflow(context.Context, *ExecuteWorkflowRequest) (*ExecuteWorkflowResponse, error)
 	// 异步执行工作流
@@ -223,6 +247,9 @@ type UnimplementedOrchestratorServer struct{}
 func (UnimplementedOrchestratorServer) ProcessQuery(context.Context, *ProcessQueryRequest) (*ProcessQueryResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ProcessQuery not implemented")
 }
+func (UnimplementedOrchestratorServer) ProcessQueryStream(*ProcessQueryRequest, grpc.ServerStreamingServer[ProcessQueryEvent]) error {
+	return status.Errorf(codes.Unimplemented, "method ProcessQueryStream not implemented")
+}
 func (UnimplementedOrchestratorServer) ExecuteWorkflow(context.Context, *ExecuteWorkflowRequest) (*ExecuteWorkflowResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ExecuteWorkflow not implemented")
 }
@@ -292,6 +319,17 @@ func _Orchestrator_ProcessQuery_Handler(srv interface{}, ctx context.Context, de
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Orchestrator_ProcessQueryStream_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ProcessQueryRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(OrchestratorServer).ProcessQueryStream(m, &grpc.GenericServerStream[ProcessQueryRequest, ProcessQueryEvent]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type Orchestrator_ProcessQueryStreamServer = grpc.ServerStreamingServer[ProcessQueryEvent]
+
 func _Orchestrator_ExecuteWorkflow_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ExecuteWorkflowRequest)
 	if err := dec(in); err != nil {
@@ -524,6 +562,12 @@ var Orchestrator_ServiceDesc = grpc.ServiceDesc{
 			Handler:    _Orchestrator_HealthCheck_Handler,
 		},
 	},
-	Streams:  []grpc.StreamDesc{},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "ProcessQueryStream",
+			Handler:       _Orchestrator_ProcessQueryStream_Handler,
+			ServerStreams: true,
+		},
+	},
 	Metadata: "v1/orchestrator_svc.proto",
 }
@@ -24,17 +24,24 @@ import (
 
 // wireApp init kratos application.
 func wireApp(confServer *conf.Server, confData *conf.Data, logger log.Logger) (*kratos.App, func(), error) {
-	dataData, cleanup, err := data.NewData(confData, logger)
+	tokenizerRepo, err := data.NewTokenizerRepo(confData, logger)
 	if err != nil {
 		return nil, nil, err
 	}
-	greeterRepo := data.NewGreeterRepo(dataData, logger)
-	greeterUsecase := biz.NewGreeterUsecase(greeterRepo, logger)
-	greeterService := service.NewGreeterService(greeterUsecase)
-	grpcServer := server.NewGRPCServer(confServer, greeterService, logger)
-	httpServer := server.NewHTTPServer(confServer, greeterService, logger)
+	templateRepo, err := data.NewTemplateRepo(confData, logger)
+	if err != nil {
+		return nil, nil, err
+	}
+	assembleUsecase := biz.NewAssembleUsecase(tokenizerRepo, templateRepo, logger)
+	tokenUsecase := biz.NewTokenUsecase(tokenizerRepo, logger)
+	splitUsecase := biz.NewSplitUsecase(tokenizerRepo, logger)
+	contentGenerator := data.NewContentGenerator(logger)
+	optimizeUsecase := biz.NewOptimizeUsecase(contentGenerator, logger)
+	templateUsecase := biz.NewTemplateUsecase(templateRepo, logger)
+	assemblerService := service.NewAssemblerService(assembleUsecase, tokenUsecase, splitUsecase, optimizeUsecase, templateUsecase, logger)
+	grpcServer := server.NewGRPCServer(confServer, assemblerService, logger)
+	httpServer := server.NewHTTPServer(confServer, assemblerService, logger)
 	app := newApp(logger, grpcServer, httpServer)
 	return app, func() {
-		cleanup()
 	}, nil
 }
@@ -0,0 +1,275 @@
+package biz
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+
+	v1 "rag/api/assembler/v1"
+)
+
+// Arrangement type names accepted in ContentArrangementStrategy.arrangement_type.
+const (
+	ArrangeScoreDesc       = "score_desc"
+	ArrangeScoreAsc        = "score_asc"
+	ArrangeChronological   = "chronological"
+	ArrangeLogical         = "logical"
+	ArrangeDocumentGrouped = "document_grouped"
+	ArrangeLostInTheMiddle = "lost_in_the_middle"
+)
+
+const (
+	defaultSectionType     = "main_content"
+	sectionTypeMetadataKey = "section_type"
+	topicSimilarityMinimum = 0.3
+	mergedChunkSeparator   = "\n"
+)
+
+// arrangeFunc orders the selected chunks using their boosted scores
+type arrangeFunc func(chunks []*scoredChunk, boosted map[*scoredChunk]float64) []*scoredChunk
+
+var arrangeFuncs = map[string]arrangeFunc{
+	ArrangeScoreDesc:       arrangeScoreDesc,
+	ArrangeScoreAsc:        arrangeScoreAsc,
+	ArrangeChronological:   arrangeChronological,
+	ArrangeLogical:         arrangeLogical,
+	ArrangeDocumentGrouped: arrangeDocumentGrouped,
+	ArrangeLostInTheMiddle: arrangeLostInTheMiddle,
+}
+
+// contextBlock is a run of chunks rendered together in the context
+type contextBlock struct {
+	chunks []*scoredChunk
+}
+
+// arranger orders selected chunks according to a ContentArrangementStrategy
+type arranger struct {
+	strategy *v1.ContentArrangementStrategy
+	sections map[string]*v1.SectionPriority
+}
+
+func newArranger(strategy *v1.ContentArrangementStrategy) *arranger {
+	if strategy == nil {
+		strategy = &v1.ContentArrangementStrategy{}
+	}
+	sections := make(map[string]*v1.SectionPriority)
+	for _, sp := range strategy.SectionPriorities {
+		sections[sp.SectionType] = sp
+	}
+	return &arranger{strategy: strategy, sections: sections}
+}
+
+// arrange returns the chunks in context order and a warning when the
+// arrangement type is unknown.
+func (a *arranger) arrange(chosen []*scoredChunk) ([]*scoredChunk, string) {
+	var warning string
+	arrangeType := a.strategy.ArrangementType
+	if arrangeType == "" {
+		arrangeType = ArrangeScoreDesc
+	}
+	fn, ok := arrangeFuncs[arrangeType]
+	if !ok {
+		warning = fmt.Sprintf("unknown arrangement type %q, falling back to %s", arrangeType, ArrangeScoreDesc)
+		fn = arrangeScoreDesc
+	}
+
+	boosted := make(map[*scoredChunk]float64, len(chosen))
+	for _, c := range chosen {
+		boosted[c] = c.score() * (1 + float64(a.section(c).GetWeightBoost()))
+	}
+	arranged := make([]*scoredChunk, len(chosen))
+	copy(arranged, chosen)
+	// 同分时按章节优先级排序
+	sort.SliceStable(arranged, func(i, j int) bool {
+		return a.section(arranged[i]).GetPriority() > a.section(arranged[j]).GetPriority()
+	})
+	arranged = fn(arranged, boosted)
+
+	if a.strategy.GroupByTopic {
+		arranged = groupStable(arranged, topicKeys(arranged))
+	}
+	if a.strategy.GroupByDocument && arrangeType != ArrangeDocumentGrouped {
+		keys := make(map[*scoredChunk]string, len(arranged))
+		for _, c := range arranged {
+			keys[c] = c.chunk.DocumentId
+		}
+		arranged = groupStable(arranged, keys)
+	}
+	if a.strategy.MaintainContextFlow {
+		restoreDocumentFlow(arranged)
+	}
+	return arranged, warning
+}
+
+// section returns the configured priority for the chunk's section, if any
+func (a *arranger) section(c *scoredChunk) *v1.SectionPriority {
+	return a.sections[sectionType(c.chunk)]
+}
+
+// sectionType reads the chunk's section from its custom metadata
+func sectionType(chunk *v1.DocumentChunk) string {
+	if t := chunk.GetMetadata().GetCustomMetadata()[sectionTypeMetadataKey]; t != "" {
+		return t
+	}
+	return defaultSectionType
+}
+
+func arrangeScoreDesc(chunks []*scoredChunk, boosted map[*scoredChunk]float64) []*scoredChunk {
+	sort.SliceStable(chunks, func(i, j int) bool {
+		return boosted[chunks[i]] > boosted[chunks[j]]
+	})
+	return chunks
+}
+
+func arrangeScoreAsc(chunks []*scoredChunk, boosted map[*scoredChunk]float64) []*scoredChunk {
+	sort.SliceStable(chunks, func(i, j int) bool {
+		return boosted[chunks[i]] < boosted[chunks[j]]
+	})
+	return chunks
+}
+
+// arrangeChronological orders chunks by creation time; undated chunks go last
+func arrangeChronological(chunks []*scoredChunk, boosted map[*scoredChunk]float64) []*scoredChunk {
+	sort.SliceStable(chunks, func(i, j int) bool {
+		ti, tj := chunks[i].chunk.GetMetadata().GetCreatedAt(), chunks[j].chunk.GetMetadata().GetCreatedAt()
+		switch {
+		case ti == nil || tj == nil:
+			return ti != nil && tj == nil
+		case ti.Seconds != tj.Seconds:
+			return ti.Seconds < tj.Seconds
+		case ti.Nanos != tj.Nanos:
+			return ti.Nanos < tj.Nanos
+		}
+		return samePositionOrder(chunks[i], chunks[j])
+	})
+	return chunks
+}
+
+// arrangeLogical follows the reading order of the source: documents in the
+// order they were supplied, chunks by position within each document.
+func arrangeLogical(chunks []*scoredChunk, boosted map[*scoredChunk]float64) []*scoredChunk {
+	firstSeen := make(map[string]int)
+	for _, c := range chunks {
+		if o, ok := firstSeen[c.chunk.DocumentId]; !ok || c.order < o {
+			firstSeen[c.chunk.DocumentId] = c.order
+		}
+	}
+	sort.SliceStable(chunks, func(i, j int) bool {
+		di, dj := firstSeen[chunks[i].chunk.DocumentId], firstSeen[chunks[j].chunk.DocumentId]
+		if di != dj {
+			return di < dj
+		}
+		return samePositionOrder(chunks[i], chunks[j])
+	})
+	return chunks
+}
+
+// arrangeDocumentGrouped groups chunks by document, strongest document first
+func arrangeDocumentGrouped(chunks []*scoredChunk, boosted map[*scoredChunk]float64) []*scoredChunk {
+	chunks = arrangeScoreDesc(chunks, boosted)
+	keys := make(map[*scoredChunk]string, len(chunks))
+	for _, c := range chunks {
+		keys[c] = c.chunk.DocumentId
+	}
+	return groupStable(chunks, keys)
+}
+
+// arrangeLostInTheMiddle places the strongest chunks at both ends of the
+// context and the weakest in the middle, where models attend least.
+func arrangeLostInTheMiddle(chunks []*scoredChunk, boosted map[*scoredChunk]float64) []*scoredChunk {
+	ranked := arrangeScoreDesc(chunks, boosted)
+	front := make([]*scoredChunk, 0, len(ranked))
+	var back []*scoredChunk
+	for i, c := range ranked {
+		if i%2 == 0 {
+			front = append(front, c)
+		} else {
+			back = append(back, c)
+		}
+	}
+	for i := len(back) - 1; i >= 0; i-- {
+		front = append(front, back[i])
+	}
+	return front
+}
+
+// groupStable groups chunks sharing a key, keeping groups in order of first
+// appearance and chunks in their current order within each group.
+func groupStable(chunks []*scoredChunk, keys map[*scoredChunk]string) []*scoredChunk {
+	var order []string
+	groups := make(map[string][]*scoredChunk)
+	for _, c := range chunks {
+		key := keys[c]
+		if _, ok := groups[key]; !ok {
+			order = append(order, key)
+		}
+		groups[key] = append(groups[key], c)
+	}
+	grouped := make([]*scoredChunk, 0, len(chunks))
+	for _, key := range order {
+		grouped = append(grouped, groups[key]...)
+	}
+	return grouped
+}
+
+// topicKeys clusters chunks greedily: each chunk joins the first topic whose
+// seed chunk is similar enough, otherwise it seeds a new topic.
+func topicKeys(chunks []*scoredChunk) map[*scoredChunk]string {
+	keys := make(map[*scoredChunk]string, len(chunks))
+	var seeds []*scoredChunk
+	for _, c := range chunks {
+		for _, seed := range seeds {
+			if cosine(c.vector, seed.vector) >= topicSimilarityMinimum {
+				keys[c] = keys[seed]
+				break
+			}
+		}
+		if _, ok := keys[c]; !ok {
+			keys[c] = strconv.Itoa(len(seeds))
+			seeds = append(seeds, c)
+		}
+	}
+	return keys
+}
+
+// restoreDocumentFlow reorders each run of consecutive chunks from the same
+// document by their position in that document.
+func restoreDocumentFlow(chunks []*scoredChunk) {
+	for start := 0; start < len(chunks); {
+		end := start + 1
+		for end < len(chunks) && chunks[end].chunk.DocumentId == chunks[start].chunk.DocumentId {
+			end++
+		}
+		run := chunks[start:end]
+		sort.SliceStable(run, func(i, j int) bool {
+			return run[i].chunk.PositionInDocument < run[j].chunk.PositionInDocument
+		})
+		start = end
+	}
+}
+
+// mergeBlocks turns the arranged chunks into context blocks. With document
+// boundaries preserved, consecutive chunks of the same document at adjacent
+// positions are merged into one block.
+func mergeBlocks(chunks []*scoredChunk, preserveBoundaries bool) []*contextBlock {
+	var blocks []*contextBlock
+	for _, c := range chunks {
+		if preserveBoundaries && len(blocks) > 0 {
+			last := blocks[len(blocks)-1]
+			prev := last.chunks[len(last.chunks)-1].chunk
+			if prev.DocumentId == c.chunk.DocumentId && c.chunk.PositionInDocument == prev.PositionInDocument+1 {
+				last.chunks = append(last.chunks, c)
+				continue
+			}
+		}
+		blocks = append(blocks, &contextBlock{chunks: []*scoredChunk{c}})
+	}
+	return blocks
+}
+
+func samePositionOrder(a, b *scoredChunk) bool {
+	if a.chunk.DocumentId != b.chunk.DocumentId {
+		return a.order < b.order
+	}
+	return a.chunk.PositionInDocument < b.chunk.PositionInDocument
+}
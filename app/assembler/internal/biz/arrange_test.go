@@ -0,0 +1,102 @@
+package biz
+
+import (
+	"reflect"
+	"testing"
+
+	v1 "rag/api/assembler/v1"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// arrangePool is five chunks of three documents, supplied in score order
+func arrangePool() []*scoredChunk {
+	dated := func(c *scoredChunk, order int, seconds int64, section string) *scoredChunk {
+		c.order = order
+		c.chunk.Metadata = &v1.ChunkMetadata{CustomMetadata: map[string]string{}}
+		if seconds > 0 {
+			c.chunk.Metadata.CreatedAt = &timestamppb.Timestamp{Seconds: seconds}
+		}
+		if section != "" {
+			c.chunk.Metadata.CustomMetadata[sectionTypeMetadataKey] = section
+		}
+		return c
+	}
+	return []*scoredChunk{
+		dated(scored("a", "d1", 2, 0.9, "go channels"), 0, 30, ""),
+		dated(scored("b", "d2", 1, 0.7, "go select"), 1, 10, ""),
+		dated(scored("c", "d1", 1, 0.5, "go goroutines"), 2, 20, ""),
+		dated(scored("d", "d3", 1, 0.3, "appendix tables"), 3, 0, "appendix"),
+		dated(scored("e", "d2", 2, 0.1, "go timers"), 4, 5, ""),
+	}
+}
+
+func chunkIDs(chunks []*scoredChunk) []string {
+	ids := make([]string, len(chunks))
+	for i, c := range chunks {
+		ids[i] = c.chunk.ChunkId
+	}
+	return ids
+}
+
+func TestArrange(t *testing.T) {
+	tests := []struct {
+		name     string
+		strategy *v1.ContentArrangementStrategy
+		want     []string
+		warning  bool
+	}{
+		{"default", nil, []string{"a", "b", "c", "d", "e"}, false},
+		{"score_asc", &v1.ContentArrangementStrategy{ArrangementType: ArrangeScoreAsc}, []string{"e", "d", "c", "b", "a"}, false},
+		// 没有时间的分块排在最后
+		{"chronological", &v1.ContentArrangementStrategy{ArrangementType: ArrangeChronological}, []string{"e", "b", "c", "a", "d"}, false},
+		{"logical", &v1.ContentArrangementStrategy{ArrangementType: ArrangeLogical}, []string{"c", "a", "b", "e", "d"}, false},
+		{"document_grouped", &v1.ContentArrangementStrategy{ArrangementType: ArrangeDocumentGrouped}, []string{"a", "c", "b", "e", "d"}, false},
+		{"document_grouped with flow", &v1.ContentArrangementStrategy{ArrangementType: ArrangeDocumentGrouped, MaintainContextFlow: true}, []string{"c", "a", "b", "e", "d"}, false},
+		{"group_by_document", &v1.ContentArrangementStrategy{GroupByDocument: true}, []string{"a", "c", "b", "e", "d"}, false},
+		// 最强的分块放在两端，最弱的在中间
+		{"lost_in_the_middle", &v1.ContentArrangementStrategy{ArrangementType: ArrangeLostInTheMiddle}, []string{"a", "c", "e", "d", "b"}, false},
+		{"section boost", &v1.ContentArrangementStrategy{SectionPriorities: []*v1.SectionPriority{{SectionType: "appendix", WeightBoost: 3}}}, []string{"d", "a", "b", "c", "e"}, false},
+		{"unknown", &v1.ContentArrangementStrategy{ArrangementType: "random"}, []string{"a", "b", "c", "d", "e"}, true},
+	}
+	for _, tt := range tests {
+		arranged, warning := newArranger(tt.strategy).arrange(arrangePool())
+		if got := chunkIDs(arranged); !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("%s: %v, want %v", tt.name, got, tt.want)
+		}
+		if (warning != "") != tt.warning {
+			t.Errorf("%s: warning %q", tt.name, warning)
+		}
+	}
+}
+
+func TestArrangeSectionPriorityBreaksTies(t *testing.T) {
+	pool := []*scoredChunk{scored("body", "d1", 1, 0.5, "x"), scored("summary", "d2", 1, 0.5, "y")}
+	pool[1].chunk.Metadata = &v1.ChunkMetadata{CustomMetadata: map[string]string{sectionTypeMetadataKey: "summary"}}
+	strategy := &v1.ContentArrangementStrategy{SectionPriorities: []*v1.SectionPriority{{SectionType: "summary", Priority: 1}}}
+	arranged, _ := newArranger(strategy).arrange(pool)
+	if got := chunkIDs(arranged); !reflect.DeepEqual(got, []string{"summary", "body"}) {
+		t.Errorf("arranged = %v, want summary first", got)
+	}
+}
+
+func TestMergeBlocks(t *testing.T) {
+	arranged, _ := newArranger(&v1.ContentArrangementStrategy{ArrangementType: ArrangeLogical}).arrange(arrangePool())
+	tests := []struct {
+		preserve bool
+		want     [][]string
+	}{
+		// 同一文档相邻位置的分块合并为一块
+		{true, [][]string{{"c", "a"}, {"b", "e"}, {"d"}}},
+		{false, [][]string{{"c"}, {"a"}, {"b"}, {"e"}, {"d"}}},
+	}
+	for _, tt := range tests {
+		var got [][]string
+		for _, block := range mergeBlocks(arranged, tt.preserve) {
+			got = append(got, chunkIDs(block.chunks))
+		}
+		if !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("preserve %t: %v, want %v", tt.preserve, got, tt.want)
+		}
+	}
+}
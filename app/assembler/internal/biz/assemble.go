@@ -0,0 +1,275 @@
+package biz
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	v1 "rag/api/assembler/v1"
+	commonv1 "rag/api/common/v1"
+	"rag/pkg/tokenizer"
+
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/golang/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const (
+	defaultMaxContextLength = 4000
+	chunkSeparator          = "\n\n"
+	unknownDocumentType     = "unknown"
+)
+
+var (
+	// ErrEmptyQuery is returned when the assembly query is empty.
+	ErrEmptyQuery = errors.BadRequest(commonv1.ErrorCode_ERROR_CODE_INVALID_QUERY.String(), "query must not be empty")
+	// ErrNoChunks is returned when there is nothing to assemble.
+	ErrNoChunks = errors.BadRequest(commonv1.ErrorCode_ERROR_CODE_BAD_REQUEST.String(), "chunks must not be empty")
+)
+
+// AssembleUsecase handles context assembly business logic
+type AssembleUsecase struct {
+	tokenizers TokenizerRepo
+	templates  TemplateRepo
+	log        *log.Helper
+}
+
+// NewAssembleUsecase creates a new assemble usecase
+func NewAssembleUsecase(tokenizers TokenizerRepo, templates TemplateRepo, logger log.Logger) *AssembleUsecase {
+	return &AssembleUsecase{
+		tokenizers: tokenizers,
+		templates:  templates,
+		log:        log.NewHelper(logger),
+	}
+}
+
+// AssembleContext selects chunks into the token budget, arranges them and
+// joins them into a single context, rendered through the template sections
+// when a template is given.
+func (uc *AssembleUsecase) AssembleContext(ctx context.Context, req *v1.AssembleContextRequest) (*v1.AssembleContextResponse, error) {
+	return uc.assemble(ctx, req, newAssemblyCache())
+}
+
+// assemble builds a context, taking chunk tokens and features from cache
+func (uc *AssembleUsecase) assemble(ctx context.Context, req *v1.AssembleContextRequest, cache *assemblyCache) (*v1.AssembleContextResponse, error) {
+	startTime := time.Now()
+	if strings.TrimSpace(req.Query) == "" {
+		return nil, ErrEmptyQuery
+	}
+	if len(req.Chunks) == 0 {
+		return nil, ErrNoChunks
+	}
+
+	options := req.Options
+	if options == nil {
+		options = &v1.AssemblyOptions{}
+	}
+	budget := int(options.MaxContextLength)
+	if budget <= 0 {
+		budget = defaultMaxContextLength
+	}
+	tok, err := uc.tokenizers.GetTokenizer(ctx, options.TokenizerName)
+	if err != nil {
+		return nil, err
+	}
+	// 模板分节占用的 token 从预算中扣除
+	var tmpl *contextTemplate
+	if req.Template != nil {
+		if tmpl, err = uc.loadContextTemplate(ctx, req.Template, req.Query); err != nil {
+			return nil, err
+		}
+	}
+	if tmpl != nil {
+		budget = tmpl.reserve(ctx, tok, budget)
+	}
+	strategy := selectionStrategy(options)
+	uc.log.WithContext(ctx).Infof("Assembling %d chunks with strategy %s and budget %d", len(req.Chunks), strategy.StrategyType, budget)
+
+	var warnings []string
+	stats := &v1.AssemblyStatistics{
+		ChunksByDocumentType: make(map[string]int32),
+	}
+	candidates, candidateTokens := prepareCandidates(tok, cache, req.Chunks, options, budget, stats, &warnings)
+
+	selectFn, ok := selectionFuncs[strategy.StrategyType]
+	if !ok {
+		warnings = append(warnings, fmt.Sprintf("unknown selection strategy %q, falling back to %s", strategy.StrategyType, StrategyTopK))
+		selectFn = selectTopK
+	}
+	sepTokens := cache.countTokens(tok, chunkSeparator)
+
+	// 优先文档类型先参与选择，其余分块在剩余预算内选择
+	var priority, regular []*scoredChunk
+	for _, c := range candidates {
+		if c.priority {
+			priority = append(priority, c)
+		} else {
+			regular = append(regular, c)
+		}
+	}
+	sel := newSelection(budget, int(strategy.MaxChunks), sepTokens)
+	selectFn(sel, priority, strategy)
+	selectFn(sel, regular, strategy)
+	chosen, warning := newArranger(options.ArrangementStrategy).arrange(sel.chosen)
+	if warning != "" {
+		warnings = append(warnings, warning)
+	}
+	blocks := mergeBlocks(chosen, options.PreserveDocumentBoundaries)
+
+	builder := newContextBuilder(options.IncludeMetadata, options.IncludeSourceCitations)
+	usedChunks := make([]*v1.UsedChunk, 0, len(chosen))
+	var usedTokens int
+	var scoreSum float32
+	for i, block := range blocks {
+		builder.writeBlock(block)
+		for _, c := range block.chunks {
+			usedChunks = append(usedChunks, &v1.UsedChunk{
+				ChunkId:           c.chunk.ChunkId,
+				DocumentId:        c.chunk.DocumentId,
+				PositionInContext: int32(i + 1),
+				TokenCount:        int32(c.tokens),
+				RelevanceScore:    c.chunk.RelevanceScore,
+				UsageReason:       c.reason,
+			})
+			usedTokens += c.tokens
+			scoreSum += c.chunk.RelevanceScore
+			stats.ChunksByDocumentType[documentType(c.chunk)]++
+		}
+	}
+	assembled := builder.String()
+	citations := builder.citations
+	if tmpl != nil {
+		rendered, err := tmpl.render(ctx, tok, assembled, &warnings)
+		if err != nil {
+			return nil, err
+		}
+		citations = relocateCitations(citations, assembled, rendered, &warnings)
+		assembled = rendered
+	}
+	totalTokens := countTokens(tok, assembled)
+
+	if candidateTokens > 0 {
+		stats.ContentCompressionRatio = float32(usedTokens) / float32(candidateTokens)
+	}
+	var avgScore float32
+	if len(chosen) > 0 {
+		avgScore = scoreSum / float32(len(chosen))
+	} else {
+		warnings = append(warnings, "no chunks fit into the context budget")
+	}
+	stats.AssemblyTimeMs = time.Since(startTime).Milliseconds()
+
+	return &v1.AssembleContextResponse{
+		AssembledContext: assembled,
+		ContextMetadata: &v1.ContextMetadata{
+			TotalTokens:       int32(totalTokens),
+			ChunksUsed:        int32(len(chosen)),
+			ChunksFiltered:    int32(len(req.Chunks) - len(chosen)),
+			AvgRelevanceScore: avgScore,
+			TokenizerUsed:     tok.Name(),
+			Statistics:        stats,
+			AssembledAt:       timestamppb.Now(),
+		},
+		UsedChunks: usedChunks,
+		Warnings:   warnings,
+		Citations:  citations,
+	}, nil
+}
+
+// prepareCandidates drops empty chunks, counts tokens, orders the chunks by
+// relevance and removes duplicates, keeping the highest-scoring chunk of each
+// group. It returns the candidates and their total tokens.
+func prepareCandidates(tok tokenizer.Tokenizer, cache *assemblyCache, chunks []*v1.DocumentChunk, options *v1.AssemblyOptions, budget int, stats *v1.AssemblyStatistics, warnings *[]string) ([]*scoredChunk, int) {
+	priorityTypes := make(map[string]bool)
+	for _, t := range options.GetSelectionStrategy().GetPriorityDocumentTypes() {
+		priorityTypes[t] = true
+	}
+
+	// 引用标记同样占用预算，按最大编号估算
+	var markerTokens int
+	if options.IncludeSourceCitations {
+		markerTokens = cache.countTokens(tok, citationMarker(len(chunks)))
+	}
+
+	var scored []*scoredChunk
+	for i, chunk := range chunks {
+		features := cache.chunkFeatures(chunk.Content)
+		if features.normalized == "" {
+			stats.LowQualityChunksFiltered++
+			continue
+		}
+		scored = append(scored, &scoredChunk{
+			chunk:    chunk,
+			order:    i,
+			priority: priorityTypes[chunk.GetMetadata().GetDocumentType()],
+			vector:   features.vector,
+			features: features,
+		})
+	}
+	sort.SliceStable(scored, func(i, j int) bool {
+		return scored[i].chunk.RelevanceScore > scored[j].chunk.RelevanceScore
+	})
+
+	dedup := newDeduplicator(options.Deduplication, warnings)
+	candidates := scored[:0]
+	var total int
+	for _, c := range scored {
+		if !dedup.offer(c) {
+			stats.DuplicateChunksRemoved++
+			continue
+		}
+		c.tokens = cache.chunkTokens(tok, c.chunk, options.IncludeMetadata) + markerTokens
+		if c.tokens > budget {
+			*warnings = append(*warnings, fmt.Sprintf("chunk %s exceeds the context budget (%d > %d tokens)", c.chunk.ChunkId, c.tokens, budget))
+		}
+		total += c.tokens
+		candidates = append(candidates, c)
+	}
+	if len(dedup.removed) > 0 {
+		*warnings = append(*warnings, "removed duplicate chunks: "+strings.Join(dedup.removed, ", "))
+	}
+	return candidates, total
+}
+
+// selectionStrategy returns the request strategy with defaults filled in
+func selectionStrategy(options *v1.AssemblyOptions) *v1.ContentSelectionStrategy {
+	strategy := &v1.ContentSelectionStrategy{}
+	if options.SelectionStrategy != nil {
+		strategy = proto.Clone(options.SelectionStrategy).(*v1.ContentSelectionStrategy)
+	}
+	if strategy.StrategyType == "" {
+		strategy.StrategyType = StrategyTopK
+	}
+	// 未设置冗余阈值时沿用 diversity_threshold
+	if strategy.RedundancyThreshold <= 0 && options.DiversityThreshold > 0 {
+		strategy.RedundancyThreshold = options.DiversityThreshold
+	}
+	return strategy
+}
+
+// renderChunk formats a chunk for the context, prefixing its title when
+// metadata is requested.
+func renderChunk(chunk *v1.DocumentChunk, includeMetadata bool) string {
+	content := strings.TrimSpace(chunk.Content)
+	if !includeMetadata || chunk.Title == "" {
+		return content
+	}
+	if content == "" {
+		return "[" + chunk.Title + "]"
+	}
+	return "[" + chunk.Title + "]\n" + content
+}
+
+func documentType(chunk *v1.DocumentChunk) string {
+	if t := chunk.GetMetadata().GetDocumentType(); t != "" {
+		return t
+	}
+	return unknownDocumentType
+}
+
+func normalizeWhitespace(s string) string {
+	return strings.Join(strings.Fields(s), " ")
+}
@@ -0,0 +1,123 @@
+package biz
+
+import (
+	"context"
+	"fmt"
+	"runtime"
+	"time"
+
+	v1 "rag/api/assembler/v1"
+	commonv1 "rag/api/common/v1"
+
+	"github.com/go-kratos/kratos/v2/errors"
+	"golang.org/x/sync/errgroup"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const (
+	// 单个批次允许的最大请求数
+	maxBatchRequests = 100
+	// 并发构建数上限，实际取 GOMAXPROCS 与该值的较小者
+	maxBatchConcurrency = 8
+)
+
+var (
+	// ErrEmptyBatch is returned when a batch has no requests.
+	ErrEmptyBatch = errors.BadRequest(commonv1.ErrorCode_ERROR_CODE_BAD_REQUEST.String(), "requests must not be empty")
+	// ErrBatchTooLarge is returned when a batch has more requests than allowed.
+	ErrBatchTooLarge = errors.BadRequest(commonv1.ErrorCode_ERROR_CODE_BAD_REQUEST.String(), fmt.Sprintf("a batch holds at most %d requests", maxBatchRequests))
+)
+
+// AssembleBatchContexts assembles the contexts of a batch concurrently on a
+// bounded pool. Requests share one cache, so chunks referenced by several
+// requests are tokenized and normalized once. A failing request is reported
+// in its own result and does not fail the batch.
+func (uc *AssembleUsecase) AssembleBatchContexts(ctx context.Context, req *v1.AssembleBatchContextsRequest) (*v1.AssembleBatchContextsResponse, error) {
+	startTime := time.Now()
+	if len(req.Requests) == 0 {
+		return nil, ErrEmptyBatch
+	}
+	if len(req.Requests) > maxBatchRequests {
+		return nil, ErrBatchTooLarge
+	}
+	batchID := req.BatchId
+	if batchID == "" {
+		batchID = fmt.Sprintf("batch-%d", startTime.UnixNano())
+	}
+	workers := min(len(req.Requests), maxBatchConcurrency, runtime.GOMAXPROCS(0))
+	uc.log.WithContext(ctx).Infof("Assembling batch %s of %d requests with %d workers", batchID, len(req.Requests), workers)
+
+	cache := newAssemblyCache()
+	results := make([]*v1.BatchContextResult, len(req.Requests))
+	g := new(errgroup.Group)
+	g.SetLimit(workers)
+	for i, item := range req.Requests {
+		requestID := item.RequestId
+		if requestID == "" {
+			requestID = fmt.Sprintf("request_%d", i+1)
+		}
+		result := &v1.BatchContextResult{RequestId: requestID}
+		results[i] = result
+		g.Go(func() error {
+			uc.assembleBatchItem(ctx, item.ContextRequest, cache, result)
+			return nil
+		})
+	}
+	_ = g.Wait()
+
+	metadata := &v1.BatchAssemblyMetadata{
+		TotalRequests: int32(len(results)),
+		StartedAt:     timestamppb.New(startTime),
+	}
+	for _, result := range results {
+		if result.Status == commonv1.ProcessingStatus_PROCESSING_STATUS_COMPLETED {
+			metadata.SuccessfulAssemblies++
+		} else {
+			metadata.FailedAssemblies++
+		}
+	}
+	completedAt := time.Now()
+	metadata.TotalProcessingTimeMs = completedAt.Sub(startTime).Milliseconds()
+	metadata.CompletedAt = timestamppb.New(completedAt)
+
+	hits, misses := cache.stats()
+	uc.log.WithContext(ctx).Infof("Batch %s done: %d succeeded, %d failed, cache hits %d misses %d",
+		batchID, metadata.SuccessfulAssemblies, metadata.FailedAssemblies, hits, misses)
+	return &v1.AssembleBatchContextsResponse{
+		BatchId:  batchID,
+		Results:  results,
+		Metadata: metadata,
+	}, nil
+}
+
+// assembleBatchItem assembles one request of a batch into result, turning
+// errors and panics into a failed status.
+func (uc *AssembleUsecase) assembleBatchItem(ctx context.Context, req *v1.AssembleContextRequest, cache *assemblyCache, result *v1.BatchContextResult) {
+	defer func() {
+		if r := recover(); r != nil {
+			uc.log.WithContext(ctx).Errorf("Batch request %s panicked: %v", result.RequestId, r)
+			result.ContextResponse = nil
+			result.Status = commonv1.ProcessingStatus_PROCESSING_STATUS_FAILED
+			result.ErrorMessage = fmt.Sprintf("internal error: %v", r)
+		}
+	}()
+	// 批次被取消时未开始的请求不再构建
+	if err := ctx.Err(); err != nil {
+		result.Status = commonv1.ProcessingStatus_PROCESSING_STATUS_CANCELLED
+		result.ErrorMessage = err.Error()
+		return
+	}
+	if req == nil {
+		result.Status = commonv1.ProcessingStatus_PROCESSING_STATUS_FAILED
+		result.ErrorMessage = "context_request is required"
+		return
+	}
+	resp, err := uc.assemble(ctx, req, cache)
+	if err != nil {
+		result.Status = commonv1.ProcessingStatus_PROCESSING_STATUS_FAILED
+		result.ErrorMessage = errors.FromError(err).GetMessage()
+		return
+	}
+	result.ContextResponse = resp
+	result.Status = commonv1.ProcessingStatus_PROCESSING_STATUS_COMPLETED
+}
@@ -0,0 +1,160 @@
+package biz
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+	"testing"
+
+	v1 "rag/api/assembler/v1"
+	commonv1 "rag/api/common/v1"
+	"rag/pkg/tokenizer"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+// countingTokenizer records how often each text is encoded
+type countingTokenizer struct {
+	tokenizer.Tokenizer
+	mu      sync.Mutex
+	encoded map[string]int
+}
+
+func (t *countingTokenizer) Encode(text string, addSpecial bool) []tokenizer.Token {
+	t.mu.Lock()
+	t.encoded[text]++
+	t.mu.Unlock()
+	return t.Tokenizer.Encode(text, addSpecial)
+}
+
+// panickingTokenizer panics on texts containing "boom"
+type panickingTokenizer struct {
+	tokenizer.Tokenizer
+}
+
+func (t panickingTokenizer) Encode(text string, addSpecial bool) []tokenizer.Token {
+	if strings.Contains(text, "boom") {
+		panic("tokenizer exploded")
+	}
+	return t.Tokenizer.Encode(text, addSpecial)
+}
+
+type fakeTokenizerRepo struct {
+	tok tokenizer.Tokenizer
+}
+
+func (r *fakeTokenizerRepo) GetTokenizer(ctx context.Context, name string) (tokenizer.Tokenizer, error) {
+	return r.tok, nil
+}
+
+func TestAssembleBatchSharesCache(t *testing.T) {
+	tok := &countingTokenizer{Tokenizer: tokenizer.NewHeuristic(), encoded: make(map[string]int)}
+	uc := NewAssembleUsecase(&fakeTokenizerRepo{tok: tok}, nil, log.DefaultLogger)
+	chunks := []*v1.DocumentChunk{
+		{ChunkId: "c1", DocumentId: "d1", Content: "Go channels connect goroutines.", RelevanceScore: 0.9},
+		{ChunkId: "c2", DocumentId: "d2", Content: "Select waits on several channels.", RelevanceScore: 0.8},
+	}
+	request := func(query string) *v1.BatchContextRequest {
+		return &v1.BatchContextRequest{ContextRequest: &v1.AssembleContextRequest{Query: query, Chunks: chunks}}
+	}
+	resp, err := uc.AssembleBatchContexts(context.Background(), &v1.AssembleBatchContextsRequest{
+		Requests: []*v1.BatchContextRequest{request("channels"), request("select"), request(" "), {RequestId: "empty"}},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	statuses := make([]commonv1.ProcessingStatus, len(resp.Results))
+	for i, result := range resp.Results {
+		statuses[i] = result.Status
+	}
+	completed, failed := commonv1.ProcessingStatus_PROCESSING_STATUS_COMPLETED, commonv1.ProcessingStatus_PROCESSING_STATUS_FAILED
+	if statuses[0] != completed || statuses[1] != completed || statuses[2] != failed || statuses[3] != failed {
+		t.Errorf("statuses = %v", statuses)
+	}
+	if resp.Results[0].RequestId != "request_1" || resp.Results[3].RequestId != "empty" {
+		t.Errorf("request ids = %s, %s", resp.Results[0].RequestId, resp.Results[3].RequestId)
+	}
+	if md := resp.Metadata; md.SuccessfulAssemblies != 2 || md.FailedAssemblies != 2 {
+		t.Errorf("metadata = %+v", md)
+	}
+	// 两个请求引用相同的分块，每个分块只计数一次
+	for _, chunk := range chunks {
+		if n := tok.encoded[renderChunk(chunk, false)]; n != 1 {
+			t.Errorf("chunk %s encoded %d times, want once", chunk.ChunkId, n)
+		}
+	}
+}
+
+func TestAssembleBatchCancelled(t *testing.T) {
+	uc := NewAssembleUsecase(&fakeTokenizerRepo{tok: tokenizer.NewHeuristic()}, nil, log.DefaultLogger)
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	resp, err := uc.AssembleBatchContexts(ctx, &v1.AssembleBatchContextsRequest{
+		Requests: []*v1.BatchContextRequest{{ContextRequest: &v1.AssembleContextRequest{Query: "q"}}},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if status := resp.Results[0].Status; status != commonv1.ProcessingStatus_PROCESSING_STATUS_CANCELLED {
+		t.Errorf("status = %v, want cancelled", status)
+	}
+
+	if _, err := uc.AssembleBatchContexts(context.Background(), &v1.AssembleBatchContextsRequest{}); err != ErrEmptyBatch {
+		t.Errorf("empty batch = %v, want ErrEmptyBatch", err)
+	}
+}
+
+func TestAssembleBatchKeepsOrder(t *testing.T) {
+	uc := NewAssembleUsecase(&fakeTokenizerRepo{tok: panickingTokenizer{tokenizer.NewHeuristic()}}, nil, log.DefaultLogger)
+	completed, failed := commonv1.ProcessingStatus_PROCESSING_STATUS_COMPLETED, commonv1.ProcessingStatus_PROCESSING_STATUS_FAILED
+
+	// 请求数多于并发数，失败的请求穿插其中
+	var requests []*v1.BatchContextRequest
+	var want []commonv1.ProcessingStatus
+	for i := 0; i < 3*maxBatchConcurrency; i++ {
+		content := fmt.Sprintf("topic%d appears in chunk %d", i, i)
+		status := completed
+		switch i % 4 {
+		case 1:
+			content, status = "boom goes the tokenizer", failed
+		case 2:
+			status = failed
+		}
+		req := &v1.BatchContextRequest{RequestId: fmt.Sprintf("r%d", i)}
+		if i%4 != 2 {
+			req.ContextRequest = &v1.AssembleContextRequest{
+				Query:  fmt.Sprintf("topic%d", i),
+				Chunks: []*v1.DocumentChunk{{ChunkId: "c", DocumentId: "d", Content: content, RelevanceScore: 0.9}},
+			}
+		}
+		requests = append(requests, req)
+		want = append(want, status)
+	}
+
+	resp, err := uc.AssembleBatchContexts(context.Background(), &v1.AssembleBatchContextsRequest{Requests: requests})
+	if err != nil {
+		t.Fatal(err)
+	}
+	for i, result := range resp.Results {
+		if result.RequestId != requests[i].RequestId || result.Status != want[i] {
+			t.Errorf("result %d = %s %v, want %s %v", i, result.RequestId, result.Status, requests[i].RequestId, want[i])
+			continue
+		}
+		if result.Status == completed && !strings.Contains(result.ContextResponse.AssembledContext, fmt.Sprintf("topic%d ", i)) {
+			t.Errorf("result %d holds the context of another request: %q", i, result.ContextResponse.AssembledContext)
+		}
+		if result.Status == failed && result.ErrorMessage == "" {
+			t.Errorf("result %d failed without a message", i)
+		}
+	}
+	if md := resp.Metadata; md.SuccessfulAssemblies != int32(len(requests)/2) || md.FailedAssemblies != int32(len(requests)/2) {
+		t.Errorf("metadata = %+v", md)
+	}
+
+	tooLarge := make([]*v1.BatchContextRequest, maxBatchRequests+1)
+	if _, err := uc.AssembleBatchContexts(context.Background(), &v1.AssembleBatchContextsRequest{Requests: tooLarge}); err != ErrBatchTooLarge {
+		t.Errorf("oversized batch = %v, want ErrBatchTooLarge", err)
+	}
+}
@@ -0,0 +1,107 @@
+package biz
+
+import (
+	"sync"
+
+	v1 "rag/api/assembler/v1"
+	"rag/pkg/tokenizer"
+)
+
+// assemblyCache memoizes per-chunk work so that the requests of a batch that
+// reference the same chunks tokenize and normalize them only once. It is safe
+// for concurrent use.
+type assemblyCache struct {
+	mu       sync.Mutex
+	tokens   map[tokenKey]int
+	features map[string]*chunkFeatures
+	hits     int
+	misses   int
+}
+
+// tokenKey identifies a text counted with a tokenizer
+type tokenKey struct {
+	tokenizer string
+	text      string
+}
+
+// chunkFeatures is what deduplication and similarity need from chunk content.
+// Near-duplicate signatures are computed on first use.
+type chunkFeatures struct {
+	content string
+	// 空白归一化后的内容，用于精确去重
+	normalized string
+	vector     termVector
+
+	minHashOnce sync.Once
+	minHash     []uint64
+	simHashOnce sync.Once
+	simHash     uint64
+}
+
+func newAssemblyCache() *assemblyCache {
+	return &assemblyCache{
+		tokens:   make(map[tokenKey]int),
+		features: make(map[string]*chunkFeatures),
+	}
+}
+
+// countTokens returns the number of tokens in text, without special tokens
+func (c *assemblyCache) countTokens(tok tokenizer.Tokenizer, text string) int {
+	if text == "" {
+		return 0
+	}
+	key := tokenKey{tokenizer: tok.Name() + "@" + tok.Version(), text: text}
+	c.mu.Lock()
+	n, ok := c.tokens[key]
+	if ok {
+		c.hits++
+	}
+	c.mu.Unlock()
+	if ok {
+		return n
+	}
+	// 计算在锁外进行，并发请求同一文本时可能重复计算，结果一致
+	n = countTokens(tok, text)
+	c.mu.Lock()
+	c.tokens[key] = n
+	c.misses++
+	c.mu.Unlock()
+	return n
+}
+
+// chunkTokens returns the tokens a chunk occupies once rendered, counted
+// with tok. The token count in the chunk metadata is ignored: it may come
+// from a different tokenizer.
+func (c *assemblyCache) chunkTokens(tok tokenizer.Tokenizer, chunk *v1.DocumentChunk, includeMetadata bool) int {
+	return c.countTokens(tok, renderChunk(chunk, includeMetadata))
+}
+
+// chunkFeatures returns the normalized content and term vector of content
+func (c *assemblyCache) chunkFeatures(content string) *chunkFeatures {
+	c.mu.Lock()
+	f, ok := c.features[content]
+	if ok {
+		c.hits++
+	}
+	c.mu.Unlock()
+	if ok {
+		return f
+	}
+	f = &chunkFeatures{
+		content:    content,
+		normalized: normalizeWhitespace(content),
+		vector:     newTermVector(content),
+	}
+	c.mu.Lock()
+	c.features[content] = f
+	c.misses++
+	c.mu.Unlock()
+	return f
+}
+
+// stats returns the cache hits and misses so far
+func (c *assemblyCache) stats() (hits, misses int) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.hits, c.misses
+}
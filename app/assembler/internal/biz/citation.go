@@ -0,0 +1,72 @@
+package biz
+
+import (
+	"fmt"
+	"strings"
+	"unicode/utf8"
+
+	commonv1 "rag/api/common/v1"
+)
+
+// citationMarker returns the numbered marker appended after cited content
+func citationMarker(n int) string {
+	return fmt.Sprintf(" [%d]", n)
+}
+
+// contextBuilder writes context blocks and records where each chunk lands,
+// measuring offsets in characters so clients can slice the context directly.
+type contextBuilder struct {
+	sb               strings.Builder
+	length           int
+	includeMetadata  bool
+	includeCitations bool
+	citations        []*commonv1.Citation
+}
+
+func newContextBuilder(includeMetadata, includeCitations bool) *contextBuilder {
+	return &contextBuilder{
+		includeMetadata:  includeMetadata,
+		includeCitations: includeCitations,
+	}
+}
+
+func (b *contextBuilder) write(s string) {
+	b.sb.WriteString(s)
+	b.length += utf8.RuneCountInString(s)
+}
+
+// writeBlock appends a block, titled by its first chunk when metadata is
+// requested, with a citation marker after every chunk when citations are on.
+func (b *contextBuilder) writeBlock(block *contextBlock) {
+	if b.length > 0 {
+		b.write(chunkSeparator)
+	}
+	if title := block.chunks[0].chunk.Title; b.includeMetadata && title != "" {
+		b.write("[" + title + "]\n")
+	}
+	for i, c := range block.chunks {
+		if i > 0 {
+			b.write(mergedChunkSeparator)
+		}
+		start := b.length
+		b.write(strings.TrimSpace(c.chunk.Content))
+		if !b.includeCitations {
+			continue
+		}
+		n := len(b.citations) + 1
+		b.citations = append(b.citations, &commonv1.Citation{
+			CitationNumber: int32(n),
+			ChunkId:        c.chunk.ChunkId,
+			DocumentId:     c.chunk.DocumentId,
+			Title:          c.chunk.Title,
+			SourceUrl:      c.chunk.GetMetadata().GetSourceUrl(),
+			StartOffset:    int32(start),
+			EndOffset:      int32(b.length),
+		})
+		b.write(citationMarker(n))
+	}
+}
+
+func (b *contextBuilder) String() string {
+	return b.sb.String()
+}
@@ -0,0 +1,283 @@
+package biz
+
+import (
+	"encoding/binary"
+	"fmt"
+	"hash/fnv"
+	"math"
+	"math/bits"
+	"strings"
+
+	v1 "rag/api/assembler/v1"
+)
+
+// Deduplication methods accepted in DeduplicationOptions.method.
+const (
+	DedupMinHash = "minhash"
+	DedupSimHash = "simhash"
+	DedupExact   = "exact"
+	DedupNone    = "none"
+)
+
+const (
+	defaultSimilarityThreshold = 0.8
+	defaultSimHashDistance     = 3
+	defaultEmbeddingThreshold  = 0.95
+	// 128 个哈希分成 32 段、每段 4 行，Jaccard 约 0.42 以上的分块会进入比较
+	minHashPermutations = 128
+	minHashBands        = 32
+	minHashRows         = minHashPermutations / minHashBands
+	shingleSize         = 3
+)
+
+// minHashSeeds are the seeds of the hash functions that stand in for random
+// permutations. They are fixed so signatures are stable across processes.
+var minHashSeeds = func() []uint64 {
+	seeds := make([]uint64, minHashPermutations)
+	state := uint64(0x9e3779b97f4a7c15)
+	for i := range seeds {
+		state += 0x9e3779b97f4a7c15
+		seeds[i] = mix64(state)
+	}
+	return seeds
+}()
+
+// minHashSignature returns the MinHash signature over word shingles of the
+// content; texts shorter than a shingle hash their words instead.
+func (f *chunkFeatures) minHashSignature() []uint64 {
+	f.minHashOnce.Do(func() {
+		terms := splitTerms(f.content)
+		size := min(shingleSize, len(terms))
+		sig := make([]uint64, minHashPermutations)
+		for i := range sig {
+			sig[i] = math.MaxUint64
+		}
+		for start := 0; size > 0 && start+size <= len(terms); start++ {
+			h := hashString(strings.Join(terms[start:start+size], " "))
+			for i, seed := range minHashSeeds {
+				if v := mix64(h ^ seed); v < sig[i] {
+					sig[i] = v
+				}
+			}
+		}
+		f.minHash = sig
+	})
+	return f.minHash
+}
+
+// simHashFingerprint returns the 64-bit SimHash of the term frequencies
+func (f *chunkFeatures) simHashFingerprint() uint64 {
+	f.simHashOnce.Do(func() {
+		var weights [64]float64
+		for term, tf := range f.vector.terms {
+			h := hashString(term)
+			for bit := 0; bit < 64; bit++ {
+				if h&(1<<bit) != 0 {
+					weights[bit] += tf
+				} else {
+					weights[bit] -= tf
+				}
+			}
+		}
+		var fp uint64
+		for bit, w := range weights {
+			if w > 0 {
+				fp |= 1 << bit
+			}
+		}
+		f.simHash = fp
+	})
+	return f.simHash
+}
+
+// deduplicator removes exact and near duplicates. Candidates must be offered
+// in descending relevance so the representative kept for each group of
+// duplicates is its highest-scoring chunk.
+type deduplicator struct {
+	method         string
+	threshold      float64
+	maxDistance    int
+	useEmbeddings  bool
+	embeddingLimit float64
+
+	exact   map[string]*scoredChunk
+	kept    []*scoredChunk
+	buckets []map[uint64][]*scoredChunk
+	removed []string
+}
+
+func newDeduplicator(options *v1.DeduplicationOptions, warnings *[]string) *deduplicator {
+	d := &deduplicator{
+		method:         options.GetMethod(),
+		threshold:      float64(options.GetSimilarityThreshold()),
+		maxDistance:    int(options.GetSimhashMaxDistance()),
+		useEmbeddings:  options.GetUseEmbeddings(),
+		embeddingLimit: float64(options.GetEmbeddingThreshold()),
+		exact:          make(map[string]*scoredChunk),
+	}
+	switch d.method {
+	case "":
+		d.method = DedupMinHash
+	case DedupMinHash, DedupSimHash, DedupExact, DedupNone:
+	default:
+		*warnings = append(*warnings, fmt.Sprintf("unknown deduplication method %q, falling back to %s", d.method, DedupMinHash))
+		d.method = DedupMinHash
+	}
+	if d.threshold <= 0 {
+		d.threshold = defaultSimilarityThreshold
+	}
+	if d.maxDistance <= 0 {
+		d.maxDistance = defaultSimHashDistance
+	}
+	if d.embeddingLimit <= 0 {
+		d.embeddingLimit = defaultEmbeddingThreshold
+	}
+	if d.method == DedupMinHash {
+		d.buckets = make([]map[uint64][]*scoredChunk, minHashBands)
+		for i := range d.buckets {
+			d.buckets[i] = make(map[uint64][]*scoredChunk)
+		}
+	}
+	return d
+}
+
+// offer reports whether c is kept, recording it as a representative if so
+func (d *deduplicator) offer(c *scoredChunk) bool {
+	if d.method == DedupNone {
+		return true
+	}
+	if rep, ok := d.exact[c.features.normalized]; ok {
+		d.remove(c, rep, "exact duplicate")
+		return false
+	}
+	if rep, reason := d.nearDuplicate(c); rep != nil {
+		// 之后与该分块完全相同的内容同样归入这个代表
+		d.exact[c.features.normalized] = rep
+		d.remove(c, rep, reason)
+		return false
+	}
+
+	d.exact[c.features.normalized] = c
+	d.kept = append(d.kept, c)
+	if d.method == DedupMinHash && len(c.features.vector.terms) > 0 {
+		for band, key := range bandKeys(c.features.minHashSignature()) {
+			d.buckets[band][key] = append(d.buckets[band][key], c)
+		}
+	}
+	return true
+}
+
+// nearDuplicate returns the most similar representative c duplicates, if any
+func (d *deduplicator) nearDuplicate(c *scoredChunk) (*scoredChunk, string) {
+	// 没有词项的内容只做精确去重
+	method := d.method
+	if len(c.features.vector.terms) == 0 {
+		method = DedupExact
+	}
+	switch method {
+	case DedupMinHash:
+		sig := c.features.minHashSignature()
+		var (
+			best  *scoredChunk
+			score float64
+		)
+		seen := make(map[*scoredChunk]bool)
+		for band, key := range bandKeys(sig) {
+			for _, rep := range d.buckets[band][key] {
+				if seen[rep] {
+					continue
+				}
+				seen[rep] = true
+				if s := jaccardEstimate(sig, rep.features.minHashSignature()); s >= d.threshold && s > score {
+					best, score = rep, s
+				}
+			}
+		}
+		if best != nil {
+			return best, fmt.Sprintf("minhash similarity %.2f", score)
+		}
+	case DedupSimHash:
+		fp := c.features.simHashFingerprint()
+		for _, rep := range d.kept {
+			if len(rep.features.vector.terms) == 0 {
+				continue
+			}
+			if dist := bits.OnesCount64(fp ^ rep.features.simHashFingerprint()); dist <= d.maxDistance {
+				return rep, fmt.Sprintf("simhash distance %d", dist)
+			}
+		}
+	}
+
+	if d.useEmbeddings && len(c.chunk.Embedding) > 0 {
+		for _, rep := range d.kept {
+			if sim := embeddingCosine(c.chunk.Embedding, rep.chunk.Embedding); sim >= d.embeddingLimit {
+				return rep, fmt.Sprintf("embedding similarity %.2f", sim)
+			}
+		}
+	}
+	return nil, ""
+}
+
+func (d *deduplicator) remove(c, rep *scoredChunk, reason string) {
+	d.removed = append(d.removed, fmt.Sprintf("%s (%s of %s)", c.chunk.ChunkId, reason, rep.chunk.ChunkId))
+}
+
+// bandKeys hashes each band of a MinHash signature for LSH bucketing
+func bandKeys(sig []uint64) []uint64 {
+	keys := make([]uint64, minHashBands)
+	buf := make([]byte, 8*minHashRows)
+	for band := range keys {
+		for row := 0; row < minHashRows; row++ {
+			binary.LittleEndian.PutUint64(buf[8*row:], sig[band*minHashRows+row])
+		}
+		h := fnv.New64a()
+		h.Write(buf)
+		keys[band] = h.Sum64()
+	}
+	return keys
+}
+
+// jaccardEstimate is the share of equal MinHash slots
+func jaccardEstimate(a, b []uint64) float64 {
+	var equal int
+	for i := range a {
+		if a[i] == b[i] {
+			equal++
+		}
+	}
+	return float64(equal) / float64(len(a))
+}
+
+// embeddingCosine returns the cosine similarity of two embeddings, or 0 when
+// their dimensions differ.
+func embeddingCosine(a, b []float32) float64 {
+	if len(a) != len(b) || len(a) == 0 {
+		return 0
+	}
+	var dot, na, nb float64
+	for i := range a {
+		dot += float64(a[i]) * float64(b[i])
+		na += float64(a[i]) * float64(a[i])
+		nb += float64(b[i]) * float64(b[i])
+	}
+	if na == 0 || nb == 0 {
+		return 0
+	}
+	return dot / math.Sqrt(na*nb)
+}
+
+func hashString(s string) uint64 {
+	h := fnv.New64a()
+	h.Write([]byte(s))
+	return h.Sum64()
+}
+
+// mix64 is the splitmix64 finalizer
+func mix64(x uint64) uint64 {
+	x ^= x >> 30
+	x *= 0xbf58476d1ce4e5b9
+	x ^= x >> 27
+	x *= 0x94d049bb133111eb
+	x ^= x >> 31
+	return x
+}
@@ -0,0 +1,130 @@
+package biz
+
+import (
+	"fmt"
+	"math"
+	"strings"
+	"testing"
+
+	v1 "rag/api/assembler/v1"
+)
+
+// longText is a sentence of n distinct words starting at word offset
+func longText(offset, n int) string {
+	words := make([]string, n)
+	for i := range words {
+		words[i] = fmt.Sprintf("w%d", offset+i)
+	}
+	return strings.Join(words, " ")
+}
+
+func dedupChunk(id, content string, embedding ...float32) *scoredChunk {
+	cache := newAssemblyCache()
+	features := cache.chunkFeatures(content)
+	return &scoredChunk{
+		chunk:    &v1.DocumentChunk{ChunkId: id, Content: content, Embedding: embedding},
+		vector:   features.vector,
+		features: features,
+	}
+}
+
+func TestMinHashEstimatesJaccard(t *testing.T) {
+	// 100 个词、错开 20 个词：三词片段 78 个共有，共 118 个，Jaccard ≈ 0.66
+	a := dedupChunk("a", longText(0, 100)).features.minHashSignature()
+	b := dedupChunk("b", longText(20, 100)).features.minHashSignature()
+	want := 78.0 / 118.0
+	if got := jaccardEstimate(a, b); math.Abs(got-want) > 0.12 {
+		t.Errorf("jaccard estimate = %.2f, want about %.2f", got, want)
+	}
+	if got := jaccardEstimate(a, a); got != 1 {
+		t.Errorf("self estimate = %v, want 1", got)
+	}
+	c := dedupChunk("c", longText(1000, 100)).features.minHashSignature()
+	if got := jaccardEstimate(a, c); got > 0.05 {
+		t.Errorf("disjoint estimate = %.2f, want about 0", got)
+	}
+	// 签名与进程无关，重新计算结果相同
+	if again := dedupChunk("a", longText(0, 100)).features.minHashSignature(); jaccardEstimate(a, again) != 1 {
+		t.Error("signatures differ between computations")
+	}
+}
+
+func TestDeduplicatorMinHash(t *testing.T) {
+	var warnings []string
+	d := newDeduplicator(&v1.DeduplicationOptions{SimilarityThreshold: 0.7}, &warnings)
+	base := longText(0, 200)
+	near := strings.Replace(base, "w100", "changed", 1)
+
+	offers := []struct {
+		chunk *scoredChunk
+		kept  bool
+	}{
+		{dedupChunk("base", base), true},
+		{dedupChunk("near", near), false},
+		// 空白不同的完全重复
+		{dedupChunk("spaced", strings.ReplaceAll(base, " ", "  ")), false},
+		{dedupChunk("other", longText(500, 200)), true},
+		{dedupChunk("short", "tiny"), true},
+		{dedupChunk("short-copy", " tiny "), false},
+	}
+	for _, o := range offers {
+		if kept := d.offer(o.chunk); kept != o.kept {
+			t.Errorf("offer(%s) = %v, want %v", o.chunk.chunk.ChunkId, kept, o.kept)
+		}
+	}
+	if len(d.removed) != 3 || !strings.HasPrefix(d.removed[0], "near (minhash similarity") {
+		t.Errorf("removed = %v", d.removed)
+	}
+	if len(warnings) != 0 {
+		t.Errorf("warnings = %v", warnings)
+	}
+}
+
+func TestDeduplicatorMethods(t *testing.T) {
+	base := longText(0, 200)
+	near := strings.Replace(base, "w100", "changed", 1)
+
+	var warnings []string
+	// 100 个词的文本换掉一个词，指纹相差 1 位
+	short := longText(0, 100)
+	d := newDeduplicator(&v1.DeduplicationOptions{Method: DedupSimHash}, &warnings)
+	if !d.offer(dedupChunk("base", short)) || d.offer(dedupChunk("near", strings.Replace(short, "w10 ", "changed ", 1))) || !d.offer(dedupChunk("other", longText(1000, 100))) {
+		t.Errorf("simhash removed = %v, want near removed", d.removed)
+	}
+
+	d = newDeduplicator(&v1.DeduplicationOptions{Method: DedupExact}, &warnings)
+	if !d.offer(dedupChunk("base", base)) || !d.offer(dedupChunk("near", near)) || d.offer(dedupChunk("copy", base)) {
+		t.Errorf("exact removed = %v, want only the copy removed", d.removed)
+	}
+
+	d = newDeduplicator(&v1.DeduplicationOptions{Method: DedupNone}, &warnings)
+	if !d.offer(dedupChunk("base", base)) || !d.offer(dedupChunk("copy", base)) {
+		t.Error("none removed a chunk")
+	}
+
+	// 内容不同但嵌入几乎相同
+	d = newDeduplicator(&v1.DeduplicationOptions{Method: DedupExact, UseEmbeddings: true}, &warnings)
+	if !d.offer(dedupChunk("a", "alpha", 1, 0, 0)) || d.offer(dedupChunk("b", "beta", 0.99, 0.01, 0)) || !d.offer(dedupChunk("c", "gamma", 0, 1, 0)) {
+		t.Errorf("embedding removed = %v, want only b removed", d.removed)
+	}
+	if len(warnings) != 0 {
+		t.Errorf("warnings = %v", warnings)
+	}
+
+	newDeduplicator(&v1.DeduplicationOptions{Method: "fuzzy"}, &warnings)
+	if len(warnings) != 1 {
+		t.Errorf("unknown method warnings = %v", warnings)
+	}
+}
+
+func TestEmbeddingCosine(t *testing.T) {
+	if got := embeddingCosine([]float32{1, 2}, []float32{2, 4}); math.Abs(got-1) > 1e-9 {
+		t.Errorf("parallel = %v, want 1", got)
+	}
+	if got := embeddingCosine([]float32{1, 0}, []float32{1, 0, 0}); got != 0 {
+		t.Errorf("different dimensions = %v, want 0", got)
+	}
+	if got := embeddingCosine([]float32{0, 0}, []float32{1, 0}); got != 0 {
+		t.Errorf("zero vector = %v, want 0", got)
+	}
+}
@@ -0,0 +1,534 @@
+package biz
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	v1 "rag/api/assembler/v1"
+	commonv1 "rag/api/common/v1"
+
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+// Optimization method names accepted in OptimizationOptions.optimization_method.
+const (
+	OptimizeSummarization = "summarization"
+	OptimizeExtraction    = "extraction"
+	OptimizeCompression   = "compression"
+	OptimizeParaphrasing  = "paraphrasing"
+)
+
+const (
+	textRankDamping    = 0.85
+	textRankIterations = 50
+	textRankTolerance  = 1e-4
+	// TextRank 的相似度矩阵随句子数平方增长，超过时按位置排序
+	maxTextRankSentences = 500
+	// 不相邻的重复行只有达到这个长度才去除，短行（列表标记、"Yes" 等）重复是正常内容
+	minRepeatedLineLength = 20
+	// 含关键信息（数字、专有名词）的句子加权
+	keyInformationBoost = 0.2
+)
+
+var (
+	// ErrGeneratorUnavailable is returned by a ContentGenerator that cannot serve requests.
+	ErrGeneratorUnavailable = errors.ServiceUnavailable(commonv1.ErrorCode_ERROR_CODE_MODEL_NOT_AVAILABLE.String(), "content generator is not available")
+	// ErrInvalidRemovePattern is returned when a remove pattern is not a valid regular expression.
+	ErrInvalidRemovePattern = errors.BadRequest(commonv1.ErrorCode_ERROR_CODE_BAD_REQUEST.String(), "invalid remove pattern")
+
+	spaceRun     = regexp.MustCompile(`[ \t\f\v]+`)
+	blankLines   = regexp.MustCompile(`\n\s*\n\s*`)
+	parenthetic  = regexp.MustCompile(`\s*[(（][^()（）]{0,80}[)）]`)
+	fillerWords  = regexp.MustCompile(`(?i)\b(basically|actually|really|very|just|quite|simply|of course|in fact|needless to say)\b,?\s*`)
+	boilerplates = []*regexp.Regexp{
+		regexp.MustCompile(`(?i)^\s*(copyright|©|all rights reserved)`),
+		regexp.MustCompile(`(?i)^\s*(click here|read more|subscribe|share this|follow us|cookie|privacy policy|terms of (use|service))`),
+		regexp.MustCompile(`^\s*(版权所有|未经许可|点击(这里|此处)|阅读全文|关注我们|分享到|免责声明)`),
+		regexp.MustCompile(`^\s*(page \d+( of \d+)?|第\s*\d+\s*页)\s*$`),
+	}
+)
+
+// ContentGenerator rewrites content with a language model
+type ContentGenerator interface {
+	// 按方法（summarization / paraphrasing）改写内容，结果尽量不超过 targetLength 个字符
+	Generate(ctx context.Context, method, content string, targetLength int, options *v1.OptimizationOptions) (string, error)
+}
+
+// OptimizeUsecase handles content optimization business logic
+type OptimizeUsecase struct {
+	generator ContentGenerator
+	log       *log.Helper
+}
+
+// NewOptimizeUsecase creates a new optimize usecase
+func NewOptimizeUsecase(generator ContentGenerator, logger log.Logger) *OptimizeUsecase {
+	return &OptimizeUsecase{
+		generator: generator,
+		log:       log.NewHelper(logger),
+	}
+}
+
+// optimizeRun records the steps applied to the content. Lengths are in characters.
+type optimizeRun struct {
+	content string
+	steps   []*v1.OptimizationStep
+}
+
+func (r *optimizeRun) apply(name, method, description, content string) {
+	r.steps = append(r.steps, &v1.OptimizationStep{
+		StepName:            name,
+		MethodUsed:          method,
+		ContentLengthBefore: int32(utf8.RuneCountInString(r.content)),
+		ContentLengthAfter:  int32(utf8.RuneCountInString(content)),
+		Description:         description,
+	})
+	r.content = content
+}
+
+func (r *optimizeRun) length() int {
+	return utf8.RuneCountInString(r.content)
+}
+
+// OptimizeContent shrinks content towards target_length characters. Rule-based
+// cleanup always runs first; the method then reduces what remains: TextRank or
+// query-aware sentence extraction, further compression, or the generator for
+// abstractive methods, falling back to TextRank when no generator is available.
+func (uc *OptimizeUsecase) OptimizeContent(ctx context.Context, req *v1.OptimizeContentRequest) (*v1.OptimizeContentResponse, error) {
+	if strings.TrimSpace(req.Content) == "" {
+		return nil, ErrEmptyContent
+	}
+	options := req.Options
+	if options == nil {
+		options = &v1.OptimizationOptions{}
+	}
+	method := options.OptimizationMethod
+	if method == "" {
+		method = OptimizeExtraction
+	}
+	target := int(req.TargetLength)
+	if target <= 0 {
+		target = utf8.RuneCountInString(req.Content)
+	}
+	uc.log.WithContext(ctx).Infof("Optimizing %d characters to %d with method %s", utf8.RuneCountInString(req.Content), target, method)
+
+	run := &optimizeRun{content: req.Content}
+	metrics := map[string]string{"method": method}
+	if err := cleanup(run, options); err != nil {
+		return nil, err
+	}
+
+	if run.length() > target {
+		switch method {
+		case OptimizeSummarization, OptimizeParaphrasing:
+			generated, err := uc.generator.Generate(ctx, method, run.content, target, options)
+			switch {
+			case err == nil:
+				run.apply(method, "generator", "abstractive rewrite by the content generator", strings.TrimSpace(generated))
+				metrics["generator"] = "used"
+			case errors.Is(err, ErrGeneratorUnavailable):
+				uc.log.WithContext(ctx).Warnf("Content generator unavailable, falling back to textrank: %v", err)
+				metrics["generator"] = "unavailable"
+				extract(run, req, options, target, "textrank", "generator unavailable, extractive fallback with TextRank over sentences", metrics)
+			default:
+				return nil, err
+			}
+		case OptimizeCompression:
+			compress(run, options)
+		case OptimizeExtraction:
+			name, description := "textrank", "TextRank sentence extraction"
+			if strings.TrimSpace(req.Query) != "" {
+				name, description = "query_extraction", "query-aware sentence extraction"
+			}
+			extract(run, req, options, target, name, description, metrics)
+		default:
+			metrics["unknown_method"] = method
+			extract(run, req, options, target, "textrank", "unknown method, extractive fallback with TextRank over sentences", metrics)
+		}
+	}
+	if run.length() > target {
+		truncate(run, target, options.PreserveStructure)
+	}
+
+	original, optimized := utf8.RuneCountInString(req.Content), run.length()
+	coverage, similarity := informationRetained(req.Content, run.content)
+	var ratio float32
+	if original > 0 {
+		ratio = float32(optimized) / float32(original)
+	}
+	return &v1.OptimizeContentResponse{
+		OptimizedContent: run.content,
+		OptimizationResult: &v1.OptimizationResult{
+			OriginalLength:                 int32(original),
+			OptimizedLength:                int32(optimized),
+			CompressionRatio:               ratio,
+			QualityScore:                   float32(similarity),
+			InformationPreservedPercentage: int32(math.Round(coverage * 100)),
+			Metrics:                        metrics,
+		},
+		StepsTaken: run.steps,
+	}, nil
+}
+
+// cleanup removes the requested patterns, boilerplate lines and redundant whitespace
+func cleanup(run *optimizeRun, options *v1.OptimizationOptions) error {
+	for _, pattern := range options.RemovePatterns {
+		re, err := regexp.Compile(pattern)
+		if err != nil {
+			return ErrInvalidRemovePattern.WithCause(err)
+		}
+		content := removeMatches(run.content, re, options.PreservePhrases)
+		if content != run.content {
+			run.apply("remove_patterns", "regex", fmt.Sprintf("removed matches of %q", pattern), content)
+		}
+	}
+
+	// 去除样板行：版权声明、导航链接，以及紧接着重复或较长的重复行
+	lines := strings.Split(run.content, "\n")
+	seen := make(map[string]bool)
+	kept := lines[:0]
+	var previous string
+	for _, line := range lines {
+		key := strings.TrimSpace(line)
+		if key != "" && !containsAny(line, options.PreservePhrases) {
+			repeated := key == previous || seen[key] && utf8.RuneCountInString(key) >= minRepeatedLineLength
+			if repeated || isBoilerplate(key) {
+				continue
+			}
+			seen[key] = true
+		}
+		if key != "" {
+			previous = key
+		}
+		kept = append(kept, line)
+	}
+	if content := strings.Join(kept, "\n"); content != run.content {
+		run.apply("remove_boilerplate", "rules", "removed boilerplate and repeated lines", content)
+	}
+
+	content := spaceRun.ReplaceAllString(run.content, " ")
+	if options.PreserveStructure {
+		content = blankLines.ReplaceAllString(content, "\n\n")
+	} else {
+		content = strings.Join(strings.Fields(content), " ")
+	}
+	if content = strings.TrimSpace(content); content != run.content {
+		run.apply("normalize_whitespace", "rules", "collapsed redundant whitespace", content)
+	}
+	return nil
+}
+
+// compress drops asides and filler words, more of them as aggressiveness grows
+func compress(run *optimizeRun, options *v1.OptimizationOptions) {
+	if options.Aggressiveness >= 0.3 {
+		if content := removeMatches(run.content, fillerWords, options.PreservePhrases); content != run.content {
+			run.apply("remove_fillers", "compression", "removed filler words", content)
+		}
+	}
+	if options.Aggressiveness >= 0.6 {
+		if content := removeMatches(run.content, parenthetic, options.PreservePhrases); content != run.content {
+			run.apply("remove_parentheticals", "compression", "removed parenthetical asides", content)
+		}
+	}
+}
+
+// removeMatches deletes matches of re that do not overlap a preserved phrase
+func removeMatches(text string, re *regexp.Regexp, preserve []string) string {
+	var protected [][2]int
+	for _, phrase := range preserve {
+		if phrase == "" {
+			continue
+		}
+		for from := 0; ; {
+			i := strings.Index(text[from:], phrase)
+			if i < 0 {
+				break
+			}
+			protected = append(protected, [2]int{from + i, from + i + len(phrase)})
+			from += i + len(phrase)
+		}
+	}
+	var sb strings.Builder
+	last := 0
+	for _, loc := range re.FindAllStringIndex(text, -1) {
+		overlaps := false
+		for _, p := range protected {
+			if loc[0] < p[1] && p[0] < loc[1] {
+				overlaps = true
+				break
+			}
+		}
+		if overlaps {
+			continue
+		}
+		sb.WriteString(text[last:loc[0]])
+		last = loc[1]
+	}
+	sb.WriteString(text[last:])
+	return sb.String()
+}
+
+// sentence is a sentence of the content with the paragraph it belongs to
+type sentence struct {
+	text      string
+	paragraph int
+	vector    termVector
+	terms     map[string]bool
+}
+
+// extract keeps the highest-scoring sentences, in their original order, that
+// fit into the target. Sentences containing preserved phrases are kept first.
+func extract(run *optimizeRun, req *v1.OptimizeContentRequest, options *v1.OptimizationOptions, target int, name, description string, metrics map[string]string) {
+	sentences := splitSentences(run.content)
+	metrics["sentences_original"] = strconv.Itoa(len(sentences))
+	if len(sentences) <= 1 {
+		return
+	}
+
+	var scores []float64
+	if len(sentences) > maxTextRankSentences {
+		scores = positionScores(len(sentences))
+		metrics["sentence_ranking"] = "position"
+	} else {
+		scores = textRank(sentences)
+		metrics["sentence_ranking"] = "textrank"
+	}
+	if query := strings.TrimSpace(req.Query); query != "" && name == "query_extraction" {
+		// 查询相关性与 TextRank 中心度各占一半
+		queryVec := newTermVector(query)
+		for i, s := range sentences {
+			scores[i] = 0.5*scores[i] + 0.5*cosine(queryVec, s.vector)
+		}
+	}
+	if options.PreserveKeyInformation {
+		for i, s := range sentences {
+			if hasKeyInformation(s.text) {
+				scores[i] += keyInformationBoost
+			}
+		}
+	}
+
+	order := make([]int, len(sentences))
+	for i := range order {
+		order[i] = i
+	}
+	sort.SliceStable(order, func(a, b int) bool {
+		pa, pb := containsAny(sentences[order[a]].text, options.PreservePhrases), containsAny(sentences[order[b]].text, options.PreservePhrases)
+		if pa != pb {
+			return pa
+		}
+		return scores[order[a]] > scores[order[b]]
+	})
+
+	keep := make([]bool, len(sentences))
+	used, kept := 0, 0
+	for _, i := range order {
+		n := utf8.RuneCountInString(sentences[i].text) + 1
+		if used+n > target && kept > 0 {
+			continue
+		}
+		keep[i] = true
+		used += n
+		kept++
+	}
+	metrics["sentences_kept"] = strconv.Itoa(kept)
+	run.apply(name, OptimizeExtraction, description, joinSentences(sentences, keep, options.PreserveStructure))
+}
+
+// textRank scores sentences by centrality in their similarity graph, using
+// the overlap similarity of the original TextRank paper.
+func textRank(sentences []sentence) []float64 {
+	n := len(sentences)
+	weights := make([][]float64, n)
+	outSum := make([]float64, n)
+	for i := range weights {
+		weights[i] = make([]float64, n)
+	}
+	for i := 0; i < n; i++ {
+		for j := i + 1; j < n; j++ {
+			w := overlapSimilarity(sentences[i].terms, sentences[j].terms)
+			weights[i][j], weights[j][i] = w, w
+			outSum[i] += w
+			outSum[j] += w
+		}
+	}
+
+	scores := make([]float64, n)
+	for i := range scores {
+		scores[i] = 1.0 / float64(n)
+	}
+	for iter := 0; iter < textRankIterations; iter++ {
+		next := make([]float64, n)
+		var delta float64
+		for i := 0; i < n; i++ {
+			var sum float64
+			for j := 0; j < n; j++ {
+				if weights[j][i] > 0 {
+					sum += weights[j][i] / outSum[j] * scores[j]
+				}
+			}
+			next[i] = (1-textRankDamping)/float64(n) + textRankDamping*sum
+			delta += math.Abs(next[i] - scores[i])
+		}
+		scores = next
+		if delta < textRankTolerance {
+			break
+		}
+	}
+
+	// 归一化到 [0, 1]，便于与查询相关性组合
+	var max float64
+	for _, s := range scores {
+		max = math.Max(max, s)
+	}
+	if max > 0 {
+		for i := range scores {
+			scores[i] /= max
+		}
+	}
+	return scores
+}
+
+// positionScores ranks sentences by position, earlier ones first, for content
+// too long to build the TextRank graph of
+func positionScores(n int) []float64 {
+	scores := make([]float64, n)
+	for i := range scores {
+		scores[i] = 1 - float64(i)/float64(n)
+	}
+	return scores
+}
+
+func overlapSimilarity(a, b map[string]bool) float64 {
+	if len(a) < 2 || len(b) < 2 {
+		return 0
+	}
+	var common int
+	for term := range a {
+		if b[term] {
+			common++
+		}
+	}
+	return float64(common) / (math.Log(float64(len(a))) + math.Log(float64(len(b))))
+}
+
+func splitSentences(text string) []sentence {
+	paragraphStarts := append([]int{0}, paragraphBreaks(text)...)
+	var sentences []sentence
+	for _, sp := range splitAt(text, sentenceBreaks(text)) {
+		sp = trimSpan(text, sp)
+		if sp.start >= sp.end {
+			continue
+		}
+		s := text[sp.start:sp.end]
+		terms := make(map[string]bool)
+		for _, term := range splitTerms(s) {
+			terms[term] = true
+		}
+		sentences = append(sentences, sentence{
+			text:      s,
+			paragraph: sort.SearchInts(paragraphStarts, sp.start+1) - 1,
+			vector:    newTermVector(s),
+			terms:     terms,
+		})
+	}
+	return sentences
+}
+
+// joinSentences joins the kept sentences, separating paragraphs with a blank
+// line when the structure is preserved.
+func joinSentences(sentences []sentence, keep []bool, preserveStructure bool) string {
+	var sb strings.Builder
+	prev := -1
+	for i, s := range sentences {
+		if !keep[i] {
+			continue
+		}
+		if sb.Len() > 0 {
+			if preserveStructure && s.paragraph != prev {
+				sb.WriteString("\n\n")
+			} else if !endsWithCJK(sb.String()) {
+				sb.WriteString(" ")
+			}
+		}
+		sb.WriteString(s.text)
+		prev = s.paragraph
+	}
+	return sb.String()
+}
+
+// truncate cuts the content to the target, at a sentence boundary when possible
+func truncate(run *optimizeRun, target int, preserveStructure bool) {
+	sentences := splitSentences(run.content)
+	keep := make([]bool, len(sentences))
+	used := 0
+	for i, s := range sentences {
+		n := utf8.RuneCountInString(s.text) + 1
+		if used+n > target {
+			break
+		}
+		keep[i] = true
+		used += n
+	}
+	content := joinSentences(sentences, keep, preserveStructure)
+	if content == "" {
+		content = string([]rune(run.content)[:target])
+	}
+	run.apply("truncate", "truncation", "cut to the target length at a sentence boundary", content)
+}
+
+// informationRetained returns the share of the original term mass kept and
+// the cosine similarity between the original and optimized content.
+func informationRetained(original, optimized string) (float64, float64) {
+	a, b := newTermVector(original), newTermVector(optimized)
+	var total, kept float64
+	for term, tf := range a.terms {
+		total += tf
+		kept += math.Min(tf, b.terms[term])
+	}
+	if total == 0 {
+		return 1, 1
+	}
+	return kept / total, cosine(a, b)
+}
+
+// hasKeyInformation reports whether a sentence carries numbers or proper nouns
+func hasKeyInformation(s string) bool {
+	for i, word := range strings.Fields(s) {
+		r, _ := utf8.DecodeRuneInString(word)
+		if unicode.IsDigit(r) || (i > 0 && unicode.IsUpper(r)) {
+			return true
+		}
+	}
+	return false
+}
+
+func isBoilerplate(line string) bool {
+	for _, re := range boilerplates {
+		if re.MatchString(line) {
+			return true
+		}
+	}
+	return false
+}
+
+func containsAny(s string, phrases []string) bool {
+	for _, phrase := range phrases {
+		if phrase != "" && strings.Contains(s, phrase) {
+			return true
+		}
+	}
+	return false
+}
+
+func endsWithCJK(s string) bool {
+	r, _ := utf8.DecodeLastRuneInString(s)
+	return unicode.Is(unicode.Han, r) || strings.ContainsRune("。！？；", r)
+}
@@ -0,0 +1,201 @@
+package biz
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	v1 "rag/api/assembler/v1"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+// fakeGenerator returns a fixed rewrite or error
+type fakeGenerator struct {
+	text string
+	err  error
+}
+
+func (g *fakeGenerator) Generate(ctx context.Context, method, content string, targetLength int, options *v1.OptimizationOptions) (string, error) {
+	return g.text, g.err
+}
+
+const optimizeContent = "Go channels connect goroutines safely. " +
+	"Go channels pass values between goroutines. " +
+	"Channels and goroutines make Go concurrency simple. " +
+	"The weather today is sunny and warm."
+
+func TestTextRankRanksCentralSentences(t *testing.T) {
+	sentences := splitSentences(optimizeContent)
+	if len(sentences) != 4 {
+		t.Fatalf("sentences = %d, want 4", len(sentences))
+	}
+	scores := textRank(sentences)
+	var top float64
+	for i, s := range scores[:3] {
+		if s <= scores[3] {
+			t.Errorf("sentence %d scores %.3f, not above the off-topic %.3f", i, s, scores[3])
+		}
+		top = max(top, s)
+	}
+	if top != 1 {
+		t.Errorf("top score = %v, want scores normalized to 1", top)
+	}
+}
+
+func TestTextRankWithoutEdges(t *testing.T) {
+	// 句子之间没有共同词时所有句子得分相同
+	scores := textRank(splitSentences("Alpha beta gamma. Delta epsilon zeta. Eta theta iota."))
+	for i, s := range scores {
+		if s != 1 {
+			t.Errorf("score %d = %v, want 1", i, s)
+		}
+	}
+}
+
+func TestOptimizeContentExtraction(t *testing.T) {
+	uc := NewOptimizeUsecase(&fakeGenerator{err: ErrGeneratorUnavailable}, log.DefaultLogger)
+	ctx := context.Background()
+
+	resp, err := uc.OptimizeContent(ctx, &v1.OptimizeContentRequest{Content: optimizeContent, TargetLength: 100})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if strings.Contains(resp.OptimizedContent, "weather") {
+		t.Errorf("textrank kept the off-topic sentence: %q", resp.OptimizedContent)
+	}
+	if n := resp.OptimizationResult.OptimizedLength; n > 100 {
+		t.Errorf("optimized length = %d, want at most 100", n)
+	}
+
+	// 两个话题的 TextRank 得分相同，由查询相关性决定保留哪个
+	twoTopics := "Go channels connect goroutines. Go channels pass values between goroutines. " +
+		"The sunny weather is warm today. The sunny weather stays warm tomorrow."
+	resp, err = uc.OptimizeContent(ctx, &v1.OptimizeContentRequest{Content: twoTopics, TargetLength: 75, Query: "sunny weather"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if resp.OptimizedContent != "The sunny weather is warm today. The sunny weather stays warm tomorrow." {
+		t.Errorf("query extraction = %q", resp.OptimizedContent)
+	}
+	if last := resp.StepsTaken[len(resp.StepsTaken)-1]; last.StepName != "query_extraction" {
+		t.Errorf("last step = %s, want query_extraction", last.StepName)
+	}
+
+	// 含保留短语的句子先保留
+	resp, err = uc.OptimizeContent(ctx, &v1.OptimizeContentRequest{
+		Content:      optimizeContent,
+		TargetLength: 40,
+		Options:      &v1.OptimizationOptions{PreservePhrases: []string{"sunny"}},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(resp.OptimizedContent, "sunny") {
+		t.Errorf("preserved phrase dropped: %q", resp.OptimizedContent)
+	}
+}
+
+func TestOptimizeContentGenerator(t *testing.T) {
+	ctx := context.Background()
+	req := &v1.OptimizeContentRequest{
+		Content:      optimizeContent,
+		TargetLength: 100,
+		Options:      &v1.OptimizationOptions{OptimizationMethod: OptimizeSummarization},
+	}
+
+	uc := NewOptimizeUsecase(&fakeGenerator{text: " Go channels connect goroutines. "}, log.DefaultLogger)
+	resp, err := uc.OptimizeContent(ctx, req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if resp.OptimizedContent != "Go channels connect goroutines." || resp.OptimizationResult.Metrics["generator"] != "used" {
+		t.Errorf("generated = %q, metrics %v", resp.OptimizedContent, resp.OptimizationResult.Metrics)
+	}
+
+	// 生成器不可用时回退到 TextRank
+	uc = NewOptimizeUsecase(&fakeGenerator{err: ErrGeneratorUnavailable}, log.DefaultLogger)
+	resp, err = uc.OptimizeContent(ctx, req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if resp.OptimizationResult.Metrics["generator"] != "unavailable" || resp.OptimizationResult.Metrics["sentences_kept"] == "" {
+		t.Errorf("fallback metrics = %v", resp.OptimizationResult.Metrics)
+	}
+}
+
+func TestOptimizeContentCleanupAndCompression(t *testing.T) {
+	uc := NewOptimizeUsecase(&fakeGenerator{err: ErrGeneratorUnavailable}, log.DefaultLogger)
+	content := "This is basically a really simple test (with an aside) of compression.\n" +
+		"Copyright 2024 Example Inc.\n" +
+		"This is basically a really simple test (with an aside) of compression."
+	resp, err := uc.OptimizeContent(context.Background(), &v1.OptimizeContentRequest{
+		Content:      content,
+		TargetLength: 45,
+		Options: &v1.OptimizationOptions{
+			OptimizationMethod: OptimizeCompression,
+			Aggressiveness:     0.8,
+			PreservePhrases:    []string{"simple"},
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if resp.OptimizedContent != "This is a simple test of compression." {
+		t.Errorf("compressed = %q", resp.OptimizedContent)
+	}
+
+	if _, err := uc.OptimizeContent(context.Background(), &v1.OptimizeContentRequest{
+		Content: "text",
+		Options: &v1.OptimizationOptions{RemovePatterns: []string{"("}},
+	}); err == nil {
+		t.Error("expected an invalid pattern error")
+	}
+}
+
+func TestOptimizeContentLongContentRanksByPosition(t *testing.T) {
+	uc := NewOptimizeUsecase(&fakeGenerator{err: ErrGeneratorUnavailable}, log.DefaultLogger)
+	var sb strings.Builder
+	for i := 0; i <= maxTextRankSentences; i++ {
+		fmt.Fprintf(&sb, "Sentence number %d talks about topic %d. ", i, i%7)
+	}
+	resp, err := uc.OptimizeContent(context.Background(), &v1.OptimizeContentRequest{
+		Content:      sb.String(),
+		TargetLength: 80,
+		Options:      &v1.OptimizationOptions{OptimizationMethod: OptimizeExtraction},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	// 句子过多时不建相似度矩阵，保留开头的句子
+	if got := resp.OptimizationResult.Metrics["sentence_ranking"]; got != "position" {
+		t.Errorf("ranking = %q, want position", got)
+	}
+	if !strings.HasPrefix(resp.OptimizedContent, "Sentence number 0 talks") {
+		t.Errorf("optimized = %q", resp.OptimizedContent)
+	}
+}
+
+func TestCleanupRepeatedLines(t *testing.T) {
+	tests := []struct {
+		content, want string
+	}{
+		// 紧接着重复的行去除，无论长短
+		{"Yes\nYes\nNo", "Yes\nNo"},
+		{"Yes\n\nYes", "Yes\n\n"},
+		// 不相邻的短行保留
+		{"Step:\nmix the flour\nStep:\nbake the bread", "Step:\nmix the flour\nStep:\nbake the bread"},
+		// 不相邻的长行去除
+		{"See the appendix for the full table\nbody\nSee the appendix for the full table", "See the appendix for the full table\nbody"},
+	}
+	for _, tt := range tests {
+		run := &optimizeRun{content: tt.content}
+		if err := cleanup(run, &v1.OptimizationOptions{PreserveStructure: true}); err != nil {
+			t.Fatal(err)
+		}
+		if run.content != strings.TrimSpace(tt.want) {
+			t.Errorf("cleanup(%q) = %q, want %q", tt.content, run.content, strings.TrimSpace(tt.want))
+		}
+	}
+}
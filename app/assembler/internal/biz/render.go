@@ -0,0 +1,654 @@
+package biz
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"html"
+	htmltemplate "html/template"
+	"io"
+	"sort"
+	"strconv"
+	"strings"
+	"text/template"
+	"text/template/parse"
+	"time"
+	"unicode"
+	"unicode/utf8"
+)
+
+// Output formats accepted in RenderOptions.output_format.
+const (
+	OutputText     = "text"
+	OutputMarkdown = "markdown"
+	OutputHTML     = "html"
+)
+
+const (
+	maxTemplateSize   = 64 << 10
+	maxTemplateOutput = 1 << 20
+	maxIndent         = 32
+	// 一次渲染最多执行的循环迭代和模板调用次数
+	maxTemplateSteps = 100000
+	// 一次渲染的最长时间，请求的截止时间更早时以请求为准
+	maxTemplateDuration = 2 * time.Second
+	// 插入到每个循环体和模板开头的计步函数
+	stepFunc = "_step"
+)
+
+var (
+	errOutputTooLarge = errors.New("template output exceeds the size limit")
+	errTooManySteps   = fmt.Errorf("template exceeds %d loop iterations and template calls", maxTemplateSteps)
+)
+
+// templateFuncs are the functions a template can call that build no new
+// strings. The ones that do are bound per rendering by renderBudget.funcs.
+var templateFuncs = map[string]any{
+	"trim":      strings.TrimSpace,
+	"contains":  func(substr, s string) bool { return strings.Contains(s, substr) },
+	"hasPrefix": func(prefix, s string) bool { return strings.HasPrefix(s, prefix) },
+	"hasSuffix": func(suffix, s string) bool { return strings.HasSuffix(s, suffix) },
+	"split":     func(sep, s string) []string { return strings.Split(s, sep) },
+	"lines":     func(s string) []string { return strings.Split(strings.TrimRight(s, "\n"), "\n") },
+	"wordcount": func(s string) int { return len(strings.Fields(s)) },
+	"default": func(fallback, s string) string {
+		if strings.TrimSpace(s) == "" {
+			return fallback
+		}
+		return s
+	},
+	"truncate": func(n int, s string) string {
+		if n < 0 || utf8.RuneCountInString(s) <= n {
+			return s
+		}
+		return string([]rune(s)[:n])
+	},
+	"slice": sliceOf,
+	"call": func(any, ...any) (any, error) {
+		return nil, errors.New("call is not allowed in templates")
+	},
+}
+
+// parseFuncs stand in for the budgeted functions until a rendering binds
+// its own budget
+var parseFuncs = new(renderBudget).funcs()
+
+// compiledTemplate is a parsed template with the variables it references
+type compiledTemplate struct {
+	name      string
+	content   string
+	text      *template.Template
+	supported []string
+	required  []string
+}
+
+// compileTemplate parses content and finds its variables. Ranges over
+// anything but variables and split or lines results are rejected, since
+// ranging over an integer or a function result could loop without bound.
+func compileTemplate(name, content string) (*compiledTemplate, error) {
+	if len(content) > maxTemplateSize {
+		return nil, errors.New("template exceeds the size limit")
+	}
+	t, err := template.New(name).Funcs(templateFuncs).Funcs(parseFuncs).Option("missingkey=zero").Parse(content)
+	if err != nil {
+		return nil, err
+	}
+	s := &variableScanner{used: make(map[string]bool), required: make(map[string]bool)}
+	for _, tt := range t.Templates() {
+		if tt.Tree != nil {
+			s.walk(tt.Tree.Root, nil, true)
+			if err := instrument(tt.Tree); err != nil {
+				return nil, err
+			}
+		}
+	}
+	return &compiledTemplate{
+		name:      name,
+		content:   content,
+		text:      t,
+		supported: sortedKeys(s.used),
+		required:  sortedKeys(s.required),
+	}, nil
+}
+
+// execute renders the template with vars. HTML output goes through
+// html/template so variables are escaped for the context they appear in.
+// Rendering stops after maxTemplateSteps loop iterations and template calls,
+// once the strings its functions build exceed maxTemplateOutput, or once ctx
+// or maxTemplateDuration runs out.
+func (c *compiledTemplate) execute(ctx context.Context, vars map[string]string, format string) (string, error) {
+	ctx, cancel := context.WithTimeout(ctx, maxTemplateDuration)
+	defer cancel()
+	funcs := (&renderBudget{ctx: ctx}).funcs()
+
+	w := &limitedWriter{limit: maxTemplateOutput}
+	var err error
+	if format == OutputHTML {
+		err = c.executeHTML(w, vars, funcs)
+	} else {
+		var t *template.Template
+		// 克隆后绑定本次渲染的计数函数，编译结果可并发使用
+		if t, err = c.text.Clone(); err == nil {
+			err = t.Funcs(funcs).Execute(w, vars)
+		}
+	}
+	if err != nil {
+		return "", err
+	}
+	out := w.sb.String()
+	if format == OutputMarkdown {
+		// Markdown 输出合并多余空行
+		out = strings.TrimSpace(blankLines.ReplaceAllString(out, "\n\n"))
+	}
+	return out, nil
+}
+
+func (c *compiledTemplate) executeHTML(w io.Writer, vars map[string]string, funcs map[string]any) error {
+	t, err := htmltemplate.New(c.name).Funcs(templateFuncs).Funcs(funcs).Option("missingkey=zero").Parse(c.content)
+	if err != nil {
+		return err
+	}
+	for _, tt := range t.Templates() {
+		if tt.Tree != nil {
+			if err := instrument(tt.Tree); err != nil {
+				return err
+			}
+		}
+	}
+	return t.Execute(w, vars)
+}
+
+// renderBudget counts the steps of one rendering and the bytes of the
+// strings its functions build. Reassigning a variable in a loop can grow a
+// value without writing it out, so the output limit alone does not bound
+// memory.
+type renderBudget struct {
+	ctx   context.Context
+	steps int
+	size  int
+}
+
+// funcs are the functions bound to the budget. They replace the builtins
+// that build strings, printf, print, println, html, js and urlquery.
+func (b *renderBudget) funcs() map[string]any {
+	return map[string]any{
+		stepFunc:   b.step,
+		"upper":    b.text(strings.ToUpper),
+		"lower":    b.text(strings.ToLower),
+		"title":    b.text(titleCase),
+		"quote":    b.text(strconv.Quote),
+		"escape":   b.text(html.EscapeString),
+		"replace":  b.replace,
+		"join":     b.join,
+		"indent":   b.indent,
+		"printf":   b.printf,
+		"print":    b.print(fmt.Sprint),
+		"println":  b.print(fmt.Sprintln),
+		"html":     b.escaper(template.HTMLEscaper),
+		"js":       b.escaper(template.JSEscaper),
+		"urlquery": b.escaper(template.URLQueryEscaper),
+	}
+}
+
+func (b *renderBudget) step() (string, error) {
+	b.steps++
+	if b.steps > maxTemplateSteps {
+		return "", errTooManySteps
+	}
+	if err := b.ctx.Err(); err != nil {
+		return "", fmt.Errorf("template rendering stopped: %w", err)
+	}
+	return "", nil
+}
+
+// grow reserves n bytes, failing once the rendering has built more than the
+// output limit
+func (b *renderBudget) grow(n int) error {
+	if n > maxTemplateOutput-b.size {
+		return errOutputTooLarge
+	}
+	b.size += n
+	return nil
+}
+
+func (b *renderBudget) keep(s string) (string, error) {
+	if err := b.grow(len(s)); err != nil {
+		return "", err
+	}
+	return s, nil
+}
+
+// text charges the result of f, which grows its input by a small factor at
+// most
+func (b *renderBudget) text(f func(string) string) func(string) (string, error) {
+	return func(s string) (string, error) { return b.keep(f(s)) }
+}
+
+func (b *renderBudget) escaper(f func(...any) string) func(...any) (string, error) {
+	return func(args ...any) (string, error) {
+		if err := checkPrintable(args); err != nil {
+			return "", err
+		}
+		return b.keep(f(args...))
+	}
+}
+
+// replace is strings.ReplaceAll that fails before building a result larger
+// than the rest of the budget
+func (b *renderBudget) replace(old, new, s string) (string, error) {
+	if err := b.grow(len(s) + strings.Count(s, old)*(len(new)-len(old))); err != nil {
+		return "", err
+	}
+	return strings.ReplaceAll(s, old, new), nil
+}
+
+// join is strings.Join that fails before building a result larger than the
+// rest of the budget
+func (b *renderBudget) join(sep string, items []string) (string, error) {
+	n := len(sep) * max(len(items)-1, 0)
+	for _, item := range items {
+		n += len(item)
+	}
+	if err := b.grow(n); err != nil {
+		return "", err
+	}
+	return strings.Join(items, sep), nil
+}
+
+func (b *renderBudget) indent(n int, s string) (string, error) {
+	n = max(0, min(n, maxIndent))
+	if err := b.grow(len(s) + n*(strings.Count(s, "\n")+1)); err != nil {
+		return "", err
+	}
+	pad := strings.Repeat(" ", n)
+	return pad + strings.ReplaceAll(s, "\n", "\n"+pad), nil
+}
+
+// print charges the result of fmt.Sprint or fmt.Sprintln after checking
+// that the arguments fit the rest of the budget
+func (b *renderBudget) print(f func(...any) string) func(...any) (string, error) {
+	return func(args ...any) (string, error) {
+		if err := checkPrintable(args); err != nil {
+			return "", err
+		}
+		n := 0
+		for _, arg := range args {
+			n += printedSize(arg)
+		}
+		if n > maxTemplateOutput-b.size {
+			return "", errOutputTooLarge
+		}
+		return b.keep(f(args...))
+	}
+}
+
+// printf is fmt.Sprintf formatted one verb at a time, so it fails before
+// building a result larger than the rest of the budget. Widths, precisions
+// and argument indexes must be literal and the arguments scalars, which
+// keeps the size of each verb known before it is formatted.
+func (b *renderBudget) printf(format string, args ...any) (string, error) {
+	var sb strings.Builder
+	next := 0
+	for format != "" {
+		i := strings.IndexByte(format, '%')
+		if i < 0 {
+			i = len(format)
+		}
+		if err := b.grow(i); err != nil {
+			return "", err
+		}
+		sb.WriteString(format[:i])
+		format = format[i:]
+		if format == "" {
+			break
+		}
+
+		// %[flags][width][.precision]verb
+		j := 1
+		for j < len(format) && strings.IndexByte("+-# 0", format[j]) >= 0 {
+			j++
+		}
+		width, j := literalNumber(format, j)
+		precision := 0
+		if j < len(format) && format[j] == '.' {
+			precision, j = literalNumber(format, j+1)
+		}
+		if j == len(format) {
+			return "", errors.New("printf format ends in the middle of a verb")
+		}
+		verb, size := utf8.DecodeRuneInString(format[j:])
+		if verb == '*' || verb == '[' {
+			return "", errors.New("printf widths, precisions and argument indexes must be literal")
+		}
+		if width > maxTemplateOutput-b.size || precision > maxTemplateOutput-b.size {
+			return "", errOutputTooLarge
+		}
+		spec := format[:j+size]
+		format = format[j+size:]
+
+		var s string
+		switch {
+		case verb == '%':
+			// %% 不消耗参数，也忽略宽度和精度
+			s = "%"
+		case next < len(args):
+			if !isScalar(args[next]) {
+				return "", fmt.Errorf("printf cannot format a %T, only strings, numbers and booleans", args[next])
+			}
+			s = fmt.Sprintf(spec, args[next])
+			next++
+		default:
+			return "", fmt.Errorf("printf format has more verbs than the %d arguments", len(args))
+		}
+		if err := b.grow(len(s)); err != nil {
+			return "", err
+		}
+		sb.WriteString(s)
+	}
+	if next < len(args) {
+		return "", fmt.Errorf("printf format has fewer verbs than the %d arguments", len(args))
+	}
+	return sb.String(), nil
+}
+
+// literalNumber reads the digits of format from i. Values past the output
+// limit are reported as just past it, so they cannot overflow.
+func literalNumber(format string, i int) (int, int) {
+	n := 0
+	for ; i < len(format) && '0' <= format[i] && format[i] <= '9'; i++ {
+		n = min(n*10+int(format[i]-'0'), maxTemplateOutput+1)
+	}
+	return n, i
+}
+
+func isScalar(v any) bool {
+	switch v.(type) {
+	case nil, string, bool, int, int64, uint64, float64, complex128:
+		return true
+	}
+	return false
+}
+
+// checkPrintable allows scalars and the string lists split and lines return
+func checkPrintable(args []any) error {
+	for _, arg := range args {
+		if _, ok := arg.([]string); !ok && !isScalar(arg) {
+			return fmt.Errorf("cannot print a %T, only strings, numbers, booleans and string lists", arg)
+		}
+	}
+	return nil
+}
+
+// printedSize is an upper bound of the bytes fmt.Sprint writes for v
+func printedSize(v any) int {
+	switch v := v.(type) {
+	case string:
+		return len(v) + 1
+	case []string:
+		n := 2
+		for _, item := range v {
+			n += len(item) + 1
+		}
+		return n
+	}
+	// 数值与布尔值的默认格式都很短
+	return 64
+}
+
+// sliceOf is the slice builtin for strings and string lists, the only
+// sliceable values a template sees. Slicing shares the backing memory, so it
+// is not charged.
+func sliceOf(item any, indexes ...int) (any, error) {
+	if len(indexes) > 2 {
+		return nil, fmt.Errorf("slice takes at most 2 indexes, got %d", len(indexes))
+	}
+	var n int
+	switch v := item.(type) {
+	case string:
+		n = len(v)
+	case []string:
+		n = len(v)
+	default:
+		return nil, fmt.Errorf("cannot slice a %T", item)
+	}
+	lo, hi := 0, n
+	if len(indexes) > 0 {
+		lo = indexes[0]
+	}
+	if len(indexes) > 1 {
+		hi = indexes[1]
+	}
+	if lo < 0 || hi < lo || hi > n {
+		return nil, fmt.Errorf("slice index out of range [%d:%d] with length %d", lo, hi, n)
+	}
+	if s, ok := item.(string); ok {
+		return s[lo:hi], nil
+	}
+	return item.([]string)[lo:hi], nil
+}
+
+// instrument checks the ranges of a template and makes each call of the
+// template and each range iteration call the step function first.
+func instrument(tree *parse.Tree) error {
+	if tree.Root == nil {
+		return nil
+	}
+	if err := instrumentNode(tree.Root); err != nil {
+		return err
+	}
+	tree.Root.Nodes = append([]parse.Node{stepAction(tree.Root.Position())}, tree.Root.Nodes...)
+	return nil
+}
+
+func instrumentNode(node parse.Node) error {
+	switch n := node.(type) {
+	case *parse.ListNode:
+		if n == nil {
+			return nil
+		}
+		for _, child := range n.Nodes {
+			if err := instrumentNode(child); err != nil {
+				return err
+			}
+		}
+	case *parse.IfNode:
+		return instrumentBranch(&n.BranchNode)
+	case *parse.WithNode:
+		return instrumentBranch(&n.BranchNode)
+	case *parse.RangeNode:
+		if err := checkRange(n.Pipe); err != nil {
+			return err
+		}
+		if err := instrumentBranch(&n.BranchNode); err != nil {
+			return err
+		}
+		if n.List == nil {
+			n.List = &parse.ListNode{NodeType: parse.NodeList, Pos: n.Position()}
+		}
+		n.List.Nodes = append([]parse.Node{stepAction(n.Position())}, n.List.Nodes...)
+	}
+	return nil
+}
+
+func instrumentBranch(n *parse.BranchNode) error {
+	if err := instrumentNode(n.List); err != nil {
+		return err
+	}
+	return instrumentNode(n.ElseList)
+}
+
+// checkRange allows ranging over a variable, or over the items split or
+// lines makes of one, whose count the input bounds
+func checkRange(p *parse.PipeNode) error {
+	cmd := p.Cmds[len(p.Cmds)-1]
+	switch arg := cmd.Args[0].(type) {
+	case *parse.FieldNode, *parse.VariableNode:
+		return nil
+	case *parse.ChainNode:
+		if _, ok := arg.Node.(*parse.PipeNode); !ok {
+			return nil
+		}
+	case *parse.PipeNode:
+		return checkRange(arg)
+	case *parse.IdentifierNode:
+		if arg.Ident == "split" || arg.Ident == "lines" {
+			return nil
+		}
+	}
+	return fmt.Errorf("range over %s is not allowed, only over variables, split or lines", p)
+}
+
+// stepAction is {{$_step := _step}}. As a declaration it prints nothing,
+// and html/template leaves it unescaped.
+func stepAction(pos parse.Pos) *parse.ActionNode {
+	return &parse.ActionNode{
+		NodeType: parse.NodeAction,
+		Pos:      pos,
+		Pipe: &parse.PipeNode{
+			NodeType: parse.NodePipe,
+			Pos:      pos,
+			Decl:     []*parse.VariableNode{{NodeType: parse.NodeVariable, Pos: pos, Ident: []string{"$" + stepFunc}}},
+			Cmds: []*parse.CommandNode{{
+				NodeType: parse.NodeCommand,
+				Pos:      pos,
+				Args:     []parse.Node{parse.NewIdentifier(stepFunc).SetPos(pos)},
+			}},
+		},
+	}
+}
+
+// limitedWriter fails once more than limit bytes are written
+type limitedWriter struct {
+	sb    strings.Builder
+	limit int
+}
+
+func (w *limitedWriter) Write(p []byte) (int, error) {
+	if w.sb.Len()+len(p) > w.limit {
+		return 0, errOutputTooLarge
+	}
+	return w.sb.Write(p)
+}
+
+var _ io.Writer = (*limitedWriter)(nil)
+
+// variableScanner collects the top-level variables a template references. A
+// variable is required unless it is only passed to default or used under an
+// if/with that tests it.
+type variableScanner struct {
+	used     map[string]bool
+	required map[string]bool
+}
+
+// walk visits node; guarded holds the variables tested by enclosing if/with
+// actions and rootDot reports whether dot is still the variables map.
+func (s *variableScanner) walk(node parse.Node, guarded map[string]bool, rootDot bool) {
+	switch n := node.(type) {
+	case *parse.ListNode:
+		if n == nil {
+			return
+		}
+		for _, child := range n.Nodes {
+			s.walk(child, guarded, rootDot)
+		}
+	case *parse.ActionNode:
+		s.pipe(n.Pipe, guarded, rootDot, false)
+	case *parse.TemplateNode:
+		s.pipe(n.Pipe, guarded, rootDot, false)
+	case *parse.IfNode:
+		s.branch(&n.BranchNode, guarded, rootDot, true, rootDot)
+	case *parse.WithNode:
+		// with 内部的 . 指向被测试的值
+		s.branch(&n.BranchNode, guarded, rootDot, true, false)
+	case *parse.RangeNode:
+		s.branch(&n.BranchNode, guarded, rootDot, false, false)
+	}
+}
+
+func (s *variableScanner) branch(n *parse.BranchNode, guarded map[string]bool, rootDot, guard, bodyDot bool) {
+	tested := s.pipe(n.Pipe, guarded, rootDot, guard)
+	inner := guarded
+	if len(tested) > 0 {
+		inner = make(map[string]bool, len(guarded)+len(tested))
+		for name := range guarded {
+			inner[name] = true
+		}
+		for _, name := range tested {
+			inner[name] = true
+		}
+	}
+	s.walk(n.List, inner, bodyDot)
+	s.walk(n.ElseList, guarded, rootDot)
+}
+
+// pipe records the variables of a pipeline and returns them
+func (s *variableScanner) pipe(p *parse.PipeNode, guarded map[string]bool, rootDot, optional bool) []string {
+	if p == nil {
+		return nil
+	}
+	var names []string
+	for i, cmd := range p.Cmds {
+		// {{default "x" .v}} 与 {{.v | default "x"}} 中的变量均为可选
+		opt := optional || isDefaultCmd(cmd) || (i+1 < len(p.Cmds) && isDefaultCmd(p.Cmds[i+1]))
+		for _, arg := range cmd.Args {
+			names = append(names, s.arg(arg, guarded, rootDot, opt)...)
+		}
+	}
+	return names
+}
+
+func (s *variableScanner) arg(node parse.Node, guarded map[string]bool, rootDot, optional bool) []string {
+	var name string
+	switch n := node.(type) {
+	case *parse.FieldNode:
+		if rootDot {
+			name = n.Ident[0]
+		}
+	case *parse.VariableNode:
+		if n.Ident[0] == "$" && len(n.Ident) > 1 {
+			name = n.Ident[1]
+		}
+	case *parse.ChainNode:
+		return s.arg(n.Node, guarded, rootDot, optional)
+	case *parse.PipeNode:
+		return s.pipe(n, guarded, rootDot, optional)
+	}
+	if name == "" {
+		return nil
+	}
+	s.used[name] = true
+	if !optional && !guarded[name] {
+		s.required[name] = true
+	}
+	return []string{name}
+}
+
+func isDefaultCmd(cmd *parse.CommandNode) bool {
+	if len(cmd.Args) == 0 {
+		return false
+	}
+	ident, ok := cmd.Args[0].(*parse.IdentifierNode)
+	return ok && ident.Ident == "default"
+}
+
+func titleCase(s string) string {
+	var sb strings.Builder
+	start := true
+	for _, r := range s {
+		if start {
+			r = unicode.ToTitle(r)
+		}
+		start = unicode.IsSpace(r)
+		sb.WriteRune(r)
+	}
+	return sb.String()
+}
+
+func sortedKeys(m map[string]bool) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
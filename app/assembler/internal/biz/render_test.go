@@ -0,0 +1,193 @@
+package biz
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestCompileTemplateVariables(t *testing.T) {
+	tests := []struct {
+		content   string
+		supported []string
+		required  []string
+	}{
+		{"Hello {{.name}}", []string{"name"}, []string{"name"}},
+		{`{{default "friend" .name}} {{.title | default "none"}}`, []string{"name", "title"}, []string{}},
+		{"{{if .note}}Note: {{.note}}{{end}}{{.body}}", []string{"body", "note"}, []string{"body"}},
+		// with 内部的 . 不再是变量表
+		{"{{with .user}}{{.ignored}}{{else}}{{.fallback}}{{end}}", []string{"fallback", "user"}, []string{"fallback"}},
+		{"{{range lines .items}}- {{.}} {{$.prefix}}{{end}}", []string{"items", "prefix"}, []string{"items", "prefix"}},
+		{`{{define "sig"}}-- {{.author}}{{end}}{{.body}}{{template "sig" .}}`, []string{"author", "body"}, []string{"author", "body"}},
+		{"{{upper (trim .q)}}", []string{"q"}, []string{"q"}},
+	}
+	for _, tt := range tests {
+		c, err := compileTemplate("t", tt.content)
+		if err != nil {
+			t.Errorf("%q: %v", tt.content, err)
+			continue
+		}
+		if !reflect.DeepEqual(c.supported, tt.supported) || !reflect.DeepEqual(c.required, tt.required) {
+			t.Errorf("%q: supported %v required %v, want %v %v", tt.content, c.supported, c.required, tt.supported, tt.required)
+		}
+	}
+}
+
+func TestCompileTemplateRejectsUnboundedRanges(t *testing.T) {
+	for _, content := range []string{
+		"{{range 1000000000}}x{{end}}",
+		"{{range wordcount .text}}x{{end}}",
+		"{{range (wordcount .text)}}x{{end}}",
+		"{{range .text | wordcount}}x{{end}}",
+		`{{define "inner"}}{{range 10}}{{end}}{{end}}`,
+	} {
+		if _, err := compileTemplate("t", content); err == nil {
+			t.Errorf("%q compiled, want a range error", content)
+		}
+	}
+	for _, content := range []string{
+		"{{range .items}}{{.}}{{end}}",
+		`{{range $i, $v := split "," .items}}{{$i}}={{$v}}{{end}}`,
+		"{{range .items | lines}}{{.}}{{else}}none{{end}}",
+		`{{$parts := split "," .items}}{{range $parts}}{{.}}{{end}}`,
+	} {
+		if _, err := compileTemplate("t", content); err != nil {
+			t.Errorf("%q: %v", content, err)
+		}
+	}
+}
+
+func TestExecuteBounds(t *testing.T) {
+	ctx := context.Background()
+	render := func(content string, vars map[string]string) error {
+		t.Helper()
+		c, err := compileTemplate("t", content)
+		if err != nil {
+			t.Fatalf("%q: %v", content, err)
+		}
+		_, err = c.execute(ctx, vars, OutputText)
+		return err
+	}
+
+	// 变量中的整数同样按迭代计步
+	if err := render("{{$n := 1000000000}}{{range $n}}{{end}}", nil); !errors.Is(err, errTooManySteps) {
+		t.Errorf("range over an integer variable: %v, want errTooManySteps", err)
+	}
+	// 嵌套循环即使不输出也会计步
+	items := map[string]string{"items": strings.Repeat("a,", 1000)}
+	if err := render(`{{range split "," .items}}{{range split "," $.items}}{{end}}{{end}}`, items); !errors.Is(err, errTooManySteps) {
+		t.Errorf("nested ranges: %v, want errTooManySteps", err)
+	}
+	if err := render(`{{range split "," .items}}{{.}}{{end}}`, items); err != nil {
+		t.Errorf("bounded range: %v", err)
+	}
+
+	// 每层调用两次下一层，调用次数按层数指数增长
+	var sb strings.Builder
+	for i := 0; i < 25; i++ {
+		fmt.Fprintf(&sb, `{{define "t%d"}}{{template "t%d"}}{{template "t%d"}}{{end}}`, i, i+1, i+1)
+	}
+	sb.WriteString(`{{define "t25"}}{{end}}{{template "t0"}}`)
+	if err := render(sb.String(), nil); !errors.Is(err, errTooManySteps) {
+		t.Errorf("template calls: %v, want errTooManySteps", err)
+	}
+
+	if err := render(`{{replace "" .big .big}}`, map[string]string{"big": strings.Repeat("x", 2000)}); !errors.Is(err, errOutputTooLarge) {
+		t.Errorf("replace: %v, want errOutputTooLarge", err)
+	}
+	if err := render(`{{join .big (split "" .big)}}`, map[string]string{"big": strings.Repeat("x", 2000)}); !errors.Is(err, errOutputTooLarge) {
+		t.Errorf("join: %v, want errOutputTooLarge", err)
+	}
+
+	// 循环中重新赋值的变量不输出也会占用内存，函数构造的字符串同样计入上限
+	loop := `{{range split "," "` + strings.Repeat("x,", 32) + `"}}`
+	for _, content := range []string{
+		`{{$a := printf "%1000000s" "x"}}{{range split "," "1,2,3,4,5,6,7,8,9,10,11,12"}}{{$a = printf "%s%s" $a $a}}{{end}}{{len $a}}`,
+		`{{$a := "x"}}` + loop + `{{$a = print $a $a $a}}{{end}}{{len $a}}`,
+		`{{$a := "<>"}}` + loop + `{{$a = js $a}}{{end}}{{len $a}}`,
+		`{{$a := "xx"}}` + loop + `{{$a = replace "x" "xxx" $a}}{{end}}{{len $a}}`,
+		`{{printf "%2000000d" 1}}`,
+		`{{printf "%099999999999999999999d" 1}}`,
+	} {
+		if err := render(content, nil); !errors.Is(err, errOutputTooLarge) {
+			t.Errorf("%s: %v, want errOutputTooLarge", content, err)
+		}
+	}
+	for _, content := range []string{
+		`{{printf "%*d" 2000000 1}}`,
+		`{{printf "%[2]d %[1]d" 1 2}}`,
+		`{{printf "%v" (split "," "a,b")}}`,
+		`{{printf "%d %d" 1}}`,
+		`{{slice .big 0 5000}}`,
+		`{{call upper "x"}}`,
+	} {
+		if err := render(content, map[string]string{"big": "xx"}); err == nil || errors.Is(err, errOutputTooLarge) {
+			t.Errorf("%s: %v, want a template error", content, err)
+		}
+	}
+}
+
+func TestExecuteBudgetedFuncs(t *testing.T) {
+	tests := []struct {
+		content string
+		want    string
+	}{
+		{`{{printf "%-4s|%5.2f|%x|%%|%q" "ab" 3.14159 255 "q"}}`, `ab  | 3.14|ff|%|"q"`},
+		{`{{print "a" 1 true}} {{println "b"}}`, "a1 true b\n"},
+		{`{{printf "%v" .name}} {{slice .name 1 3}} {{slice (split "," "a,b,c") 1}}`, "gopher op [b c]"},
+		{`{{indent 2 "a\nb"}}`, "  a\n  b"},
+		{`{{upper .name | replace "O" "0"}} {{join "+" (lines "a\nb")}}`, "G0PHER a+b"},
+	}
+	for _, tt := range tests {
+		c, err := compileTemplate("t", tt.content)
+		if err != nil {
+			t.Fatalf("%q: %v", tt.content, err)
+		}
+		out, err := c.execute(context.Background(), map[string]string{"name": "gopher"}, OutputText)
+		if err != nil || out != tt.want {
+			t.Errorf("%q = %q, %v, want %q", tt.content, out, err, tt.want)
+		}
+	}
+}
+
+func TestExecuteStopsWithContext(t *testing.T) {
+	c, err := compileTemplate("t", `{{range split "," .items}}{{.}}{{end}}`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	if _, err := c.execute(ctx, map[string]string{"items": "a,b"}, OutputText); !errors.Is(err, context.Canceled) {
+		t.Errorf("execute = %v, want context.Canceled", err)
+	}
+}
+
+func TestExecuteFormats(t *testing.T) {
+	ctx := context.Background()
+	c, err := compileTemplate("t", "<p>{{.name}}</p>\n\n\n<script>{{range lines .calls}}{{.}}();{{end}}</script>")
+	if err != nil {
+		t.Fatal(err)
+	}
+	vars := map[string]string{"name": "<b>Go</b>", "calls": "a\nb"}
+
+	out, err := c.execute(ctx, vars, OutputHTML)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// 计步动作不输出内容，脚本中也不会留下 ""
+	want := "<p>&lt;b&gt;Go&lt;/b&gt;</p>\n\n\n<script>\"a\"();\"b\"();</script>"
+	if out != want {
+		t.Errorf("html = %q, want %q", out, want)
+	}
+
+	out, err = c.execute(ctx, vars, OutputMarkdown)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := "<p><b>Go</b></p>\n\n<script>a();b();</script>"; out != want {
+		t.Errorf("markdown = %q, want %q", out, want)
+	}
+}
@@ -0,0 +1,206 @@
+package biz
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	v1 "rag/api/assembler/v1"
+	commonv1 "rag/api/common/v1"
+	"rag/pkg/tokenizer"
+)
+
+// Variables every context template section can use besides its own.
+const (
+	SectionVarQuery   = "query"
+	SectionVarContext = "context"
+)
+
+const sectionSeparator = "\n\n"
+
+// contextSection is a compiled ContextTemplate section
+type contextSection struct {
+	spec     *v1.TemplateSection
+	compiled *compiledTemplate
+	// 不含上下文时渲染结果的 token 数
+	overhead int
+}
+
+// contextTemplate renders the assembled context through the sections of a
+// ContextTemplate, keeping each section within its max_length tokens.
+type contextTemplate struct {
+	sections []*contextSection
+	vars     map[string]string
+}
+
+// loadContextTemplate compiles the request's template sections. A template
+// that only names a stored template renders that template as one section.
+func (uc *AssembleUsecase) loadContextTemplate(ctx context.Context, tmpl *v1.ContextTemplate, query string) (*contextTemplate, error) {
+	specs := tmpl.Sections
+	if len(specs) == 0 && tmpl.TemplateName != "" {
+		stored, err := uc.templates.GetTemplate(ctx, tmpl.TemplateName)
+		if err != nil {
+			return nil, err
+		}
+		specs = []*v1.TemplateSection{{
+			SectionName:     stored.Name,
+			SectionTemplate: stored.Content,
+			IsRequired:      true,
+		}}
+	}
+	if len(specs) == 0 {
+		return nil, nil
+	}
+
+	t := &contextTemplate{vars: make(map[string]string, len(tmpl.Variables)+2)}
+	for k, v := range tmpl.Variables {
+		t.vars[k] = v
+	}
+	t.vars[SectionVarQuery] = query
+	for i, spec := range specs {
+		name := spec.SectionName
+		if name == "" {
+			name = fmt.Sprintf("section_%d", i+1)
+		}
+		compiled, err := compileTemplate(name, spec.SectionTemplate)
+		if err != nil {
+			return nil, ErrInvalidTemplate.WithCause(err).WithMetadata(map[string]string{"section": name})
+		}
+		t.sections = append(t.sections, &contextSection{spec: spec, compiled: compiled})
+	}
+	return t, nil
+}
+
+// reserve measures the sections without the context and returns the tokens
+// left for chunks out of budget.
+func (t *contextTemplate) reserve(ctx context.Context, tok tokenizer.Tokenizer, budget int) int {
+	vars := t.withContext("")
+	available := budget - countTokens(tok, sectionSeparator)*(len(t.sections)-1)
+	for _, s := range t.sections {
+		// 渲染失败的分节在最终渲染时处理，这里不预留
+		out, err := s.compiled.execute(ctx, vars, OutputText)
+		if err != nil {
+			continue
+		}
+		s.overhead = countTokens(tok, out)
+		if limit := int(s.spec.MaxLength); limit > 0 {
+			s.overhead = min(s.overhead, limit)
+			// 引用上下文的分节不能超过其长度上限
+			if containsString(s.compiled.supported, SectionVarContext) {
+				available = min(available, limit-s.overhead)
+			}
+		}
+		available -= s.overhead
+	}
+	return max(available, 0)
+}
+
+// render renders every section with the assembled context. Sections over
+// their max_length are cut at a word boundary; optional sections that fail
+// or render empty are left out.
+func (t *contextTemplate) render(ctx context.Context, tok tokenizer.Tokenizer, assembled string, warnings *[]string) (string, error) {
+	vars := t.withContext(assembled)
+	var parts []string
+	referenced := false
+	for _, s := range t.sections {
+		referenced = referenced || containsString(s.compiled.supported, SectionVarContext)
+	}
+	if !referenced {
+		*warnings = append(*warnings, "template does not reference {{.context}}, the selected chunks are not part of the output")
+	}
+	for _, s := range t.sections {
+		name := s.compiled.name
+		out, err := s.compiled.execute(ctx, vars, OutputText)
+		if err != nil {
+			if s.spec.IsRequired {
+				return "", ErrTemplateRender.WithCause(err).WithMetadata(map[string]string{"section": name})
+			}
+			*warnings = append(*warnings, fmt.Sprintf("section %s failed to render and was skipped: %v", name, err))
+			continue
+		}
+		out = strings.TrimSpace(out)
+		if limit := int(s.spec.MaxLength); limit > 0 && countTokens(tok, out) > limit {
+			out = truncateTokens(tok, out, limit)
+			*warnings = append(*warnings, fmt.Sprintf("section %s truncated to %d tokens", name, limit))
+		}
+		if out == "" {
+			if s.spec.IsRequired {
+				*warnings = append(*warnings, fmt.Sprintf("required section %s rendered empty", name))
+			}
+			continue
+		}
+		parts = append(parts, out)
+	}
+	return strings.Join(parts, sectionSeparator), nil
+}
+
+func (t *contextTemplate) withContext(assembled string) map[string]string {
+	vars := make(map[string]string, len(t.vars)+1)
+	for k, v := range t.vars {
+		vars[k] = v
+	}
+	vars[SectionVarContext] = assembled
+	return vars
+}
+
+// truncateTokens returns the longest prefix of text, cut after a word, that
+// has at most limit tokens.
+func truncateTokens(tok tokenizer.Tokenizer, text string, limit int) string {
+	runes := []rune(text)
+	lo, hi := 0, len(runes)
+	for lo < hi {
+		mid := (lo + hi + 1) / 2
+		if countTokens(tok, string(runes[:mid])) <= limit {
+			lo = mid
+		} else {
+			hi = mid - 1
+		}
+	}
+	cut := lo
+	// 回退到词边界，避免截断半个单词
+	for cut > 0 && cut < len(runes) && !unicode.IsSpace(runes[cut]) && !unicode.Is(unicode.Han, runes[cut]) {
+		cut--
+	}
+	if cut == 0 {
+		cut = lo
+	}
+	return strings.TrimSpace(string(runes[:cut]))
+}
+
+// relocateCitations moves citation offsets from the assembled context to
+// where the cited text landed in the rendered output. Citations whose text
+// was cut are dropped.
+func relocateCitations(citations []*commonv1.Citation, assembled, rendered string, warnings *[]string) []*commonv1.Citation {
+	source := []rune(assembled)
+	kept := make([]*commonv1.Citation, 0, len(citations))
+	var dropped []string
+	from := 0
+	for _, c := range citations {
+		cited := string(source[c.StartOffset:c.EndOffset])
+		i := strings.Index(rendered[from:], cited)
+		if i < 0 {
+			dropped = append(dropped, c.ChunkId)
+			continue
+		}
+		start := utf8.RuneCountInString(rendered[:from+i])
+		c.StartOffset = int32(start)
+		c.EndOffset = int32(start + utf8.RuneCountInString(cited))
+		from += i + len(cited)
+		kept = append(kept, c)
+	}
+	if len(dropped) > 0 {
+		*warnings = append(*warnings, fmt.Sprintf("citations of chunks %s were cut from the rendered context", strings.Join(dropped, ", ")))
+	}
+	return kept
+}
+
+func containsString(items []string, s string) bool {
+	for _, item := range items {
+		if item == s {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,246 @@
+package biz
+
+import (
+	"math"
+
+	v1 "rag/api/assembler/v1"
+)
+
+// Selection strategy names accepted in ContentSelectionStrategy.strategy_type.
+const (
+	StrategyTopK      = "top_k"
+	StrategyThreshold = "threshold"
+	StrategyDiverse   = "diverse"
+	StrategyMMR       = "mmr"
+	StrategyHybrid    = "hybrid"
+)
+
+// Usage reasons reported in UsedChunk.usage_reason.
+const (
+	ReasonHighRelevance     = "high_relevance"
+	ReasonDiversity         = "diversity"
+	ReasonContextCompletion = "context_completion"
+)
+
+const (
+	defaultDiversityWeight     = 0.3
+	defaultRedundancyThreshold = 0.85
+)
+
+// scoredChunk is a candidate chunk with its token cost and term vector
+type scoredChunk struct {
+	chunk    *v1.DocumentChunk
+	order    int
+	tokens   int
+	priority bool
+	vector   termVector
+	features *chunkFeatures
+	reason   string
+}
+
+func (c *scoredChunk) score() float64 {
+	return float64(c.chunk.RelevanceScore)
+}
+
+// selectionFunc picks chunks from pool into the selection
+type selectionFunc func(s *selection, pool []*scoredChunk, strategy *v1.ContentSelectionStrategy)
+
+var selectionFuncs = map[string]selectionFunc{
+	StrategyTopK:      selectTopK,
+	StrategyThreshold: selectThreshold,
+	StrategyDiverse:   selectDiverse,
+	StrategyMMR:       selectMMR,
+	StrategyHybrid:    selectHybrid,
+}
+
+// selection tracks the chosen chunks against the token budget
+type selection struct {
+	remaining int
+	maxChunks int
+	sepTokens int
+	chosen    []*scoredChunk
+	taken     map[*scoredChunk]bool
+}
+
+func newSelection(budget, maxChunks, sepTokens int) *selection {
+	return &selection{
+		remaining: budget,
+		maxChunks: maxChunks,
+		sepTokens: sepTokens,
+		taken:     make(map[*scoredChunk]bool),
+	}
+}
+
+// full reports whether no further chunk may be added
+func (s *selection) full() bool {
+	return s.remaining <= 0 || (s.maxChunks > 0 && len(s.chosen) >= s.maxChunks)
+}
+
+// cost returns the tokens a chunk consumes including its separator
+func (s *selection) cost(c *scoredChunk) int {
+	if len(s.chosen) == 0 {
+		return c.tokens
+	}
+	return c.tokens + s.sepTokens
+}
+
+func (s *selection) fits(c *scoredChunk) bool {
+	return !s.taken[c] && !s.full() && s.cost(c) <= s.remaining
+}
+
+func (s *selection) add(c *scoredChunk, reason string) {
+	s.remaining -= s.cost(c)
+	c.reason = reason
+	s.chosen = append(s.chosen, c)
+	s.taken[c] = true
+}
+
+// maxSimilarity returns the highest similarity between c and any chosen chunk
+func (s *selection) maxSimilarity(c *scoredChunk) float64 {
+	var best float64
+	for _, chosen := range s.chosen {
+		if sim := cosine(c.vector, chosen.vector); sim > best {
+			best = sim
+		}
+	}
+	return best
+}
+
+// selectTopK takes chunks in relevance order until the budget is spent
+func selectTopK(s *selection, pool []*scoredChunk, strategy *v1.ContentSelectionStrategy) {
+	for _, c := range pool {
+		if s.full() {
+			return
+		}
+		if s.fits(c) {
+			s.add(c, ReasonHighRelevance)
+		}
+	}
+}
+
+// selectThreshold takes chunks scoring at least score_threshold in relevance order
+func selectThreshold(s *selection, pool []*scoredChunk, strategy *v1.ContentSelectionStrategy) {
+	selectTopK(s, aboveThreshold(pool, strategy.GetScoreThreshold()), strategy)
+}
+
+// selectDiverse greedily picks the best chunk after penalising documents that
+// already contributed chunks, skipping near-duplicates of chosen chunks.
+func selectDiverse(s *selection, pool []*scoredChunk, strategy *v1.ContentSelectionStrategy) {
+	weight := diversityWeight(strategy)
+	redundancy := redundancyThreshold(strategy)
+	perDocument := make(map[string]int)
+	for _, c := range s.chosen {
+		perDocument[c.chunk.DocumentId]++
+	}
+	greedySelect(s, pool, redundancy, func(c *scoredChunk) float64 {
+		// 同一文档每多选一个分块，得分按 (1 - w)^n 衰减
+		return c.score() * math.Pow(1-weight, float64(perDocument[c.chunk.DocumentId]))
+	}, func(c *scoredChunk) {
+		perDocument[c.chunk.DocumentId]++
+	})
+}
+
+// selectMMR picks chunks by maximal marginal relevance with λ = 1 - diversity_weight
+func selectMMR(s *selection, pool []*scoredChunk, strategy *v1.ContentSelectionStrategy) {
+	lambda := 1 - diversityWeight(strategy)
+	greedySelect(s, pool, redundancyThreshold(strategy), func(c *scoredChunk) float64 {
+		return lambda*c.score() - (1-lambda)*s.maxSimilarity(c)
+	}, nil)
+}
+
+// selectHybrid applies the score threshold, selects by MMR, then spends the
+// remaining budget on chunks adjacent to the selected ones.
+func selectHybrid(s *selection, pool []*scoredChunk, strategy *v1.ContentSelectionStrategy) {
+	selectMMR(s, aboveThreshold(pool, strategy.GetScoreThreshold()), strategy)
+
+	// 补全上下文：同一文档中相邻位置且不重复的分块
+	redundancy := redundancyThreshold(strategy)
+	for _, c := range pool {
+		if s.full() {
+			return
+		}
+		if s.fits(c) && adjacentToChosen(s, c) && s.maxSimilarity(c) < redundancy {
+			s.add(c, ReasonContextCompletion)
+		}
+	}
+}
+
+// greedySelect repeatedly adds the candidate with the highest objective. A
+// pick is reported as diversity when a more relevant candidate was passed over.
+func greedySelect(s *selection, pool []*scoredChunk, redundancy float64, objective func(*scoredChunk) float64, picked func(*scoredChunk)) {
+	skipped := make(map[*scoredChunk]bool)
+	for !s.full() {
+		var (
+			best, top *scoredChunk
+			bestValue float64
+		)
+		for _, c := range pool {
+			if !s.fits(c) {
+				continue
+			}
+			if top == nil {
+				top = c
+			}
+			if skipped[c] {
+				continue
+			}
+			if s.maxSimilarity(c) >= redundancy {
+				// 与已选分块高度重复，直接跳过
+				skipped[c] = true
+				continue
+			}
+			if value := objective(c); best == nil || value > bestValue {
+				best, bestValue = c, value
+			}
+		}
+		if best == nil {
+			return
+		}
+		reason := ReasonHighRelevance
+		if best != top && best.score() < top.score() {
+			reason = ReasonDiversity
+		}
+		s.add(best, reason)
+		if picked != nil {
+			picked(best)
+		}
+	}
+}
+
+func adjacentToChosen(s *selection, c *scoredChunk) bool {
+	for _, chosen := range s.chosen {
+		if chosen.chunk.DocumentId != c.chunk.DocumentId || chosen.reason == ReasonContextCompletion {
+			continue
+		}
+		if d := chosen.chunk.PositionInDocument - c.chunk.PositionInDocument; d == 1 || d == -1 {
+			return true
+		}
+	}
+	return false
+}
+
+func aboveThreshold(pool []*scoredChunk, threshold float32) []*scoredChunk {
+	var out []*scoredChunk
+	for _, c := range pool {
+		if c.chunk.RelevanceScore >= threshold {
+			out = append(out, c)
+		}
+	}
+	return out
+}
+
+func diversityWeight(strategy *v1.ContentSelectionStrategy) float64 {
+	w := float64(strategy.GetDiversityWeight())
+	if w <= 0 || w > 1 {
+		return defaultDiversityWeight
+	}
+	return w
+}
+
+func redundancyThreshold(strategy *v1.ContentSelectionStrategy) float64 {
+	t := float64(strategy.GetRedundancyThreshold())
+	if t <= 0 || t > 1 {
+		return defaultRedundancyThreshold
+	}
+	return t
+}
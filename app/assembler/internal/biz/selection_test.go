@@ -0,0 +1,115 @@
+package biz
+
+import (
+	"reflect"
+	"testing"
+
+	v1 "rag/api/assembler/v1"
+)
+
+func scored(id, document string, position int32, score float32, content string) *scoredChunk {
+	return &scoredChunk{
+		chunk: &v1.DocumentChunk{
+			ChunkId:            id,
+			DocumentId:         document,
+			Content:            content,
+			RelevanceScore:     score,
+			PositionInDocument: position,
+		},
+		tokens: 10,
+		vector: newTermVector(content),
+	}
+}
+
+func chosenIDs(s *selection) []string {
+	ids := make([]string, len(s.chosen))
+	for i, c := range s.chosen {
+		ids[i] = c.chunk.ChunkId + ":" + c.reason
+	}
+	return ids
+}
+
+func selectionPool() []*scoredChunk {
+	return []*scoredChunk{
+		scored("a", "d1", 1, 0.9, "go channels goroutines"),
+		scored("b", "d1", 2, 0.85, "go channels goroutines select timers"),
+		scored("c", "d2", 1, 0.6, "database indexing btree"),
+	}
+}
+
+func TestSelectMMR(t *testing.T) {
+	strategy := &v1.ContentSelectionStrategy{DiversityWeight: 0.5}
+
+	s := newSelection(100, 2, 0)
+	selectMMR(s, selectionPool(), strategy)
+	// b 与 a 相似，MMR 先选与已选内容不同的 c
+	if got, want := chosenIDs(s), []string{"a:" + ReasonHighRelevance, "c:" + ReasonDiversity}; !reflect.DeepEqual(got, want) {
+		t.Errorf("mmr = %v, want %v", got, want)
+	}
+
+	s = newSelection(100, 2, 0)
+	selectTopK(s, selectionPool(), strategy)
+	if got, want := chosenIDs(s), []string{"a:" + ReasonHighRelevance, "b:" + ReasonHighRelevance}; !reflect.DeepEqual(got, want) {
+		t.Errorf("top_k = %v, want %v", got, want)
+	}
+
+	// 只看相关性时 MMR 等同于 top_k
+	s = newSelection(100, 2, 0)
+	selectMMR(s, selectionPool(), &v1.ContentSelectionStrategy{DiversityWeight: 0.01})
+	if got, want := chosenIDs(s), []string{"a:" + ReasonHighRelevance, "b:" + ReasonHighRelevance}; !reflect.DeepEqual(got, want) {
+		t.Errorf("mmr with λ≈1 = %v, want %v", got, want)
+	}
+}
+
+func TestSelectSkipsRedundantChunks(t *testing.T) {
+	pool := []*scoredChunk{
+		scored("a", "d1", 1, 0.9, "go channels goroutines"),
+		scored("copy", "d2", 1, 0.8, "Go channels, goroutines."),
+		scored("c", "d3", 1, 0.1, "database indexing btree"),
+	}
+	s := newSelection(100, 0, 0)
+	selectMMR(s, pool, &v1.ContentSelectionStrategy{DiversityWeight: 0.01})
+	if got, want := chosenIDs(s), []string{"a:" + ReasonHighRelevance, "c:" + ReasonDiversity}; !reflect.DeepEqual(got, want) {
+		t.Errorf("mmr = %v, want %v", got, want)
+	}
+}
+
+func TestSelectDiverse(t *testing.T) {
+	pool := selectionPool()
+	pool[2].chunk.RelevanceScore = 0.7
+	s := newSelection(100, 2, 0)
+	// 同一文档的第二个分块得分减半：0.85 * 0.5 < 0.7
+	selectDiverse(s, pool, &v1.ContentSelectionStrategy{DiversityWeight: 0.5, RedundancyThreshold: 1})
+	if got, want := chosenIDs(s), []string{"a:" + ReasonHighRelevance, "c:" + ReasonDiversity}; !reflect.DeepEqual(got, want) {
+		t.Errorf("diverse = %v, want %v", got, want)
+	}
+}
+
+func TestSelectHybridCompletesContext(t *testing.T) {
+	pool := []*scoredChunk{
+		scored("a", "d1", 5, 0.9, "go channels goroutines"),
+		scored("c", "d2", 1, 0.8, "database indexing btree"),
+		scored("next", "d1", 6, 0.2, "select statements wait on channels"),
+		scored("far", "d1", 9, 0.2, "garbage collection pauses"),
+	}
+	s := newSelection(100, 0, 0)
+	selectHybrid(s, pool, &v1.ContentSelectionStrategy{ScoreThreshold: 0.5})
+	want := []string{"a:" + ReasonHighRelevance, "c:" + ReasonHighRelevance, "next:" + ReasonContextCompletion}
+	if got := chosenIDs(s); !reflect.DeepEqual(got, want) {
+		t.Errorf("hybrid = %v, want %v", got, want)
+	}
+}
+
+func TestSelectionBudget(t *testing.T) {
+	pool := selectionPool()
+	pool[1].tokens = 50
+	// 第二个分块加分隔符超出预算，跳过后仍可选更小的分块
+	s := newSelection(30, 0, 2)
+	selectTopK(s, pool, &v1.ContentSelectionStrategy{})
+	if got, want := chosenIDs(s), []string{"a:" + ReasonHighRelevance, "c:" + ReasonHighRelevance}; !reflect.DeepEqual(got, want) {
+		t.Errorf("top_k = %v, want %v", got, want)
+	}
+	if s.remaining != 30-10-12 {
+		t.Errorf("remaining = %d, want %d", s.remaining, 30-10-12)
+	}
+}
@@ -0,0 +1,68 @@
+package biz
+
+import (
+	"math"
+	"strings"
+	"unicode"
+)
+
+// termVector is a sparse term-frequency vector with a cached norm
+type termVector struct {
+	terms map[string]float64
+	norm  float64
+}
+
+// newTermVector builds a term-frequency vector from text
+func newTermVector(text string) termVector {
+	terms := make(map[string]float64)
+	for _, term := range splitTerms(text) {
+		terms[term]++
+	}
+	var sum float64
+	for _, tf := range terms {
+		sum += tf * tf
+	}
+	return termVector{terms: terms, norm: math.Sqrt(sum)}
+}
+
+// cosine returns the cosine similarity of two term vectors
+func cosine(a, b termVector) float64 {
+	if a.norm == 0 || b.norm == 0 {
+		return 0
+	}
+	if len(a.terms) > len(b.terms) {
+		a, b = b, a
+	}
+	var dot float64
+	for term, tf := range a.terms {
+		dot += tf * b.terms[term]
+	}
+	return dot / (a.norm * b.norm)
+}
+
+// splitTerms lowercases text and splits it into words, treating each Han character as a term
+func splitTerms(text string) []string {
+	var (
+		terms []string
+		word  strings.Builder
+	)
+	flush := func() {
+		if word.Len() > 0 {
+			terms = append(terms, word.String())
+			word.Reset()
+		}
+	}
+	for _, r := range strings.ToLower(text) {
+		switch {
+		case unicode.Is(unicode.Han, r):
+			flush()
+			terms = append(terms, string(r))
+		case unicode.IsLetter(r) || unicode.IsDigit(r):
+			word.WriteRune(r)
+		default:
+			flush()
+		}
+	}
+	flush()
+	return terms
+}
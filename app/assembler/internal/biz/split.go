@@ -0,0 +1,403 @@
+package biz
+
+import (
+	"context"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+	"unicode"
+	"unicode/utf8"
+
+	v1 "rag/api/assembler/v1"
+	commonv1 "rag/api/common/v1"
+	"rag/pkg/tokenizer"
+
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+// Split strategy names accepted in SplitOptions.split_strategy.
+const (
+	SplitSentence    = "sentence"
+	SplitParagraph   = "paragraph"
+	SplitSemantic    = "semantic"
+	SplitFixedLength = "fixed_length"
+)
+
+const (
+	defaultMaxChunkSize = 512
+	// 相邻句子相似度低于该值时视为话题切换
+	semanticBreakSimilarity = 0.1
+)
+
+var (
+	// ErrInvalidOverlap is returned when the chunk overlap does not leave room for new content.
+	ErrInvalidOverlap = errors.BadRequest(commonv1.ErrorCode_ERROR_CODE_BAD_REQUEST.String(), "chunk_overlap must be smaller than max_chunk_size")
+	// ErrEmptyContent is returned when there is nothing to split.
+	ErrEmptyContent = errors.BadRequest(commonv1.ErrorCode_ERROR_CODE_BAD_REQUEST.String(), "content must not be empty")
+
+	paragraphBreak = regexp.MustCompile(`\n[ \t\r]*\n\s*`)
+)
+
+// span is a byte range of the content being split
+type span struct {
+	start, end int
+}
+
+// SplitUsecase splits long content into token-bounded chunks
+type SplitUsecase struct {
+	tokenizers TokenizerRepo
+	log        *log.Helper
+}
+
+// NewSplitUsecase creates a new split usecase
+func NewSplitUsecase(tokenizers TokenizerRepo, logger log.Logger) *SplitUsecase {
+	return &SplitUsecase{
+		tokenizers: tokenizers,
+		log:        log.NewHelper(logger),
+	}
+}
+
+// SplitContent splits content into chunks of at most max_chunk_size tokens,
+// cutting at sentence, paragraph or word boundaries and repeating up to
+// chunk_overlap tokens of trailing units at the start of the next chunk.
+func (uc *SplitUsecase) SplitContent(ctx context.Context, req *v1.SplitContentRequest) (*v1.SplitContentResponse, error) {
+	startTime := time.Now()
+	if strings.TrimSpace(req.Content) == "" {
+		return nil, ErrEmptyContent
+	}
+	options := req.Options
+	if options == nil {
+		options = &v1.SplitOptions{}
+	}
+	maxTokens := int(options.MaxChunkSize)
+	if maxTokens <= 0 {
+		maxTokens = defaultMaxChunkSize
+	}
+	overlap := int(options.ChunkOverlap)
+	if overlap >= maxTokens {
+		return nil, ErrInvalidOverlap
+	}
+	tok, err := uc.tokenizers.GetTokenizer(ctx, options.TokenizerName)
+	if err != nil {
+		return nil, err
+	}
+
+	strategy := options.SplitStrategy
+	switch strategy {
+	case SplitSentence, SplitParagraph, SplitSemantic, SplitFixedLength:
+	default:
+		strategy = SplitSentence
+	}
+	uc.log.WithContext(ctx).Infof("Splitting %d bytes with strategy %s into chunks of %d tokens", len(req.Content), strategy, maxTokens)
+
+	s := &splitter{
+		text:     req.Content,
+		tok:      tok,
+		max:      maxTokens,
+		overlap:  overlap,
+		preserve: options.PreserveBoundaries,
+		semantic: strategy == SplitSemantic,
+	}
+	var units []span
+	switch strategy {
+	case SplitParagraph:
+		units = splitAt(req.Content, paragraphBreaks(req.Content))
+	case SplitFixedLength:
+		units = wordSpans(req.Content, span{0, len(req.Content)})
+	default:
+		units = splitAt(req.Content, sentenceBreaks(req.Content))
+	}
+	if markers := markerBreaks(req.Content, options.BoundaryMarkers); len(markers) > 0 {
+		units = refine(units, markers)
+	}
+
+	chunks := make([]*v1.ContentChunk, 0)
+	var totalTokens int
+	for _, sp := range s.pack(units) {
+		sp = trimSpan(req.Content, sp)
+		if sp.start >= sp.end {
+			continue
+		}
+		content := req.Content[sp.start:sp.end]
+		n := countTokens(tok, content)
+		totalTokens += n
+		chunks = append(chunks, &v1.ContentChunk{
+			ChunkIndex:    int32(len(chunks)),
+			Content:       content,
+			StartPosition: int32(utf8.RuneCountInString(req.Content[:sp.start])),
+			EndPosition:   int32(utf8.RuneCountInString(req.Content[:sp.end])),
+			TokenCount:    int32(n),
+			ChunkMetadata: map[string]string{"tokenizer": tok.Name()},
+		})
+	}
+
+	var avg float32
+	if len(chunks) > 0 {
+		avg = float32(totalTokens) / float32(len(chunks))
+	}
+	return &v1.SplitContentResponse{
+		Chunks: chunks,
+		Metadata: &v1.SplitMetadata{
+			TotalChunks:       int32(len(chunks)),
+			OriginalLength:    int32(utf8.RuneCountInString(req.Content)),
+			AvgChunkSize:      avg,
+			SplitStrategyUsed: strategy,
+			SplitTimeMs:       time.Since(startTime).Milliseconds(),
+		},
+	}, nil
+}
+
+// splitter packs units into token-bounded chunks
+type splitter struct {
+	text     string
+	tok      tokenizer.Tokenizer
+	max      int
+	overlap  int
+	preserve bool
+	semantic bool
+}
+
+// pack greedily fills chunks with units. Units larger than a chunk are split
+// into words; without preserved boundaries a unit that does not fit is split
+// into words to fill the current chunk.
+func (s *splitter) pack(units []span) []span {
+	var (
+		chunks  []span
+		cur     []span
+		tokens  []int
+		total   int
+		fresh   int
+		prevVec termVector
+	)
+	emit := func() {
+		// 只含上一块重叠部分时不重复输出
+		if fresh == 0 {
+			return
+		}
+		fresh = 0
+		chunks = append(chunks, span{cur[0].start, cur[len(cur)-1].end})
+		// 保留末尾不超过 overlap 的单元作为下一块的开头
+		keep, kept := len(cur), 0
+		for keep > 0 && kept+tokens[keep-1] <= s.overlap {
+			keep--
+			kept += tokens[keep]
+		}
+		cur, tokens, total = append([]span(nil), cur[keep:]...), append([]int(nil), tokens[keep:]...), kept
+	}
+
+	queue := append([]span(nil), units...)
+	for len(queue) > 0 {
+		u := queue[0]
+		queue = queue[1:]
+		n := countTokens(s.tok, s.text[u.start:u.end])
+		if n == 0 && len(cur) == 0 {
+			continue
+		}
+		if n > s.max {
+			queue = append(s.atoms(u), queue...)
+			continue
+		}
+
+		var vec termVector
+		topicShift := false
+		if s.semantic {
+			vec = newTermVector(s.text[u.start:u.end])
+			topicShift = len(cur) > 0 && total >= s.max/4 && cosine(prevVec, vec) < semanticBreakSimilarity
+			prevVec = vec
+		}
+		if len(cur) > 0 && (total+n > s.max || topicShift) {
+			if !s.preserve && !topicShift && total < s.max {
+				if atoms := s.atoms(u); len(atoms) > 1 {
+					queue = append(atoms, queue...)
+					fresh += s.fill(&queue, &cur, &tokens, &total)
+					emit()
+					continue
+				}
+			}
+			emit()
+			// 重叠部分过大时放弃重叠，保证新单元放得下
+			for len(cur) > 0 && total+n > s.max {
+				total -= tokens[0]
+				cur, tokens = cur[1:], tokens[1:]
+			}
+		}
+		cur = append(cur, u)
+		tokens = append(tokens, n)
+		total += n
+		fresh++
+	}
+	if len(cur) > 0 {
+		emit()
+	}
+	return chunks
+}
+
+// fill moves leading atoms from the queue into the current chunk while they
+// fit and returns how many were moved.
+func (s *splitter) fill(queue *[]span, cur *[]span, tokens *[]int, total *int) int {
+	moved := 0
+	for len(*queue) > 0 {
+		u := (*queue)[0]
+		n := countTokens(s.tok, s.text[u.start:u.end])
+		if *total+n > s.max {
+			break
+		}
+		*queue = (*queue)[1:]
+		*cur = append(*cur, u)
+		*tokens = append(*tokens, n)
+		*total += n
+		moved++
+	}
+	return moved
+}
+
+// atoms splits a unit into words, and a single oversized word into runs of
+// characters that fit into a chunk.
+func (s *splitter) atoms(u span) []span {
+	words := wordSpans(s.text, u)
+	if len(words) > 1 {
+		return words
+	}
+	var out []span
+	start := u.start
+	for start < u.end {
+		end := start
+		for end < u.end {
+			_, size := utf8.DecodeRuneInString(s.text[end:])
+			if end > start && countTokens(s.tok, s.text[start:end+size]) > s.max {
+				break
+			}
+			end += size
+		}
+		out = append(out, span{start, end})
+		start = end
+	}
+	return out
+}
+
+// sentenceBreaks returns the offsets after sentence terminators and newlines
+func sentenceBreaks(text string) []int {
+	var breaks []int
+	for i, r := range text {
+		size := utf8.RuneLen(r)
+		next := i + size
+		switch r {
+		case '。', '！', '？', '；', '\n':
+			breaks = append(breaks, next)
+		case '.', '!', '?', ';':
+			// 英文句末标点后需跟空白，避免切开小数和缩写
+			if next >= len(text) || unicode.IsSpace(rune(text[next])) {
+				breaks = append(breaks, next)
+			}
+		}
+	}
+	return breaks
+}
+
+func paragraphBreaks(text string) []int {
+	var breaks []int
+	for _, loc := range paragraphBreak.FindAllStringIndex(text, -1) {
+		breaks = append(breaks, loc[1])
+	}
+	return breaks
+}
+
+// markerBreaks returns the offsets after every occurrence of a boundary marker
+func markerBreaks(text string, markers []string) []int {
+	var breaks []int
+	for _, marker := range markers {
+		if marker == "" {
+			continue
+		}
+		for from := 0; ; {
+			i := strings.Index(text[from:], marker)
+			if i < 0 {
+				break
+			}
+			from += i + len(marker)
+			breaks = append(breaks, from)
+		}
+	}
+	sort.Ints(breaks)
+	return breaks
+}
+
+// splitAt cuts text into consecutive spans at the given offsets
+func splitAt(text string, breaks []int) []span {
+	var units []span
+	start := 0
+	for _, b := range breaks {
+		if b > start && b < len(text) {
+			units = append(units, span{start, b})
+			start = b
+		}
+	}
+	return append(units, span{start, len(text)})
+}
+
+// refine cuts units further at the given sorted offsets
+func refine(units []span, breaks []int) []span {
+	var out []span
+	for _, u := range units {
+		start := u.start
+		for _, b := range breaks {
+			if b > start && b < u.end {
+				out = append(out, span{start, b})
+				start = b
+			}
+		}
+		out = append(out, span{start, u.end})
+	}
+	return out
+}
+
+// wordSpans splits a span into words with their trailing whitespace, treating
+// each Han character as a word.
+func wordSpans(text string, u span) []span {
+	var words []span
+	start := u.start
+	inSpace := false
+	for i := u.start; i < u.end; {
+		r, size := utf8.DecodeRuneInString(text[i:])
+		switch {
+		case unicode.IsSpace(r):
+			inSpace = true
+		case unicode.Is(unicode.Han, r):
+			if i > start {
+				words = append(words, span{start, i})
+			}
+			start, inSpace = i, true
+		default:
+			if inSpace && i > start {
+				words = append(words, span{start, i})
+				start = i
+			}
+			inSpace = false
+		}
+		i += size
+	}
+	if start < u.end {
+		words = append(words, span{start, u.end})
+	}
+	return words
+}
+
+func trimSpan(text string, sp span) span {
+	for sp.start < sp.end {
+		r, size := utf8.DecodeRuneInString(text[sp.start:])
+		if !unicode.IsSpace(r) {
+			break
+		}
+		sp.start += size
+	}
+	for sp.end > sp.start {
+		r, size := utf8.DecodeLastRuneInString(text[:sp.end])
+		if !unicode.IsSpace(r) {
+			break
+		}
+		sp.end -= size
+	}
+	return sp
+}
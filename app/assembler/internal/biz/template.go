@@ -0,0 +1,300 @@
+package biz
+
+import (
+	"context"
+	"fmt"
+	"html"
+	"regexp"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	v1 "rag/api/assembler/v1"
+	commonv1 "rag/api/common/v1"
+
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/log"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// Updatable template fields accepted in UpdateTemplateRequest.update_fields.
+const (
+	TemplateFieldDisplayName = "display_name"
+	TemplateFieldDescription = "description"
+	TemplateFieldCategory    = "category"
+	TemplateFieldContent     = "content"
+)
+
+var (
+	// ErrTemplateNotFound is returned when no template has the requested name.
+	ErrTemplateNotFound = errors.NotFound(commonv1.ErrorCode_ERROR_CODE_NOT_FOUND.String(), "template not found")
+	// ErrTemplateExists is returned when creating a template whose name is taken.
+	ErrTemplateExists = errors.Conflict(commonv1.ErrorCode_ERROR_CODE_CONFLICT.String(), "template already exists")
+	// ErrInvalidTemplateName is returned for names that are not safe file names.
+	ErrInvalidTemplateName = errors.BadRequest(commonv1.ErrorCode_ERROR_CODE_BAD_REQUEST.String(), "template name must match ^[a-z0-9][a-z0-9_-]*$")
+	// ErrInvalidTemplate is returned when template content does not parse.
+	ErrInvalidTemplate = errors.BadRequest(commonv1.ErrorCode_ERROR_CODE_BAD_REQUEST.String(), "invalid template")
+	// ErrInvalidOutputFormat is returned for unknown output formats.
+	ErrInvalidOutputFormat = errors.BadRequest(commonv1.ErrorCode_ERROR_CODE_BAD_REQUEST.String(), "output_format must be one of text, markdown, html")
+	// ErrMissingVariables is returned in strict mode when required variables have no value.
+	ErrMissingVariables = errors.BadRequest(commonv1.ErrorCode_ERROR_CODE_BAD_REQUEST.String(), "required template variables are missing")
+	// ErrTemplateRender is returned when a template fails while executing.
+	ErrTemplateRender = errors.New(422, commonv1.ErrorCode_ERROR_CODE_UNPROCESSABLE_ENTITY.String(), "template rendering failed")
+
+	templateName = regexp.MustCompile(`^[a-z0-9][a-z0-9_-]*$`)
+)
+
+// Template represents a stored prompt template
+type Template struct {
+	Name        string
+	DisplayName string
+	Description string
+	Category    string
+	Content     string
+	CreatedAt   time.Time
+	UpdatedAt   time.Time
+}
+
+// TemplateRepo defines the data access interface for templates
+type TemplateRepo interface {
+	// 获取模板
+	GetTemplate(ctx context.Context, name string) (*Template, error)
+	// 列出模板，支持过滤和分页
+	ListTemplates(ctx context.Context, pagination *commonv1.PaginationRequest, filters []*commonv1.Filter) ([]*Template, *commonv1.PaginationResponse, error)
+	// 创建模板
+	CreateTemplate(ctx context.Context, t *Template) error
+	// 更新模板
+	UpdateTemplate(ctx context.Context, t *Template) error
+	// 删除模板
+	DeleteTemplate(ctx context.Context, name string) error
+	// 记录一次渲染
+	RecordUsage(ctx context.Context, name string, elapsed time.Duration, variables []string)
+	// 获取使用统计
+	GetStatistics(ctx context.Context, name string) *v1.TemplateStatistics
+}
+
+// TemplateUsecase handles template management and rendering
+type TemplateUsecase struct {
+	repo TemplateRepo
+	log  *log.Helper
+}
+
+// NewTemplateUsecase creates a new template usecase
+func NewTemplateUsecase(repo TemplateRepo, logger log.Logger) *TemplateUsecase {
+	return &TemplateUsecase{
+		repo: repo,
+		log:  log.NewHelper(logger),
+	}
+}
+
+// RenderTemplate renders a stored template. Request variables override the
+// default values; in strict mode a missing required variable is an error.
+func (uc *TemplateUsecase) RenderTemplate(ctx context.Context, req *v1.RenderTemplateRequest) (*v1.RenderTemplateResponse, error) {
+	startTime := time.Now()
+	options := req.Options
+	if options == nil {
+		options = &v1.RenderOptions{}
+	}
+	format := options.OutputFormat
+	switch format {
+	case "":
+		format = OutputText
+	case OutputText, OutputMarkdown, OutputHTML:
+	default:
+		return nil, ErrInvalidOutputFormat
+	}
+
+	t, err := uc.repo.GetTemplate(ctx, req.TemplateName)
+	if err != nil {
+		return nil, err
+	}
+	compiled, err := compileTemplate(t.Name, t.Content)
+	if err != nil {
+		return nil, ErrInvalidTemplate.WithCause(err)
+	}
+
+	vars := make(map[string]string, len(options.DefaultValues)+len(req.Variables))
+	for k, v := range options.DefaultValues {
+		vars[k] = v
+	}
+	for k, v := range req.Variables {
+		vars[k] = v
+	}
+	used, missing := make([]string, 0), make([]string, 0)
+	for _, name := range compiled.supported {
+		if _, ok := vars[name]; ok {
+			used = append(used, name)
+		} else {
+			missing = append(missing, name)
+		}
+	}
+	if options.StrictMode {
+		var absent []string
+		for _, name := range compiled.required {
+			if _, ok := vars[name]; !ok {
+				absent = append(absent, name)
+			}
+		}
+		if len(absent) > 0 {
+			return nil, ErrMissingVariables.WithMetadata(map[string]string{"missing_variables": strings.Join(absent, ",")})
+		}
+	}
+	// HTML 输出由 html/template 按上下文转义，其余格式按需转义变量值
+	if options.EscapeHtml && format != OutputHTML {
+		for k, v := range vars {
+			vars[k] = html.EscapeString(v)
+		}
+	}
+
+	rendered, err := compiled.execute(ctx, vars, format)
+	if err != nil {
+		return nil, ErrTemplateRender.WithCause(err)
+	}
+	elapsed := time.Since(startTime)
+	uc.repo.RecordUsage(ctx, t.Name, elapsed, used)
+	uc.log.WithContext(ctx).Infof("Rendered template %s with %d variables", t.Name, len(used))
+
+	return &v1.RenderTemplateResponse{
+		RenderedContent: rendered,
+		Metadata: &v1.TemplateRenderingMetadata{
+			TemplateUsed:         t.Name,
+			VariablesSubstituted: int32(len(used)),
+			OutputLength:         int32(utf8.RuneCountInString(rendered)),
+			RenderingTimeMs:      elapsed.Milliseconds(),
+		},
+		UsedVariables:    used,
+		MissingVariables: missing,
+	}, nil
+}
+
+// ListTemplates lists templates with their variables and usage statistics
+func (uc *TemplateUsecase) ListTemplates(ctx context.Context, req *v1.ListTemplatesRequest) (*v1.ListTemplatesResponse, error) {
+	templates, pagination, err := uc.repo.ListTemplates(ctx, req.Pagination, req.Filters)
+	if err != nil {
+		uc.log.WithContext(ctx).Errorf("Failed to list templates: %v", err)
+		return nil, err
+	}
+	infos := make([]*v1.TemplateInfo, 0, len(templates))
+	for _, t := range templates {
+		infos = append(infos, uc.templateInfo(ctx, t))
+	}
+	return &v1.ListTemplatesResponse{
+		Templates:  infos,
+		Pagination: pagination,
+	}, nil
+}
+
+// GetTemplate returns a template with its content
+func (uc *TemplateUsecase) GetTemplate(ctx context.Context, req *v1.GetTemplateRequest) (*v1.GetTemplateResponse, error) {
+	t, err := uc.repo.GetTemplate(ctx, req.Name)
+	if err != nil {
+		return nil, err
+	}
+	return &v1.GetTemplateResponse{
+		Template: uc.templateInfo(ctx, t),
+		Content:  t.Content,
+	}, nil
+}
+
+// CreateTemplate validates and stores a new template
+func (uc *TemplateUsecase) CreateTemplate(ctx context.Context, req *v1.CreateTemplateRequest) (*v1.CreateTemplateResponse, error) {
+	if !templateName.MatchString(req.Name) {
+		return nil, ErrInvalidTemplateName
+	}
+	if _, err := compileTemplate(req.Name, req.Content); err != nil {
+		return nil, ErrInvalidTemplate.WithCause(err)
+	}
+	now := time.Now()
+	t := &Template{
+		Name:        req.Name,
+		DisplayName: req.DisplayName,
+		Description: req.Description,
+		Category:    req.Category,
+		Content:     req.Content,
+		CreatedAt:   now,
+		UpdatedAt:   now,
+	}
+	if err := uc.repo.CreateTemplate(ctx, t); err != nil {
+		return nil, err
+	}
+	uc.log.WithContext(ctx).Infof("Template created: %s", t.Name)
+	return &v1.CreateTemplateResponse{Template: uc.templateInfo(ctx, t)}, nil
+}
+
+// UpdateTemplate updates the fields listed in update_fields, or every
+// non-empty field when none are listed.
+func (uc *TemplateUsecase) UpdateTemplate(ctx context.Context, req *v1.UpdateTemplateRequest) (*v1.UpdateTemplateResponse, error) {
+	t, err := uc.repo.GetTemplate(ctx, req.Name)
+	if err != nil {
+		return nil, err
+	}
+	fields := req.UpdateFields
+	if len(fields) == 0 {
+		for field, value := range map[string]string{
+			TemplateFieldDisplayName: req.DisplayName,
+			TemplateFieldDescription: req.Description,
+			TemplateFieldCategory:    req.Category,
+			TemplateFieldContent:     req.Content,
+		} {
+			if value != "" {
+				fields = append(fields, field)
+			}
+		}
+	}
+
+	updated := *t
+	for _, field := range fields {
+		switch field {
+		case TemplateFieldDisplayName:
+			updated.DisplayName = req.DisplayName
+		case TemplateFieldDescription:
+			updated.Description = req.Description
+		case TemplateFieldCategory:
+			updated.Category = req.Category
+		case TemplateFieldContent:
+			if _, err := compileTemplate(t.Name, req.Content); err != nil || strings.TrimSpace(req.Content) == "" {
+				return nil, ErrInvalidTemplate.WithCause(err)
+			}
+			updated.Content = req.Content
+		default:
+			return nil, errors.BadRequest(commonv1.ErrorCode_ERROR_CODE_BAD_REQUEST.String(), fmt.Sprintf("unknown update field %q", field))
+		}
+	}
+	updated.UpdatedAt = time.Now()
+	if err := uc.repo.UpdateTemplate(ctx, &updated); err != nil {
+		return nil, err
+	}
+	uc.log.WithContext(ctx).Infof("Template updated: %s", t.Name)
+	return &v1.UpdateTemplateResponse{Template: uc.templateInfo(ctx, &updated)}, nil
+}
+
+// DeleteTemplate removes a template
+func (uc *TemplateUsecase) DeleteTemplate(ctx context.Context, req *v1.DeleteTemplateRequest) (*v1.DeleteTemplateResponse, error) {
+	if err := uc.repo.DeleteTemplate(ctx, req.Name); err != nil {
+		return nil, err
+	}
+	uc.log.WithContext(ctx).Infof("Template deleted: %s", req.Name)
+	return &v1.DeleteTemplateResponse{Status: "deleted"}, nil
+}
+
+func (uc *TemplateUsecase) templateInfo(ctx context.Context, t *Template) *v1.TemplateInfo {
+	info := &v1.TemplateInfo{
+		Name:            t.Name,
+		DisplayName:     t.DisplayName,
+		Description:     t.Description,
+		Category:        t.Category,
+		UsageStatistics: uc.repo.GetStatistics(ctx, t.Name),
+		CreatedAt:       timestamppb.New(t.CreatedAt),
+		UpdatedAt:       timestamppb.New(t.UpdatedAt),
+	}
+	if info.DisplayName == "" {
+		info.DisplayName = t.Name
+	}
+	if compiled, err := compileTemplate(t.Name, t.Content); err == nil {
+		info.SupportedVariables = compiled.supported
+		info.RequiredVariables = compiled.required
+	} else {
+		uc.log.WithContext(ctx).Warnf("Template %s does not parse: %v", t.Name, err)
+	}
+	return info
+}
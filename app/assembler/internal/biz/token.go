@@ -0,0 +1,190 @@
+package biz
+
+import (
+	"context"
+	"math"
+	"time"
+
+	v1 "rag/api/assembler/v1"
+	commonv1 "rag/api/common/v1"
+	"rag/pkg/tokenizer"
+
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+var (
+	// ErrTokenizerNotFound is returned when the requested tokenizer is not registered.
+	ErrTokenizerNotFound = errors.NotFound(commonv1.ErrorCode_ERROR_CODE_NOT_FOUND.String(), "tokenizer not found")
+	// ErrNoTokenContent is returned when EstimateTokens has no content to count.
+	ErrNoTokenContent = errors.BadRequest(commonv1.ErrorCode_ERROR_CODE_BAD_REQUEST.String(), "one of text, texts or context_request is required")
+)
+
+// TokenizerRepo resolves tokenizers by name
+type TokenizerRepo interface {
+	// 按名称获取分词器，名称为空时返回默认分词器
+	GetTokenizer(ctx context.Context, name string) (tokenizer.Tokenizer, error)
+}
+
+// TokenUsecase handles token estimation business logic
+type TokenUsecase struct {
+	tokenizers TokenizerRepo
+	log        *log.Helper
+}
+
+// NewTokenUsecase creates a new token usecase
+func NewTokenUsecase(tokenizers TokenizerRepo, logger log.Logger) *TokenUsecase {
+	return &TokenUsecase{
+		tokenizers: tokenizers,
+		log:        log.NewHelper(logger),
+	}
+}
+
+// EstimateTokens counts the tokens of a text, a list of texts or a whole
+// context request with the requested tokenizer.
+func (uc *TokenUsecase) EstimateTokens(ctx context.Context, req *v1.EstimateTokensRequest) (*v1.EstimateTokensResponse, error) {
+	startTime := time.Now()
+	tok, err := uc.tokenizers.GetTokenizer(ctx, req.TokenizerName)
+	if err != nil {
+		return nil, err
+	}
+	counter := newTokenTally(tok, req.Options)
+
+	resp := &v1.EstimateTokensResponse{}
+	switch source := req.ContentSource.(type) {
+	case *v1.EstimateTokensRequest_Text:
+		resp.Result = &v1.EstimateTokensResponse_SingleCount{SingleCount: counter.count(source.Text)}
+	case *v1.EstimateTokensRequest_Texts:
+		resp.Result = &v1.EstimateTokensResponse_BatchCounts{BatchCounts: counter.countBatch(source.Texts.GetTexts())}
+	case *v1.EstimateTokensRequest_ContextRequest:
+		resp.Result = &v1.EstimateTokensResponse_ContextEstimation{ContextEstimation: estimateContext(tok, source.ContextRequest)}
+	default:
+		return nil, ErrNoTokenContent
+	}
+
+	resp.Metadata = &v1.TokenizationMetadata{
+		TokenizerUsed:      tok.Name(),
+		TokenizerVersion:   tok.Version(),
+		TokenizationTimeMs: time.Since(startTime).Milliseconds(),
+		TokenizerConfig:    tok.Config(),
+	}
+	resp.Metadata.TokenizerConfig["type"] = tok.Type()
+	return resp, nil
+}
+
+// tokenTally applies the estimation options to per-category counts
+type tokenTally struct {
+	tok       tokenizer.Tokenizer
+	special   bool
+	breakdown bool
+	types     []tokenizer.Category
+}
+
+func newTokenTally(tok tokenizer.Tokenizer, options *v1.TokenEstimationOptions) *tokenTally {
+	t := &tokenTally{
+		tok:       tok,
+		special:   options.GetIncludeSpecialTokens(),
+		breakdown: options.GetIncludeDetailedBreakdown(),
+	}
+	for _, name := range options.GetTokenTypesToCount() {
+		if category, ok := tokenizer.ParseCategory(name); ok {
+			t.types = append(t.types, category)
+		}
+	}
+	return t
+}
+
+// count returns the token count of text, restricted to the requested token
+// types when any are given.
+func (t *tokenTally) count(text string) *v1.TokenCount {
+	counts := tokenizer.Count(t.tok, text, t.special)
+	total := counts.Total()
+	if len(t.types) > 0 {
+		total = 0
+		for _, category := range t.types {
+			total += counts.Of(category)
+		}
+	}
+	result := &v1.TokenCount{TotalTokens: int32(total)}
+	if t.breakdown {
+		result.Breakdown = &v1.TokenBreakdown{
+			RegularTokens:     int32(counts.Regular),
+			SpecialTokens:     int32(counts.Special),
+			PunctuationTokens: int32(counts.Punctuation),
+			NumberTokens:      int32(counts.Number),
+		}
+	}
+	return result
+}
+
+func (t *tokenTally) countBatch(texts []string) *v1.BatchTokenCounts {
+	batch := &v1.BatchTokenCounts{
+		Counts:     make([]*v1.TokenCount, len(texts)),
+		Statistics: &v1.TokenStatistics{},
+	}
+	if len(texts) == 0 {
+		return batch
+	}
+	var sum, sumSquares float64
+	for i, text := range texts {
+		c := t.count(text)
+		batch.Counts[i] = c
+		batch.TotalTokensAll += c.TotalTokens
+		n := float64(c.TotalTokens)
+		sum += n
+		sumSquares += n * n
+		if i == 0 || c.TotalTokens < batch.Statistics.MinTokens {
+			batch.Statistics.MinTokens = c.TotalTokens
+		}
+		if c.TotalTokens > batch.Statistics.MaxTokens {
+			batch.Statistics.MaxTokens = c.TotalTokens
+		}
+	}
+	mean := sum / float64(len(texts))
+	batch.Statistics.AvgTokens = float32(mean)
+	batch.Statistics.StdDevTokens = float32(math.Sqrt(math.Max(sumSquares/float64(len(texts))-mean*mean, 0)))
+	return batch
+}
+
+// estimateContext counts the query, chunk and template tokens of a context
+// request the way AssembleContext would count them.
+func estimateContext(tok tokenizer.Tokenizer, req *v1.AssembleContextRequest) *v1.ContextTokenEstimation {
+	options := req.GetOptions()
+	estimation := &v1.ContextTokenEstimation{
+		QueryTokens: int32(countTokens(tok, req.GetQuery())),
+	}
+	cache := newAssemblyCache()
+	for _, chunk := range req.GetChunks() {
+		n := cache.chunkTokens(tok, chunk, options.GetIncludeMetadata())
+		estimation.ContentTokens += int32(n)
+		estimation.ChunkTokenInfo = append(estimation.ChunkTokenInfo, &v1.ChunkTokenInfo{
+			ChunkId:    chunk.ChunkId,
+			TokenCount: int32(n),
+		})
+	}
+	if len(req.GetChunks()) > 1 {
+		estimation.ContentTokens += int32(countTokens(tok, chunkSeparator) * (len(req.GetChunks()) - 1))
+	}
+	if template := req.GetTemplate(); template != nil {
+		n := countTokens(tok, template.SystemPrompt) + countTokens(tok, template.UserPromptTemplate)
+		for _, section := range template.Sections {
+			n += countTokens(tok, section.SectionTemplate)
+		}
+		estimation.TemplateTokens = int32(n)
+	}
+	estimation.EstimatedTotalTokens = estimation.QueryTokens + estimation.ContentTokens + estimation.TemplateTokens
+	for _, info := range estimation.ChunkTokenInfo {
+		if estimation.ContentTokens > 0 {
+			info.EstimatedContextContribution = float32(info.TokenCount) / float32(estimation.ContentTokens)
+		}
+	}
+	return estimation
+}
+
+// countTokens returns the number of tokens in text, without special tokens
+func countTokens(tok tokenizer.Tokenizer, text string) int {
+	if text == "" {
+		return 0
+	}
+	return len(tok.Encode(text, false))
+}
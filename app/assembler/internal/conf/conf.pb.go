@@ -226,11 +226,13 @@ func (m *Server_GRPC) GetTimeout() *durationpb.Duration {
 }
 
 type Data struct {
-	Database             *Data_Database `protobuf:"bytes,1,opt,name=database,proto3" json:"database,omitempty"`
-	Redis                *Data_Redis    `protobuf:"bytes,2,opt,name=redis,proto3" json:"redis,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
-	XXX_unrecognized     []byte         `json:"-"`
-	XXX_sizecache        int32          `json:"-"`
+	Database             *Data_Database  `protobuf:"bytes,1,opt,name=database,proto3" json:"database,omitempty"`
+	Redis                *Data_Redis     `protobuf:"bytes,2,opt,name=redis,proto3" json:"redis,omitempty"`
+	Tokenizer            *Data_Tokenizer `protobuf:"bytes,3,opt,name=tokenizer,proto3" json:"tokenizer,omitempty"`
+	Template             *Data_Template  `protobuf:"bytes,4,opt,name=template,proto3" json:"template,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
 }
 
 func (m *Data) Reset()         { *m = Data{} }
@@ -272,6 +274,20 @@ func (m *Data) GetRedis() *Data_Redis {
 	return nil
 }
 
+func (m *Data) GetTokenizer() *Data_Tokenizer {
+	if m != nil {
+		return m.Tokenizer
+	}
+	return nil
+}
+
+func (m *Data) GetTemplate() *Data_Template {
+	if m != nil {
+		return m.Template
+	}
+	return nil
+}
+
 type Data_Database struct {
 	Driver               string   `protobuf:"bytes,1,opt,name=driver,proto3" json:"driver,omitempty"`
 	Source               string   `protobuf:"bytes,2,opt,name=source,proto3" json:"source,omitempty"`
@@ -382,6 +398,92 @@ func (m *Data_Redis) GetWriteTimeout() *durationpb.Duration {
 	return nil
 }
 
+type Data_Tokenizer struct {
+	VocabDir             string   `protobuf:"bytes,1,opt,name=vocab_dir,json=vocabDir,proto3" json:"vocab_dir,omitempty"`
+	DefaultTokenizer     string   `protobuf:"bytes,2,opt,name=default_tokenizer,json=defaultTokenizer,proto3" json:"default_tokenizer,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Data_Tokenizer) Reset()         { *m = Data_Tokenizer{} }
+func (m *Data_Tokenizer) String() string { return proto.CompactTextString(m) }
+func (*Data_Tokenizer) ProtoMessage()    {}
+func (*Data_Tokenizer) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9c69a7f648509b54, []int{2, 2}
+}
+
+func (m *Data_Tokenizer) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Data_Tokenizer.Unmarshal(m, b)
+}
+func (m *Data_Tokenizer) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Data_Tokenizer.Marshal(b, m, deterministic)
+}
+func (m *Data_Tokenizer) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Data_Tokenizer.Merge(m, src)
+}
+func (m *Data_Tokenizer) XXX_Size() int {
+	return xxx_messageInfo_Data_Tokenizer.Size(m)
+}
+func (m *Data_Tokenizer) XXX_DiscardUnknown() {
+	xxx_messageInfo_Data_Tokenizer.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Data_Tokenizer proto.InternalMessageInfo
+
+func (m *Data_Tokenizer) GetVocabDir() string {
+	if m != nil {
+		return m.VocabDir
+	}
+	return ""
+}
+
+func (m *Data_Tokenizer) GetDefaultTokenizer() string {
+	if m != nil {
+		return m.DefaultTokenizer
+	}
+	return ""
+}
+
+type Data_Template struct {
+	Dir                  string   `protobuf:"bytes,1,opt,name=dir,proto3" json:"dir,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Data_Template) Reset()         { *m = Data_Template{} }
+func (m *Data_Template) String() string { return proto.CompactTextString(m) }
+func (*Data_Template) ProtoMessage()    {}
+func (*Data_Template) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9c69a7f648509b54, []int{2, 3}
+}
+
+func (m *Data_Template) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Data_Template.Unmarshal(m, b)
+}
+func (m *Data_Template) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Data_Template.Marshal(b, m, deterministic)
+}
+func (m *Data_Template) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Data_Template.Merge(m, src)
+}
+func (m *Data_Template) XXX_Size() int {
+	return xxx_messageInfo_Data_Template.Size(m)
+}
+func (m *Data_Template) XXX_DiscardUnknown() {
+	xxx_messageInfo_Data_Template.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Data_Template proto.InternalMessageInfo
+
+func (m *Data_Template) GetDir() string {
+	if m != nil {
+		return m.Dir
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*Bootstrap)(nil), "kratos.api.Bootstrap")
 	proto.RegisterType((*Server)(nil), "kratos.api.Server")
@@ -390,6 +492,8 @@ func init() {
 	proto.RegisterType((*Data)(nil), "kratos.api.Data")
 	proto.RegisterType((*Data_Database)(nil), "kratos.api.Data.Database")
 	proto.RegisterType((*Data_Redis)(nil), "kratos.api.Data.Redis")
+	proto.RegisterType((*Data_Tokenizer)(nil), "kratos.api.Data.Tokenizer")
+	proto.RegisterType((*Data_Template)(nil), "kratos.api.Data.Template")
 }
 
 func init() {
@@ -397,30 +501,36 @@ func init() {
 }
 
 var fileDescriptor_9c69a7f648509b54 = []byte{
-	// 399 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x93, 0x4f, 0xab, 0xd3, 0x40,
-	0x14, 0xc5, 0x69, 0x4d, 0xd3, 0xe6, 0xb6, 0x82, 0xcc, 0xa2, 0xa6, 0x59, 0x88, 0x94, 0x22, 0xa2,
-	0x32, 0x01, 0x8b, 0x1b, 0x15, 0x17, 0xb5, 0xa0, 0xcb, 0x32, 0x76, 0x25, 0x88, 0x4c, 0x9a, 0x69,
-	0x1c, 0xda, 0x66, 0xc2, 0x9d, 0x89, 0xfd, 0x60, 0x6e, 0xfc, 0x32, 0x7e, 0x17, 0x99, 0xc9, 0xa4,
-	0xef, 0x4f, 0x1f, 0xbc, 0xf7, 0x36, 0x6f, 0x73, 0xe9, 0xed, 0xfd, 0x9d, 0x73, 0xc8, 0x21, 0x81,
-	0x58, 0x96, 0x46, 0x60, 0xc9, 0xf7, 0xe9, 0x46, 0x95, 0x5b, 0x37, 0x68, 0x85, 0xca, 0x28, 0x02,
-	0x3b, 0xe4, 0x46, 0x69, 0xca, 0x2b, 0x99, 0x3c, 0x2b, 0x94, 0x2a, 0xf6, 0x22, 0x75, 0x97, 0xac,
-	0xde, 0xa6, 0x79, 0x8d, 0xdc, 0x48, 0x55, 0x36, 0xec, 0xf4, 0x07, 0x44, 0x0b, 0xa5, 0x8c, 0x36,
-	0xc8, 0x2b, 0xf2, 0x0a, 0x42, 0x2d, 0xf0, 0xb7, 0xc0, 0xb8, 0xf3, 0xbc, 0xf3, 0x72, 0xf8, 0x96,
-	0xd0, 0x0b, 0x27, 0xfa, 0xcd, 0x5d, 0x98, 0x27, 0xc8, 0x0c, 0x82, 0x9c, 0x1b, 0x1e, 0x77, 0x1d,
-	0xf9, 0xe4, 0x32, 0xb9, 0xe4, 0x86, 0x33, 0x77, 0x9d, 0xfe, 0xed, 0x42, 0xd8, 0x08, 0xc9, 0x6b,
-	0x08, 0x7e, 0x19, 0x53, 0x79, 0xeb, 0xa7, 0xe7, 0xd6, 0xf4, 0xeb, 0x7a, 0xbd, 0x62, 0x0e, 0xb2,
-	0x70, 0x81, 0xd5, 0xc6, 0xbb, 0xdf, 0x04, 0x7f, 0x61, 0xab, 0xcf, 0xcc, 0x41, 0x89, 0x84, 0xc0,
-	0x4a, 0x49, 0x0c, 0xfd, 0x52, 0x98, 0xa3, 0xc2, 0x9d, 0x0b, 0x89, 0x58, 0xbb, 0x12, 0x02, 0x01,
-	0xcf, 0x73, 0x74, 0x76, 0x11, 0x73, 0xbf, 0xc9, 0x1c, 0xfa, 0x46, 0x1e, 0x84, 0xaa, 0x4d, 0xfc,
-	0xc8, 0xa5, 0x4c, 0x68, 0xd3, 0x15, 0x6d, 0xbb, 0xa2, 0x4b, 0xdf, 0x15, 0x6b, 0x49, 0x1b, 0x65,
-	0x83, 0x1f, 0x20, 0x6a, 0xfa, 0xaf, 0x0b, 0x81, 0x6d, 0x92, 0xbc, 0x83, 0x81, 0xed, 0x32, 0xe3,
-	0x5a, 0xf8, 0xf2, 0x26, 0xd7, 0xdb, 0x76, 0xc3, 0x02, 0xec, 0x84, 0x92, 0x37, 0xd0, 0x43, 0x91,
-	0x4b, 0xed, 0x3b, 0x1c, 0x9f, 0x69, 0x98, 0xbd, 0xb2, 0x06, 0x4a, 0xde, 0xc3, 0xa0, 0xf5, 0x20,
-	0x63, 0x08, 0x73, 0x94, 0xed, 0x6b, 0x10, 0x31, 0xbf, 0xd9, 0xff, 0xb5, 0xaa, 0x71, 0x23, 0xfc,
-	0xc3, 0xf9, 0x2d, 0xf9, 0xd3, 0x81, 0x9e, 0x33, 0xbb, 0x67, 0x2d, 0x1f, 0x61, 0x84, 0x82, 0xe7,
-	0x3f, 0xef, 0xdc, 0xcd, 0xd0, 0xe2, 0xeb, 0x86, 0x26, 0x9f, 0xe0, 0xf1, 0x11, 0xa5, 0x11, 0x27,
-	0x79, 0x70, 0x9b, 0x7c, 0xe4, 0x78, 0xaf, 0x5f, 0xbc, 0xf8, 0x3e, 0x43, 0x5e, 0xa4, 0xbc, 0xaa,
-	0x52, 0xae, 0xb5, 0x38, 0x64, 0x7b, 0x81, 0xe9, 0x95, 0x6f, 0xea, 0x83, 0x1d, 0x59, 0xe8, 0x8c,
-	0xe6, 0xff, 0x03, 0x00, 0x00, 0xff, 0xff, 0x77, 0xfe, 0xcd, 0x43, 0x70, 0x03, 0x00, 0x00,
+	// 486 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x94, 0x41, 0x8b, 0xd3, 0x40,
+	0x14, 0xc7, 0x69, 0x37, 0xdb, 0x6d, 0xde, 0xae, 0x50, 0xe7, 0xb0, 0x66, 0xa3, 0x88, 0x94, 0x45,
+	0x44, 0x25, 0x01, 0x17, 0x41, 0x54, 0x3c, 0xac, 0x05, 0x3d, 0x2e, 0x63, 0xbc, 0x08, 0x52, 0x26,
+	0x9d, 0x69, 0x1d, 0x9a, 0x66, 0xc2, 0xcb, 0xcb, 0x2e, 0xf8, 0xb5, 0xbc, 0x78, 0xf7, 0x8b, 0xc9,
+	0x4c, 0x26, 0xa9, 0x5a, 0x16, 0xf5, 0xb2, 0x97, 0x61, 0x66, 0xde, 0xef, 0xff, 0xde, 0x9b, 0xff,
+	0x6b, 0x03, 0x91, 0x2e, 0x49, 0x61, 0x29, 0x8a, 0x74, 0x61, 0xca, 0xa5, 0x5b, 0x92, 0x0a, 0x0d,
+	0x19, 0x06, 0x6b, 0x14, 0x64, 0xea, 0x44, 0x54, 0x3a, 0xbe, 0xbf, 0x32, 0x66, 0x55, 0xa8, 0xd4,
+	0x45, 0xf2, 0x66, 0x99, 0xca, 0x06, 0x05, 0x69, 0x53, 0xb6, 0xec, 0xf4, 0x33, 0x84, 0xe7, 0xc6,
+	0x50, 0x4d, 0x28, 0x2a, 0xf6, 0x18, 0x46, 0xb5, 0xc2, 0x4b, 0x85, 0xd1, 0xe0, 0xc1, 0xe0, 0xd1,
+	0xe1, 0x33, 0x96, 0x6c, 0x33, 0x25, 0x1f, 0x5c, 0x84, 0x7b, 0x82, 0x9d, 0x42, 0x20, 0x05, 0x89,
+	0x68, 0xe8, 0xc8, 0xc9, 0xaf, 0xe4, 0x4c, 0x90, 0xe0, 0x2e, 0x3a, 0xfd, 0x3e, 0x84, 0x51, 0x2b,
+	0x64, 0x4f, 0x20, 0xf8, 0x42, 0x54, 0xf9, 0xd4, 0x77, 0x76, 0x53, 0x27, 0xef, 0xb3, 0xec, 0x82,
+	0x3b, 0xc8, 0xc2, 0x2b, 0xac, 0x16, 0xd1, 0xf0, 0x5a, 0xf8, 0x1d, 0xbf, 0x78, 0xcb, 0x1d, 0x14,
+	0x6b, 0x08, 0xac, 0x94, 0x45, 0x70, 0x50, 0x2a, 0xba, 0x32, 0xb8, 0x76, 0x45, 0x42, 0xde, 0x1d,
+	0x19, 0x83, 0x40, 0x48, 0x89, 0x2e, 0x5d, 0xc8, 0xdd, 0x9e, 0x9d, 0xc1, 0x01, 0xe9, 0x8d, 0x32,
+	0x0d, 0x45, 0x7b, 0xae, 0xca, 0x49, 0xd2, 0x7a, 0x95, 0x74, 0x5e, 0x25, 0x33, 0xef, 0x15, 0xef,
+	0x48, 0x5b, 0xca, 0x16, 0xbe, 0x81, 0x52, 0xd3, 0x1f, 0x01, 0x04, 0xd6, 0x49, 0xf6, 0x1c, 0xc6,
+	0xd6, 0xcb, 0x5c, 0xd4, 0xca, 0x9b, 0x77, 0xf2, 0xa7, 0xdb, 0xc9, 0xcc, 0x03, 0xbc, 0x47, 0xd9,
+	0x53, 0xd8, 0x47, 0x25, 0x75, 0xed, 0x3d, 0x3c, 0xde, 0xd1, 0x70, 0x1b, 0xe5, 0x2d, 0xc4, 0x5e,
+	0x40, 0x48, 0x66, 0xad, 0x4a, 0xfd, 0x55, 0xa1, 0x6f, 0x32, 0xde, 0x51, 0x64, 0x1d, 0xc1, 0xb7,
+	0xb0, 0x6d, 0x8f, 0xd4, 0xa6, 0x2a, 0x04, 0xa9, 0x28, 0xb8, 0xa6, 0xbd, 0xcc, 0x03, 0xbc, 0x47,
+	0xe3, 0x97, 0x30, 0xee, 0x9a, 0x66, 0xc7, 0x30, 0x92, 0xa8, 0xbb, 0xdf, 0x5d, 0xc8, 0xfd, 0xc9,
+	0xde, 0xd7, 0xa6, 0xc1, 0x85, 0xf2, 0x6e, 0xfa, 0x53, 0xfc, 0x6d, 0x00, 0xfb, 0xae, 0xfb, 0xff,
+	0x9c, 0xc3, 0x6b, 0x38, 0x42, 0x25, 0xe4, 0xfc, 0x9f, 0x87, 0x71, 0x68, 0xf1, 0xac, 0xa5, 0xd9,
+	0x1b, 0xb8, 0x75, 0x85, 0x9a, 0x54, 0x2f, 0x0f, 0xfe, 0x26, 0x3f, 0x72, 0xbc, 0xd7, 0xc7, 0x1f,
+	0x21, 0xec, 0x0d, 0x64, 0x77, 0x21, 0xbc, 0x34, 0x0b, 0x91, 0xcf, 0xa5, 0xee, 0x5e, 0x3d, 0x76,
+	0x17, 0x33, 0x6d, 0xff, 0x2a, 0xb7, 0xa5, 0x5a, 0x8a, 0xa6, 0xa0, 0xf9, 0x76, 0x28, 0xed, 0x43,
+	0x26, 0x3e, 0xd0, 0x67, 0x8a, 0xef, 0xc1, 0xb8, 0xb3, 0x97, 0x4d, 0x60, 0x6f, 0x9b, 0xcf, 0x6e,
+	0xcf, 0x1f, 0x7e, 0x3a, 0x45, 0xb1, 0x4a, 0x45, 0x55, 0xa5, 0xa2, 0xae, 0xd5, 0x26, 0x2f, 0x14,
+	0xa6, 0xbf, 0x7d, 0x39, 0x5e, 0xd9, 0x25, 0x1f, 0xb9, 0xee, 0xcf, 0x7e, 0x0e, 0x00, 0x21, 0x32,
+	0x51, 0x51, 0x56, 0x04, 0x00, 0x00,
 }
@@ -8,7 +8,7 @@ import (
 )
 
 // ProviderSet is data providers.
-var ProviderSet = wire.NewSet(NewData, NewGreeterRepo)
+var ProviderSet = wire.NewSet(NewData, NewTokenizerRepo, NewContentGenerator, NewTemplateRepo)
 
 // Data .
 type Data struct {
@@ -0,0 +1,28 @@
+package data
+
+import (
+	"context"
+
+	v1 "rag/api/assembler/v1"
+	"rag/app/assembler/internal/biz"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+// contentGenerator implements biz.ContentGenerator. No language model is
+// configured yet, so abstractive optimization falls back to extraction.
+type contentGenerator struct {
+	log *log.Helper
+}
+
+// NewContentGenerator creates a content generator
+func NewContentGenerator(logger log.Logger) biz.ContentGenerator {
+	return &contentGenerator{
+		log: log.NewHelper(logger),
+	}
+}
+
+// Generate always reports that no generator is available
+func (g *contentGenerator) Generate(ctx context.Context, method, content string, targetLength int, options *v1.OptimizationOptions) (string, error) {
+	return "", biz.ErrGeneratorUnavailable
+}
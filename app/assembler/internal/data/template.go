@@ -0,0 +1,377 @@
+package data
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	v1 "rag/api/assembler/v1"
+	commonv1 "rag/api/common/v1"
+	"rag/app/assembler/internal/biz"
+	"rag/app/assembler/internal/conf"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// 模板文件：<name>.tmpl 保存模板内容，<name>.json 保存展示信息
+const (
+	templateFileExt = ".tmpl"
+	metaFileExt     = ".json"
+)
+
+// templateMeta is the sidecar file stored next to a template
+type templateMeta struct {
+	DisplayName string    `json:"display_name,omitempty"`
+	Description string    `json:"description,omitempty"`
+	Category    string    `json:"category,omitempty"`
+	CreatedAt   time.Time `json:"created_at"`
+	UpdatedAt   time.Time `json:"updated_at"`
+}
+
+// templateUsage accumulates rendering statistics of a template
+type templateUsage struct {
+	count     int32
+	totalMs   float64
+	lastUsed  time.Time
+	variables map[string]int32
+}
+
+// templateRepo implements biz.TemplateRepo on a directory of template files.
+// Templates are loaded at startup and written through on every change; usage
+// statistics are kept in memory.
+type templateRepo struct {
+	dir       string
+	mu        sync.RWMutex
+	templates map[string]*biz.Template
+	usage     map[string]*templateUsage
+	log       *log.Helper
+}
+
+// NewTemplateRepo loads the templates found in the configured directory
+func NewTemplateRepo(c *conf.Data, logger log.Logger) (biz.TemplateRepo, error) {
+	r := &templateRepo{
+		dir:       c.GetTemplate().GetDir(),
+		templates: make(map[string]*biz.Template),
+		usage:     make(map[string]*templateUsage),
+		log:       log.NewHelper(logger),
+	}
+	if r.dir == "" {
+		r.log.Warn("template dir not configured, templates are kept in memory only")
+		return r, nil
+	}
+	entries, err := os.ReadDir(r.dir)
+	if errors.Is(err, fs.ErrNotExist) {
+		r.log.Warnf("template dir %s not found, it will be created on first write", r.dir)
+		return r, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	for _, entry := range entries {
+		if entry.IsDir() || filepath.Ext(entry.Name()) != templateFileExt {
+			continue
+		}
+		t, err := r.load(strings.TrimSuffix(entry.Name(), templateFileExt))
+		if err != nil {
+			return nil, err
+		}
+		r.templates[t.Name] = t
+	}
+	r.log.Infof("loaded %d templates from %s", len(r.templates), r.dir)
+	return r, nil
+}
+
+// load reads a template and its sidecar; templates without one take their
+// times from the file.
+func (r *templateRepo) load(name string) (*biz.Template, error) {
+	path := filepath.Join(r.dir, name+templateFileExt)
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	info, err := os.Stat(path)
+	if err != nil {
+		return nil, err
+	}
+	meta := templateMeta{CreatedAt: info.ModTime(), UpdatedAt: info.ModTime()}
+	if raw, err := os.ReadFile(filepath.Join(r.dir, name+metaFileExt)); err == nil {
+		if err := json.Unmarshal(raw, &meta); err != nil {
+			return nil, err
+		}
+	} else if !errors.Is(err, fs.ErrNotExist) {
+		return nil, err
+	}
+	return &biz.Template{
+		Name:        name,
+		DisplayName: meta.DisplayName,
+		Description: meta.Description,
+		Category:    meta.Category,
+		Content:     string(content),
+		CreatedAt:   meta.CreatedAt,
+		UpdatedAt:   meta.UpdatedAt,
+	}, nil
+}
+
+// save writes a template and its sidecar, replacing existing files atomically
+func (r *templateRepo) save(t *biz.Template) error {
+	if r.dir == "" {
+		return nil
+	}
+	if err := os.MkdirAll(r.dir, 0o755); err != nil {
+		return err
+	}
+	meta, err := json.MarshalIndent(templateMeta{
+		DisplayName: t.DisplayName,
+		Description: t.Description,
+		Category:    t.Category,
+		CreatedAt:   t.CreatedAt,
+		UpdatedAt:   t.UpdatedAt,
+	}, "", "  ")
+	if err != nil {
+		return err
+	}
+	if err := writeFileAtomic(filepath.Join(r.dir, t.Name+templateFileExt), []byte(t.Content)); err != nil {
+		return err
+	}
+	return writeFileAtomic(filepath.Join(r.dir, t.Name+metaFileExt), meta)
+}
+
+func writeFileAtomic(path string, data []byte) error {
+	tmp, err := os.CreateTemp(filepath.Dir(path), filepath.Base(path)+".*")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+	if _, err := tmp.Write(data); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+	return os.Rename(tmp.Name(), path)
+}
+
+// GetTemplate returns a template by name
+func (r *templateRepo) GetTemplate(ctx context.Context, name string) (*biz.Template, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	t, ok := r.templates[name]
+	if !ok {
+		return nil, biz.ErrTemplateNotFound
+	}
+	copied := *t
+	return &copied, nil
+}
+
+// ListTemplates filters, sorts and pages the templates
+func (r *templateRepo) ListTemplates(ctx context.Context, pagination *commonv1.PaginationRequest, filters []*commonv1.Filter) ([]*biz.Template, *commonv1.PaginationResponse, error) {
+	r.mu.RLock()
+	var templates []*biz.Template
+	for _, t := range r.templates {
+		passAllFilters := true
+		for _, filter := range filters {
+			if !matchTemplateFilter(t, filter) {
+				passAllFilters = false
+				break
+			}
+		}
+		if passAllFilters {
+			copied := *t
+			templates = append(templates, &copied)
+		}
+	}
+	usage := make(map[string]int32, len(r.usage))
+	for name, u := range r.usage {
+		usage[name] = u.count
+	}
+	r.mu.RUnlock()
+
+	// 排序，默认按名称
+	sortBy, desc := pagination.GetSortBy(), pagination.GetSortDesc()
+	sort.Slice(templates, func(i, j int) bool {
+		a, b := templates[i], templates[j]
+		if desc {
+			a, b = b, a
+		}
+		switch sortBy {
+		case "created_at":
+			if !a.CreatedAt.Equal(b.CreatedAt) {
+				return a.CreatedAt.Before(b.CreatedAt)
+			}
+		case "updated_at":
+			if !a.UpdatedAt.Equal(b.UpdatedAt) {
+				return a.UpdatedAt.Before(b.UpdatedAt)
+			}
+		case "category":
+			if a.Category != b.Category {
+				return a.Category < b.Category
+			}
+		case "usage_count":
+			if usage[a.Name] != usage[b.Name] {
+				return usage[a.Name] < usage[b.Name]
+			}
+		}
+		return a.Name < b.Name
+	})
+
+	// 应用分页
+	page := int32(1)
+	pageSize := int32(10)
+	if pagination != nil {
+		if pagination.Page > 0 {
+			page = pagination.Page
+		}
+		if pagination.PageSize > 0 {
+			pageSize = pagination.PageSize
+		}
+	}
+	total := int64(len(templates))
+	// 先转为 int64 再相乘，过大的页码不会溢出为负数
+	start := max(0, min((int64(page)-1)*int64(pageSize), total))
+	end := min(start+int64(pageSize), total)
+
+	return templates[start:end], &commonv1.PaginationResponse{
+		Page:       page,
+		PageSize:   pageSize,
+		Total:      total,
+		TotalPages: int32((total + int64(pageSize) - 1) / int64(pageSize)),
+	}, nil
+}
+
+// matchTemplateFilter matches a template against a filter; unknown fields pass
+func matchTemplateFilter(t *biz.Template, filter *commonv1.Filter) bool {
+	var value string
+	switch filter.Field {
+	case "name":
+		value = t.Name
+	case "display_name":
+		value = t.DisplayName
+	case "category":
+		value = t.Category
+	case "description":
+		value = t.Description
+	default:
+		return true
+	}
+	if len(filter.Values) == 0 {
+		return true
+	}
+	switch filter.Operator {
+	case "eq", "in":
+		for _, v := range filter.Values {
+			if value == v {
+				return true
+			}
+		}
+		return false
+	case "ne":
+		for _, v := range filter.Values {
+			if value == v {
+				return false
+			}
+		}
+		return true
+	case "like":
+		for _, v := range filter.Values {
+			if strings.Contains(strings.ToLower(value), strings.ToLower(strings.Trim(v, "%"))) {
+				return true
+			}
+		}
+		return false
+	default:
+		return true
+	}
+}
+
+// CreateTemplate stores a new template
+func (r *templateRepo) CreateTemplate(ctx context.Context, t *biz.Template) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if _, ok := r.templates[t.Name]; ok {
+		return biz.ErrTemplateExists
+	}
+	if err := r.save(t); err != nil {
+		return err
+	}
+	copied := *t
+	r.templates[t.Name] = &copied
+	return nil
+}
+
+// UpdateTemplate replaces an existing template
+func (r *templateRepo) UpdateTemplate(ctx context.Context, t *biz.Template) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if _, ok := r.templates[t.Name]; !ok {
+		return biz.ErrTemplateNotFound
+	}
+	if err := r.save(t); err != nil {
+		return err
+	}
+	copied := *t
+	r.templates[t.Name] = &copied
+	return nil
+}
+
+// DeleteTemplate removes a template, its files and its statistics
+func (r *templateRepo) DeleteTemplate(ctx context.Context, name string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if _, ok := r.templates[name]; !ok {
+		return biz.ErrTemplateNotFound
+	}
+	if r.dir != "" {
+		for _, ext := range []string{templateFileExt, metaFileExt} {
+			if err := os.Remove(filepath.Join(r.dir, name+ext)); err != nil && !errors.Is(err, fs.ErrNotExist) {
+				return err
+			}
+		}
+	}
+	delete(r.templates, name)
+	delete(r.usage, name)
+	return nil
+}
+
+// RecordUsage adds a rendering to the template's statistics
+func (r *templateRepo) RecordUsage(ctx context.Context, name string, elapsed time.Duration, variables []string) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	u, ok := r.usage[name]
+	if !ok {
+		u = &templateUsage{variables: make(map[string]int32)}
+		r.usage[name] = u
+	}
+	u.count++
+	u.totalMs += float64(elapsed.Microseconds()) / 1000
+	u.lastUsed = time.Now()
+	for _, v := range variables {
+		u.variables[v]++
+	}
+}
+
+// GetStatistics returns the usage statistics of a template
+func (r *templateRepo) GetStatistics(ctx context.Context, name string) *v1.TemplateStatistics {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	stats := &v1.TemplateStatistics{VariableUsageFrequency: make(map[string]int32)}
+	u, ok := r.usage[name]
+	if !ok {
+		return stats
+	}
+	stats.UsageCount = u.count
+	stats.AvgRenderingTimeMs = float32(u.totalMs / float64(u.count))
+	stats.LastUsed = timestamppb.New(u.lastUsed)
+	for v, n := range u.variables {
+		stats.VariableUsageFrequency[v] = n
+	}
+	return stats
+}
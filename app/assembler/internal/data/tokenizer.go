@@ -0,0 +1,53 @@
+package data
+
+import (
+	"context"
+	"errors"
+	"io/fs"
+
+	"rag/app/assembler/internal/biz"
+	"rag/app/assembler/internal/conf"
+	"rag/pkg/tokenizer"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+// tokenizerRepo implements biz.TokenizerRepo on top of the shared tokenizer registry
+type tokenizerRepo struct {
+	registry *tokenizer.Registry
+	log      *log.Helper
+}
+
+// NewTokenizerRepo loads the vocabularies found in the configured directory
+func NewTokenizerRepo(c *conf.Data, logger log.Logger) (biz.TokenizerRepo, error) {
+	helper := log.NewHelper(logger)
+	dir := c.GetTokenizer().GetVocabDir()
+	registry, err := tokenizer.LoadDir(dir)
+	if errors.Is(err, fs.ErrNotExist) {
+		// 词表目录不存在时仅使用启发式分词器
+		helper.Warnf("tokenizer vocab dir %s not found, using %s only", dir, tokenizer.HeuristicName)
+		registry, err = tokenizer.NewRegistry(), nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	if name := c.GetTokenizer().GetDefaultTokenizer(); name != "" {
+		if err := registry.SetDefault(name); err != nil {
+			return nil, err
+		}
+	}
+	helper.Infof("loaded tokenizers: %v", registry.Names())
+	return &tokenizerRepo{
+		registry: registry,
+		log:      helper,
+	}, nil
+}
+
+// GetTokenizer returns the named tokenizer, or the default one when name is empty
+func (r *tokenizerRepo) GetTokenizer(ctx context.Context, name string) (tokenizer.Tokenizer, error) {
+	t, ok := r.registry.Get(name)
+	if !ok {
+		return nil, biz.ErrTokenizerNotFound
+	}
+	return t, nil
+}
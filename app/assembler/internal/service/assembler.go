@@ -0,0 +1,111 @@
+package service
+
+import (
+	"context"
+
+	pb "rag/api/assembler/v1"
+	commonv1 "rag/api/common/v1"
+	"rag/app/assembler/internal/biz"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+type AssemblerService struct {
+	pb.UnimplementedAssemblerServer
+
+	assembleUc *biz.AssembleUsecase
+	tokenUc    *biz.TokenUsecase
+	splitUc    *biz.SplitUsecase
+	optimizeUc *biz.OptimizeUsecase
+	templateUc *biz.TemplateUsecase
+	log        *log.Helper
+}
+
+func NewAssemblerService(assembleUc *biz.AssembleUsecase, tokenUc *biz.TokenUsecase, splitUc *biz.SplitUsecase, optimizeUc *biz.OptimizeUsecase, templateUc *biz.TemplateUsecase, logger log.Logger) *AssemblerService {
+	return &AssemblerService{
+		assembleUc: assembleUc,
+		tokenUc:    tokenUc,
+		splitUc:    splitUc,
+		optimizeUc: optimizeUc,
+		templateUc: templateUc,
+		log:        log.NewHelper(logger),
+	}
+}
+
+// AssembleContext builds a context from document chunks
+func (s *AssemblerService) AssembleContext(ctx context.Context, req *pb.AssembleContextRequest) (*pb.AssembleContextResponse, error) {
+	s.log.WithContext(ctx).Info("AssembleContext request received")
+	return s.assembleUc.AssembleContext(ctx, req)
+}
+
+// AssembleBatchContexts builds the contexts of a batch concurrently
+func (s *AssemblerService) AssembleBatchContexts(ctx context.Context, req *pb.AssembleBatchContextsRequest) (*pb.AssembleBatchContextsResponse, error) {
+	s.log.WithContext(ctx).Infof("AssembleBatchContexts request received: %d requests", len(req.Requests))
+	return s.assembleUc.AssembleBatchContexts(ctx, req)
+}
+
+// EstimateTokens counts tokens with the requested tokenizer
+func (s *AssemblerService) EstimateTokens(ctx context.Context, req *pb.EstimateTokensRequest) (*pb.EstimateTokensResponse, error) {
+	s.log.WithContext(ctx).Info("EstimateTokens request received")
+	return s.tokenUc.EstimateTokens(ctx, req)
+}
+
+// SplitContent splits long content into token-bounded chunks
+func (s *AssemblerService) SplitContent(ctx context.Context, req *pb.SplitContentRequest) (*pb.SplitContentResponse, error) {
+	s.log.WithContext(ctx).Info("SplitContent request received")
+	return s.splitUc.SplitContent(ctx, req)
+}
+
+// OptimizeContent shrinks content towards a target length
+func (s *AssemblerService) OptimizeContent(ctx context.Context, req *pb.OptimizeContentRequest) (*pb.OptimizeContentResponse, error) {
+	s.log.WithContext(ctx).Info("OptimizeContent request received")
+	return s.optimizeUc.OptimizeContent(ctx, req)
+}
+
+// RenderTemplate renders a stored template with variables
+func (s *AssemblerService) RenderTemplate(ctx context.Context, req *pb.RenderTemplateRequest) (*pb.RenderTemplateResponse, error) {
+	s.log.WithContext(ctx).Info("RenderTemplate request received")
+	return s.templateUc.RenderTemplate(ctx, req)
+}
+
+// ListTemplates lists stored templates
+func (s *AssemblerService) ListTemplates(ctx context.Context, req *pb.ListTemplatesRequest) (*pb.ListTemplatesResponse, error) {
+	s.log.WithContext(ctx).Info("ListTemplates request received")
+	return s.templateUc.ListTemplates(ctx, req)
+}
+
+// GetTemplate returns a stored template
+func (s *AssemblerService) GetTemplate(ctx context.Context, req *pb.GetTemplateRequest) (*pb.GetTemplateResponse, error) {
+	s.log.WithContext(ctx).Info("GetTemplate request received")
+	return s.templateUc.GetTemplate(ctx, req)
+}
+
+// CreateTemplate stores a new template
+func (s *AssemblerService) CreateTemplate(ctx context.Context, req *pb.CreateTemplateRequest) (*pb.CreateTemplateResponse, error) {
+	s.log.WithContext(ctx).Info("CreateTemplate request received")
+	return s.templateUc.CreateTemplate(ctx, req)
+}
+
+// UpdateTemplate updates a stored template
+func (s *AssemblerService) UpdateTemplate(ctx context.Context, req *pb.UpdateTemplateRequest) (*pb.UpdateTemplateResponse, error) {
+	s.log.WithContext(ctx).Info("UpdateTemplate request received")
+	return s.templateUc.UpdateTemplate(ctx, req)
+}
+
+// DeleteTemplate removes a stored template
+func (s *AssemblerService) DeleteTemplate(ctx context.Context, req *pb.DeleteTemplateRequest) (*pb.DeleteTemplateResponse, error) {
+	s.log.WithContext(ctx).Info("DeleteTemplate request received")
+	return s.templateUc.DeleteTemplate(ctx, req)
+}
+
+// HealthCheck performs health check
+func (s *AssemblerService) HealthCheck(ctx context.Context, req *emptypb.Empty) (*commonv1.HealthCheckResponse, error) {
+	return &commonv1.HealthCheckResponse{
+		Status:    "SERVING",
+		Service:   "assembler",
+		Version:   "v1.0.0",
+		Timestamp: timestamppb.Now(),
+	}, nil
+}
@@ -261,3 +261,13 @@ func (uc *DocumentUsecase) UpdateDocumentMetadata(ctx context.Context, req *v1.U
 func generateDocumentID() string {
 	return "doc-" + time.Now().Format("20060102150405") + "-" + randomString(8)
 }
+
+// randomString generates a random string of specified length
+func randomString(length int) string {
+	const charset = "abcdefghijklmnopqrstuvwxyzABCDEFGHIJKLMNOPQRSTUVWXYZ0123456789"
+	b := make([]byte, length)
+	for i := range b {
+		b[i] = charset[time.Now().UnixNano()%int64(len(charset))]
+	}
+	return string(b)
+}
@@ -2,22 +2,23 @@ package biz
 
 import (
 	"context"
+	"strings"
 	"time"
 
 	commonv1 "rag/api/common/v1"
 	v1 "rag/api/gateway/v1"
 
+	"github.com/go-kratos/kratos/v2/errors"
 	"github.com/go-kratos/kratos/v2/log"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
-// QueryResult represents query processing result
-type QueryResult struct {
-	QueryID          string
-	Answer           string
-	RelatedDocuments []*RelatedDocument
-	Metadata         *QueryMetadata
-	Suggestions      []string
-}
+var (
+	// ErrQueryRequired is returned for a query without a query.
+	ErrQueryRequired = errors.BadRequest(commonv1.ErrorCode_ERROR_CODE_BAD_REQUEST.String(), "query is required")
+	// ErrOrchestratorUnavailable is returned for queries when no orchestrator is configured.
+	ErrOrchestratorUnavailable = errors.ServiceUnavailable(commonv1.ErrorCode_ERROR_CODE_SERVICE_UNAVAILABLE.String(), "queries need an orchestrator endpoint")
+)
 
 // RelatedDocument represents a document related to the query
 type RelatedDocument struct {
@@ -29,6 +30,13 @@ type RelatedDocument struct {
 	Chunks         []*commonv1.ChunkInfo
 }
 
+// AssembledAnswer is a generated answer whose citation markers point back to
+// chunks of the related documents
+type AssembledAnswer struct {
+	Answer    string
+	Citations []*commonv1.Citation
+}
+
 // QueryMetadata contains metadata about query processing
 type QueryMetadata struct {
 	QueryTime              time.Time
@@ -39,14 +47,37 @@ type QueryMetadata struct {
 	DebugInfo              map[string]string
 }
 
+// QueryStreamEvent is one stage of a streamed answer: the retrieved
+// documents, a piece of the answer, or the finished answer. Exactly one of
+// Retrieval, AnswerDelta and Completed is set.
+type QueryStreamEvent struct {
+	QueryID     string
+	Retrieval   *QueryRetrieval
+	AnswerDelta string
+	Completed   *QueryAnswer
+}
+
+// QueryRetrieval is the documents an answer is generated from
+type QueryRetrieval struct {
+	Documents              []*RelatedDocument
+	TotalDocumentsSearched int32
+}
+
+// QueryAnswer is the full answer to a query with the documents it was
+// generated from
+type QueryAnswer struct {
+	QueryID string
+	AssembledAnswer
+	Documents []*RelatedDocument
+	Metadata  *QueryMetadata
+}
+
 // QueryRepo defines the data access interface for query processing
 type QueryRepo interface {
-	// 调用检索服务进行文档检索
-	RetrieveDocuments(ctx context.Context, query string, params *v1.QueryParameters) ([]*RelatedDocument, error)
-	// 调用重排序服务优化结果
-	RerankDocuments(ctx context.Context, query string, documents []*RelatedDocument) ([]*RelatedDocument, error)
-	// 调用组装服务生成答案
-	AssembleAnswer(ctx context.Context, query string, documents []*RelatedDocument) (string, error)
+	// 调用编排服务检索文档并生成答案
+	Answer(ctx context.Context, req *v1.QueryRequest) (*QueryAnswer, error)
+	// 调用编排服务流式生成答案，按顺序把各阶段交给 handle，handle 出错时停止
+	StreamAnswer(ctx context.Context, req *v1.QueryRequest, handle func(*QueryStreamEvent) error) error
 	// 保存查询历史
 	SaveQueryHistory(ctx context.Context, userID, query, answer string, metadata *QueryMetadata) error
 	// 获取查询建议
@@ -67,86 +98,93 @@ func NewQueryUsecase(repo QueryRepo, logger log.Logger) *QueryUsecase {
 	}
 }
 
-// ProcessQuery handles intelligent query processing
+// ProcessQuery answers a query through the orchestrator, which retrieves,
+// reranks and assembles the documents and generates the answer
 func (uc *QueryUsecase) ProcessQuery(ctx context.Context, req *v1.QueryRequest) (*v1.QueryResponse, error) {
+	if strings.TrimSpace(req.Query) == "" {
+		return nil, ErrQueryRequired
+	}
 	startTime := time.Now()
 	uc.log.WithContext(ctx).Infof("Processing query: %s", req.Query)
 
-	// 检索相关文档
-	documents, err := uc.repo.RetrieveDocuments(ctx, req.Query, req.Parameters)
+	answer, err := uc.repo.Answer(ctx, req)
 	if err != nil {
-		uc.log.WithContext(ctx).Errorf("Failed to retrieve documents: %v", err)
+		uc.log.WithContext(ctx).Errorf("Failed to process query: %v", err)
 		return nil, err
 	}
+	uc.log.WithContext(ctx).Infof("Retrieved %d documents", len(answer.Documents))
 
-	uc.log.WithContext(ctx).Infof("Retrieved %d documents", len(documents))
+	response := uc.complete(ctx, req, answer, startTime)
+	uc.log.WithContext(ctx).Infof("Query processed successfully in %dms", response.Metadata.ProcessingTimeMs)
+	return response, nil
+}
 
-	// 如果启用重排序，调用重排序服务
-	if req.Parameters != nil && req.Parameters.EnableReranking {
-		rerankedDocs, err := uc.repo.RerankDocuments(ctx, req.Query, documents)
-		if err != nil {
-			uc.log.WithContext(ctx).Warnf("Failed to rerank documents: %v", err)
-		} else {
-			documents = rerankedDocs
-			uc.log.WithContext(ctx).Info("Documents reranked successfully")
-		}
+// StreamQuery answers a query through the orchestrator and sends the
+// retrieved documents, the answer as it is generated and the full response,
+// in that order. Cancelling ctx cancels the query in the orchestrator.
+func (uc *QueryUsecase) StreamQuery(ctx context.Context, req *v1.QueryRequest, send func(*v1.QueryStreamEvent) error) error {
+	if strings.TrimSpace(req.Query) == "" {
+		return ErrQueryRequired
 	}
-
-	// 如果启用上下文组装，调用组装服务生成答案
-	var answer string
-	if req.Parameters != nil && req.Parameters.EnableContextAssembly {
-		generatedAnswer, err := uc.repo.AssembleAnswer(ctx, req.Query, documents)
-		if err != nil {
-			uc.log.WithContext(ctx).Warnf("Failed to assemble answer: %v", err)
-			answer = "抱歉，无法生成答案，请查看相关文档。"
-		} else {
-			answer = generatedAnswer
+	startTime := time.Now()
+	uc.log.WithContext(ctx).Infof("Streaming query: %s", req.Query)
+
+	err := uc.repo.StreamAnswer(ctx, req, func(event *QueryStreamEvent) error {
+		switch {
+		case event.Retrieval != nil:
+			uc.log.WithContext(ctx).Infof("Retrieved %d documents", len(event.Retrieval.Documents))
+			return send(&v1.QueryStreamEvent{
+				QueryId: event.QueryID,
+				Event: &v1.QueryStreamEvent_Retrieval{Retrieval: &v1.QueryRetrieval{
+					RelatedDocuments:       toRelatedDocuments(event.Retrieval.Documents),
+					TotalDocumentsSearched: event.Retrieval.TotalDocumentsSearched,
+				}},
+			})
+		case event.Completed != nil:
+			return send(&v1.QueryStreamEvent{
+				QueryId: event.QueryID,
+				Event:   &v1.QueryStreamEvent_Completed{Completed: uc.complete(ctx, req, event.Completed, startTime)},
+			})
+		default:
+			return send(&v1.QueryStreamEvent{
+				QueryId: event.QueryID,
+				Event:   &v1.QueryStreamEvent_AnswerDelta{AnswerDelta: &v1.QueryAnswerDelta{Text: event.AnswerDelta}},
+			})
 		}
-	} else {
-		answer = "请查看相关文档获取信息。"
+	})
+	if err != nil {
+		uc.log.WithContext(ctx).Errorf("Failed to stream query: %v", err)
+		return err
 	}
+	uc.log.WithContext(ctx).Infof("Query streamed successfully in %dms", time.Since(startTime).Milliseconds())
+	return nil
+}
 
-	// 获取查询建议
+// complete builds the response to a query, with suggestions, and saves the
+// query history
+func (uc *QueryUsecase) complete(ctx context.Context, req *v1.QueryRequest, answer *QueryAnswer, startTime time.Time) *v1.QueryResponse {
 	suggestions, err := uc.repo.GetQuerySuggestions(ctx, req.Query)
 	if err != nil {
 		uc.log.WithContext(ctx).Warnf("Failed to get suggestions: %v", err)
 		suggestions = []string{}
 	}
 
-	// 构建查询元数据
-	processingTime := time.Since(startTime).Milliseconds()
-	metadata := &QueryMetadata{
-		QueryTime:              startTime,
-		ProcessingTimeMs:       processingTime,
-		TotalDocumentsSearched: int32(len(documents)),
-		DocumentsReturned:      int32(len(documents)),
-		ModelUsed:              "default",
-		DebugInfo:              make(map[string]string),
+	metadata := answer.Metadata
+	if metadata == nil {
+		metadata = &QueryMetadata{DebugInfo: make(map[string]string)}
 	}
-
-	// 保存查询历史
-	if err := uc.repo.SaveQueryHistory(ctx, req.UserId, req.Query, answer, metadata); err != nil {
+	metadata.QueryTime = startTime
+	metadata.ProcessingTimeMs = time.Since(startTime).Milliseconds()
+	if err := uc.repo.SaveQueryHistory(ctx, req.UserId, req.Query, answer.Answer, metadata); err != nil {
 		uc.log.WithContext(ctx).Warnf("Failed to save query history: %v", err)
 	}
 
-	// 转换为 protobuf 格式
-	relatedDocs := make([]*v1.RelatedDocument, len(documents))
-	for i, doc := range documents {
-		relatedDocs[i] = &v1.RelatedDocument{
-			DocumentId:     doc.DocumentID,
-			Title:          doc.Title,
-			Snippet:        doc.Snippet,
-			RelevanceScore: doc.RelevanceScore,
-			DocumentType:   doc.DocumentType,
-			Chunks:         doc.Chunks,
-		}
-	}
-
-	response := &v1.QueryResponse{
-		QueryId:          generateQueryID(),
-		Answer:           answer,
-		RelatedDocuments: relatedDocs,
+	return &v1.QueryResponse{
+		QueryId:          answer.QueryID,
+		Answer:           answer.Answer,
+		RelatedDocuments: toRelatedDocuments(answer.Documents),
 		Metadata: &v1.QueryMetadata{
+			QueryTime:              timestamppb.New(metadata.QueryTime),
 			ProcessingTimeMs:       metadata.ProcessingTimeMs,
 			TotalDocumentsSearched: metadata.TotalDocumentsSearched,
 			DocumentsReturned:      metadata.DocumentsReturned,
@@ -154,23 +192,22 @@ func (uc *QueryUsecase) ProcessQuery(ctx context.Context, req *v1.QueryRequest)
 			DebugInfo:              metadata.DebugInfo,
 		},
 		Suggestions: suggestions,
+		Citations:   answer.Citations,
 	}
-
-	uc.log.WithContext(ctx).Infof("Query processed successfully in %dms", processingTime)
-	return response, nil
 }
 
-// generateQueryID generates a unique query ID
-func generateQueryID() string {
-	return time.Now().Format("20060102150405") + "-" + randomString(8)
-}
-
-// randomString generates a random string of specified length
-func randomString(length int) string {
-	const charset = "abcdefghijklmnopqrstuvwxyzABCDEFGHIJKLMNOPQRSTUVWXYZ0123456789"
-	b := make([]byte, length)
-	for i := range b {
-		b[i] = charset[time.Now().UnixNano()%int64(len(charset))]
+// toRelatedDocuments converts documents to protobuf format
+func toRelatedDocuments(documents []*RelatedDocument) []*v1.RelatedDocument {
+	relatedDocs := make([]*v1.RelatedDocument, len(documents))
+	for i, doc := range documents {
+		relatedDocs[i] = &v1.RelatedDocument{
+			DocumentId:     doc.DocumentID,
+			Title:          doc.Title,
+			Snippet:        doc.Snippet,
+			RelevanceScore: doc.RelevanceScore,
+			DocumentType:   doc.DocumentType,
+			Chunks:         doc.Chunks,
+		}
 	}
-	return string(b)
+	return relatedDocs
 }
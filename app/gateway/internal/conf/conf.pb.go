@@ -226,11 +226,12 @@ func (m *Server_GRPC) GetTimeout() *durationpb.Duration {
 }
 
 type Data struct {
-	Database             *Data_Database `protobuf:"bytes,1,opt,name=database,proto3" json:"database,omitempty"`
-	Redis                *Data_Redis    `protobuf:"bytes,2,opt,name=redis,proto3" json:"redis,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
-	XXX_unrecognized     []byte         `json:"-"`
-	XXX_sizecache        int32          `json:"-"`
+	Database             *Data_Database     `protobuf:"bytes,1,opt,name=database,proto3" json:"database,omitempty"`
+	Redis                *Data_Redis        `protobuf:"bytes,2,opt,name=redis,proto3" json:"redis,omitempty"`
+	Orchestrator         *Data_Orchestrator `protobuf:"bytes,3,opt,name=orchestrator,proto3" json:"orchestrator,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
+	XXX_unrecognized     []byte             `json:"-"`
+	XXX_sizecache        int32              `json:"-"`
 }
 
 func (m *Data) Reset()         { *m = Data{} }
@@ -272,6 +273,13 @@ func (m *Data) GetRedis() *Data_Redis {
 	return nil
 }
 
+func (m *Data) GetOrchestrator() *Data_Orchestrator {
+	if m != nil {
+		return m.Orchestrator
+	}
+	return nil
+}
+
 type Data_Database struct {
 	Driver               string   `protobuf:"bytes,1,opt,name=driver,proto3" json:"driver,omitempty"`
 	Source               string   `protobuf:"bytes,2,opt,name=source,proto3" json:"source,omitempty"`
@@ -382,6 +390,46 @@ func (m *Data_Redis) GetWriteTimeout() *durationpb.Duration {
 	return nil
 }
 
+type Data_Orchestrator struct {
+	// orchestrator 的 gRPC 地址，未配置时流式查询不可用
+	Endpoint             string   `protobuf:"bytes,1,opt,name=endpoint,proto3" json:"endpoint,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Data_Orchestrator) Reset()         { *m = Data_Orchestrator{} }
+func (m *Data_Orchestrator) String() string { return proto.CompactTextString(m) }
+func (*Data_Orchestrator) ProtoMessage()    {}
+func (*Data_Orchestrator) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9c69a7f648509b54, []int{2, 2}
+}
+
+func (m *Data_Orchestrator) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Data_Orchestrator.Unmarshal(m, b)
+}
+func (m *Data_Orchestrator) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Data_Orchestrator.Marshal(b, m, deterministic)
+}
+func (m *Data_Orchestrator) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Data_Orchestrator.Merge(m, src)
+}
+func (m *Data_Orchestrator) XXX_Size() int {
+	return xxx_messageInfo_Data_Orchestrator.Size(m)
+}
+func (m *Data_Orchestrator) XXX_DiscardUnknown() {
+	xxx_messageInfo_Data_Orchestrator.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Data_Orchestrator proto.InternalMessageInfo
+
+func (m *Data_Orchestrator) GetEndpoint() string {
+	if m != nil {
+		return m.Endpoint
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*Bootstrap)(nil), "kratos.api.Bootstrap")
 	proto.RegisterType((*Server)(nil), "kratos.api.Server")
@@ -390,6 +438,7 @@ func init() {
 	proto.RegisterType((*Data)(nil), "kratos.api.Data")
 	proto.RegisterType((*Data_Database)(nil), "kratos.api.Data.Database")
 	proto.RegisterType((*Data_Redis)(nil), "kratos.api.Data.Redis")
+	proto.RegisterType((*Data_Orchestrator)(nil), "kratos.api.Data.Orchestrator")
 }
 
 func init() {
@@ -397,30 +446,33 @@ func init() {
 }
 
 var fileDescriptor_9c69a7f648509b54 = []byte{
-	// 397 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x93, 0x4f, 0xab, 0xd3, 0x40,
-	0x14, 0xc5, 0x69, 0x4d, 0xd3, 0xe6, 0xb6, 0x82, 0xcc, 0xa2, 0xa6, 0x59, 0x88, 0x84, 0x2e, 0x44,
-	0x65, 0x02, 0x16, 0x37, 0x2a, 0x2e, 0x6a, 0x41, 0x97, 0x65, 0xec, 0x4a, 0x10, 0xb9, 0x6d, 0xa6,
-	0x71, 0x68, 0xcd, 0x84, 0x9b, 0x89, 0xc5, 0xcf, 0xe5, 0xc6, 0x2f, 0xe3, 0x77, 0x91, 0x99, 0x4c,
-	0xea, 0x9f, 0x0a, 0xef, 0xbd, 0xcd, 0xdb, 0x5c, 0x7a, 0x7b, 0x7f, 0xe7, 0x1c, 0x72, 0x48, 0x20,
-	0x56, 0xa5, 0x91, 0x54, 0xe2, 0x31, 0xdb, 0xe9, 0x72, 0xef, 0x06, 0xaf, 0x48, 0x1b, 0xcd, 0xe0,
-	0x40, 0x68, 0x74, 0xcd, 0xb1, 0x52, 0xc9, 0x83, 0x42, 0xeb, 0xe2, 0x28, 0x33, 0x77, 0xd9, 0x36,
-	0xfb, 0x2c, 0x6f, 0x08, 0x8d, 0xd2, 0x65, 0xcb, 0xa6, 0x1f, 0x21, 0x5a, 0x6a, 0x6d, 0x6a, 0x43,
-	0x58, 0xb1, 0xc7, 0x10, 0xd6, 0x92, 0xbe, 0x4a, 0x8a, 0x7b, 0x0f, 0x7b, 0x8f, 0xc6, 0xcf, 0x18,
-	0xff, 0xed, 0xc4, 0xdf, 0xbb, 0x8b, 0xf0, 0x04, 0x9b, 0x43, 0x90, 0xa3, 0xc1, 0xb8, 0xef, 0xc8,
-	0x7b, 0x7f, 0x92, 0x2b, 0x34, 0x28, 0xdc, 0x35, 0xfd, 0xd1, 0x87, 0xb0, 0x15, 0xb2, 0x27, 0x10,
-	0x7c, 0x36, 0xa6, 0xf2, 0xd6, 0xf7, 0x2f, 0xad, 0xf9, 0xbb, 0xcd, 0x66, 0x2d, 0x1c, 0x64, 0xe1,
-	0x82, 0xaa, 0x9d, 0x77, 0xff, 0x1f, 0xfc, 0x56, 0xac, 0xdf, 0x08, 0x07, 0x25, 0x0a, 0x02, 0x2b,
-	0x65, 0x31, 0x0c, 0x4b, 0x69, 0x4e, 0x9a, 0x0e, 0x2e, 0x24, 0x12, 0xdd, 0xca, 0x18, 0x04, 0x98,
-	0xe7, 0xe4, 0xec, 0x22, 0xe1, 0x7e, 0xb3, 0x05, 0x0c, 0x8d, 0xfa, 0x22, 0x75, 0x63, 0xe2, 0x3b,
-	0x2e, 0x65, 0xc6, 0xdb, 0xae, 0x78, 0xd7, 0x15, 0x5f, 0xf9, 0xae, 0x44, 0x47, 0xda, 0x28, 0x1b,
-	0x7c, 0x0b, 0x51, 0xe9, 0xcf, 0x3e, 0x04, 0xb6, 0x49, 0xf6, 0x1c, 0x46, 0xb6, 0xcb, 0x2d, 0xd6,
-	0xd2, 0x97, 0x37, 0xfb, 0xb7, 0x6d, 0x37, 0x2c, 0x20, 0xce, 0x28, 0x7b, 0x0a, 0x03, 0x92, 0xb9,
-	0xaa, 0x7d, 0x87, 0xd3, 0x0b, 0x8d, 0xb0, 0x57, 0xd1, 0x42, 0xc9, 0x0b, 0x18, 0x75, 0x1e, 0x6c,
-	0x0a, 0x61, 0x4e, 0xaa, 0x7b, 0x0d, 0x22, 0xe1, 0x37, 0xfb, 0x7f, 0xad, 0x1b, 0xda, 0x49, 0xff,
-	0x70, 0x7e, 0x4b, 0xbe, 0xf7, 0x60, 0xe0, 0xcc, 0x6e, 0x58, 0xcb, 0x2b, 0x98, 0x90, 0xc4, 0xfc,
-	0xd3, 0xb5, 0xbb, 0x19, 0x5b, 0x7c, 0xd3, 0xd2, 0xec, 0x35, 0xdc, 0x3d, 0x91, 0x32, 0xf2, 0x2c,
-	0x0f, 0xae, 0x92, 0x4f, 0x1c, 0xef, 0xf5, 0xcb, 0xf9, 0x87, 0x94, 0xb0, 0xc8, 0xb0, 0xaa, 0xb2,
-	0x02, 0x8d, 0x3c, 0xe1, 0xb7, 0xec, 0xaf, 0x2f, 0xea, 0xa5, 0x1d, 0xdb, 0xd0, 0xd9, 0x2c, 0x7e,
-	0x05, 0x00, 0x00, 0xff, 0xff, 0xa2, 0x82, 0xe8, 0xdc, 0x6e, 0x03, 0x00, 0x00,
+	// 435 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x93, 0x41, 0x6b, 0x13, 0x41,
+	0x14, 0xc7, 0x49, 0xba, 0x4d, 0x93, 0xd7, 0x08, 0x32, 0x87, 0xba, 0x5d, 0x50, 0x24, 0xf4, 0x20,
+	0x55, 0x66, 0xc1, 0xe2, 0x45, 0x45, 0xb0, 0x06, 0xf4, 0x66, 0x19, 0x73, 0x12, 0x44, 0x5e, 0x32,
+	0xd3, 0xed, 0xd0, 0xba, 0x33, 0xbc, 0x7d, 0x31, 0xf8, 0xb9, 0xbc, 0xf8, 0x21, 0xfc, 0x50, 0x32,
+	0xb3, 0xb3, 0x31, 0x31, 0x88, 0x7a, 0xf1, 0x32, 0xec, 0xdb, 0xf7, 0xfb, 0xbf, 0xff, 0xe3, 0xcf,
+	0x0c, 0xe4, 0xb6, 0x66, 0x43, 0x35, 0xde, 0x94, 0x0b, 0x57, 0x5f, 0xc6, 0x43, 0x7a, 0x72, 0xec,
+	0x04, 0x5c, 0x13, 0xb2, 0x6b, 0x24, 0x7a, 0x5b, 0xdc, 0xab, 0x9c, 0xab, 0x6e, 0x4c, 0x19, 0x3b,
+	0xf3, 0xe5, 0x65, 0xa9, 0x97, 0x84, 0x6c, 0x5d, 0xdd, 0xb2, 0x93, 0x0f, 0x30, 0x3a, 0x77, 0x8e,
+	0x1b, 0x26, 0xf4, 0xe2, 0x14, 0x06, 0x8d, 0xa1, 0xcf, 0x86, 0xf2, 0xde, 0xfd, 0xde, 0x83, 0xc3,
+	0xc7, 0x42, 0xfe, 0x9c, 0x24, 0xdf, 0xc5, 0x8e, 0x4a, 0x84, 0x38, 0x81, 0x4c, 0x23, 0x63, 0xde,
+	0x8f, 0xe4, 0xed, 0x4d, 0x72, 0x8a, 0x8c, 0x2a, 0x76, 0x27, 0xdf, 0xfa, 0x30, 0x68, 0x85, 0xe2,
+	0x21, 0x64, 0x57, 0xcc, 0x3e, 0x8d, 0xbe, 0xb3, 0x3b, 0x5a, 0xbe, 0x99, 0xcd, 0x2e, 0x54, 0x84,
+	0x02, 0x5c, 0x91, 0x5f, 0xe4, 0xfd, 0xdf, 0xc2, 0xaf, 0xd5, 0xc5, 0x2b, 0x15, 0xa1, 0xc2, 0x42,
+	0x16, 0xa4, 0x22, 0x87, 0x83, 0xda, 0xf0, 0xca, 0xd1, 0x75, 0x34, 0x19, 0xa9, 0xae, 0x14, 0x02,
+	0x32, 0xd4, 0x9a, 0xe2, 0xb8, 0x91, 0x8a, 0xdf, 0xe2, 0x0c, 0x0e, 0xd8, 0x7e, 0x32, 0x6e, 0xc9,
+	0xf9, 0x5e, 0x74, 0x39, 0x96, 0x6d, 0x56, 0xb2, 0xcb, 0x4a, 0x4e, 0x53, 0x56, 0xaa, 0x23, 0x83,
+	0x55, 0x30, 0xfe, 0x0f, 0x56, 0x93, 0xef, 0x7b, 0x90, 0x85, 0x24, 0xc5, 0x13, 0x18, 0x86, 0x2c,
+	0xe7, 0xd8, 0x98, 0x14, 0xde, 0xf1, 0xaf, 0x69, 0xcb, 0x69, 0x02, 0xd4, 0x1a, 0x15, 0x8f, 0x60,
+	0x9f, 0x8c, 0xb6, 0x4d, 0xca, 0xf0, 0x68, 0x47, 0xa3, 0x42, 0x57, 0xb5, 0x90, 0x78, 0x09, 0x63,
+	0x47, 0x8b, 0x2b, 0x13, 0x2e, 0x02, 0x3b, 0x4a, 0x7b, 0xde, 0xdd, 0x11, 0xbd, 0xdd, 0x80, 0xd4,
+	0x96, 0xa4, 0x78, 0x0a, 0xc3, 0x6e, 0x0d, 0x71, 0x04, 0x03, 0x4d, 0xb6, 0xbb, 0x49, 0x23, 0x95,
+	0xaa, 0xf0, 0xbf, 0x71, 0x4b, 0x5a, 0x98, 0x94, 0x4f, 0xaa, 0x8a, 0xaf, 0x3d, 0xd8, 0x8f, 0xfb,
+	0xfc, 0x63, 0xb2, 0xcf, 0x61, 0x4c, 0x06, 0xf5, 0xc7, 0xbf, 0x8e, 0xf7, 0x30, 0xe0, 0xb3, 0x96,
+	0x16, 0x2f, 0xe0, 0xd6, 0x8a, 0x2c, 0x9b, 0xb5, 0x3c, 0xfb, 0x93, 0x7c, 0x1c, 0xf9, 0xa4, 0x2f,
+	0x4e, 0x61, 0xbc, 0x99, 0x87, 0x28, 0x60, 0x68, 0x6a, 0xed, 0x9d, 0xad, 0x39, 0x2d, 0xbf, 0xae,
+	0xcf, 0x4f, 0xde, 0x4f, 0x08, 0xab, 0x12, 0xbd, 0x2f, 0x2b, 0x64, 0xb3, 0xc2, 0x2f, 0xe5, 0xd6,
+	0x03, 0x7e, 0x16, 0x8e, 0xf9, 0x20, 0x5a, 0x9e, 0xfd, 0x18, 0x00, 0x92, 0x48, 0x3a, 0x48, 0xdd,
+	0x03, 0x00, 0x00,
 }
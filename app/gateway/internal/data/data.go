@@ -1,9 +1,13 @@
 package data
 
 import (
+	"context"
+
 	"rag/app/gateway/internal/conf"
 
 	"github.com/go-kratos/kratos/v2/log"
+	"github.com/go-kratos/kratos/v2/middleware/recovery"
+	kgrpc "github.com/go-kratos/kratos/v2/transport/grpc"
 	"github.com/google/wire"
 	"google.golang.org/grpc"
 )
@@ -39,6 +43,19 @@ func NewData(c *conf.Data, logger log.Logger) (*Data, func(), error) {
 		logger: logger,
 	}
 
+	if endpoint := c.GetOrchestrator().GetEndpoint(); endpoint != "" {
+		// 流式调用不受客户端超时限制，由请求的 context 控制
+		conn, err := kgrpc.DialInsecure(context.Background(),
+			kgrpc.WithEndpoint(endpoint),
+			kgrpc.WithMiddleware(recovery.Recovery()),
+		)
+		if err != nil {
+			return nil, nil, err
+		}
+		data.orchestratorConn = conn
+		log.NewHelper(logger).Infof("orchestrator at %s", endpoint)
+	}
+
 	cleanup := func() {
 		log.NewHelper(logger).Info("closing the data resources")
 		// TODO: 关闭所有 gRPC 连接
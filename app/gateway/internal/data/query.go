@@ -2,15 +2,23 @@ package data
 
 import (
 	"context"
-	"fmt"
+	"io"
+	"strings"
+	"unicode/utf8"
 
 	commonv1 "rag/api/common/v1"
 	v1 "rag/api/gateway/v1"
+	orchestratorv1 "rag/api/orchestrator/v1"
 	"rag/app/gateway/internal/biz"
 
 	"github.com/go-kratos/kratos/v2/log"
+	"google.golang.org/protobuf/types/known/anypb"
+	"google.golang.org/protobuf/types/known/structpb"
 )
 
+// 相关文档摘要的最大字符数
+const snippetLength = 120
+
 // queryRepo implements biz.QueryRepo interface
 type queryRepo struct {
 	data *Data
@@ -25,156 +33,196 @@ func NewQueryRepo(data *Data, logger log.Logger) biz.QueryRepo {
 	}
 }
 
-// RetrieveDocuments calls orchestrator service to retrieve relevant documents
-func (r *queryRepo) RetrieveDocuments(ctx context.Context, query string, params *v1.QueryParameters) ([]*biz.RelatedDocument, error) {
-	r.log.WithContext(ctx).Infof("Retrieving documents for query: %s", query)
-
-	// TODO: 调用 orchestrator 服务进行文档检索
-	// 这里是模拟实现，实际应该通过 gRPC 调用 orchestrator 服务
-
-	// 模拟返回一些相关文档
-	documents := []*biz.RelatedDocument{
-		{
-			DocumentID:     "doc-1",
-			Title:          "机器学习基础",
-			Snippet:        "机器学习是人工智能的一个重要分支...",
-			RelevanceScore: 0.95,
-			DocumentType:   "pdf",
-			Chunks: []*commonv1.ChunkInfo{
-				{
-					ChunkId:    "chunk-1-1",
-					DocumentId: "doc-1",
-					Content:    "机器学习基础知识介绍...",
-					ChunkIndex: 1,
-				},
-			},
-		},
-		{
-			DocumentID:     "doc-2",
-			Title:          "深度学习实践",
-			Snippet:        "深度学习是机器学习的一个子领域...",
-			RelevanceScore: 0.88,
-			DocumentType:   "pdf",
-			Chunks: []*commonv1.ChunkInfo{
-				{
-					ChunkId:    "chunk-2-1",
-					DocumentId: "doc-2",
-					Content:    "深度学习网络结构...",
-					ChunkIndex: 1,
-				},
-			},
-		},
+// Answer runs the query through the orchestrator's default workflow
+func (r *queryRepo) Answer(ctx context.Context, req *v1.QueryRequest) (*biz.QueryAnswer, error) {
+	if r.data.orchestratorConn == nil {
+		return nil, biz.ErrOrchestratorUnavailable
+	}
+	in, err := processQueryRequest(req)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := orchestratorv1.NewOrchestratorClient(r.data.orchestratorConn).ProcessQuery(ctx, in)
+	if err != nil {
+		return nil, err
 	}
+	return queryAnswer(resp), nil
+}
 
-	// 应用查询参数过滤
-	if params != nil {
-		// 限制返回结果数量
-		if params.MaxResults > 0 && int32(len(documents)) > params.MaxResults {
-			documents = documents[:params.MaxResults]
-		}
+// StreamAnswer runs the query through the orchestrator's default workflow
+// and hands its events on as they arrive. Returning stops the orchestrator
+// stream, which cancels the query there.
+func (r *queryRepo) StreamAnswer(ctx context.Context, req *v1.QueryRequest, handle func(*biz.QueryStreamEvent) error) error {
+	if r.data.orchestratorConn == nil {
+		return biz.ErrOrchestratorUnavailable
+	}
+	in, err := processQueryRequest(req)
+	if err != nil {
+		return err
+	}
 
-		// 应用相似度阈值过滤
-		if params.SimilarityThreshold > 0 {
-			var filteredDocs []*biz.RelatedDocument
-			for _, doc := range documents {
-				if doc.RelevanceScore >= params.SimilarityThreshold {
-					filteredDocs = append(filteredDocs, doc)
-				}
-			}
-			documents = filteredDocs
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	stream, err := orchestratorv1.NewOrchestratorClient(r.data.orchestratorConn).ProcessQueryStream(ctx, in)
+	if err != nil {
+		return err
+	}
+	for {
+		event, err := stream.Recv()
+		if err == io.EOF {
+			return nil
 		}
-
-		// 应用文档类型过滤
-		if len(params.DocumentTypes) > 0 {
-			typeMap := make(map[string]bool)
-			for _, docType := range params.DocumentTypes {
-				typeMap[docType] = true
-			}
-
-			var filteredDocs []*biz.RelatedDocument
-			for _, doc := range documents {
-				if typeMap[doc.DocumentType] {
-					filteredDocs = append(filteredDocs, doc)
-				}
-			}
-			documents = filteredDocs
+		if err != nil {
+			return err
 		}
-
-		// 应用文档ID过滤
-		if len(params.DocumentIds) > 0 {
-			idMap := make(map[string]bool)
-			for _, docID := range params.DocumentIds {
-				idMap[docID] = true
-			}
-
-			var filteredDocs []*biz.RelatedDocument
-			for _, doc := range documents {
-				if idMap[doc.DocumentID] {
-					filteredDocs = append(filteredDocs, doc)
-				}
+		out := &biz.QueryStreamEvent{QueryID: event.QueryId}
+		switch e := event.Event.(type) {
+		case *orchestratorv1.ProcessQueryEvent_Retrieval:
+			out.Retrieval = &biz.QueryRetrieval{
+				Documents:              relatedDocuments(e.Retrieval.ContextDocuments),
+				TotalDocumentsSearched: e.Retrieval.TotalDocumentsSearched,
 			}
-			documents = filteredDocs
+		case *orchestratorv1.ProcessQueryEvent_AnswerDelta:
+			out.AnswerDelta = e.AnswerDelta.Text
+		case *orchestratorv1.ProcessQueryEvent_Completed:
+			out.Completed = queryAnswer(e.Completed)
+		default:
+			continue
+		}
+		if err := handle(out); err != nil {
+			return err
 		}
 	}
-
-	r.log.WithContext(ctx).Infof("Retrieved %d documents", len(documents))
-	return documents, nil
 }
 
-// RerankDocuments calls reranker service to optimize document order
-func (r *queryRepo) RerankDocuments(ctx context.Context, query string, documents []*biz.RelatedDocument) ([]*biz.RelatedDocument, error) {
-	r.log.WithContext(ctx).Infof("Reranking %d documents", len(documents))
-
-	// TODO: 调用 reranker 服务进行文档重排序
-	// 这里是模拟实现，实际应该通过 gRPC 调用 reranker 服务
-
-	// 模拟重排序：简单地按相关性分数降序排列
-	rerankedDocs := make([]*biz.RelatedDocument, len(documents))
-	copy(rerankedDocs, documents)
-
-	// 简单的冒泡排序按相关性分数降序
-	for i := 0; i < len(rerankedDocs)-1; i++ {
-		for j := 0; j < len(rerankedDocs)-i-1; j++ {
-			if rerankedDocs[j].RelevanceScore < rerankedDocs[j+1].RelevanceScore {
-				rerankedDocs[j], rerankedDocs[j+1] = rerankedDocs[j+1], rerankedDocs[j]
-			}
+// processQueryRequest maps a query onto the orchestrator's default
+// workflow. max_results and similarity_threshold limit the reranked
+// documents, max_context_length the assembled context, and the document
+// filters are passed to the hybrid search; filters become the workflow
+// context.
+func processQueryRequest(req *v1.QueryRequest) (*orchestratorv1.ProcessQueryRequest, error) {
+	params := req.GetParameters()
+	serviceOptions := make(map[string]map[string]any)
+	rerank := make(map[string]any)
+	if params.GetMaxResults() > 0 {
+		rerank["top_k"] = params.GetMaxResults()
+	}
+	if params.GetSimilarityThreshold() > 0 {
+		rerank["score_threshold"] = params.GetSimilarityThreshold()
+	}
+	if len(rerank) > 0 {
+		serviceOptions["reranker"] = map[string]any{"options": rerank}
+	}
+	if params.GetMaxContextLength() > 0 {
+		serviceOptions["assembler"] = map[string]any{
+			"options": map[string]any{
+				"max_context_length":       params.GetMaxContextLength(),
+				"include_source_citations": true,
+			},
 		}
 	}
-
-	// 模拟重排序可能会微调分数
-	for i, doc := range rerankedDocs {
-		doc.RelevanceScore = doc.RelevanceScore * (1.0 - float32(i)*0.01) // 略微降低后续文档的分数
+	var filters []any
+	for field, values := range map[string][]string{
+		"document_id":   params.GetDocumentIds(),
+		"document_type": params.GetDocumentTypes(),
+	} {
+		if len(values) == 0 {
+			continue
+		}
+		items := make([]any, len(values))
+		for i, v := range values {
+			items[i] = v
+		}
+		filters = append(filters, map[string]any{"field": field, "operator": "in", "values": items})
 	}
-
-	r.log.WithContext(ctx).Infof("Documents reranked successfully")
-	return rerankedDocs, nil
-}
-
-// AssembleAnswer calls assembler service to generate answer
-func (r *queryRepo) AssembleAnswer(ctx context.Context, query string, documents []*biz.RelatedDocument) (string, error) {
-	r.log.WithContext(ctx).Infof("Assembling answer for query: %s", query)
-
-	// TODO: 调用 assembler 服务生成答案
-	// 这里是模拟实现，实际应该通过 gRPC 调用 assembler 服务
-
-	if len(documents) == 0 {
-		return "抱歉，没有找到相关的文档信息。", nil
+	if len(filters) > 0 {
+		serviceOptions["docstore"] = map[string]any{"filters": filters}
 	}
 
-	// 模拟生成答案：基于文档内容生成简单的回答
-	answer := fmt.Sprintf("根据相关文档，关于「%s」的信息如下：\n\n", query)
+	options := &orchestratorv1.QueryProcessingOptions{
+		EnableFallback: true,
+		ServiceOptions: make(map[string]*anypb.Any, len(serviceOptions)),
+	}
+	for service, fields := range serviceOptions {
+		s, err := structpb.NewStruct(fields)
+		if err != nil {
+			return nil, err
+		}
+		if options.ServiceOptions[service], err = anypb.New(s); err != nil {
+			return nil, err
+		}
+	}
+	return &orchestratorv1.ProcessQueryRequest{
+		Query:     req.Query,
+		SessionId: req.SessionId,
+		UserId:    req.UserId,
+		Options:   options,
+		Context:   params.GetFilters(),
+	}, nil
+}
 
-	for i, doc := range documents {
-		if i >= 3 { // 最多使用前3个文档
-			break
+// relatedDocuments groups the context chunks by document in the order the
+// documents first appear. A document scores as its best chunk.
+func relatedDocuments(chunks []*orchestratorv1.ContextDocument) []*biz.RelatedDocument {
+	var documents []*biz.RelatedDocument
+	byID := make(map[string]*biz.RelatedDocument)
+	for _, chunk := range chunks {
+		doc, ok := byID[chunk.DocumentId]
+		if !ok {
+			doc = &biz.RelatedDocument{
+				DocumentID:   chunk.DocumentId,
+				Title:        chunk.Title,
+				Snippet:      snippet(chunk.Content),
+				DocumentType: chunk.Metadata["document_type"],
+			}
+			byID[chunk.DocumentId] = doc
+			documents = append(documents, doc)
+		}
+		if chunk.RelevanceScore > doc.RelevanceScore {
+			doc.RelevanceScore = chunk.RelevanceScore
 		}
-		answer += fmt.Sprintf("%d. %s：%s\n\n", i+1, doc.Title, doc.Snippet)
+		doc.Chunks = append(doc.Chunks, &commonv1.ChunkInfo{
+			ChunkId:    chunk.ChunkId,
+			DocumentId: chunk.DocumentId,
+			Content:    chunk.Content,
+		})
 	}
+	return documents
+}
 
-	answer += "以上信息来自相关文档，如需更详细信息，请查看具体文档。"
+// queryAnswer converts an orchestrator response: the final answer, its
+// citations and the context documents grouped by document
+func queryAnswer(resp *orchestratorv1.ProcessQueryResponse) *biz.QueryAnswer {
+	documents := relatedDocuments(resp.ContextDocuments)
+	md := resp.GetMetadata()
+	debugInfo := make(map[string]string, len(md.GetDebugInfo())+1)
+	for k, v := range md.GetDebugInfo() {
+		debugInfo[k] = v
+	}
+	debugInfo["workflow_used"] = md.GetWorkflowUsed()
+	return &biz.QueryAnswer{
+		QueryID: resp.QueryId,
+		AssembledAnswer: biz.AssembledAnswer{
+			Answer:    resp.FinalAnswer,
+			Citations: resp.Citations,
+		},
+		Documents: documents,
+		Metadata: &biz.QueryMetadata{
+			TotalDocumentsSearched: md.GetTotalDocumentsSearched(),
+			DocumentsReturned:      int32(len(documents)),
+			ModelUsed:              md.GetDebugInfo()["model"],
+			DebugInfo:              debugInfo,
+		},
+	}
+}
 
-	r.log.WithContext(ctx).Info("Answer assembled successfully")
-	return answer, nil
+// snippet shortens content to snippetLength characters
+func snippet(content string) string {
+	content = strings.TrimSpace(content)
+	if utf8.RuneCountInString(content) <= snippetLength {
+		return content
+	}
+	return string([]rune(content)[:snippetLength]) + "..."
 }
 
 // SaveQueryHistory saves query history
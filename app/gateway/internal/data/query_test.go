@@ -0,0 +1,103 @@
+package data
+
+import (
+	"testing"
+
+	commonv1 "rag/api/common/v1"
+	v1 "rag/api/gateway/v1"
+	orchestratorv1 "rag/api/orchestrator/v1"
+
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+func TestQueryAnswerKeepsOrchestratorCitations(t *testing.T) {
+	citation := &commonv1.Citation{CitationNumber: 1, ChunkId: "c2", DocumentId: "d1", StartOffset: 10, EndOffset: 20}
+	answer := queryAnswer(&orchestratorv1.ProcessQueryResponse{
+		QueryId:     "q1",
+		FinalAnswer: "Channels connect goroutines [1].",
+		ContextDocuments: []*orchestratorv1.ContextDocument{
+			{DocumentId: "d1", ChunkId: "c1", Content: "first", RelevanceScore: 0.4},
+			{DocumentId: "d2", ChunkId: "c3", Content: "other", RelevanceScore: 0.5},
+			{DocumentId: "d1", ChunkId: "c2", Content: "second", RelevanceScore: 0.9},
+		},
+		Citations: []*commonv1.Citation{citation},
+		Metadata: &orchestratorv1.QueryProcessingMetadata{
+			TotalDocumentsSearched: 7,
+			WorkflowUsed:           "default_query_workflow",
+			DebugInfo:              map[string]string{"model": "m"},
+		},
+	})
+
+	if answer.QueryID != "q1" || answer.Answer != "Channels connect goroutines [1]." {
+		t.Errorf("answer = %q/%q", answer.QueryID, answer.Answer)
+	}
+	if len(answer.Citations) != 1 || answer.Citations[0] != citation {
+		t.Errorf("citations = %v, want the orchestrator's", answer.Citations)
+	}
+	// 分块按文档分组，文档取最高分块的分数
+	if len(answer.Documents) != 2 {
+		t.Fatalf("documents = %d, want 2", len(answer.Documents))
+	}
+	d1 := answer.Documents[0]
+	if d1.DocumentID != "d1" || len(d1.Chunks) != 2 || d1.RelevanceScore != 0.9 {
+		t.Errorf("first document = %+v", d1)
+	}
+	md := answer.Metadata
+	if md.TotalDocumentsSearched != 7 || md.DocumentsReturned != 2 || md.ModelUsed != "m" {
+		t.Errorf("metadata = %+v", md)
+	}
+	if md.DebugInfo["workflow_used"] != "default_query_workflow" {
+		t.Errorf("workflow_used = %q", md.DebugInfo["workflow_used"])
+	}
+}
+
+func TestProcessQueryRequest(t *testing.T) {
+	req, err := processQueryRequest(&v1.QueryRequest{
+		Query: "go channels",
+		Parameters: &v1.QueryParameters{
+			MaxResults:          3,
+			SimilarityThreshold: 0.5,
+			DocumentTypes:       []string{"pdf"},
+			MaxContextLength:    1000,
+			Filters:             map[string]string{"lang": "en"},
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	options := func(service string) map[string]any {
+		t.Helper()
+		s := new(structpb.Struct)
+		if err := req.Options.ServiceOptions[service].UnmarshalTo(s); err != nil {
+			t.Fatalf("%s options: %v", service, err)
+		}
+		return s.AsMap()
+	}
+	rerank := options("reranker")["options"].(map[string]any)
+	if rerank["top_k"] != float64(3) || rerank["score_threshold"] != float64(float32(0.5)) {
+		t.Errorf("reranker options = %v", rerank)
+	}
+	if v := options("assembler")["options"].(map[string]any)["max_context_length"]; v != float64(1000) {
+		t.Errorf("max_context_length = %v", v)
+	}
+	filters := options("docstore")["filters"].([]any)
+	if len(filters) != 1 || filters[0].(map[string]any)["field"] != "document_type" {
+		t.Errorf("docstore filters = %v", filters)
+	}
+	if req.Context["lang"] != "en" || !req.Options.EnableFallback {
+		t.Errorf("context = %v, fallback = %v", req.Context, req.Options.EnableFallback)
+	}
+}
+
+func TestSnippet(t *testing.T) {
+	long := make([]rune, snippetLength+5)
+	for i := range long {
+		long[i] = '文'
+	}
+	if got := []rune(snippet(string(long))); len(got) != snippetLength+3 {
+		t.Errorf("snippet length = %d, want %d", len(got), snippetLength+3)
+	}
+	if got := snippet("  short  "); got != "short" {
+		t.Errorf("snippet = %q", got)
+	}
+}
@@ -75,8 +75,12 @@ func NewHTTPServer(c *conf.Server, gateway *service.GatewayService, logger log.L
 		// validate.Validator(),
 	))
 
+	// 流式问答需要在超时之前保留请求的上下文
+	opts = append(opts, http.Filter(streamFilter(service.QueryStreamPath)))
+
 	srv := http.NewServer(opts...)
 	v1.RegisterGatewayHTTPServer(srv, gateway)
+	routeStream(srv, service.QueryStreamPath, gateway.QueryStreamSSE)
 
 	return srv
 }
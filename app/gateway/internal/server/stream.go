@@ -0,0 +1,59 @@
+package server
+
+import (
+	"context"
+	nethttp "net/http"
+
+	"github.com/go-kratos/kratos/v2/transport/http"
+)
+
+// connContextKey carries the request context as it was before the server
+// applied its timeout
+type connContextKey struct{}
+
+// streamFilter keeps the context of requests to path before the timeout is
+// applied, so that the stream can outlive the timeout but not the client
+func streamFilter(path string) http.FilterFunc {
+	return func(next nethttp.Handler) nethttp.Handler {
+		return nethttp.HandlerFunc(func(w nethttp.ResponseWriter, r *nethttp.Request) {
+			if r.URL.Path == path {
+				r = r.WithContext(context.WithValue(r.Context(), connContextKey{}, r.Context()))
+			}
+			next.ServeHTTP(w, r)
+		})
+	}
+}
+
+// routeStream serves a streaming handler on path for GET and POST. It runs
+// through the server middleware like any other route and only escapes the
+// server timeout: it ends when the client goes away.
+func routeStream(srv *http.Server, path string, h nethttp.HandlerFunc) {
+	handler := func(c http.Context) error {
+		ctx, cancel := withoutTimeout(c.Request().Context())
+		defer cancel()
+		next := c.Middleware(func(ctx context.Context, _ interface{}) (interface{}, error) {
+			h(c.Response(), c.Request().WithContext(ctx))
+			return nil, nil
+		})
+		_, err := next(ctx, nil)
+		return err
+	}
+	r := srv.Route("/")
+	r.GET(path, handler)
+	r.POST(path, handler)
+}
+
+// withoutTimeout keeps the values of ctx but is only cancelled with the
+// connection context streamFilter saved
+func withoutTimeout(ctx context.Context) (context.Context, context.CancelFunc) {
+	conn, ok := ctx.Value(connContextKey{}).(context.Context)
+	if !ok {
+		return context.WithCancel(ctx)
+	}
+	detached, cancel := context.WithCancel(context.WithoutCancel(ctx))
+	stop := context.AfterFunc(conn, cancel)
+	return detached, func() {
+		stop()
+		cancel()
+	}
+}
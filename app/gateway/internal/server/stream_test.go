@@ -0,0 +1,84 @@
+package server
+
+import (
+	"context"
+	"io"
+	nethttp "net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/middleware"
+	"github.com/go-kratos/kratos/v2/transport"
+	"github.com/go-kratos/kratos/v2/transport/http"
+)
+
+// newStreamServer serves a stream that writes "done" after delay, with a
+// timeout shorter than that and a middleware marking the reply
+func newStreamServer(delay time.Duration, result chan<- error) *httptest.Server {
+	srv := http.NewServer(
+		http.Timeout(30*time.Millisecond),
+		http.Filter(streamFilter("/stream")),
+		http.Middleware(func(h middleware.Handler) middleware.Handler {
+			return func(ctx context.Context, req interface{}) (interface{}, error) {
+				if tr, ok := transport.FromServerContext(ctx); ok {
+					tr.ReplyHeader().Set("X-Middleware", tr.Operation())
+				}
+				return h(ctx, req)
+			}
+		}),
+	)
+	routeStream(srv, "/stream", func(w nethttp.ResponseWriter, r *nethttp.Request) {
+		select {
+		case <-time.After(delay):
+			_, _ = io.WriteString(w, "done")
+			result <- nil
+		case <-r.Context().Done():
+			result <- r.Context().Err()
+		}
+	})
+	return httptest.NewServer(srv)
+}
+
+func TestStreamOutlivesTimeout(t *testing.T) {
+	result := make(chan error, 1)
+	ts := newStreamServer(100*time.Millisecond, result)
+	defer ts.Close()
+
+	for _, method := range []string{nethttp.MethodGet, nethttp.MethodPost} {
+		req, _ := nethttp.NewRequest(method, ts.URL+"/stream", nil)
+		resp, err := nethttp.DefaultClient.Do(req)
+		if err != nil {
+			t.Fatal(err)
+		}
+		body, _ := io.ReadAll(resp.Body)
+		resp.Body.Close()
+		if err := <-result; err != nil {
+			t.Fatalf("%s: stream stopped: %v", method, err)
+		}
+		if string(body) != "done" || resp.Header.Get("X-Middleware") != "/stream" {
+			t.Errorf("%s: body %q, middleware header %q", method, body, resp.Header.Get("X-Middleware"))
+		}
+	}
+}
+
+func TestStreamEndsWithClient(t *testing.T) {
+	result := make(chan error, 1)
+	ts := newStreamServer(time.Minute, result)
+	defer ts.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+	req, _ := nethttp.NewRequestWithContext(ctx, nethttp.MethodGet, ts.URL+"/stream", nil)
+	if resp, err := nethttp.DefaultClient.Do(req); err == nil {
+		resp.Body.Close()
+	}
+	select {
+	case err := <-result:
+		if err != context.Canceled {
+			t.Errorf("stream stopped with %v, want the client's cancellation", err)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("stream kept running after the client left")
+	}
+}
@@ -48,6 +48,13 @@ func (s *GatewayService) Query(ctx context.Context, req *pb.QueryRequest) (*pb.Q
 	return s.queryUc.ProcessQuery(ctx, req)
 }
 
+// QueryStream streams the retrieved documents, the answer as it is
+// generated and the full response of a query
+func (s *GatewayService) QueryStream(req *pb.QueryRequest, stream pb.Gateway_QueryStreamServer) error {
+	s.log.WithContext(stream.Context()).Info("QueryStream request received")
+	return s.queryUc.StreamQuery(stream.Context(), req, stream.Send)
+}
+
 // UploadDocument handles document upload
 func (s *GatewayService) UploadDocument(ctx context.Context, req *pb.UploadDocumentRequest) (*pb.UploadDocumentResponse, error) {
 	s.log.WithContext(ctx).Info("UploadDocument request received")
@@ -0,0 +1,106 @@
+package service
+
+import (
+	"fmt"
+	"net/http"
+
+	pb "rag/api/gateway/v1"
+
+	"github.com/go-kratos/kratos/v2/encoding"
+	"github.com/go-kratos/kratos/v2/encoding/json"
+	"github.com/go-kratos/kratos/v2/errors"
+	khttp "github.com/go-kratos/kratos/v2/transport/http"
+)
+
+// QueryStreamPath is where QueryStream is served over HTTP as server-sent
+// events
+const QueryStreamPath = "/v1/query/stream"
+
+// Server-sent event types of a streamed query
+const (
+	sseRetrieval   = "retrieval"
+	sseAnswerDelta = "answer_delta"
+	sseCompleted   = "completed"
+	sseError       = "error"
+)
+
+// QueryStreamSSE serves QueryStream as server-sent events. POST takes the
+// QueryRequest as its body, GET as query parameters for EventSource
+// clients. Each event is named after the stage it carries, and its data
+// has the query_id and the stage, as in QueryStreamEvent. A failure before
+// the first event is an ordinary error response; later it is an error
+// event. Closing the connection cancels the query.
+func (s *GatewayService) QueryStreamSSE(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	req := new(pb.QueryRequest)
+	var err error
+	switch r.Method {
+	case http.MethodGet:
+		err = khttp.DefaultRequestQuery(r, req)
+	case http.MethodPost:
+		err = khttp.DefaultRequestDecoder(r, req)
+	default:
+		w.Header().Set("Allow", "GET, POST")
+		w.WriteHeader(http.StatusMethodNotAllowed)
+		return
+	}
+	if err != nil {
+		khttp.DefaultErrorEncoder(w, r, err)
+		return
+	}
+	s.log.WithContext(ctx).Info("QueryStream request received over SSE")
+
+	sse := &sseWriter{w: w, rc: http.NewResponseController(w), codec: encoding.GetCodec(json.Name)}
+	err = s.queryUc.StreamQuery(ctx, req, func(event *pb.QueryStreamEvent) error {
+		switch e := event.Event.(type) {
+		case *pb.QueryStreamEvent_Retrieval:
+			return sse.send(sseRetrieval, map[string]any{"query_id": event.QueryId, sseRetrieval: e.Retrieval})
+		case *pb.QueryStreamEvent_AnswerDelta:
+			return sse.send(sseAnswerDelta, map[string]any{"query_id": event.QueryId, sseAnswerDelta: e.AnswerDelta})
+		case *pb.QueryStreamEvent_Completed:
+			return sse.send(sseCompleted, map[string]any{"query_id": event.QueryId, sseCompleted: e.Completed})
+		}
+		return nil
+	})
+	switch {
+	case err == nil:
+	case ctx.Err() != nil:
+		// 客户端已断开
+		s.log.WithContext(ctx).Infof("QueryStream closed by the client: %v", err)
+	case !sse.started:
+		khttp.DefaultErrorEncoder(w, r, err)
+	default:
+		if err := sse.send(sseError, errors.FromError(err)); err != nil {
+			s.log.WithContext(ctx).Warnf("Failed to send the error event: %v", err)
+		}
+	}
+}
+
+// sseWriter writes server-sent events, flushing each one
+type sseWriter struct {
+	w       http.ResponseWriter
+	rc      *http.ResponseController
+	codec   encoding.Codec
+	started bool
+}
+
+func (e *sseWriter) send(event string, data any) error {
+	raw, err := e.codec.Marshal(data)
+	if err != nil {
+		return err
+	}
+	if !e.started {
+		h := e.w.Header()
+		h.Set("Content-Type", "text/event-stream")
+		h.Set("Cache-Control", "no-cache")
+		h.Set("Connection", "keep-alive")
+		// 关闭反向代理的缓冲
+		h.Set("X-Accel-Buffering", "no")
+		e.w.WriteHeader(http.StatusOK)
+		e.started = true
+	}
+	if _, err := fmt.Fprintf(e.w, "event: %s\ndata: %s\n\n", event, raw); err != nil {
+		return err
+	}
+	return e.rc.Flush()
+}
@@ -0,0 +1,148 @@
+package service
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	v1 "rag/api/gateway/v1"
+	"rag/app/gateway/internal/biz"
+
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+// streamRepo streams events and then fails with err
+type streamRepo struct {
+	events []*biz.QueryStreamEvent
+	err    error
+}
+
+func (r *streamRepo) Answer(ctx context.Context, req *v1.QueryRequest) (*biz.QueryAnswer, error) {
+	return nil, errors.InternalServer("UNUSED", "unused")
+}
+
+func (r *streamRepo) StreamAnswer(ctx context.Context, req *v1.QueryRequest, handle func(*biz.QueryStreamEvent) error) error {
+	for _, e := range r.events {
+		if err := handle(e); err != nil {
+			return err
+		}
+	}
+	return r.err
+}
+
+func (r *streamRepo) SaveQueryHistory(ctx context.Context, userID, query, answer string, metadata *biz.QueryMetadata) error {
+	return nil
+}
+
+func (r *streamRepo) GetQuerySuggestions(ctx context.Context, query string) ([]string, error) {
+	return nil, nil
+}
+
+type sseEvent struct {
+	name string
+	data map[string]any
+}
+
+// serveSSE runs QueryStreamSSE and parses the events it wrote
+func serveSSE(t *testing.T, repo biz.QueryRepo, r *http.Request) (*httptest.ResponseRecorder, []sseEvent) {
+	t.Helper()
+	s := NewGatewayService(nil, biz.NewQueryUsecase(repo, log.DefaultLogger), nil, log.DefaultLogger)
+	w := httptest.NewRecorder()
+	s.QueryStreamSSE(w, r)
+	if w.Header().Get("Content-Type") != "text/event-stream" {
+		return w, nil
+	}
+	var (
+		events []sseEvent
+		cur    sseEvent
+	)
+	scanner := bufio.NewScanner(strings.NewReader(w.Body.String()))
+	for scanner.Scan() {
+		line := scanner.Text()
+		switch {
+		case strings.HasPrefix(line, "event: "):
+			cur.name = strings.TrimPrefix(line, "event: ")
+		case strings.HasPrefix(line, "data: "):
+			if err := json.Unmarshal([]byte(strings.TrimPrefix(line, "data: ")), &cur.data); err != nil {
+				t.Fatalf("event %s: %v", cur.name, err)
+			}
+		case line == "":
+			events = append(events, cur)
+			cur = sseEvent{}
+		}
+	}
+	return w, events
+}
+
+func streamedQuery() []*biz.QueryStreamEvent {
+	return []*biz.QueryStreamEvent{
+		{QueryID: "q1", Retrieval: &biz.QueryRetrieval{Documents: []*biz.RelatedDocument{{DocumentID: "d1"}}, TotalDocumentsSearched: 3}},
+		{QueryID: "q1", AnswerDelta: "Hel"},
+		{QueryID: "q1", AnswerDelta: "lo"},
+		{QueryID: "q1", Completed: &biz.QueryAnswer{QueryID: "q1", AssembledAnswer: biz.AssembledAnswer{Answer: "Hello"}}},
+	}
+}
+
+func eventNames(events []sseEvent) string {
+	names := make([]string, len(events))
+	for i, e := range events {
+		names[i] = e.name
+	}
+	return strings.Join(names, " ")
+}
+
+func TestQueryStreamSSEOrder(t *testing.T) {
+	for _, r := range []*http.Request{
+		httptest.NewRequest(http.MethodPost, QueryStreamPath, strings.NewReader(`{"query":"q"}`)),
+		httptest.NewRequest(http.MethodGet, QueryStreamPath+"?query=q", nil),
+	} {
+		r.Header.Set("Content-Type", "application/json")
+		w, events := serveSSE(t, &streamRepo{events: streamedQuery()}, r)
+		if w.Code != http.StatusOK {
+			t.Fatalf("%s: status %d: %s", r.Method, w.Code, w.Body)
+		}
+		if got := eventNames(events); got != "retrieval answer_delta answer_delta completed" {
+			t.Fatalf("%s: events %s", r.Method, got)
+		}
+		var answer strings.Builder
+		for _, e := range events {
+			if e.data["query_id"] != "q1" {
+				t.Errorf("%s event has query_id %v", e.name, e.data["query_id"])
+			}
+			if delta, ok := e.data["answer_delta"].(map[string]any); ok {
+				answer.WriteString(delta["text"].(string))
+			}
+		}
+		completed, _ := events[3].data["completed"].(map[string]any)
+		if answer.String() != "Hello" || completed["answer"] != "Hello" {
+			t.Errorf("%s: streamed %q, completed %v", r.Method, answer.String(), completed)
+		}
+	}
+}
+
+func TestQueryStreamSSEErrors(t *testing.T) {
+	// 已开始发送后的失败作为 error 事件结束流
+	repo := &streamRepo{events: streamedQuery()[:2], err: errors.ServiceUnavailable("DOWN", "generator unavailable")}
+	w, events := serveSSE(t, repo, httptest.NewRequest(http.MethodGet, QueryStreamPath+"?query=q", nil))
+	if got := eventNames(events); w.Code != http.StatusOK || got != "retrieval answer_delta error" {
+		t.Fatalf("status %d, events %s", w.Code, got)
+	}
+	if last := events[2].data; last["reason"] != "DOWN" || last["code"] != float64(http.StatusServiceUnavailable) {
+		t.Errorf("error event %v", last)
+	}
+
+	// 开始之前的失败是普通的错误响应
+	w, events = serveSSE(t, &streamRepo{}, httptest.NewRequest(http.MethodGet, QueryStreamPath+"?query=+", nil))
+	if w.Code != http.StatusBadRequest || events != nil {
+		t.Errorf("blank query: status %d, events %v", w.Code, events)
+	}
+	w, _ = serveSSE(t, &streamRepo{}, httptest.NewRequest(http.MethodPut, QueryStreamPath, nil))
+	if w.Code != http.StatusMethodNotAllowed || w.Header().Get("Allow") != "GET, POST" {
+		t.Errorf("PUT: status %d, allow %q", w.Code, w.Header().Get("Allow"))
+	}
+}
@@ -7,13 +7,13 @@
 package main
 
 import (
+	"github.com/go-kratos/kratos/v2"
+	"github.com/go-kratos/kratos/v2/log"
 	"rag/app/orchestrator/internal/biz"
 	"rag/app/orchestrator/internal/conf"
 	"rag/app/orchestrator/internal/data"
 	"rag/app/orchestrator/internal/server"
 	"rag/app/orchestrator/internal/service"
-	"github.com/go-kratos/kratos/v2"
-	"github.com/go-kratos/kratos/v2/log"
 )
 
 import (
@@ -28,13 +28,49 @@ func wireApp(confServer *conf.Server, confData *conf.Data, logger log.Logger) (*
 	if err != nil {
 		return nil, nil, err
 	}
-	greeterRepo := data.NewGreeterRepo(dataData, logger)
-	greeterUsecase := biz.NewGreeterUsecase(greeterRepo, logger)
-	greeterService := service.NewGreeterService(greeterUsecase)
-	grpcServer := server.NewGRPCServer(confServer, greeterService, logger)
-	httpServer := server.NewHTTPServer(confServer, greeterService, logger)
+	serviceRepo, err := data.NewServiceRepo(dataData, confData, logger)
+	if err != nil {
+		cleanup()
+		return nil, nil, err
+	}
+	tokenizer, err := data.NewTokenizer(confData, logger)
+	if err != nil {
+		cleanup()
+		return nil, nil, err
+	}
+	generator, err := data.NewGenerator(confData, tokenizer, logger)
+	if err != nil {
+		cleanup()
+		return nil, nil, err
+	}
+	generationUsecase := biz.NewGenerationUsecase(generator, tokenizer, logger)
+	workflowEngine := biz.NewWorkflowEngine(serviceRepo, generationUsecase, logger)
+	definitionRepo, err := data.NewDefinitionRepo(confData, logger)
+	if err != nil {
+		cleanup()
+		return nil, nil, err
+	}
+	queryCache := data.NewQueryCache(logger)
+	queryUsecase := biz.NewQueryUsecase(workflowEngine, definitionRepo, queryCache, logger)
+	healthUsecase := biz.NewHealthUsecase(serviceRepo, logger)
+	executionRepo, err := data.NewExecutionRepo(confData, logger)
+	if err != nil {
+		cleanup()
+		return nil, nil, err
+	}
+	callbackRepo := data.NewCallbackRepo(confData, logger)
+	executionUsecase, cleanup2, err := biz.NewExecutionUsecase(workflowEngine, definitionRepo, executionRepo, callbackRepo, logger)
+	if err != nil {
+		cleanup()
+		return nil, nil, err
+	}
+	definitionUsecase := biz.NewDefinitionUsecase(workflowEngine, definitionRepo, executionRepo, logger)
+	orchestratorService := service.NewOrchestratorService(queryUsecase, healthUsecase, executionUsecase, definitionUsecase, logger)
+	grpcServer := server.NewGRPCServer(confServer, orchestratorService, logger)
+	httpServer := server.NewHTTPServer(confServer, orchestratorService, logger)
 	app := newApp(logger, grpcServer, httpServer)
 	return app, func() {
+		cleanup2()
 		cleanup()
 	}, nil
 }
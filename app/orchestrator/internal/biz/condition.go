@@ -0,0 +1,646 @@
+package biz
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"runtime"
+	"runtime/metrics"
+	"strconv"
+	"strings"
+	"time"
+
+	v1 "rag/api/orchestrator/v1"
+
+	"github.com/dop251/goja"
+	"github.com/go-kratos/kratos/v2/errors"
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+// Condition types accepted in ConditionalExecution.condition_type.
+const (
+	ConditionSimple     = "simple"
+	ConditionJSONPath   = "jsonpath"
+	ConditionJavaScript = "javascript"
+)
+
+// Simple conditions combine mapping references and literals:
+//
+//	$.steps.retrieve.output.results | length > 0
+//	$.input.mode == "fast" || not $.context.premium
+//	"draft" in $.input.tags && $.steps.rerank.output.results[0].score >= 0.5
+//
+// Operators are ==, !=, <, <=, >, >=, contains and in, combined with
+// && (and), || (or) and ! (not). Ordering comparisons between values of
+// different types are false. jsonpath conditions are a single reference,
+// usually with a filter, and hold when it selects anything. javascript
+// conditions run in a sandboxed interpreter that sees input, steps, context
+// and output as plain objects and holds when the script's value is truthy.
+// Scripts are bounded by length, call depth, running time and memory.
+
+const (
+	// 脚本条件的限制
+	scriptTimeout     = time.Second
+	scriptMemoryLimit = 32 << 20
+	// 堆增长超过上限的倍数时不等回收结束直接中断
+	scriptMemoryHardFactor = 4
+	scriptMaxCallStack     = 256
+	maxScriptLength        = 16 << 10
+	// 检查脚本内存用量的间隔
+	scriptWatchInterval = 2 * time.Millisecond
+)
+
+// condNode is a node of a compiled simple condition
+type condNode interface {
+	// eval computes the node's JSON value; current is the element a filter tests
+	eval(scope *mappingScope, current any) (any, error)
+	// walk calls fn for every reference in the node
+	walk(fn func(*expression) error) error
+}
+
+type condLiteral struct{ value any }
+
+func (n condLiteral) eval(*mappingScope, any) (any, error) { return n.value, nil }
+func (n condLiteral) walk(func(*expression) error) error   { return nil }
+
+type condRef struct{ expr *expression }
+
+func (n condRef) eval(scope *mappingScope, current any) (any, error) {
+	return n.expr.value(scope, current)
+}
+
+func (n condRef) walk(fn func(*expression) error) error { return n.expr.walk(fn) }
+
+type condNot struct{ operand condNode }
+
+func (n condNot) eval(scope *mappingScope, current any) (any, error) {
+	v, err := n.operand.eval(scope, current)
+	if err != nil {
+		return nil, err
+	}
+	return !truthy(v), nil
+}
+
+func (n condNot) walk(fn func(*expression) error) error { return n.operand.walk(fn) }
+
+// condLogic is && or ||, evaluated with short circuit
+type condLogic struct {
+	and         bool
+	left, right condNode
+}
+
+func (n condLogic) eval(scope *mappingScope, current any) (any, error) {
+	l, err := n.left.eval(scope, current)
+	if err != nil {
+		return nil, err
+	}
+	if truthy(l) != n.and {
+		return !n.and, nil
+	}
+	r, err := n.right.eval(scope, current)
+	if err != nil {
+		return nil, err
+	}
+	return truthy(r), nil
+}
+
+func (n condLogic) walk(fn func(*expression) error) error {
+	if err := n.left.walk(fn); err != nil {
+		return err
+	}
+	return n.right.walk(fn)
+}
+
+type condCompare struct {
+	op          string
+	left, right condNode
+}
+
+func (n condCompare) eval(scope *mappingScope, current any) (any, error) {
+	l, err := n.left.eval(scope, current)
+	if err != nil {
+		return nil, err
+	}
+	r, err := n.right.eval(scope, current)
+	if err != nil {
+		return nil, err
+	}
+	switch n.op {
+	case "==":
+		return reflect.DeepEqual(l, r), nil
+	case "!=":
+		return !reflect.DeepEqual(l, r), nil
+	case "contains":
+		return contains(l, r), nil
+	case "in":
+		return contains(r, l), nil
+	}
+	c, ok := order(l, r)
+	if !ok {
+		return false, nil
+	}
+	switch n.op {
+	case "<":
+		return c < 0, nil
+	case "<=":
+		return c <= 0, nil
+	case ">":
+		return c > 0, nil
+	default:
+		return c >= 0, nil
+	}
+}
+
+func (n condCompare) walk(fn func(*expression) error) error {
+	if err := n.left.walk(fn); err != nil {
+		return err
+	}
+	return n.right.walk(fn)
+}
+
+// truthy follows JavaScript: null, false, 0, "" and, unlike JavaScript,
+// empty lists and objects are false
+func truthy(v any) bool {
+	switch t := v.(type) {
+	case nil:
+		return false
+	case bool:
+		return t
+	case float64:
+		return t != 0
+	case string:
+		return t != ""
+	case []any:
+		return len(t) > 0
+	case map[string]any:
+		return len(t) > 0
+	}
+	return true
+}
+
+// contains reports whether a string holds a substring, a list an element or
+// an object a key
+func contains(container, item any) bool {
+	switch c := container.(type) {
+	case string:
+		s, ok := item.(string)
+		return ok && strings.Contains(c, s)
+	case []any:
+		for _, e := range c {
+			if reflect.DeepEqual(e, item) {
+				return true
+			}
+		}
+	case map[string]any:
+		s, ok := item.(string)
+		if ok {
+			_, found := c[s]
+			return found
+		}
+	}
+	return false
+}
+
+// order compares two numbers or two strings
+func order(l, r any) (int, bool) {
+	switch a := l.(type) {
+	case float64:
+		if b, ok := r.(float64); ok {
+			switch {
+			case a < b:
+				return -1, true
+			case a > b:
+				return 1, true
+			}
+			return 0, true
+		}
+	case string:
+		if b, ok := r.(string); ok {
+			return strings.Compare(a, b), true
+		}
+	}
+	return 0, false
+}
+
+// condition parses a simple condition up to the first token it cannot use
+func (p *exprParser) condition() (condNode, error) {
+	left, err := p.and()
+	if err != nil {
+		return nil, err
+	}
+	for p.operator("||") || p.word("or") {
+		right, err := p.and()
+		if err != nil {
+			return nil, err
+		}
+		left = condLogic{left: left, right: right}
+	}
+	return left, nil
+}
+
+func (p *exprParser) and() (condNode, error) {
+	left, err := p.not()
+	if err != nil {
+		return nil, err
+	}
+	for p.operator("&&") || p.word("and") {
+		right, err := p.not()
+		if err != nil {
+			return nil, err
+		}
+		left = condLogic{and: true, left: left, right: right}
+	}
+	return left, nil
+}
+
+func (p *exprParser) not() (condNode, error) {
+	p.skipSpace()
+	if (p.peek() == '!' && !strings.HasPrefix(p.src[p.pos:], "!=")) || p.word("not") {
+		if p.peek() == '!' {
+			p.pos++
+		}
+		operand, err := p.not()
+		if err != nil {
+			return nil, err
+		}
+		return condNot{operand: operand}, nil
+	}
+	return p.comparison()
+}
+
+func (p *exprParser) comparison() (condNode, error) {
+	left, err := p.operand()
+	if err != nil {
+		return nil, err
+	}
+	for _, op := range []string{"==", "!=", "<=", ">=", "<", ">"} {
+		if p.operator(op) {
+			return p.compare(op, left)
+		}
+	}
+	for _, op := range []string{"contains", "in"} {
+		if p.word(op) {
+			return p.compare(op, left)
+		}
+	}
+	return left, nil
+}
+
+func (p *exprParser) compare(op string, left condNode) (condNode, error) {
+	right, err := p.operand()
+	if err != nil {
+		return nil, err
+	}
+	return condCompare{op: op, left: left, right: right}, nil
+}
+
+// operand parses a reference, a literal or a parenthesized condition
+func (p *exprParser) operand() (condNode, error) {
+	p.skipSpace()
+	switch c := p.peek(); {
+	case c == '(':
+		p.pos++
+		node, err := p.condition()
+		if err != nil {
+			return nil, err
+		}
+		p.skipSpace()
+		if !p.consume(')') {
+			return nil, fmt.Errorf("expected ) at offset %d", p.pos)
+		}
+		return node, nil
+	case c == '$' || c == '@':
+		expr, err := p.reference()
+		if err != nil {
+			return nil, err
+		}
+		return condRef{expr: expr}, nil
+	case c == '"' || c == '\'':
+		s, err := p.quoted()
+		if err != nil {
+			return nil, err
+		}
+		return condLiteral{value: s}, nil
+	case c == '-' || c == '.' || c >= '0' && c <= '9':
+		return p.number()
+	}
+	start := p.pos
+	switch word := p.ident(); word {
+	case "true":
+		return condLiteral{value: true}, nil
+	case "false":
+		return condLiteral{value: false}, nil
+	case "null":
+		return condLiteral{value: nil}, nil
+	case "":
+		if p.pos == len(p.src) {
+			return nil, fmt.Errorf("unexpected end of condition")
+		}
+		return nil, fmt.Errorf("unexpected %q at offset %d", p.src[p.pos:], p.pos)
+	default:
+		return nil, fmt.Errorf("unknown name %q at offset %d", word, start)
+	}
+}
+
+func (p *exprParser) number() (condNode, error) {
+	start := p.pos
+	p.consume('-')
+	for p.pos < len(p.src) && strings.IndexByte("0123456789.eE", p.src[p.pos]) >= 0 {
+		// 指数的符号
+		if (p.src[p.pos] == 'e' || p.src[p.pos] == 'E') && p.pos+1 < len(p.src) && (p.src[p.pos+1] == '-' || p.src[p.pos+1] == '+') {
+			p.pos++
+		}
+		p.pos++
+	}
+	f, err := strconv.ParseFloat(p.src[start:p.pos], 64)
+	if err != nil {
+		return nil, fmt.Errorf("invalid number %q", p.src[start:p.pos])
+	}
+	return condLiteral{value: f}, nil
+}
+
+// operator consumes op after optional spaces
+func (p *exprParser) operator(op string) bool {
+	save := p.pos
+	p.skipSpace()
+	if strings.HasPrefix(p.src[p.pos:], op) {
+		p.pos += len(op)
+		return true
+	}
+	p.pos = save
+	return false
+}
+
+// word consumes a keyword that is not the start of a longer name
+func (p *exprParser) word(w string) bool {
+	save := p.pos
+	p.skipSpace()
+	if strings.HasPrefix(p.src[p.pos:], w) {
+		p.pos += len(w)
+		if end := p.pos; p.ident() == "" {
+			p.pos = end
+			return true
+		}
+	}
+	p.pos = save
+	return false
+}
+
+// condition is a compiled step condition
+type condition interface {
+	test(ctx context.Context, scope *mappingScope) (bool, error)
+}
+
+// exprCondition is a simple or jsonpath condition
+type exprCondition struct{ node condNode }
+
+func (c exprCondition) test(_ context.Context, scope *mappingScope) (bool, error) {
+	v, err := c.node.eval(scope, nil)
+	if err != nil {
+		return false, err
+	}
+	return truthy(v), nil
+}
+
+// scriptCondition is a javascript condition compiled once per definition.
+// Each test runs in a fresh runtime without host bindings.
+type scriptCondition struct{ program *goja.Program }
+
+func (c scriptCondition) test(ctx context.Context, scope *mappingScope) (bool, error) {
+	vm := goja.New()
+	vm.SetMaxCallStackSize(scriptMaxCallStack)
+	globals, err := scriptGlobals(scope)
+	if err != nil {
+		return false, err
+	}
+	parse, _ := goja.AssertFunction(vm.Get("JSON").ToObject(vm).Get("parse"))
+	for name, raw := range globals {
+		v, err := parse(goja.Undefined(), vm.ToValue(string(raw)))
+		if err != nil {
+			return false, err
+		}
+		if err := vm.Set(name, v); err != nil {
+			return false, err
+		}
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, scriptTimeout)
+	defer cancel()
+	done := make(chan struct{})
+	defer close(done)
+	go watchScript(ctx, vm, done)
+
+	v, err := vm.RunProgram(c.program)
+	if err != nil {
+		if ie, ok := err.(*goja.InterruptedError); ok {
+			return false, fmt.Errorf("script stopped: %v", ie.Value())
+		}
+		if _, ok := err.(*goja.StackOverflowError); ok {
+			return false, fmt.Errorf("script exceeded %d nested calls", scriptMaxCallStack)
+		}
+		return false, fmt.Errorf("script failed: %w", err)
+	}
+	return v.ToBoolean(), nil
+}
+
+// watchScript interrupts the runtime when ctx ends before the script does,
+// or when the heap grows by more than scriptMemoryLimit while it runs. goja
+// does not account for a single runtime's memory, so the growth of the
+// process heap stands in for it. Growth past the limit is confirmed by a
+// collection first, so garbage does not stop a script, unless it reaches
+// scriptMemoryLimit*scriptMemoryHardFactor before the collection ends.
+func watchScript(ctx context.Context, vm *goja.Runtime, done <-chan struct{}) {
+	base := heapObjects()
+	ticker := time.NewTicker(scriptWatchInterval)
+	defer ticker.Stop()
+	overLimit := func() {
+		vm.Interrupt(fmt.Sprintf("used more than %d MiB of memory", scriptMemoryLimit>>20))
+	}
+	// 回收进行中时非空，回收期间脚本继续运行
+	var collected chan struct{}
+	for {
+		select {
+		case <-done:
+			return
+		case <-ctx.Done():
+			if errors.Is(ctx.Err(), context.DeadlineExceeded) {
+				vm.Interrupt(fmt.Sprintf("timed out after %s", scriptTimeout))
+			} else {
+				vm.Interrupt(ctx.Err().Error())
+			}
+			return
+		case <-ticker.C:
+			growth := heapObjects() - base
+			switch {
+			case growth > scriptMemoryLimit*scriptMemoryHardFactor:
+				overLimit()
+				return
+			case growth > scriptMemoryLimit && collected == nil:
+				collected = make(chan struct{})
+				go func(ch chan struct{}) {
+					runtime.GC()
+					close(ch)
+				}(collected)
+			}
+		case <-collected:
+			if heapObjects()-base > scriptMemoryLimit {
+				overLimit()
+				return
+			}
+			collected = nil
+		}
+	}
+}
+
+// heapObjects reads the bytes of heap objects not yet freed
+func heapObjects() int64 {
+	sample := []metrics.Sample{{Name: "/memory/classes/heap/objects:bytes"}}
+	metrics.Read(sample)
+	return int64(sample[0].Value.Uint64())
+}
+
+// scriptGlobals renders the scope as the JSON of the script's globals
+func scriptGlobals(scope *mappingScope) (map[string][]byte, error) {
+	fields := func(m map[string]*anypb.Any) (map[string]any, error) {
+		tree := make(map[string]any, len(m))
+		for k, v := range m {
+			t, err := anyToTree(v)
+			if err != nil {
+				return nil, err
+			}
+			tree[k] = t
+		}
+		return tree, nil
+	}
+	input, err := fields(scope.input)
+	if err != nil {
+		return nil, err
+	}
+	output, err := fields(scope.output)
+	if err != nil {
+		return nil, err
+	}
+	steps := make(map[string]any, len(scope.steps))
+	for id, out := range scope.steps {
+		tree, err := fields(out)
+		if err != nil {
+			return nil, err
+		}
+		steps[id] = map[string]any{"output": tree}
+	}
+	execution := make(map[string]any, len(scope.context))
+	for k, v := range scope.context {
+		execution[k] = v
+	}
+
+	globals := make(map[string][]byte, 4)
+	for name, v := range map[string]any{"input": input, "steps": steps, "context": execution, "output": output} {
+		raw, err := json.Marshal(v)
+		if err != nil {
+			return nil, err
+		}
+		globals[name] = raw
+	}
+	return globals, nil
+}
+
+// compileCondition compiles a condition of the given type
+func compileCondition(conditionType, src string) (condition, []*expression, error) {
+	switch conditionType {
+	case "", ConditionSimple:
+		p := &exprParser{src: src}
+		node, err := p.condition()
+		if err != nil {
+			return nil, nil, err
+		}
+		p.skipSpace()
+		if p.pos < len(p.src) {
+			return nil, nil, fmt.Errorf("unexpected %q at offset %d", p.src[p.pos:], p.pos)
+		}
+		var refs []*expression
+		_ = node.walk(func(e *expression) error {
+			refs = append(refs, e)
+			return nil
+		})
+		return exprCondition{node: node}, refs, nil
+	case ConditionJSONPath:
+		expr, err := compileExpression(src)
+		if err != nil {
+			return nil, nil, err
+		}
+		return exprCondition{node: condRef{expr: expr}}, []*expression{expr}, nil
+	case ConditionJavaScript:
+		if len(src) > maxScriptLength {
+			return nil, nil, fmt.Errorf("script is longer than %d bytes", maxScriptLength)
+		}
+		program, err := goja.Compile("condition", src, true)
+		if err != nil {
+			return nil, nil, err
+		}
+		return scriptCondition{program: program}, nil, nil
+	}
+	return nil, nil, fmt.Errorf("unknown condition type %q", conditionType)
+}
+
+// branch is the compiled conditional of a step: once the step succeeds its
+// condition picks which of the listed downstream steps run
+type branch struct {
+	cond    condition
+	ifTrue  []int
+	ifFalse []int
+}
+
+// compileBranches compiles the conditionals of every step. Branch targets
+// must depend on the step, directly or not, and appear in one list only.
+// Conditions may read the step's own output like output mappings do.
+func compileBranches(g *workflowGraph) ([]*branch, error) {
+	branches := make([]*branch, len(g.steps))
+	for i, step := range g.steps {
+		c := step.GetConditional()
+		if c == nil {
+			continue
+		}
+		if strings.TrimSpace(c.Condition) == "" {
+			return nil, invalidWorkflow("step %q has a conditional without a condition", step.StepId)
+		}
+		cond, refs, err := compileCondition(c.ConditionType, c.Condition)
+		if err != nil {
+			return nil, invalidWorkflow("step %q condition: %v", step.StepId, err)
+		}
+		for _, expr := range refs {
+			if err := checkReference(expr, step.StepId, g.ancestors[i], true); err != nil {
+				return nil, invalidWorkflow("step %q condition: %v", step.StepId, err)
+			}
+		}
+		b := &branch{cond: cond}
+		if b.ifTrue, err = branchTargets(g, step, c.ExecuteIfTrue); err != nil {
+			return nil, err
+		}
+		if b.ifFalse, err = branchTargets(g, step, c.ExecuteIfFalse); err != nil {
+			return nil, err
+		}
+		for _, id := range c.ExecuteIfTrue {
+			if containsString(c.ExecuteIfFalse, id) {
+				return nil, invalidWorkflow("step %q lists %q in both branches", step.StepId, id)
+			}
+		}
+		branches[i] = b
+	}
+	return branches, nil
+}
+
+func branchTargets(g *workflowGraph, step *v1.WorkflowStep, ids []string) ([]int, error) {
+	targets := make([]int, 0, len(ids))
+	for _, id := range ids {
+		j, ok := g.index[id]
+		if !ok {
+			return nil, invalidWorkflow("step %q branches to unknown step %q", step.StepId, id)
+		}
+		if !g.ancestors[j][step.StepId] {
+			return nil, invalidWorkflow("step %q branches to %q, which does not depend on it", step.StepId, id)
+		}
+		targets = append(targets, j)
+	}
+	return targets, nil
+}
@@ -0,0 +1,204 @@
+package biz
+
+import (
+	"context"
+	"strings"
+	"sync"
+	"testing"
+
+	commonv1 "rag/api/common/v1"
+	v1 "rag/api/orchestrator/v1"
+
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+func testCondition(t *testing.T, conditionType, src string) bool {
+	t.Helper()
+	cond, _, err := compileCondition(conditionType, src)
+	if err != nil {
+		t.Fatalf("%s: %v", src, err)
+	}
+	ok, err := cond.test(context.Background(), testScope(t))
+	if err != nil {
+		t.Fatalf("%s: %v", src, err)
+	}
+	return ok
+}
+
+func TestSimpleConditions(t *testing.T) {
+	tests := []struct {
+		src  string
+		want bool
+	}{
+		{"$.steps.retrieve.output.results | length > 0", true},
+		{"$.steps.retrieve.output.results | length >= 4", false},
+		{`$.context.tenant == "acme" && $.input.limit < 5`, true},
+		{`$.context.tenant != "acme" || not $.context.premium`, true},
+		{"!$.input.missing", true},
+		{`"c2" in $.steps.retrieve.output.results[*].id`, true},
+		{`$.input.query contains "Channels"`, true},
+		{`$.steps.retrieve.output.results[0].score >= 0.5 and $.steps.retrieve.output.results[1].score >= 0.5`, false},
+		{`($.input.limit > 5 or $.input.limit == 3) && true`, true},
+		// 不同类型之间的大小比较为假
+		{`$.input.query > 1`, false},
+		{`$.input.query <= 1`, false},
+	}
+	for _, tt := range tests {
+		if got := testCondition(t, ConditionSimple, tt.src); got != tt.want {
+			t.Errorf("%s = %t, want %t", tt.src, got, tt.want)
+		}
+	}
+}
+
+func TestJSONPathConditions(t *testing.T) {
+	if !testCondition(t, ConditionJSONPath, "$.steps.retrieve.output.results[?(@.score >= 0.5)]") {
+		t.Error("filter selecting results is false")
+	}
+	if testCondition(t, ConditionJSONPath, "$.steps.retrieve.output.results[?(@.score > 0.95)]") {
+		t.Error("filter selecting nothing is true")
+	}
+}
+
+func TestScriptConditions(t *testing.T) {
+	tests := []struct {
+		src  string
+		want bool
+	}{
+		{`steps.retrieve.output.results.filter(r => r.score > 0.5).length === 2`, true},
+		{`input.query.trim().toLowerCase() === "go channels" && context.tenant === "acme"`, true},
+		{`typeof require === "undefined" && typeof console === "undefined"`, true},
+		{`0`, false},
+	}
+	for _, tt := range tests {
+		if got := testCondition(t, ConditionJavaScript, tt.src); got != tt.want {
+			t.Errorf("%s = %t, want %t", tt.src, got, tt.want)
+		}
+	}
+}
+
+func TestScriptConditionLimits(t *testing.T) {
+	tests := map[string]string{
+		"while (true) {}":                      "timed out",
+		"function f(n) { return f(n+1) } f(0)": "nested calls",
+		"null.x":                               "script failed",
+		// 不断翻倍并保留字符串，超过内存上限后中断
+		`var s = "xxxxxxxx"; var k = []; while (true) { s = s + s; k.push(s) }`: "memory",
+	}
+	for src, want := range tests {
+		cond, _, err := compileCondition(ConditionJavaScript, src)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if _, err := cond.test(context.Background(), testScope(t)); err == nil || !strings.Contains(err.Error(), want) {
+			t.Errorf("%s: err = %v, want %q", src, err, want)
+		}
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	cond, _, _ := compileCondition(ConditionJavaScript, "while (true) {}")
+	if _, err := cond.test(ctx, testScope(t)); err == nil || !strings.Contains(err.Error(), "canceled") {
+		t.Errorf("cancelled script: err = %v", err)
+	}
+}
+
+func TestScriptConditionIgnoresOtherAllocations(t *testing.T) {
+	cond, _, err := compileCondition(ConditionJavaScript, "var n = 0; for (var i = 0; i < 1e5; i++) { n += i } n > 0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	// 其他 goroutine 的大量分配不应让脚本失败
+	var wg sync.WaitGroup
+	stop := make(chan struct{})
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		var sink [][]byte
+		for {
+			select {
+			case <-stop:
+				return
+			default:
+				sink = append(sink, make([]byte, 1<<20))
+				if len(sink) > 16 {
+					sink = sink[:0]
+				}
+			}
+		}
+	}()
+	defer func() {
+		close(stop)
+		wg.Wait()
+	}()
+	if ok, err := cond.test(context.Background(), testScope(t)); err != nil || !ok {
+		t.Errorf("test = %t, %v", ok, err)
+	}
+}
+
+func TestConditionCompileErrors(t *testing.T) {
+	tests := []struct{ conditionType, src string }{
+		{"python", "True"},
+		{ConditionSimple, "$.input.limit >"},
+		{ConditionSimple, "$.input.limit > 1 1"},
+		{ConditionSimple, "$.input.limit === 1"},
+		{ConditionJSONPath, "$.input.limit > 1"},
+		{ConditionJavaScript, "if ("},
+		{ConditionJavaScript, strings.Repeat(" ", maxScriptLength) + "true"},
+	}
+	for _, tt := range tests {
+		if _, _, err := compileCondition(tt.conditionType, tt.src); err == nil {
+			t.Errorf("%s %.40q compiled", tt.conditionType, tt.src)
+		}
+	}
+}
+
+func TestConditionalBranches(t *testing.T) {
+	for _, tt := range []struct {
+		condition    string
+		ran, skipped string
+		answer       string
+	}{
+		{`$.output.out == "a(q)"`, "b", "c", "d(b(a(q)))"},
+		{`$.output.out == "other"`, "c", "b", ""},
+	} {
+		def := diamond(t, StrategyParallel)
+		def.Steps[0].Conditional = &v1.ConditionalExecution{
+			Condition:      tt.condition,
+			ExecuteIfTrue:  []string{"b"},
+			ExecuteIfFalse: []string{"c"},
+		}
+		services := diamondServices(t)
+		exec, err := newTestEngine(services).Execute(context.Background(), "", def,
+			map[string]*anypb.Any{"query": packString(t, "q")}, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		statuses := stepStatuses(exec)
+		if statuses[tt.ran] != commonv1.ProcessingStatus_PROCESSING_STATUS_COMPLETED ||
+			statuses[tt.skipped] != commonv1.ProcessingStatus_PROCESSING_STATUS_SKIPPED {
+			t.Errorf("%s: statuses = %v", tt.condition, statuses)
+		}
+		if services.callsOf("svc."+tt.skipped) != 0 {
+			t.Errorf("%s: skipped step %s was called", tt.condition, tt.skipped)
+		}
+		if tt.answer != "" {
+			if got := unpackString(t, exec.Outputs["answer"]); got != tt.answer {
+				t.Errorf("%s: answer = %s, want %s", tt.condition, got, tt.answer)
+			}
+		}
+	}
+
+	engine := newTestEngine(diamondServices(t))
+	for want, c := range map[string]*v1.ConditionalExecution{
+		"does not depend on it": {Condition: "true", ExecuteIfTrue: []string{"c"}},
+		"in both branches":      {Condition: "true", ExecuteIfTrue: []string{"d"}, ExecuteIfFalse: []string{"d"}},
+		"unknown step":          {Condition: "true", ExecuteIfTrue: []string{"x"}},
+		"upstream dependency":   {Condition: `$.steps.c.output.out == "x"`, ExecuteIfTrue: []string{"d"}},
+	} {
+		def := diamond(t, StrategyParallel)
+		def.Steps[1].Conditional = c
+		if err := engine.Validate(def); err == nil || !strings.Contains(err.Error(), want) {
+			t.Errorf("Validate = %v, want %q", err, want)
+		}
+	}
+}
@@ -0,0 +1,524 @@
+package biz
+
+import (
+	"context"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	commonv1 "rag/api/common/v1"
+	v1 "rag/api/orchestrator/v1"
+
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/golang/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+var (
+	// ErrDefinitionRequired is returned when a request carries no definition.
+	ErrDefinitionRequired = errors.BadRequest(commonv1.ErrorCode_ERROR_CODE_BAD_REQUEST.String(), "definition is required")
+	// ErrInvalidDefinitionName is returned for a definition name that cannot serve as its id.
+	ErrInvalidDefinitionName = errors.BadRequest(commonv1.ErrorCode_ERROR_CODE_BAD_REQUEST.String(), "definition name must be 1-128 letters, digits, '.', '_', ':' or '-'")
+	// ErrDefinitionRenamed is returned when an update names another definition.
+	ErrDefinitionRenamed = errors.BadRequest(commonv1.ErrorCode_ERROR_CODE_BAD_REQUEST.String(), "definition name does not match definition_id")
+	// ErrInvalidVersion is returned for a version that is not a semantic version.
+	ErrInvalidVersion = errors.BadRequest(commonv1.ErrorCode_ERROR_CODE_BAD_REQUEST.String(), "version must be a semantic version such as 1.2.0")
+	// ErrVersionNotFound is returned when a definition has no such version.
+	ErrVersionNotFound = errors.NotFound(commonv1.ErrorCode_ERROR_CODE_NOT_FOUND.String(), "workflow definition version not found")
+	// ErrVersionExists is returned when publishing a version that already exists.
+	ErrVersionExists = errors.Conflict(commonv1.ErrorCode_ERROR_CODE_CONFLICT.String(), "workflow definition version already exists")
+	// ErrVersionImmutable is returned when changing the content of a published version.
+	ErrVersionImmutable = errors.Conflict(commonv1.ErrorCode_ERROR_CODE_CONFLICT.String(), "published versions cannot change, set create_new_version to publish the change")
+)
+
+// DefinitionRecord is a stored workflow definition. The name of the
+// workflow is its id; each change is published as a new version, and one of
+// the versions is the default that runs when no version is asked for.
+type DefinitionRecord struct {
+	ID             string
+	DefaultVersion string
+	// 按版本号从低到高排列
+	Versions       []DefinitionVersion
+	Tags           []string
+	CustomMetadata map[string]string
+	CreatedAt      time.Time
+	UpdatedAt      time.Time
+}
+
+// DefinitionVersion describes a published version of a definition.
+type DefinitionVersion struct {
+	Version     string
+	Description string
+	CreatedBy   string
+	CreatedAt   time.Time
+}
+
+// version returns the entry of version v
+func (r *DefinitionRecord) version(v string) (*DefinitionVersion, bool) {
+	for i := range r.Versions {
+		if r.Versions[i].Version == v {
+			return &r.Versions[i], true
+		}
+	}
+	return nil, false
+}
+
+// DefinitionRepo stores workflow definitions and their versions. A version's
+// content never changes once published.
+type DefinitionRepo interface {
+	// GetDefinition returns a definition, or ErrWorkflowNotFound
+	GetDefinition(ctx context.Context, id string) (*DefinitionRecord, error)
+	// GetVersion returns the content of a version, or ErrVersionNotFound
+	GetVersion(ctx context.Context, id, version string) (*v1.WorkflowDefinition, error)
+	// ListDefinitions returns every definition
+	ListDefinitions(ctx context.Context) ([]*DefinitionRecord, error)
+	// PublishVersion stores def as a new version and saves rec, which
+	// already lists it
+	PublishVersion(ctx context.Context, rec *DefinitionRecord, def *v1.WorkflowDefinition) error
+	// SaveDefinition saves the default version, tags and metadata of rec
+	SaveDefinition(ctx context.Context, rec *DefinitionRecord) error
+}
+
+// resolveDefinition looks up the definition a reference names: id for the
+// default version, id@version for a pinned one.
+func resolveDefinition(ctx context.Context, repo DefinitionRepo, ref string) (string, *v1.WorkflowDefinition, error) {
+	id, version, pinned := strings.Cut(ref, "@")
+	if !pinned {
+		rec, err := repo.GetDefinition(ctx, id)
+		if err != nil {
+			return "", nil, err
+		}
+		version = rec.DefaultVersion
+	}
+	def, err := repo.GetVersion(ctx, id, version)
+	if err != nil {
+		return "", nil, err
+	}
+	return id, def, nil
+}
+
+// DefinitionUsecase manages the stored workflow definitions.
+type DefinitionUsecase struct {
+	engine      *WorkflowEngine
+	definitions DefinitionRepo
+	executions  ExecutionRepo
+	log         *log.Helper
+
+	// 串行化定义的读改写
+	mu sync.Mutex
+}
+
+// NewDefinitionUsecase creates a definition usecase
+func NewDefinitionUsecase(engine *WorkflowEngine, definitions DefinitionRepo, executions ExecutionRepo, logger log.Logger) *DefinitionUsecase {
+	return &DefinitionUsecase{
+		engine:      engine,
+		definitions: definitions,
+		executions:  executions,
+		log:         log.NewHelper(logger),
+	}
+}
+
+// CreateDefinition publishes a definition. A new name starts a definition
+// whose first version is the default; an existing name gets a new version,
+// which becomes the default only when asked to. Without a version the first
+// one is 1.0.0 and later ones follow the latest: a pre-release is released,
+// a release gets its next minor version.
+func (uc *DefinitionUsecase) CreateDefinition(ctx context.Context, req *v1.CreateWorkflowDefinitionRequest) (*v1.CreateWorkflowDefinitionResponse, error) {
+	def := req.GetDefinition()
+	if def == nil {
+		return nil, ErrDefinitionRequired
+	}
+	if def.Name == "" || !validID(def.Name) {
+		return nil, ErrInvalidDefinitionName
+	}
+
+	uc.mu.Lock()
+	defer uc.mu.Unlock()
+	now := time.Now()
+	rec, err := uc.definitions.GetDefinition(ctx, def.Name)
+	if errors.IsNotFound(err) {
+		rec, err = &DefinitionRecord{ID: def.Name, CreatedAt: now}, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	setLabels(rec, req.Tags, req.CustomMetadata)
+	published, err := uc.publish(ctx, rec, def, req.CreatedBy, req.MakeDefault || rec.DefaultVersion == "", now)
+	if err != nil {
+		return nil, err
+	}
+	uc.log.WithContext(ctx).Infof("Published workflow %s version %s", rec.ID, published.Version)
+	return &v1.CreateWorkflowDefinitionResponse{
+		DefinitionId:      rec.ID,
+		CreatedDefinition: published,
+		Version:           published.Version,
+		CreatedAt:         timestamppb.New(now),
+	}, nil
+}
+
+// UpdateDefinition changes a definition. With create_new_version the
+// definition is published as a new version; otherwise the version it names,
+// or the default one, is left as published and only the default pointer,
+// tags and custom metadata change.
+func (uc *DefinitionUsecase) UpdateDefinition(ctx context.Context, req *v1.UpdateWorkflowDefinitionRequest) (*v1.UpdateWorkflowDefinitionResponse, error) {
+	def := req.GetDefinition()
+	if def.GetName() != "" && def.GetName() != req.DefinitionId {
+		return nil, ErrDefinitionRenamed
+	}
+
+	uc.mu.Lock()
+	defer uc.mu.Unlock()
+	now := time.Now()
+	rec, err := uc.definitions.GetDefinition(ctx, req.DefinitionId)
+	if err != nil {
+		return nil, err
+	}
+	setLabels(rec, req.Tags, req.CustomMetadata)
+
+	var updated *v1.WorkflowDefinition
+	if req.CreateNewVersion {
+		if def == nil {
+			return nil, ErrDefinitionRequired
+		}
+		if updated, err = uc.publish(ctx, rec, def, "", req.MakeDefault, now); err != nil {
+			return nil, err
+		}
+		uc.log.WithContext(ctx).Infof("Published workflow %s version %s", rec.ID, updated.Version)
+	} else {
+		version := def.GetVersion()
+		if version == "" {
+			version = rec.DefaultVersion
+		}
+		if _, ok := rec.version(version); !ok {
+			return nil, versionNotFound(rec.ID, version)
+		}
+		if updated, err = uc.definitions.GetVersion(ctx, rec.ID, version); err != nil {
+			return nil, err
+		}
+		if hasContent(def) {
+			changed := proto.Clone(def).(*v1.WorkflowDefinition)
+			changed.Name, changed.Version = updated.Name, updated.Version
+			if !proto.Equal(changed, updated) {
+				return nil, ErrVersionImmutable.WithMetadata(map[string]string{"version": version})
+			}
+		}
+		if req.MakeDefault {
+			rec.DefaultVersion = version
+		}
+		rec.UpdatedAt = now
+		if err := uc.definitions.SaveDefinition(ctx, rec); err != nil {
+			return nil, err
+		}
+		uc.log.WithContext(ctx).Infof("Updated workflow %s, default version %s", rec.ID, rec.DefaultVersion)
+	}
+	return &v1.UpdateWorkflowDefinitionResponse{
+		DefinitionId:      rec.ID,
+		UpdatedDefinition: updated,
+		Version:           updated.Version,
+		UpdatedAt:         timestamppb.New(now),
+	}, nil
+}
+
+// publish validates def and stores it as a new version of rec
+func (uc *DefinitionUsecase) publish(ctx context.Context, rec *DefinitionRecord, def *v1.WorkflowDefinition, createdBy string, makeDefault bool, now time.Time) (*v1.WorkflowDefinition, error) {
+	def = proto.Clone(def).(*v1.WorkflowDefinition)
+	def.Name = rec.ID
+	if def.Version == "" {
+		def.Version = initialVersion
+		if n := len(rec.Versions); n > 0 {
+			latest, _ := parseSemver(rec.Versions[n-1].Version)
+			def.Version = latest.next()
+		}
+	}
+	v, ok := parseSemver(def.Version)
+	if !ok {
+		return nil, ErrInvalidVersion.WithMetadata(map[string]string{"version": def.Version})
+	}
+	for _, existing := range rec.Versions {
+		// 仅构建元数据不同的版本视为同一版本
+		if old, _ := parseSemver(existing.Version); old.compare(v) == 0 {
+			return nil, ErrVersionExists.WithMetadata(map[string]string{"version": existing.Version})
+		}
+	}
+	if err := uc.engine.Validate(def); err != nil {
+		return nil, err
+	}
+
+	rec.Versions = append(rec.Versions, DefinitionVersion{
+		Version:     def.Version,
+		Description: def.Description,
+		CreatedBy:   createdBy,
+		CreatedAt:   now,
+	})
+	sort.SliceStable(rec.Versions, func(i, j int) bool {
+		return compareVersions(rec.Versions[i].Version, rec.Versions[j].Version) < 0
+	})
+	if makeDefault {
+		rec.DefaultVersion = def.Version
+	}
+	rec.UpdatedAt = now
+	if err := uc.definitions.PublishVersion(ctx, rec, def); err != nil {
+		return nil, err
+	}
+	return def, nil
+}
+
+// GetDefinition returns a version of a definition, the default one unless
+// the request names another, with its metadata
+func (uc *DefinitionUsecase) GetDefinition(ctx context.Context, req *v1.GetWorkflowDefinitionRequest) (*v1.GetWorkflowDefinitionResponse, error) {
+	rec, err := uc.definitions.GetDefinition(ctx, req.DefinitionId)
+	if err != nil {
+		return nil, err
+	}
+	version := req.Version
+	if version == "" {
+		version = rec.DefaultVersion
+	}
+	v, ok := rec.version(version)
+	if !ok {
+		return nil, versionNotFound(rec.ID, version)
+	}
+	def, err := uc.definitions.GetVersion(ctx, rec.ID, version)
+	if err != nil {
+		return nil, err
+	}
+	stats, err := uc.executions.ExecutionStats(ctx, rec.ID)
+	if err != nil {
+		return nil, err
+	}
+	return &v1.GetWorkflowDefinitionResponse{
+		Definition: def,
+		Metadata:   definitionMetadata(rec, v, stats[version]),
+	}, nil
+}
+
+// ListDefinitions lists every published version, newest first within each
+// definition. Filters apply to definition_id, name, version, description,
+// created_by, tags, is_default and is_active.
+func (uc *DefinitionUsecase) ListDefinitions(ctx context.Context, req *v1.ListWorkflowDefinitionsRequest) (*v1.ListWorkflowDefinitionsResponse, error) {
+	recs, err := uc.definitions.ListDefinitions(ctx)
+	if err != nil {
+		uc.log.WithContext(ctx).Errorf("Failed to list workflow definitions: %v", err)
+		return nil, err
+	}
+	type listed struct {
+		info      *v1.WorkflowDefinitionInfo
+		createdAt time.Time
+	}
+	var versions []listed
+	for _, rec := range recs {
+		var stats map[string]ExecutionStats
+		if req.IncludeMetadata {
+			if stats, err = uc.executions.ExecutionStats(ctx, rec.ID); err != nil {
+				return nil, err
+			}
+		}
+		for i := len(rec.Versions) - 1; i >= 0; i-- {
+			v := &rec.Versions[i]
+			info := &v1.WorkflowDefinitionInfo{
+				DefinitionId: rec.ID,
+				Name:         rec.ID,
+				Description:  v.Description,
+				Version:      v.Version,
+				IsDefault:    v.Version == rec.DefaultVersion,
+				IsActive:     true,
+			}
+			if !matchDefinitionFilters(info, rec, v, req.Filters) {
+				continue
+			}
+			if req.IncludeMetadata {
+				info.Metadata = definitionMetadata(rec, v, stats[v.Version])
+			}
+			versions = append(versions, listed{info: info, createdAt: v.CreatedAt})
+		}
+	}
+
+	// 排序，默认按名称
+	pagination := req.GetPagination()
+	sortBy, desc := pagination.GetSortBy(), pagination.GetSortDesc()
+	sort.SliceStable(versions, func(i, j int) bool {
+		a, b := versions[i], versions[j]
+		if desc {
+			a, b = b, a
+		}
+		switch sortBy {
+		case "created_at":
+			if !a.createdAt.Equal(b.createdAt) {
+				return a.createdAt.Before(b.createdAt)
+			}
+		case "version":
+			if c := compareVersions(a.info.Version, b.info.Version); c != 0 {
+				return c < 0
+			}
+		}
+		return a.info.Name < b.info.Name
+	})
+
+	// 应用分页
+	page := int32(1)
+	pageSize := int32(10)
+	if pagination != nil {
+		if pagination.Page > 0 {
+			page = pagination.Page
+		}
+		if pagination.PageSize > 0 {
+			pageSize = pagination.PageSize
+		}
+	}
+	total := int64(len(versions))
+	// 先转为 int64 再相乘，过大的页码不会溢出为负数
+	start := max(0, min((int64(page)-1)*int64(pageSize), total))
+	end := min(start+int64(pageSize), total)
+	infos := make([]*v1.WorkflowDefinitionInfo, 0, end-start)
+	for _, l := range versions[start:end] {
+		infos = append(infos, l.info)
+	}
+	return &v1.ListWorkflowDefinitionsResponse{
+		Definitions: infos,
+		Pagination: &commonv1.PaginationResponse{
+			Page:       page,
+			PageSize:   pageSize,
+			Total:      total,
+			TotalPages: int32((total + int64(pageSize) - 1) / int64(pageSize)),
+		},
+	}, nil
+}
+
+// matchDefinitionFilters matches a version against every filter; unknown
+// fields pass. The comparison operators order versions by precedence and
+// other fields as strings.
+func matchDefinitionFilters(info *v1.WorkflowDefinitionInfo, rec *DefinitionRecord, v *DefinitionVersion, filters []*commonv1.Filter) bool {
+	for _, filter := range filters {
+		var values []string
+		switch filter.Field {
+		case "definition_id", "name":
+			values = []string{rec.ID}
+		case "version":
+			values = []string{v.Version}
+		case "description":
+			values = []string{v.Description}
+		case "created_by":
+			values = []string{v.CreatedBy}
+		case "tags":
+			values = rec.Tags
+		case "is_default":
+			values = []string{boolString(info.IsDefault)}
+		case "is_active":
+			values = []string{boolString(info.IsActive)}
+		default:
+			continue
+		}
+		if len(filter.Values) > 0 && !matchFilter(filter, values, filter.Field == "version") {
+			return false
+		}
+	}
+	return true
+}
+
+// matchFilter reports whether any of values satisfies the filter; ne
+// requires that none equals a filter value
+func matchFilter(filter *commonv1.Filter, values []string, version bool) bool {
+	compare := strings.Compare
+	if version {
+		compare = func(a, b string) int {
+			va, okA := parseSemver(a)
+			vb, okB := parseSemver(b)
+			if !okA || !okB {
+				return strings.Compare(a, b)
+			}
+			return va.compare(vb)
+		}
+	}
+	if filter.Operator == "ne" {
+		for _, value := range values {
+			for _, want := range filter.Values {
+				if value == want {
+					return false
+				}
+			}
+		}
+		return true
+	}
+	for _, value := range values {
+		for _, want := range filter.Values {
+			var ok bool
+			switch filter.Operator {
+			case "eq", "in":
+				ok = value == want
+			case "like":
+				ok = strings.Contains(strings.ToLower(value), strings.ToLower(strings.Trim(want, "%")))
+			case "gt":
+				ok = compare(value, want) > 0
+			case "gte":
+				ok = compare(value, want) >= 0
+			case "lt":
+				ok = compare(value, want) < 0
+			case "lte":
+				ok = compare(value, want) <= 0
+			default:
+				return true
+			}
+			if ok {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func boolString(b bool) string {
+	if b {
+		return "true"
+	}
+	return "false"
+}
+
+// definitionMetadata describes a version with the statistics of its
+// finished executions
+func definitionMetadata(rec *DefinitionRecord, v *DefinitionVersion, stats ExecutionStats) *v1.WorkflowDefinitionMetadata {
+	m := &v1.WorkflowDefinitionMetadata{
+		CreatedBy:      v.CreatedBy,
+		CreatedAt:      timestamppb.New(v.CreatedAt),
+		UpdatedAt:      timestamppb.New(rec.UpdatedAt),
+		ExecutionCount: int32(stats.Executions),
+		Tags:           rec.Tags,
+		CustomMetadata: rec.CustomMetadata,
+	}
+	if stats.Executions > 0 {
+		m.SuccessRate = float32(stats.Succeeded) / float32(stats.Executions)
+		m.AvgExecutionTimeMs = float32(stats.TotalTime.Milliseconds()) / float32(stats.Executions)
+	}
+	return m
+}
+
+// setLabels replaces the tags and custom metadata of rec with those given
+func setLabels(rec *DefinitionRecord, tags []string, custom map[string]string) {
+	if len(tags) > 0 {
+		seen := make(map[string]bool, len(tags))
+		rec.Tags = rec.Tags[:0:0]
+		for _, tag := range tags {
+			if tag = strings.TrimSpace(tag); tag != "" && !seen[tag] {
+				seen[tag] = true
+				rec.Tags = append(rec.Tags, tag)
+			}
+		}
+		sort.Strings(rec.Tags)
+	}
+	if len(custom) > 0 {
+		rec.CustomMetadata = make(map[string]string, len(custom))
+		for k, v := range custom {
+			rec.CustomMetadata[k] = v
+		}
+	}
+}
+
+// hasContent reports whether def carries more than a name and version
+func hasContent(def *v1.WorkflowDefinition) bool {
+	return def.GetDescription() != "" || len(def.GetSteps()) > 0 || def.GetConfiguration() != nil || len(def.GetDefaultParameters()) > 0
+}
+
+func versionNotFound(id, version string) error {
+	return ErrVersionNotFound.WithMetadata(map[string]string{"workflow_id": id, "version": version})
+}
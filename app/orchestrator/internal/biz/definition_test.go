@@ -0,0 +1,319 @@
+package biz
+
+import (
+	"context"
+	"math"
+	"sync"
+	"testing"
+	"time"
+
+	commonv1 "rag/api/common/v1"
+	v1 "rag/api/orchestrator/v1"
+
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/golang/protobuf/proto"
+)
+
+// memoryDefinitions is a DefinitionRepo kept in memory
+type memoryDefinitions struct {
+	mu       sync.Mutex
+	records  map[string]*DefinitionRecord
+	versions map[string]*v1.WorkflowDefinition
+}
+
+func newMemoryDefinitions() *memoryDefinitions {
+	return &memoryDefinitions{
+		records:  make(map[string]*DefinitionRecord),
+		versions: make(map[string]*v1.WorkflowDefinition),
+	}
+}
+
+func copyRecord(rec *DefinitionRecord) *DefinitionRecord {
+	c := *rec
+	c.Versions = append([]DefinitionVersion(nil), rec.Versions...)
+	c.Tags = append([]string(nil), rec.Tags...)
+	return &c
+}
+
+func (r *memoryDefinitions) GetDefinition(ctx context.Context, id string) (*DefinitionRecord, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	rec, ok := r.records[id]
+	if !ok {
+		return nil, ErrWorkflowNotFound
+	}
+	return copyRecord(rec), nil
+}
+
+func (r *memoryDefinitions) GetVersion(ctx context.Context, id, version string) (*v1.WorkflowDefinition, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	def, ok := r.versions[id+"@"+version]
+	if !ok {
+		return nil, versionNotFound(id, version)
+	}
+	return proto.Clone(def).(*v1.WorkflowDefinition), nil
+}
+
+func (r *memoryDefinitions) ListDefinitions(ctx context.Context) ([]*DefinitionRecord, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	recs := make([]*DefinitionRecord, 0, len(r.records))
+	for _, rec := range r.records {
+		recs = append(recs, copyRecord(rec))
+	}
+	return recs, nil
+}
+
+func (r *memoryDefinitions) PublishVersion(ctx context.Context, rec *DefinitionRecord, def *v1.WorkflowDefinition) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.versions[rec.ID+"@"+def.Version] = proto.Clone(def).(*v1.WorkflowDefinition)
+	r.records[rec.ID] = copyRecord(rec)
+	return nil
+}
+
+func (r *memoryDefinitions) SaveDefinition(ctx context.Context, rec *DefinitionRecord) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.records[rec.ID] = copyRecord(rec)
+	return nil
+}
+
+// memoryExecutions is an ExecutionRepo kept in memory
+type memoryExecutions struct {
+	mu      sync.Mutex
+	records map[string]*ExecutionRecord
+}
+
+func newMemoryExecutions() *memoryExecutions {
+	return &memoryExecutions{records: make(map[string]*ExecutionRecord)}
+}
+
+func (r *memoryExecutions) SaveExecution(ctx context.Context, rec *ExecutionRecord) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	// 运行中的执行会继续修改记录，保存时复制可变的字段
+	c := *rec
+	c.Checkpoints = make(map[string]*StepCheckpoint, len(rec.Checkpoints))
+	for id, cp := range rec.Checkpoints {
+		c.Checkpoints[id] = cp
+	}
+	c.Logs = append([]*v1.WorkflowLog(nil), rec.Logs...)
+	r.records[rec.ID] = &c
+	return nil
+}
+
+func (r *memoryExecutions) GetExecution(ctx context.Context, id string) (*ExecutionRecord, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	rec, ok := r.records[id]
+	if !ok {
+		return nil, ErrExecutionNotFound
+	}
+	c := *rec
+	return &c, nil
+}
+
+func (r *memoryExecutions) ListPendingExecutions(ctx context.Context) ([]*ExecutionRecord, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	var pending []*ExecutionRecord
+	for _, rec := range r.records {
+		if !finished(rec.Status) || rec.CallbackPending {
+			c := *rec
+			pending = append(pending, &c)
+		}
+	}
+	return pending, nil
+}
+
+func (r *memoryExecutions) ExecutionStats(ctx context.Context, definitionID string) (map[string]ExecutionStats, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	stats := make(map[string]ExecutionStats)
+	for _, rec := range r.records {
+		if rec.DefinitionID != definitionID || !finished(rec.Status) {
+			continue
+		}
+		st := stats[rec.Definition.GetVersion()]
+		st.Executions++
+		if rec.Status == commonv1.ProcessingStatus_PROCESSING_STATUS_COMPLETED {
+			st.Succeeded++
+		}
+		st.TotalTime += rec.CompletedAt.Sub(rec.StartedAt)
+		stats[rec.Definition.GetVersion()] = st
+	}
+	return stats, nil
+}
+
+func newTestDefinitions(t testing.TB) (*DefinitionUsecase, *memoryExecutions) {
+	executions := newMemoryExecutions()
+	return NewDefinitionUsecase(newTestEngine(diamondServices(t)), newMemoryDefinitions(), executions, log.DefaultLogger), executions
+}
+
+func TestDefinitionVersions(t *testing.T) {
+	ctx := context.Background()
+	uc, _ := newTestDefinitions(t)
+	tests := []struct {
+		version     string
+		makeDefault bool
+		want        string
+		wantDefault string
+		reason      string
+	}{
+		// 第一个版本总是默认版本
+		{"", false, "1.0.0", "1.0.0", ""},
+		{"", false, "1.1.0", "1.0.0", ""},
+		{"2.0.0-rc.1", true, "2.0.0-rc.1", "2.0.0-rc.1", ""},
+		// 预发布版本之后发布其正式版本
+		{"", false, "2.0.0", "2.0.0-rc.1", ""},
+		// 仅构建元数据不同的版本已存在
+		{"1.1.0+build.7", false, "", "2.0.0-rc.1", commonv1.ErrorCode_ERROR_CODE_CONFLICT.String()},
+		{"v3", false, "", "2.0.0-rc.1", commonv1.ErrorCode_ERROR_CODE_BAD_REQUEST.String()},
+	}
+	for _, tt := range tests {
+		def := diamond(t, "")
+		def.Version = tt.version
+		resp, err := uc.CreateDefinition(ctx, &v1.CreateWorkflowDefinitionRequest{Definition: def, MakeDefault: tt.makeDefault, CreatedBy: "tester"})
+		if tt.reason != "" {
+			if errors.Reason(err) != tt.reason {
+				t.Errorf("version %q: err = %v, want %s", tt.version, err, tt.reason)
+			}
+		} else if err != nil || resp.Version != tt.want {
+			t.Errorf("version %q: published %v, %v, want %s", tt.version, resp.GetVersion(), err, tt.want)
+		}
+		got, err := uc.GetDefinition(ctx, &v1.GetWorkflowDefinitionRequest{DefinitionId: "diamond"})
+		if err != nil || got.Definition.Version != tt.wantDefault {
+			t.Errorf("after version %q: default %v, %v, want %s", tt.version, got.GetDefinition().GetVersion(), err, tt.wantDefault)
+		}
+	}
+}
+
+func TestDefinitionPinning(t *testing.T) {
+	ctx := context.Background()
+	uc, executions := newTestDefinitions(t)
+	for _, version := range []string{"1.0.0", "1.1.0"} {
+		def := diamond(t, "")
+		def.Version, def.Description = version, "v"+version
+		if _, err := uc.CreateDefinition(ctx, &v1.CreateWorkflowDefinitionRequest{Definition: def}); err != nil {
+			t.Fatal(err)
+		}
+	}
+	started := time.Now()
+	for i, status := range []commonv1.ProcessingStatus{
+		commonv1.ProcessingStatus_PROCESSING_STATUS_COMPLETED,
+		commonv1.ProcessingStatus_PROCESSING_STATUS_FAILED,
+		commonv1.ProcessingStatus_PROCESSING_STATUS_PROCESSING,
+	} {
+		executions.SaveExecution(ctx, &ExecutionRecord{
+			ID:           string(rune('a' + i)),
+			DefinitionID: "diamond",
+			Definition:   &v1.WorkflowDefinition{Version: "1.1.0"},
+			Status:       status,
+			StartedAt:    started,
+			CompletedAt:  started.Add(100 * time.Millisecond),
+		})
+	}
+
+	tests := []struct {
+		ref     string
+		version string
+		found   bool
+	}{
+		{"diamond", "1.0.0", true},
+		{"diamond@1.1.0", "1.1.0", true},
+		{"diamond@1.0.0", "1.0.0", true},
+		{"diamond@2.0.0", "", false},
+		{"missing", "", false},
+	}
+	for _, tt := range tests {
+		_, def, err := resolveDefinition(ctx, uc.definitions, tt.ref)
+		if !tt.found {
+			if !errors.IsNotFound(err) {
+				t.Errorf("%s: err = %v, want not found", tt.ref, err)
+			}
+			continue
+		}
+		if err != nil || def.Version != tt.version {
+			t.Errorf("%s: version %v, %v, want %s", tt.ref, def.GetVersion(), err, tt.version)
+		}
+	}
+
+	// 指定版本读取时返回该版本及其执行统计，未结束的执行不计入
+	got, err := uc.GetDefinition(ctx, &v1.GetWorkflowDefinitionRequest{DefinitionId: "diamond", Version: "1.1.0"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got.Definition.Description != "v1.1.0" || got.Metadata.ExecutionCount != 2 || got.Metadata.SuccessRate != 0.5 || got.Metadata.AvgExecutionTimeMs != 100 {
+		t.Errorf("1.1.0 = %q with %d executions, success %v, avg %vms", got.Definition.Description, got.Metadata.ExecutionCount, got.Metadata.SuccessRate, got.Metadata.AvgExecutionTimeMs)
+	}
+
+	// 已发布的版本不可修改，只能切换默认版本
+	changed := diamond(t, "")
+	changed.Version, changed.Description = "1.0.0", "edited"
+	if _, err := uc.UpdateDefinition(ctx, &v1.UpdateWorkflowDefinitionRequest{DefinitionId: "diamond", Definition: changed}); errors.Reason(err) != commonv1.ErrorCode_ERROR_CODE_CONFLICT.String() {
+		t.Errorf("editing 1.0.0: err = %v, want a conflict", err)
+	}
+	if _, err := uc.UpdateDefinition(ctx, &v1.UpdateWorkflowDefinitionRequest{DefinitionId: "diamond", Definition: &v1.WorkflowDefinition{Version: "1.1.0"}, MakeDefault: true}); err != nil {
+		t.Fatal(err)
+	}
+	if _, def, err := resolveDefinition(ctx, uc.definitions, "diamond"); err != nil || def.Version != "1.1.0" {
+		t.Errorf("default = %v, %v, want 1.1.0", def.GetVersion(), err)
+	}
+	resp, err := uc.UpdateDefinition(ctx, &v1.UpdateWorkflowDefinitionRequest{DefinitionId: "diamond", Definition: changed, CreateNewVersion: true})
+	if errors.Reason(err) != commonv1.ErrorCode_ERROR_CODE_CONFLICT.String() {
+		t.Errorf("publishing an existing version: %v, %v, want a conflict", resp.GetVersion(), err)
+	}
+	changed.Version = ""
+	if resp, err = uc.UpdateDefinition(ctx, &v1.UpdateWorkflowDefinitionRequest{DefinitionId: "diamond", Definition: changed, CreateNewVersion: true}); err != nil || resp.Version != "1.2.0" {
+		t.Errorf("new version = %v, %v, want 1.2.0", resp.GetVersion(), err)
+	}
+}
+
+func TestListDefinitionsPaging(t *testing.T) {
+	ctx := context.Background()
+	uc, _ := newTestDefinitions(t)
+	for _, version := range []string{"1.0.0", "1.1.0", "1.2.0"} {
+		def := diamond(t, "")
+		def.Version = version
+		if _, err := uc.CreateDefinition(ctx, &v1.CreateWorkflowDefinitionRequest{Definition: def}); err != nil {
+			t.Fatal(err)
+		}
+	}
+	tests := []struct {
+		page, pageSize int32
+		want           []string
+	}{
+		{0, 0, []string{"1.2.0", "1.1.0", "1.0.0"}},
+		{1, 2, []string{"1.2.0", "1.1.0"}},
+		{2, 2, []string{"1.0.0"}},
+		{3, 2, nil},
+		// 过大的页码不能溢出为负的下标
+		{math.MaxInt32, 2, nil},
+		{math.MaxInt32, math.MaxInt32, nil},
+		{1, math.MaxInt32, []string{"1.2.0", "1.1.0", "1.0.0"}},
+	}
+	for _, tt := range tests {
+		resp, err := uc.ListDefinitions(ctx, &v1.ListWorkflowDefinitionsRequest{Pagination: &commonv1.PaginationRequest{Page: tt.page, PageSize: tt.pageSize}})
+		if err != nil {
+			t.Errorf("page %d size %d: %v", tt.page, tt.pageSize, err)
+			continue
+		}
+		var got []string
+		for _, info := range resp.Definitions {
+			got = append(got, info.Version)
+		}
+		if len(got) != len(tt.want) || resp.Pagination.Total != 3 {
+			t.Errorf("page %d size %d: %v of %d, want %v of 3", tt.page, tt.pageSize, got, resp.Pagination.Total, tt.want)
+			continue
+		}
+		for i := range got {
+			if got[i] != tt.want[i] {
+				t.Errorf("page %d size %d: %v, want %v", tt.page, tt.pageSize, got, tt.want)
+				break
+			}
+		}
+	}
+}
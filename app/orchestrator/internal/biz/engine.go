@@ -0,0 +1,924 @@
+package biz
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"strconv"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	commonv1 "rag/api/common/v1"
+	v1 "rag/api/orchestrator/v1"
+
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/golang/protobuf/proto"
+	"google.golang.org/protobuf/types/known/anypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const (
+	// 未配置超时时的默认值
+	defaultStepTimeout     = 30 * time.Second
+	defaultWorkflowTimeout = 5 * time.Minute
+	// 同时运行的步骤数上限
+	maxParallelSteps = 16
+)
+
+// Step event types recorded in StepExecutionTrace.events.
+const (
+	EventStarted   = "started"
+	EventCompleted = "completed"
+	EventFailed    = "failed"
+	EventTimeout   = "timeout"
+	EventCancelled = "cancelled"
+	EventSkipped   = "skipped"
+	EventCondition = "condition"
+	EventRetry     = "retry"
+	EventFallback  = "fallback"
+)
+
+// StepCheckpoint is a step that succeeded, kept so that an interrupted
+// execution can resume without running it again.
+type StepCheckpoint struct {
+	Output map[string]*anypb.Any
+	Trace  *v1.StepExecutionTrace
+}
+
+// runHooks let the caller of execute persist the progress of a run and
+// resume it later, or follow the workflow outputs as steps publish them.
+// checkpoint and progress are called on the scheduler goroutine before the
+// dependents of the step start; outputs must not be kept past the call.
+type runHooks struct {
+	resume     map[string]*StepCheckpoint
+	checkpoint func(stepID string, cp *StepCheckpoint)
+	progress   func(stepID string, outputs map[string]*anypb.Any)
+}
+
+// ServiceSpan is one call a workflow step or compensation made to a
+// backend service, retries included.
+type ServiceSpan struct {
+	StepID    string
+	Service   string
+	Method    string
+	StartedAt time.Time
+	Duration  time.Duration
+	Err       error
+}
+
+// Execution is the outcome of running a workflow.
+type Execution struct {
+	ID          string
+	Status      commonv1.ProcessingStatus
+	Outputs     map[string]*anypb.Any
+	Trace       *v1.WorkflowExecutionTrace
+	Spans       []ServiceSpan
+	StartedAt   time.Time
+	CompletedAt time.Time
+}
+
+// WorkflowEngine executes workflow definitions as dependency graphs.
+type WorkflowEngine struct {
+	services   ServiceRepo
+	generation *GenerationUsecase
+	log        *log.Helper
+}
+
+// NewWorkflowEngine creates a workflow engine whose steps call the backend
+// services and the in-process generator
+func NewWorkflowEngine(services ServiceRepo, generation *GenerationUsecase, logger log.Logger) *WorkflowEngine {
+	return &WorkflowEngine{
+		services: withLocalServices(services, map[string]localService{
+			GeneratorService: generation,
+		}),
+		generation: generation,
+		log:        log.NewHelper(logger),
+	}
+}
+
+// Validate checks that def forms an executable step graph whose steps all
+// call registered service methods with well-formed mappings and conditions.
+func (e *WorkflowEngine) Validate(def *v1.WorkflowDefinition) error {
+	_, err := e.validate(def)
+	return err
+}
+
+func (e *WorkflowEngine) validate(def *v1.WorkflowDefinition) (*workflowGraph, error) {
+	graph, err := buildGraph(def)
+	if err != nil {
+		return nil, err
+	}
+	for _, step := range def.Steps {
+		methods, ok := e.services.Methods(step.ServiceName)
+		if !ok {
+			return nil, invalidWorkflow("step %q calls unknown service %q", step.StepId, step.ServiceName)
+		}
+		if !containsString(methods, step.MethodName) {
+			return nil, invalidWorkflow("step %q calls unknown method %s.%s", step.StepId, step.ServiceName, step.MethodName)
+		}
+		if c := step.GetCompensation(); c != nil {
+			methods, ok := e.services.Methods(c.ServiceName)
+			if !ok || !containsString(methods, c.MethodName) {
+				return nil, invalidWorkflow("step %q compensates with unknown method %s.%s", step.StepId, c.ServiceName, c.MethodName)
+			}
+		}
+	}
+	if graph.mappings, err = compileMappings(graph); err != nil {
+		return nil, err
+	}
+	if graph.branches, err = compileBranches(graph); err != nil {
+		return nil, err
+	}
+	if graph.recovery, err = compileRecovery(graph, def.GetConfiguration().GetErrorHandling()); err != nil {
+		return nil, err
+	}
+	if graph.compensations, err = compileCompensations(graph); err != nil {
+		return nil, err
+	}
+	return graph, nil
+}
+
+// newExecutionID returns a random execution id
+func newExecutionID() string {
+	b := make([]byte, 8)
+	_, _ = rand.Read(b)
+	return "exec-" + hex.EncodeToString(b)
+}
+
+// stepState is where a step is in its lifecycle
+type stepState int
+
+const (
+	stepWaiting stepState = iota
+	stepRunning
+	stepSucceeded
+	stepFailed
+	stepCancelled
+	stepSkipped
+)
+
+// stepResult is what a finished step reports back to the scheduler
+type stepResult struct {
+	index   int
+	output  map[string]*anypb.Any
+	err     error
+	retries []retryRecord
+}
+
+// retryRecord is a failed attempt that was retried
+type retryRecord struct {
+	attempt int
+	err     error
+	delay   time.Duration
+	at      time.Time
+}
+
+// workflowRun holds the state of one execution. Only the scheduler goroutine
+// touches it; steps report through the results channel.
+type workflowRun struct {
+	engine  *WorkflowEngine
+	def     *v1.WorkflowDefinition
+	graph   *workflowGraph
+	results chan stepResult
+	cancel  context.CancelFunc
+	// 所有步骤已用的重试次数，步骤协程并发更新
+	retriesUsed atomic.Int32
+	// 映射表达式可见的数据，步骤成功后写入其输出
+	scope     *mappingScope
+	published map[string]*anypb.Any
+
+	states []stepState
+	// 尚未结束的上游数，以及其中被跳过的上游数
+	waiting []int
+	skipped []int
+	traces  []*v1.StepExecutionTrace
+	// 正在运行的步骤数，以及其中是否有独占步骤
+	running   int
+	exclusive bool
+	failure   string
+	// 调用成功的步骤，按完成顺序，回滚时逆序补偿
+	completed []int
+	hooks     *runHooks
+
+	// 每次服务调用的耗时，步骤协程并发追加
+	spanMu sync.Mutex
+	spans  []ServiceSpan
+}
+
+// Execute runs def with the given input. Ready steps are scheduled as soon as
+// their dependencies succeed; how many run at once follows the execution
+// strategy. A conditional step skips the branch its condition rules out, and
+// a step whose dependencies were all skipped is skipped too. Failed steps are
+// retried and fall back as configured. A step that still fails stops the
+// workflow: running steps are cancelled and steps not started are skipped.
+// Under continue_on_error only critical steps do so, and the steps
+// downstream of other failures are skipped. When a failed workflow enables
+// rollback, the completed steps are compensated afterwards. The returned
+// error is only set when the definition itself is invalid.
+func (e *WorkflowEngine) Execute(ctx context.Context, executionID string, def *v1.WorkflowDefinition, input map[string]*anypb.Any, opts *v1.ExecutionOptions) (*Execution, error) {
+	return e.execute(ctx, executionID, def, input, opts, nil)
+}
+
+// execute runs def like Execute. Steps with a checkpoint in hooks are
+// restored instead of run, and every step that succeeds is reported back.
+func (e *WorkflowEngine) execute(ctx context.Context, executionID string, def *v1.WorkflowDefinition, input map[string]*anypb.Any, opts *v1.ExecutionOptions, hooks *runHooks) (*Execution, error) {
+	graph, err := e.validate(def)
+	if err != nil {
+		return nil, err
+	}
+	if executionID == "" {
+		executionID = newExecutionID()
+	}
+	startTime := time.Now()
+	timeout := workflowTimeout(def, opts)
+	e.log.WithContext(ctx).Infof("Executing workflow %s as %s: %d steps, strategy %s, timeout %s",
+		def.Name, executionID, len(def.Steps), def.GetConfiguration().GetExecutionStrategy(), timeout)
+
+	// 请求输入覆盖定义中的默认参数
+	merged := make(map[string]*anypb.Any, len(def.DefaultParameters)+len(input))
+	for k, v := range def.DefaultParameters {
+		merged[k] = v
+	}
+	for k, v := range input {
+		merged[k] = v
+	}
+	r := &workflowRun{
+		engine:  e,
+		def:     def,
+		graph:   graph,
+		results: make(chan stepResult, len(def.Steps)),
+		scope: &mappingScope{
+			input:   merged,
+			steps:   make(map[string]map[string]*anypb.Any, len(def.Steps)),
+			context: opts.GetExecutionContext(),
+		},
+		published: make(map[string]*anypb.Any),
+		states:    make([]stepState, len(def.Steps)),
+		waiting:   make([]int, len(def.Steps)),
+		skipped:   make([]int, len(def.Steps)),
+		traces:    make([]*v1.StepExecutionTrace, len(def.Steps)),
+		hooks:     hooks,
+	}
+	for i, step := range def.Steps {
+		r.traces[i] = &v1.StepExecutionTrace{
+			StepId:   step.StepId,
+			StepName: step.StepName,
+			Status:   commonv1.ProcessingStatus_PROCESSING_STATUS_PENDING,
+		}
+	}
+	for i, deps := range graph.dependencies {
+		r.waiting[i] = len(deps)
+	}
+
+	runCtx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+	r.cancel = cancel
+	if opts.GetEnableCircuitBreaker() {
+		// 熔断中的方法直接失败，不再等待调用超时
+		runCtx = WithCircuitBreaker(runCtx)
+	}
+	r.restore()
+	r.schedule(runCtx)
+
+	status := commonv1.ProcessingStatus_PROCESSING_STATUS_COMPLETED
+	switch {
+	case errors.Is(ctx.Err(), context.Canceled):
+		status = commonv1.ProcessingStatus_PROCESSING_STATUS_CANCELLED
+		r.failure = "workflow cancelled: " + context.Cause(ctx).Error()
+	case errors.Is(runCtx.Err(), context.DeadlineExceeded) && r.failure == "":
+		status = commonv1.ProcessingStatus_PROCESSING_STATUS_FAILED
+		r.failure = fmt.Sprintf("workflow timed out after %s", timeout)
+	case r.failure != "":
+		status = commonv1.ProcessingStatus_PROCESSING_STATUS_FAILED
+	}
+	var compensations []*v1.StepExecutionTrace
+	if status == commonv1.ProcessingStatus_PROCESSING_STATUS_FAILED && def.GetConfiguration().GetErrorHandling().GetEnableRollback() {
+		compensations = r.rollback(ctx)
+	}
+	completedAt := time.Now()
+	summary := r.summary(status, completedAt.Sub(startTime))
+	for _, trace := range compensations {
+		if trace.Status == commonv1.ProcessingStatus_PROCESSING_STATUS_COMPLETED {
+			summary.CompensatedSteps++
+		} else {
+			summary.FailedCompensations++
+		}
+	}
+	execution := &Execution{
+		ID:          executionID,
+		Status:      status,
+		Outputs:     r.published,
+		Spans:       r.spans,
+		StartedAt:   startTime,
+		CompletedAt: completedAt,
+		Trace: &v1.WorkflowExecutionTrace{
+			ExecutionId:        executionID,
+			StepTraces:         r.traces,
+			Summary:            summary,
+			CompensationTraces: compensations,
+		},
+	}
+	e.log.WithContext(ctx).Infof("Workflow %s finished as %s in %dms", executionID, status, completedAt.Sub(startTime).Milliseconds())
+	return execution, nil
+}
+
+// workflowTimeout is the smallest positive timeout of the definition and the
+// execution options.
+func workflowTimeout(def *v1.WorkflowDefinition, opts *v1.ExecutionOptions) time.Duration {
+	timeout := defaultWorkflowTimeout
+	if s := def.GetConfiguration().GetGlobalTimeoutSeconds(); s > 0 {
+		timeout = time.Duration(s) * time.Second
+	}
+	if s := opts.GetTimeoutSeconds(); s > 0 {
+		timeout = min(timeout, time.Duration(s)*time.Second)
+	}
+	return timeout
+}
+
+// parallel reports whether step i may run alongside other steps. Sequential
+// workflows run one step at a time and parallel ones run every ready step;
+// under the dag strategy a step runs alongside others when it sets
+// run_in_parallel or the workflow enables parallel execution.
+func (r *workflowRun) parallel(i int) bool {
+	config := r.def.GetConfiguration()
+	switch config.GetExecutionStrategy() {
+	case StrategySequential:
+		return false
+	case StrategyParallel:
+		return true
+	default:
+		return config.GetEnableParallelExecution() || r.def.Steps[i].GetStepConfig().GetRunInParallel()
+	}
+}
+
+// schedule runs the steps until none is running and none can start. It
+// returns once every started step has reported back.
+func (r *workflowRun) schedule(ctx context.Context) {
+	for {
+		if ctx.Err() == nil && r.failure == "" {
+			r.launchReady(ctx)
+		}
+		if r.running == 0 {
+			break
+		}
+		res := <-r.results
+		r.running--
+		if r.running == 0 {
+			r.exclusive = false
+		}
+		r.finish(ctx, res)
+	}
+
+	// 未启动的步骤记为跳过
+	reason := r.failure
+	if reason == "" && ctx.Err() != nil {
+		reason = "workflow stopped: " + ctx.Err().Error()
+	}
+	for i, state := range r.states {
+		if state != stepWaiting {
+			continue
+		}
+		if r.graph.recovery[i].primary >= 0 {
+			r.skip(i, fmt.Sprintf("step %s did not need its alternative", r.def.Steps[r.graph.recovery[i].primary].StepId))
+			continue
+		}
+		r.states[i] = stepSkipped
+		r.traces[i].Status = commonv1.ProcessingStatus_PROCESSING_STATUS_CANCELLED
+		addEvent(r.traces[i], EventSkipped, reason, nil)
+	}
+}
+
+// launchReady starts the ready steps in definition order as far as the
+// strategy allows. An exclusive step only starts when nothing else runs and
+// blocks every other step until it finishes. Alternative steps only run in
+// place of the step they stand by for.
+func (r *workflowRun) launchReady(ctx context.Context) {
+	for i := range r.def.Steps {
+		if r.states[i] != stepWaiting || r.waiting[i] > 0 || r.graph.recovery[i].primary >= 0 {
+			continue
+		}
+		if r.exclusive || r.running >= maxParallelSteps {
+			return
+		}
+		if !r.parallel(i) {
+			if r.running > 0 {
+				// 独占步骤等待其他步骤结束，其后的步骤也不越过它
+				return
+			}
+			r.exclusive = true
+		}
+		r.start(ctx, i)
+	}
+}
+
+// start runs step i in its own goroutine
+func (r *workflowRun) start(ctx context.Context, i int) {
+	step := r.def.Steps[i]
+	input, err := r.stepInput(i)
+	trace := r.traces[i]
+	trace.Status = commonv1.ProcessingStatus_PROCESSING_STATUS_PROCESSING
+	trace.StartedAt = timestamppb.Now()
+	trace.InputData = input
+	addEvent(trace, EventStarted, fmt.Sprintf("calling %s.%s", step.ServiceName, step.MethodName), nil)
+	r.states[i] = stepRunning
+	r.running++
+	if err != nil {
+		// 输入无法解析时不调用服务，结果通道有足够缓冲
+		r.results <- stepResult{index: i, err: err}
+		return
+	}
+	go func() {
+		r.results <- r.attempt(ctx, i, input)
+	}()
+}
+
+// attempt calls the method of step i under the step timeout. Failures that
+// may pass on a later attempt are retried with backoff as long as the step's
+// policy and the workflow's retry budget allow.
+func (r *workflowRun) attempt(ctx context.Context, i int, input map[string]*anypb.Any) stepResult {
+	step := r.def.Steps[i]
+	policy := r.graph.recovery[i].retry
+	res := stepResult{index: i}
+	for n := 1; ; n++ {
+		stepCtx, cancel := context.WithTimeout(ctx, r.stepTimeout(i))
+		res.output, res.err = r.call(stepCtx, step.StepId, step.ServiceName, step.MethodName, input)
+		cancel()
+		if res.err == nil || n > policy.attempts || ctx.Err() != nil || !retryable(res.err) || !r.takeRetry() {
+			return res
+		}
+		delay := policy.delay(n)
+		res.retries = append(res.retries, retryRecord{attempt: n, err: res.err, delay: delay, at: time.Now()})
+		timer := time.NewTimer(delay)
+		select {
+		case <-timer.C:
+		case <-ctx.Done():
+			timer.Stop()
+			return res
+		}
+	}
+}
+
+// takeRetry claims one retry from the workflow's budget
+func (r *workflowRun) takeRetry() bool {
+	limit := r.def.GetConfiguration().GetErrorHandling().GetMaxGlobalRetries()
+	return limit <= 0 || r.retriesUsed.Add(1) <= limit
+}
+
+func (r *workflowRun) stepTimeout(i int) time.Duration {
+	if s := r.def.Steps[i].GetStepConfig().GetTimeoutSeconds(); s > 0 {
+		return time.Duration(s) * time.Second
+	}
+	return defaultStepTimeout
+}
+
+// call invokes a service method for a step and records the call as a span
+func (r *workflowRun) call(ctx context.Context, stepID, service, method string, input map[string]*anypb.Any) (map[string]*anypb.Any, error) {
+	start := time.Now()
+	output, err := r.engine.call(ctx, service, method, input)
+	span := ServiceSpan{
+		StepID:    stepID,
+		Service:   service,
+		Method:    method,
+		StartedAt: start,
+		Duration:  time.Since(start),
+		Err:       err,
+	}
+	r.spanMu.Lock()
+	r.spans = append(r.spans, span)
+	r.spanMu.Unlock()
+	return output, err
+}
+
+// call invokes a service method and returns when it does or when ctx is
+// done, so a call that ignores its context cannot hold the workflow past its
+// timeout.
+func (e *WorkflowEngine) call(ctx context.Context, service, method string, input map[string]*anypb.Any) (map[string]*anypb.Any, error) {
+	type reply struct {
+		output map[string]*anypb.Any
+		err    error
+	}
+	done := make(chan reply, 1)
+	go func() {
+		defer func() {
+			if p := recover(); p != nil {
+				done <- reply{err: fmt.Errorf("step panicked: %v", p)}
+			}
+		}()
+		output, err := e.services.Call(ctx, service, method, input)
+		done <- reply{output: output, err: err}
+	}()
+	select {
+	case res := <-done:
+		return res.output, res.err
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+}
+
+// finish records the result of a step. Successful steps release their
+// dependents; failed ones fall back or fail under the error handling
+// strategy.
+func (r *workflowRun) finish(ctx context.Context, res stepResult) {
+	i := res.index
+	r.stopClock(i)
+	r.recordRetries(i, res.retries)
+	if p := r.graph.recovery[i].primary; p >= 0 {
+		r.finishAlternative(ctx, p, res)
+		return
+	}
+	if res.err == nil {
+		r.traces[i].OutputData = res.output
+		if res.err = r.complete(ctx, i, res.output); res.err == nil {
+			r.completed = append(r.completed, i)
+			return
+		}
+	}
+	if ctx.Err() != nil {
+		// 工作流被取消或整体超时，运行中的步骤记为取消
+		r.markCancelled(ctx, i)
+		return
+	}
+	r.markFailed(i, res.err)
+	if !r.fallBack(ctx, i) {
+		r.fail(i)
+	}
+}
+
+// complete publishes the output of step i, evaluates its condition and
+// releases its dependents
+func (r *workflowRun) complete(ctx context.Context, i int, output map[string]*anypb.Any) error {
+	if err := r.publish(i, output); err != nil {
+		return err
+	}
+	taken := false
+	if r.graph.branches[i] != nil {
+		var err error
+		if taken, err = r.test(ctx, i, output); err != nil {
+			return err
+		}
+	}
+
+	trace := r.traces[i]
+	r.states[i] = stepSucceeded
+	trace.Status = commonv1.ProcessingStatus_PROCESSING_STATUS_COMPLETED
+	addEvent(trace, EventCompleted, fmt.Sprintf("completed in %dms", trace.DurationMs), nil)
+	if r.hooks != nil && r.hooks.checkpoint != nil {
+		r.hooks.checkpoint(r.def.Steps[i].StepId, &StepCheckpoint{
+			Output: output,
+			Trace:  proto.Clone(trace).(*v1.StepExecutionTrace),
+		})
+	}
+	if r.hooks != nil && r.hooks.progress != nil {
+		r.hooks.progress(r.def.Steps[i].StepId, r.published)
+	}
+	r.release(i, taken)
+	return nil
+}
+
+// release lets the dependents of a succeeded step i go ahead and skips the
+// branch its condition ruled out
+func (r *workflowRun) release(i int, taken bool) {
+	for _, d := range r.graph.dependents[i] {
+		r.waiting[d]--
+	}
+	if b := r.graph.branches[i]; b != nil {
+		ruledOut := b.ifTrue
+		if taken {
+			ruledOut = b.ifFalse
+		}
+		for _, j := range ruledOut {
+			if r.states[j] == stepWaiting {
+				r.skip(j, fmt.Sprintf("condition of step %s is %t", r.def.Steps[i].StepId, taken))
+			}
+		}
+	}
+}
+
+// restore marks the steps checkpointed by an earlier run of the execution as
+// succeeded, in topological order, so that only the rest runs again. A
+// condition keeps the result it had; a checkpoint that no longer fits the
+// step is dropped and the step runs again.
+func (r *workflowRun) restore() {
+	if r.hooks == nil || len(r.hooks.resume) == 0 {
+		return
+	}
+	for _, i := range r.graph.order {
+		step := r.def.Steps[i]
+		cp, ok := r.hooks.resume[step.StepId]
+		if !ok || r.states[i] != stepWaiting || r.waiting[i] > 0 || cp.Trace == nil {
+			continue
+		}
+		taken, known := conditionResult(cp.Trace)
+		if r.graph.branches[i] != nil && !known {
+			continue
+		}
+		if err := r.publish(i, cp.Output); err != nil {
+			continue
+		}
+		r.states[i] = stepSucceeded
+		r.traces[i] = cp.Trace
+		switch fb := cp.Trace.GetFallback(); {
+		case fb == nil:
+			r.completed = append(r.completed, i)
+		case fb.FallbackType == FallbackAlternativeStep && r.graph.recovery[i].fallback != nil:
+			r.completed = append(r.completed, r.graph.recovery[i].fallback.alternative)
+		}
+		r.release(i, taken)
+	}
+}
+
+// conditionResult reads the last condition result recorded in a trace
+func conditionResult(trace *v1.StepExecutionTrace) (taken, ok bool) {
+	for k := len(trace.Events) - 1; k >= 0; k-- {
+		if e := trace.Events[k]; e.EventType == EventCondition {
+			taken, err := strconv.ParseBool(e.EventMetadata["result"])
+			return taken, err == nil
+		}
+	}
+	return false, false
+}
+
+// fallBack replaces the result of a failed step with its fallback, if it has
+// one. It reports false when the step stays failed.
+func (r *workflowRun) fallBack(ctx context.Context, i int) bool {
+	fb := r.graph.recovery[i].fallback
+	if fb == nil {
+		return false
+	}
+	trace := r.traces[i]
+	trace.Fallback = &commonv1.FallbackResponse{
+		Reason:       trace.ErrorMessage,
+		FallbackType: fb.kind,
+		Data:         fb.value,
+		FallbackAt:   timestamppb.Now(),
+	}
+	switch fb.kind {
+	case FallbackDefaultValue:
+		addEvent(trace, EventFallback, "using the default value", nil)
+		trace.OutputData = fb.output
+		if err := r.complete(ctx, i, fb.output); err != nil {
+			r.markFailed(i, err)
+			return false
+		}
+	case FallbackSkip:
+		addEvent(trace, EventFallback, "skipping the step", nil)
+		r.skip(i, "skipped after failing: "+trace.ErrorMessage)
+	case FallbackAlternativeStep:
+		alt := fb.alternative
+		if r.states[alt] != stepWaiting {
+			return false
+		}
+		// 替补步骤占用原步骤的位置运行，原步骤保持运行状态直到替补结束
+		r.states[i] = stepRunning
+		trace.Status = commonv1.ProcessingStatus_PROCESSING_STATUS_PROCESSING
+		addEvent(trace, EventFallback, "running alternative step "+r.def.Steps[alt].StepId, nil)
+		r.start(ctx, alt)
+	}
+	return true
+}
+
+// finishAlternative records the result of an alternative step and settles
+// the step it ran for with it
+func (r *workflowRun) finishAlternative(ctx context.Context, p int, res stepResult) {
+	i := res.index
+	r.stopClock(p)
+	switch {
+	case res.err == nil:
+		r.states[i] = stepSucceeded
+		r.traces[i].Status = commonv1.ProcessingStatus_PROCESSING_STATUS_COMPLETED
+		r.traces[i].OutputData = res.output
+		addEvent(r.traces[i], EventCompleted, fmt.Sprintf("completed in %dms for step %s", r.traces[i].DurationMs, r.def.Steps[p].StepId), nil)
+		r.traces[p].OutputData = res.output
+		// 替补步骤完成了实际工作，回滚时补偿替补步骤
+		r.completed = append(r.completed, i)
+		if err := r.complete(ctx, p, res.output); err != nil {
+			r.markFailed(p, err)
+			r.fail(p)
+		}
+	case ctx.Err() != nil:
+		r.markCancelled(ctx, i)
+		r.markCancelled(ctx, p)
+	default:
+		r.markFailed(i, res.err)
+		r.markFailed(p, fmt.Errorf("alternative step %s failed: %s", r.def.Steps[i].StepId, r.traces[i].ErrorMessage))
+		r.fail(p)
+	}
+}
+
+// fail applies the error handling strategy to a step that failed for good
+func (r *workflowRun) fail(i int) {
+	critical := r.graph.recovery[i].critical
+	if r.def.GetConfiguration().GetErrorHandling().GetStrategy() == ErrorContinueOnError && !critical {
+		r.abandon(i)
+		return
+	}
+	if r.failure != "" {
+		return
+	}
+	kind := "step"
+	if critical {
+		kind = "critical step"
+	}
+	r.failure = fmt.Sprintf("%s %s failed: %s", kind, r.def.Steps[i].StepId, r.traces[i].ErrorMessage)
+	// 快速失败，取消仍在运行的步骤
+	r.cancel()
+}
+
+// abandon skips the waiting steps downstream of a failed step
+func (r *workflowRun) abandon(i int) {
+	for _, d := range r.graph.dependents[i] {
+		if r.states[d] != stepWaiting {
+			continue
+		}
+		r.states[d] = stepSkipped
+		r.traces[d].Status = commonv1.ProcessingStatus_PROCESSING_STATUS_SKIPPED
+		addEvent(r.traces[d], EventSkipped, fmt.Sprintf("dependency %s failed", r.def.Steps[i].StepId), nil)
+		r.abandon(d)
+	}
+}
+
+func (r *workflowRun) markCancelled(ctx context.Context, i int) {
+	trace := r.traces[i]
+	r.states[i] = stepCancelled
+	trace.Status = commonv1.ProcessingStatus_PROCESSING_STATUS_CANCELLED
+	trace.ErrorMessage = ctx.Err().Error()
+	addEvent(trace, EventCancelled, "workflow stopped while the step was running", nil)
+}
+
+func (r *workflowRun) markFailed(i int, err error) {
+	trace := r.traces[i]
+	r.states[i] = stepFailed
+	trace.Status = commonv1.ProcessingStatus_PROCESSING_STATUS_FAILED
+	if errors.Is(err, context.DeadlineExceeded) {
+		trace.ErrorMessage = fmt.Sprintf("step timed out after %s", r.stepTimeout(i))
+		addEvent(trace, EventTimeout, trace.ErrorMessage, nil)
+		return
+	}
+	trace.ErrorMessage = errorMessage(err)
+	addEvent(trace, EventFailed, trace.ErrorMessage, nil)
+}
+
+func (r *workflowRun) stopClock(i int) {
+	trace := r.traces[i]
+	completedAt := time.Now()
+	trace.CompletedAt = timestamppb.New(completedAt)
+	trace.DurationMs = completedAt.Sub(trace.StartedAt.AsTime()).Milliseconds()
+}
+
+// recordRetries adds the retried attempts of step i to its trace
+func (r *workflowRun) recordRetries(i int, retries []retryRecord) {
+	trace := r.traces[i]
+	policy := r.graph.recovery[i].retry
+	for _, rt := range retries {
+		reason := errorMessage(rt.err)
+		if errors.Is(rt.err, context.DeadlineExceeded) {
+			reason = fmt.Sprintf("timed out after %s", r.stepTimeout(i))
+		}
+		trace.Events = append(trace.Events, &v1.StepExecutionEvent{
+			EventType:    EventRetry,
+			EventMessage: fmt.Sprintf("attempt %d failed, retrying in %dms: %s", rt.attempt, rt.delay.Milliseconds(), reason),
+			EventTime:    timestamppb.New(rt.at),
+			EventMetadata: map[string]string{
+				"attempt":  strconv.Itoa(rt.attempt),
+				"delay_ms": strconv.FormatInt(rt.delay.Milliseconds(), 10),
+			},
+		})
+	}
+	trace.RetryCount = int32(len(retries))
+	if policy.attempts == 0 {
+		return
+	}
+	info := &commonv1.RetryInfo{
+		RetryCount:      trace.RetryCount,
+		MaxRetries:      int32(policy.attempts),
+		BackoffStrategy: policy.strategy,
+	}
+	// 记录最后一次重试前的等待
+	if len(retries) > 0 {
+		info.NextRetryDelayMs = retries[len(retries)-1].delay.Milliseconds()
+	}
+	trace.RetryInfo = info
+}
+
+// stepInput evaluates the input mapping of step i. Entries that resolve to
+// nothing are left out of the request.
+func (r *workflowRun) stepInput(i int) (map[string]*anypb.Any, error) {
+	return evaluateInput(r.graph.mappings[i].inputs, r.scope)
+}
+
+func evaluateInput(mapping map[string]*mappingValue, scope *mappingScope) (map[string]*anypb.Any, error) {
+	input := make(map[string]*anypb.Any, len(mapping))
+	for field, v := range mapping {
+		value, err := v.evaluate(scope)
+		if err != nil {
+			return input, fmt.Errorf("input %s: %w", field, err)
+		}
+		if value != nil {
+			input[field] = value
+		}
+	}
+	return input, nil
+}
+
+// publish stores the output of step i for later steps and evaluates its
+// output mapping into the workflow outputs. Steps without an output mapping
+// publish every field as <step_id>.<field>.
+func (r *workflowRun) publish(i int, output map[string]*anypb.Any) error {
+	step := r.def.Steps[i]
+	r.scope.steps[step.StepId] = output
+	if len(r.graph.mappings[i].outputs) == 0 {
+		for field, value := range output {
+			r.published[step.StepId+"."+field] = value
+		}
+		return nil
+	}
+	scope := *r.scope
+	scope.output = output
+	values := make(map[string]*anypb.Any, len(r.graph.mappings[i].outputs))
+	for name, v := range r.graph.mappings[i].outputs {
+		value, err := v.evaluate(&scope)
+		if err != nil {
+			delete(r.scope.steps, step.StepId)
+			return fmt.Errorf("output %s: %w", name, err)
+		}
+		if value != nil {
+			values[name] = value
+		}
+	}
+	for name, value := range values {
+		r.published[name] = value
+	}
+	return nil
+}
+
+// test evaluates the condition of step i against its output
+func (r *workflowRun) test(ctx context.Context, i int, output map[string]*anypb.Any) (bool, error) {
+	scope := *r.scope
+	scope.output = output
+	taken, err := r.graph.branches[i].cond.test(ctx, &scope)
+	if err != nil {
+		return false, fmt.Errorf("condition: %w", err)
+	}
+	addEvent(r.traces[i], EventCondition, fmt.Sprintf("condition is %t", taken), map[string]string{"result": strconv.FormatBool(taken)})
+	return taken, nil
+}
+
+// skip marks a step as skipped and releases its dependents. A waiting
+// dependent whose dependencies have all been skipped is skipped in turn.
+func (r *workflowRun) skip(i int, reason string) {
+	r.states[i] = stepSkipped
+	r.traces[i].Status = commonv1.ProcessingStatus_PROCESSING_STATUS_SKIPPED
+	addEvent(r.traces[i], EventSkipped, reason, nil)
+	for _, d := range r.graph.dependents[i] {
+		r.waiting[d]--
+		r.skipped[d]++
+		// 替补步骤只在运行结束时处理
+		if r.graph.recovery[d].primary >= 0 {
+			continue
+		}
+		if r.states[d] == stepWaiting && r.waiting[d] == 0 && r.skipped[d] == len(r.graph.dependencies[d]) {
+			r.skip(d, "every dependency was skipped")
+		}
+	}
+}
+
+// summary counts the step outcomes of the run
+func (r *workflowRun) summary(status commonv1.ProcessingStatus, elapsed time.Duration) *v1.WorkflowExecutionSummary {
+	s := &v1.WorkflowExecutionSummary{
+		OverallStatus:        status,
+		TotalSteps:           int32(len(r.def.Steps)),
+		TotalExecutionTimeMs: elapsed.Milliseconds(),
+		FailureReason:        r.failure,
+	}
+	for _, state := range r.states {
+		switch state {
+		case stepSucceeded:
+			s.SuccessfulSteps++
+		case stepFailed, stepCancelled:
+			s.FailedSteps++
+		case stepSkipped:
+			s.SkippedSteps++
+		}
+	}
+	return s
+}
+
+func addEvent(trace *v1.StepExecutionTrace, eventType, message string, metadata map[string]string) {
+	trace.Events = append(trace.Events, &v1.StepExecutionEvent{
+		EventType:     eventType,
+		EventMessage:  message,
+		EventTime:     timestamppb.Now(),
+		EventMetadata: metadata,
+	})
+}
+
+// errorMessage prefers the message of a kratos error over its full string
+func errorMessage(err error) string {
+	if se := new(errors.Error); errors.As(err, &se) {
+		return se.Message
+	}
+	return err.Error()
+}
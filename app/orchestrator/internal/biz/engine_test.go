@@ -0,0 +1,356 @@
+package biz
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	commonv1 "rag/api/common/v1"
+	v1 "rag/api/orchestrator/v1"
+
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/log"
+	"google.golang.org/protobuf/types/known/anypb"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+// stepHandler serves one fake service method
+type stepHandler func(ctx context.Context, input map[string]*anypb.Any) (map[string]*anypb.Any, error)
+
+// fakeServices is a ServiceRepo whose methods are handlers keyed by
+// "service.method". It records the calls and how many ran at once.
+type fakeServices struct {
+	mu       sync.Mutex
+	handlers map[string]stepHandler
+	calls    []string
+	running  int
+	peak     int
+}
+
+func newFakeServices(handlers map[string]stepHandler) *fakeServices {
+	return &fakeServices{handlers: handlers}
+}
+
+func (f *fakeServices) Call(ctx context.Context, service, method string, input map[string]*anypb.Any) (map[string]*anypb.Any, error) {
+	key := service + "." + method
+	f.mu.Lock()
+	h, ok := f.handlers[key]
+	f.calls = append(f.calls, key)
+	f.running++
+	f.peak = max(f.peak, f.running)
+	f.mu.Unlock()
+	defer func() {
+		f.mu.Lock()
+		f.running--
+		f.mu.Unlock()
+	}()
+	if !ok {
+		return nil, errors.NotFound("METHOD_NOT_FOUND", key)
+	}
+	return h(ctx, input)
+}
+
+func (f *fakeServices) Services() []string {
+	seen := make(map[string]bool)
+	for key := range f.handlers {
+		seen[strings.SplitN(key, ".", 2)[0]] = true
+	}
+	var names []string
+	for name := range seen {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+func (f *fakeServices) Methods(service string) ([]string, bool) {
+	var methods []string
+	for key := range f.handlers {
+		if s, m, _ := strings.Cut(key, "."); s == service {
+			methods = append(methods, m)
+		}
+	}
+	return methods, len(methods) > 0
+}
+
+func (f *fakeServices) CheckHealth(ctx context.Context, service string) (*commonv1.HealthCheckResponse, error) {
+	return &commonv1.HealthCheckResponse{}, nil
+}
+
+func (f *fakeServices) Protection(service string) ServiceProtection {
+	return ServiceProtection{}
+}
+
+// callsOf returns the calls made to key
+func (f *fakeServices) callsOf(key string) int {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	n := 0
+	for _, call := range f.calls {
+		if call == key {
+			n++
+		}
+	}
+	return n
+}
+
+func newTestEngine(services ServiceRepo) *WorkflowEngine {
+	return NewWorkflowEngine(services, nil, log.DefaultLogger)
+}
+
+func packString(t testing.TB, s string) *anypb.Any {
+	t.Helper()
+	a, err := anypb.New(wrapperspb.String(s))
+	if err != nil {
+		t.Fatal(err)
+	}
+	return a
+}
+
+func unpackString(t testing.TB, a *anypb.Any) string {
+	t.Helper()
+	if a == nil {
+		return "<nil>"
+	}
+	var s wrapperspb.StringValue
+	if err := a.UnmarshalTo(&s); err != nil {
+		t.Fatal(err)
+	}
+	return s.Value
+}
+
+// echo returns its "in" input, prefixed with name, as "out"
+func echo(t testing.TB, name string) stepHandler {
+	return func(ctx context.Context, input map[string]*anypb.Any) (map[string]*anypb.Any, error) {
+		in := ""
+		if a, ok := input["in"]; ok {
+			in = unpackString(t, a)
+		}
+		return map[string]*anypb.Any{"out": packString(t, name+"("+in+")")}, nil
+	}
+}
+
+// blockUntilDone waits for the step to be cancelled
+func blockUntilDone(ctx context.Context, input map[string]*anypb.Any) (map[string]*anypb.Any, error) {
+	<-ctx.Done()
+	return nil, ctx.Err()
+}
+
+func testStep(id, method string, dependsOn ...string) *v1.WorkflowStep {
+	return &v1.WorkflowStep{StepId: id, ServiceName: "svc", MethodName: method, DependsOn: dependsOn}
+}
+
+// diamond is a → (b, c) → d, where d combines the outputs of b and c
+func diamond(t testing.TB, strategy string) *v1.WorkflowDefinition {
+	a := testStep("a", "a")
+	a.InputMapping = map[string]*anypb.Any{"in": packString(t, "$.input.query")}
+	b := testStep("b", "b", "a")
+	b.InputMapping = map[string]*anypb.Any{"in": packString(t, "$.steps.a.output.out")}
+	c := testStep("c", "c", "a")
+	c.InputMapping = map[string]*anypb.Any{"in": packString(t, "$.steps.a.output.out")}
+	d := testStep("d", "d", "b", "c")
+	d.InputMapping = map[string]*anypb.Any{"in": packString(t, "$.steps.b.output.out")}
+	d.OutputMapping = map[string]string{"answer": "$.output.out"}
+	return &v1.WorkflowDefinition{
+		Name:          "diamond",
+		Steps:         []*v1.WorkflowStep{a, b, c, d},
+		Configuration: &v1.WorkflowConfiguration{ExecutionStrategy: strategy},
+	}
+}
+
+func diamondServices(t testing.TB) *fakeServices {
+	return newFakeServices(map[string]stepHandler{
+		"svc.a": echo(t, "a"),
+		"svc.b": echo(t, "b"),
+		"svc.c": echo(t, "c"),
+		"svc.d": echo(t, "d"),
+	})
+}
+
+func stepStatuses(exec *Execution) map[string]commonv1.ProcessingStatus {
+	statuses := make(map[string]commonv1.ProcessingStatus)
+	for _, trace := range exec.Trace.StepTraces {
+		statuses[trace.StepId] = trace.Status
+	}
+	return statuses
+}
+
+func TestExecuteFollowsDependencies(t *testing.T) {
+	services := diamondServices(t)
+	exec, err := newTestEngine(services).Execute(context.Background(), "", diamond(t, StrategyParallel),
+		map[string]*anypb.Any{"query": packString(t, "q")}, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if exec.Status != commonv1.ProcessingStatus_PROCESSING_STATUS_COMPLETED {
+		t.Fatalf("status = %v: %s", exec.Status, exec.Trace.Summary.FailureReason)
+	}
+	if got := unpackString(t, exec.Outputs["answer"]); got != "d(b(a(q)))" {
+		t.Errorf("answer = %s, want d(b(a(q)))", got)
+	}
+	if services.calls[0] != "svc.a" || services.calls[3] != "svc.d" {
+		t.Errorf("calls = %v, want a first and d last", services.calls)
+	}
+	if !strings.HasPrefix(exec.ID, "exec-") || exec.Trace.Summary.SuccessfulSteps != 4 {
+		t.Errorf("id %s, summary %+v", exec.ID, exec.Trace.Summary)
+	}
+}
+
+func TestExecuteStrategies(t *testing.T) {
+	slow := func(ctx context.Context, input map[string]*anypb.Any) (map[string]*anypb.Any, error) {
+		time.Sleep(50 * time.Millisecond)
+		return nil, nil
+	}
+	tests := []struct {
+		strategy string
+		parallel bool
+		peak     int
+	}{
+		{StrategySequential, false, 1},
+		{StrategyParallel, false, 2},
+		{StrategyDAG, false, 1},
+		{StrategyDAG, true, 2},
+	}
+	for _, tt := range tests {
+		services := newFakeServices(map[string]stepHandler{"svc.a": slow, "svc.b": slow, "svc.c": slow, "svc.d": slow})
+		def := diamond(t, tt.strategy)
+		def.Configuration.EnableParallelExecution = tt.parallel
+		exec, err := newTestEngine(services).Execute(context.Background(), "x", def, nil, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if exec.Status != commonv1.ProcessingStatus_PROCESSING_STATUS_COMPLETED {
+			t.Errorf("%s: status = %v", tt.strategy, exec.Status)
+		}
+		if services.peak != tt.peak {
+			t.Errorf("%s (parallel %t): %d steps ran at once, want %d", tt.strategy, tt.parallel, services.peak, tt.peak)
+		}
+	}
+}
+
+func TestExecuteFailFast(t *testing.T) {
+	services := diamondServices(t)
+	services.handlers["svc.b"] = func(ctx context.Context, input map[string]*anypb.Any) (map[string]*anypb.Any, error) {
+		return nil, errors.BadRequest("BAD", "rejected input")
+	}
+	services.handlers["svc.c"] = blockUntilDone
+	exec, err := newTestEngine(services).Execute(context.Background(), "x", diamond(t, StrategyParallel), nil, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if exec.Status != commonv1.ProcessingStatus_PROCESSING_STATUS_FAILED {
+		t.Fatalf("status = %v", exec.Status)
+	}
+	if reason := exec.Trace.Summary.FailureReason; reason != "step b failed: rejected input" {
+		t.Errorf("failure reason = %q", reason)
+	}
+	want := map[string]commonv1.ProcessingStatus{
+		"a": commonv1.ProcessingStatus_PROCESSING_STATUS_COMPLETED,
+		"b": commonv1.ProcessingStatus_PROCESSING_STATUS_FAILED,
+		"c": commonv1.ProcessingStatus_PROCESSING_STATUS_CANCELLED,
+		"d": commonv1.ProcessingStatus_PROCESSING_STATUS_CANCELLED,
+	}
+	if got := stepStatuses(exec); fmt.Sprint(got) != fmt.Sprint(want) {
+		t.Errorf("statuses = %v, want %v", got, want)
+	}
+	if services.callsOf("svc.d") != 0 {
+		t.Error("d ran after b failed")
+	}
+}
+
+func TestExecuteContinueOnError(t *testing.T) {
+	for _, critical := range []bool{false, true} {
+		services := diamondServices(t)
+		services.handlers["svc.b"] = func(ctx context.Context, input map[string]*anypb.Any) (map[string]*anypb.Any, error) {
+			return nil, errors.BadRequest("BAD", "rejected input")
+		}
+		def := diamond(t, StrategyParallel)
+		def.Configuration.ErrorHandling = &v1.ErrorHandlingStrategy{Strategy: ErrorContinueOnError}
+		if critical {
+			def.Configuration.ErrorHandling.CriticalSteps = []string{"b"}
+		}
+		exec, err := newTestEngine(services).Execute(context.Background(), "x", def, nil, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		statuses := stepStatuses(exec)
+		if critical {
+			if exec.Status != commonv1.ProcessingStatus_PROCESSING_STATUS_FAILED || !strings.HasPrefix(exec.Trace.Summary.FailureReason, "critical step b failed") {
+				t.Errorf("critical: status %v, reason %q", exec.Status, exec.Trace.Summary.FailureReason)
+			}
+			continue
+		}
+		if exec.Status != commonv1.ProcessingStatus_PROCESSING_STATUS_COMPLETED {
+			t.Errorf("status = %v, want completed", exec.Status)
+		}
+		if statuses["c"] != commonv1.ProcessingStatus_PROCESSING_STATUS_COMPLETED || statuses["d"] != commonv1.ProcessingStatus_PROCESSING_STATUS_SKIPPED {
+			t.Errorf("statuses = %v, want c completed and d skipped", statuses)
+		}
+	}
+}
+
+func TestExecuteTimeouts(t *testing.T) {
+	services := newFakeServices(map[string]stepHandler{"svc.slow": blockUntilDone})
+	step := testStep("slow", "slow")
+	step.StepConfig = &v1.StepConfiguration{TimeoutSeconds: 1}
+	def := &v1.WorkflowDefinition{Name: "timeout", Steps: []*v1.WorkflowStep{step}}
+
+	exec, err := newTestEngine(services).Execute(context.Background(), "x", def, nil, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	trace := exec.Trace.StepTraces[0]
+	if trace.Status != commonv1.ProcessingStatus_PROCESSING_STATUS_FAILED || trace.ErrorMessage != "step timed out after 1s" {
+		t.Errorf("trace = %v %q", trace.Status, trace.ErrorMessage)
+	}
+
+	// 工作流超时先于步骤超时
+	step.StepConfig = nil
+	exec, err = newTestEngine(services).Execute(context.Background(), "x", def, nil, &v1.ExecutionOptions{TimeoutSeconds: 1})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if exec.Status != commonv1.ProcessingStatus_PROCESSING_STATUS_FAILED || exec.Trace.Summary.FailureReason != "workflow timed out after 1s" {
+		t.Errorf("status %v, reason %q", exec.Status, exec.Trace.Summary.FailureReason)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	time.AfterFunc(10*time.Millisecond, cancel)
+	exec, err = newTestEngine(services).Execute(ctx, "x", def, nil, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if exec.Status != commonv1.ProcessingStatus_PROCESSING_STATUS_CANCELLED || exec.Trace.StepTraces[0].Status != commonv1.ProcessingStatus_PROCESSING_STATUS_CANCELLED {
+		t.Errorf("cancelled: status %v, step %v", exec.Status, exec.Trace.StepTraces[0].Status)
+	}
+}
+
+func TestValidateRejectsInvalidGraphs(t *testing.T) {
+	engine := newTestEngine(diamondServices(t))
+	tests := map[string][]*v1.WorkflowStep{
+		"dependency cycle: b -> c -> b": {testStep("a", "a"), testStep("b", "b", "c"), testStep("c", "c", "b")},
+		"depends on itself":             {testStep("a", "a", "a")},
+		"unknown step":                  {testStep("a", "a", "missing")},
+		"duplicate step_id":             {testStep("a", "a"), testStep("a", "b")},
+		"has no step_id":                {testStep("", "a")},
+		"unknown method svc.nope":       {testStep("a", "nope")},
+	}
+	for want, steps := range tests {
+		err := engine.Validate(&v1.WorkflowDefinition{Name: "w", Steps: steps})
+		if err == nil || !strings.Contains(errors.FromError(err).Message, want) {
+			t.Errorf("Validate = %v, want %q", err, want)
+		}
+	}
+	if err := engine.Validate(&v1.WorkflowDefinition{Name: "w"}); err == nil {
+		t.Error("empty workflow validated")
+	}
+	err := engine.Validate(&v1.WorkflowDefinition{Name: "w", Steps: []*v1.WorkflowStep{testStep("a", "a")},
+		Configuration: &v1.WorkflowConfiguration{ExecutionStrategy: "eventually"}})
+	if err == nil {
+		t.Error("unknown strategy validated")
+	}
+}
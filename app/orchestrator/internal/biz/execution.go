@@ -0,0 +1,723 @@
+package biz
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"net/url"
+	"os"
+	"sort"
+	"sync"
+	"time"
+
+	commonv1 "rag/api/common/v1"
+	v1 "rag/api/orchestrator/v1"
+
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/golang/protobuf/proto"
+	protov2 "google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/anypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// Log levels of WorkflowLog.log_level.
+const (
+	LogDebug = "DEBUG"
+	LogInfo  = "INFO"
+	LogWarn  = "WARN"
+	LogError = "ERROR"
+)
+
+const (
+	// 协作式取消等待执行结束的时间
+	cancelGracePeriod = 5 * time.Second
+	// 完成回调的尝试次数和首次重试间隔
+	callbackAttempts = 3
+	callbackBackoff  = time.Second
+)
+
+var (
+	// ErrExecutionNotFound is returned when an execution does not exist.
+	ErrExecutionNotFound = errors.NotFound(commonv1.ErrorCode_ERROR_CODE_NOT_FOUND.String(), "workflow execution not found")
+	// ErrExecutionFinished is returned when cancelling an execution that already ended.
+	ErrExecutionFinished = errors.Conflict(commonv1.ErrorCode_ERROR_CODE_CONFLICT.String(), "workflow execution already finished")
+	// ErrExecutionConflict is returned when an execution id is reused for a different request.
+	ErrExecutionConflict = errors.Conflict(commonv1.ErrorCode_ERROR_CODE_CONFLICT.String(), "execution_id was already used with different inputs")
+	// ErrInvalidExecutionID is returned for an execution id that cannot be stored.
+	ErrInvalidExecutionID = errors.BadRequest(commonv1.ErrorCode_ERROR_CODE_BAD_REQUEST.String(), "execution_id must be 1-128 letters, digits, '.', '_', ':' or '-'")
+
+	// 服务停止时取消执行的原因，执行保持运行状态以便重启后恢复
+	errShutdown = errors.New(503, commonv1.ErrorCode_ERROR_CODE_SERVICE_UNAVAILABLE.String(), "orchestrator shutting down")
+)
+
+// ExecutionRecord is the persisted state of an asynchronous execution. The
+// definition is kept with it, so the execution resumes as it started even if
+// the stored definition changes. Checkpoints hold the steps that succeeded
+// while the execution is unfinished. RequestHash fingerprints the definition
+// id and inputs, to tell a retried request from a different one.
+type ExecutionRecord struct {
+	ID              string
+	RequestHash     string
+	DefinitionID    string
+	Definition      *v1.WorkflowDefinition
+	Input           map[string]*anypb.Any
+	Options         *v1.ExecutionOptions
+	CallbackURL     string
+	Priority        int32
+	Status          commonv1.ProcessingStatus
+	Outputs         map[string]*anypb.Any
+	Trace           *v1.WorkflowExecutionTrace
+	Checkpoints     map[string]*StepCheckpoint
+	Logs            []*v1.WorkflowLog
+	CancelReason    string
+	CallbackPending bool
+	ExecutorID      string
+	CreatedAt       time.Time
+	StartedAt       time.Time
+	CompletedAt     time.Time
+}
+
+// ExecutionRepo persists asynchronous executions.
+type ExecutionRepo interface {
+	// SaveExecution creates or replaces an execution
+	SaveExecution(ctx context.Context, rec *ExecutionRecord) error
+	// GetExecution returns an execution, or ErrExecutionNotFound
+	GetExecution(ctx context.Context, id string) (*ExecutionRecord, error)
+	// ListPendingExecutions returns the executions that are unfinished or
+	// still owe their callback
+	ListPendingExecutions(ctx context.Context) ([]*ExecutionRecord, error)
+	// ExecutionStats returns the statistics of the finished executions of a
+	// definition, by version
+	ExecutionStats(ctx context.Context, definitionID string) (map[string]ExecutionStats, error)
+}
+
+// ExecutionStats sums up the finished executions of a definition version.
+type ExecutionStats struct {
+	Executions int64
+	Succeeded  int64
+	TotalTime  time.Duration
+}
+
+// CallbackRepo notifies callback URLs of finished executions.
+type CallbackRepo interface {
+	// Deliver posts the final status of an execution to url
+	Deliver(ctx context.Context, url string, status *v1.GetWorkflowStatusResponse) error
+}
+
+// activeExecution is an execution running in this process
+type activeExecution struct {
+	mu     sync.Mutex
+	record *ExecutionRecord
+	cancel context.CancelCauseFunc
+	done   chan struct{}
+}
+
+// ExecutionUsecase runs workflows in the background and keeps their state
+// in the execution repo, so clients can poll or cancel them and executions
+// interrupted by a restart resume where they stopped. It assumes a single
+// orchestrator owns the stored executions.
+type ExecutionUsecase struct {
+	engine      *WorkflowEngine
+	definitions DefinitionRepo
+	executions  ExecutionRepo
+	callbacks   CallbackRepo
+	executorID  string
+	log         *log.Helper
+
+	// 服务停止时取消所有执行
+	ctx    context.Context
+	stop   context.CancelCauseFunc
+	wg     sync.WaitGroup
+	mu     sync.Mutex
+	active map[string]*activeExecution
+}
+
+// NewExecutionUsecase creates an execution usecase and resumes the
+// executions the previous process left unfinished
+func NewExecutionUsecase(engine *WorkflowEngine, definitions DefinitionRepo, executions ExecutionRepo, callbacks CallbackRepo, logger log.Logger) (*ExecutionUsecase, func(), error) {
+	ctx, stop := context.WithCancelCause(context.Background())
+	uc := &ExecutionUsecase{
+		engine:      engine,
+		definitions: definitions,
+		executions:  executions,
+		callbacks:   callbacks,
+		executorID:  executorID(),
+		log:         log.NewHelper(logger),
+		ctx:         ctx,
+		stop:        stop,
+		active:      make(map[string]*activeExecution),
+	}
+	pending, err := executions.ListPendingExecutions(ctx)
+	if err != nil {
+		stop(errShutdown)
+		return nil, nil, err
+	}
+	for _, rec := range pending {
+		if finished(rec.Status) {
+			uc.notify(&activeExecution{record: rec})
+			continue
+		}
+		uc.log.Infof("Resuming execution %s with %d completed steps", rec.ID, len(rec.Checkpoints))
+		rec.ExecutorID = uc.executorID
+		uc.mu.Lock()
+		uc.launch(rec)
+		uc.mu.Unlock()
+	}
+	cleanup := func() {
+		uc.stop(errShutdown)
+		uc.wg.Wait()
+	}
+	return uc, cleanup, nil
+}
+
+func executorID() string {
+	host, err := os.Hostname()
+	if err != nil {
+		host = "orchestrator"
+	}
+	return fmt.Sprintf("%s-%d", host, os.Getpid())
+}
+
+func finished(status commonv1.ProcessingStatus) bool {
+	switch status {
+	case commonv1.ProcessingStatus_PROCESSING_STATUS_COMPLETED,
+		commonv1.ProcessingStatus_PROCESSING_STATUS_FAILED,
+		commonv1.ProcessingStatus_PROCESSING_STATUS_CANCELLED:
+		return true
+	}
+	return false
+}
+
+// Execute runs a stored workflow and waits for it to finish. An execution id
+// makes the call idempotent: a finished execution with that id returns its
+// stored result and a running one is waited for instead of started twice,
+// while reusing the id with other inputs is rejected. The execution goes on
+// in the background when the caller stops waiting, so calling again with its
+// id picks up the result.
+func (uc *ExecutionUsecase) Execute(ctx context.Context, req *v1.ExecuteWorkflowRequest) (*v1.ExecuteWorkflowResponse, error) {
+	if req.ExecutionId != "" && !validID(req.ExecutionId) {
+		return nil, ErrInvalidExecutionID
+	}
+	hash := requestHash(req.WorkflowDefinitionId, req.InputParameters)
+	uc.mu.Lock()
+	a, err := uc.attach(ctx, req.ExecutionId, hash)
+	if err == nil && a == nil {
+		a, err = uc.create(ctx, &ExecutionRecord{
+			ID:           req.ExecutionId,
+			RequestHash:  hash,
+			DefinitionID: req.WorkflowDefinitionId,
+			Input:        req.InputParameters,
+			Options:      req.Options,
+		})
+	}
+	uc.mu.Unlock()
+	if err != nil {
+		return nil, err
+	}
+
+	select {
+	case <-a.done:
+	case <-ctx.Done():
+		// 调用方不再等待，执行在后台继续
+	}
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	status := statusResponse(a.record, true, false)
+	return &v1.ExecuteWorkflowResponse{
+		ExecutionId:    a.record.ID,
+		Status:         status.Status,
+		OutputResults:  status.OutputResults,
+		Metadata:       status.Metadata,
+		ExecutionTrace: status.ExecutionTrace,
+	}, nil
+}
+
+// attach finds the execution a request with the given id and fingerprint
+// refers to. It returns nil when the execution does not exist yet. The
+// caller holds uc.mu, so two requests with one id cannot both create it.
+func (uc *ExecutionUsecase) attach(ctx context.Context, id, hash string) (*activeExecution, error) {
+	if id == "" {
+		return nil, nil
+	}
+	a, ok := uc.active[id]
+	if !ok {
+		rec, err := uc.executions.GetExecution(ctx, id)
+		if errors.Is(err, ErrExecutionNotFound) {
+			return nil, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+		// 已结束或不在本进程运行的执行直接返回其记录
+		done := make(chan struct{})
+		close(done)
+		a = &activeExecution{record: rec, done: done}
+	}
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	if a.record.RequestHash != hash {
+		return nil, ErrExecutionConflict.WithMetadata(map[string]string{"execution_id": id})
+	}
+	uc.log.WithContext(ctx).Infof("Request attached to execution %s (%s)", id, a.record.Status)
+	return a, nil
+}
+
+// ExecuteAsync stores a new execution of a stored definition and starts it
+// in the background. The estimated completion is the workflow timeout, the
+// longest the execution may take.
+func (uc *ExecutionUsecase) ExecuteAsync(ctx context.Context, req *v1.ExecuteWorkflowAsyncRequest) (*v1.ExecuteWorkflowAsyncResponse, error) {
+	if req.CallbackUrl != "" {
+		if u, err := url.Parse(req.CallbackUrl); err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+			return nil, errors.BadRequest(commonv1.ErrorCode_ERROR_CODE_BAD_REQUEST.String(), "callback_url must be an absolute http or https URL")
+		}
+	}
+	uc.mu.Lock()
+	a, err := uc.create(ctx, &ExecutionRecord{
+		RequestHash:     requestHash(req.WorkflowDefinitionId, req.InputParameters),
+		DefinitionID:    req.WorkflowDefinitionId,
+		Input:           req.InputParameters,
+		Options:         req.Options,
+		CallbackURL:     req.CallbackUrl,
+		Priority:        req.Priority,
+		CallbackPending: req.CallbackUrl != "",
+	})
+	uc.mu.Unlock()
+	if err != nil {
+		return nil, err
+	}
+	rec := a.record
+	return &v1.ExecuteWorkflowAsyncResponse{
+		ExecutionId:                rec.ID,
+		Status:                     commonv1.ProcessingStatus_PROCESSING_STATUS_PENDING,
+		EstimatedCompletionSeconds: int32(workflowTimeout(rec.Definition, req.Options).Seconds()),
+		CreatedAt:                  timestamppb.New(rec.CreatedAt),
+	}, nil
+}
+
+// create looks up and validates the definition of rec, stores rec as a new
+// pending execution and starts it. The definition id may pin a version as
+// id@version, otherwise the default version runs. The caller holds uc.mu.
+func (uc *ExecutionUsecase) create(ctx context.Context, rec *ExecutionRecord) (*activeExecution, error) {
+	id, def, err := resolveDefinition(ctx, uc.definitions, rec.DefinitionID)
+	if err != nil {
+		return nil, err
+	}
+	rec.DefinitionID = id
+	if err := uc.engine.Validate(def); err != nil {
+		return nil, err
+	}
+	if rec.ID == "" {
+		rec.ID = newExecutionID()
+	}
+	rec.Definition = def
+	rec.Status = commonv1.ProcessingStatus_PROCESSING_STATUS_PENDING
+	rec.Checkpoints = make(map[string]*StepCheckpoint)
+	rec.ExecutorID = uc.executorID
+	rec.CreatedAt = time.Now()
+	addLog(rec, LogInfo, fmt.Sprintf("execution of workflow %s accepted", def.Name))
+	if err := uc.executions.SaveExecution(ctx, rec); err != nil {
+		return nil, err
+	}
+	uc.log.WithContext(ctx).Infof("Execution %s of workflow %s started", rec.ID, def.Name)
+	return uc.launch(rec), nil
+}
+
+// launch runs rec in its own goroutine. The caller holds uc.mu.
+func (uc *ExecutionUsecase) launch(rec *ExecutionRecord) *activeExecution {
+	ctx, cancel := context.WithCancelCause(uc.ctx)
+	a := &activeExecution{record: rec, cancel: cancel, done: make(chan struct{})}
+	uc.active[rec.ID] = a
+	uc.wg.Add(1)
+	go func() {
+		defer uc.wg.Done()
+		defer cancel(nil)
+		ended := uc.run(ctx, a)
+		uc.mu.Lock()
+		delete(uc.active, rec.ID)
+		uc.mu.Unlock()
+		close(a.done)
+		if ended {
+			uc.deliver(a)
+		}
+	}()
+	return a
+}
+
+// validID reports whether a caller-chosen execution or definition id is usable
+func validID(id string) bool {
+	if len(id) > 128 {
+		return false
+	}
+	for _, c := range id {
+		switch {
+		case c >= 'a' && c <= 'z', c >= 'A' && c <= 'Z', c >= '0' && c <= '9', c == '.', c == '_', c == ':', c == '-':
+		default:
+			return false
+		}
+	}
+	return id != "." && id != ".."
+}
+
+// requestHash fingerprints a definition id and the inputs. Values are
+// re-encoded deterministically when their type is known, so the same inputs
+// hash alike however the client serialized them.
+func requestHash(definitionID string, input map[string]*anypb.Any) string {
+	h := sha256.New()
+	h.Write([]byte(definitionID))
+	keys := make([]string, 0, len(input))
+	for k := range input {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	for _, k := range keys {
+		v := input[k]
+		value := v.GetValue()
+		if msg, err := v.UnmarshalNew(); err == nil {
+			if b, err := (protov2.MarshalOptions{Deterministic: true}).Marshal(msg); err == nil {
+				value = b
+			}
+		}
+		fmt.Fprintf(h, "\x00%s\x00%s\x00%d:", k, v.GetTypeUrl(), len(value))
+		h.Write(value)
+	}
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+// run executes the workflow of a and stores the outcome. Every step that
+// succeeds is stored as a checkpoint on the way. It reports whether the
+// execution ended here; one stopped by shutdown stays unfinished.
+func (uc *ExecutionUsecase) run(ctx context.Context, a *activeExecution) bool {
+	rec := a.record
+	a.mu.Lock()
+	rec.Status = commonv1.ProcessingStatus_PROCESSING_STATUS_PROCESSING
+	if rec.StartedAt.IsZero() {
+		rec.StartedAt = time.Now()
+		addLog(rec, LogInfo, "execution started")
+	} else {
+		addLog(rec, LogInfo, fmt.Sprintf("execution resumed with %d completed steps", len(rec.Checkpoints)))
+	}
+	resume := make(map[string]*StepCheckpoint, len(rec.Checkpoints))
+	for id, cp := range rec.Checkpoints {
+		resume[id] = cp
+	}
+	uc.save(rec)
+	a.mu.Unlock()
+
+	hooks := &runHooks{
+		resume: resume,
+		checkpoint: func(stepID string, cp *StepCheckpoint) {
+			a.mu.Lock()
+			defer a.mu.Unlock()
+			if finished(rec.Status) {
+				return
+			}
+			rec.Checkpoints[stepID] = cp
+			uc.save(rec)
+		},
+	}
+	execution, err := uc.engine.execute(ctx, rec.ID, rec.Definition, rec.Input, rec.Options, hooks)
+	if err == nil && execution.Status == commonv1.ProcessingStatus_PROCESSING_STATUS_CANCELLED && errors.Is(context.Cause(ctx), errShutdown) {
+		uc.log.Infof("Execution %s interrupted by shutdown, it resumes on restart", rec.ID)
+		return false
+	}
+
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	if finished(rec.Status) {
+		// 已被强制取消
+		return false
+	}
+	rec.CompletedAt = time.Now()
+	rec.Checkpoints = nil
+	if err != nil {
+		// 定义在启动时已校验，仅在恢复的旧定义不再有效时发生
+		rec.Status = commonv1.ProcessingStatus_PROCESSING_STATUS_FAILED
+		rec.Trace = &v1.WorkflowExecutionTrace{
+			ExecutionId: rec.ID,
+			Summary: &v1.WorkflowExecutionSummary{
+				OverallStatus: rec.Status,
+				TotalSteps:    int32(len(rec.Definition.GetSteps())),
+				FailureReason: errorMessage(err),
+			},
+		}
+	} else {
+		rec.Status = execution.Status
+		rec.Outputs = execution.Outputs
+		rec.Trace = execution.Trace
+	}
+	switch reason := rec.Trace.GetSummary().GetFailureReason(); rec.Status {
+	case commonv1.ProcessingStatus_PROCESSING_STATUS_COMPLETED:
+		addLog(rec, LogInfo, fmt.Sprintf("execution completed in %dms", rec.CompletedAt.Sub(rec.StartedAt).Milliseconds()))
+	case commonv1.ProcessingStatus_PROCESSING_STATUS_CANCELLED:
+		addLog(rec, LogWarn, reason)
+	default:
+		addLog(rec, LogError, reason)
+	}
+	uc.save(rec)
+	return true
+}
+
+// save stores rec, logging failures: the run goes on and the next save
+// catches up
+func (uc *ExecutionUsecase) save(rec *ExecutionRecord) {
+	if err := uc.executions.SaveExecution(uc.ctx, rec); err != nil {
+		uc.log.Errorf("Failed to save execution %s: %v", rec.ID, err)
+	}
+}
+
+// GetStatus returns the state of an execution. While it runs, the trace
+// holds the steps completed so far.
+func (uc *ExecutionUsecase) GetStatus(ctx context.Context, req *v1.GetWorkflowStatusRequest) (*v1.GetWorkflowStatusResponse, error) {
+	uc.mu.Lock()
+	a, ok := uc.active[req.WorkflowId]
+	uc.mu.Unlock()
+	if ok {
+		a.mu.Lock()
+		defer a.mu.Unlock()
+		return statusResponse(a.record, req.IncludeTrace, req.IncludeLogs), nil
+	}
+	rec, err := uc.executions.GetExecution(ctx, req.WorkflowId)
+	if err != nil {
+		return nil, err
+	}
+	return statusResponse(rec, req.IncludeTrace, req.IncludeLogs), nil
+}
+
+// Cancel stops an execution. The cancellation reaches the running steps
+// through their context, and Cancel waits a grace period for the execution
+// to wind down. A forced cancellation marks the execution cancelled at once
+// without waiting for its steps. Executions that are not running here are
+// marked cancelled directly.
+func (uc *ExecutionUsecase) Cancel(ctx context.Context, req *v1.CancelWorkflowRequest) (*v1.CancelWorkflowResponse, error) {
+	reason := req.Reason
+	if reason == "" {
+		reason = "cancelled by request"
+	}
+	uc.mu.Lock()
+	a, running := uc.active[req.WorkflowId]
+	uc.mu.Unlock()
+	if !running {
+		rec, err := uc.executions.GetExecution(ctx, req.WorkflowId)
+		if err != nil {
+			return nil, err
+		}
+		a = &activeExecution{record: rec}
+	}
+
+	a.mu.Lock()
+	if finished(a.record.Status) {
+		a.mu.Unlock()
+		return nil, ErrExecutionFinished.WithMetadata(map[string]string{"status": a.record.Status.String()})
+	}
+	a.record.CancelReason = reason
+	if !running || req.ForceCancel {
+		uc.markCancelled(a.record, reason, req.ForceCancel)
+	}
+	a.mu.Unlock()
+
+	if running {
+		// 取消原因经由 context 传给引擎，记入执行失败原因
+		a.cancel(fmt.Errorf("%s", reason))
+		if !req.ForceCancel {
+			timer := time.NewTimer(cancelGracePeriod)
+			select {
+			case <-a.done:
+			case <-timer.C:
+			case <-ctx.Done():
+			}
+			timer.Stop()
+		}
+	}
+	if !running || req.ForceCancel {
+		uc.notify(a)
+	}
+	uc.log.WithContext(ctx).Infof("Execution %s cancelled (force=%t): %s", req.WorkflowId, req.ForceCancel, reason)
+
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	resp := &v1.CancelWorkflowResponse{
+		WorkflowId:         req.WorkflowId,
+		Status:             a.record.Status,
+		CancellationReason: reason,
+	}
+	if !a.record.CompletedAt.IsZero() {
+		resp.CancelledAt = timestamppb.New(a.record.CompletedAt)
+	}
+	return resp, nil
+}
+
+// markCancelled ends rec as cancelled without waiting for its run. The trace
+// keeps the steps completed so far.
+func (uc *ExecutionUsecase) markCancelled(rec *ExecutionRecord, reason string, force bool) {
+	rec.Status = commonv1.ProcessingStatus_PROCESSING_STATUS_CANCELLED
+	rec.CompletedAt = time.Now()
+	failure := "workflow cancelled: " + reason
+	if force {
+		failure = "workflow force-cancelled: " + reason
+	}
+	rec.Trace = partialTrace(rec)
+	rec.Trace.Summary = &v1.WorkflowExecutionSummary{
+		OverallStatus:   rec.Status,
+		TotalSteps:      int32(len(rec.Definition.GetSteps())),
+		SuccessfulSteps: int32(len(rec.Checkpoints)),
+		FailureReason:   failure,
+	}
+	if !rec.StartedAt.IsZero() {
+		rec.Trace.Summary.TotalExecutionTimeMs = rec.CompletedAt.Sub(rec.StartedAt).Milliseconds()
+	}
+	rec.Checkpoints = nil
+	addLog(rec, LogWarn, failure)
+	uc.save(rec)
+}
+
+// deliver posts the final status of a to its callback URL, retrying with
+// backoff. A callback that still fails stays pending and is retried when the
+// orchestrator restarts.
+func (uc *ExecutionUsecase) deliver(a *activeExecution) {
+	a.mu.Lock()
+	rec := a.record
+	if !rec.CallbackPending {
+		a.mu.Unlock()
+		return
+	}
+	callbackURL := rec.CallbackURL
+	status := statusResponse(rec, true, false)
+	a.mu.Unlock()
+
+	backoff := callbackBackoff
+	var err error
+	for n := 1; n <= callbackAttempts; n++ {
+		if err = uc.callbacks.Deliver(uc.ctx, callbackURL, status); err == nil {
+			break
+		}
+		uc.log.Warnf("Callback of execution %s failed (attempt %d): %v", rec.ID, n, err)
+		if n == callbackAttempts {
+			break
+		}
+		timer := time.NewTimer(backoff)
+		select {
+		case <-timer.C:
+		case <-uc.ctx.Done():
+			timer.Stop()
+			return
+		}
+		backoff *= 2
+	}
+
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	if err != nil {
+		addLog(rec, LogError, "callback failed: "+errorMessage(err))
+	} else {
+		rec.CallbackPending = false
+		addLog(rec, LogInfo, "callback delivered to "+callbackURL)
+	}
+	uc.save(rec)
+}
+
+// notify delivers the callback of a in the background
+func (uc *ExecutionUsecase) notify(a *activeExecution) {
+	uc.wg.Add(1)
+	go func() {
+		defer uc.wg.Done()
+		uc.deliver(a)
+	}()
+}
+
+// statusResponse builds the status of rec
+func statusResponse(rec *ExecutionRecord, includeTrace, includeLogs bool) *v1.GetWorkflowStatusResponse {
+	metadata := &v1.WorkflowExecutionMetadata{
+		WorkflowDefinitionId: rec.DefinitionID,
+		WorkflowVersion:      rec.Definition.GetVersion(),
+		ExecutorId:           rec.ExecutorID,
+		ExecutionContext:     rec.Options.GetExecutionContext(),
+		CreatedAt:            timestamppb.New(rec.CreatedAt),
+	}
+	if !rec.StartedAt.IsZero() {
+		metadata.StartedAt = timestamppb.New(rec.StartedAt)
+	}
+	if !rec.CompletedAt.IsZero() {
+		metadata.CompletedAt = timestamppb.New(rec.CompletedAt)
+	}
+	resp := &v1.GetWorkflowStatusResponse{
+		WorkflowId:    rec.ID,
+		Status:        rec.Status,
+		Metadata:      metadata,
+		OutputResults: rec.Outputs,
+	}
+	trace := rec.Trace
+	if trace == nil {
+		trace = partialTrace(rec)
+	}
+	if includeTrace {
+		resp.ExecutionTrace = proto.Clone(trace).(*v1.WorkflowExecutionTrace)
+	}
+	if includeLogs {
+		resp.Logs = executionLogs(rec, trace)
+	}
+	return resp
+}
+
+// partialTrace lists the checkpointed steps of an unfinished execution in
+// definition order
+func partialTrace(rec *ExecutionRecord) *v1.WorkflowExecutionTrace {
+	trace := &v1.WorkflowExecutionTrace{ExecutionId: rec.ID}
+	for _, step := range rec.Definition.GetSteps() {
+		if cp, ok := rec.Checkpoints[step.StepId]; ok && cp.Trace != nil {
+			trace.StepTraces = append(trace.StepTraces, cp.Trace)
+		}
+	}
+	return trace
+}
+
+// executionLogs merges the execution's own log entries with the step events
+// of its trace, oldest first
+func executionLogs(rec *ExecutionRecord, trace *v1.WorkflowExecutionTrace) []*v1.WorkflowLog {
+	logs := make([]*v1.WorkflowLog, 0, len(rec.Logs))
+	for _, l := range rec.Logs {
+		logs = append(logs, proto.Clone(l).(*v1.WorkflowLog))
+	}
+	add := func(traces []*v1.StepExecutionTrace, prefix string) {
+		for _, st := range traces {
+			for _, e := range st.Events {
+				logs = append(logs, &v1.WorkflowLog{
+					LogLevel:  eventLevel(e.EventType),
+					Message:   prefix + e.EventMessage,
+					StepId:    st.StepId,
+					Timestamp: e.EventTime,
+					Metadata:  e.EventMetadata,
+				})
+			}
+		}
+	}
+	add(trace.GetStepTraces(), "")
+	add(trace.GetCompensationTraces(), "compensation: ")
+	sort.SliceStable(logs, func(i, j int) bool {
+		return logs[i].Timestamp.AsTime().Before(logs[j].Timestamp.AsTime())
+	})
+	return logs
+}
+
+// eventLevel is the log level of a step event type
+func eventLevel(eventType string) string {
+	switch eventType {
+	case EventFailed, EventTimeout:
+		return LogError
+	case EventRetry, EventFallback, EventSkipped, EventCancelled:
+		return LogWarn
+	case EventStarted, EventCondition:
+		return LogDebug
+	default:
+		return LogInfo
+	}
+}
+
+func addLog(rec *ExecutionRecord, level, message string) {
+	rec.Logs = append(rec.Logs, &v1.WorkflowLog{
+		LogLevel:  level,
+		Message:   message,
+		Timestamp: timestamppb.Now(),
+	})
+}
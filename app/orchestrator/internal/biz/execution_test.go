@@ -0,0 +1,134 @@
+package biz
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	commonv1 "rag/api/common/v1"
+	v1 "rag/api/orchestrator/v1"
+
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/log"
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+// newTestExecutions runs the diamond workflow as the stored definition
+// "diamond"; its first step waits for release before answering
+func newTestExecutions(t *testing.T, release <-chan struct{}) (*ExecutionUsecase, *fakeServices) {
+	t.Helper()
+	services := diamondServices(t)
+	a := services.handlers["svc.a"]
+	services.handlers["svc.a"] = func(ctx context.Context, input map[string]*anypb.Any) (map[string]*anypb.Any, error) {
+		select {
+		case <-release:
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+		return a(ctx, input)
+	}
+	engine := newTestEngine(services)
+	definitions := newMemoryDefinitions()
+	uc := NewDefinitionUsecase(engine, definitions, newMemoryExecutions(), log.DefaultLogger)
+	if _, err := uc.CreateDefinition(context.Background(), &v1.CreateWorkflowDefinitionRequest{Definition: diamond(t, "")}); err != nil {
+		t.Fatal(err)
+	}
+	executions, cleanup, err := NewExecutionUsecase(engine, definitions, newMemoryExecutions(), nil, log.DefaultLogger)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(cleanup)
+	return executions, services
+}
+
+func executeRequest(t testing.TB, id, query string) *v1.ExecuteWorkflowRequest {
+	return &v1.ExecuteWorkflowRequest{
+		WorkflowDefinitionId: "diamond",
+		ExecutionId:          id,
+		InputParameters:      map[string]*anypb.Any{"query": packString(t, query)},
+	}
+}
+
+func TestExecuteIdempotency(t *testing.T) {
+	ctx := context.Background()
+	release := make(chan struct{})
+	close(release)
+	uc, services := newTestExecutions(t, release)
+	first, err := uc.Execute(ctx, executeRequest(t, "run-1", "q"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if first.Status != commonv1.ProcessingStatus_PROCESSING_STATUS_COMPLETED {
+		t.Fatalf("status = %v", first.Status)
+	}
+
+	tests := []struct {
+		name   string
+		req    *v1.ExecuteWorkflowRequest
+		reason string
+	}{
+		// 相同的 id 和输入返回已保存的结果，不再执行
+		{"replay", executeRequest(t, "run-1", "q"), ""},
+		{"other inputs", executeRequest(t, "run-1", "other"), commonv1.ErrorCode_ERROR_CODE_CONFLICT.String()},
+		{"other workflow", &v1.ExecuteWorkflowRequest{WorkflowDefinitionId: "diamond@1.0.0", ExecutionId: "run-1", InputParameters: executeRequest(t, "", "q").InputParameters}, commonv1.ErrorCode_ERROR_CODE_CONFLICT.String()},
+		{"invalid id", executeRequest(t, "../run-1", "q"), commonv1.ErrorCode_ERROR_CODE_BAD_REQUEST.String()},
+	}
+	for _, tt := range tests {
+		resp, err := uc.Execute(ctx, tt.req)
+		if tt.reason != "" {
+			if errors.Reason(err) != tt.reason {
+				t.Errorf("%s: err = %v, want %s", tt.name, err, tt.reason)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("%s: %v", tt.name, err)
+			continue
+		}
+		if resp.ExecutionId != "run-1" || unpackString(t, resp.OutputResults["answer"]) != unpackString(t, first.OutputResults["answer"]) {
+			t.Errorf("%s: %s answered %s", tt.name, resp.ExecutionId, unpackString(t, resp.OutputResults["answer"]))
+		}
+	}
+	if n := services.callsOf("svc.a"); n != 1 {
+		t.Errorf("svc.a called %d times, want once", n)
+	}
+}
+
+func TestExecuteAttachesToRunningExecution(t *testing.T) {
+	release := make(chan struct{})
+	uc, services := newTestExecutions(t, release)
+
+	// 调用方的截止时间先到，执行在后台继续
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+	resp, err := uc.Execute(ctx, executeRequest(t, "run-2", "q"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if resp.Status != commonv1.ProcessingStatus_PROCESSING_STATUS_PROCESSING {
+		t.Errorf("status after the deadline = %v, want processing", resp.Status)
+	}
+
+	// 同一 id 的重试等待正在运行的执行，而不是再启动一次
+	results := make(chan *v1.ExecuteWorkflowResponse, 2)
+	for i := 0; i < 2; i++ {
+		go func() {
+			resp, err := uc.Execute(context.Background(), executeRequest(t, "run-2", "q"))
+			if err != nil {
+				t.Error(err)
+			}
+			results <- resp
+		}()
+	}
+	time.Sleep(20 * time.Millisecond)
+	close(release)
+	for i := 0; i < 2; i++ {
+		resp := <-results
+		if resp.GetStatus() != commonv1.ProcessingStatus_PROCESSING_STATUS_COMPLETED || unpackString(t, resp.GetOutputResults()["answer"]) != "d(b(a(q)))" {
+			t.Errorf("attached request = %v %s", resp.GetStatus(), unpackString(t, resp.GetOutputResults()["answer"]))
+		}
+	}
+	if n := services.callsOf("svc.a"); n != 1 {
+		t.Errorf("svc.a called %d times, want once", n)
+	}
+}
@@ -0,0 +1,150 @@
+package biz
+
+import (
+	v1 "rag/api/orchestrator/v1"
+
+	"google.golang.org/protobuf/types/known/anypb"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+// Fallback types accepted in FallbackConfiguration.fallback_type.
+const (
+	FallbackDefaultValue    = "default_value"
+	FallbackSkip            = "skip"
+	FallbackAlternativeStep = "alternative_step"
+)
+
+// fallback is what replaces the result of a step that failed for good
+type fallback struct {
+	kind string
+	// default_value 的原始值及其展开的输出字段
+	value  *anypb.Any
+	output map[string]*anypb.Any
+	// alternative_step 的步骤下标
+	alternative int
+}
+
+// stepRecovery is how the engine reacts when a step fails
+type stepRecovery struct {
+	retry    retryPolicy
+	fallback *fallback
+	critical bool
+	// 作为替补步骤时为被替补步骤的下标，否则为 -1
+	primary int
+}
+
+// compileRecovery reads the retry, fallback and error handling settings of
+// the workflow. A default value must be a struct whose fields become the
+// step's output. An alternative step stands by for one step only: it runs
+// in that step's place with its own input mapping and its output becomes
+// that step's output, so it may only depend on that step's upstream steps,
+// nothing may depend on it and it publishes nothing itself.
+func compileRecovery(g *workflowGraph, handling *v1.ErrorHandlingStrategy) ([]*stepRecovery, error) {
+	switch strategy := handling.GetStrategy(); strategy {
+	case "", ErrorFailFast, ErrorContinueOnError, ErrorRetryAll:
+	default:
+		return nil, invalidWorkflow("unknown error handling strategy %q", strategy)
+	}
+	if handling.GetMaxGlobalRetries() < 0 {
+		return nil, invalidWorkflow("max_global_retries is negative")
+	}
+
+	recovery := make([]*stepRecovery, len(g.steps))
+	for i, step := range g.steps {
+		policy, err := compileRetryPolicy(step, handling)
+		if err != nil {
+			return nil, err
+		}
+		recovery[i] = &stepRecovery{retry: policy, primary: -1}
+	}
+	for _, id := range handling.GetCriticalSteps() {
+		i, ok := g.index[id]
+		if !ok {
+			return nil, invalidWorkflow("unknown critical step %q", id)
+		}
+		recovery[i].critical = true
+	}
+
+	for i, step := range g.steps {
+		config := step.GetStepConfig()
+		if !config.GetEnableFallback() {
+			continue
+		}
+		fc := config.GetFallbackConfig()
+		if fc == nil {
+			return nil, invalidWorkflow("step %q enables fallback without a fallback_config", step.StepId)
+		}
+		fb := &fallback{kind: fc.FallbackType}
+		switch fc.FallbackType {
+		case FallbackDefaultValue:
+			output, err := defaultOutput(fc.FallbackValue)
+			if err != nil {
+				return nil, invalidWorkflow("step %q fallback value: %v", step.StepId, err)
+			}
+			fb.value, fb.output = fc.FallbackValue, output
+		case FallbackSkip:
+		case FallbackAlternativeStep:
+			j, err := alternativeStep(g, recovery, i, fc.AlternativeStepId)
+			if err != nil {
+				return nil, err
+			}
+			fb.alternative = j
+			recovery[j].primary = i
+		default:
+			return nil, invalidWorkflow("step %q has unknown fallback type %q", step.StepId, fc.FallbackType)
+		}
+		recovery[i].fallback = fb
+	}
+	for i, rec := range recovery {
+		if rec.primary >= 0 && rec.fallback != nil && rec.fallback.kind == FallbackAlternativeStep {
+			return nil, invalidWorkflow("alternative step %q has an alternative step itself", g.steps[i].StepId)
+		}
+	}
+	return recovery, nil
+}
+
+// alternativeStep checks the alternative of step i
+func alternativeStep(g *workflowGraph, recovery []*stepRecovery, i int, id string) (int, error) {
+	step := g.steps[i]
+	j, ok := g.index[id]
+	switch {
+	case !ok:
+		return 0, invalidWorkflow("step %q falls back to unknown step %q", step.StepId, id)
+	case j == i:
+		return 0, invalidWorkflow("step %q falls back to itself", step.StepId)
+	case recovery[j].primary >= 0:
+		return 0, invalidWorkflow("step %q is the alternative of both %q and %q", id, g.steps[recovery[j].primary].StepId, step.StepId)
+	case len(g.dependents[j]) > 0:
+		return 0, invalidWorkflow("alternative step %q must not have dependents", id)
+	case len(g.steps[j].OutputMapping) > 0 || g.steps[j].Conditional != nil:
+		return 0, invalidWorkflow("alternative step %q must not have an output mapping or a conditional", id)
+	}
+	for _, d := range g.dependencies[j] {
+		if !g.ancestors[i][g.steps[d].StepId] {
+			return 0, invalidWorkflow("alternative step %q depends on %q, which step %q does not", id, g.steps[d].StepId, step.StepId)
+		}
+	}
+	return j, nil
+}
+
+// defaultOutput splits a struct default value into output fields
+func defaultOutput(value *anypb.Any) (map[string]*anypb.Any, error) {
+	output := make(map[string]*anypb.Any)
+	if value == nil {
+		return output, nil
+	}
+	s := new(structpb.Struct)
+	if err := value.UnmarshalTo(s); err != nil {
+		return nil, err
+	}
+	for name, v := range s.AsMap() {
+		a, err := treeToAny(v)
+		if err != nil {
+			return nil, err
+		}
+		if a != nil {
+			output[name] = a
+		}
+	}
+	return output, nil
+}
@@ -0,0 +1,424 @@
+package biz
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+	"unicode"
+
+	commonv1 "rag/api/common/v1"
+	"rag/pkg/tokenizer"
+
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/log"
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+// Reasons a generation stopped, reported as finish_reason.
+const (
+	FinishStop   = "stop"
+	FinishLength = "length"
+)
+
+const (
+	// 提供方未给出时的上下文窗口和生成上限
+	defaultContextWindow = 4096
+	defaultMaxTokens     = 512
+	// 提示词之外至少要留给生成的 token 数
+	minCompletionTokens = 16
+)
+
+// DefaultSystemPrompt is the system prompt of the default workflow.
+const DefaultSystemPrompt = "You answer questions using only the numbered context passages. " +
+	"Cite the passages you use with their markers, such as [1]. " +
+	"If the context does not contain the answer, say that you do not know."
+
+var (
+	// ErrPromptRequired is returned when a generation has no prompt.
+	ErrPromptRequired = errors.BadRequest(commonv1.ErrorCode_ERROR_CODE_BAD_REQUEST.String(), "prompt is required")
+	// ErrPromptTooLong is returned when a prompt leaves no room in the context window to generate.
+	ErrPromptTooLong = errors.BadRequest(commonv1.ErrorCode_ERROR_CODE_BAD_REQUEST.String(), "prompt does not fit the model's context window")
+
+	// 流式生成遇到停止序列时用来中断生成
+	errStopSequence = fmt.Errorf("stop sequence generated")
+)
+
+// GenerationRequest is a prompt to complete. Passages are the texts the
+// prompt was built from, for generators that work on them directly.
+type GenerationRequest struct {
+	Model       string
+	System      string
+	Prompt      string
+	Query       string
+	Passages    []string
+	MaxTokens   int
+	Temperature float32
+	Stop        []string
+}
+
+// Generation is a completed prompt
+type Generation struct {
+	Text             string
+	Model            string
+	FinishReason     string
+	PromptTokens     int
+	CompletionTokens int
+}
+
+// GeneratorInfo describes a generator and the defaults it was configured with
+type GeneratorInfo struct {
+	Provider string
+	Model    string
+	// 上下文窗口和默认的生成参数，零值表示未配置
+	ContextWindow int
+	MaxTokens     int
+	Temperature   float32
+	Stop          []string
+	// 单次生成请求的超时，零值表示未配置
+	Timeout time.Duration
+}
+
+// Generator completes prompts with a language model
+type Generator interface {
+	// 生成补全，遇到停止序列或达到 token 上限时结束
+	Generate(ctx context.Context, req *GenerationRequest) (*Generation, error)
+	// 流式生成补全，每生成一段文本就交给 emit，emit 返回错误时停止生成并返回该错误
+	GenerateStream(ctx context.Context, req *GenerationRequest, emit func(delta string) error) (*Generation, error)
+	// 提供方信息和默认参数
+	Info() GeneratorInfo
+}
+
+// GenerationUsecase generates answers. It backs the generator service that
+// workflow steps call with GeneratorService.GenerateMethod.
+type GenerationUsecase struct {
+	generator Generator
+	tok       tokenizer.Tokenizer
+	log       *log.Helper
+}
+
+// NewGenerationUsecase creates a generation usecase counting tokens with tok
+func NewGenerationUsecase(generator Generator, tok tokenizer.Tokenizer, logger log.Logger) *GenerationUsecase {
+	return &GenerationUsecase{
+		generator: generator,
+		tok:       tok,
+		log:       log.NewHelper(logger),
+	}
+}
+
+// Generate completes req with the generator's defaults filled in. The
+// completion is limited to what the context window leaves after the prompt,
+// and the text is cut at the first stop sequence even when the provider
+// does not support them.
+func (uc *GenerationUsecase) Generate(ctx context.Context, req *GenerationRequest) (*Generation, error) {
+	return uc.generate(ctx, req, nil)
+}
+
+// GenerateStream is Generate handing the text to emit as it is generated.
+// Text that could begin a stop sequence is held back until it does not, so
+// emit never sees a stop sequence or what follows it.
+func (uc *GenerationUsecase) GenerateStream(ctx context.Context, req *GenerationRequest, emit func(delta string) error) (*Generation, error) {
+	return uc.generate(ctx, req, emit)
+}
+
+func (uc *GenerationUsecase) generate(ctx context.Context, req *GenerationRequest, emit func(delta string) error) (*Generation, error) {
+	if strings.TrimSpace(req.Prompt) == "" {
+		return nil, ErrPromptRequired
+	}
+	info := uc.generator.Info()
+	r := *req
+	if r.Model == "" {
+		r.Model = info.Model
+	}
+	if r.MaxTokens <= 0 {
+		r.MaxTokens = info.MaxTokens
+	}
+	if r.MaxTokens <= 0 {
+		r.MaxTokens = defaultMaxTokens
+	}
+	if r.Temperature <= 0 {
+		r.Temperature = info.Temperature
+	}
+	r.Stop = mergeStop(info.Stop, req.Stop)
+
+	window := info.ContextWindow
+	if window <= 0 {
+		window = defaultContextWindow
+	}
+	promptTokens := CountTokens(uc.tok, r.System) + CountTokens(uc.tok, r.Prompt)
+	if left := window - promptTokens; left < r.MaxTokens {
+		if left < minCompletionTokens {
+			return nil, ErrPromptTooLong.WithMetadata(map[string]string{
+				"prompt_tokens":  fmt.Sprint(promptTokens),
+				"context_window": fmt.Sprint(window),
+			})
+		}
+		r.MaxTokens = left
+	}
+
+	var (
+		gen *Generation
+		err error
+	)
+	if emit == nil {
+		gen, err = uc.generator.Generate(ctx, &r)
+	} else {
+		w := &stopWriter{emit: emit, stop: r.Stop}
+		gen, err = uc.generator.GenerateStream(ctx, &r, w.write)
+		switch {
+		case w.stopped:
+			// 生成在停止序列处被中断，答案为之前的文本
+			gen, err = &Generation{Text: w.text.String(), FinishReason: FinishStop}, nil
+		case err == nil:
+			err = w.flush()
+		}
+	}
+	if err != nil {
+		return nil, err
+	}
+	if text, cut := cutAtStop(gen.Text, r.Stop); cut {
+		gen.Text, gen.FinishReason = text, FinishStop
+	}
+	if gen.Model == "" {
+		gen.Model = r.Model
+	}
+	if gen.PromptTokens == 0 {
+		gen.PromptTokens = promptTokens
+	}
+	if gen.CompletionTokens == 0 {
+		gen.CompletionTokens = CountTokens(uc.tok, gen.Text)
+	}
+	uc.log.WithContext(ctx).Infof("Generated %d tokens with %s %s, finished by %s",
+		gen.CompletionTokens, info.Provider, gen.Model, gen.FinishReason)
+	return gen, nil
+}
+
+// answerStreamKey carries the function that receives the answers generated
+// under a context as they are produced
+type answerStreamKey struct{}
+
+// withAnswerStream makes the generator steps run under ctx stream their
+// answers to emit
+func withAnswerStream(ctx context.Context, emit func(delta string) error) context.Context {
+	return context.WithValue(ctx, answerStreamKey{}, emit)
+}
+
+// call serves GeneratorService.GenerateMethod to workflow steps. The input
+// has the prompt, usually the context the assembler rendered from its
+// template, with an optional system_prompt, query, passages and options
+// (model, max_tokens, temperature, stop). The output has the answer, the
+// model, finish_reason and the token counts. Under a context with an answer
+// stream the answer is streamed as well.
+func (uc *GenerationUsecase) call(ctx context.Context, method string, input map[string]*anypb.Any) (map[string]*anypb.Any, error) {
+	if method != GenerateMethod {
+		return nil, ErrMethodNotRegistered.WithMetadata(map[string]string{"service": GeneratorService, "method": method})
+	}
+	req, err := generationRequest(input)
+	if err != nil {
+		return nil, errors.BadRequest(commonv1.ErrorCode_ERROR_CODE_BAD_REQUEST.String(),
+			fmt.Sprintf("invalid input for %s.%s: %v", GeneratorService, GenerateMethod, err))
+	}
+	emit, _ := ctx.Value(answerStreamKey{}).(func(string) error)
+	gen, err := uc.generate(ctx, req, emit)
+	if err != nil {
+		return nil, err
+	}
+	output := make(map[string]*anypb.Any, 5)
+	for name, value := range map[string]any{
+		"answer":            gen.Text,
+		"model":             gen.Model,
+		"finish_reason":     gen.FinishReason,
+		"prompt_tokens":     float64(gen.PromptTokens),
+		"completion_tokens": float64(gen.CompletionTokens),
+	} {
+		if output[name], err = treeToAny(value); err != nil {
+			return nil, err
+		}
+	}
+	return output, nil
+}
+
+// timeout is how long one generation may take, zero when not configured
+func (uc *GenerationUsecase) timeout() time.Duration {
+	if uc == nil {
+		return 0
+	}
+	return uc.generator.Info().Timeout
+}
+
+func (uc *GenerationUsecase) methods() []string {
+	return []string{GenerateMethod}
+}
+
+// generationRequest reads the step input of a generation
+func generationRequest(input map[string]*anypb.Any) (*GenerationRequest, error) {
+	tree := make(map[string]any, len(input))
+	for name, value := range input {
+		v, err := anyToTree(value)
+		if err != nil {
+			return nil, fmt.Errorf("field %s: %w", name, err)
+		}
+		tree[name] = v
+	}
+	var (
+		req GenerationRequest
+		err error
+	)
+	str := func(v any, name string) string {
+		if err != nil || v == nil {
+			return ""
+		}
+		s, ok := v.(string)
+		if !ok {
+			err = fmt.Errorf("%s must be a string, got %s", name, typeName(v))
+		}
+		return s
+	}
+	strs := func(v any, name string) []string {
+		if err != nil || v == nil {
+			return nil
+		}
+		items, ok := v.([]any)
+		if !ok {
+			err = fmt.Errorf("%s must be a list, got %s", name, typeName(v))
+			return nil
+		}
+		out := make([]string, 0, len(items))
+		for _, item := range items {
+			if s := str(item, name); s != "" {
+				out = append(out, s)
+			}
+		}
+		return out
+	}
+	req.Prompt = str(tree["prompt"], "prompt")
+	req.System = str(tree["system_prompt"], "system_prompt")
+	req.Query = str(tree["query"], "query")
+	req.Passages = strs(tree["passages"], "passages")
+	if tree["options"] != nil {
+		options, ok := tree["options"].(map[string]any)
+		if !ok {
+			return nil, fmt.Errorf("options must be an object, got %s", typeName(tree["options"]))
+		}
+		req.Model = str(options["model"], "options.model")
+		req.Stop = strs(options["stop"], "options.stop")
+		if n, ok := options["max_tokens"].(float64); ok {
+			req.MaxTokens = int(n)
+		}
+		if t, ok := options["temperature"].(float64); ok {
+			req.Temperature = float32(t)
+		}
+	}
+	return &req, err
+}
+
+// mergeStop joins the configured and requested stop sequences, dropping
+// empty and repeated ones
+func mergeStop(lists ...[]string) []string {
+	var out []string
+	seen := make(map[string]bool)
+	for _, list := range lists {
+		for _, s := range list {
+			if s != "" && !seen[s] {
+				seen[s] = true
+				out = append(out, s)
+			}
+		}
+	}
+	return out
+}
+
+// cutAtStop cuts text before the earliest stop sequence
+func cutAtStop(text string, stop []string) (string, bool) {
+	end := -1
+	for _, s := range stop {
+		if i := strings.Index(text, s); i >= 0 && (end < 0 || i < end) {
+			end = i
+		}
+	}
+	if end < 0 {
+		return text, false
+	}
+	return strings.TrimRightFunc(text[:end], unicode.IsSpace), true
+}
+
+// stopWriter passes streamed text on to emit, holding back the end of the
+// text while it could be the beginning of a stop sequence or the whitespace
+// cut before one
+type stopWriter struct {
+	emit func(delta string) error
+	stop []string
+	// 已收到的文本，以及其中已发送的字节数
+	text    strings.Builder
+	sent    int
+	stopped bool
+}
+
+// write takes the next piece of text. It returns errStopSequence once a stop
+// sequence is complete, after emitting the text before it.
+func (w *stopWriter) write(delta string) error {
+	if w.stopped {
+		return errStopSequence
+	}
+	w.text.WriteString(delta)
+	text := w.text.String()
+	if before, cut := cutAtStop(text, w.stop); cut {
+		w.stopped = true
+		w.text.Reset()
+		w.text.WriteString(before)
+		if len(before) > w.sent {
+			if err := w.emit(before[w.sent:]); err != nil {
+				return err
+			}
+		}
+		return errStopSequence
+	}
+	end := len(text) - stopPrefixLen(text, w.stop)
+	if len(w.stop) > 0 {
+		// 停止序列前的空白会被去掉，也先不发送
+		end = len(strings.TrimRightFunc(text[:end], unicode.IsSpace))
+	}
+	if end <= w.sent {
+		return nil
+	}
+	delta, w.sent = text[w.sent:end], end
+	return w.emit(delta)
+}
+
+// flush emits the text held back when the generation ends
+func (w *stopWriter) flush() error {
+	text := w.text.String()
+	if w.stopped || len(text) <= w.sent {
+		return nil
+	}
+	delta := text[w.sent:]
+	w.sent = len(text)
+	return w.emit(delta)
+}
+
+// stopPrefixLen is the length of the longest end of text that is the
+// beginning of a stop sequence
+func stopPrefixLen(text string, stop []string) int {
+	n := 0
+	for _, s := range stop {
+		for k := min(len(s)-1, len(text)); k > n; k-- {
+			if strings.HasSuffix(text, s[:k]) {
+				n = k
+				break
+			}
+		}
+	}
+	return n
+}
+
+// CountTokens returns the number of tokens tok reads in text, without
+// special tokens. A nil tok counts with the heuristic tokenizer.
+func CountTokens(tok tokenizer.Tokenizer, text string) int {
+	if text == "" {
+		return 0
+	}
+	if tok == nil {
+		tok = tokenizer.NewHeuristic()
+	}
+	return len(tok.Encode(text, false))
+}
@@ -0,0 +1,155 @@
+package biz
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+// fakeGenerator answers with chunks, streaming them one by one
+type fakeGenerator struct {
+	info   GeneratorInfo
+	chunks []string
+	err    error
+	last   *GenerationRequest
+}
+
+func (g *fakeGenerator) Generate(ctx context.Context, req *GenerationRequest) (*Generation, error) {
+	g.last = req
+	if g.err != nil {
+		return nil, g.err
+	}
+	return &Generation{Text: strings.Join(g.chunks, ""), FinishReason: FinishStop}, nil
+}
+
+func (g *fakeGenerator) GenerateStream(ctx context.Context, req *GenerationRequest, emit func(delta string) error) (*Generation, error) {
+	g.last = req
+	var text strings.Builder
+	for _, chunk := range g.chunks {
+		text.WriteString(chunk)
+		if err := emit(chunk); err != nil {
+			return nil, err
+		}
+	}
+	if g.err != nil {
+		return nil, g.err
+	}
+	return &Generation{Text: text.String(), FinishReason: FinishStop}, nil
+}
+
+func (g *fakeGenerator) Info() GeneratorInfo {
+	return g.info
+}
+
+func newTestGeneration(g Generator) *GenerationUsecase {
+	return NewGenerationUsecase(g, nil, log.DefaultLogger)
+}
+
+func TestDefaultWorkflowGenerateTimeout(t *testing.T) {
+	handlers := make(map[string]stepHandler)
+	for _, s := range pipelineSteps {
+		handlers[s.service+"."+s.method] = echo(t, s.id)
+	}
+	for timeout, want := range map[time.Duration]int32{0: 0, 90 * time.Second: 90, 1500 * time.Millisecond: 2} {
+		generation := newTestGeneration(&fakeGenerator{info: GeneratorInfo{Timeout: timeout}})
+		engine := NewWorkflowEngine(newFakeServices(handlers), generation, log.DefaultLogger)
+		def, err := defaultWorkflow(engine.services, engine.generation.timeout(), false)
+		if err != nil {
+			t.Fatal(err)
+		}
+		for _, step := range def.Steps {
+			got := step.GetStepConfig().GetTimeoutSeconds()
+			if step.StepId == "generate" && got != want || step.StepId != "generate" && got != 0 {
+				t.Errorf("generator timeout %s: step %s timeout = %ds", timeout, step.StepId, got)
+			}
+		}
+	}
+}
+
+func TestStopWriter(t *testing.T) {
+	tests := []struct {
+		chunks  []string
+		stop    []string
+		emitted []string
+		stopped bool
+	}{
+		{[]string{"Hello", " world"}, nil, []string{"Hello", " world"}, false},
+		// 可能是停止序列开头的文本先保留
+		{[]string{"Hello ", "EN", "D more"}, []string{"END"}, []string{"Hello"}, true},
+		{[]string{"Hello E", "very", "one"}, []string{"END"}, []string{"Hello", " Every", "one"}, false},
+		{[]string{"a\n\nQ", "uestion:", " b"}, []string{"\n\nQuestion:"}, []string{"a"}, true},
+		// 最早出现的停止序列生效
+		{[]string{"x ##y", "|z"}, []string{"|", "##"}, []string{"x"}, true},
+		{[]string{"STOP"}, []string{"STOP"}, nil, true},
+	}
+	for _, tt := range tests {
+		var emitted []string
+		w := &stopWriter{stop: tt.stop, emit: func(delta string) error {
+			emitted = append(emitted, delta)
+			return nil
+		}}
+		var err error
+		for _, chunk := range tt.chunks {
+			if err = w.write(chunk); err != nil {
+				break
+			}
+		}
+		if err == nil {
+			err = w.flush()
+		} else if err != errStopSequence {
+			t.Fatalf("%q: %v", tt.chunks, err)
+		}
+		if strings.Join(emitted, "|") != strings.Join(tt.emitted, "|") || w.stopped != tt.stopped {
+			t.Errorf("%q with stop %q: emitted %q, stopped %t; want %q, %t", tt.chunks, tt.stop, emitted, w.stopped, tt.emitted, tt.stopped)
+		}
+		if err := w.write("more"); tt.stopped && err != errStopSequence {
+			t.Errorf("%q: write after stop = %v", tt.chunks, err)
+		}
+	}
+}
+
+func TestGenerateStreamCutsAtStop(t *testing.T) {
+	gen := &fakeGenerator{
+		info:   GeneratorInfo{Provider: "fake", Model: "m", Stop: []string{"\n\nQuestion:"}},
+		chunks: []string{"The answer", " is 42.\n\nQue", "stion: why?"},
+	}
+	var streamed strings.Builder
+	out, err := newTestGeneration(gen).GenerateStream(context.Background(), &GenerationRequest{Prompt: "q", Stop: []string{"never"}},
+		func(delta string) error {
+			streamed.WriteString(delta)
+			return nil
+		})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if out.Text != "The answer is 42." || streamed.String() != out.Text || out.FinishReason != FinishStop {
+		t.Errorf("generation %+v, streamed %q", out, streamed.String())
+	}
+	if out.Model != "m" || out.CompletionTokens == 0 || out.PromptTokens != CountTokens(nil, "q") {
+		t.Errorf("generation %+v", out)
+	}
+	if got := gen.last.Stop; strings.Join(got, "|") != "\n\nQuestion:|never" {
+		t.Errorf("stop = %q", got)
+	}
+}
+
+func TestGenerateFitsContextWindow(t *testing.T) {
+	gen := &fakeGenerator{info: GeneratorInfo{ContextWindow: 100, MaxTokens: 80}, chunks: []string{"ok"}}
+	uc := newTestGeneration(gen)
+	prompt := strings.Repeat("word ", 40)
+	if _, err := uc.Generate(context.Background(), &GenerationRequest{Prompt: prompt}); err != nil {
+		t.Fatal(err)
+	}
+	if want := 100 - CountTokens(nil, prompt); gen.last.MaxTokens != want {
+		t.Errorf("max tokens = %d, want %d", gen.last.MaxTokens, want)
+	}
+	if _, err := uc.Generate(context.Background(), &GenerationRequest{Prompt: strings.Repeat("word ", 95)}); err == nil {
+		t.Error("prompt filling the window was accepted")
+	}
+	if _, err := uc.Generate(context.Background(), &GenerationRequest{Prompt: " "}); err != ErrPromptRequired {
+		t.Errorf("blank prompt: %v", err)
+	}
+}
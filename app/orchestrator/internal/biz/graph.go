@@ -0,0 +1,167 @@
+package biz
+
+import (
+	"fmt"
+	"strings"
+
+	commonv1 "rag/api/common/v1"
+	v1 "rag/api/orchestrator/v1"
+
+	"github.com/go-kratos/kratos/v2/errors"
+)
+
+// Execution strategies accepted in WorkflowConfiguration.execution_strategy.
+const (
+	StrategySequential = "sequential"
+	StrategyParallel   = "parallel"
+	StrategyDAG        = "dag"
+)
+
+// workflowGraph is the validated dependency graph of a workflow definition,
+// along with the compiled mappings and conditions of its steps. Steps keep
+// their definition order, which breaks ties between ready steps.
+type workflowGraph struct {
+	steps []*v1.WorkflowStep
+	index map[string]int
+	// 每个步骤的上游和下游步骤
+	dependencies [][]int
+	dependents   [][]int
+	// 每个步骤直接或间接依赖的全部步骤
+	ancestors []map[string]bool
+	// 拓扑序，顺序执行时使用
+	order []int
+	// 按步骤下标的映射、条件分支和失败处理，没有条件的步骤分支为 nil
+	mappings []*stepMappings
+	branches []*branch
+	recovery []*stepRecovery
+	// 补偿调用的输入映射，没有补偿的步骤为 nil
+	compensations []map[string]*mappingValue
+}
+
+// invalidWorkflow reports a definition that cannot be executed
+func invalidWorkflow(format string, args ...any) error {
+	return errors.BadRequest(commonv1.ErrorCode_ERROR_CODE_BAD_REQUEST.String(), "invalid workflow: "+fmt.Sprintf(format, args...))
+}
+
+// buildGraph validates the steps of def and links their dependencies. It
+// rejects empty workflows, duplicate or empty step ids, unknown dependencies
+// and cycles.
+func buildGraph(def *v1.WorkflowDefinition) (*workflowGraph, error) {
+	if def == nil || len(def.Steps) == 0 {
+		return nil, invalidWorkflow("workflow has no steps")
+	}
+	switch strategy := def.GetConfiguration().GetExecutionStrategy(); strategy {
+	case "", StrategySequential, StrategyParallel, StrategyDAG:
+	default:
+		return nil, invalidWorkflow("unknown execution strategy %q", strategy)
+	}
+
+	g := &workflowGraph{
+		steps:        def.Steps,
+		index:        make(map[string]int, len(def.Steps)),
+		dependencies: make([][]int, len(def.Steps)),
+		dependents:   make([][]int, len(def.Steps)),
+	}
+	for i, step := range def.Steps {
+		if step.StepId == "" {
+			return nil, invalidWorkflow("step %d has no step_id", i+1)
+		}
+		if _, ok := g.index[step.StepId]; ok {
+			return nil, invalidWorkflow("duplicate step_id %q", step.StepId)
+		}
+		g.index[step.StepId] = i
+	}
+
+	for i, step := range def.Steps {
+		seen := make(map[string]bool, len(step.DependsOn))
+		for _, dep := range step.DependsOn {
+			j, ok := g.index[dep]
+			if !ok {
+				return nil, invalidWorkflow("step %q depends on unknown step %q", step.StepId, dep)
+			}
+			if j == i {
+				return nil, invalidWorkflow("step %q depends on itself", step.StepId)
+			}
+			if seen[dep] {
+				continue
+			}
+			seen[dep] = true
+			g.dependencies[i] = append(g.dependencies[i], j)
+			g.dependents[j] = append(g.dependents[j], i)
+		}
+	}
+
+	// Kahn 算法，按定义顺序取入度为零的步骤
+	remaining := make([]int, len(def.Steps))
+	for i, deps := range g.dependencies {
+		remaining[i] = len(deps)
+	}
+	done := make([]bool, len(def.Steps))
+	for len(g.order) < len(def.Steps) {
+		next := -1
+		for i := range def.Steps {
+			if !done[i] && remaining[i] == 0 {
+				next = i
+				break
+			}
+		}
+		if next < 0 {
+			return nil, invalidWorkflow("dependency cycle: %s", strings.Join(g.cycle(done), " -> "))
+		}
+		done[next] = true
+		g.order = append(g.order, next)
+		for _, d := range g.dependents[next] {
+			remaining[d]--
+		}
+	}
+
+	// 按拓扑序计算每个步骤的全部上游
+	g.ancestors = make([]map[string]bool, len(def.Steps))
+	for _, i := range g.order {
+		g.ancestors[i] = make(map[string]bool)
+		for _, j := range g.dependencies[i] {
+			g.ancestors[i][g.steps[j].StepId] = true
+			for a := range g.ancestors[j] {
+				g.ancestors[i][a] = true
+			}
+		}
+	}
+	return g, nil
+}
+
+// cycle returns the step ids of one cycle among the steps not yet ordered
+func (g *workflowGraph) cycle(done []bool) []string {
+	// 未排序的步骤都在环上或依赖环，沿未排序的依赖一直走必然回到走过的步骤
+	start := 0
+	for done[start] {
+		start++
+	}
+	visited := make(map[int]int)
+	var path []int
+	for cur := start; ; {
+		if at, ok := visited[cur]; ok {
+			ids := make([]string, 0, len(path)-at+1)
+			for _, i := range path[at:] {
+				ids = append(ids, g.steps[i].StepId)
+			}
+			return append(ids, g.steps[cur].StepId)
+		}
+		visited[cur] = len(path)
+		path = append(path, cur)
+		for _, dep := range g.steps[cur].DependsOn {
+			if j := g.index[dep]; !done[j] {
+				cur = j
+				break
+			}
+		}
+	}
+}
+
+func containsString(items []string, s string) bool {
+	for _, item := range items {
+		if item == s {
+			return true
+		}
+	}
+	return false
+}
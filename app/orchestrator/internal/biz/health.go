@@ -0,0 +1,224 @@
+package biz
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+	"sync"
+	"time"
+
+	commonv1 "rag/api/common/v1"
+	v1 "rag/api/orchestrator/v1"
+
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/log"
+	"google.golang.org/protobuf/types/known/anypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// Service health states reported in ServiceHealthStatus.status.
+const (
+	ServiceServing    = "SERVING"
+	ServiceNotServing = "NOT_SERVING"
+	ServiceUnknown    = "SERVICE_UNKNOWN"
+)
+
+// Overall health states reported in OverallHealthStatus.status.
+const (
+	HealthHealthy   = "HEALTHY"
+	HealthDegraded  = "DEGRADED"
+	HealthUnhealthy = "UNHEALTHY"
+)
+
+// Circuit breaker states reported in ServiceProtection.Circuits.
+const (
+	CircuitClosed   = "closed"
+	CircuitOpen     = "open"
+	CircuitHalfOpen = "half_open"
+)
+
+// 单个服务健康检查的超时
+const healthCheckTimeout = 3 * time.Second
+
+var (
+	// ErrMethodNotRegistered is returned when a service method cannot be called.
+	ErrMethodNotRegistered = errors.New(501, commonv1.ErrorCode_ERROR_CODE_NOT_IMPLEMENTED.String(), "service method is not registered")
+	// ErrCircuitOpen is returned when a call is rejected by an open circuit breaker.
+	ErrCircuitOpen = errors.New(503, commonv1.ErrorCode_ERROR_CODE_SERVICE_UNAVAILABLE.String(), "circuit breaker is open")
+)
+
+// ServiceProtection is the state of the circuit breakers and the bulkhead
+// guarding a backend service.
+type ServiceProtection struct {
+	// 未处于关闭状态的熔断器，按方法名索引
+	Circuits map[string]string
+	// 进行中和排队等待的调用数，以及并发上限
+	InFlight       int
+	Queued         int
+	MaxConcurrency int
+}
+
+// Tripped reports whether a circuit breaker of the service is not closed
+func (p ServiceProtection) Tripped() bool {
+	return len(p.Circuits) > 0
+}
+
+type circuitBreakerKey struct{}
+
+// WithCircuitBreaker marks calls made with ctx as rejected while the circuit
+// breaker of their method is open. Calls without it are still counted by
+// the breakers but always go through.
+func WithCircuitBreaker(ctx context.Context) context.Context {
+	return context.WithValue(ctx, circuitBreakerKey{}, true)
+}
+
+// CircuitBreakerEnabled reports whether ctx was marked by WithCircuitBreaker
+func CircuitBreakerEnabled(ctx context.Context) bool {
+	enabled, _ := ctx.Value(circuitBreakerKey{}).(bool)
+	return enabled
+}
+
+// ServiceRepo calls the methods of the backend services workflows use. Inputs
+// and outputs are the top-level fields of the request and response messages.
+type ServiceRepo interface {
+	// Call invokes service.method with the given request fields
+	Call(ctx context.Context, service, method string, input map[string]*anypb.Any) (map[string]*anypb.Any, error)
+	// Services returns the names of the registered services
+	Services() []string
+	// Methods returns the callable methods of a service, or false if it is not registered
+	Methods(service string) ([]string, bool)
+	// CheckHealth calls the HealthCheck method of a service
+	CheckHealth(ctx context.Context, service string) (*commonv1.HealthCheckResponse, error)
+	// Protection returns the circuit breaker and bulkhead state of a service
+	Protection(service string) ServiceProtection
+}
+
+// HealthUsecase reports the health of the backend services.
+type HealthUsecase struct {
+	services ServiceRepo
+	log      *log.Helper
+}
+
+// NewHealthUsecase creates a health usecase
+func NewHealthUsecase(services ServiceRepo, logger log.Logger) *HealthUsecase {
+	return &HealthUsecase{
+		services: services,
+		log:      log.NewHelper(logger),
+	}
+}
+
+// GetServicesHealth checks the requested services, or every registered one,
+// concurrently. Services that are not registered are reported as unknown.
+// A serving service with a tripped circuit breaker counts as unavailable, so
+// the overall status is degraded rather than healthy.
+func (uc *HealthUsecase) GetServicesHealth(ctx context.Context, req *v1.GetServicesHealthRequest) (*v1.GetServicesHealthResponse, error) {
+	names := req.ServiceNames
+	if len(names) == 0 {
+		names = uc.services.Services()
+	}
+
+	var (
+		mu     sync.Mutex
+		wg     sync.WaitGroup
+		health = make(map[string]*v1.ServiceHealthStatus, len(names))
+	)
+	for _, name := range names {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			status := uc.checkService(ctx, name, req.IncludeDetails)
+			mu.Lock()
+			health[name] = status
+			mu.Unlock()
+		}()
+	}
+	wg.Wait()
+
+	overall := &v1.OverallHealthStatus{TotalServices: int32(len(health))}
+	serving, tripped := 0, 0
+	for name, status := range health {
+		if status.Status != ServiceServing {
+			overall.UnavailableServices = append(overall.UnavailableServices, name)
+			continue
+		}
+		serving++
+		if uc.services.Protection(name).Tripped() {
+			tripped++
+			overall.UnavailableServices = append(overall.UnavailableServices, name)
+			continue
+		}
+		overall.HealthyServices++
+	}
+	sort.Strings(overall.UnavailableServices)
+	switch {
+	case len(overall.UnavailableServices) == 0:
+		overall.Status = HealthHealthy
+	case serving == 0:
+		overall.Status = HealthUnhealthy
+	default:
+		overall.Status = HealthDegraded
+	}
+	overall.HealthSummary = fmt.Sprintf("%d of %d services serving", serving, overall.TotalServices)
+	if tripped > 0 {
+		overall.HealthSummary += fmt.Sprintf(", %d with open circuit breakers", tripped)
+	}
+
+	return &v1.GetServicesHealthResponse{
+		ServicesHealth: health,
+		OverallStatus:  overall,
+		CheckedAt:      timestamppb.Now(),
+	}, nil
+}
+
+// checkService calls the HealthCheck method of one service
+func (uc *HealthUsecase) checkService(ctx context.Context, name string, includeDetails bool) *v1.ServiceHealthStatus {
+	status := &v1.ServiceHealthStatus{
+		ServiceName: name,
+		Status:      ServiceUnknown,
+		LastCheck:   timestamppb.Now(),
+	}
+	methods, ok := uc.services.Methods(name)
+	if !ok {
+		if includeDetails {
+			status.Details = &v1.ServiceHealthDetails{ErrorMessage: "service is not registered"}
+		}
+		return status
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, healthCheckTimeout)
+	defer cancel()
+	start := time.Now()
+	resp, err := uc.services.CheckHealth(ctx, name)
+	elapsed := time.Since(start)
+	details := &v1.ServiceHealthDetails{
+		ResponseTimeMs:   elapsed.Milliseconds(),
+		AvailableMethods: methods,
+	}
+	if err != nil {
+		uc.log.WithContext(ctx).Warnf("Health check of %s failed: %v", name, err)
+		status.Status = ServiceNotServing
+		details.ErrorMessage = errorMessage(err)
+	} else {
+		status.Status = resp.Status
+		status.Version = resp.Version
+		details.ServiceMetadata = make(map[string]string, len(resp.Details))
+		for k, v := range resp.Details {
+			details.ServiceMetadata[k] = v
+		}
+	}
+	if includeDetails {
+		p := uc.services.Protection(name)
+		if details.ServiceMetadata == nil {
+			details.ServiceMetadata = make(map[string]string)
+		}
+		for method, state := range p.Circuits {
+			details.ServiceMetadata["circuit_breaker."+method] = state
+		}
+		details.ServiceMetadata["in_flight_calls"] = strconv.Itoa(p.InFlight)
+		details.ServiceMetadata["queued_calls"] = strconv.Itoa(p.Queued)
+		details.ServiceMetadata["max_concurrency"] = strconv.Itoa(p.MaxConcurrency)
+		status.Details = details
+	}
+	return status
+}
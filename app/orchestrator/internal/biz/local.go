@@ -0,0 +1,54 @@
+package biz
+
+import (
+	"context"
+	"sort"
+
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+// localService is a service implemented inside the orchestrator that
+// workflow steps call like a backend service
+type localService interface {
+	methods() []string
+	call(ctx context.Context, method string, input map[string]*anypb.Any) (map[string]*anypb.Any, error)
+}
+
+// localServices adds the in-process services to the backend services. A
+// local service takes the place of a backend service of the same name.
+type localServices struct {
+	ServiceRepo
+	local map[string]localService
+}
+
+func withLocalServices(backend ServiceRepo, local map[string]localService) ServiceRepo {
+	return &localServices{ServiceRepo: backend, local: local}
+}
+
+// Call invokes a local service method, or a backend one
+func (s *localServices) Call(ctx context.Context, service, method string, input map[string]*anypb.Any) (map[string]*anypb.Any, error) {
+	if l, ok := s.local[service]; ok {
+		return l.call(ctx, method, input)
+	}
+	return s.ServiceRepo.Call(ctx, service, method, input)
+}
+
+// Services returns the names of the backend and local services
+func (s *localServices) Services() []string {
+	names := s.ServiceRepo.Services()
+	for name := range s.local {
+		if _, ok := s.ServiceRepo.Methods(name); !ok {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+	return names
+}
+
+// Methods returns the methods of a local or backend service
+func (s *localServices) Methods(service string) ([]string, bool) {
+	if l, ok := s.local[service]; ok {
+		return l.methods(), true
+	}
+	return s.ServiceRepo.Methods(service)
+}
@@ -0,0 +1,1170 @@
+package biz
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode"
+
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/anypb"
+	"google.golang.org/protobuf/types/known/structpb"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+// Mapping expressions reference workflow data with a JSONPath-like syntax
+// and may pipe the value through transforms:
+//
+//	$.input.query
+//	$.steps.retrieve.output.results[0:5]
+//	$.steps.retrieve.output.results[*].chunk.content | join("\n")
+//	$.context.tenant | default("public")
+//	$.steps.rerank.output.results[?(@.score >= 0.5)].chunk
+//	$.steps.retrieve.output.results | select("id:chunk.chunk_id", "score")
+//	$.steps.rewrite.output.query ?? $.input.query
+//
+// Roots are input (workflow inputs over the definition's default
+// parameters), steps.<step_id>.output, context (execution context) and, in
+// output mappings, output for the step's own output. Filters keep the list
+// elements for which a condition holds, with @ standing for the element. In
+// input mappings a
+// string value starting with "$" is an expression, as is every such string
+// inside a struct or list value; other values are literals. A leading "$$"
+// escapes a literal dollar. References joined by "??" are tried in turn
+// until one yields a value.
+
+// Expression roots.
+const (
+	rootInput   = "input"
+	rootSteps   = "steps"
+	rootContext = "context"
+	rootOutput  = "output"
+	// 过滤条件中的当前元素
+	rootCurrent = "@"
+)
+
+// segmentKind is the kind of one path segment
+type segmentKind int
+
+const (
+	segField segmentKind = iota
+	segIndex
+	segSlice
+	segWildcard
+	segFilter
+)
+
+type segment struct {
+	kind  segmentKind
+	name  string
+	index int
+	// 切片边界，nil 表示省略
+	start, end *int
+	filter     condNode
+}
+
+// transform is a compiled pipe stage
+type transform struct {
+	name string
+	args []any
+	fn   func(v any, args []any) (any, error)
+}
+
+// expression is a compiled mapping expression
+type expression struct {
+	source string
+	root   string
+	// steps 根下引用的步骤
+	stepID     string
+	path       []segment
+	transforms []transform
+	// ?? 之后的备选引用
+	alternative *expression
+}
+
+// transformSpec describes a transform and how many arguments it takes
+type transformSpec struct {
+	minArgs, maxArgs int
+	fn               func(v any, args []any) (any, error)
+}
+
+var transforms = map[string]transformSpec{
+	"default": {1, 1, func(v any, args []any) (any, error) {
+		if v == nil {
+			return args[0], nil
+		}
+		return v, nil
+	}},
+	"lower":   {0, 0, stringTransform(strings.ToLower)},
+	"upper":   {0, 0, stringTransform(strings.ToUpper)},
+	"trim":    {0, 0, stringTransform(strings.TrimSpace)},
+	"join":    {0, 1, joinTransform},
+	"split":   {0, 1, splitTransform},
+	"first":   {0, 0, func(v any, _ []any) (any, error) { return pickTransform(v, 0) }},
+	"last":    {0, 0, func(v any, _ []any) (any, error) { return pickTransform(v, -1) }},
+	"length":  {0, 0, lengthTransform},
+	"flatten": {0, 0, flattenTransform},
+	"string":  {0, 0, toStringTransform},
+	"number":  {0, 0, toNumberTransform},
+	"select":  {1, math.MaxInt, selectTransform},
+}
+
+// isExpression reports whether a mapping string is an expression
+func isExpression(s string) bool {
+	return strings.HasPrefix(s, "$") && !strings.HasPrefix(s, "$$")
+}
+
+// unescapeLiteral strips the escape of a literal starting with "$$"
+func unescapeLiteral(s string) string {
+	if strings.HasPrefix(s, "$$") {
+		return s[1:]
+	}
+	return s
+}
+
+// compileExpression parses an expression and checks its root and transforms
+func compileExpression(src string) (*expression, error) {
+	p := &exprParser{src: src}
+	expr, err := p.parse()
+	if err != nil {
+		return nil, fmt.Errorf("%q: %w", src, err)
+	}
+	return expr, nil
+}
+
+// exprParser is a hand-written recursive descent parser over one expression
+type exprParser struct {
+	src string
+	pos int
+	// 嵌套的过滤条件层数，@ 只能出现在过滤条件中
+	filters int
+}
+
+func (p *exprParser) parse() (*expression, error) {
+	expr, err := p.reference()
+	if err != nil {
+		return nil, err
+	}
+	for last := expr; ; last = last.alternative {
+		p.skipSpace()
+		if !strings.HasPrefix(p.src[p.pos:], "??") {
+			break
+		}
+		p.pos += 2
+		p.skipSpace()
+		if last.alternative, err = p.reference(); err != nil {
+			return nil, err
+		}
+	}
+	if p.pos < len(p.src) {
+		return nil, fmt.Errorf("unexpected %q at offset %d", p.src[p.pos:], p.pos)
+	}
+	return expr, nil
+}
+
+// reference parses a $ or @ reference and its transforms, stopping at the
+// first character that cannot continue it
+func (p *exprParser) reference() (*expression, error) {
+	start := p.pos
+	expr := &expression{}
+	if p.consume('@') {
+		if p.filters == 0 {
+			return nil, fmt.Errorf("@ is only available inside filters")
+		}
+		path, err := p.parsePath()
+		if err != nil {
+			return nil, err
+		}
+		expr.root, expr.path = rootCurrent, path
+		return p.transforms(expr, start)
+	}
+	if !p.consume('$') {
+		return nil, fmt.Errorf("expression must start with $")
+	}
+	path, err := p.parsePath()
+	if err != nil {
+		return nil, err
+	}
+	if len(path) == 0 || path[0].kind != segField {
+		return nil, fmt.Errorf("expression must name a root: input, steps, context or output")
+	}
+	expr.root = path[0].name
+	path = path[1:]
+	switch expr.root {
+	case rootInput, rootContext, rootOutput:
+	case rootSteps:
+		if len(path) < 2 || path[0].kind != segField || path[1].kind != segField || path[1].name != "output" {
+			return nil, fmt.Errorf("step references take the form $.steps.<step_id>.output")
+		}
+		expr.stepID = path[0].name
+		path = path[2:]
+	default:
+		return nil, fmt.Errorf("unknown root %q", expr.root)
+	}
+	expr.path = path
+	return p.transforms(expr, start)
+}
+
+// transforms parses the pipe stages after a reference. "||" is left alone so
+// references can be used in conditions.
+func (p *exprParser) transforms(expr *expression, start int) (*expression, error) {
+	for {
+		save := p.pos
+		p.skipSpace()
+		if p.peek() != '|' || strings.HasPrefix(p.src[p.pos:], "||") {
+			p.pos = save
+			expr.source = p.src[start:p.pos]
+			return expr, nil
+		}
+		p.pos++
+		t, err := p.parseTransform()
+		if err != nil {
+			return nil, err
+		}
+		expr.transforms = append(expr.transforms, t)
+	}
+}
+
+func (p *exprParser) parsePath() ([]segment, error) {
+	var path []segment
+	for p.pos < len(p.src) {
+		switch p.src[p.pos] {
+		case '.':
+			p.pos++
+			if p.consume('*') {
+				path = append(path, segment{kind: segWildcard})
+				continue
+			}
+			name := p.ident()
+			if name == "" {
+				return nil, fmt.Errorf("expected a field name at offset %d", p.pos)
+			}
+			path = append(path, segment{kind: segField, name: name})
+		case '[':
+			p.pos++
+			seg, err := p.parseBracket()
+			if err != nil {
+				return nil, err
+			}
+			path = append(path, seg)
+		default:
+			return path, nil
+		}
+	}
+	return path, nil
+}
+
+// parseBracket parses what follows "[": an index, a slice, *, a quoted name
+// or a ?filter
+func (p *exprParser) parseBracket() (segment, error) {
+	p.skipSpace()
+	var seg segment
+	switch {
+	case p.consume('*'):
+		seg = segment{kind: segWildcard}
+	case p.consume('?'):
+		p.filters++
+		filter, err := p.condition()
+		p.filters--
+		if err != nil {
+			return seg, err
+		}
+		seg = segment{kind: segFilter, filter: filter}
+	case p.peek() == '"' || p.peek() == '\'':
+		name, err := p.quoted()
+		if err != nil {
+			return seg, err
+		}
+		seg = segment{kind: segField, name: name}
+	default:
+		start, hasStart, err := p.integer()
+		if err != nil {
+			return seg, err
+		}
+		p.skipSpace()
+		if !p.consume(':') {
+			if !hasStart {
+				return seg, fmt.Errorf("expected an index at offset %d", p.pos)
+			}
+			seg = segment{kind: segIndex, index: start}
+			break
+		}
+		seg = segment{kind: segSlice}
+		if hasStart {
+			seg.start = &start
+		}
+		p.skipSpace()
+		end, hasEnd, err := p.integer()
+		if err != nil {
+			return seg, err
+		}
+		if hasEnd {
+			seg.end = &end
+		}
+	}
+	p.skipSpace()
+	if !p.consume(']') {
+		return seg, fmt.Errorf("expected ] at offset %d", p.pos)
+	}
+	return seg, nil
+}
+
+func (p *exprParser) parseTransform() (transform, error) {
+	p.skipSpace()
+	name := p.ident()
+	spec, ok := transforms[name]
+	if !ok {
+		return transform{}, fmt.Errorf("unknown transform %q", name)
+	}
+	t := transform{name: name, fn: spec.fn}
+	p.skipSpace()
+	if p.consume('(') {
+		for {
+			p.skipSpace()
+			if p.consume(')') {
+				break
+			}
+			if len(t.args) > 0 && !p.consume(',') {
+				return t, fmt.Errorf("expected , or ) at offset %d", p.pos)
+			}
+			p.skipSpace()
+			arg, err := p.literal()
+			if err != nil {
+				return t, err
+			}
+			t.args = append(t.args, arg)
+		}
+	}
+	if len(t.args) < spec.minArgs || len(t.args) > spec.maxArgs {
+		return t, fmt.Errorf("transform %s takes %d to %d arguments, got %d", name, spec.minArgs, spec.maxArgs, len(t.args))
+	}
+	return t, nil
+}
+
+// literal parses a quoted string, number, true, false or null
+func (p *exprParser) literal() (any, error) {
+	if c := p.peek(); c == '"' || c == '\'' {
+		return p.quoted()
+	}
+	start := p.pos
+	for p.pos < len(p.src) && !strings.ContainsRune(",) \t", rune(p.src[p.pos])) {
+		p.pos++
+	}
+	word := p.src[start:p.pos]
+	switch word {
+	case "true":
+		return true, nil
+	case "false":
+		return false, nil
+	case "null":
+		return nil, nil
+	}
+	f, err := strconv.ParseFloat(word, 64)
+	if err != nil {
+		return nil, fmt.Errorf("invalid literal %q", word)
+	}
+	return f, nil
+}
+
+// quoted parses a single or double quoted string with backslash escapes
+func (p *exprParser) quoted() (string, error) {
+	quote := p.src[p.pos]
+	p.pos++
+	var b strings.Builder
+	for p.pos < len(p.src) {
+		c := p.src[p.pos]
+		p.pos++
+		switch c {
+		case quote:
+			return b.String(), nil
+		case '\\':
+			if p.pos == len(p.src) {
+				return "", fmt.Errorf("unterminated string")
+			}
+			switch e := p.src[p.pos]; e {
+			case 'n':
+				b.WriteByte('\n')
+			case 't':
+				b.WriteByte('\t')
+			default:
+				b.WriteByte(e)
+			}
+			p.pos++
+		default:
+			b.WriteByte(c)
+		}
+	}
+	return "", fmt.Errorf("unterminated string")
+}
+
+// integer parses an optionally signed integer, reporting whether one was there
+func (p *exprParser) integer() (int, bool, error) {
+	start := p.pos
+	if p.peek() == '-' {
+		p.pos++
+	}
+	for p.pos < len(p.src) && p.src[p.pos] >= '0' && p.src[p.pos] <= '9' {
+		p.pos++
+	}
+	if p.pos == start {
+		return 0, false, nil
+	}
+	n, err := strconv.Atoi(p.src[start:p.pos])
+	if err != nil {
+		return 0, false, fmt.Errorf("invalid index %q", p.src[start:p.pos])
+	}
+	return n, true, nil
+}
+
+func (p *exprParser) ident() string {
+	start := p.pos
+	for p.pos < len(p.src) {
+		r := rune(p.src[p.pos])
+		if !unicode.IsLetter(r) && !unicode.IsDigit(r) && r != '_' && r != '-' {
+			break
+		}
+		p.pos++
+	}
+	return p.src[start:p.pos]
+}
+
+func (p *exprParser) peek() byte {
+	if p.pos < len(p.src) {
+		return p.src[p.pos]
+	}
+	return 0
+}
+
+func (p *exprParser) consume(c byte) bool {
+	if p.peek() == c {
+		p.pos++
+		return true
+	}
+	return false
+}
+
+func (p *exprParser) skipSpace() {
+	for p.pos < len(p.src) && (p.src[p.pos] == ' ' || p.src[p.pos] == '\t') {
+		p.pos++
+	}
+}
+
+// mappingScope is the data expressions of one step can see
+type mappingScope struct {
+	input   map[string]*anypb.Any
+	steps   map[string]map[string]*anypb.Any
+	context map[string]string
+	// 输出映射中为当前步骤的输出
+	output map[string]*anypb.Any
+}
+
+// evaluate resolves the expression in scope. A path that misses yields nil,
+// or the value of the first alternative that does not. A reference to a
+// whole field without transforms passes the value through unchanged, keeping
+// its message type.
+func (e *expression) evaluate(scope *mappingScope) (*anypb.Any, error) {
+	for ; e != nil; e = e.alternative {
+		if e.root != rootContext && len(e.path) == 1 && e.path[0].kind == segField && len(e.transforms) == 0 {
+			if value, ok := e.fields(scope)[e.path[0].name]; ok {
+				return value, nil
+			}
+		}
+		value, err := e.resolve(scope, nil)
+		if err != nil || value != nil {
+			if err != nil {
+				return nil, err
+			}
+			return treeToAny(value)
+		}
+	}
+	return nil, nil
+}
+
+// fields returns the packed fields under the expression's root
+func (e *expression) fields(scope *mappingScope) map[string]*anypb.Any {
+	switch e.root {
+	case rootInput:
+		return scope.input
+	case rootSteps:
+		return scope.steps[e.stepID]
+	case rootOutput:
+		return scope.output
+	}
+	return nil
+}
+
+// value resolves the expression, or the first alternative that yields
+// something, to a JSON tree. @ references start from current, the element a
+// filter is testing.
+func (e *expression) value(scope *mappingScope, current any) (any, error) {
+	for ; e != nil; e = e.alternative {
+		value, err := e.resolve(scope, current)
+		if err != nil || value != nil {
+			return value, err
+		}
+	}
+	return nil, nil
+}
+
+// resolve resolves the expression itself, ignoring its alternatives
+func (e *expression) resolve(scope *mappingScope, current any) (any, error) {
+	var tree any
+	path := e.path
+	switch e.root {
+	case rootCurrent:
+		tree = current
+	case rootContext:
+		m := make(map[string]any, len(scope.context))
+		for k, v := range scope.context {
+			m[k] = v
+		}
+		tree = m
+	default:
+		fields := e.fields(scope)
+		if len(path) > 0 && path[0].kind == segField {
+			// 只转换路径用到的字段
+			if value, ok := fields[path[0].name]; ok {
+				t, err := anyToTree(value)
+				if err != nil {
+					return nil, err
+				}
+				tree = t
+			}
+			path = path[1:]
+			break
+		}
+		m := make(map[string]any, len(fields))
+		for k, v := range fields {
+			t, err := anyToTree(v)
+			if err != nil {
+				return nil, err
+			}
+			m[k] = t
+		}
+		tree = m
+	}
+
+	value, err := navigate(tree, path, scope)
+	if err != nil {
+		return nil, err
+	}
+	for _, t := range e.transforms {
+		if value, err = t.fn(value, t.args); err != nil {
+			return nil, fmt.Errorf("%s: %w", t.name, err)
+		}
+	}
+	return value, nil
+}
+
+// walk calls fn for the expression, its alternatives and every expression
+// in their filters
+func (e *expression) walk(fn func(*expression) error) error {
+	if err := fn(e); err != nil {
+		return err
+	}
+	for _, seg := range e.path {
+		if seg.filter != nil {
+			if err := seg.filter.walk(fn); err != nil {
+				return err
+			}
+		}
+	}
+	if e.alternative != nil {
+		return e.alternative.walk(fn)
+	}
+	return nil
+}
+
+// navigate follows path through a JSON tree. Slices, wildcards and filters
+// project the rest of the path over each element, dropping elements where it
+// misses.
+func navigate(v any, path []segment, scope *mappingScope) (any, error) {
+	if len(path) == 0 || v == nil {
+		return v, nil
+	}
+	seg, rest := path[0], path[1:]
+	switch seg.kind {
+	case segField:
+		m, ok := v.(map[string]any)
+		if !ok {
+			return nil, nil
+		}
+		return navigate(m[seg.name], rest, scope)
+	case segIndex:
+		l, ok := v.([]any)
+		if !ok {
+			return nil, nil
+		}
+		i := seg.index
+		if i < 0 {
+			i += len(l)
+		}
+		if i < 0 || i >= len(l) {
+			return nil, nil
+		}
+		return navigate(l[i], rest, scope)
+	case segSlice:
+		l, ok := v.([]any)
+		if !ok {
+			return nil, nil
+		}
+		start, end := sliceBound(seg.start, 0, len(l)), sliceBound(seg.end, len(l), len(l))
+		if start > end {
+			start = end
+		}
+		return project(l[start:end], rest, scope)
+	case segWildcard:
+		if items, ok := elements(v); ok {
+			return project(items, rest, scope)
+		}
+	case segFilter:
+		items, ok := elements(v)
+		if !ok {
+			return nil, nil
+		}
+		kept := make([]any, 0, len(items))
+		for _, item := range items {
+			result, err := seg.filter.eval(scope, item)
+			if err != nil {
+				return nil, err
+			}
+			if truthy(result) {
+				kept = append(kept, item)
+			}
+		}
+		return project(kept, rest, scope)
+	}
+	return nil, nil
+}
+
+// elements returns the items of a list, or the values of an object in key order
+func elements(v any) ([]any, bool) {
+	switch c := v.(type) {
+	case []any:
+		return c, true
+	case map[string]any:
+		keys := make([]string, 0, len(c))
+		for k := range c {
+			keys = append(keys, k)
+		}
+		sort.Strings(keys)
+		values := make([]any, len(keys))
+		for i, k := range keys {
+			values[i] = c[k]
+		}
+		return values, true
+	}
+	return nil, false
+}
+
+func project(items []any, path []segment, scope *mappingScope) (any, error) {
+	out := make([]any, 0, len(items))
+	for _, item := range items {
+		v, err := navigate(item, path, scope)
+		if err != nil {
+			return nil, err
+		}
+		if v != nil {
+			out = append(out, v)
+		}
+	}
+	return out, nil
+}
+
+// sliceBound resolves a slice bound the way Python does
+func sliceBound(bound *int, def, n int) int {
+	if bound == nil {
+		return def
+	}
+	b := *bound
+	if b < 0 {
+		b += n
+	}
+	return max(0, min(b, n))
+}
+
+// anyToTree converts a packed message to its JSON tree with proto field names
+func anyToTree(a *anypb.Any) (any, error) {
+	msg, err := a.UnmarshalNew()
+	if err != nil {
+		return nil, err
+	}
+	raw, err := protojson.MarshalOptions{UseProtoNames: true}.Marshal(msg)
+	if err != nil {
+		return nil, err
+	}
+	var tree any
+	if err := json.Unmarshal(raw, &tree); err != nil {
+		return nil, err
+	}
+	return tree, nil
+}
+
+// treeToAny packs a JSON tree, using wrappers for scalars. A nil value packs
+// to nil so the field is left unset.
+func treeToAny(v any) (*anypb.Any, error) {
+	var msg proto.Message
+	switch t := v.(type) {
+	case nil:
+		return nil, nil
+	case string:
+		msg = wrapperspb.String(t)
+	case bool:
+		msg = wrapperspb.Bool(t)
+	case float64:
+		msg = wrapperspb.Double(t)
+	case []any:
+		l, err := structpb.NewList(t)
+		if err != nil {
+			return nil, err
+		}
+		msg = l
+	case map[string]any:
+		s, err := structpb.NewStruct(t)
+		if err != nil {
+			return nil, err
+		}
+		msg = s
+	default:
+		return nil, fmt.Errorf("unsupported value %T", v)
+	}
+	return anypb.New(msg)
+}
+
+func stringTransform(f func(string) string) func(any, []any) (any, error) {
+	return func(v any, _ []any) (any, error) {
+		if v == nil {
+			return nil, nil
+		}
+		s, ok := v.(string)
+		if !ok {
+			return nil, fmt.Errorf("expects a string, got %s", typeName(v))
+		}
+		return f(s), nil
+	}
+}
+
+func joinTransform(v any, args []any) (any, error) {
+	if v == nil {
+		return nil, nil
+	}
+	l, ok := v.([]any)
+	if !ok {
+		return nil, fmt.Errorf("expects a list, got %s", typeName(v))
+	}
+	sep, err := stringArg(args, ",")
+	if err != nil {
+		return nil, err
+	}
+	parts := make([]string, len(l))
+	for i, item := range l {
+		parts[i] = stringify(item)
+	}
+	return strings.Join(parts, sep), nil
+}
+
+func splitTransform(v any, args []any) (any, error) {
+	if v == nil {
+		return nil, nil
+	}
+	s, ok := v.(string)
+	if !ok {
+		return nil, fmt.Errorf("expects a string, got %s", typeName(v))
+	}
+	sep, err := stringArg(args, ",")
+	if err != nil {
+		return nil, err
+	}
+	parts := strings.Split(s, sep)
+	out := make([]any, len(parts))
+	for i, part := range parts {
+		out[i] = part
+	}
+	return out, nil
+}
+
+func pickTransform(v any, i int) (any, error) {
+	if v == nil {
+		return nil, nil
+	}
+	l, ok := v.([]any)
+	if !ok {
+		return nil, fmt.Errorf("expects a list, got %s", typeName(v))
+	}
+	if len(l) == 0 {
+		return nil, nil
+	}
+	if i < 0 {
+		i += len(l)
+	}
+	return l[i], nil
+}
+
+func lengthTransform(v any, _ []any) (any, error) {
+	switch t := v.(type) {
+	case nil:
+		return float64(0), nil
+	case string:
+		return float64(len([]rune(t))), nil
+	case []any:
+		return float64(len(t)), nil
+	case map[string]any:
+		return float64(len(t)), nil
+	}
+	return nil, fmt.Errorf("expects a string, list or object, got %s", typeName(v))
+}
+
+func flattenTransform(v any, _ []any) (any, error) {
+	if v == nil {
+		return nil, nil
+	}
+	l, ok := v.([]any)
+	if !ok {
+		return nil, fmt.Errorf("expects a list, got %s", typeName(v))
+	}
+	out := make([]any, 0, len(l))
+	for _, item := range l {
+		if inner, ok := item.([]any); ok {
+			out = append(out, inner...)
+		} else {
+			out = append(out, item)
+		}
+	}
+	return out, nil
+}
+
+// selectTransform keeps the named fields of an object, or of every object in
+// a list. A field written "name:path" is read from the dotted path and
+// stored as name. Missing fields are left out.
+func selectTransform(v any, args []any) (any, error) {
+	type field struct {
+		name string
+		path []string
+	}
+	fields := make([]field, len(args))
+	for i, arg := range args {
+		s, ok := arg.(string)
+		if !ok || s == "" {
+			return nil, fmt.Errorf("fields must be non-empty strings")
+		}
+		name, path, renamed := strings.Cut(s, ":")
+		if !renamed {
+			path = name
+		}
+		fields[i] = field{name: name, path: strings.Split(path, ".")}
+	}
+	pick := func(item any) (map[string]any, bool) {
+		obj, ok := item.(map[string]any)
+		if !ok {
+			return nil, false
+		}
+		out := make(map[string]any, len(fields))
+		for _, f := range fields {
+			var value any = obj
+			for _, key := range f.path {
+				m, _ := value.(map[string]any)
+				value = m[key]
+			}
+			if value != nil {
+				out[f.name] = value
+			}
+		}
+		return out, true
+	}
+	switch t := v.(type) {
+	case nil:
+		return nil, nil
+	case map[string]any:
+		out, _ := pick(t)
+		return out, nil
+	case []any:
+		out := make([]any, 0, len(t))
+		for _, item := range t {
+			if picked, ok := pick(item); ok {
+				out = append(out, picked)
+			}
+		}
+		return out, nil
+	}
+	return nil, fmt.Errorf("expects an object or list, got %s", typeName(v))
+}
+
+func toStringTransform(v any, _ []any) (any, error) {
+	if v == nil {
+		return nil, nil
+	}
+	return stringify(v), nil
+}
+
+func toNumberTransform(v any, _ []any) (any, error) {
+	switch t := v.(type) {
+	case nil:
+		return nil, nil
+	case float64:
+		return t, nil
+	case bool:
+		if t {
+			return float64(1), nil
+		}
+		return float64(0), nil
+	case string:
+		f, err := strconv.ParseFloat(strings.TrimSpace(t), 64)
+		if err != nil || math.IsNaN(f) || math.IsInf(f, 0) {
+			return nil, fmt.Errorf("%q is not a number", t)
+		}
+		return f, nil
+	}
+	return nil, fmt.Errorf("expects a scalar, got %s", typeName(v))
+}
+
+func stringArg(args []any, def string) (string, error) {
+	if len(args) == 0 {
+		return def, nil
+	}
+	s, ok := args[0].(string)
+	if !ok {
+		return "", fmt.Errorf("separator must be a string")
+	}
+	return s, nil
+}
+
+// stringify renders scalars as text and other values as JSON
+func stringify(v any) string {
+	switch t := v.(type) {
+	case string:
+		return t
+	case float64:
+		return strconv.FormatFloat(t, 'f', -1, 64)
+	case nil:
+		return ""
+	}
+	raw, _ := json.Marshal(v)
+	return string(raw)
+}
+
+func typeName(v any) string {
+	switch v.(type) {
+	case string:
+		return "string"
+	case float64:
+		return "number"
+	case bool:
+		return "bool"
+	case []any:
+		return "list"
+	case map[string]any:
+		return "object"
+	}
+	return fmt.Sprintf("%T", v)
+}
+
+// mappingValue is a compiled mapping entry: a literal, an expression, or a
+// struct or list value with expressions among its leaves
+type mappingValue struct {
+	literal  *anypb.Any
+	expr     *expression
+	template any
+	exprs    []*expression
+}
+
+// compileInputValue compiles one input_mapping value
+func compileInputValue(a *anypb.Any) (*mappingValue, error) {
+	msg, err := a.UnmarshalNew()
+	if err != nil {
+		return nil, err
+	}
+	switch m := msg.(type) {
+	case *wrapperspb.StringValue:
+		return compileStringValue(m.Value)
+	case *structpb.Struct, *structpb.ListValue, *structpb.Value:
+		tree, err := anyToTree(a)
+		if err != nil {
+			return nil, err
+		}
+		v := &mappingValue{}
+		if v.template, err = v.compileTemplate(tree); err != nil {
+			return nil, err
+		}
+		if len(v.exprs) == 0 {
+			v.literal, err = treeToAny(v.template)
+		}
+		return v, err
+	}
+	return &mappingValue{literal: a}, nil
+}
+
+// compileStringValue compiles a string that is either an expression or a
+// literal
+func compileStringValue(s string) (*mappingValue, error) {
+	if isExpression(s) {
+		expr, err := compileExpression(s)
+		if err != nil {
+			return nil, err
+		}
+		return &mappingValue{expr: expr, exprs: []*expression{expr}}, nil
+	}
+	literal, err := anypb.New(wrapperspb.String(unescapeLiteral(s)))
+	return &mappingValue{literal: literal}, err
+}
+
+func (v *mappingValue) compileTemplate(tree any) (any, error) {
+	switch t := tree.(type) {
+	case string:
+		if !isExpression(t) {
+			return unescapeLiteral(t), nil
+		}
+		expr, err := compileExpression(t)
+		if err != nil {
+			return nil, err
+		}
+		v.exprs = append(v.exprs, expr)
+		return expr, nil
+	case []any:
+		out := make([]any, len(t))
+		for i, item := range t {
+			c, err := v.compileTemplate(item)
+			if err != nil {
+				return nil, err
+			}
+			out[i] = c
+		}
+		return out, nil
+	case map[string]any:
+		out := make(map[string]any, len(t))
+		for k, item := range t {
+			c, err := v.compileTemplate(item)
+			if err != nil {
+				return nil, err
+			}
+			out[k] = c
+		}
+		return out, nil
+	}
+	return tree, nil
+}
+
+// evaluate resolves the entry in scope; nil means the field stays unset
+func (v *mappingValue) evaluate(scope *mappingScope) (*anypb.Any, error) {
+	switch {
+	case v.literal != nil:
+		return v.literal, nil
+	case v.expr != nil:
+		return v.expr.evaluate(scope)
+	}
+	tree, err := fillTemplate(v.template, scope)
+	if err != nil {
+		return nil, err
+	}
+	return treeToAny(tree)
+}
+
+func fillTemplate(tree any, scope *mappingScope) (any, error) {
+	switch t := tree.(type) {
+	case *expression:
+		a, err := t.evaluate(scope)
+		if err != nil || a == nil {
+			return nil, err
+		}
+		return anyToTree(a)
+	case []any:
+		out := make([]any, len(t))
+		for i, item := range t {
+			v, err := fillTemplate(item, scope)
+			if err != nil {
+				return nil, err
+			}
+			out[i] = v
+		}
+		return out, nil
+	case map[string]any:
+		out := make(map[string]any, len(t))
+		for k, item := range t {
+			v, err := fillTemplate(item, scope)
+			if err != nil {
+				return nil, err
+			}
+			// 缺失的值不写入对象
+			if v != nil {
+				out[k] = v
+			}
+		}
+		return out, nil
+	}
+	return tree, nil
+}
+
+// stepMappings are the compiled input and output mappings of a step
+type stepMappings struct {
+	inputs  map[string]*mappingValue
+	outputs map[string]*mappingValue
+}
+
+// compileMappings compiles the mappings of every step and checks their
+// references: a step may only read the outputs of steps it transitively
+// depends on, its own output only in output mappings, and no two steps may
+// publish the same workflow output.
+func compileMappings(g *workflowGraph) ([]*stepMappings, error) {
+	published := make(map[string]string)
+	mappings := make([]*stepMappings, len(g.steps))
+	for i, step := range g.steps {
+		m := &stepMappings{
+			inputs:  make(map[string]*mappingValue, len(step.InputMapping)),
+			outputs: make(map[string]*mappingValue, len(step.OutputMapping)),
+		}
+		for field, value := range step.InputMapping {
+			v, err := compileInputValue(value)
+			if err != nil {
+				return nil, invalidWorkflow("step %q input %s: %v", step.StepId, field, err)
+			}
+			for _, expr := range v.exprs {
+				if err := checkReference(expr, step.StepId, g.ancestors[i], false); err != nil {
+					return nil, invalidWorkflow("step %q input %s: %v", step.StepId, field, err)
+				}
+			}
+			m.inputs[field] = v
+		}
+		for name, source := range step.OutputMapping {
+			if other, ok := published[name]; ok {
+				return nil, invalidWorkflow("steps %q and %q both publish output %s", other, step.StepId, name)
+			}
+			published[name] = step.StepId
+			v, err := compileStringValue(source)
+			if err != nil {
+				return nil, invalidWorkflow("step %q output %s: %v", step.StepId, name, err)
+			}
+			for _, expr := range v.exprs {
+				if err := checkReference(expr, step.StepId, g.ancestors[i], true); err != nil {
+					return nil, invalidWorkflow("step %q output %s: %v", step.StepId, name, err)
+				}
+			}
+			m.outputs[name] = v
+		}
+		mappings[i] = m
+	}
+	return mappings, nil
+}
+
+// checkReference checks that expr, filters included, only reads data
+// available to the step. output allows the step's own output.
+func checkReference(expr *expression, stepID string, ancestors map[string]bool, output bool) error {
+	return expr.walk(func(e *expression) error {
+		switch e.root {
+		case rootOutput:
+			if !output {
+				return fmt.Errorf("%q: $.output is only available in output mappings and conditions", e.source)
+			}
+		case rootSteps:
+			if e.stepID == stepID && output {
+				return nil
+			}
+			if !ancestors[e.stepID] {
+				return fmt.Errorf("%q: step %q is not an upstream dependency", e.source, e.stepID)
+			}
+		}
+		return nil
+	})
+}
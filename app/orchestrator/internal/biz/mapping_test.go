@@ -0,0 +1,215 @@
+package biz
+
+import (
+	"context"
+	"reflect"
+	"strings"
+	"testing"
+
+	v1 "rag/api/orchestrator/v1"
+
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/anypb"
+	"google.golang.org/protobuf/types/known/structpb"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+func packValue(t testing.TB, m proto.Message) *anypb.Any {
+	t.Helper()
+	a, err := anypb.New(m)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return a
+}
+
+func packTree(t testing.TB, v any) *anypb.Any {
+	t.Helper()
+	a, err := treeToAny(v)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return a
+}
+
+func testScope(t testing.TB) *mappingScope {
+	results := []any{
+		map[string]any{"id": "c1", "score": 0.9, "chunk": map[string]any{"chunk_id": "k1", "content": "alpha"}},
+		map[string]any{"id": "c2", "score": 0.4, "chunk": map[string]any{"chunk_id": "k2", "content": "beta"}},
+		map[string]any{"id": "c3", "score": 0.7, "chunk": map[string]any{"chunk_id": "k3", "content": "gamma"}},
+	}
+	return &mappingScope{
+		input: map[string]*anypb.Any{
+			"query": packValue(t, wrapperspb.String("  Go Channels ")),
+			"limit": packValue(t, wrapperspb.Double(3)),
+		},
+		steps: map[string]map[string]*anypb.Any{
+			"retrieve": {"results": packTree(t, results)},
+		},
+		context: map[string]string{"tenant": "acme"},
+	}
+}
+
+func TestMappingExpressions(t *testing.T) {
+	scope := testScope(t)
+	tests := []struct {
+		expr string
+		want any
+	}{
+		{"$.input.query | trim | lower", "go channels"},
+		{"$.steps.retrieve.output.results[0].id", "c1"},
+		{"$.steps.retrieve.output.results[-1].id", "c3"},
+		{"$.steps.retrieve.output.results[5].id", nil},
+		{"$.steps.retrieve.output.results[0:2].id", []any{"c1", "c2"}},
+		{"$.steps.retrieve.output.results[-2:].chunk.content", []any{"beta", "gamma"}},
+		{`$.steps.retrieve.output.results[*].chunk.content | join("\n")`, "alpha\nbeta\ngamma"},
+		{"$.steps.retrieve.output.results[?(@.score >= 0.5)].id", []any{"c1", "c3"}},
+		{`$.steps.retrieve.output.results[?(@.chunk.content == "beta")].score | first`, 0.4},
+		{`$.steps.retrieve.output.results[0] | select("id:chunk.chunk_id", "score", "missing")`, map[string]any{"id": "k1", "score": 0.9}},
+		{"$.steps.retrieve.output.results | length", 3.0},
+		{`$.context.tenant | default("public")`, "acme"},
+		{`$.context.region | default("public")`, "public"},
+		{"$.input.missing ?? $.context.missing ?? $.input.query | trim", "Go Channels"},
+		{`$.input.query | trim | split(" ") | last | upper`, "CHANNELS"},
+		{"$.input.limit | string", "3"},
+		{`$.steps.retrieve.output["results"][1]["id"]`, "c2"},
+		{"$.steps.retrieve.output.results[*].chunk | select(\"chunk_id\") | length", 3.0},
+	}
+	for _, tt := range tests {
+		expr, err := compileExpression(tt.expr)
+		if err != nil {
+			t.Errorf("%s: %v", tt.expr, err)
+			continue
+		}
+		got, err := expr.value(scope, nil)
+		if err != nil {
+			t.Errorf("%s: %v", tt.expr, err)
+			continue
+		}
+		if !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("%s = %#v, want %#v", tt.expr, got, tt.want)
+		}
+	}
+}
+
+func TestMappingCompileErrors(t *testing.T) {
+	for _, src := range []string{
+		"input.query",
+		"$",
+		"$.unknown.x",
+		"$.steps.retrieve.results",
+		"$.input.q | nope",
+		`$.input.q | join(",", "x")`,
+		"@.score",
+		"$.input.q[",
+		"$.input.q[x]",
+		"$.input.q extra",
+		`$.input.q | default("unterminated)`,
+	} {
+		if _, err := compileExpression(src); err == nil {
+			t.Errorf("%q compiled", src)
+		}
+	}
+}
+
+func TestMappingRuntimeErrors(t *testing.T) {
+	scope := testScope(t)
+	for _, src := range []string{
+		"$.input.query | join",
+		"$.steps.retrieve.output.results | upper",
+		"$.input.query | number",
+	} {
+		expr, err := compileExpression(src)
+		if err != nil {
+			t.Fatalf("%s: %v", src, err)
+		}
+		if _, err := expr.value(scope, nil); err == nil {
+			t.Errorf("%s evaluated without an error", src)
+		}
+	}
+}
+
+func TestMappingValues(t *testing.T) {
+	scope := testScope(t)
+
+	// 直接引用整个字段时保留原消息类型
+	v, err := compileStringValue("$.input.limit")
+	if err != nil {
+		t.Fatal(err)
+	}
+	got, err := v.evaluate(scope)
+	if err != nil || got != scope.input["limit"] {
+		t.Errorf("passthrough = %v, %v", got, err)
+	}
+
+	v, err = compileStringValue("$$5 off")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, _ := v.evaluate(scope); unpackString(t, got) != "$5 off" {
+		t.Errorf("escaped literal = %v", got)
+	}
+
+	// 结构体中的表达式逐个求值，缺失的字段不写入
+	s, err := structpb.NewStruct(map[string]any{
+		"query":   "$.input.query | trim",
+		"ids":     []any{"$.steps.retrieve.output.results[0].id", "fixed"},
+		"missing": "$.input.missing",
+		"top_k":   5,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	v, err = compileInputValue(packValue(t, s))
+	if err != nil {
+		t.Fatal(err)
+	}
+	packed, err := v.evaluate(scope)
+	if err != nil {
+		t.Fatal(err)
+	}
+	tree, err := anyToTree(packed)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := map[string]any{"query": "Go Channels", "ids": []any{"c1", "fixed"}, "top_k": 5.0}
+	if !reflect.DeepEqual(tree, want) {
+		t.Errorf("struct = %#v, want %#v", tree, want)
+	}
+}
+
+func TestMappingReferences(t *testing.T) {
+	engine := newTestEngine(diamondServices(t))
+	tests := map[string]func(def *v1.WorkflowDefinition){
+		`step "c" is not an upstream dependency`: func(def *v1.WorkflowDefinition) {
+			def.Steps[1].InputMapping["in"] = packString(t, "$.steps.c.output.out")
+		},
+		"$.output is only available": func(def *v1.WorkflowDefinition) {
+			def.Steps[1].InputMapping["in"] = packString(t, "$.output.out")
+		},
+		`both publish output answer`: func(def *v1.WorkflowDefinition) {
+			def.Steps[2].OutputMapping = map[string]string{"answer": "$.output.out"}
+		},
+		"unknown transform": func(def *v1.WorkflowDefinition) {
+			def.Steps[0].InputMapping["in"] = packString(t, "$.input.query | reverse")
+		},
+	}
+	for want, change := range tests {
+		def := diamond(t, StrategyParallel)
+		change(def)
+		if err := engine.Validate(def); err == nil || !strings.Contains(err.Error(), want) {
+			t.Errorf("Validate = %v, want %q", err, want)
+		}
+	}
+
+	// 输出映射可以引用自身的输出和上游步骤
+	def := diamond(t, StrategyParallel)
+	def.Steps[3].OutputMapping["sources"] = "$.steps.a.output.out ?? $.steps.d.output.out"
+	exec, err := engine.Execute(context.Background(), "x", def, map[string]*anypb.Any{"query": packString(t, "q")}, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := unpackString(t, exec.Outputs["sources"]); got != "a(q)" {
+		t.Errorf("sources = %s, want a(q)", got)
+	}
+}
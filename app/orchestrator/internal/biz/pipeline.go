@@ -0,0 +1,330 @@
+package biz
+
+import (
+	"fmt"
+	"math"
+	"strings"
+	"time"
+
+	v1 "rag/api/orchestrator/v1"
+
+	"github.com/golang/protobuf/proto"
+	"google.golang.org/protobuf/types/known/anypb"
+	"google.golang.org/protobuf/types/known/structpb"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+// DefaultWorkflowName is the built-in workflow ProcessQuery runs when a
+// query names no workflow.
+const DefaultWorkflowName = "default_query_workflow"
+
+// The service the default workflow calls to generate the final answer.
+const (
+	GeneratorService = "generator"
+	GenerateMethod   = "Generate"
+)
+
+// Outputs the default workflow publishes besides final_answer.
+const (
+	// 传给组装步骤的候选文档，以及组装实际用到的分块 id
+	OutputContextDocuments  = "context_documents"
+	OutputUsedChunks        = "used_chunks"
+	OutputAssembledContext  = "assembled_context"
+	OutputDocumentsSearched = "documents_searched"
+	// 组装上下文时生成的引用，对应答案中的引用标记
+	OutputCitations = "citations"
+	// 生成答案的模型，记入元数据的 debug_info
+	OutputModel = "model"
+)
+
+const (
+	// 默认工作流的检索和组装参数
+	defaultSearchTopK       = 20
+	defaultRerankTopK       = 5
+	defaultMaxContextLength = 4000
+	// 检索步骤失败时的重试次数
+	defaultSearchRetries = 2
+)
+
+// pipelineStep is one stage of the default workflow
+type pipelineStep struct {
+	id, name        string
+	service, method string
+	// 可选步骤在服务未注册时省略，失败时以空输出继续
+	optional bool
+}
+
+var pipelineSteps = []pipelineStep{
+	{"preprocess", "Preprocess query", "preprocessor", "ProcessQuery", true},
+	{"embed", "Embed query", "embedding", "EmbedText", true},
+	{"search", "Hybrid search", "docstore", "SearchHybrid", false},
+	{"rerank", "Rerank documents", "reranker", "RerankDocuments", true},
+	{"assemble", "Assemble context", "assembler", "AssembleContext", false},
+	{"generate", "Generate answer", GeneratorService, GenerateMethod, true},
+}
+
+// defaultWorkflow builds the built-in workflow: preprocess, embed, hybrid
+// search, rerank, assemble and generate, run one after the other. Optional
+// stages are left out when their service is not registered, and with
+// fallback enabled one that fails passes an empty output on, so later stages
+// read the query, the search results or the context from the stage before.
+// Nothing after the search runs when it finds no documents. The generate
+// step may take as long as the generator is configured to.
+func defaultWorkflow(services ServiceRepo, generateTimeout time.Duration, enableFallback bool) (*v1.WorkflowDefinition, error) {
+	present := make(map[string]bool, len(pipelineSteps))
+	for _, s := range pipelineSteps {
+		methods, ok := services.Methods(s.service)
+		present[s.id] = ok && containsString(methods, s.method)
+		if !present[s.id] && !s.optional {
+			return nil, ErrDefaultWorkflowUnavailable.WithMetadata(map[string]string{"service": s.service})
+		}
+	}
+
+	query := "$.input.query"
+	if present["preprocess"] {
+		query = "$.steps.preprocess.output.processed_query ?? " + query
+	}
+	// 组装的候选文档优先取重排结果
+	candidates := `$.steps.search.output.results | select("document_id:chunk.document_id", "chunk_id:chunk.chunk_id", "content:chunk.content", "relevance_score:final_score")`
+	if present["rerank"] {
+		candidates = `$.steps.rerank.output.ranked_documents | select("document_id", "chunk_id", "content", "relevance_score:rerank_score") ?? ` + candidates
+	}
+
+	// 生成时参考的段落只取组装用到的分块
+	passages := `$.steps.search.output.results[?(@.chunk.chunk_id in $.steps.assemble.output.used_chunks[*].chunk_id)].chunk.content`
+	if present["rerank"] {
+		passages = `$.steps.rerank.output.ranked_documents[?(@.chunk_id in $.steps.assemble.output.used_chunks[*].chunk_id)].content ?? ` + passages
+	}
+
+	inputs := map[string]map[string]any{
+		"preprocess": {
+			"query": "$.input.query",
+		},
+		"embed": {
+			"text": query,
+		},
+		"search": {
+			"query_text": query,
+			"options": map[string]any{
+				"top_k":            defaultSearchTopK,
+				"fusion_method":    "rrf",
+				"include_metadata": true,
+			},
+		},
+		"rerank": {
+			"query":     query,
+			"documents": `$.steps.search.output.results | select("document_id:chunk.document_id", "chunk_id:chunk.chunk_id", "content:chunk.content", "initial_score:final_score")`,
+			"options": map[string]any{
+				"top_k": defaultRerankTopK,
+			},
+		},
+		"assemble": {
+			"query":  query,
+			"chunks": candidates,
+			"options": map[string]any{
+				"max_context_length":       defaultMaxContextLength,
+				"include_source_citations": true,
+			},
+			// 组装服务按模板渲染出生成用的提示词
+			"template": map[string]any{
+				"template_name": "default_answer",
+				"system_prompt": DefaultSystemPrompt,
+				"sections": []any{
+					map[string]any{"section_name": "context", "section_template": "Context:\n{{.context}}", "is_required": true},
+					map[string]any{"section_name": "question", "section_template": "Question: {{.query}}\nAnswer:", "is_required": true},
+				},
+			},
+		},
+		"generate": {
+			"query":         query,
+			"prompt":        "$.steps.assemble.output.assembled_context",
+			"system_prompt": DefaultSystemPrompt,
+			"passages":      passages,
+		},
+	}
+	if present["embed"] {
+		inputs["search"]["query_embedding"] = map[string]any{"values": "$.steps.embed.output.embedding"}
+	}
+	outputs := map[string]map[string]string{
+		"search": {
+			OutputDocumentsSearched: "$.output.results | length",
+		},
+		"assemble": {
+			OutputAssembledContext: "$.output.assembled_context",
+			OutputUsedChunks:       "$.output.used_chunks[*].chunk_id",
+			OutputContextDocuments: candidates,
+			OutputCitations:        "$.output.citations",
+		},
+		"generate": {
+			OutputFinalAnswer: "$.output.answer",
+			OutputModel:       "$.output.model",
+		},
+	}
+
+	def := &v1.WorkflowDefinition{
+		Name:        DefaultWorkflowName,
+		Description: "Built-in query workflow: preprocess, embed, hybrid search, rerank, assemble and generate",
+		Configuration: &v1.WorkflowConfiguration{
+			ExecutionStrategy: StrategySequential,
+			ErrorHandling: &v1.ErrorHandlingStrategy{
+				Strategy: ErrorFailFast,
+			},
+		},
+	}
+	search := -1
+	for _, s := range pipelineSteps {
+		if !present[s.id] {
+			continue
+		}
+		mapping, err := inputMapping(inputs[s.id])
+		if err != nil {
+			return nil, err
+		}
+		step := &v1.WorkflowStep{
+			StepId:        s.id,
+			StepName:      s.name,
+			ServiceName:   s.service,
+			MethodName:    s.method,
+			InputMapping:  mapping,
+			OutputMapping: outputs[s.id],
+			StepConfig:    &v1.StepConfiguration{},
+		}
+		if n := len(def.Steps); n > 0 {
+			step.DependsOn = []string{def.Steps[n-1].StepId}
+		}
+		switch {
+		case !s.optional:
+			step.StepConfig.RetryAttempts = defaultSearchRetries
+			step.StepConfig.RetryStrategy = RetryExponential
+		case enableFallback:
+			// 空的默认值让后续步骤的 ?? 退回到前一阶段的数据
+			empty, err := anypb.New(&structpb.Struct{})
+			if err != nil {
+				return nil, err
+			}
+			step.StepConfig.EnableFallback = true
+			step.StepConfig.FallbackConfig = &v1.FallbackConfiguration{
+				FallbackType:  FallbackDefaultValue,
+				FallbackValue: empty,
+			}
+		}
+		if s.id == "search" {
+			search = len(def.Steps)
+		}
+		if s.id == "generate" && generateTimeout > 0 {
+			// 生成通常比其他步骤慢得多，步骤超时跟随生成请求的超时
+			step.StepConfig.TimeoutSeconds = int32(math.Ceil(generateTimeout.Seconds()))
+		}
+		def.Steps = append(def.Steps, step)
+	}
+
+	// 检索不到文档时跳过之后的步骤
+	var later []string
+	for _, step := range def.Steps[search+1:] {
+		later = append(later, step.StepId)
+	}
+	def.Steps[search].Conditional = &v1.ConditionalExecution{
+		Condition:     "$.output.results | length > 0",
+		ConditionType: ConditionSimple,
+		ExecuteIfTrue: later,
+	}
+	return def, nil
+}
+
+// inputMapping packs expressions and literal option trees into an input
+// mapping
+func inputMapping(fields map[string]any) (map[string]*anypb.Any, error) {
+	mapping := make(map[string]*anypb.Any, len(fields))
+	for name, value := range fields {
+		var (
+			a   *anypb.Any
+			err error
+		)
+		if expr, ok := value.(string); ok {
+			a, err = anypb.New(wrapperspb.String(expr))
+		} else {
+			var s *structpb.Value
+			if s, err = structpb.NewValue(value); err == nil {
+				a, err = anypb.New(s)
+			}
+		}
+		if err != nil {
+			return nil, fmt.Errorf("input %s: %w", name, err)
+		}
+		mapping[name] = a
+	}
+	return mapping, nil
+}
+
+// applyServiceOptions overrides the request fields of every step that calls
+// a service listed in options. A struct sets the top-level fields it names;
+// any other message replaces the request's options field. The values are
+// literals, so strings starting with "$" are escaped. def is not modified.
+func applyServiceOptions(def *v1.WorkflowDefinition, options map[string]*anypb.Any) (*v1.WorkflowDefinition, error) {
+	if len(options) == 0 {
+		return def, nil
+	}
+	overrides := make(map[string]map[string]*anypb.Any, len(options))
+	for service, a := range options {
+		tree, err := anyToTree(a)
+		if err != nil {
+			return nil, fmt.Errorf("service_options[%s]: %w", service, err)
+		}
+		fields, ok := tree.(map[string]any)
+		if !ok {
+			return nil, fmt.Errorf("service_options[%s]: expects a message, got %s", service, typeName(tree))
+		}
+		if !a.MessageIs(&structpb.Struct{}) {
+			fields = map[string]any{"options": fields}
+		}
+		overrides[service] = make(map[string]*anypb.Any, len(fields))
+		for name, value := range fields {
+			packed, err := treeToAny(escapeLiterals(value))
+			if err != nil {
+				return nil, fmt.Errorf("service_options[%s].%s: %w", service, name, err)
+			}
+			if packed != nil {
+				overrides[service][name] = packed
+			}
+		}
+	}
+
+	def = proto.Clone(def).(*v1.WorkflowDefinition)
+	for _, step := range def.Steps {
+		fields, ok := overrides[step.ServiceName]
+		if !ok {
+			continue
+		}
+		if step.InputMapping == nil {
+			step.InputMapping = make(map[string]*anypb.Any, len(fields))
+		}
+		for name, value := range fields {
+			step.InputMapping[name] = value
+		}
+	}
+	return def, nil
+}
+
+// escapeLiterals escapes every string of a tree that would otherwise read as
+// an expression in an input mapping
+func escapeLiterals(tree any) any {
+	switch t := tree.(type) {
+	case string:
+		if strings.HasPrefix(t, "$") {
+			return "$" + t
+		}
+	case []any:
+		out := make([]any, len(t))
+		for i, item := range t {
+			out[i] = escapeLiterals(item)
+		}
+		return out
+	case map[string]any:
+		out := make(map[string]any, len(t))
+		for k, item := range t {
+			out[k] = escapeLiterals(item)
+		}
+		return out
+	}
+	return tree
+}
@@ -0,0 +1,263 @@
+package biz
+
+import (
+	"context"
+	"reflect"
+	"strings"
+	"sync"
+	"testing"
+
+	v1 "rag/api/orchestrator/v1"
+
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/log"
+	"google.golang.org/protobuf/types/known/anypb"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+// pipelineServices registers the services of the default workflow stages
+// listed; every stage answers with an empty output
+func pipelineServices(t testing.TB, stages ...string) *fakeServices {
+	handlers := make(map[string]stepHandler)
+	for _, s := range pipelineSteps {
+		for _, id := range stages {
+			if id == s.id {
+				handlers[s.service+"."+s.method] = echo(t, s.id)
+			}
+		}
+	}
+	return newFakeServices(handlers)
+}
+
+func TestDefaultWorkflowSteps(t *testing.T) {
+	tests := []struct {
+		name    string
+		stages  []string
+		want    []string
+		missing string
+	}{
+		{"all", []string{"preprocess", "embed", "search", "rerank", "assemble", "generate"}, []string{"preprocess", "embed", "search", "rerank", "assemble", "generate"}, ""},
+		// 可选阶段的服务未注册时省略
+		{"required only", []string{"search", "assemble"}, []string{"search", "assemble"}, ""},
+		{"no rerank", []string{"embed", "search", "assemble", "generate"}, []string{"embed", "search", "assemble", "generate"}, ""},
+		{"no docstore", []string{"preprocess", "rerank", "assemble"}, nil, "docstore"},
+		{"no assembler", []string{"search", "generate"}, nil, "assembler"},
+	}
+	for _, tt := range tests {
+		def, err := defaultWorkflow(pipelineServices(t, tt.stages...), 0, false)
+		if tt.missing != "" {
+			if !errors.Is(err, ErrDefaultWorkflowUnavailable) || errors.FromError(err).Metadata["service"] != tt.missing {
+				t.Errorf("%s: err = %v, want %s unavailable", tt.name, err, tt.missing)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("%s: %v", tt.name, err)
+			continue
+		}
+		var ids []string
+		for i, step := range def.Steps {
+			ids = append(ids, step.StepId)
+			// 步骤依次执行
+			if i == 0 && len(step.DependsOn) != 0 || i > 0 && !reflect.DeepEqual(step.DependsOn, []string{def.Steps[i-1].StepId}) {
+				t.Errorf("%s: %s depends on %v", tt.name, step.StepId, step.DependsOn)
+			}
+		}
+		if !reflect.DeepEqual(ids, tt.want) {
+			t.Errorf("%s: steps %v, want %v", tt.name, ids, tt.want)
+		}
+	}
+}
+
+func TestDefaultWorkflowStepConfig(t *testing.T) {
+	services := pipelineServices(t, "preprocess", "embed", "search", "rerank", "assemble", "generate")
+	for _, fallback := range []bool{false, true} {
+		def, err := defaultWorkflow(services, 0, fallback)
+		if err != nil {
+			t.Fatal(err)
+		}
+		for _, step := range def.Steps {
+			config := step.GetStepConfig()
+			required := step.StepId == "search" || step.StepId == "assemble"
+			if required && (config.RetryAttempts != defaultSearchRetries || config.RetryStrategy != RetryExponential || config.EnableFallback) {
+				t.Errorf("fallback %t: required step %s config = %v", fallback, step.StepId, config)
+			}
+			if !required && (config.RetryAttempts != 0 || config.EnableFallback != fallback) {
+				t.Errorf("fallback %t: optional step %s config = %v", fallback, step.StepId, config)
+			}
+			if !required && fallback && config.GetFallbackConfig().GetFallbackType() != FallbackDefaultValue {
+				t.Errorf("optional step %s fallback = %v", step.StepId, config.GetFallbackConfig())
+			}
+		}
+	}
+
+	// 检索不到文档时跳过之后的所有步骤
+	def, err := defaultWorkflow(services, 0, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	conditional := def.Steps[2].GetConditional()
+	if def.Steps[2].StepId != "search" || !reflect.DeepEqual(conditional.GetExecuteIfTrue(), []string{"rerank", "assemble", "generate"}) {
+		t.Errorf("search conditional = %v", conditional)
+	}
+	if _, ok := def.Steps[3].InputMapping["query"]; !ok {
+		t.Errorf("rerank input = %v", def.Steps[3].InputMapping)
+	}
+	if _, ok := def.Steps[2].InputMapping["query_embedding"]; !ok {
+		t.Error("search does not read the query embedding")
+	}
+}
+
+func TestApplyServiceOptions(t *testing.T) {
+	def, err := defaultWorkflow(pipelineServices(t, "search", "rerank", "assemble"), 0, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	topK, err := structpb.NewStruct(map[string]any{"top_k": 3, "filter": "$.input.user_id"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	options := map[string]*anypb.Any{
+		// 结构体覆盖同名的请求字段，其他消息替换 options
+		"docstore": packValue(t, &structpb.Struct{Fields: map[string]*structpb.Value{"options": structpb.NewStructValue(topK)}}),
+		"reranker": packValue(t, structpb.NewStructValue(topK)),
+	}
+	applied, err := applyServiceOptions(def, options)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := map[string]any{"top_k": float64(3), "filter": "$$.input.user_id"}
+	for _, i := range []int{0, 1} {
+		step := applied.Steps[i]
+		got, err := anyToTree(step.InputMapping["options"])
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !reflect.DeepEqual(got, want) {
+			t.Errorf("%s options = %v, want %v", step.StepId, got, want)
+		}
+	}
+	if _, ok := applied.Steps[0].InputMapping["query_text"]; !ok {
+		t.Error("search lost its other inputs")
+	}
+	if reflect.DeepEqual(def.Steps[0].InputMapping["options"], applied.Steps[0].InputMapping["options"]) {
+		t.Error("applyServiceOptions modified the definition")
+	}
+
+	if _, err := applyServiceOptions(def, map[string]*anypb.Any{"docstore": packString(t, "top_k")}); err == nil {
+		t.Error("options that are not a message accepted")
+	}
+}
+
+// pipelineRecorder answers the service stages of the default workflow and records
+// the input each stage read
+type pipelineRecorder struct {
+	mu     sync.Mutex
+	inputs map[string]map[string]any
+}
+
+func (r *pipelineRecorder) handler(t testing.TB, id string, output map[string]any, err error) stepHandler {
+	return func(ctx context.Context, input map[string]*anypb.Any) (map[string]*anypb.Any, error) {
+		tree := make(map[string]any, len(input))
+		for name, a := range input {
+			v, err := anyToTree(a)
+			if err != nil {
+				t.Error(err)
+			}
+			tree[name] = v
+		}
+		r.mu.Lock()
+		r.inputs[id] = tree
+		r.mu.Unlock()
+		if err != nil {
+			return nil, err
+		}
+		out := make(map[string]*anypb.Any, len(output))
+		for name, v := range output {
+			out[name] = packTree(t, v)
+		}
+		return out, nil
+	}
+}
+
+func (r *pipelineRecorder) input(id, name string) any {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.inputs[id][name]
+}
+
+func TestProcessQueryDefaultWorkflow(t *testing.T) {
+	results := []any{
+		map[string]any{"final_score": 0.4, "chunk": map[string]any{"document_id": "d1", "chunk_id": "k1", "content": "alpha"}},
+		map[string]any{"final_score": 0.3, "chunk": map[string]any{"document_id": "d2", "chunk_id": "k2", "content": "beta"}},
+	}
+	pipeline := func(preprocessErr error, found []any) (*QueryUsecase, *pipelineRecorder, *fakeGenerator) {
+		r := &pipelineRecorder{inputs: make(map[string]map[string]any)}
+		services := newFakeServices(map[string]stepHandler{
+			"preprocessor.ProcessQuery": r.handler(t, "preprocess", map[string]any{"processed_query": "rewritten"}, preprocessErr),
+			"docstore.SearchHybrid":     r.handler(t, "search", map[string]any{"results": found}, nil),
+			"reranker.RerankDocuments": r.handler(t, "rerank", map[string]any{"ranked_documents": []any{
+				map[string]any{"document_id": "d2", "chunk_id": "k2", "content": "beta", "rerank_score": 0.9},
+				map[string]any{"document_id": "d1", "chunk_id": "k1", "content": "alpha", "rerank_score": 0.2},
+			}}, nil),
+			"assembler.AssembleContext": r.handler(t, "assemble", map[string]any{
+				"assembled_context": "Context: beta",
+				"used_chunks":       []any{map[string]any{"chunk_id": "k2"}},
+			}, nil),
+		})
+		generator := &fakeGenerator{chunks: []string{"B"}}
+		engine := NewWorkflowEngine(services, newTestGeneration(generator), log.DefaultLogger)
+		return NewQueryUsecase(engine, nil, nil, log.DefaultLogger), r, generator
+	}
+	ctx := context.Background()
+
+	uc, r, generator := pipeline(nil, results)
+	resp, err := uc.ProcessQuery(ctx, &v1.ProcessQueryRequest{Query: "q"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if resp.FinalAnswer != "B" {
+		t.Errorf("answer = %q", resp.FinalAnswer)
+	}
+	// 上下文文档取重排结果中组装用到的分块
+	if len(resp.ContextDocuments) != 1 || resp.ContextDocuments[0].ChunkId != "k2" {
+		t.Errorf("context documents = %v", resp.ContextDocuments)
+	}
+	if got := r.input("search", "query_text"); got != "rewritten" {
+		t.Errorf("search query = %v, want the preprocessed query", got)
+	}
+	if generator.last.Prompt != "Context: beta" || !reflect.DeepEqual(generator.last.Passages, []string{"beta"}) {
+		t.Errorf("generated from %q with passages %v", generator.last.Prompt, generator.last.Passages)
+	}
+	if got := strings.Join(resp.Metadata.GetServicesCalled(), ","); got != "assembler,docstore,generator,preprocessor,reranker" {
+		t.Errorf("services called = %s", got)
+	}
+
+	// 预处理失败时退回原始查询继续
+	uc, r, _ = pipeline(errors.ServiceUnavailable("UNAVAILABLE", "preprocessor down"), results)
+	resp, err = uc.ProcessQuery(ctx, &v1.ProcessQueryRequest{Query: "q", Options: &v1.QueryProcessingOptions{EnableFallback: true}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := r.input("search", "query_text"); got != "q" || resp.FinalAnswer != "B" {
+		t.Errorf("after a failed preprocess: search query %v, answer %q", got, resp.FinalAnswer)
+	}
+
+	// 检索不到文档时不再重排、组装和生成
+	uc, r, generator = pipeline(nil, []any{})
+	resp, err = uc.ProcessQuery(ctx, &v1.ProcessQueryRequest{Query: "q"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if resp.FinalAnswer != "" || len(resp.ContextDocuments) != 0 {
+		t.Errorf("empty search answered %q with %v", resp.FinalAnswer, resp.ContextDocuments)
+	}
+	for _, id := range []string{"rerank", "assemble"} {
+		if r.input(id, "query") != nil {
+			t.Errorf("%s ran after an empty search", id)
+		}
+	}
+	if generator.last != nil {
+		t.Error("generated after an empty search")
+	}
+}
@@ -0,0 +1,442 @@
+package biz
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"sort"
+	"sync"
+	"time"
+
+	commonv1 "rag/api/common/v1"
+	v1 "rag/api/orchestrator/v1"
+
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/golang/protobuf/proto"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/types/known/anypb"
+	"google.golang.org/protobuf/types/known/structpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+// Workflow inputs ProcessQuery provides to every workflow.
+const (
+	InputQuery     = "query"
+	InputSessionID = "session_id"
+	InputUserID    = "user_id"
+	InputContext   = "context"
+	// 工作流输出中作为最终答案的键
+	OutputFinalAnswer = "final_answer"
+)
+
+var (
+	// ErrWorkflowNotFound is returned when a workflow definition does not exist.
+	ErrWorkflowNotFound = errors.NotFound(commonv1.ErrorCode_ERROR_CODE_NOT_FOUND.String(), "workflow definition not found")
+	// ErrDefaultWorkflowUnavailable is returned when a service the default workflow needs is not registered.
+	ErrDefaultWorkflowUnavailable = errors.ServiceUnavailable(commonv1.ErrorCode_ERROR_CODE_SERVICE_UNAVAILABLE.String(), "default workflow needs a service that is not registered")
+)
+
+// 缓存的查询结果的有效期
+const queryCacheTTL = 5 * time.Minute
+
+// QueryCache stores query responses keyed by the query and the workflow
+// that answered it
+type QueryCache interface {
+	// 读取未过期的结果，返回副本
+	Get(ctx context.Context, key string) (*v1.ProcessQueryResponse, bool)
+	// 写入结果并设置过期时间
+	Set(ctx context.Context, key string, resp *v1.ProcessQueryResponse, ttl time.Duration)
+}
+
+// QueryUsecase answers queries by running them through a workflow.
+type QueryUsecase struct {
+	engine      *WorkflowEngine
+	definitions DefinitionRepo
+	cache       QueryCache
+	log         *log.Helper
+}
+
+// NewQueryUsecase creates a query usecase
+func NewQueryUsecase(engine *WorkflowEngine, definitions DefinitionRepo, cache QueryCache, logger log.Logger) *QueryUsecase {
+	return &QueryUsecase{
+		engine:      engine,
+		definitions: definitions,
+		cache:       cache,
+		log:         log.NewHelper(logger),
+	}
+}
+
+// ProcessQuery runs the query through its custom workflow, the stored
+// workflow it names, or the built-in default workflow, with the query,
+// session, user and context as inputs. service_options override the requests
+// of the steps calling each service. The workflow outputs named
+// final_answer, context_documents and citations become the answer, its
+// documents and citations; the time spent in each service is summed from the
+// calls the steps made. With caching enabled, a completed response is reused
+// for the same query and workflow.
+func (uc *QueryUsecase) ProcessQuery(ctx context.Context, req *v1.ProcessQueryRequest) (*v1.ProcessQueryResponse, error) {
+	return uc.process(ctx, req, nil)
+}
+
+// ProcessQueryStream answers a query like ProcessQuery and sends its
+// progress in order: the context documents as soon as the workflow
+// publishes them, the answer while it is generated, and the response.
+// What the workflow did not stream, such as a cached response, is sent
+// before the response. Cancelling ctx cancels the workflow.
+func (uc *QueryUsecase) ProcessQueryStream(ctx context.Context, req *v1.ProcessQueryRequest, send func(*v1.ProcessQueryEvent) error) error {
+	stream := &queryStream{id: newExecutionID(), send: send}
+	resp, err := uc.process(ctx, req, stream)
+	if err != nil {
+		return err
+	}
+	return stream.finish(resp)
+}
+
+// process answers a query, streaming its progress when stream is set
+func (uc *QueryUsecase) process(ctx context.Context, req *v1.ProcessQueryRequest, stream *queryStream) (*v1.ProcessQueryResponse, error) {
+	options := req.GetOptions()
+	def, err := uc.workflow(ctx, options)
+	if err != nil {
+		return nil, err
+	}
+	if def, err = applyServiceOptions(def, options.GetServiceOptions()); err != nil {
+		return nil, errors.BadRequest(commonv1.ErrorCode_ERROR_CODE_BAD_REQUEST.String(), err.Error())
+	}
+	input, err := queryInput(req)
+	if err != nil {
+		return nil, errors.BadRequest(commonv1.ErrorCode_ERROR_CODE_BAD_REQUEST.String(), err.Error())
+	}
+
+	var cacheKey string
+	if options.GetEnableCaching() && uc.cache != nil {
+		if cacheKey, err = queryCacheKey(req, def); err != nil {
+			uc.log.WithContext(ctx).Warnf("query not cached: %v", err)
+		} else if resp, ok := uc.cache.Get(ctx, cacheKey); ok {
+			if resp.Metadata.DebugInfo == nil {
+				resp.Metadata.DebugInfo = make(map[string]string)
+			}
+			resp.Metadata.DebugInfo["cache_hit"] = "true"
+			return resp, nil
+		}
+	}
+
+	var (
+		executionID string
+		hooks       *runHooks
+	)
+	if stream != nil {
+		executionID = stream.id
+		ctx = withAnswerStream(ctx, stream.answer)
+		hooks = &runHooks{progress: func(stepID string, outputs map[string]*anypb.Any) {
+			if _, ok := outputs[OutputContextDocuments]; ok {
+				// 发送失败说明客户端已断开，工作流随请求取消
+				_ = stream.documents(uc.contextDocuments(ctx, outputs), documentsSearched(outputs))
+			}
+		}}
+	}
+	execution, err := uc.engine.execute(ctx, executionID, def, input, &v1.ExecutionOptions{
+		TimeoutSeconds: options.GetTimeoutSeconds(),
+		EnableFallback: options.GetEnableFallback(),
+	}, hooks)
+	if err != nil {
+		return nil, err
+	}
+	summary := execution.Trace.Summary
+	switch execution.Status {
+	case commonv1.ProcessingStatus_PROCESSING_STATUS_COMPLETED:
+	case commonv1.ProcessingStatus_PROCESSING_STATUS_CANCELLED:
+		return nil, errors.ClientClosed(commonv1.ErrorCode_ERROR_CODE_WORKFLOW_FAILED.String(), summary.FailureReason)
+	default:
+		return nil, errors.InternalServer(commonv1.ErrorCode_ERROR_CODE_WORKFLOW_FAILED.String(), summary.FailureReason).
+			WithMetadata(map[string]string{"execution_id": execution.ID})
+	}
+
+	resp := &v1.ProcessQueryResponse{
+		QueryId:          execution.ID,
+		ContextDocuments: uc.contextDocuments(ctx, execution.Outputs),
+		ExecutionTrace:   execution.Trace,
+		Citations:        uc.citations(ctx, execution.Outputs),
+	}
+	if answer, ok := execution.Outputs[OutputFinalAnswer]; ok {
+		var s wrapperspb.StringValue
+		if err := answer.UnmarshalTo(&s); err == nil {
+			resp.FinalAnswer = s.Value
+		}
+	}
+	resp.Metadata = queryMetadata(def, execution, len(resp.ContextDocuments))
+	if cacheKey != "" {
+		uc.cache.Set(ctx, cacheKey, resp, queryCacheTTL)
+	}
+	return resp, nil
+}
+
+// workflow picks the definition a query runs: its custom workflow, the
+// stored workflow it names, or the built-in default. The default's name
+// refers to the built-in workflow unless a stored one replaces it.
+func (uc *QueryUsecase) workflow(ctx context.Context, options *v1.QueryProcessingOptions) (*v1.WorkflowDefinition, error) {
+	if def := options.GetCustomWorkflow(); def != nil {
+		return def, nil
+	}
+	ref := options.GetWorkflowId()
+	if ref != "" {
+		_, def, err := resolveDefinition(ctx, uc.definitions, ref)
+		if err == nil || ref != DefaultWorkflowName || !errors.Is(err, ErrWorkflowNotFound) {
+			return def, err
+		}
+	}
+	return defaultWorkflow(uc.engine.services, uc.engine.generation.timeout(), options.GetEnableFallback())
+}
+
+// contextDocuments reads the context_documents output, keeping only the
+// chunks the used_chunks output lists when there is one. Entries that are
+// not documents are dropped.
+func (uc *QueryUsecase) contextDocuments(ctx context.Context, outputs map[string]*anypb.Any) []*v1.ContextDocument {
+	value, ok := outputs[OutputContextDocuments]
+	if !ok {
+		return nil
+	}
+	tree, err := anyToTree(value)
+	if err != nil {
+		uc.log.WithContext(ctx).Warnf("unreadable %s output: %v", OutputContextDocuments, err)
+		return nil
+	}
+	items, _ := tree.([]any)
+	var used map[string]bool
+	if value, ok := outputs[OutputUsedChunks]; ok {
+		if tree, err := anyToTree(value); err == nil {
+			ids, _ := tree.([]any)
+			used = make(map[string]bool, len(ids))
+			for _, id := range ids {
+				used[stringify(id)] = true
+			}
+		}
+	}
+
+	var docs []*v1.ContextDocument
+	for _, item := range items {
+		raw, err := json.Marshal(item)
+		if err != nil {
+			continue
+		}
+		doc := new(v1.ContextDocument)
+		if err := (protojson.UnmarshalOptions{DiscardUnknown: true}).Unmarshal(raw, proto.MessageV2(doc)); err != nil {
+			uc.log.WithContext(ctx).Warnf("dropping context document: %v", err)
+			continue
+		}
+		if used != nil && !used[doc.ChunkId] {
+			continue
+		}
+		docs = append(docs, doc)
+	}
+	return docs
+}
+
+// citations reads the citations output. Entries that are not citations are
+// dropped.
+func (uc *QueryUsecase) citations(ctx context.Context, outputs map[string]*anypb.Any) []*commonv1.Citation {
+	value, ok := outputs[OutputCitations]
+	if !ok {
+		return nil
+	}
+	tree, err := anyToTree(value)
+	if err != nil {
+		uc.log.WithContext(ctx).Warnf("unreadable %s output: %v", OutputCitations, err)
+		return nil
+	}
+	items, _ := tree.([]any)
+	var citations []*commonv1.Citation
+	for _, item := range items {
+		raw, err := json.Marshal(item)
+		if err != nil {
+			continue
+		}
+		citation := new(commonv1.Citation)
+		if err := (protojson.UnmarshalOptions{DiscardUnknown: true}).Unmarshal(raw, proto.MessageV2(citation)); err != nil {
+			uc.log.WithContext(ctx).Warnf("dropping citation: %v", err)
+			continue
+		}
+		citations = append(citations, citation)
+	}
+	return citations
+}
+
+// documentsSearched reads the documents_searched output
+func documentsSearched(outputs map[string]*anypb.Any) int32 {
+	value, ok := outputs[OutputDocumentsSearched]
+	if !ok {
+		return 0
+	}
+	tree, err := anyToTree(value)
+	if err != nil {
+		return 0
+	}
+	n, _ := tree.(float64)
+	return int32(n)
+}
+
+// queryStream sends the events of a streamed query. The documents are sent
+// from the scheduler and the answer from the generating step, so sends are
+// serialized.
+type queryStream struct {
+	id   string
+	send func(*v1.ProcessQueryEvent) error
+
+	mu sync.Mutex
+	// 检索结果和答案片段是否已发送
+	retrieved bool
+	answered  bool
+}
+
+// documents sends the retrieval event, once
+func (s *queryStream) documents(docs []*v1.ContextDocument, searched int32) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.retrieval(docs, searched)
+}
+
+func (s *queryStream) retrieval(docs []*v1.ContextDocument, searched int32) error {
+	if s.retrieved {
+		return nil
+	}
+	s.retrieved = true
+	return s.send(&v1.ProcessQueryEvent{
+		QueryId: s.id,
+		Event: &v1.ProcessQueryEvent_Retrieval{Retrieval: &v1.QueryRetrieval{
+			ContextDocuments:       docs,
+			TotalDocumentsSearched: searched,
+		}},
+	})
+}
+
+// answer sends a piece of the answer. An answer generated before the
+// workflow published any documents follows an empty retrieval event, so
+// the events stay in order.
+func (s *queryStream) answer(delta string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if err := s.retrieval(nil, 0); err != nil {
+		return err
+	}
+	s.answered = true
+	return s.send(&v1.ProcessQueryEvent{
+		QueryId: s.id,
+		Event:   &v1.ProcessQueryEvent_AnswerDelta{AnswerDelta: &v1.QueryAnswerDelta{Text: delta}},
+	})
+}
+
+// finish sends the documents and the answer when they were not streamed,
+// then the response
+func (s *queryStream) finish(resp *v1.ProcessQueryResponse) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	// 命中缓存时沿用缓存结果的 id
+	s.id = resp.QueryId
+	if err := s.retrieval(resp.ContextDocuments, resp.GetMetadata().GetTotalDocumentsSearched()); err != nil {
+		return err
+	}
+	if !s.answered && resp.FinalAnswer != "" {
+		s.answered = true
+		if err := s.send(&v1.ProcessQueryEvent{
+			QueryId: s.id,
+			Event:   &v1.ProcessQueryEvent_AnswerDelta{AnswerDelta: &v1.QueryAnswerDelta{Text: resp.FinalAnswer}},
+		}); err != nil {
+			return err
+		}
+	}
+	return s.send(&v1.ProcessQueryEvent{
+		QueryId: s.id,
+		Event:   &v1.ProcessQueryEvent_Completed{Completed: resp},
+	})
+}
+
+// queryCacheKey hashes the query inputs together with the definition that
+// answers them, so a changed workflow or option misses the cache
+func queryCacheKey(req *v1.ProcessQueryRequest, def *v1.WorkflowDefinition) (string, error) {
+	keyed := &v1.ProcessQueryRequest{
+		Query:     req.Query,
+		SessionId: req.SessionId,
+		UserId:    req.UserId,
+		Context:   req.Context,
+		Options: &v1.QueryProcessingOptions{
+			CustomWorkflow: def,
+			EnableFallback: req.GetOptions().GetEnableFallback(),
+		},
+	}
+	// 经 JSON 树再编码，map 字段和 Any 内容的顺序固定
+	raw, err := protojson.Marshal(proto.MessageV2(keyed))
+	if err != nil {
+		return "", err
+	}
+	var tree any
+	if err := json.Unmarshal(raw, &tree); err != nil {
+		return "", err
+	}
+	if raw, err = json.Marshal(tree); err != nil {
+		return "", err
+	}
+	sum := sha256.Sum256(raw)
+	return hex.EncodeToString(sum[:]), nil
+}
+
+// queryInput packs the request into workflow inputs
+func queryInput(req *v1.ProcessQueryRequest) (map[string]*anypb.Any, error) {
+	values := map[string]string{
+		InputQuery:     req.Query,
+		InputSessionID: req.SessionId,
+		InputUserID:    req.UserId,
+	}
+	input := make(map[string]*anypb.Any, len(values)+1)
+	for k, v := range values {
+		a, err := anypb.New(wrapperspb.String(v))
+		if err != nil {
+			return nil, err
+		}
+		input[k] = a
+	}
+	fields := make(map[string]any, len(req.Context))
+	for k, v := range req.Context {
+		fields[k] = v
+	}
+	s, err := structpb.NewStruct(fields)
+	if err != nil {
+		return nil, err
+	}
+	if input[InputContext], err = anypb.New(s); err != nil {
+		return nil, err
+	}
+	return input, nil
+}
+
+// queryMetadata sums the time spent in each service over the calls the
+// steps made, retries and compensations included
+func queryMetadata(def *v1.WorkflowDefinition, execution *Execution, returned int) *v1.QueryProcessingMetadata {
+	metadata := &v1.QueryProcessingMetadata{
+		TotalProcessingTimeMs:  execution.CompletedAt.Sub(execution.StartedAt).Milliseconds(),
+		ServiceProcessingTimes: make(map[string]int64),
+		WorkflowUsed:           def.Name,
+		DocumentsReturned:      int32(returned),
+		StartedAt:              timestamppb.New(execution.StartedAt),
+		CompletedAt:            timestamppb.New(execution.CompletedAt),
+	}
+	elapsed := make(map[string]time.Duration)
+	for _, span := range execution.Spans {
+		if _, ok := elapsed[span.Service]; !ok {
+			metadata.ServicesCalled = append(metadata.ServicesCalled, span.Service)
+		}
+		elapsed[span.Service] += span.Duration
+	}
+	for service, d := range elapsed {
+		metadata.ServiceProcessingTimes[service] = d.Milliseconds()
+	}
+	sort.Strings(metadata.ServicesCalled)
+	metadata.TotalDocumentsSearched = documentsSearched(execution.Outputs)
+	if value, ok := execution.Outputs[OutputModel]; ok {
+		if tree, err := anyToTree(value); err == nil {
+			metadata.DebugInfo = map[string]string{OutputModel: stringify(tree)}
+		}
+	}
+	return metadata
+}
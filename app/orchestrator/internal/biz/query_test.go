@@ -0,0 +1,130 @@
+package biz
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	v1 "rag/api/orchestrator/v1"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/golang/protobuf/proto"
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+type memoryCache map[string]*v1.ProcessQueryResponse
+
+func (c memoryCache) Get(ctx context.Context, key string) (*v1.ProcessQueryResponse, bool) {
+	resp, ok := c[key]
+	if !ok {
+		return nil, false
+	}
+	return proto.Clone(resp).(*v1.ProcessQueryResponse), true
+}
+
+func (c memoryCache) Set(ctx context.Context, key string, resp *v1.ProcessQueryResponse, ttl time.Duration) {
+	c[key] = proto.Clone(resp).(*v1.ProcessQueryResponse)
+}
+
+// streamWorkflow searches and then generates, publishing the documents and
+// the answer
+func streamWorkflow(t testing.TB, search bool) *v1.WorkflowDefinition {
+	generate := &v1.WorkflowStep{
+		StepId:        "generate",
+		ServiceName:   GeneratorService,
+		MethodName:    GenerateMethod,
+		InputMapping:  map[string]*anypb.Any{"prompt": packString(t, "$.input.query")},
+		OutputMapping: map[string]string{OutputFinalAnswer: "$.output.answer"},
+	}
+	def := &v1.WorkflowDefinition{Name: "stream", Steps: []*v1.WorkflowStep{generate}}
+	if search {
+		s := testStep("search", "search")
+		s.OutputMapping = map[string]string{OutputContextDocuments: "$.output.documents"}
+		generate.DependsOn = []string{"search"}
+		def.Steps = []*v1.WorkflowStep{s, generate}
+	}
+	return def
+}
+
+func newStreamUsecase(t testing.TB, cache QueryCache) *QueryUsecase {
+	services := newFakeServices(map[string]stepHandler{
+		"svc.search": func(context.Context, map[string]*anypb.Any) (map[string]*anypb.Any, error) {
+			docs, err := treeToAny([]any{map[string]any{"document_id": "d1", "content": "alpha"}})
+			return map[string]*anypb.Any{"documents": docs}, err
+		},
+	})
+	generation := newTestGeneration(&fakeGenerator{info: GeneratorInfo{Model: "m"}, chunks: []string{"Hel", "lo"}})
+	return NewQueryUsecase(NewWorkflowEngine(services, generation, log.DefaultLogger), nil, cache, log.DefaultLogger)
+}
+
+// streamEvents describes the events of a streamed query in order
+func streamEvents(t *testing.T, uc *QueryUsecase, req *v1.ProcessQueryRequest) ([]string, []*v1.ProcessQueryEvent) {
+	t.Helper()
+	var (
+		kinds  []string
+		events []*v1.ProcessQueryEvent
+	)
+	err := uc.ProcessQueryStream(context.Background(), req, func(event *v1.ProcessQueryEvent) error {
+		events = append(events, event)
+		switch e := event.Event.(type) {
+		case *v1.ProcessQueryEvent_Retrieval:
+			kinds = append(kinds, fmt.Sprintf("retrieval:%d", len(e.Retrieval.ContextDocuments)))
+		case *v1.ProcessQueryEvent_AnswerDelta:
+			kinds = append(kinds, "delta:"+e.AnswerDelta.Text)
+		case *v1.ProcessQueryEvent_Completed:
+			kinds = append(kinds, "completed:"+e.Completed.FinalAnswer)
+		}
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return kinds, events
+}
+
+func TestProcessQueryStreamOrder(t *testing.T) {
+	uc := newStreamUsecase(t, nil)
+	kinds, events := streamEvents(t, uc, &v1.ProcessQueryRequest{
+		Query:   "q",
+		Options: &v1.QueryProcessingOptions{CustomWorkflow: streamWorkflow(t, true)},
+	})
+	if got := strings.Join(kinds, " "); got != "retrieval:1 delta:Hel delta:lo completed:Hello" {
+		t.Errorf("events = %s", got)
+	}
+	for _, e := range events {
+		if e.QueryId != events[0].QueryId || e.QueryId == "" {
+			t.Errorf("query ids %s and %s differ", e.QueryId, events[0].QueryId)
+		}
+	}
+
+	// 没有检索结果的工作流先发送空的检索事件
+	kinds, _ = streamEvents(t, uc, &v1.ProcessQueryRequest{
+		Query:   "q",
+		Options: &v1.QueryProcessingOptions{CustomWorkflow: streamWorkflow(t, false)},
+	})
+	if got := strings.Join(kinds, " "); got != "retrieval:0 delta:Hel delta:lo completed:Hello" {
+		t.Errorf("events without documents = %s", got)
+	}
+}
+
+func TestProcessQueryStreamCached(t *testing.T) {
+	uc := newStreamUsecase(t, memoryCache{})
+	req := &v1.ProcessQueryRequest{
+		Query:   "q",
+		Options: &v1.QueryProcessingOptions{CustomWorkflow: streamWorkflow(t, true), EnableCaching: true},
+	}
+	_, first := streamEvents(t, uc, req)
+	kinds, events := streamEvents(t, uc, req)
+	// 命中缓存时答案作为一个片段发送
+	if got := strings.Join(kinds, " "); got != "retrieval:1 delta:Hello completed:Hello" {
+		t.Errorf("cached events = %s", got)
+	}
+	if events[0].QueryId != first[0].QueryId {
+		t.Errorf("cached query id %s, want %s", events[0].QueryId, first[0].QueryId)
+	}
+	if events[2].GetCompleted().Metadata.DebugInfo["cache_hit"] != "true" {
+		t.Error("cached response not marked")
+	}
+}
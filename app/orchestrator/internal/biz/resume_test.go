@@ -0,0 +1,129 @@
+package biz
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	commonv1 "rag/api/common/v1"
+	v1 "rag/api/orchestrator/v1"
+
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+// interrupted runs def with d failing and returns the checkpoints it left
+func interrupted(t *testing.T, def *v1.WorkflowDefinition) map[string]*StepCheckpoint {
+	t.Helper()
+	services := compensatedServices(t, nil)
+	checkpoints := make(map[string]*StepCheckpoint)
+	hooks := &runHooks{checkpoint: func(stepID string, cp *StepCheckpoint) { checkpoints[stepID] = cp }}
+	exec, err := newTestEngine(services).execute(context.Background(), "exec-1", def,
+		map[string]*anypb.Any{"query": packString(t, "q")}, nil, hooks)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if exec.Status != commonv1.ProcessingStatus_PROCESSING_STATUS_FAILED {
+		t.Fatalf("first run status = %v", exec.Status)
+	}
+	return checkpoints
+}
+
+func resume(t *testing.T, services *fakeServices, def *v1.WorkflowDefinition, checkpoints map[string]*StepCheckpoint) *Execution {
+	t.Helper()
+	exec, err := newTestEngine(services).execute(context.Background(), "exec-1", def,
+		map[string]*anypb.Any{"query": packString(t, "q")}, nil, &runHooks{resume: checkpoints})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return exec
+}
+
+func TestResumeSkipsCheckpointedSteps(t *testing.T) {
+	checkpoints := interrupted(t, diamond(t, StrategySequential))
+	if len(checkpoints) != 3 || checkpoints["d"] != nil {
+		t.Fatalf("checkpoints of %d steps", len(checkpoints))
+	}
+
+	services := diamondServices(t)
+	exec := resume(t, services, diamond(t, StrategySequential), checkpoints)
+	if exec.Status != commonv1.ProcessingStatus_PROCESSING_STATUS_COMPLETED {
+		t.Fatalf("status = %v: %s", exec.Status, exec.Trace.Summary.FailureReason)
+	}
+	if !reflect.DeepEqual(services.calls, []string{"svc.d"}) {
+		t.Errorf("calls = %v, want only d", services.calls)
+	}
+	if got := unpackString(t, exec.Outputs["answer"]); got != "d(b(a(q)))" {
+		t.Errorf("answer = %s", got)
+	}
+	if exec.Trace.StepTraces[1] != checkpoints["b"].Trace {
+		t.Error("restored step does not keep its trace")
+	}
+}
+
+func TestResumeDropsUnusableCheckpoints(t *testing.T) {
+	checkpoints := interrupted(t, diamond(t, StrategySequential))
+	// 没有轨迹的检查点作废，其下游即使有检查点也要重新运行
+	checkpoints["b"] = &StepCheckpoint{Output: checkpoints["b"].Output}
+	services := diamondServices(t)
+	exec := resume(t, services, diamond(t, StrategySequential), checkpoints)
+	if exec.Status != commonv1.ProcessingStatus_PROCESSING_STATUS_COMPLETED {
+		t.Fatalf("status = %v", exec.Status)
+	}
+	if !reflect.DeepEqual(services.calls, []string{"svc.b", "svc.d"}) {
+		t.Errorf("calls = %v, want b and d", services.calls)
+	}
+
+	// 缺少条件结果的检查点不能决定分支，步骤重新运行
+	def := diamond(t, StrategySequential)
+	def.Steps[0].Conditional = &v1.ConditionalExecution{
+		Condition:      `$.output.out == "a(q)"`,
+		ExecuteIfTrue:  []string{"b"},
+		ExecuteIfFalse: []string{"c"},
+	}
+	checkpoints = interrupted(t, diamond(t, StrategySequential))
+	services = diamondServices(t)
+	exec = resume(t, services, def, checkpoints)
+	if services.callsOf("svc.a") != 1 || services.callsOf("svc.c") != 0 {
+		t.Errorf("calls = %v, want a to run again and c skipped", services.calls)
+	}
+	if stepStatuses(exec)["c"] != commonv1.ProcessingStatus_PROCESSING_STATUS_SKIPPED {
+		t.Errorf("statuses = %v", stepStatuses(exec))
+	}
+}
+
+func TestResumeKeepsConditionResults(t *testing.T) {
+	def := func() *v1.WorkflowDefinition {
+		def := diamond(t, StrategySequential)
+		def.Steps[0].Conditional = &v1.ConditionalExecution{
+			Condition:      `$.output.out == "a(q)"`,
+			ExecuteIfTrue:  []string{"b"},
+			ExecuteIfFalse: []string{"c"},
+		}
+		return def
+	}
+	checkpoints := interrupted(t, def())
+	if checkpoints["c"] != nil {
+		t.Fatal("skipped step was checkpointed")
+	}
+	services := diamondServices(t)
+	exec := resume(t, services, def(), checkpoints)
+	if !reflect.DeepEqual(services.calls, []string{"svc.d"}) {
+		t.Errorf("calls = %v, want only d", services.calls)
+	}
+	if stepStatuses(exec)["c"] != commonv1.ProcessingStatus_PROCESSING_STATUS_SKIPPED {
+		t.Errorf("statuses = %v", stepStatuses(exec))
+	}
+}
+
+func TestResumeRollsBackRestoredSteps(t *testing.T) {
+	checkpoints := interrupted(t, compensated(t, StrategySequential, false))
+	services := compensatedServices(t, nil)
+	exec := resume(t, services, compensated(t, StrategySequential, true), checkpoints)
+	want := []string{"svc.d", "svc.undo_c", "svc.undo_b", "svc.undo_a"}
+	if !reflect.DeepEqual(services.calls, want) {
+		t.Errorf("calls = %v, want %v", services.calls, want)
+	}
+	if got := unpackString(t, exec.Trace.CompensationTraces[1].OutputData["out"]); got != "undo_b(b(a(q)))" {
+		t.Errorf("undo_b output = %s", got)
+	}
+}
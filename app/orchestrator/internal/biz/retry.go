@@ -0,0 +1,122 @@
+package biz
+
+import (
+	"context"
+	"math/rand/v2"
+	"net/http"
+	"strconv"
+	"time"
+
+	v1 "rag/api/orchestrator/v1"
+
+	"github.com/go-kratos/kratos/v2/errors"
+)
+
+// Retry strategies accepted in StepConfiguration.retry_strategy.
+const (
+	RetryFixed       = "fixed"
+	RetryLinear      = "linear"
+	RetryExponential = "exponential"
+)
+
+// Error handling strategies accepted in ErrorHandlingStrategy.strategy.
+const (
+	ErrorFailFast        = "fail_fast"
+	ErrorContinueOnError = "continue_on_error"
+	ErrorRetryAll        = "retry_all"
+)
+
+const (
+	// 重试间隔的默认基数和上限
+	defaultRetryDelay = 200 * time.Millisecond
+	maxRetryDelay     = 10 * time.Second
+	// retry_all 策略下未配置重试的步骤的重试次数，以及单个步骤的重试次数上限
+	defaultRetryAttempts = 2
+	maxRetryAttempts     = 10
+	// custom_config 中覆盖重试间隔基数的键
+	retryDelayKey = "retry_delay_ms"
+)
+
+// retryPolicy is how often and how fast a failed step is retried
+type retryPolicy struct {
+	attempts int
+	strategy string
+	base     time.Duration
+}
+
+// compileRetryPolicy reads the retry settings of a step. retry_attempts
+// counts the retries after the first call; under retry_all, steps that set
+// none get a default. Attempts and the delay base are capped.
+func compileRetryPolicy(step *v1.WorkflowStep, handling *v1.ErrorHandlingStrategy) (retryPolicy, error) {
+	config := step.GetStepConfig()
+	p := retryPolicy{
+		attempts: int(config.GetRetryAttempts()),
+		strategy: config.GetRetryStrategy(),
+		base:     defaultRetryDelay,
+	}
+	if p.attempts < 0 {
+		return p, invalidWorkflow("step %q has negative retry_attempts", step.StepId)
+	}
+	if p.attempts > maxRetryAttempts {
+		return p, invalidWorkflow("step %q has more than %d retry_attempts", step.StepId, maxRetryAttempts)
+	}
+	if p.attempts == 0 && handling.GetStrategy() == ErrorRetryAll {
+		p.attempts = defaultRetryAttempts
+	}
+	switch p.strategy {
+	case "":
+		p.strategy = RetryExponential
+	case RetryFixed, RetryLinear, RetryExponential:
+	default:
+		return p, invalidWorkflow("step %q has unknown retry strategy %q", step.StepId, p.strategy)
+	}
+	if v, ok := config.GetCustomConfig()[retryDelayKey]; ok {
+		ms, err := strconv.Atoi(v)
+		if err != nil || ms < 0 {
+			return p, invalidWorkflow("step %q has invalid %s %q", step.StepId, retryDelayKey, v)
+		}
+		if ms > int(maxRetryDelay/time.Millisecond) {
+			return p, invalidWorkflow("step %q has %s above %d", step.StepId, retryDelayKey, maxRetryDelay/time.Millisecond)
+		}
+		p.base = time.Duration(ms) * time.Millisecond
+	}
+	return p, nil
+}
+
+// delay returns the backoff before retry n, counted from 1. Half of it is
+// fixed and the other half random, so retries of parallel steps spread out.
+func (p retryPolicy) delay(n int) time.Duration {
+	d := min(p.base, maxRetryDelay)
+	// 先和上限比较再乘或移位，避免溢出成负数
+	switch {
+	case n <= 1 || d == 0:
+	case p.strategy == RetryLinear:
+		if d > maxRetryDelay/time.Duration(n) {
+			d = maxRetryDelay
+		} else {
+			d *= time.Duration(n)
+		}
+	case p.strategy == RetryExponential:
+		if n > 63 || d > maxRetryDelay>>(n-1) {
+			d = maxRetryDelay
+		} else {
+			d <<= n - 1
+		}
+	}
+	half := d / 2
+	return half + rand.N(d-half+1)
+}
+
+// retryable reports whether an attempt that failed with err may pass when
+// repeated. Rejected input and missing methods fail the same way every time.
+func retryable(err error) bool {
+	if errors.Is(err, context.DeadlineExceeded) {
+		return true
+	}
+	if errors.Is(err, context.Canceled) {
+		return false
+	}
+	code := errors.FromError(err).Code
+	return code == http.StatusTooManyRequests || code == http.StatusRequestTimeout ||
+		code >= 500 && code != http.StatusNotImplemented
+}
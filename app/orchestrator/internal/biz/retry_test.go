@@ -0,0 +1,125 @@
+package biz
+
+import (
+	"context"
+	"math"
+	"strings"
+	"testing"
+	"time"
+
+	commonv1 "rag/api/common/v1"
+	v1 "rag/api/orchestrator/v1"
+
+	"github.com/go-kratos/kratos/v2/errors"
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+func TestRetryDelayExtremes(t *testing.T) {
+	for _, strategy := range []string{RetryFixed, RetryLinear, RetryExponential} {
+		for _, base := range []time.Duration{0, time.Millisecond, defaultRetryDelay, maxRetryDelay, math.MaxInt64} {
+			p := retryPolicy{strategy: strategy, base: base}
+			for _, n := range []int{1, 2, 31, 32, 63, 64, 65, 1000, math.MaxInt} {
+				d := p.delay(n)
+				if d < 0 || d > maxRetryDelay {
+					t.Errorf("%s base %s: delay(%d) = %s", strategy, base, n, d)
+				}
+			}
+		}
+	}
+
+	p := retryPolicy{strategy: RetryExponential, base: 100 * time.Millisecond}
+	for n, want := range map[int]time.Duration{1: 100 * time.Millisecond, 4: 800 * time.Millisecond, 10: maxRetryDelay} {
+		if d := p.delay(n); d < want/2 || d > want {
+			t.Errorf("exponential delay(%d) = %s, want within [%s, %s]", n, d, want/2, want)
+		}
+	}
+	p.strategy = RetryLinear
+	if d := p.delay(3); d < 150*time.Millisecond || d > 300*time.Millisecond {
+		t.Errorf("linear delay(3) = %s", d)
+	}
+}
+
+func TestCompileRetryPolicy(t *testing.T) {
+	step := func(config *v1.StepConfiguration) *v1.WorkflowStep {
+		s := testStep("a", "a")
+		s.StepConfig = config
+		return s
+	}
+
+	p, err := compileRetryPolicy(step(nil), &v1.ErrorHandlingStrategy{Strategy: ErrorRetryAll})
+	if err != nil || p.attempts != defaultRetryAttempts || p.strategy != RetryExponential || p.base != defaultRetryDelay {
+		t.Errorf("retry_all default = %+v, %v", p, err)
+	}
+	p, err = compileRetryPolicy(step(&v1.StepConfiguration{
+		RetryAttempts: maxRetryAttempts,
+		RetryStrategy: RetryLinear,
+		CustomConfig:  map[string]string{retryDelayKey: "10000"},
+	}), nil)
+	if err != nil || p.attempts != maxRetryAttempts || p.base != maxRetryDelay {
+		t.Errorf("upper limits = %+v, %v", p, err)
+	}
+
+	for _, config := range []*v1.StepConfiguration{
+		{RetryAttempts: -1},
+		{RetryAttempts: maxRetryAttempts + 1},
+		{RetryAttempts: math.MaxInt32},
+		{RetryStrategy: "fibonacci"},
+		{CustomConfig: map[string]string{retryDelayKey: "-1"}},
+		{CustomConfig: map[string]string{retryDelayKey: "fast"}},
+		{CustomConfig: map[string]string{retryDelayKey: "10001"}},
+		{CustomConfig: map[string]string{retryDelayKey: "9223372036854775807"}},
+	} {
+		if _, err := compileRetryPolicy(step(config), nil); err == nil {
+			t.Errorf("%v compiled", config)
+		}
+	}
+}
+
+func TestExecuteRetries(t *testing.T) {
+	flaky := func(failures int, err error) stepHandler {
+		return func(ctx context.Context, input map[string]*anypb.Any) (map[string]*anypb.Any, error) {
+			if failures > 0 {
+				failures--
+				return nil, err
+			}
+			return map[string]*anypb.Any{"out": input["in"]}, nil
+		}
+	}
+	tests := []struct {
+		name     string
+		failures int
+		err      error
+		calls    int
+		status   commonv1.ProcessingStatus
+	}{
+		{"recovers", 2, errors.ServiceUnavailable("DOWN", "busy"), 3, commonv1.ProcessingStatus_PROCESSING_STATUS_COMPLETED},
+		{"exhausted", 5, errors.ServiceUnavailable("DOWN", "busy"), 3, commonv1.ProcessingStatus_PROCESSING_STATUS_FAILED},
+		{"not retryable", 1, errors.BadRequest("BAD", "rejected input"), 1, commonv1.ProcessingStatus_PROCESSING_STATUS_FAILED},
+	}
+	for _, tt := range tests {
+		services := newFakeServices(map[string]stepHandler{"svc.a": flaky(tt.failures, tt.err)})
+		a := testStep("a", "a")
+		a.InputMapping = map[string]*anypb.Any{"in": packString(t, "$.input.query")}
+		a.OutputMapping = map[string]string{"answer": "$.output.out"}
+		a.StepConfig = &v1.StepConfiguration{
+			RetryAttempts: 2,
+			RetryStrategy: RetryExponential,
+			CustomConfig:  map[string]string{retryDelayKey: "1"},
+		}
+		def := &v1.WorkflowDefinition{Name: "retry", Steps: []*v1.WorkflowStep{a}}
+		exec, err := newTestEngine(services).Execute(context.Background(), "", def,
+			map[string]*anypb.Any{"query": packString(t, "q")}, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if exec.Status != tt.status || services.callsOf("svc.a") != tt.calls {
+			t.Errorf("%s: status %v after %d calls, want %v after %d", tt.name, exec.Status, services.callsOf("svc.a"), tt.status, tt.calls)
+		}
+	}
+
+	def := &v1.WorkflowDefinition{Name: "retry", Steps: []*v1.WorkflowStep{testStep("a", "a")}}
+	def.Steps[0].StepConfig = &v1.StepConfiguration{CustomConfig: map[string]string{retryDelayKey: "86400000"}}
+	if err := newTestEngine(diamondServices(t)).Validate(def); err == nil || !strings.Contains(err.Error(), retryDelayKey) {
+		t.Errorf("Validate = %v", err)
+	}
+}
@@ -0,0 +1,123 @@
+package biz
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	commonv1 "rag/api/common/v1"
+	v1 "rag/api/orchestrator/v1"
+
+	"github.com/go-kratos/kratos/v2/errors"
+	"google.golang.org/protobuf/types/known/anypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// compileCompensations compiles the input mappings of the step
+// compensations. Besides the step's upstream data they may read what the
+// step returned, as $.output or through its own step id.
+func compileCompensations(g *workflowGraph) ([]map[string]*mappingValue, error) {
+	compensations := make([]map[string]*mappingValue, len(g.steps))
+	for i, step := range g.steps {
+		c := step.GetCompensation()
+		if c == nil {
+			continue
+		}
+		if c.TimeoutSeconds < 0 {
+			return nil, invalidWorkflow("step %q compensation has a negative timeout", step.StepId)
+		}
+		m := make(map[string]*mappingValue, len(c.InputMapping))
+		for field, value := range c.InputMapping {
+			v, err := compileInputValue(value)
+			if err != nil {
+				return nil, invalidWorkflow("step %q compensation input %s: %v", step.StepId, field, err)
+			}
+			for _, expr := range v.exprs {
+				if err := checkReference(expr, step.StepId, g.ancestors[i], true); err != nil {
+					return nil, invalidWorkflow("step %q compensation input %s: %v", step.StepId, field, err)
+				}
+			}
+			m[field] = v
+		}
+		compensations[i] = m
+	}
+	return compensations, nil
+}
+
+// rollback runs the compensations of the completed steps, latest first, so
+// a step is undone before the steps it built on. Steps that fell back to a
+// default value did no work and are left alone. A compensation that fails is
+// recorded and the rest still run. Compensations run after the workflow
+// stopped and are not bound by its timeout, only by their own.
+func (r *workflowRun) rollback(ctx context.Context) []*v1.StepExecutionTrace {
+	ctx = context.WithoutCancel(ctx)
+	var traces []*v1.StepExecutionTrace
+	for k := len(r.completed) - 1; k >= 0; k-- {
+		i := r.completed[k]
+		if r.graph.compensations[i] == nil {
+			continue
+		}
+		traces = append(traces, r.compensate(ctx, i))
+	}
+	if len(traces) > 0 {
+		r.engine.log.WithContext(ctx).Infof("Rolled back %d steps of workflow %s", len(traces), r.def.Name)
+	}
+	return traces
+}
+
+// compensate calls the compensation of step i and traces it
+func (r *workflowRun) compensate(ctx context.Context, i int) *v1.StepExecutionTrace {
+	step := r.def.Steps[i]
+	c := step.Compensation
+	output := r.traces[i].OutputData
+	// 替补步骤的输出记在原步骤名下，这里按自身 id 补上
+	scope := *r.scope
+	scope.output = output
+	scope.steps = make(map[string]map[string]*anypb.Any, len(r.scope.steps)+1)
+	for id, out := range r.scope.steps {
+		scope.steps[id] = out
+	}
+	scope.steps[step.StepId] = output
+
+	timeout := defaultStepTimeout
+	if c.TimeoutSeconds > 0 {
+		timeout = time.Duration(c.TimeoutSeconds) * time.Second
+	}
+	input, err := evaluateInput(r.graph.compensations[i], &scope)
+	trace := &v1.StepExecutionTrace{
+		StepId:    step.StepId,
+		StepName:  step.StepName,
+		Status:    commonv1.ProcessingStatus_PROCESSING_STATUS_PROCESSING,
+		StartedAt: timestamppb.Now(),
+		InputData: input,
+	}
+	addEvent(trace, EventStarted, fmt.Sprintf("compensating with %s.%s", c.ServiceName, c.MethodName), nil)
+	var result map[string]*anypb.Any
+	if err == nil {
+		callCtx, cancel := context.WithTimeout(ctx, timeout)
+		result, err = r.call(callCtx, step.StepId, c.ServiceName, c.MethodName, input)
+		cancel()
+	}
+
+	completedAt := time.Now()
+	trace.CompletedAt = timestamppb.New(completedAt)
+	trace.DurationMs = completedAt.Sub(trace.StartedAt.AsTime()).Milliseconds()
+	switch {
+	case err == nil:
+		trace.Status = commonv1.ProcessingStatus_PROCESSING_STATUS_COMPLETED
+		trace.OutputData = result
+		addEvent(trace, EventCompleted, fmt.Sprintf("compensated in %dms", trace.DurationMs), nil)
+	case errors.Is(err, context.DeadlineExceeded):
+		trace.Status = commonv1.ProcessingStatus_PROCESSING_STATUS_FAILED
+		trace.ErrorMessage = fmt.Sprintf("compensation timed out after %s", timeout)
+		addEvent(trace, EventTimeout, trace.ErrorMessage, nil)
+	default:
+		trace.Status = commonv1.ProcessingStatus_PROCESSING_STATUS_FAILED
+		trace.ErrorMessage = errorMessage(err)
+		addEvent(trace, EventFailed, trace.ErrorMessage, nil)
+	}
+	if err != nil {
+		r.engine.log.WithContext(ctx).Warnf("Compensation of step %s failed: %v", step.StepId, err)
+	}
+	return trace
+}
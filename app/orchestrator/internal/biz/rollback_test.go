@@ -0,0 +1,169 @@
+package biz
+
+import (
+	"context"
+	"reflect"
+	"strings"
+	"testing"
+
+	commonv1 "rag/api/common/v1"
+	v1 "rag/api/orchestrator/v1"
+
+	"github.com/go-kratos/kratos/v2/errors"
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+// compensated is the diamond with compensations on a, b and c, where d fails
+func compensated(t testing.TB, strategy string, rollback bool) *v1.WorkflowDefinition {
+	def := diamond(t, strategy)
+	for _, step := range def.Steps[:3] {
+		step.Compensation = &v1.CompensationAction{
+			ServiceName:  "svc",
+			MethodName:   "undo_" + step.StepId,
+			InputMapping: map[string]*anypb.Any{"in": packString(t, "$.output.out")},
+		}
+	}
+	def.Configuration.ErrorHandling = &v1.ErrorHandlingStrategy{Strategy: ErrorFailFast, EnableRollback: rollback}
+	return def
+}
+
+func compensatedServices(t testing.TB, handlers map[string]stepHandler) *fakeServices {
+	services := diamondServices(t)
+	services.handlers["svc.d"] = func(context.Context, map[string]*anypb.Any) (map[string]*anypb.Any, error) {
+		return nil, errors.BadRequest("BAD", "rejected input")
+	}
+	for _, id := range []string{"a", "b", "c"} {
+		services.handlers["svc.undo_"+id] = echo(t, "undo_"+id)
+	}
+	for key, h := range handlers {
+		services.handlers[key] = h
+	}
+	return services
+}
+
+func compensationIDs(exec *Execution) []string {
+	var ids []string
+	for _, trace := range exec.Trace.CompensationTraces {
+		ids = append(ids, trace.StepId)
+	}
+	return ids
+}
+
+func TestRollbackReversesCompletedSteps(t *testing.T) {
+	services := compensatedServices(t, nil)
+	exec, err := newTestEngine(services).Execute(context.Background(), "", compensated(t, StrategySequential, true),
+		map[string]*anypb.Any{"query": packString(t, "q")}, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if exec.Status != commonv1.ProcessingStatus_PROCESSING_STATUS_FAILED {
+		t.Fatalf("status = %v", exec.Status)
+	}
+	want := []string{"svc.a", "svc.b", "svc.c", "svc.d", "svc.undo_c", "svc.undo_b", "svc.undo_a"}
+	if !reflect.DeepEqual(services.calls, want) {
+		t.Errorf("calls = %v, want %v", services.calls, want)
+	}
+	traces := exec.Trace.CompensationTraces
+	if got := compensationIDs(exec); !reflect.DeepEqual(got, []string{"c", "b", "a"}) {
+		t.Fatalf("compensated %v, want c, b, a", got)
+	}
+	// 补偿读取的是对应步骤自己的输出
+	if got := unpackString(t, traces[1].OutputData["out"]); got != "undo_b(b(a(q)))" {
+		t.Errorf("undo_b output = %s", got)
+	}
+	if s := exec.Trace.Summary; s.CompensatedSteps != 3 || s.FailedCompensations != 0 {
+		t.Errorf("summary %+v", s)
+	}
+}
+
+func TestRollbackContinuesAfterFailedCompensation(t *testing.T) {
+	services := compensatedServices(t, map[string]stepHandler{
+		"svc.undo_b": func(context.Context, map[string]*anypb.Any) (map[string]*anypb.Any, error) {
+			return nil, errors.ServiceUnavailable("DOWN", "busy")
+		},
+	})
+	exec, err := newTestEngine(services).Execute(context.Background(), "", compensated(t, StrategySequential, true),
+		map[string]*anypb.Any{"query": packString(t, "q")}, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	traces := exec.Trace.CompensationTraces
+	if len(traces) != 3 || traces[1].Status != commonv1.ProcessingStatus_PROCESSING_STATUS_FAILED ||
+		traces[2].Status != commonv1.ProcessingStatus_PROCESSING_STATUS_COMPLETED {
+		t.Fatalf("compensations %v", traces)
+	}
+	if !strings.Contains(traces[1].ErrorMessage, "busy") {
+		t.Errorf("error message %q", traces[1].ErrorMessage)
+	}
+	if s := exec.Trace.Summary; s.CompensatedSteps != 2 || s.FailedCompensations != 1 {
+		t.Errorf("summary %+v", s)
+	}
+}
+
+func TestRollbackOnlyCompensatesCompletedSteps(t *testing.T) {
+	// 并行执行时 c 失败，b 被取消，只有 a 完成
+	services := compensatedServices(t, map[string]stepHandler{
+		"svc.b": blockUntilDone,
+		"svc.c": func(context.Context, map[string]*anypb.Any) (map[string]*anypb.Any, error) {
+			return nil, errors.BadRequest("BAD", "rejected input")
+		},
+	})
+	exec, err := newTestEngine(services).Execute(context.Background(), "", compensated(t, StrategyParallel, true),
+		map[string]*anypb.Any{"query": packString(t, "q")}, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := compensationIDs(exec); !reflect.DeepEqual(got, []string{"a"}) {
+		t.Errorf("compensated %v, want a", got)
+	}
+
+	// 未开启回滚时不做补偿
+	services = compensatedServices(t, nil)
+	exec, err = newTestEngine(services).Execute(context.Background(), "", compensated(t, StrategySequential, false),
+		map[string]*anypb.Any{"query": packString(t, "q")}, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(exec.Trace.CompensationTraces) != 0 || services.callsOf("svc.undo_a") != 0 {
+		t.Errorf("compensated %v without rollback", compensationIDs(exec))
+	}
+}
+
+func TestRollbackIgnoresCancelledWorkflow(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	services := compensatedServices(t, map[string]stepHandler{
+		"svc.d": func(context.Context, map[string]*anypb.Any) (map[string]*anypb.Any, error) {
+			cancel()
+			return nil, context.Canceled
+		},
+	})
+	exec, err := newTestEngine(services).Execute(ctx, "", compensated(t, StrategySequential, true),
+		map[string]*anypb.Any{"query": packString(t, "q")}, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if exec.Status != commonv1.ProcessingStatus_PROCESSING_STATUS_CANCELLED || len(exec.Trace.CompensationTraces) != 0 {
+		t.Errorf("status %v with compensations %v", exec.Status, compensationIDs(exec))
+	}
+}
+
+func TestValidateCompensations(t *testing.T) {
+	engine := newTestEngine(compensatedServices(t, nil))
+	for want, change := range map[string]func(def *v1.WorkflowDefinition){
+		"unknown method": func(def *v1.WorkflowDefinition) {
+			def.Steps[0].Compensation.MethodName = "missing"
+		},
+		"negative timeout": func(def *v1.WorkflowDefinition) {
+			def.Steps[0].Compensation.TimeoutSeconds = -1
+		},
+		"not an upstream dependency": func(def *v1.WorkflowDefinition) {
+			def.Steps[1].Compensation.InputMapping["in"] = packString(t, "$.steps.c.output.out")
+		},
+	} {
+		def := compensated(t, StrategySequential, true)
+		change(def)
+		if err := engine.Validate(def); err == nil || !strings.Contains(err.Error(), want) {
+			t.Errorf("Validate = %v, want %q", err, want)
+		}
+	}
+}
@@ -0,0 +1,156 @@
+package biz
+
+import (
+	"strconv"
+	"strings"
+)
+
+// 新建定义未指定版本时的初始版本
+const initialVersion = "1.0.0"
+
+// semver is a parsed semantic version, MAJOR.MINOR.PATCH with an optional
+// pre-release and build metadata
+type semver struct {
+	major, minor, patch uint64
+	pre                 []string
+	build               string
+}
+
+// parseSemver parses a semantic version; a leading "v" is not accepted so
+// every version has a single spelling
+func parseSemver(s string) (semver, bool) {
+	var v semver
+	if i := strings.IndexByte(s, '+'); i >= 0 {
+		v.build = s[i+1:]
+		if !validIdentifiers(v.build, false) {
+			return v, false
+		}
+		s = s[:i]
+	}
+	if i := strings.IndexByte(s, '-'); i >= 0 {
+		pre := s[i+1:]
+		if !validIdentifiers(pre, true) {
+			return v, false
+		}
+		v.pre = strings.Split(pre, ".")
+		s = s[:i]
+	}
+	parts := strings.Split(s, ".")
+	if len(parts) != 3 {
+		return v, false
+	}
+	nums := make([]uint64, 3)
+	for i, p := range parts {
+		n, ok := numericIdentifier(p)
+		if !ok {
+			return v, false
+		}
+		nums[i] = n
+	}
+	v.major, v.minor, v.patch = nums[0], nums[1], nums[2]
+	return v, true
+}
+
+// numericIdentifier parses a number without leading zeros
+func numericIdentifier(s string) (uint64, bool) {
+	if s == "" || len(s) > 1 && s[0] == '0' {
+		return 0, false
+	}
+	n, err := strconv.ParseUint(s, 10, 64)
+	return n, err == nil
+}
+
+// validIdentifiers checks dot-separated pre-release or build identifiers.
+// Numeric pre-release identifiers may not have leading zeros.
+func validIdentifiers(s string, pre bool) bool {
+	for _, id := range strings.Split(s, ".") {
+		if id == "" {
+			return false
+		}
+		numeric := true
+		for _, c := range id {
+			switch {
+			case c >= '0' && c <= '9':
+			case c >= 'a' && c <= 'z', c >= 'A' && c <= 'Z', c == '-':
+				numeric = false
+			default:
+				return false
+			}
+		}
+		if pre && numeric && len(id) > 1 && id[0] == '0' {
+			return false
+		}
+	}
+	return true
+}
+
+// compare orders versions by semver precedence; build metadata is ignored
+func (v semver) compare(o semver) int {
+	for _, d := range [][2]uint64{{v.major, o.major}, {v.minor, o.minor}, {v.patch, o.patch}} {
+		if d[0] != d[1] {
+			if d[0] < d[1] {
+				return -1
+			}
+			return 1
+		}
+	}
+	// 预发布版本低于对应的正式版本
+	switch {
+	case len(v.pre) == 0 && len(o.pre) == 0:
+		return 0
+	case len(v.pre) == 0:
+		return 1
+	case len(o.pre) == 0:
+		return -1
+	}
+	for i := 0; i < len(v.pre) && i < len(o.pre); i++ {
+		if c := compareIdentifier(v.pre[i], o.pre[i]); c != 0 {
+			return c
+		}
+	}
+	switch {
+	case len(v.pre) < len(o.pre):
+		return -1
+	case len(v.pre) > len(o.pre):
+		return 1
+	}
+	return 0
+}
+
+// compareIdentifier orders pre-release identifiers: numbers numerically and
+// below alphanumerics, which compare as strings
+func compareIdentifier(a, b string) int {
+	na, aNum := numericIdentifier(a)
+	nb, bNum := numericIdentifier(b)
+	switch {
+	case aNum && bNum:
+		if na == nb {
+			return 0
+		}
+		if na < nb {
+			return -1
+		}
+		return 1
+	case aNum:
+		return -1
+	case bNum:
+		return 1
+	}
+	return strings.Compare(a, b)
+}
+
+// next returns the version published after v when none is given: the
+// release of a pre-release, otherwise the next minor version
+func (v semver) next() string {
+	if len(v.pre) == 0 {
+		v.minor, v.patch = v.minor+1, 0
+	}
+	return strconv.FormatUint(v.major, 10) + "." + strconv.FormatUint(v.minor, 10) + "." + strconv.FormatUint(v.patch, 10)
+}
+
+// compareVersions orders two valid version strings
+func compareVersions(a, b string) int {
+	va, _ := parseSemver(a)
+	vb, _ := parseSemver(b)
+	return va.compare(vb)
+}
@@ -226,11 +226,14 @@ func (m *Server_GRPC) GetTimeout() *durationpb.Duration {
 }
 
 type Data struct {
-	Database             *Data_Database `protobuf:"bytes,1,opt,name=database,proto3" json:"database,omitempty"`
-	Redis                *Data_Redis    `protobuf:"bytes,2,opt,name=redis,proto3" json:"redis,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
-	XXX_unrecognized     []byte         `json:"-"`
-	XXX_sizecache        int32          `json:"-"`
+	Database             *Data_Database           `protobuf:"bytes,1,opt,name=database,proto3" json:"database,omitempty"`
+	Redis                *Data_Redis              `protobuf:"bytes,2,opt,name=redis,proto3" json:"redis,omitempty"`
+	Services             map[string]*Data_Service `protobuf:"bytes,3,rep,name=services,proto3" json:"services,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	Workflow             *Data_Workflow           `protobuf:"bytes,4,opt,name=workflow,proto3" json:"workflow,omitempty"`
+	Generation           *Data_Generation         `protobuf:"bytes,5,opt,name=generation,proto3" json:"generation,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                 `json:"-"`
+	XXX_unrecognized     []byte                   `json:"-"`
+	XXX_sizecache        int32                    `json:"-"`
 }
 
 func (m *Data) Reset()         { *m = Data{} }
@@ -272,6 +275,27 @@ func (m *Data) GetRedis() *Data_Redis {
 	return nil
 }
 
+func (m *Data) GetServices() map[string]*Data_Service {
+	if m != nil {
+		return m.Services
+	}
+	return nil
+}
+
+func (m *Data) GetWorkflow() *Data_Workflow {
+	if m != nil {
+		return m.Workflow
+	}
+	return nil
+}
+
+func (m *Data) GetGeneration() *Data_Generation {
+	if m != nil {
+		return m.Generation
+	}
+	return nil
+}
+
 type Data_Database struct {
 	Driver               string   `protobuf:"bytes,1,opt,name=driver,proto3" json:"driver,omitempty"`
 	Source               string   `protobuf:"bytes,2,opt,name=source,proto3" json:"source,omitempty"`
@@ -382,14 +406,351 @@ func (m *Data_Redis) GetWriteTimeout() *durationpb.Duration {
 	return nil
 }
 
+type Data_CircuitBreaker struct {
+	// 窗口内失败比例达到该值时熔断
+	ErrorRatio float64              `protobuf:"fixed64,1,opt,name=error_ratio,json=errorRatio,proto3" json:"error_ratio,omitempty"`
+	Window     *durationpb.Duration `protobuf:"bytes,2,opt,name=window,proto3" json:"window,omitempty"`
+	// 窗口内请求数不足时不熔断
+	MinRequests int32 `protobuf:"varint,3,opt,name=min_requests,json=minRequests,proto3" json:"min_requests,omitempty"`
+	// 熔断多久后放行试探请求
+	OpenTimeout *durationpb.Duration `protobuf:"bytes,4,opt,name=open_timeout,json=openTimeout,proto3" json:"open_timeout,omitempty"`
+	// 半开状态下连续成功多少次后恢复
+	HalfOpenRequests     int32    `protobuf:"varint,5,opt,name=half_open_requests,json=halfOpenRequests,proto3" json:"half_open_requests,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Data_CircuitBreaker) Reset()         { *m = Data_CircuitBreaker{} }
+func (m *Data_CircuitBreaker) String() string { return proto.CompactTextString(m) }
+func (*Data_CircuitBreaker) ProtoMessage()    {}
+func (*Data_CircuitBreaker) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9c69a7f648509b54, []int{2, 2}
+}
+
+func (m *Data_CircuitBreaker) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Data_CircuitBreaker.Unmarshal(m, b)
+}
+func (m *Data_CircuitBreaker) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Data_CircuitBreaker.Marshal(b, m, deterministic)
+}
+func (m *Data_CircuitBreaker) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Data_CircuitBreaker.Merge(m, src)
+}
+func (m *Data_CircuitBreaker) XXX_Size() int {
+	return xxx_messageInfo_Data_CircuitBreaker.Size(m)
+}
+func (m *Data_CircuitBreaker) XXX_DiscardUnknown() {
+	xxx_messageInfo_Data_CircuitBreaker.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Data_CircuitBreaker proto.InternalMessageInfo
+
+func (m *Data_CircuitBreaker) GetErrorRatio() float64 {
+	if m != nil {
+		return m.ErrorRatio
+	}
+	return 0
+}
+
+func (m *Data_CircuitBreaker) GetWindow() *durationpb.Duration {
+	if m != nil {
+		return m.Window
+	}
+	return nil
+}
+
+func (m *Data_CircuitBreaker) GetMinRequests() int32 {
+	if m != nil {
+		return m.MinRequests
+	}
+	return 0
+}
+
+func (m *Data_CircuitBreaker) GetOpenTimeout() *durationpb.Duration {
+	if m != nil {
+		return m.OpenTimeout
+	}
+	return nil
+}
+
+func (m *Data_CircuitBreaker) GetHalfOpenRequests() int32 {
+	if m != nil {
+		return m.HalfOpenRequests
+	}
+	return 0
+}
+
+type Data_Service struct {
+	Endpoint string               `protobuf:"bytes,1,opt,name=endpoint,proto3" json:"endpoint,omitempty"`
+	Timeout  *durationpb.Duration `protobuf:"bytes,2,opt,name=timeout,proto3" json:"timeout,omitempty"`
+	// 按方法熔断，未配置的字段使用默认值
+	CircuitBreaker *Data_CircuitBreaker `protobuf:"bytes,3,opt,name=circuit_breaker,json=circuitBreaker,proto3" json:"circuit_breaker,omitempty"`
+	// 同时进行的调用数上限
+	MaxConcurrency       int32    `protobuf:"varint,4,opt,name=max_concurrency,json=maxConcurrency,proto3" json:"max_concurrency,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Data_Service) Reset()         { *m = Data_Service{} }
+func (m *Data_Service) String() string { return proto.CompactTextString(m) }
+func (*Data_Service) ProtoMessage()    {}
+func (*Data_Service) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9c69a7f648509b54, []int{2, 3}
+}
+
+func (m *Data_Service) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Data_Service.Unmarshal(m, b)
+}
+func (m *Data_Service) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Data_Service.Marshal(b, m, deterministic)
+}
+func (m *Data_Service) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Data_Service.Merge(m, src)
+}
+func (m *Data_Service) XXX_Size() int {
+	return xxx_messageInfo_Data_Service.Size(m)
+}
+func (m *Data_Service) XXX_DiscardUnknown() {
+	xxx_messageInfo_Data_Service.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Data_Service proto.InternalMessageInfo
+
+func (m *Data_Service) GetEndpoint() string {
+	if m != nil {
+		return m.Endpoint
+	}
+	return ""
+}
+
+func (m *Data_Service) GetTimeout() *durationpb.Duration {
+	if m != nil {
+		return m.Timeout
+	}
+	return nil
+}
+
+func (m *Data_Service) GetCircuitBreaker() *Data_CircuitBreaker {
+	if m != nil {
+		return m.CircuitBreaker
+	}
+	return nil
+}
+
+func (m *Data_Service) GetMaxConcurrency() int32 {
+	if m != nil {
+		return m.MaxConcurrency
+	}
+	return 0
+}
+
+type Data_Workflow struct {
+	// 工作流定义目录，每个定义一个子目录保存其各个版本，未配置时只保存在内存中
+	DefinitionDir string `protobuf:"bytes,1,opt,name=definition_dir,json=definitionDir,proto3" json:"definition_dir,omitempty"`
+	// 异步执行记录目录，未配置时只保存在内存中
+	ExecutionDir string `protobuf:"bytes,2,opt,name=execution_dir,json=executionDir,proto3" json:"execution_dir,omitempty"`
+	// 完成回调的请求超时
+	CallbackTimeout *durationpb.Duration `protobuf:"bytes,3,opt,name=callback_timeout,json=callbackTimeout,proto3" json:"callback_timeout,omitempty"`
+	// 允许回调到内网地址的主机名，其余回调只能发往公网地址
+	CallbackAllowedHosts []string `protobuf:"bytes,4,rep,name=callback_allowed_hosts,json=callbackAllowedHosts,proto3" json:"callback_allowed_hosts,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Data_Workflow) Reset()         { *m = Data_Workflow{} }
+func (m *Data_Workflow) String() string { return proto.CompactTextString(m) }
+func (*Data_Workflow) ProtoMessage()    {}
+func (*Data_Workflow) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9c69a7f648509b54, []int{2, 4}
+}
+
+func (m *Data_Workflow) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Data_Workflow.Unmarshal(m, b)
+}
+func (m *Data_Workflow) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Data_Workflow.Marshal(b, m, deterministic)
+}
+func (m *Data_Workflow) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Data_Workflow.Merge(m, src)
+}
+func (m *Data_Workflow) XXX_Size() int {
+	return xxx_messageInfo_Data_Workflow.Size(m)
+}
+func (m *Data_Workflow) XXX_DiscardUnknown() {
+	xxx_messageInfo_Data_Workflow.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Data_Workflow proto.InternalMessageInfo
+
+func (m *Data_Workflow) GetDefinitionDir() string {
+	if m != nil {
+		return m.DefinitionDir
+	}
+	return ""
+}
+
+func (m *Data_Workflow) GetExecutionDir() string {
+	if m != nil {
+		return m.ExecutionDir
+	}
+	return ""
+}
+
+func (m *Data_Workflow) GetCallbackTimeout() *durationpb.Duration {
+	if m != nil {
+		return m.CallbackTimeout
+	}
+	return nil
+}
+
+func (m *Data_Workflow) GetCallbackAllowedHosts() []string {
+	if m != nil {
+		return m.CallbackAllowedHosts
+	}
+	return nil
+}
+
+type Data_Generation struct {
+	// 生成答案的提供方：extractive（默认，本地抽取式）、openai（OpenAI 兼容接口）、ollama
+	Provider string `protobuf:"bytes,1,opt,name=provider,proto3" json:"provider,omitempty"`
+	// 提供方地址，openai 为 API 根路径，如 https://api.openai.com/v1
+	Endpoint string               `protobuf:"bytes,2,opt,name=endpoint,proto3" json:"endpoint,omitempty"`
+	ApiKey   string               `protobuf:"bytes,3,opt,name=api_key,json=apiKey,proto3" json:"api_key,omitempty"`
+	Model    string               `protobuf:"bytes,4,opt,name=model,proto3" json:"model,omitempty"`
+	Timeout  *durationpb.Duration `protobuf:"bytes,5,opt,name=timeout,proto3" json:"timeout,omitempty"`
+	// 模型的上下文窗口，提示词与生成内容的 token 总数不超过它
+	ContextWindow int32 `protobuf:"varint,6,opt,name=context_window,json=contextWindow,proto3" json:"context_window,omitempty"`
+	// 请求未指定时的生成 token 上限和温度
+	MaxTokens   int32   `protobuf:"varint,7,opt,name=max_tokens,json=maxTokens,proto3" json:"max_tokens,omitempty"`
+	Temperature float32 `protobuf:"fixed32,8,opt,name=temperature,proto3" json:"temperature,omitempty"`
+	// 每次生成都使用的停止序列
+	Stop []string `protobuf:"bytes,9,rep,name=stop,proto3" json:"stop,omitempty"`
+	// 计算 token 数的分词器和词表目录，与组装服务配置相同时两边计数一致，未配置时使用启发式分词器
+	Tokenizer            string   `protobuf:"bytes,10,opt,name=tokenizer,proto3" json:"tokenizer,omitempty"`
+	VocabDir             string   `protobuf:"bytes,11,opt,name=vocab_dir,json=vocabDir,proto3" json:"vocab_dir,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Data_Generation) Reset()         { *m = Data_Generation{} }
+func (m *Data_Generation) String() string { return proto.CompactTextString(m) }
+func (*Data_Generation) ProtoMessage()    {}
+func (*Data_Generation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9c69a7f648509b54, []int{2, 5}
+}
+
+func (m *Data_Generation) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Data_Generation.Unmarshal(m, b)
+}
+func (m *Data_Generation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Data_Generation.Marshal(b, m, deterministic)
+}
+func (m *Data_Generation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Data_Generation.Merge(m, src)
+}
+func (m *Data_Generation) XXX_Size() int {
+	return xxx_messageInfo_Data_Generation.Size(m)
+}
+func (m *Data_Generation) XXX_DiscardUnknown() {
+	xxx_messageInfo_Data_Generation.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Data_Generation proto.InternalMessageInfo
+
+func (m *Data_Generation) GetProvider() string {
+	if m != nil {
+		return m.Provider
+	}
+	return ""
+}
+
+func (m *Data_Generation) GetEndpoint() string {
+	if m != nil {
+		return m.Endpoint
+	}
+	return ""
+}
+
+func (m *Data_Generation) GetApiKey() string {
+	if m != nil {
+		return m.ApiKey
+	}
+	return ""
+}
+
+func (m *Data_Generation) GetModel() string {
+	if m != nil {
+		return m.Model
+	}
+	return ""
+}
+
+func (m *Data_Generation) GetTimeout() *durationpb.Duration {
+	if m != nil {
+		return m.Timeout
+	}
+	return nil
+}
+
+func (m *Data_Generation) GetContextWindow() int32 {
+	if m != nil {
+		return m.ContextWindow
+	}
+	return 0
+}
+
+func (m *Data_Generation) GetMaxTokens() int32 {
+	if m != nil {
+		return m.MaxTokens
+	}
+	return 0
+}
+
+func (m *Data_Generation) GetTemperature() float32 {
+	if m != nil {
+		return m.Temperature
+	}
+	return 0
+}
+
+func (m *Data_Generation) GetStop() []string {
+	if m != nil {
+		return m.Stop
+	}
+	return nil
+}
+
+func (m *Data_Generation) GetTokenizer() string {
+	if m != nil {
+		return m.Tokenizer
+	}
+	return ""
+}
+
+func (m *Data_Generation) GetVocabDir() string {
+	if m != nil {
+		return m.VocabDir
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*Bootstrap)(nil), "kratos.api.Bootstrap")
 	proto.RegisterType((*Server)(nil), "kratos.api.Server")
 	proto.RegisterType((*Server_HTTP)(nil), "kratos.api.Server.HTTP")
 	proto.RegisterType((*Server_GRPC)(nil), "kratos.api.Server.GRPC")
 	proto.RegisterType((*Data)(nil), "kratos.api.Data")
+	proto.RegisterMapType((map[string]*Data_Service)(nil), "kratos.api.Data.ServicesEntry")
 	proto.RegisterType((*Data_Database)(nil), "kratos.api.Data.Database")
 	proto.RegisterType((*Data_Redis)(nil), "kratos.api.Data.Redis")
+	proto.RegisterType((*Data_CircuitBreaker)(nil), "kratos.api.Data.CircuitBreaker")
+	proto.RegisterType((*Data_Service)(nil), "kratos.api.Data.Service")
+	proto.RegisterType((*Data_Workflow)(nil), "kratos.api.Data.Workflow")
+	proto.RegisterType((*Data_Generation)(nil), "kratos.api.Data.Generation")
 }
 
 func init() {
@@ -397,31 +758,63 @@ func init() {
 }
 
 var fileDescriptor_9c69a7f648509b54 = []byte{
-	// 401 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x93, 0xcd, 0x6a, 0xdb, 0x40,
-	0x14, 0x85, 0xb1, 0x2b, 0xcb, 0xd6, 0xb5, 0x0b, 0x65, 0x16, 0xae, 0xac, 0x45, 0x29, 0xa6, 0xd0,
-	0x5f, 0x46, 0x50, 0xd3, 0x4d, 0x5b, 0xba, 0x70, 0x0d, 0xed, 0xd2, 0x4c, 0xbc, 0x0a, 0x84, 0x30,
-	0x96, 0xc6, 0xf2, 0x60, 0x47, 0x23, 0xae, 0x46, 0xf1, 0x83, 0x65, 0x93, 0x97, 0xc9, 0xbb, 0x84,
-	0x19, 0x8d, 0x9c, 0x1f, 0x07, 0x92, 0x6c, 0xb2, 0x19, 0x74, 0x75, 0xbf, 0x73, 0x0e, 0x3a, 0x68,
-	0x20, 0x94, 0xb9, 0x16, 0x98, 0xf3, 0x6d, 0x9c, 0xa8, 0x7c, 0x65, 0x0f, 0x5a, 0xa0, 0xd2, 0x8a,
-	0xc0, 0x06, 0xb9, 0x56, 0x25, 0xe5, 0x85, 0x8c, 0xde, 0x65, 0x4a, 0x65, 0x5b, 0x11, 0xdb, 0xcd,
-	0xb2, 0x5a, 0xc5, 0x69, 0x85, 0x5c, 0x4b, 0x95, 0xd7, 0xec, 0xf8, 0x04, 0x82, 0xa9, 0x52, 0xba,
-	0xd4, 0xc8, 0x0b, 0xf2, 0x05, 0xfc, 0x52, 0xe0, 0xb9, 0xc0, 0xb0, 0xf5, 0xbe, 0xf5, 0xa9, 0xff,
-	0x9d, 0xd0, 0x1b, 0x27, 0x7a, 0x64, 0x37, 0xcc, 0x11, 0xe4, 0x03, 0x78, 0x29, 0xd7, 0x3c, 0x6c,
-	0x5b, 0xf2, 0xcd, 0x6d, 0x72, 0xc6, 0x35, 0x67, 0x76, 0x3b, 0xbe, 0x6c, 0x83, 0x5f, 0x0b, 0xc9,
-	0x57, 0xf0, 0xd6, 0x5a, 0x17, 0xce, 0xfa, 0xed, 0xa1, 0x35, 0xfd, 0xbf, 0x58, 0xcc, 0x99, 0x85,
-	0x0c, 0x9c, 0x61, 0x91, 0x38, 0xf7, 0x87, 0xe0, 0x7f, 0x6c, 0xfe, 0x97, 0x59, 0x28, 0x92, 0xe0,
-	0x19, 0x29, 0x09, 0xa1, 0x9b, 0x0b, 0xbd, 0x53, 0xb8, 0xb1, 0x21, 0x01, 0x6b, 0x46, 0x42, 0xc0,
-	0xe3, 0x69, 0x8a, 0xd6, 0x2e, 0x60, 0xf6, 0x99, 0x4c, 0xa0, 0xab, 0xe5, 0x99, 0x50, 0x95, 0x0e,
-	0x5f, 0xd9, 0x94, 0x11, 0xad, 0xbb, 0xa2, 0x4d, 0x57, 0x74, 0xe6, 0xba, 0x62, 0x0d, 0x69, 0xa2,
-	0x4c, 0xf0, 0x0b, 0x44, 0x8d, 0xaf, 0xda, 0xe0, 0x99, 0x26, 0xc9, 0x0f, 0xe8, 0x99, 0x2e, 0x97,
-	0xbc, 0x14, 0xae, 0xbc, 0xd1, 0xfd, 0xb6, 0xed, 0x61, 0x00, 0xb6, 0x47, 0xc9, 0x37, 0xe8, 0xa0,
-	0x48, 0x65, 0xe9, 0x3a, 0x1c, 0x1e, 0x68, 0x98, 0xd9, 0xb2, 0x1a, 0x8a, 0x7e, 0x42, 0xaf, 0xf1,
-	0x20, 0x43, 0xf0, 0x53, 0x94, 0xcd, 0x6f, 0x10, 0x30, 0x37, 0x99, 0xf7, 0xa5, 0xaa, 0x30, 0x11,
-	0xee, 0xe3, 0xdc, 0x14, 0x5d, 0xb4, 0xa0, 0x63, 0xcd, 0x9e, 0x59, 0xcb, 0x6f, 0x18, 0xa0, 0xe0,
-	0xe9, 0xe9, 0x93, 0xbb, 0xe9, 0x1b, 0x7c, 0x51, 0xd3, 0xe4, 0x0f, 0xbc, 0xde, 0xa1, 0xd4, 0x62,
-	0x2f, 0xf7, 0x1e, 0x93, 0x0f, 0x2c, 0xef, 0xf4, 0xd3, 0xcf, 0xc7, 0x1f, 0x91, 0x67, 0x31, 0x2f,
-	0x8a, 0x58, 0x61, 0xb2, 0x16, 0xe6, 0x0a, 0x68, 0x85, 0xf1, 0x9d, 0x6b, 0xf5, 0xcb, 0x1c, 0x4b,
-	0xdf, 0x7a, 0x4d, 0xae, 0x03, 0x00, 0x00, 0xff, 0xff, 0x0c, 0xf5, 0x2c, 0x81, 0x73, 0x03, 0x00,
-	0x00,
+	// 915 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x56, 0xdf, 0x8e, 0xdb, 0xc4,
+	0x17, 0x56, 0xb2, 0xf9, 0xe7, 0x93, 0x3f, 0xbb, 0x1a, 0x55, 0x5b, 0xd7, 0xfd, 0xfd, 0xda, 0xb0,
+	0x50, 0x75, 0x81, 0xca, 0x11, 0x2d, 0x48, 0x68, 0x8b, 0x90, 0xd8, 0x0d, 0xea, 0x4a, 0x5c, 0x50,
+	0x4d, 0x17, 0x55, 0x42, 0x42, 0xd6, 0xc4, 0x3e, 0xc9, 0x8e, 0xe2, 0x78, 0xcc, 0x78, 0xbc, 0xc9,
+	0xf2, 0x5a, 0xdc, 0xf0, 0x12, 0xdc, 0xf0, 0x02, 0x88, 0x67, 0xe0, 0x05, 0xd0, 0x8c, 0xc7, 0x4e,
+	0xd2, 0x68, 0xd5, 0x70, 0xc3, 0x8d, 0xe5, 0xf9, 0xce, 0x77, 0xe6, 0xcc, 0xf9, 0xbe, 0x33, 0x96,
+	0xc1, 0xe5, 0x89, 0x42, 0x99, 0xb0, 0x78, 0x14, 0x8a, 0x64, 0x6a, 0x1e, 0x7e, 0x2a, 0x85, 0x12,
+	0x04, 0xe6, 0x92, 0x29, 0x91, 0xf9, 0x2c, 0xe5, 0xde, 0xa3, 0x99, 0x10, 0xb3, 0x18, 0x47, 0x26,
+	0x32, 0xc9, 0xa7, 0xa3, 0x28, 0x97, 0x4c, 0x71, 0x91, 0x14, 0xdc, 0x93, 0x9f, 0xc0, 0x39, 0x17,
+	0x42, 0x65, 0x4a, 0xb2, 0x94, 0x7c, 0x02, 0xad, 0x0c, 0xe5, 0x0d, 0x4a, 0xb7, 0x36, 0xac, 0x9d,
+	0x76, 0x9f, 0x13, 0x7f, 0xbd, 0x93, 0xff, 0xc6, 0x44, 0xa8, 0x65, 0x90, 0x8f, 0xa0, 0x11, 0x31,
+	0xc5, 0xdc, 0xba, 0x61, 0x1e, 0x6d, 0x32, 0xc7, 0x4c, 0x31, 0x6a, 0xa2, 0x27, 0xbf, 0xd5, 0xa1,
+	0x55, 0x24, 0x92, 0x4f, 0xa1, 0x71, 0xad, 0x54, 0x6a, 0xb7, 0xbe, 0xbf, 0xbb, 0xb5, 0x7f, 0x79,
+	0x75, 0xf5, 0x9a, 0x1a, 0x92, 0x26, 0xcf, 0x64, 0x1a, 0xba, 0xf5, 0x3b, 0xc9, 0xaf, 0xe8, 0xeb,
+	0x0b, 0x6a, 0x48, 0x1e, 0x87, 0x86, 0x4e, 0x25, 0x2e, 0xb4, 0x13, 0x54, 0x4b, 0x21, 0xe7, 0xa6,
+	0x88, 0x43, 0xcb, 0x25, 0x21, 0xd0, 0x60, 0x51, 0x24, 0xcd, 0x76, 0x0e, 0x35, 0xef, 0xe4, 0x05,
+	0xb4, 0x15, 0x5f, 0xa0, 0xc8, 0x95, 0x7b, 0x60, 0xaa, 0x3c, 0xf0, 0x0b, 0xad, 0xfc, 0x52, 0x2b,
+	0x7f, 0x6c, 0xb5, 0xa2, 0x25, 0x53, 0x97, 0xd2, 0x85, 0xff, 0x83, 0x52, 0x27, 0x7f, 0xf5, 0xa0,
+	0xa1, 0x95, 0x24, 0x5f, 0x40, 0x47, 0x6b, 0x39, 0x61, 0x19, 0x5a, 0xf1, 0x1e, 0xbc, 0xab, 0xb6,
+	0x3f, 0xb6, 0x04, 0x5a, 0x51, 0xc9, 0x33, 0x68, 0x4a, 0x8c, 0x78, 0x66, 0x35, 0x3c, 0xde, 0xc9,
+	0xa1, 0x3a, 0x4a, 0x0b, 0x12, 0x39, 0x83, 0x8e, 0x36, 0x96, 0x87, 0x98, 0xb9, 0x07, 0xc3, 0x83,
+	0xd3, 0xee, 0xf3, 0x47, 0x3b, 0x09, 0x6f, 0x2c, 0xe1, 0xdb, 0x44, 0xc9, 0x5b, 0x5a, 0xf1, 0xf5,
+	0x01, 0x75, 0xeb, 0xd3, 0x58, 0x2c, 0xdd, 0xc6, 0x1d, 0x07, 0x7c, 0x6b, 0x09, 0xb4, 0xa2, 0x92,
+	0x97, 0x00, 0x33, 0x4c, 0xb0, 0xe8, 0xdb, 0x6d, 0x9a, 0xc4, 0x87, 0x3b, 0x89, 0xaf, 0x2a, 0x0a,
+	0xdd, 0xa0, 0x7b, 0x67, 0xd0, 0x29, 0x7b, 0x26, 0xc7, 0xd0, 0x8a, 0x24, 0x2f, 0xc7, 0xd6, 0xa1,
+	0x76, 0xa5, 0xf1, 0x4c, 0xe4, 0x32, 0x44, 0x6b, 0x86, 0x5d, 0x79, 0xbf, 0xd6, 0xa0, 0x69, 0x9a,
+	0xff, 0x97, 0x36, 0x7e, 0x05, 0x3d, 0x89, 0x2c, 0x0a, 0xf6, 0xf6, 0xb2, 0xab, 0xe9, 0x57, 0x05,
+	0x9b, 0x7c, 0x0d, 0xfd, 0xa5, 0xe4, 0x0a, 0xab, 0xf4, 0xc6, 0xfb, 0xd2, 0x7b, 0x86, 0x6f, 0xf3,
+	0xbd, 0xbf, 0x6b, 0x30, 0xb8, 0xe0, 0x32, 0xcc, 0xb9, 0x3a, 0x97, 0xc8, 0xe6, 0x28, 0xc9, 0x63,
+	0xe8, 0xa2, 0x94, 0x42, 0x06, 0x26, 0xc1, 0xb4, 0x50, 0xa3, 0x60, 0x20, 0xaa, 0x11, 0xf2, 0x19,
+	0xb4, 0x96, 0x3c, 0x89, 0xc4, 0xd2, 0xad, 0xbf, 0xaf, 0x98, 0x25, 0x92, 0x0f, 0xa0, 0xb7, 0xe0,
+	0x49, 0x20, 0xf1, 0xe7, 0x1c, 0x33, 0x95, 0x99, 0x26, 0x9b, 0xb4, 0xbb, 0xe0, 0x09, 0xb5, 0x90,
+	0xd6, 0x41, 0xa4, 0x98, 0xec, 0xdf, 0x48, 0x57, 0xd3, 0x4b, 0x1d, 0x9e, 0x01, 0xb9, 0x66, 0xf1,
+	0x34, 0x30, 0x5b, 0x54, 0x65, 0x9a, 0xa6, 0xcc, 0x91, 0x8e, 0x7c, 0x9f, 0x62, 0x55, 0xcb, 0xfb,
+	0xbd, 0x06, 0x6d, 0x3b, 0x77, 0xc4, 0x83, 0x0e, 0x26, 0x51, 0x2a, 0x78, 0xa2, 0xac, 0x5d, 0xd5,
+	0x7a, 0xf3, 0x8a, 0xd5, 0xf7, 0xbd, 0x62, 0xe4, 0x12, 0x0e, 0xc3, 0x42, 0xd1, 0x60, 0x52, 0x48,
+	0x6a, 0x3d, 0x7d, 0xbc, 0x33, 0x86, 0xdb, 0xca, 0xd3, 0x41, 0xb8, 0xed, 0xc4, 0x53, 0x38, 0x5c,
+	0xb0, 0x55, 0x10, 0x8a, 0x24, 0xcc, 0xa5, 0xc4, 0x24, 0xbc, 0x35, 0xaa, 0x34, 0xe9, 0x60, 0xc1,
+	0x56, 0x17, 0x6b, 0xd4, 0xfb, 0xa3, 0x06, 0x9d, 0xf2, 0x2e, 0x90, 0x27, 0x30, 0x88, 0x70, 0xca,
+	0x13, 0xae, 0x8f, 0x15, 0x44, 0xbc, 0x1c, 0xe0, 0xfe, 0x1a, 0x1d, 0x73, 0x49, 0x3e, 0x84, 0x3e,
+	0xae, 0x30, 0xcc, 0x2b, 0x56, 0x31, 0x94, 0xbd, 0x0a, 0xd4, 0xa4, 0x31, 0x1c, 0x85, 0x2c, 0x8e,
+	0x27, 0x2c, 0x9c, 0xef, 0x3f, 0xa0, 0x87, 0x65, 0x4a, 0x69, 0xce, 0xe7, 0x70, 0x5c, 0xed, 0xc2,
+	0xe2, 0x58, 0x2c, 0x31, 0x0a, 0xae, 0x85, 0x36, 0xa8, 0x31, 0x3c, 0x38, 0x75, 0xe8, 0xbd, 0x32,
+	0xfa, 0x4d, 0x11, 0xbc, 0xd4, 0x31, 0xef, 0xcf, 0x3a, 0xc0, 0xfa, 0x9e, 0x6a, 0x9f, 0x52, 0x29,
+	0x6e, 0x78, 0x54, 0xdd, 0xc8, 0x6a, 0xbd, 0xe5, 0x61, 0xfd, 0x1d, 0x0f, 0xef, 0x43, 0x9b, 0xa5,
+	0x3c, 0x98, 0xe3, 0xad, 0x39, 0xb9, 0x43, 0x5b, 0x2c, 0xe5, 0xdf, 0xe1, 0x2d, 0xb9, 0x07, 0xcd,
+	0x85, 0x88, 0x30, 0x36, 0x9a, 0x3a, 0xb4, 0x58, 0x6c, 0x5a, 0xde, 0xdc, 0xdb, 0xf2, 0x27, 0x30,
+	0x08, 0x45, 0xa2, 0x70, 0xa5, 0x02, 0x7b, 0x33, 0x5a, 0xc6, 0xa7, 0xbe, 0x45, 0xdf, 0x1a, 0x90,
+	0xfc, 0x1f, 0x40, 0xfb, 0xa9, 0xc4, 0x1c, 0x93, 0xcc, 0x6d, 0x1b, 0x8a, 0xb3, 0x60, 0xab, 0x2b,
+	0x03, 0x90, 0x21, 0x74, 0x15, 0x2e, 0x52, 0xdd, 0x70, 0x2e, 0xd1, 0xed, 0x0c, 0x6b, 0xa7, 0x75,
+	0xba, 0x09, 0xe9, 0xef, 0x47, 0xa6, 0x44, 0xea, 0x3a, 0x46, 0x36, 0xf3, 0x4e, 0xfe, 0x07, 0x8e,
+	0xd9, 0x90, 0xff, 0x82, 0xd2, 0x05, 0xd3, 0xca, 0x1a, 0x20, 0x0f, 0xc1, 0xb9, 0x11, 0x21, 0x9b,
+	0x18, 0x87, 0xbb, 0x85, 0x34, 0x06, 0x18, 0x73, 0xe9, 0xfd, 0x00, 0xfd, 0xad, 0xaf, 0x2f, 0x39,
+	0x82, 0x03, 0xad, 0x53, 0x21, 0xaf, 0x7e, 0x25, 0x3e, 0x34, 0x6f, 0x58, 0x9c, 0xa3, 0x9d, 0x7f,
+	0xf7, 0xae, 0xcf, 0x37, 0x2d, 0x68, 0x67, 0xf5, 0x2f, 0x6b, 0xe7, 0x1f, 0xff, 0xf8, 0x54, 0xb2,
+	0xd9, 0x88, 0xa5, 0xe9, 0x48, 0xc8, 0xf0, 0x1a, 0xf5, 0x6f, 0x80, 0x12, 0x72, 0xb4, 0xf5, 0x6b,
+	0xf1, 0x52, 0x3f, 0x26, 0x2d, 0x23, 0xea, 0x8b, 0x7f, 0x06, 0x00, 0xa0, 0xc2, 0xd1, 0x1d, 0x77,
+	0x08, 0x00, 0x00,
 }
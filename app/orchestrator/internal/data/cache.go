@@ -0,0 +1,84 @@
+package data
+
+import (
+	"container/list"
+	"context"
+	"sync"
+	"time"
+
+	v1 "rag/api/orchestrator/v1"
+	"rag/app/orchestrator/internal/biz"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/golang/protobuf/proto"
+)
+
+// maxCachedQueries bounds the number of query responses kept in memory
+const maxCachedQueries = 10000
+
+// queryCache implements biz.QueryCache as an in-memory LRU with per-entry TTL
+type queryCache struct {
+	log *log.Helper
+
+	mu      sync.Mutex
+	entries map[string]*list.Element
+	lru     *list.List
+}
+
+type cacheEntry struct {
+	key       string
+	resp      *v1.ProcessQueryResponse
+	expiresAt time.Time
+}
+
+// NewQueryCache creates a new query response cache
+func NewQueryCache(logger log.Logger) biz.QueryCache {
+	return &queryCache{
+		log:     log.NewHelper(logger),
+		entries: make(map[string]*list.Element),
+		lru:     list.New(),
+	}
+}
+
+// Get returns a copy of a cached response that has not expired
+func (c *queryCache) Get(ctx context.Context, key string) (*v1.ProcessQueryResponse, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	elem, ok := c.entries[key]
+	if !ok {
+		return nil, false
+	}
+	entry := elem.Value.(*cacheEntry)
+	if time.Now().After(entry.expiresAt) {
+		c.lru.Remove(elem)
+		delete(c.entries, key)
+		return nil, false
+	}
+	c.lru.MoveToFront(elem)
+	return proto.Clone(entry.resp).(*v1.ProcessQueryResponse), true
+}
+
+// Set stores a copy of a response, evicting the least recently used entry
+// when full
+func (c *queryCache) Set(ctx context.Context, key string, resp *v1.ProcessQueryResponse, ttl time.Duration) {
+	resp = proto.Clone(resp).(*v1.ProcessQueryResponse)
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	expiresAt := time.Now().Add(ttl)
+	if elem, ok := c.entries[key]; ok {
+		entry := elem.Value.(*cacheEntry)
+		entry.resp = resp
+		entry.expiresAt = expiresAt
+		c.lru.MoveToFront(elem)
+		return
+	}
+
+	c.entries[key] = c.lru.PushFront(&cacheEntry{key: key, resp: resp, expiresAt: expiresAt})
+	for c.lru.Len() > maxCachedQueries {
+		oldest := c.lru.Back()
+		c.lru.Remove(oldest)
+		delete(c.entries, oldest.Value.(*cacheEntry).key)
+	}
+}
@@ -0,0 +1,143 @@
+package data
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/netip"
+	"net/url"
+	"strings"
+	"syscall"
+	"time"
+
+	v1 "rag/api/orchestrator/v1"
+	"rag/app/orchestrator/internal/biz"
+	"rag/app/orchestrator/internal/conf"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+// 未配置时回调请求的超时
+const defaultCallbackTimeout = 10 * time.Second
+
+// 公网单播地址中仍不可路由到外部的网段
+var reservedPrefixes = []netip.Prefix{
+	netip.MustParsePrefix("0.0.0.0/8"),
+	netip.MustParsePrefix("100.64.0.0/10"),
+	netip.MustParsePrefix("192.0.0.0/24"),
+	netip.MustParsePrefix("198.18.0.0/15"),
+	netip.MustParsePrefix("240.0.0.0/4"),
+	netip.MustParsePrefix("64:ff9b::/96"),
+	netip.MustParsePrefix("64:ff9b:1::/48"),
+}
+
+// callbackRepo implements biz.CallbackRepo by posting the status as JSON.
+// Callback URLs come from clients, so by default they may only reach public
+// addresses; the check runs on the dialed address, after DNS resolution and
+// for every connection. Configured hosts may resolve to any address.
+// Redirects are not followed.
+type callbackRepo struct {
+	public  *http.Client
+	trusted *http.Client
+	allowed map[string]bool
+	log     *log.Helper
+}
+
+// NewCallbackRepo creates the callback client
+func NewCallbackRepo(c *conf.Data, logger log.Logger) biz.CallbackRepo {
+	timeout := defaultCallbackTimeout
+	if t := c.GetWorkflow().GetCallbackTimeout(); t != nil && t.AsDuration() > 0 {
+		timeout = t.AsDuration()
+	}
+	allowed := make(map[string]bool)
+	for _, host := range c.GetWorkflow().GetCallbackAllowedHosts() {
+		allowed[strings.ToLower(host)] = true
+	}
+	return &callbackRepo{
+		public:  newCallbackClient(timeout, publicOnly),
+		trusted: newCallbackClient(timeout, nil),
+		allowed: allowed,
+		log:     log.NewHelper(logger),
+	}
+}
+
+// newCallbackClient creates a client that checks each dialed address with
+// control. It goes without proxies so that the check sees the real peer.
+func newCallbackClient(timeout time.Duration, control func(network, address string, c syscall.RawConn) error) *http.Client {
+	dialer := &net.Dialer{Timeout: timeout, KeepAlive: 30 * time.Second, Control: control}
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.Proxy = nil
+	transport.DialContext = dialer.DialContext
+	return &http.Client{
+		Timeout:   timeout,
+		Transport: transport,
+		CheckRedirect: func(*http.Request, []*http.Request) error {
+			return http.ErrUseLastResponse
+		},
+	}
+}
+
+// publicOnly refuses connections to addresses that are not public
+func publicOnly(_, address string, _ syscall.RawConn) error {
+	host, _, err := net.SplitHostPort(address)
+	if err != nil {
+		return err
+	}
+	addr, err := netip.ParseAddr(host)
+	if err != nil {
+		return err
+	}
+	if !publicAddress(addr) {
+		return fmt.Errorf("callback address %s is not public", addr)
+	}
+	return nil
+}
+
+// publicAddress reports whether addr is a routable public unicast address
+func publicAddress(addr netip.Addr) bool {
+	addr = addr.Unmap()
+	if !addr.IsGlobalUnicast() || addr.IsPrivate() {
+		return false
+	}
+	for _, p := range reservedPrefixes {
+		if p.Contains(addr) {
+			return false
+		}
+	}
+	return true
+}
+
+// Deliver posts status to url and expects a 2xx answer
+func (r *callbackRepo) Deliver(ctx context.Context, target string, status *v1.GetWorkflowStatusResponse) error {
+	u, err := url.Parse(target)
+	if err != nil {
+		return err
+	}
+	client := r.public
+	if r.allowed[strings.ToLower(u.Hostname())] {
+		client = r.trusted
+	}
+	body, err := marshalMessage(status)
+	if err != nil {
+		return err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, target, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("X-Execution-Id", status.WorkflowId)
+	resp, err := client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	_, _ = io.Copy(io.Discard, io.LimitReader(resp.Body, 64<<10))
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return fmt.Errorf("callback answered %s", resp.Status)
+	}
+	return nil
+}
@@ -0,0 +1,97 @@
+package data
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/netip"
+	"net/url"
+	"strings"
+	"testing"
+
+	v1 "rag/api/orchestrator/v1"
+	"rag/app/orchestrator/internal/conf"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+func TestPublicAddress(t *testing.T) {
+	for addr, want := range map[string]bool{
+		"8.8.8.8":            true,
+		"2606:4700::1111":    true,
+		"127.0.0.1":          false,
+		"10.1.2.3":           false,
+		"172.16.0.1":         false,
+		"192.168.1.1":        false,
+		"169.254.169.254":    false,
+		"100.64.0.1":         false,
+		"0.0.0.0":            false,
+		"0.1.2.3":            false,
+		"224.0.0.1":          false,
+		"255.255.255.255":    false,
+		"::1":                false,
+		"::":                 false,
+		"fd00::1":            false,
+		"fe80::1":            false,
+		"::ffff:127.0.0.1":   false,
+		"::ffff:8.8.8.8":     true,
+		"64:ff9b::a9fe:a9fe": false,
+	} {
+		if got := publicAddress(netip.MustParseAddr(addr)); got != want {
+			t.Errorf("publicAddress(%s) = %t, want %t", addr, got, want)
+		}
+	}
+}
+
+func newTestCallbackRepo(allowed ...string) *callbackRepo {
+	return NewCallbackRepo(&conf.Data{Workflow: &conf.Data_Workflow{CallbackAllowedHosts: allowed}}, log.DefaultLogger).(*callbackRepo)
+}
+
+func TestDeliverRejectsPrivateAddresses(t *testing.T) {
+	var received []string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		received = append(received, r.Header.Get("X-Execution-Id")+" "+string(body))
+	}))
+	defer srv.Close()
+	status := &v1.GetWorkflowStatusResponse{WorkflowId: "exec-1"}
+
+	err := newTestCallbackRepo().Deliver(context.Background(), srv.URL, status)
+	if err == nil || !strings.Contains(err.Error(), "not public") {
+		t.Fatalf("Deliver to loopback = %v", err)
+	}
+	if len(received) != 0 {
+		t.Fatal("callback reached the loopback server")
+	}
+
+	// 放行的主机可以回调到内网
+	u, _ := url.Parse(srv.URL)
+	if err := newTestCallbackRepo(u.Hostname()).Deliver(context.Background(), srv.URL, status); err != nil {
+		t.Fatal(err)
+	}
+	if len(received) != 1 || !strings.HasPrefix(received[0], "exec-1 {") {
+		t.Errorf("received %q", received)
+	}
+	// 通过主机名解析到内网同样被拒绝
+	localhost := strings.Replace(srv.URL, u.Hostname(), "localhost", 1)
+	if err := newTestCallbackRepo(u.Hostname()).Deliver(context.Background(), localhost, status); err == nil {
+		t.Error("Deliver to localhost succeeded")
+	}
+}
+
+func TestDeliverDoesNotFollowRedirects(t *testing.T) {
+	var hits int
+	target := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) { hits++ }))
+	defer target.Close()
+	redirect := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		http.Redirect(w, r, target.URL, http.StatusTemporaryRedirect)
+	}))
+	defer redirect.Close()
+
+	u, _ := url.Parse(redirect.URL)
+	err := newTestCallbackRepo(u.Hostname()).Deliver(context.Background(), redirect.URL, &v1.GetWorkflowStatusResponse{})
+	if err == nil || !strings.Contains(err.Error(), "307") || hits != 0 {
+		t.Errorf("Deliver = %v after %d hits on the target", err, hits)
+	}
+}
@@ -1,24 +1,58 @@
 package data
 
 import (
+	"context"
+
 	"rag/app/orchestrator/internal/conf"
 
 	"github.com/go-kratos/kratos/v2/log"
+	"github.com/go-kratos/kratos/v2/middleware/recovery"
+	kgrpc "github.com/go-kratos/kratos/v2/transport/grpc"
 	"github.com/google/wire"
+	"google.golang.org/grpc"
 )
 
 // ProviderSet is data providers.
-var ProviderSet = wire.NewSet(NewData, NewGreeterRepo)
+var ProviderSet = wire.NewSet(NewData, NewServiceRepo, NewDefinitionRepo, NewExecutionRepo, NewCallbackRepo, NewQueryCache, NewGenerator, NewTokenizer)
 
 // Data .
 type Data struct {
-	// TODO wrapped database client
+	// 各后端服务的 gRPC 连接，按服务名索引
+	conns map[string]*grpc.ClientConn
 }
 
-// NewData .
+// NewData dials the backend services listed in the configuration. Connections
+// are established lazily, so services that are down do not block startup.
 func NewData(c *conf.Data, logger log.Logger) (*Data, func(), error) {
+	helper := log.NewHelper(logger)
+	data := &Data{
+		conns: make(map[string]*grpc.ClientConn),
+	}
 	cleanup := func() {
-		log.NewHelper(logger).Info("closing the data resources")
+		helper.Info("closing the data resources")
+		for name, conn := range data.conns {
+			if err := conn.Close(); err != nil {
+				helper.Errorf("failed to close connection to %s: %v", name, err)
+			}
+		}
+	}
+
+	for name, svc := range c.GetServices() {
+		if svc.GetEndpoint() == "" {
+			continue
+		}
+		// 超时主要由工作流步骤的 context 控制，未配置时不另设上限
+		conn, err := kgrpc.DialInsecure(context.Background(),
+			kgrpc.WithEndpoint(svc.GetEndpoint()),
+			kgrpc.WithTimeout(svc.GetTimeout().AsDuration()),
+			kgrpc.WithMiddleware(recovery.Recovery()),
+		)
+		if err != nil {
+			cleanup()
+			return nil, nil, err
+		}
+		data.conns[name] = conn
+		helper.Infof("service %s at %s", name, svc.GetEndpoint())
 	}
-	return &Data{}, cleanup, nil
+	return data, cleanup, nil
 }
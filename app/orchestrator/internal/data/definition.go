@@ -0,0 +1,262 @@
+package data
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sort"
+	"sync"
+	"time"
+
+	v1 "rag/api/orchestrator/v1"
+	"rag/app/orchestrator/internal/biz"
+	"rag/app/orchestrator/internal/conf"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/golang/protobuf/proto"
+)
+
+// 定义目录：<definition_id>/definition.json 保存版本列表和默认版本，
+// <definition_id>/<version>.json 保存各版本的内容
+const (
+	definitionFileName = "definition.json"
+	versionFileExt     = ".json"
+)
+
+// definitionFile is the stored form of a biz.DefinitionRecord
+type definitionFile struct {
+	ID             string            `json:"definition_id"`
+	DefaultVersion string            `json:"default_version"`
+	Versions       []versionFile     `json:"versions"`
+	Tags           []string          `json:"tags,omitempty"`
+	CustomMetadata map[string]string `json:"custom_metadata,omitempty"`
+	CreatedAt      time.Time         `json:"created_at"`
+	UpdatedAt      time.Time         `json:"updated_at"`
+}
+
+// versionFile is the stored form of a biz.DefinitionVersion
+type versionFile struct {
+	Version     string    `json:"version"`
+	Description string    `json:"description,omitempty"`
+	CreatedBy   string    `json:"created_by,omitempty"`
+	CreatedAt   time.Time `json:"created_at"`
+}
+
+// definitionRepo implements biz.DefinitionRepo on a directory with one
+// subdirectory per definition. Definitions are loaded at startup and written
+// through on every change; a version file is written once, when the version
+// is published. Without a directory definitions are kept in memory only.
+type definitionRepo struct {
+	dir      string
+	mu       sync.RWMutex
+	records  map[string]*biz.DefinitionRecord
+	versions map[string]map[string]*v1.WorkflowDefinition
+	log      *log.Helper
+}
+
+// NewDefinitionRepo loads the definitions found in the configured directory
+func NewDefinitionRepo(c *conf.Data, logger log.Logger) (biz.DefinitionRepo, error) {
+	r := &definitionRepo{
+		dir:      c.GetWorkflow().GetDefinitionDir(),
+		records:  make(map[string]*biz.DefinitionRecord),
+		versions: make(map[string]map[string]*v1.WorkflowDefinition),
+		log:      log.NewHelper(logger),
+	}
+	if r.dir == "" {
+		r.log.Warn("definition dir not configured, workflow definitions are kept in memory only")
+		return r, nil
+	}
+	entries, err := os.ReadDir(r.dir)
+	if errors.Is(err, fs.ErrNotExist) {
+		r.log.Warnf("definition dir %s not found, it will be created on first write", r.dir)
+		return r, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	for _, entry := range entries {
+		if !entry.IsDir() {
+			continue
+		}
+		if _, err := os.Stat(filepath.Join(r.dir, entry.Name(), definitionFileName)); errors.Is(err, fs.ErrNotExist) {
+			continue
+		}
+		if err := r.load(entry.Name()); err != nil {
+			return nil, fmt.Errorf("load definition %s: %w", entry.Name(), err)
+		}
+	}
+	r.log.Infof("loaded %d workflow definitions from %s", len(r.records), r.dir)
+	return r, nil
+}
+
+// load reads a definition and all of its versions
+func (r *definitionRepo) load(id string) error {
+	raw, err := os.ReadFile(filepath.Join(r.dir, id, definitionFileName))
+	if err != nil {
+		return err
+	}
+	var f definitionFile
+	if err := json.Unmarshal(raw, &f); err != nil {
+		return err
+	}
+	rec := &biz.DefinitionRecord{
+		ID:             id,
+		DefaultVersion: f.DefaultVersion,
+		Tags:           f.Tags,
+		CustomMetadata: f.CustomMetadata,
+		CreatedAt:      f.CreatedAt,
+		UpdatedAt:      f.UpdatedAt,
+	}
+	versions := make(map[string]*v1.WorkflowDefinition, len(f.Versions))
+	for _, vf := range f.Versions {
+		raw, err := os.ReadFile(filepath.Join(r.dir, id, vf.Version+versionFileExt))
+		if err != nil {
+			return err
+		}
+		def := new(v1.WorkflowDefinition)
+		if err := unmarshalMessage(raw, def); err != nil {
+			return fmt.Errorf("version %s: %w", vf.Version, err)
+		}
+		versions[vf.Version] = def
+		rec.Versions = append(rec.Versions, biz.DefinitionVersion{
+			Version:     vf.Version,
+			Description: vf.Description,
+			CreatedBy:   vf.CreatedBy,
+			CreatedAt:   vf.CreatedAt,
+		})
+	}
+	r.records[id] = rec
+	r.versions[id] = versions
+	return nil
+}
+
+// GetDefinition returns a copy of a definition
+func (r *definitionRepo) GetDefinition(ctx context.Context, id string) (*biz.DefinitionRecord, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	rec, ok := r.records[id]
+	if !ok {
+		return nil, biz.ErrWorkflowNotFound.WithMetadata(map[string]string{"workflow_id": id})
+	}
+	return copyDefinition(rec), nil
+}
+
+// GetVersion returns a copy of a version's content
+func (r *definitionRepo) GetVersion(ctx context.Context, id, version string) (*v1.WorkflowDefinition, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	if _, ok := r.records[id]; !ok {
+		return nil, biz.ErrWorkflowNotFound.WithMetadata(map[string]string{"workflow_id": id})
+	}
+	def, ok := r.versions[id][version]
+	if !ok {
+		return nil, biz.ErrVersionNotFound.WithMetadata(map[string]string{"workflow_id": id, "version": version})
+	}
+	return proto.Clone(def).(*v1.WorkflowDefinition), nil
+}
+
+// ListDefinitions returns copies of every definition, ordered by id
+func (r *definitionRepo) ListDefinitions(ctx context.Context) ([]*biz.DefinitionRecord, error) {
+	r.mu.RLock()
+	recs := make([]*biz.DefinitionRecord, 0, len(r.records))
+	for _, rec := range r.records {
+		recs = append(recs, copyDefinition(rec))
+	}
+	r.mu.RUnlock()
+	sort.Slice(recs, func(i, j int) bool {
+		return recs[i].ID < recs[j].ID
+	})
+	return recs, nil
+}
+
+// PublishVersion writes the content of a new version, then the definition
+// that lists it. A version that was already published is never rewritten.
+func (r *definitionRepo) PublishVersion(ctx context.Context, rec *biz.DefinitionRecord, def *v1.WorkflowDefinition) error {
+	if !validFileID(rec.ID) || !validFileID(def.Version) {
+		return fmt.Errorf("definition %s version %s cannot be stored", rec.ID, def.Version)
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if _, ok := r.versions[rec.ID][def.Version]; ok {
+		return biz.ErrVersionExists.WithMetadata(map[string]string{"version": def.Version})
+	}
+	if r.dir != "" {
+		raw, err := marshalMessage(def)
+		if err != nil {
+			return err
+		}
+		if err := os.MkdirAll(filepath.Join(r.dir, rec.ID), 0o755); err != nil {
+			return err
+		}
+		// 版本文件先于定义文件写入，中途失败时未登记的版本文件会在重新发布时覆盖
+		if err := writeFileAtomic(filepath.Join(r.dir, rec.ID, def.Version+versionFileExt), raw); err != nil {
+			return err
+		}
+	}
+	if err := r.save(rec); err != nil {
+		return err
+	}
+	if r.versions[rec.ID] == nil {
+		r.versions[rec.ID] = make(map[string]*v1.WorkflowDefinition)
+	}
+	r.versions[rec.ID][def.Version] = proto.Clone(def).(*v1.WorkflowDefinition)
+	return nil
+}
+
+// SaveDefinition writes the definition of an existing record
+func (r *definitionRepo) SaveDefinition(ctx context.Context, rec *biz.DefinitionRecord) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if _, ok := r.records[rec.ID]; !ok {
+		return biz.ErrWorkflowNotFound.WithMetadata(map[string]string{"workflow_id": rec.ID})
+	}
+	return r.save(rec)
+}
+
+// save writes rec and keeps a copy. The caller holds r.mu.
+func (r *definitionRepo) save(rec *biz.DefinitionRecord) error {
+	if r.dir != "" {
+		f := definitionFile{
+			ID:             rec.ID,
+			DefaultVersion: rec.DefaultVersion,
+			Tags:           rec.Tags,
+			CustomMetadata: rec.CustomMetadata,
+			CreatedAt:      rec.CreatedAt,
+			UpdatedAt:      rec.UpdatedAt,
+		}
+		for _, v := range rec.Versions {
+			f.Versions = append(f.Versions, versionFile{
+				Version:     v.Version,
+				Description: v.Description,
+				CreatedBy:   v.CreatedBy,
+				CreatedAt:   v.CreatedAt,
+			})
+		}
+		raw, err := json.MarshalIndent(f, "", "  ")
+		if err != nil {
+			return err
+		}
+		if err := writeFileAtomic(filepath.Join(r.dir, rec.ID, definitionFileName), raw); err != nil {
+			return err
+		}
+	}
+	r.records[rec.ID] = copyDefinition(rec)
+	return nil
+}
+
+func copyDefinition(rec *biz.DefinitionRecord) *biz.DefinitionRecord {
+	copied := *rec
+	copied.Versions = append([]biz.DefinitionVersion(nil), rec.Versions...)
+	copied.Tags = append([]string(nil), rec.Tags...)
+	if rec.CustomMetadata != nil {
+		copied.CustomMetadata = make(map[string]string, len(rec.CustomMetadata))
+		for k, v := range rec.CustomMetadata {
+			copied.CustomMetadata[k] = v
+		}
+	}
+	return &copied
+}
@@ -0,0 +1,440 @@
+package data
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	commonv1 "rag/api/common/v1"
+	v1 "rag/api/orchestrator/v1"
+	"rag/app/orchestrator/internal/biz"
+	"rag/app/orchestrator/internal/conf"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/golang/protobuf/proto"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+// 执行记录文件：<execution_id>.json
+const executionFileExt = ".json"
+
+// executionFile is the stored form of a biz.ExecutionRecord. Messages are
+// kept in their JSON form.
+type executionFile struct {
+	ID              string                     `json:"execution_id"`
+	RequestHash     string                     `json:"request_hash,omitempty"`
+	DefinitionID    string                     `json:"workflow_definition_id"`
+	Definition      json.RawMessage            `json:"definition"`
+	Input           map[string]json.RawMessage `json:"input_parameters,omitempty"`
+	Options         json.RawMessage            `json:"options,omitempty"`
+	CallbackURL     string                     `json:"callback_url,omitempty"`
+	Priority        int32                      `json:"priority,omitempty"`
+	Status          string                     `json:"status"`
+	Outputs         map[string]json.RawMessage `json:"output_results,omitempty"`
+	Trace           json.RawMessage            `json:"execution_trace,omitempty"`
+	Checkpoints     map[string]checkpointFile  `json:"checkpoints,omitempty"`
+	Logs            []json.RawMessage          `json:"logs,omitempty"`
+	CancelReason    string                     `json:"cancel_reason,omitempty"`
+	CallbackPending bool                       `json:"callback_pending,omitempty"`
+	ExecutorID      string                     `json:"executor_id,omitempty"`
+	CreatedAt       time.Time                  `json:"created_at"`
+	StartedAt       time.Time                  `json:"started_at"`
+	CompletedAt     time.Time                  `json:"completed_at"`
+}
+
+// checkpointFile is the stored form of a biz.StepCheckpoint
+type checkpointFile struct {
+	Output map[string]json.RawMessage `json:"output,omitempty"`
+	Trace  json.RawMessage            `json:"trace"`
+}
+
+// executionRepo implements biz.ExecutionRepo on a directory with one JSON
+// file per execution, rewritten atomically on every save. Without a
+// directory the encoded executions are kept in memory. Statistics of the
+// finished executions are indexed at startup and kept up to date on save.
+type executionRepo struct {
+	dir    string
+	mu     sync.Mutex
+	memory map[string][]byte
+	// 按定义和版本汇总的已结束执行，counted 记录已计入的执行
+	stats   map[string]map[string]*biz.ExecutionStats
+	counted map[string]bool
+	log     *log.Helper
+}
+
+// NewExecutionRepo creates the execution store in the configured directory
+func NewExecutionRepo(c *conf.Data, logger log.Logger) (biz.ExecutionRepo, error) {
+	r := &executionRepo{
+		dir:     c.GetWorkflow().GetExecutionDir(),
+		memory:  make(map[string][]byte),
+		stats:   make(map[string]map[string]*biz.ExecutionStats),
+		counted: make(map[string]bool),
+		log:     log.NewHelper(logger),
+	}
+	if r.dir == "" {
+		r.log.Warn("execution dir not configured, executions do not survive a restart")
+		return r, nil
+	}
+	if err := os.MkdirAll(r.dir, 0o755); err != nil {
+		return nil, err
+	}
+	recs, err := r.decodeAll()
+	if err != nil {
+		return nil, err
+	}
+	for _, rec := range recs {
+		r.count(rec)
+	}
+	return r, nil
+}
+
+// SaveExecution encodes rec and writes it
+func (r *executionRepo) SaveExecution(ctx context.Context, rec *biz.ExecutionRecord) error {
+	raw, err := encodeExecution(rec)
+	if err != nil {
+		return fmt.Errorf("encode execution %s: %w", rec.ID, err)
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.dir == "" {
+		r.memory[rec.ID] = raw
+	} else if err := writeFileAtomic(filepath.Join(r.dir, rec.ID+executionFileExt), raw); err != nil {
+		return err
+	}
+	r.count(rec)
+	return nil
+}
+
+// count adds a finished execution to the statistics of its definition
+// version, once. The caller holds r.mu or has r to itself.
+func (r *executionRepo) count(rec *biz.ExecutionRecord) {
+	if !finishedStatus(rec.Status) || r.counted[rec.ID] {
+		return
+	}
+	r.counted[rec.ID] = true
+	versions := r.stats[rec.DefinitionID]
+	if versions == nil {
+		versions = make(map[string]*biz.ExecutionStats)
+		r.stats[rec.DefinitionID] = versions
+	}
+	version := rec.Definition.GetVersion()
+	st := versions[version]
+	if st == nil {
+		st = new(biz.ExecutionStats)
+		versions[version] = st
+	}
+	st.Executions++
+	if rec.Status == commonv1.ProcessingStatus_PROCESSING_STATUS_COMPLETED {
+		st.Succeeded++
+	}
+	if ms := rec.Trace.GetSummary().GetTotalExecutionTimeMs(); ms > 0 {
+		st.TotalTime += time.Duration(ms) * time.Millisecond
+	} else if !rec.StartedAt.IsZero() && rec.CompletedAt.After(rec.StartedAt) {
+		st.TotalTime += rec.CompletedAt.Sub(rec.StartedAt)
+	}
+}
+
+// ExecutionStats returns a copy of the statistics of a definition
+func (r *executionRepo) ExecutionStats(ctx context.Context, definitionID string) (map[string]biz.ExecutionStats, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	stats := make(map[string]biz.ExecutionStats, len(r.stats[definitionID]))
+	for version, st := range r.stats[definitionID] {
+		stats[version] = *st
+	}
+	return stats, nil
+}
+
+// GetExecution reads and decodes an execution
+func (r *executionRepo) GetExecution(ctx context.Context, id string) (*biz.ExecutionRecord, error) {
+	if !validFileID(id) {
+		return nil, biz.ErrExecutionNotFound
+	}
+	r.mu.Lock()
+	raw, err := r.read(id)
+	r.mu.Unlock()
+	if err != nil {
+		return nil, err
+	}
+	return decodeExecution(raw)
+}
+
+func (r *executionRepo) read(id string) ([]byte, error) {
+	if r.dir == "" {
+		raw, ok := r.memory[id]
+		if !ok {
+			return nil, biz.ErrExecutionNotFound
+		}
+		return raw, nil
+	}
+	raw, err := os.ReadFile(filepath.Join(r.dir, id+executionFileExt))
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil, biz.ErrExecutionNotFound
+	}
+	return raw, err
+}
+
+// ListPendingExecutions decodes every stored execution that is unfinished
+// or still owes its callback, oldest first. Files that cannot be decoded
+// are logged and left alone.
+func (r *executionRepo) ListPendingExecutions(ctx context.Context) ([]*biz.ExecutionRecord, error) {
+	r.mu.Lock()
+	recs, err := r.decodeAll()
+	r.mu.Unlock()
+	if err != nil {
+		return nil, err
+	}
+	var pending []*biz.ExecutionRecord
+	for _, rec := range recs {
+		if rec.CallbackPending || !finishedStatus(rec.Status) {
+			pending = append(pending, rec)
+		}
+	}
+	sort.Slice(pending, func(i, j int) bool {
+		return pending[i].CreatedAt.Before(pending[j].CreatedAt)
+	})
+	return pending, nil
+}
+
+// decodeAll decodes every stored execution, logging and skipping the ones
+// that cannot be decoded. The caller holds r.mu or has r to itself.
+func (r *executionRepo) decodeAll() ([]*biz.ExecutionRecord, error) {
+	var ids []string
+	if r.dir == "" {
+		for id := range r.memory {
+			ids = append(ids, id)
+		}
+	} else {
+		entries, err := os.ReadDir(r.dir)
+		if err != nil {
+			return nil, err
+		}
+		for _, entry := range entries {
+			if !entry.IsDir() && filepath.Ext(entry.Name()) == executionFileExt {
+				ids = append(ids, strings.TrimSuffix(entry.Name(), executionFileExt))
+			}
+		}
+	}
+	recs := make([]*biz.ExecutionRecord, 0, len(ids))
+	for _, id := range ids {
+		raw, err := r.read(id)
+		if err != nil {
+			return nil, err
+		}
+		rec, err := decodeExecution(raw)
+		if err != nil {
+			r.log.Errorf("skipping unreadable execution %s: %v", id, err)
+			continue
+		}
+		recs = append(recs, rec)
+	}
+	return recs, nil
+}
+
+func finishedStatus(status commonv1.ProcessingStatus) bool {
+	switch status {
+	case commonv1.ProcessingStatus_PROCESSING_STATUS_COMPLETED,
+		commonv1.ProcessingStatus_PROCESSING_STATUS_FAILED,
+		commonv1.ProcessingStatus_PROCESSING_STATUS_CANCELLED:
+		return true
+	}
+	return false
+}
+
+// validFileID reports whether id can name a file in the store directory
+func validFileID(id string) bool {
+	return id != "" && id != "." && id != ".." && !strings.ContainsAny(id, `/\`)
+}
+
+func encodeExecution(rec *biz.ExecutionRecord) ([]byte, error) {
+	f := executionFile{
+		ID:              rec.ID,
+		RequestHash:     rec.RequestHash,
+		DefinitionID:    rec.DefinitionID,
+		CallbackURL:     rec.CallbackURL,
+		Priority:        rec.Priority,
+		Status:          rec.Status.String(),
+		CancelReason:    rec.CancelReason,
+		CallbackPending: rec.CallbackPending,
+		ExecutorID:      rec.ExecutorID,
+		CreatedAt:       rec.CreatedAt,
+		StartedAt:       rec.StartedAt,
+		CompletedAt:     rec.CompletedAt,
+	}
+	var err error
+	if f.Definition, err = marshalMessage(rec.Definition); err != nil {
+		return nil, err
+	}
+	if f.Options, err = marshalMessage(rec.Options); err != nil {
+		return nil, err
+	}
+	if f.Trace, err = marshalMessage(rec.Trace); err != nil {
+		return nil, err
+	}
+	if f.Input, err = marshalAnyMap(rec.Input); err != nil {
+		return nil, err
+	}
+	if f.Outputs, err = marshalAnyMap(rec.Outputs); err != nil {
+		return nil, err
+	}
+	if len(rec.Checkpoints) > 0 {
+		f.Checkpoints = make(map[string]checkpointFile, len(rec.Checkpoints))
+		for id, cp := range rec.Checkpoints {
+			var cf checkpointFile
+			if cf.Output, err = marshalAnyMap(cp.Output); err != nil {
+				return nil, err
+			}
+			if cf.Trace, err = marshalMessage(cp.Trace); err != nil {
+				return nil, err
+			}
+			f.Checkpoints[id] = cf
+		}
+	}
+	for _, l := range rec.Logs {
+		raw, err := marshalMessage(l)
+		if err != nil {
+			return nil, err
+		}
+		f.Logs = append(f.Logs, raw)
+	}
+	return json.MarshalIndent(f, "", "  ")
+}
+
+func decodeExecution(raw []byte) (*biz.ExecutionRecord, error) {
+	var f executionFile
+	if err := json.Unmarshal(raw, &f); err != nil {
+		return nil, err
+	}
+	status, ok := commonv1.ProcessingStatus_value[f.Status]
+	if !ok {
+		return nil, fmt.Errorf("unknown status %q", f.Status)
+	}
+	rec := &biz.ExecutionRecord{
+		ID:              f.ID,
+		RequestHash:     f.RequestHash,
+		DefinitionID:    f.DefinitionID,
+		Definition:      new(v1.WorkflowDefinition),
+		CallbackURL:     f.CallbackURL,
+		Priority:        f.Priority,
+		Status:          commonv1.ProcessingStatus(status),
+		CancelReason:    f.CancelReason,
+		CallbackPending: f.CallbackPending,
+		ExecutorID:      f.ExecutorID,
+		CreatedAt:       f.CreatedAt,
+		StartedAt:       f.StartedAt,
+		CompletedAt:     f.CompletedAt,
+	}
+	if err := unmarshalMessage(f.Definition, rec.Definition); err != nil {
+		return nil, err
+	}
+	if f.Options != nil {
+		rec.Options = new(v1.ExecutionOptions)
+		if err := unmarshalMessage(f.Options, rec.Options); err != nil {
+			return nil, err
+		}
+	}
+	if f.Trace != nil {
+		rec.Trace = new(v1.WorkflowExecutionTrace)
+		if err := unmarshalMessage(f.Trace, rec.Trace); err != nil {
+			return nil, err
+		}
+	}
+	var err error
+	if rec.Input, err = unmarshalAnyMap(f.Input); err != nil {
+		return nil, err
+	}
+	if rec.Outputs, err = unmarshalAnyMap(f.Outputs); err != nil {
+		return nil, err
+	}
+	if !finishedStatus(rec.Status) {
+		rec.Checkpoints = make(map[string]*biz.StepCheckpoint, len(f.Checkpoints))
+	}
+	for id, cf := range f.Checkpoints {
+		cp := &biz.StepCheckpoint{Trace: new(v1.StepExecutionTrace)}
+		if cp.Output, err = unmarshalAnyMap(cf.Output); err != nil {
+			return nil, err
+		}
+		if err := unmarshalMessage(cf.Trace, cp.Trace); err != nil {
+			return nil, err
+		}
+		rec.Checkpoints[id] = cp
+	}
+	for _, raw := range f.Logs {
+		l := new(v1.WorkflowLog)
+		if err := unmarshalMessage(raw, l); err != nil {
+			return nil, err
+		}
+		rec.Logs = append(rec.Logs, l)
+	}
+	return rec, nil
+}
+
+// marshalMessage encodes an API message as JSON; nil messages stay empty
+func marshalMessage(m proto.Message) (json.RawMessage, error) {
+	if m == nil || !proto.MessageReflect(m).IsValid() {
+		return nil, nil
+	}
+	return protojson.Marshal(proto.MessageV2(m))
+}
+
+func unmarshalMessage(raw json.RawMessage, m proto.Message) error {
+	if len(raw) == 0 {
+		return nil
+	}
+	return protojson.Unmarshal(raw, proto.MessageV2(m))
+}
+
+func marshalAnyMap(values map[string]*anypb.Any) (map[string]json.RawMessage, error) {
+	if len(values) == 0 {
+		return nil, nil
+	}
+	out := make(map[string]json.RawMessage, len(values))
+	for k, v := range values {
+		raw, err := protojson.Marshal(v)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", k, err)
+		}
+		out[k] = raw
+	}
+	return out, nil
+}
+
+func unmarshalAnyMap(raws map[string]json.RawMessage) (map[string]*anypb.Any, error) {
+	if len(raws) == 0 {
+		return nil, nil
+	}
+	out := make(map[string]*anypb.Any, len(raws))
+	for k, raw := range raws {
+		v := new(anypb.Any)
+		if err := protojson.Unmarshal(raw, v); err != nil {
+			return nil, fmt.Errorf("%s: %w", k, err)
+		}
+		out[k] = v
+	}
+	return out, nil
+}
+
+func writeFileAtomic(path string, data []byte) error {
+	tmp, err := os.CreateTemp(filepath.Dir(path), filepath.Base(path)+".*")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+	if _, err := tmp.Write(data); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+	return os.Rename(tmp.Name(), path)
+}
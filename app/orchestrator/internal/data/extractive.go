@@ -0,0 +1,210 @@
+package data
+
+import (
+	"context"
+	"sort"
+	"strings"
+	"unicode"
+
+	"rag/app/orchestrator/internal/biz"
+	"rag/pkg/tokenizer"
+)
+
+// 句子的结束符
+const sentenceEnds = ".!?。！？；;\n"
+
+// extractiveGenerator implements biz.Generator without a model: the answer
+// is made of the context sentences that share the most terms with the
+// query. The same input always gives the same answer, which suits offline
+// deployments and tests.
+type extractiveGenerator struct {
+	info biz.GeneratorInfo
+	tok  tokenizer.Tokenizer
+}
+
+func (g *extractiveGenerator) Info() biz.GeneratorInfo {
+	return g.info
+}
+
+// sentence is a candidate sentence and where it was found
+type sentence struct {
+	text  string
+	order int
+	score int
+}
+
+// Generate picks the best matching sentences of the passages, or of the
+// prompt when there are none, within the token limit and keeps them in the
+// order they appear
+func (g *extractiveGenerator) Generate(ctx context.Context, req *biz.GenerationRequest) (*biz.Generation, error) {
+	parts, gen := g.extract(req)
+	gen.Text = strings.Join(parts, " ")
+	return gen, nil
+}
+
+// GenerateStream emits the answer of Generate one sentence at a time
+func (g *extractiveGenerator) GenerateStream(ctx context.Context, req *biz.GenerationRequest, emit func(delta string) error) (*biz.Generation, error) {
+	parts, gen := g.extract(req)
+	for i, part := range parts {
+		if i > 0 {
+			part = " " + part
+		}
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		if err := emit(part); err != nil {
+			return nil, err
+		}
+	}
+	gen.Text = strings.Join(parts, " ")
+	return gen, nil
+}
+
+// extract returns the chosen sentences and the generation without its text
+func (g *extractiveGenerator) extract(req *biz.GenerationRequest) ([]string, *biz.Generation) {
+	sources := req.Passages
+	if len(sources) == 0 {
+		sources = []string{req.Prompt}
+	}
+	query := queryTerms(req.Query)
+	var candidates []*sentence
+	seen := make(map[string]bool)
+	for _, source := range sources {
+		for _, text := range splitSentences(source) {
+			// 提示词中的问题本身不作为答案
+			if seen[text] || text == strings.TrimSpace(req.Query) {
+				continue
+			}
+			seen[text] = true
+			s := &sentence{text: text, order: len(candidates)}
+			for term := range queryTerms(text) {
+				if query[term] {
+					s.score++
+				}
+			}
+			candidates = append(candidates, s)
+		}
+	}
+
+	ranked := make([]*sentence, 0, len(candidates))
+	for _, s := range candidates {
+		if s.score > 0 {
+			ranked = append(ranked, s)
+		}
+	}
+	if len(ranked) == 0 {
+		// 没有匹配的句子时取开头的句子
+		ranked = candidates
+	}
+	sort.SliceStable(ranked, func(i, j int) bool {
+		return ranked[i].score > ranked[j].score
+	})
+
+	finish := biz.FinishStop
+	var chosen []*sentence
+	used := 0
+	for _, s := range ranked {
+		n := biz.CountTokens(g.tok, s.text)
+		if used+n > req.MaxTokens {
+			finish = biz.FinishLength
+			if len(chosen) == 0 {
+				// 一句都放不下时截取最相关句子的开头
+				s.text = truncateTokens(g.tok, s.text, req.MaxTokens)
+				chosen = append(chosen, s)
+				used = biz.CountTokens(g.tok, s.text)
+			}
+			break
+		}
+		chosen = append(chosen, s)
+		used += n
+	}
+	sort.Slice(chosen, func(i, j int) bool {
+		return chosen[i].order < chosen[j].order
+	})
+	parts := make([]string, len(chosen))
+	for i, s := range chosen {
+		parts[i] = s.text
+	}
+	return parts, &biz.Generation{
+		Model:            g.info.Model,
+		FinishReason:     finish,
+		CompletionTokens: used,
+	}
+}
+
+// splitSentences splits text after every sentence end, dropping empty
+// sentences
+func splitSentences(text string) []string {
+	var out []string
+	start := 0
+	for i, r := range text {
+		if !strings.ContainsRune(sentenceEnds, r) {
+			continue
+		}
+		end := i + len(string(r))
+		if s := strings.TrimSpace(text[start:end]); s != "" && !isPunctuation(s) {
+			out = append(out, s)
+		}
+		start = end
+	}
+	if s := strings.TrimSpace(text[start:]); s != "" && !isPunctuation(s) {
+		out = append(out, s)
+	}
+	return out
+}
+
+// truncateTokens keeps the leading words of text that fit in limit tokens
+func truncateTokens(tok tokenizer.Tokenizer, text string, limit int) string {
+	var out string
+	for _, word := range strings.Fields(text) {
+		next := strings.TrimSpace(out + " " + word)
+		if biz.CountTokens(tok, next) > limit {
+			break
+		}
+		out = next
+	}
+	return out
+}
+
+func isPunctuation(s string) bool {
+	for _, r := range s {
+		if unicode.IsLetter(r) || unicode.IsDigit(r) {
+			return false
+		}
+	}
+	return true
+}
+
+// queryTerms returns the lower-cased words of text and the bigrams of its
+// CJK runs, which have no spaces between words
+func queryTerms(text string) map[string]bool {
+	terms := make(map[string]bool)
+	var word []rune
+	var prev rune
+	for _, r := range strings.ToLower(text) {
+		cjk := unicode.Is(unicode.Han, r) || unicode.Is(unicode.Hiragana, r) ||
+			unicode.Is(unicode.Katakana, r) || unicode.Is(unicode.Hangul, r)
+		switch {
+		case cjk:
+			if prev != 0 {
+				terms[string([]rune{prev, r})] = true
+			}
+			prev = r
+		case unicode.IsLetter(r) || unicode.IsDigit(r):
+			word = append(word, r)
+			prev = 0
+		default:
+			prev = 0
+		}
+		if !(unicode.IsLetter(r) || unicode.IsDigit(r)) || cjk {
+			if len(word) > 1 {
+				terms[string(word)] = true
+			}
+			word = word[:0]
+		}
+	}
+	if len(word) > 1 {
+		terms[string(word)] = true
+	}
+	return terms
+}
@@ -0,0 +1,399 @@
+package data
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	commonv1 "rag/api/common/v1"
+	"rag/app/orchestrator/internal/biz"
+	"rag/app/orchestrator/internal/conf"
+	"rag/pkg/tokenizer"
+
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+// Generation providers accepted in the generation.provider configuration.
+const (
+	ProviderExtractive = "extractive"
+	ProviderOpenAI     = "openai"
+	ProviderOllama     = "ollama"
+)
+
+const (
+	// 未配置时生成请求的超时
+	defaultGenerationTimeout = 60 * time.Second
+	// 错误响应最多读取的字节数
+	maxErrorBody = 4 << 10
+	// 流式响应单行的长度上限
+	maxStreamLine = 1 << 20
+)
+
+// NewGenerator creates the configured generator. Without a provider the
+// extractive generator answers from the context without a model, counting
+// tokens with tok.
+func NewGenerator(c *conf.Data, tok tokenizer.Tokenizer, logger log.Logger) (biz.Generator, error) {
+	g := c.GetGeneration()
+	info := biz.GeneratorInfo{
+		Provider:      g.GetProvider(),
+		Model:         g.GetModel(),
+		ContextWindow: int(g.GetContextWindow()),
+		MaxTokens:     int(g.GetMaxTokens()),
+		Temperature:   g.GetTemperature(),
+		Stop:          g.GetStop(),
+	}
+	timeout := defaultGenerationTimeout
+	if t := g.GetTimeout(); t != nil && t.AsDuration() > 0 {
+		timeout = t.AsDuration()
+	}
+	helper := log.NewHelper(logger)
+	switch info.Provider {
+	case "", ProviderExtractive:
+		info.Provider = ProviderExtractive
+		if info.Model == "" {
+			info.Model = ProviderExtractive
+		}
+		helper.Info("answers are generated by the extractive generator")
+		return &extractiveGenerator{info: info, tok: tok}, nil
+	case ProviderOpenAI, ProviderOllama:
+		if g.GetEndpoint() == "" || info.Model == "" {
+			return nil, fmt.Errorf("generation provider %s needs an endpoint and a model", info.Provider)
+		}
+		helper.Infof("answers are generated by %s %s at %s", info.Provider, info.Model, g.GetEndpoint())
+		info.Timeout = timeout
+		return &httpGenerator{
+			info:     info,
+			endpoint: strings.TrimRight(g.GetEndpoint(), "/"),
+			apiKey:   g.GetApiKey(),
+			timeout:  timeout,
+			client:   &http.Client{},
+		}, nil
+	}
+	return nil, fmt.Errorf("unknown generation provider %q", info.Provider)
+}
+
+// httpGenerator implements biz.Generator on the chat completions API of
+// OpenAI-compatible servers or the generate API of Ollama. Each generation
+// runs under a context deadline rather than a client timeout, so that a
+// stream cut short by it reports the deadline like any other step.
+type httpGenerator struct {
+	info     biz.GeneratorInfo
+	endpoint string
+	apiKey   string
+	timeout  time.Duration
+	client   *http.Client
+}
+
+func (g *httpGenerator) Info() biz.GeneratorInfo {
+	return g.info
+}
+
+// Generate sends the prompt to the provider
+func (g *httpGenerator) Generate(ctx context.Context, req *biz.GenerationRequest) (*biz.Generation, error) {
+	ctx, cancel := context.WithTimeout(ctx, g.timeout)
+	defer cancel()
+	if g.info.Provider == ProviderOllama {
+		return g.ollama(ctx, req, nil)
+	}
+	return g.openAI(ctx, req, nil)
+}
+
+// GenerateStream sends the prompt to the provider and emits the answer as
+// the provider streams it
+func (g *httpGenerator) GenerateStream(ctx context.Context, req *biz.GenerationRequest, emit func(delta string) error) (*biz.Generation, error) {
+	ctx, cancel := context.WithTimeout(ctx, g.timeout)
+	defer cancel()
+	if g.info.Provider == ProviderOllama {
+		return g.ollama(ctx, req, emit)
+	}
+	return g.openAI(ctx, req, emit)
+}
+
+type chatMessage struct {
+	Role    string `json:"role"`
+	Content string `json:"content"`
+}
+
+type chatRequest struct {
+	Model         string             `json:"model"`
+	Messages      []chatMessage      `json:"messages"`
+	MaxTokens     int                `json:"max_tokens,omitempty"`
+	Temperature   float32            `json:"temperature"`
+	Stop          []string           `json:"stop,omitempty"`
+	Stream        bool               `json:"stream,omitempty"`
+	StreamOptions *chatStreamOptions `json:"stream_options,omitempty"`
+}
+
+type chatStreamOptions struct {
+	IncludeUsage bool `json:"include_usage"`
+}
+
+type chatResponse struct {
+	Model   string `json:"model"`
+	Choices []struct {
+		Message      chatMessage `json:"message"`
+		Delta        chatMessage `json:"delta"`
+		FinishReason string      `json:"finish_reason"`
+	} `json:"choices"`
+	Usage struct {
+		PromptTokens     int `json:"prompt_tokens"`
+		CompletionTokens int `json:"completion_tokens"`
+	} `json:"usage"`
+}
+
+// openAI calls the chat completions API. With emit the answer is streamed
+// as server-sent events, the last of which carries the token usage; a
+// stream that ends without [DONE] was interrupted.
+func (g *httpGenerator) openAI(ctx context.Context, req *biz.GenerationRequest, emit func(delta string) error) (*biz.Generation, error) {
+	body := chatRequest{
+		Model:       req.Model,
+		MaxTokens:   req.MaxTokens,
+		Temperature: req.Temperature,
+		// OpenAI 最多接受 4 个停止序列，其余由 biz 截断
+		Stop: req.Stop[:min(len(req.Stop), 4)],
+	}
+	if req.System != "" {
+		body.Messages = append(body.Messages, chatMessage{Role: "system", Content: req.System})
+	}
+	body.Messages = append(body.Messages, chatMessage{Role: "user", Content: req.Prompt})
+	if emit != nil {
+		body.Stream = true
+		body.StreamOptions = &chatStreamOptions{IncludeUsage: true}
+		return g.openAIStream(ctx, body, emit)
+	}
+	var resp chatResponse
+	if err := g.post(ctx, "/chat/completions", body, &resp); err != nil {
+		return nil, err
+	}
+	if len(resp.Choices) == 0 {
+		return nil, errors.New(http.StatusBadGateway, commonv1.ErrorCode_ERROR_CODE_MODEL_NOT_AVAILABLE.String(), "model returned no choices")
+	}
+	return &biz.Generation{
+		Text:             resp.Choices[0].Message.Content,
+		Model:            resp.Model,
+		FinishReason:     resp.Choices[0].FinishReason,
+		PromptTokens:     resp.Usage.PromptTokens,
+		CompletionTokens: resp.Usage.CompletionTokens,
+	}, nil
+}
+
+func (g *httpGenerator) openAIStream(ctx context.Context, body chatRequest, emit func(delta string) error) (*biz.Generation, error) {
+	stream, err := g.send(ctx, "/chat/completions", body)
+	if err != nil {
+		return nil, err
+	}
+	defer stream.Close()
+
+	var (
+		gen  biz.Generation
+		text strings.Builder
+		done bool
+	)
+	scanner := bufio.NewScanner(stream)
+	scanner.Buffer(make([]byte, 0, 64<<10), maxStreamLine)
+	for scanner.Scan() {
+		data, ok := strings.CutPrefix(scanner.Text(), "data:")
+		if !ok {
+			continue
+		}
+		data = strings.TrimSpace(data)
+		if data == "[DONE]" {
+			done = true
+			break
+		}
+		var chunk chatResponse
+		if err := json.Unmarshal([]byte(data), &chunk); err != nil {
+			return nil, g.unreadable(err)
+		}
+		if chunk.Model != "" {
+			gen.Model = chunk.Model
+		}
+		if chunk.Usage.CompletionTokens > 0 {
+			gen.PromptTokens = chunk.Usage.PromptTokens
+			gen.CompletionTokens = chunk.Usage.CompletionTokens
+		}
+		for _, choice := range chunk.Choices {
+			if choice.FinishReason != "" {
+				gen.FinishReason = choice.FinishReason
+			}
+			if choice.Delta.Content == "" {
+				continue
+			}
+			text.WriteString(choice.Delta.Content)
+			if err := emit(choice.Delta.Content); err != nil {
+				return nil, err
+			}
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, g.interrupted(ctx, err)
+	}
+	if !done {
+		// 连接在 [DONE] 之前关闭
+		return nil, g.interrupted(ctx, io.ErrUnexpectedEOF)
+	}
+	gen.Text = text.String()
+	return &gen, nil
+}
+
+type ollamaRequest struct {
+	Model   string        `json:"model"`
+	System  string        `json:"system,omitempty"`
+	Prompt  string        `json:"prompt"`
+	Stream  bool          `json:"stream"`
+	Options ollamaOptions `json:"options"`
+}
+
+type ollamaOptions struct {
+	NumPredict  int      `json:"num_predict,omitempty"`
+	NumCtx      int      `json:"num_ctx,omitempty"`
+	Temperature float32  `json:"temperature"`
+	Stop        []string `json:"stop,omitempty"`
+}
+
+type ollamaResponse struct {
+	Model           string `json:"model"`
+	Response        string `json:"response"`
+	DoneReason      string `json:"done_reason"`
+	PromptEvalCount int    `json:"prompt_eval_count"`
+	EvalCount       int    `json:"eval_count"`
+	Done            bool   `json:"done"`
+}
+
+// ollama calls the generate API. With emit the answer is streamed as one
+// JSON object per line, the last of which has done set.
+func (g *httpGenerator) ollama(ctx context.Context, req *biz.GenerationRequest, emit func(delta string) error) (*biz.Generation, error) {
+	body := ollamaRequest{
+		Model:  req.Model,
+		System: req.System,
+		Prompt: req.Prompt,
+		Stream: emit != nil,
+		Options: ollamaOptions{
+			NumPredict:  req.MaxTokens,
+			NumCtx:      g.info.ContextWindow,
+			Temperature: req.Temperature,
+			Stop:        req.Stop,
+		},
+	}
+	if emit != nil {
+		return g.ollamaStream(ctx, body, emit)
+	}
+	var resp ollamaResponse
+	if err := g.post(ctx, "/api/generate", body, &resp); err != nil {
+		return nil, err
+	}
+	return &biz.Generation{
+		Text:             resp.Response,
+		Model:            resp.Model,
+		FinishReason:     resp.DoneReason,
+		PromptTokens:     resp.PromptEvalCount,
+		CompletionTokens: resp.EvalCount,
+	}, nil
+}
+
+func (g *httpGenerator) ollamaStream(ctx context.Context, body ollamaRequest, emit func(delta string) error) (*biz.Generation, error) {
+	stream, err := g.send(ctx, "/api/generate", body)
+	if err != nil {
+		return nil, err
+	}
+	defer stream.Close()
+
+	var (
+		gen  biz.Generation
+		text strings.Builder
+	)
+	dec := json.NewDecoder(stream)
+	for {
+		var chunk ollamaResponse
+		if err := dec.Decode(&chunk); err != nil {
+			if err == io.EOF {
+				// 连接在 done 之前关闭
+				return nil, g.interrupted(ctx, io.ErrUnexpectedEOF)
+			}
+			if ctx.Err() != nil {
+				return nil, ctx.Err()
+			}
+			return nil, g.unreadable(err)
+		}
+		if chunk.Response != "" {
+			text.WriteString(chunk.Response)
+			if err := emit(chunk.Response); err != nil {
+				return nil, err
+			}
+		}
+		if chunk.Done {
+			gen.Model = chunk.Model
+			gen.FinishReason = chunk.DoneReason
+			gen.PromptTokens = chunk.PromptEvalCount
+			gen.CompletionTokens = chunk.EvalCount
+			break
+		}
+	}
+	gen.Text = text.String()
+	return &gen, nil
+}
+
+// post sends body as JSON and decodes the answer into out
+func (g *httpGenerator) post(ctx context.Context, path string, body, out any) error {
+	resp, err := g.send(ctx, path, body)
+	if err != nil {
+		return err
+	}
+	defer resp.Close()
+	if err := json.NewDecoder(resp).Decode(out); err != nil {
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+		return g.unreadable(err)
+	}
+	return nil
+}
+
+// send posts body as JSON and returns the response body. Error statuses
+// keep their code so that rate limits and server errors are retried.
+func (g *httpGenerator) send(ctx context.Context, path string, body any) (io.ReadCloser, error) {
+	raw, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, g.endpoint+path, bytes.NewReader(raw))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	if g.apiKey != "" {
+		req.Header.Set("Authorization", "Bearer "+g.apiKey)
+	}
+	resp, err := g.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		defer resp.Body.Close()
+		msg, _ := io.ReadAll(io.LimitReader(resp.Body, maxErrorBody))
+		return nil, errors.New(resp.StatusCode, commonv1.ErrorCode_ERROR_CODE_MODEL_NOT_AVAILABLE.String(),
+			fmt.Sprintf("%s answered %s: %s", g.info.Provider, resp.Status, strings.TrimSpace(string(msg))))
+	}
+	return resp.Body, nil
+}
+
+func (g *httpGenerator) unreadable(err error) error {
+	return errors.New(http.StatusBadGateway, commonv1.ErrorCode_ERROR_CODE_MODEL_NOT_AVAILABLE.String(),
+		fmt.Sprintf("unreadable %s response: %v", g.info.Provider, err))
+}
+
+// interrupted reports a stream that broke off, unless the caller cancelled it
+func (g *httpGenerator) interrupted(ctx context.Context, err error) error {
+	if ctx.Err() != nil {
+		return ctx.Err()
+	}
+	return errors.New(http.StatusBadGateway, commonv1.ErrorCode_ERROR_CODE_MODEL_NOT_AVAILABLE.String(),
+		fmt.Sprintf("%s stream interrupted: %v", g.info.Provider, err))
+}
@@ -0,0 +1,101 @@
+package data
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"rag/app/orchestrator/internal/biz"
+
+	"github.com/go-kratos/kratos/v2/errors"
+)
+
+// sseServer streams the lines as server-sent events, then waits for hold
+// before closing the stream
+func sseServer(lines []string, hold time.Duration) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "text/event-stream")
+		for _, line := range lines {
+			fmt.Fprintf(w, "data: %s\n\n", line)
+			w.(http.Flusher).Flush()
+		}
+		select {
+		case <-time.After(hold):
+		case <-r.Context().Done():
+		}
+	}))
+}
+
+func testOpenAIGenerator(endpoint string, timeout time.Duration) *httpGenerator {
+	return &httpGenerator{
+		info:     biz.GeneratorInfo{Provider: ProviderOpenAI, Model: "m"},
+		endpoint: endpoint,
+		timeout:  timeout,
+		client:   &http.Client{},
+	}
+}
+
+func collect(g *httpGenerator) ([]string, *biz.Generation, error) {
+	var deltas []string
+	gen, err := g.GenerateStream(context.Background(), &biz.GenerationRequest{Model: "m", Prompt: "q"}, func(delta string) error {
+		deltas = append(deltas, delta)
+		return nil
+	})
+	return deltas, gen, err
+}
+
+func TestGenerateStreamDeadline(t *testing.T) {
+	srv := sseServer([]string{`{"choices":[{"delta":{"content":"Hello"}}]}`}, 300*time.Millisecond)
+	defer srv.Close()
+
+	deltas, _, err := collect(testOpenAIGenerator(srv.URL, 50*time.Millisecond))
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("err = %v, want the deadline", err)
+	}
+	if len(deltas) != 1 || deltas[0] != "Hello" {
+		t.Errorf("deltas = %q", deltas)
+	}
+}
+
+func TestOpenAIStreamRequiresDone(t *testing.T) {
+	chunks := []string{
+		`{"model":"m-1","choices":[{"delta":{"content":"Hel"}}]}`,
+		`{"choices":[{"delta":{"content":"lo"},"finish_reason":"stop"}]}`,
+		`{"choices":[],"usage":{"prompt_tokens":3,"completion_tokens":2}}`,
+	}
+	srv := sseServer(append(chunks, "[DONE]"), 0)
+	defer srv.Close()
+	deltas, gen, err := collect(testOpenAIGenerator(srv.URL, time.Second))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(deltas) != 2 || gen.Text != "Hello" || gen.Model != "m-1" || gen.FinishReason != "stop" ||
+		gen.PromptTokens != 3 || gen.CompletionTokens != 2 {
+		t.Errorf("deltas %q, generation %+v", deltas, gen)
+	}
+
+	// 没有 [DONE] 的流视为中断
+	srv = sseServer(chunks[:1], 0)
+	defer srv.Close()
+	_, _, err = collect(testOpenAIGenerator(srv.URL, time.Second))
+	if e := errors.FromError(err); e.Code != http.StatusBadGateway || !strings.Contains(e.Message, "interrupted") {
+		t.Errorf("err = %v, want an interrupted stream", err)
+	}
+}
+
+func TestOllamaStreamRequiresDone(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprintln(w, `{"model":"m","response":"Hel"}`)
+	}))
+	defer srv.Close()
+	g := testOpenAIGenerator(srv.URL, time.Second)
+	g.info.Provider = ProviderOllama
+	_, _, err := collect(g)
+	if e := errors.FromError(err); e.Code != http.StatusBadGateway || !strings.Contains(e.Message, "interrupted") {
+		t.Errorf("err = %v, want an interrupted stream", err)
+	}
+}
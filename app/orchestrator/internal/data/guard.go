@@ -0,0 +1,247 @@
+package data
+
+import (
+	"context"
+	"net/http"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"rag/app/orchestrator/internal/biz"
+	"rag/app/orchestrator/internal/conf"
+
+	"github.com/go-kratos/kratos/v2/errors"
+)
+
+const (
+	// 熔断器和隔离舱未配置时的默认值
+	defaultErrorRatio       = 0.5
+	defaultBreakerWindow    = 30 * time.Second
+	defaultMinRequests      = 10
+	defaultOpenTimeout      = 15 * time.Second
+	defaultHalfOpenRequests = 3
+	defaultMaxConcurrency   = 32
+	// 滑动窗口的分桶数
+	breakerBuckets = 10
+)
+
+// breakerConfig is the resolved configuration of a circuit breaker
+type breakerConfig struct {
+	errorRatio float64
+	window     time.Duration
+	minCalls   int
+	openFor    time.Duration
+	probes     int
+}
+
+func newBreakerConfig(c *conf.Data_CircuitBreaker) breakerConfig {
+	cfg := breakerConfig{
+		errorRatio: defaultErrorRatio,
+		window:     defaultBreakerWindow,
+		minCalls:   defaultMinRequests,
+		openFor:    defaultOpenTimeout,
+		probes:     defaultHalfOpenRequests,
+	}
+	if r := c.GetErrorRatio(); r > 0 && r <= 1 {
+		cfg.errorRatio = r
+	}
+	if d := c.GetWindow().AsDuration(); c.GetWindow() != nil && d > 0 {
+		cfg.window = d
+	}
+	if n := c.GetMinRequests(); n > 0 {
+		cfg.minCalls = int(n)
+	}
+	if d := c.GetOpenTimeout().AsDuration(); c.GetOpenTimeout() != nil && d > 0 {
+		cfg.openFor = d
+	}
+	if n := c.GetHalfOpenRequests(); n > 0 {
+		cfg.probes = int(n)
+	}
+	return cfg
+}
+
+// bucket counts the calls of one slice of the breaker window
+type bucket struct {
+	start    time.Time
+	calls    int
+	failures int
+}
+
+// circuitBreaker guards one method. Closed, it counts calls over a sliding
+// window and opens once enough of them failed. Open, it rejects calls until
+// the open timeout passes and then turns half-open, letting a few probes
+// through: as many successes in a row close it, a failure opens it again.
+type circuitBreaker struct {
+	cfg breakerConfig
+
+	mu       sync.Mutex
+	state    string
+	openedAt time.Time
+	buckets  [breakerBuckets]bucket
+	// 半开状态下进行中的试探请求数和连续成功数
+	probing   int
+	successes int
+}
+
+func newCircuitBreaker(cfg breakerConfig) *circuitBreaker {
+	return &circuitBreaker{cfg: cfg, state: biz.CircuitClosed}
+}
+
+// allow reports whether a call may go ahead and whether it is a half-open
+// probe. Calls that do not enforce the breaker always pass and are never
+// probes.
+func (b *circuitBreaker) allow(enforce bool) (ok, probe bool) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	switch b.current(time.Now()) {
+	case biz.CircuitOpen:
+		return !enforce, false
+	case biz.CircuitHalfOpen:
+		if !enforce {
+			return true, false
+		}
+		if b.probing >= b.cfg.probes {
+			return false, false
+		}
+		b.probing++
+		return true, true
+	}
+	return true, false
+}
+
+// record counts the outcome of a call admitted by allow
+func (b *circuitBreaker) record(probe bool, err error) {
+	if errors.Is(err, context.Canceled) {
+		// 调用方放弃的请求不反映服务状态
+		b.abandon(probe)
+		return
+	}
+	failed := err != nil && breakerFailure(err)
+	now := time.Now()
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	// 熔断器重新打开后旧的试探请求不再计数
+	if probe && b.probing > 0 {
+		b.probing--
+	}
+	switch b.current(now) {
+	case biz.CircuitHalfOpen:
+		if failed {
+			b.open(now)
+			return
+		}
+		if b.successes++; b.successes >= b.cfg.probes {
+			b.state = biz.CircuitClosed
+			b.buckets = [breakerBuckets]bucket{}
+		}
+	case biz.CircuitClosed:
+		bk := b.bucket(now)
+		bk.calls++
+		if failed {
+			bk.failures++
+		}
+		calls, failures := b.totals(now)
+		if calls >= b.cfg.minCalls && float64(failures) >= b.cfg.errorRatio*float64(calls) {
+			b.open(now)
+		}
+	}
+}
+
+// abandon releases a call admitted by allow that was never made
+func (b *circuitBreaker) abandon(probe bool) {
+	if !probe {
+		return
+	}
+	b.mu.Lock()
+	if b.probing > 0 {
+		b.probing--
+	}
+	b.mu.Unlock()
+}
+
+// current returns the state at now, turning an expired open breaker half-open
+func (b *circuitBreaker) current(now time.Time) string {
+	if b.state == biz.CircuitOpen && now.Sub(b.openedAt) >= b.cfg.openFor {
+		b.state = biz.CircuitHalfOpen
+		b.probing, b.successes = 0, 0
+	}
+	return b.state
+}
+
+func (b *circuitBreaker) open(now time.Time) {
+	b.state = biz.CircuitOpen
+	b.openedAt = now
+}
+
+// bucket returns the bucket of now, resetting it if it held an older slice
+func (b *circuitBreaker) bucket(now time.Time) *bucket {
+	width := max(b.cfg.window/breakerBuckets, time.Millisecond)
+	start := now.Truncate(width)
+	bk := &b.buckets[start.UnixNano()/int64(width)%breakerBuckets]
+	if !bk.start.Equal(start) {
+		*bk = bucket{start: start}
+	}
+	return bk
+}
+
+// totals sums the buckets still inside the window
+func (b *circuitBreaker) totals(now time.Time) (calls, failures int) {
+	for _, bk := range b.buckets {
+		if now.Sub(bk.start) < b.cfg.window {
+			calls += bk.calls
+			failures += bk.failures
+		}
+	}
+	return calls, failures
+}
+
+// snapshot returns the current state
+func (b *circuitBreaker) snapshot() string {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.current(time.Now())
+}
+
+// breakerFailure reports whether err says the service is in trouble, as
+// opposed to the request being wrong
+func breakerFailure(err error) bool {
+	if errors.Is(err, context.DeadlineExceeded) {
+		return true
+	}
+	code := errors.FromError(err).Code
+	return code == http.StatusTooManyRequests || code >= 500 && code != http.StatusNotImplemented
+}
+
+// bulkhead bounds the concurrent calls to a service. Calls over the limit
+// wait for a slot as long as their context allows.
+type bulkhead struct {
+	slots  chan struct{}
+	queued atomic.Int32
+}
+
+func newBulkhead(limit int32) *bulkhead {
+	if limit <= 0 {
+		limit = defaultMaxConcurrency
+	}
+	return &bulkhead{slots: make(chan struct{}, limit)}
+}
+
+func (h *bulkhead) acquire(ctx context.Context) error {
+	select {
+	case h.slots <- struct{}{}:
+		return nil
+	default:
+	}
+	h.queued.Add(1)
+	defer h.queued.Add(-1)
+	select {
+	case h.slots <- struct{}{}:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+func (h *bulkhead) release() {
+	<-h.slots
+}
@@ -0,0 +1,189 @@
+package data
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"rag/app/orchestrator/internal/biz"
+	"rag/app/orchestrator/internal/conf"
+
+	"github.com/go-kratos/kratos/v2/errors"
+	"google.golang.org/protobuf/types/known/durationpb"
+)
+
+var errUnavailable = errors.ServiceUnavailable("DOWN", "busy")
+
+func testBreaker() *circuitBreaker {
+	return newCircuitBreaker(breakerConfig{
+		errorRatio: 0.5,
+		window:     time.Minute,
+		minCalls:   4,
+		openFor:    20 * time.Millisecond,
+		probes:     2,
+	})
+}
+
+// call runs one enforced call through the breaker
+func call(b *circuitBreaker, err error) bool {
+	ok, probe := b.allow(true)
+	if ok {
+		b.record(probe, err)
+	}
+	return ok
+}
+
+func TestBreakerConfigDefaults(t *testing.T) {
+	cfg := newBreakerConfig(&conf.Data_CircuitBreaker{ErrorRatio: 2, Window: durationpb.New(-time.Second)})
+	want := breakerConfig{defaultErrorRatio, defaultBreakerWindow, defaultMinRequests, defaultOpenTimeout, defaultHalfOpenRequests}
+	if cfg != want {
+		t.Errorf("config = %+v, want defaults", cfg)
+	}
+	cfg = newBreakerConfig(&conf.Data_CircuitBreaker{ErrorRatio: 0.2, MinRequests: 3, OpenTimeout: durationpb.New(time.Second)})
+	if cfg.errorRatio != 0.2 || cfg.minCalls != 3 || cfg.openFor != time.Second {
+		t.Errorf("config = %+v", cfg)
+	}
+}
+
+func TestBreakerOpensOnErrorRatio(t *testing.T) {
+	b := testBreaker()
+	// 请求数不足时不熔断
+	for range 3 {
+		call(b, errUnavailable)
+	}
+	if s := b.snapshot(); s != biz.CircuitClosed {
+		t.Fatalf("state after 3 failures = %s", s)
+	}
+	call(b, nil)
+	if s := b.snapshot(); s != biz.CircuitOpen {
+		t.Fatalf("state at 3/4 failures = %s", s)
+	}
+	if call(b, nil) {
+		t.Error("open breaker admitted an enforced call")
+	}
+	if ok, probe := b.allow(false); !ok || probe {
+		t.Errorf("unenforced call = %t, %t", ok, probe)
+	}
+
+	// 请求本身的错误不计为失败
+	b = testBreaker()
+	for range 8 {
+		call(b, errors.BadRequest("BAD", "rejected input"))
+	}
+	call(b, errors.New(501, "NONE", "missing"))
+	if s := b.snapshot(); s != biz.CircuitClosed {
+		t.Errorf("state after client errors = %s", s)
+	}
+}
+
+func TestBreakerHalfOpenProbes(t *testing.T) {
+	b := testBreaker()
+	for range 4 {
+		call(b, context.DeadlineExceeded)
+	}
+	time.Sleep(25 * time.Millisecond)
+	if s := b.snapshot(); s != biz.CircuitHalfOpen {
+		t.Fatalf("state after open timeout = %s", s)
+	}
+
+	// 半开状态只放行配置数量的试探请求
+	ok1, p1 := b.allow(true)
+	ok2, p2 := b.allow(true)
+	ok3, _ := b.allow(true)
+	if !ok1 || !p1 || !ok2 || !p2 || ok3 {
+		t.Fatalf("probes admitted = %t, %t, %t", ok1, ok2, ok3)
+	}
+	b.record(p1, nil)
+	if s := b.snapshot(); s != biz.CircuitHalfOpen {
+		t.Fatalf("state after one success = %s", s)
+	}
+	b.record(p2, nil)
+	if s := b.snapshot(); s != biz.CircuitClosed {
+		t.Fatalf("state after all probes passed = %s", s)
+	}
+	// 关闭后窗口重新计数
+	call(b, errUnavailable)
+	if s := b.snapshot(); s != biz.CircuitClosed {
+		t.Errorf("state after one new failure = %s", s)
+	}
+
+	// 试探失败则重新打开
+	b = testBreaker()
+	for range 4 {
+		call(b, errUnavailable)
+	}
+	time.Sleep(25 * time.Millisecond)
+	call(b, errUnavailable)
+	if s := b.snapshot(); s != biz.CircuitOpen {
+		t.Errorf("state after failed probe = %s", s)
+	}
+}
+
+func TestBreakerAbandonedProbes(t *testing.T) {
+	b := testBreaker()
+	for range 4 {
+		call(b, errUnavailable)
+	}
+	time.Sleep(25 * time.Millisecond)
+	for range 2 {
+		// 调用方取消的试探既不算成功也不算失败，并归还名额
+		ok, probe := b.allow(true)
+		if !ok {
+			t.Fatal("probe rejected")
+		}
+		b.record(probe, context.Canceled)
+	}
+	if s := b.snapshot(); s != biz.CircuitHalfOpen {
+		t.Fatalf("state after cancelled probes = %s", s)
+	}
+	ok, probe := b.allow(true)
+	if !ok {
+		t.Fatal("probe slot was not released")
+	}
+	b.abandon(probe)
+	if !call(b, nil) || !call(b, nil) || b.snapshot() != biz.CircuitClosed {
+		t.Errorf("state = %s after two passing probes", b.snapshot())
+	}
+}
+
+func TestBreakerWindowExpires(t *testing.T) {
+	b := testBreaker()
+	b.cfg.window = 20 * time.Millisecond
+	for range 3 {
+		call(b, errUnavailable)
+	}
+	time.Sleep(25 * time.Millisecond)
+	// 窗口外的失败不再计入
+	call(b, errUnavailable)
+	if s := b.snapshot(); s != biz.CircuitClosed {
+		t.Errorf("state = %s, want failures outside the window forgotten", s)
+	}
+}
+
+func TestBulkhead(t *testing.T) {
+	h := newBulkhead(1)
+	if err := h.acquire(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	if err := h.acquire(ctx); !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("acquire over the limit = %v", err)
+	}
+
+	acquired := make(chan error)
+	go func() { acquired <- h.acquire(context.Background()) }()
+	for h.queued.Load() == 0 {
+		time.Sleep(time.Millisecond)
+	}
+	h.release()
+	if err := <-acquired; err != nil {
+		t.Fatal(err)
+	}
+	if h.queued.Load() != 0 || len(h.slots) != 1 {
+		t.Errorf("queued %d, in flight %d", h.queued.Load(), len(h.slots))
+	}
+	if cap(newBulkhead(0).slots) != defaultMaxConcurrency {
+		t.Error("zero limit did not use the default")
+	}
+}
@@ -0,0 +1,153 @@
+package data
+
+import (
+	"fmt"
+	"strconv"
+
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/known/anypb"
+	"google.golang.org/protobuf/types/known/structpb"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+// setFields sets the fields of msg from step input. Keys are proto or JSON
+// field names. A value may hold the field's own message type, a wrapper for
+// scalars, or a struct value; anything whose JSON form fits the field is
+// accepted.
+func setFields(msg protoreflect.Message, input map[string]*anypb.Any) error {
+	fields := msg.Descriptor().Fields()
+	for name, value := range input {
+		fd := fields.ByName(protoreflect.Name(name))
+		if fd == nil {
+			fd = fields.ByJSONName(name)
+		}
+		if fd == nil {
+			return fmt.Errorf("%s has no field %q", msg.Descriptor().FullName(), name)
+		}
+		if err := setField(msg, fd, value); err != nil {
+			return fmt.Errorf("field %s: %w", name, err)
+		}
+	}
+	return nil
+}
+
+// setField converts value through its JSON form, which lets wrappers fill
+// scalars, list values fill repeated fields and enum names fill enums
+func setField(msg protoreflect.Message, fd protoreflect.FieldDescriptor, value *anypb.Any) error {
+	inner, err := value.UnmarshalNew()
+	if err != nil {
+		return err
+	}
+	raw, err := protojson.Marshal(inner)
+	if err != nil {
+		return err
+	}
+	doc := []byte(`{` + strconv.Quote(string(fd.Name())) + `:` + string(raw) + `}`)
+	tmp := msg.New()
+	if err := protojson.Unmarshal(doc, tmp.Interface()); err != nil {
+		return err
+	}
+	// 零值或空列表没有可赋的值
+	if !tmp.Has(fd) {
+		msg.Clear(fd)
+		return nil
+	}
+	msg.Set(fd, tmp.Get(fd))
+	return nil
+}
+
+// messageFields splits msg into its top-level fields keyed by proto name.
+// Messages are packed as themselves, scalars as wrappers, enums as their
+// names and repeated or map fields as list and struct values. Unset message
+// fields are left out.
+func messageFields(msg protoreflect.Message) (map[string]*anypb.Any, error) {
+	fields := msg.Descriptor().Fields()
+	out := make(map[string]*anypb.Any, fields.Len())
+	for i := 0; i < fields.Len(); i++ {
+		fd := fields.Get(i)
+		if !msg.Has(fd) && (fd.Message() != nil && !fd.IsList() && !fd.IsMap() || fd.ContainingOneof() != nil) {
+			continue
+		}
+		value, err := fieldValue(msg, fd)
+		if err != nil {
+			return nil, fmt.Errorf("field %s: %w", fd.Name(), err)
+		}
+		a, err := anypb.New(value)
+		if err != nil {
+			return nil, err
+		}
+		out[string(fd.Name())] = a
+	}
+	return out, nil
+}
+
+func fieldValue(msg protoreflect.Message, fd protoreflect.FieldDescriptor) (proto.Message, error) {
+	v := msg.Get(fd)
+	switch {
+	case fd.IsList() || fd.IsMap():
+		return compositeValue(msg, fd)
+	case fd.Kind() == protoreflect.MessageKind || fd.Kind() == protoreflect.GroupKind:
+		return v.Message().Interface(), nil
+	}
+	switch fd.Kind() {
+	case protoreflect.StringKind:
+		return wrapperspb.String(v.String()), nil
+	case protoreflect.BoolKind:
+		return wrapperspb.Bool(v.Bool()), nil
+	case protoreflect.Int32Kind, protoreflect.Sint32Kind, protoreflect.Sfixed32Kind:
+		return wrapperspb.Int32(int32(v.Int())), nil
+	case protoreflect.Int64Kind, protoreflect.Sint64Kind, protoreflect.Sfixed64Kind:
+		return wrapperspb.Int64(v.Int()), nil
+	case protoreflect.Uint32Kind, protoreflect.Fixed32Kind:
+		return wrapperspb.UInt32(uint32(v.Uint())), nil
+	case protoreflect.Uint64Kind, protoreflect.Fixed64Kind:
+		return wrapperspb.UInt64(v.Uint()), nil
+	case protoreflect.FloatKind:
+		return wrapperspb.Float(float32(v.Float())), nil
+	case protoreflect.DoubleKind:
+		return wrapperspb.Double(v.Float()), nil
+	case protoreflect.BytesKind:
+		return wrapperspb.Bytes(v.Bytes()), nil
+	case protoreflect.EnumKind:
+		if ev := fd.Enum().Values().ByNumber(v.Enum()); ev != nil {
+			return wrapperspb.String(string(ev.Name())), nil
+		}
+		return wrapperspb.Int32(int32(v.Enum())), nil
+	}
+	return nil, fmt.Errorf("unsupported field kind %s", fd.Kind())
+}
+
+// compositeValue converts a repeated or map field to a list or struct value
+// through its JSON form
+func compositeValue(msg protoreflect.Message, fd protoreflect.FieldDescriptor) (proto.Message, error) {
+	// 空列表和空映射是只读值，不能赋给其他消息
+	if !msg.Has(fd) {
+		if fd.IsMap() {
+			return &structpb.Struct{Fields: map[string]*structpb.Value{}}, nil
+		}
+		return &structpb.ListValue{}, nil
+	}
+	tmp := msg.New()
+	tmp.Set(fd, msg.Get(fd))
+	raw, err := protojson.MarshalOptions{UseProtoNames: true, EmitUnpopulated: true}.Marshal(tmp.Interface())
+	if err != nil {
+		return nil, err
+	}
+	var doc structpb.Struct
+	if err := protojson.Unmarshal(raw, &doc); err != nil {
+		return nil, err
+	}
+	value := doc.Fields[string(fd.Name())]
+	if fd.IsMap() {
+		if s := value.GetStructValue(); s != nil {
+			return s, nil
+		}
+		return &structpb.Struct{Fields: map[string]*structpb.Value{}}, nil
+	}
+	if l := value.GetListValue(); l != nil {
+		return l, nil
+	}
+	return &structpb.ListValue{}, nil
+}
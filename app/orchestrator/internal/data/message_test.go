@@ -0,0 +1,162 @@
+package data
+
+import (
+	"strings"
+	"testing"
+
+	commonv1 "rag/api/common/v1"
+	preprocessorv1 "rag/api/preprocessor/v1"
+	rerankerv1 "rag/api/reranker/v1"
+
+	"github.com/golang/protobuf/proto"
+	"google.golang.org/protobuf/types/known/anypb"
+	"google.golang.org/protobuf/types/known/structpb"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+func pack(t testing.TB, m proto.Message) *anypb.Any {
+	t.Helper()
+	a, err := anypb.New(proto.MessageV2(m))
+	if err != nil {
+		t.Fatal(err)
+	}
+	return a
+}
+
+func TestSetFields(t *testing.T) {
+	list, err := structpb.NewList([]any{"d1", "d2"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	metadata, err := structpb.NewStruct(map[string]any{"lang": "en"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	tests := []struct {
+		name  string
+		msg   proto.Message
+		input map[string]*anypb.Any
+		want  proto.Message
+		err   string
+	}{
+		{
+			"enum by name",
+			&preprocessorv1.BatchQueryResult{},
+			map[string]*anypb.Any{"status": pack(t, wrapperspb.String("PROCESSING_STATUS_COMPLETED"))},
+			&preprocessorv1.BatchQueryResult{Status: commonv1.ProcessingStatus_PROCESSING_STATUS_COMPLETED},
+			"",
+		},
+		{
+			// 包装类型按 JSON 形式填入其他宽度的标量
+			"wrappers into scalars",
+			&preprocessorv1.BatchQueryResult{},
+			map[string]*anypb.Any{"index": pack(t, wrapperspb.Int64(7)), "processedQuery": pack(t, wrapperspb.String("q"))},
+			&preprocessorv1.BatchQueryResult{Index: 7, ProcessedQuery: "q"},
+			"",
+		},
+		{
+			"double into int32",
+			&preprocessorv1.BatchQueryResult{},
+			map[string]*anypb.Any{"index": pack(t, wrapperspb.Double(3))},
+			&preprocessorv1.BatchQueryResult{Index: 3},
+			"",
+		},
+		{
+			"list and struct into repeated and map",
+			&rerankerv1.BenchmarkQuery{},
+			map[string]*anypb.Any{"relevant_documents": pack(t, list), "metadata": pack(t, metadata)},
+			&rerankerv1.BenchmarkQuery{RelevantDocuments: []string{"d1", "d2"}, Metadata: map[string]string{"lang": "en"}},
+			"",
+		},
+		{
+			// 零值清除字段
+			"zero value",
+			&rerankerv1.BenchmarkQuery{Query: "q", RelevantDocuments: []string{"d1"}},
+			map[string]*anypb.Any{"query": pack(t, wrapperspb.String("")), "relevant_documents": pack(t, &structpb.ListValue{})},
+			&rerankerv1.BenchmarkQuery{},
+			"",
+		},
+		{
+			"unknown field",
+			&rerankerv1.BenchmarkQuery{},
+			map[string]*anypb.Any{"nope": pack(t, wrapperspb.String("x"))},
+			nil,
+			`has no field "nope"`,
+		},
+		{
+			"wrong type",
+			&preprocessorv1.BatchQueryResult{},
+			map[string]*anypb.Any{"index": pack(t, wrapperspb.String("seven"))},
+			nil,
+			"field index",
+		},
+		{
+			"unknown enum name",
+			&preprocessorv1.BatchQueryResult{},
+			map[string]*anypb.Any{"status": pack(t, wrapperspb.String("DONE"))},
+			nil,
+			"field status",
+		},
+	}
+	for _, tt := range tests {
+		err := setFields(proto.MessageReflect(tt.msg), tt.input)
+		if tt.err != "" {
+			if err == nil || !strings.Contains(err.Error(), tt.err) {
+				t.Errorf("%s: err = %v, want %q", tt.name, err, tt.err)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("%s: %v", tt.name, err)
+			continue
+		}
+		if !proto.Equal(tt.msg, tt.want) {
+			t.Errorf("%s: %v, want %v", tt.name, tt.msg, tt.want)
+		}
+	}
+}
+
+func TestMessageFieldsRoundTrip(t *testing.T) {
+	tests := []proto.Message{
+		&rerankerv1.BenchmarkQuery{Query: "q", RelevantDocuments: []string{"d1"}, Metadata: map[string]string{"lang": "en"}},
+		// 空列表和空映射也能写回
+		&rerankerv1.BenchmarkQuery{Query: "q"},
+		&preprocessorv1.BatchQueryResult{Index: 2, Status: commonv1.ProcessingStatus_PROCESSING_STATUS_FAILED},
+		&preprocessorv1.BatchQueryResult{ProcessingMetadata: &preprocessorv1.ProcessingMetadata{}},
+	}
+	for _, msg := range tests {
+		fields, err := messageFields(proto.MessageReflect(msg))
+		if err != nil {
+			t.Fatalf("%v: %v", msg, err)
+		}
+		copied := proto.MessageReflect(msg).New()
+		if err := setFields(copied, fields); err != nil {
+			t.Errorf("%v: %v", msg, err)
+			continue
+		}
+		if got := proto.MessageV1(copied.Interface()); !proto.Equal(got, msg) {
+			t.Errorf("round trip of %v = %v", msg, got)
+		}
+	}
+
+	fields, err := messageFields(proto.MessageReflect(&preprocessorv1.BatchQueryResult{Status: commonv1.ProcessingStatus_PROCESSING_STATUS_FAILED}))
+	if err != nil {
+		t.Fatal(err)
+	}
+	// 枚举以名称输出，未设置的消息字段省略
+	var status wrapperspb.StringValue
+	if err := fields["status"].UnmarshalTo(&status); err != nil || status.Value != "PROCESSING_STATUS_FAILED" {
+		t.Errorf("status = %v (%v)", fields["status"], err)
+	}
+	if _, ok := fields["processing_metadata"]; ok {
+		t.Error("unset message field included")
+	}
+
+	fields, err = messageFields(proto.MessageReflect(&rerankerv1.BenchmarkQuery{}))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !fields["relevant_documents"].MessageIs(&structpb.ListValue{}) || !fields["metadata"].MessageIs(&structpb.Struct{}) {
+		t.Errorf("empty repeated and map fields = %v, %v", fields["relevant_documents"], fields["metadata"])
+	}
+}
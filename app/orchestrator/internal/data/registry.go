@@ -0,0 +1,226 @@
+package data
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	assemblerv1 "rag/api/assembler/v1"
+	commonv1 "rag/api/common/v1"
+	docstorev1 "rag/api/docstore/v1"
+	embeddingv1 "rag/api/embedding/v1"
+	preprocessorv1 "rag/api/preprocessor/v1"
+	rerankerv1 "rag/api/reranker/v1"
+	"rag/app/orchestrator/internal/biz"
+	"rag/app/orchestrator/internal/conf"
+
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/log"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+	"google.golang.org/protobuf/types/known/anypb"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+// backendServices maps the service names workflow steps use to the gRPC
+// services generated for them.
+var backendServices = map[string]string{
+	"preprocessor": preprocessorv1.Preprocessor_ServiceDesc.ServiceName,
+	"embedding":    embeddingv1.Embedding_ServiceDesc.ServiceName,
+	"docstore":     docstorev1.DocStore_ServiceDesc.ServiceName,
+	"reranker":     rerankerv1.Reranker_ServiceDesc.ServiceName,
+	"assembler":    assemblerv1.Assembler_ServiceDesc.ServiceName,
+}
+
+// healthCheckMethod is the method every backend service implements
+const healthCheckMethod = "HealthCheck"
+
+// serviceBinding is a backend service bound to its connection
+type serviceBinding struct {
+	desc    protoreflect.ServiceDescriptor
+	conn    *grpc.ClientConn
+	methods map[string]protoreflect.MethodDescriptor
+	names   []string
+	// 按方法的熔断器和整个服务的隔离舱
+	breakers map[string]*circuitBreaker
+	bulkhead *bulkhead
+}
+
+// serviceRepo implements biz.ServiceRepo by invoking the unary methods of the
+// backend services through their descriptors. Request and response messages
+// are built with protoreflect, so any method can be bound without code.
+type serviceRepo struct {
+	services map[string]*serviceBinding
+	log      *log.Helper
+}
+
+// NewServiceRepo binds every configured backend service, guarding each
+// method with a circuit breaker and each service with a bulkhead
+func NewServiceRepo(data *Data, c *conf.Data, logger log.Logger) (biz.ServiceRepo, error) {
+	r := &serviceRepo{
+		services: make(map[string]*serviceBinding),
+		log:      log.NewHelper(logger),
+	}
+	for name, conn := range data.conns {
+		fullName, ok := backendServices[name]
+		if !ok {
+			r.log.Warnf("unknown service %s in configuration, skipped", name)
+			continue
+		}
+		d, err := protoregistry.GlobalFiles.FindDescriptorByName(protoreflect.FullName(fullName))
+		if err != nil {
+			return nil, fmt.Errorf("service %s: %w", name, err)
+		}
+		desc, ok := d.(protoreflect.ServiceDescriptor)
+		if !ok {
+			return nil, fmt.Errorf("service %s: %s is not a service", name, fullName)
+		}
+		svc := c.GetServices()[name]
+		b := &serviceBinding{
+			desc:     desc,
+			conn:     conn,
+			methods:  make(map[string]protoreflect.MethodDescriptor),
+			breakers: make(map[string]*circuitBreaker),
+			bulkhead: newBulkhead(svc.GetMaxConcurrency()),
+		}
+		breaker := newBreakerConfig(svc.GetCircuitBreaker())
+		for i := 0; i < desc.Methods().Len(); i++ {
+			m := desc.Methods().Get(i)
+			// 流式方法无法作为单个步骤调用
+			if m.IsStreamingClient() || m.IsStreamingServer() {
+				continue
+			}
+			b.methods[string(m.Name())] = m
+			b.names = append(b.names, string(m.Name()))
+			b.breakers[string(m.Name())] = newCircuitBreaker(breaker)
+		}
+		sort.Strings(b.names)
+		r.services[name] = b
+		r.log.Infof("registered %d methods of %s", len(b.names), fullName)
+	}
+	return r, nil
+}
+
+// Call builds the request from input, invokes the method and splits the
+// response into its fields. The call waits for a slot in the service's
+// bulkhead; when ctx enables circuit breaking it is rejected while the
+// method's breaker is open.
+func (r *serviceRepo) Call(ctx context.Context, service, method string, input map[string]*anypb.Any) (map[string]*anypb.Any, error) {
+	b, ok := r.services[service]
+	if !ok {
+		return nil, biz.ErrMethodNotRegistered.WithMetadata(map[string]string{"service": service, "method": method})
+	}
+	m, ok := b.methods[method]
+	if !ok {
+		return nil, biz.ErrMethodNotRegistered.WithMetadata(map[string]string{"service": service, "method": method})
+	}
+	req, err := newMessage(m.Input().FullName())
+	if err != nil {
+		return nil, err
+	}
+	resp, err := newMessage(m.Output().FullName())
+	if err != nil {
+		return nil, err
+	}
+	if err := setFields(req, input); err != nil {
+		return nil, errors.BadRequest(commonv1.ErrorCode_ERROR_CODE_BAD_REQUEST.String(),
+			fmt.Sprintf("invalid input for %s.%s: %v", service, method, err))
+	}
+	if err := b.invoke(ctx, m, req, resp); err != nil {
+		return nil, err
+	}
+	return messageFields(resp)
+}
+
+// invoke calls the method through its circuit breaker and the bulkhead
+func (b *serviceBinding) invoke(ctx context.Context, m protoreflect.MethodDescriptor, req, resp protoreflect.Message) error {
+	breaker := b.breakers[string(m.Name())]
+	ok, probe := breaker.allow(biz.CircuitBreakerEnabled(ctx))
+	if !ok {
+		return biz.ErrCircuitOpen.WithMetadata(map[string]string{"service": string(b.desc.FullName()), "method": string(m.Name())})
+	}
+	if err := b.bulkhead.acquire(ctx); err != nil {
+		breaker.abandon(probe)
+		return err
+	}
+	defer b.bulkhead.release()
+	if err := b.conn.Invoke(ctx, fullMethod(b.desc, m), req.Interface(), resp.Interface()); err != nil {
+		err = errors.FromError(err)
+		breaker.record(probe, err)
+		return err
+	}
+	breaker.record(probe, nil)
+	return nil
+}
+
+// Protection returns the state of the service's breakers and bulkhead
+func (r *serviceRepo) Protection(service string) biz.ServiceProtection {
+	b, ok := r.services[service]
+	if !ok {
+		return biz.ServiceProtection{}
+	}
+	p := biz.ServiceProtection{
+		InFlight:       len(b.bulkhead.slots),
+		Queued:         int(b.bulkhead.queued.Load()),
+		MaxConcurrency: cap(b.bulkhead.slots),
+	}
+	for method, breaker := range b.breakers {
+		if state := breaker.snapshot(); state != biz.CircuitClosed {
+			if p.Circuits == nil {
+				p.Circuits = make(map[string]string)
+			}
+			p.Circuits[method] = state
+		}
+	}
+	return p
+}
+
+// Services returns the registered service names
+func (r *serviceRepo) Services() []string {
+	names := make([]string, 0, len(r.services))
+	for name := range r.services {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// Methods returns the callable methods of a service
+func (r *serviceRepo) Methods(service string) ([]string, bool) {
+	b, ok := r.services[service]
+	if !ok {
+		return nil, false
+	}
+	return append([]string(nil), b.names...), true
+}
+
+// CheckHealth calls the HealthCheck method of a service
+func (r *serviceRepo) CheckHealth(ctx context.Context, service string) (*commonv1.HealthCheckResponse, error) {
+	b, ok := r.services[service]
+	if !ok {
+		return nil, biz.ErrMethodNotRegistered.WithMetadata(map[string]string{"service": service, "method": healthCheckMethod})
+	}
+	m, ok := b.methods[healthCheckMethod]
+	if !ok {
+		return nil, biz.ErrMethodNotRegistered.WithMetadata(map[string]string{"service": service, "method": healthCheckMethod})
+	}
+	resp := new(commonv1.HealthCheckResponse)
+	if err := b.conn.Invoke(ctx, fullMethod(b.desc, m), new(emptypb.Empty), resp); err != nil {
+		return nil, errors.FromError(err)
+	}
+	return resp, nil
+}
+
+func fullMethod(service protoreflect.ServiceDescriptor, method protoreflect.MethodDescriptor) string {
+	return fmt.Sprintf("/%s/%s", service.FullName(), method.Name())
+}
+
+// newMessage creates an empty message of a registered type
+func newMessage(name protoreflect.FullName) (protoreflect.Message, error) {
+	mt, err := protoregistry.GlobalTypes.FindMessageByName(name)
+	if err != nil {
+		return nil, fmt.Errorf("message %s: %w", name, err)
+	}
+	return mt.New(), nil
+}
@@ -0,0 +1,138 @@
+package data
+
+import (
+	"context"
+	"net"
+	"reflect"
+	"strings"
+	"testing"
+
+	commonv1 "rag/api/common/v1"
+	rerankerv1 "rag/api/reranker/v1"
+	"rag/app/orchestrator/internal/biz"
+	"rag/app/orchestrator/internal/conf"
+
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/golang/protobuf/proto"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/protobuf/types/known/anypb"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/structpb"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+// echoReranker scores by the number of scoring aspects and explains with the
+// model parameters it received
+type echoReranker struct {
+	rerankerv1.UnimplementedRerankerServer
+}
+
+func (echoReranker) ScoreRelevance(ctx context.Context, req *rerankerv1.ScoreRelevanceRequest) (*rerankerv1.ScoreRelevanceResponse, error) {
+	return &rerankerv1.ScoreRelevanceResponse{
+		RelevanceScore: float32(len(req.GetOptions().GetScoringAspects())),
+		Explanation:    &rerankerv1.ScoringExplanation{Summary: req.Query + ":" + req.GetOptions().GetModelParameters()["mode"]},
+	}, nil
+}
+
+func (echoReranker) HealthCheck(ctx context.Context, _ *emptypb.Empty) (*commonv1.HealthCheckResponse, error) {
+	return &commonv1.HealthCheckResponse{Status: "healthy"}, nil
+}
+
+// newTestServiceRepo binds a reranker served in process, and a service name
+// the orchestrator does not know
+func newTestServiceRepo(t *testing.T) biz.ServiceRepo {
+	t.Helper()
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	srv := grpc.NewServer()
+	rerankerv1.RegisterRerankerServer(srv, echoReranker{})
+	go srv.Serve(lis)
+	t.Cleanup(srv.Stop)
+
+	conn, err := grpc.NewClient(lis.Addr().String(), grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { conn.Close() })
+	repo, err := NewServiceRepo(&Data{conns: map[string]*grpc.ClientConn{"reranker": conn, "billing": conn}}, &conf.Data{}, log.DefaultLogger)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return repo
+}
+
+func TestNewServiceRepo(t *testing.T) {
+	repo := newTestServiceRepo(t)
+	// 未知的服务名被跳过
+	if got := repo.Services(); !reflect.DeepEqual(got, []string{"reranker"}) {
+		t.Errorf("services = %v", got)
+	}
+	methods, ok := repo.Methods("reranker")
+	want := []string{"BenchmarkModel", "ConfigureModel", "GetModelInfo", "HealthCheck", "ListModels", "RerankDocuments", "ScoreBatchRelevance", "ScoreRelevance"}
+	if !ok || !reflect.DeepEqual(methods, want) {
+		t.Errorf("reranker methods = %v", methods)
+	}
+	if _, ok := repo.Methods("billing"); ok {
+		t.Error("unknown service bound")
+	}
+	resp, err := repo.CheckHealth(context.Background(), "reranker")
+	if err != nil || resp.Status != "healthy" {
+		t.Errorf("health = %v, %v", resp, err)
+	}
+}
+
+func TestServiceRepoCall(t *testing.T) {
+	repo := newTestServiceRepo(t)
+	ctx := context.Background()
+	options, err := structpb.NewStruct(map[string]any{
+		"scoring_aspects":  []any{"semantic", "lexical"},
+		"model_parameters": map[string]any{"mode": "fast"},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	out, err := repo.Call(ctx, "reranker", "ScoreRelevance", map[string]*anypb.Any{
+		"query":            pack(t, wrapperspb.String("q")),
+		"document_content": pack(t, wrapperspb.String("text")),
+		"options":          pack(t, options),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	var score wrapperspb.FloatValue
+	if err := out["relevance_score"].UnmarshalTo(&score); err != nil || score.Value != 2 {
+		t.Errorf("relevance_score = %v (%v)", out["relevance_score"], err)
+	}
+	var explanation rerankerv1.ScoringExplanation
+	if err := out["explanation"].UnmarshalTo(proto.MessageV2(&explanation)); err != nil || explanation.Summary != "q:fast" {
+		t.Errorf("explanation = %v (%v)", out["explanation"], err)
+	}
+	// 空的重复字段以空列表返回，未设置的消息字段省略
+	if !out["aspect_scores"].MessageIs(&structpb.ListValue{}) {
+		t.Errorf("aspect_scores = %v", out["aspect_scores"])
+	}
+	if _, ok := out["metadata"]; ok {
+		t.Error("unset metadata returned")
+	}
+
+	tests := []struct {
+		service, method string
+		input           map[string]*anypb.Any
+		reason          string
+		message         string
+	}{
+		{"reranker", "ScoreRelevance", map[string]*anypb.Any{"score": pack(t, wrapperspb.Float(1))}, commonv1.ErrorCode_ERROR_CODE_BAD_REQUEST.String(), `no field "score"`},
+		{"reranker", "Shuffle", nil, biz.ErrMethodNotRegistered.Reason, ""},
+		{"billing", "Charge", nil, biz.ErrMethodNotRegistered.Reason, ""},
+	}
+	for _, tt := range tests {
+		_, err := repo.Call(ctx, tt.service, tt.method, tt.input)
+		if errors.Reason(err) != tt.reason || !strings.Contains(errors.FromError(err).GetMessage(), tt.message) {
+			t.Errorf("%s.%s: err = %v, want %s", tt.service, tt.method, err, tt.reason)
+		}
+	}
+}
@@ -0,0 +1,39 @@
+package data
+
+import (
+	"errors"
+	"io/fs"
+
+	"rag/app/orchestrator/internal/conf"
+	"rag/pkg/tokenizer"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+// NewTokenizer loads the tokenizer generation counts tokens with, from the
+// same vocabulary directory the assembler reads
+func NewTokenizer(c *conf.Data, logger log.Logger) (tokenizer.Tokenizer, error) {
+	helper := log.NewHelper(logger)
+	g := c.GetGeneration()
+	registry := tokenizer.NewRegistry()
+	if dir := g.GetVocabDir(); dir != "" {
+		loaded, err := tokenizer.LoadDir(dir)
+		switch {
+		case errors.Is(err, fs.ErrNotExist):
+			// 词表目录不存在时仅使用启发式分词器
+			helper.Warnf("tokenizer vocab dir %s not found, using %s only", dir, tokenizer.HeuristicName)
+		case err != nil:
+			return nil, err
+		default:
+			registry = loaded
+		}
+	}
+	if name := g.GetTokenizer(); name != "" {
+		if err := registry.SetDefault(name); err != nil {
+			return nil, err
+		}
+	}
+	tok, _ := registry.Get("")
+	helper.Infof("generation counts tokens with %s %s", tok.Name(), tok.Version())
+	return tok, nil
+}
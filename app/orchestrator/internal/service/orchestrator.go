@@ -0,0 +1,107 @@
+package service
+
+import (
+	"context"
+
+	commonv1 "rag/api/common/v1"
+	pb "rag/api/orchestrator/v1"
+	"rag/app/orchestrator/internal/biz"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+type OrchestratorService struct {
+	pb.UnimplementedOrchestratorServer
+
+	queryUc  *biz.QueryUsecase
+	healthUc *biz.HealthUsecase
+	execUc   *biz.ExecutionUsecase
+	defUc    *biz.DefinitionUsecase
+	log      *log.Helper
+}
+
+func NewOrchestratorService(queryUc *biz.QueryUsecase, healthUc *biz.HealthUsecase, execUc *biz.ExecutionUsecase, defUc *biz.DefinitionUsecase, logger log.Logger) *OrchestratorService {
+	return &OrchestratorService{
+		queryUc:  queryUc,
+		healthUc: healthUc,
+		execUc:   execUc,
+		defUc:    defUc,
+		log:      log.NewHelper(logger),
+	}
+}
+
+// ProcessQuery answers a query by running a workflow
+func (s *OrchestratorService) ProcessQuery(ctx context.Context, req *pb.ProcessQueryRequest) (*pb.ProcessQueryResponse, error) {
+	s.log.WithContext(ctx).Info("ProcessQuery request received")
+	return s.queryUc.ProcessQuery(ctx, req)
+}
+
+// ProcessQueryStream answers a query by running a workflow and streams the
+// retrieved documents, the answer as it is generated and the response
+func (s *OrchestratorService) ProcessQueryStream(req *pb.ProcessQueryRequest, stream pb.Orchestrator_ProcessQueryStreamServer) error {
+	s.log.WithContext(stream.Context()).Info("ProcessQueryStream request received")
+	return s.queryUc.ProcessQueryStream(stream.Context(), req, stream.Send)
+}
+
+// ExecuteWorkflow runs a stored workflow and returns its result
+func (s *OrchestratorService) ExecuteWorkflow(ctx context.Context, req *pb.ExecuteWorkflowRequest) (*pb.ExecuteWorkflowResponse, error) {
+	s.log.WithContext(ctx).Infof("ExecuteWorkflow request received for %s", req.WorkflowDefinitionId)
+	return s.execUc.Execute(ctx, req)
+}
+
+// ExecuteWorkflowAsync starts a stored workflow in the background
+func (s *OrchestratorService) ExecuteWorkflowAsync(ctx context.Context, req *pb.ExecuteWorkflowAsyncRequest) (*pb.ExecuteWorkflowAsyncResponse, error) {
+	s.log.WithContext(ctx).Infof("ExecuteWorkflowAsync request received for %s", req.WorkflowDefinitionId)
+	return s.execUc.ExecuteAsync(ctx, req)
+}
+
+// GetWorkflowStatus returns the state of a background execution
+func (s *OrchestratorService) GetWorkflowStatus(ctx context.Context, req *pb.GetWorkflowStatusRequest) (*pb.GetWorkflowStatusResponse, error) {
+	return s.execUc.GetStatus(ctx, req)
+}
+
+// CancelWorkflow cancels a background execution
+func (s *OrchestratorService) CancelWorkflow(ctx context.Context, req *pb.CancelWorkflowRequest) (*pb.CancelWorkflowResponse, error) {
+	s.log.WithContext(ctx).Infof("CancelWorkflow request received for %s", req.WorkflowId)
+	return s.execUc.Cancel(ctx, req)
+}
+
+// CreateWorkflowDefinition publishes a workflow definition
+func (s *OrchestratorService) CreateWorkflowDefinition(ctx context.Context, req *pb.CreateWorkflowDefinitionRequest) (*pb.CreateWorkflowDefinitionResponse, error) {
+	s.log.WithContext(ctx).Infof("CreateWorkflowDefinition request received for %s", req.GetDefinition().GetName())
+	return s.defUc.CreateDefinition(ctx, req)
+}
+
+// GetWorkflowDefinition returns a version of a workflow definition
+func (s *OrchestratorService) GetWorkflowDefinition(ctx context.Context, req *pb.GetWorkflowDefinitionRequest) (*pb.GetWorkflowDefinitionResponse, error) {
+	return s.defUc.GetDefinition(ctx, req)
+}
+
+// ListWorkflowDefinitions lists the published workflow definition versions
+func (s *OrchestratorService) ListWorkflowDefinitions(ctx context.Context, req *pb.ListWorkflowDefinitionsRequest) (*pb.ListWorkflowDefinitionsResponse, error) {
+	return s.defUc.ListDefinitions(ctx, req)
+}
+
+// UpdateWorkflowDefinition publishes a new version or changes the default version
+func (s *OrchestratorService) UpdateWorkflowDefinition(ctx context.Context, req *pb.UpdateWorkflowDefinitionRequest) (*pb.UpdateWorkflowDefinitionResponse, error) {
+	s.log.WithContext(ctx).Infof("UpdateWorkflowDefinition request received for %s", req.DefinitionId)
+	return s.defUc.UpdateDefinition(ctx, req)
+}
+
+// GetServicesHealth checks the health of the backend services
+func (s *OrchestratorService) GetServicesHealth(ctx context.Context, req *pb.GetServicesHealthRequest) (*pb.GetServicesHealthResponse, error) {
+	s.log.WithContext(ctx).Info("GetServicesHealth request received")
+	return s.healthUc.GetServicesHealth(ctx, req)
+}
+
+// HealthCheck performs health check
+func (s *OrchestratorService) HealthCheck(ctx context.Context, req *emptypb.Empty) (*commonv1.HealthCheckResponse, error) {
+	return &commonv1.HealthCheckResponse{
+		Status:    "SERVING",
+		Service:   "orchestrator",
+		Version:   "v1.0.0",
+		Timestamp: timestamppb.Now(),
+	}, nil
+}
@@ -7,13 +7,13 @@
 package main
 
 import (
+	"github.com/go-kratos/kratos/v2"
+	"github.com/go-kratos/kratos/v2/log"
 	"rag/app/reranker/internal/biz"
 	"rag/app/reranker/internal/conf"
 	"rag/app/reranker/internal/data"
 	"rag/app/reranker/internal/server"
 	"rag/app/reranker/internal/service"
-	"github.com/go-kratos/kratos/v2"
-	"github.com/go-kratos/kratos/v2/log"
 )
 
 import (
@@ -24,15 +24,25 @@ import (
 
 // wireApp init kratos application.
 func wireApp(confServer *conf.Server, confData *conf.Data, logger log.Logger) (*kratos.App, func(), error) {
+	relevanceScorer := data.NewRelevanceScorer(logger)
+	judgeFactory := data.NewJudgeFactory(logger)
+	scoreCache := data.NewScoreCache(logger)
 	dataData, cleanup, err := data.NewData(confData, logger)
 	if err != nil {
 		return nil, nil, err
 	}
-	greeterRepo := data.NewGreeterRepo(dataData, logger)
-	greeterUsecase := biz.NewGreeterUsecase(greeterRepo, logger)
-	greeterService := service.NewGreeterService(greeterUsecase)
-	grpcServer := server.NewGRPCServer(confServer, greeterService, logger)
-	httpServer := server.NewHTTPServer(confServer, greeterService, logger)
+	modelRepo, err := data.NewModelRepo(confData, dataData, logger)
+	if err != nil {
+		cleanup()
+		return nil, nil, err
+	}
+	modelUsecase := biz.NewModelUsecase(modelRepo, logger)
+	rerankUsecase := biz.NewRerankUsecase(relevanceScorer, judgeFactory, scoreCache, modelUsecase, logger)
+	benchmarkRepo := data.NewBenchmarkRepo(confData, logger)
+	benchmarkUsecase := biz.NewBenchmarkUsecase(rerankUsecase, modelRepo, benchmarkRepo, logger)
+	rerankerService := service.NewRerankerService(rerankUsecase, modelUsecase, benchmarkUsecase, logger)
+	grpcServer := server.NewGRPCServer(confServer, rerankerService, logger)
+	httpServer := server.NewHTTPServer(confServer, rerankerService, logger)
 	app := newApp(logger, grpcServer, httpServer)
 	return app, func() {
 		cleanup()
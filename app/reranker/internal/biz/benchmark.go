@@ -0,0 +1,478 @@
+package biz
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"runtime"
+	"sort"
+	"strconv"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	commonv1 "rag/api/common/v1"
+	v1 "rag/api/reranker/v1"
+
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/log"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const (
+	// 排序指标的截断位置 k
+	benchmarkCutoff = 10
+	// 吞吐测试中每个并发级别的持续时间
+	throughputWindow = time.Second
+)
+
+// 评测指标名称
+const (
+	MetricAccuracy  = "accuracy"
+	MetricPrecision = "precision"
+	MetricRecall    = "recall"
+	MetricF1        = "f1"
+	MetricNDCG      = "ndcg"
+	MetricMAP       = "map"
+	MetricMRR       = "mrr"
+)
+
+var allMetrics = []string{MetricAccuracy, MetricPrecision, MetricRecall, MetricF1, MetricNDCG, MetricMAP, MetricMRR}
+
+// JudgedDocument is a benchmark document with its graded relevance
+type JudgedDocument struct {
+	DocumentID string
+	Content    string
+	Relevance  int
+}
+
+// BenchmarkCase is a benchmark query with judged candidate documents
+type BenchmarkCase struct {
+	QueryID   string
+	Query     string
+	Documents []*JudgedDocument
+}
+
+// queryMetrics holds the ranking metrics of one benchmark query
+type queryMetrics struct {
+	values             map[string]float64
+	relevantInTopK     int32
+	irrelevantInTopK   int32
+	rankingFingerprint string
+}
+
+// BenchmarkRepo defines the data access interface for benchmark datasets
+type BenchmarkRepo interface {
+	// 按名称加载本地评测数据集
+	LoadDataset(ctx context.Context, name string) ([]*BenchmarkCase, error)
+}
+
+// BenchmarkUsecase runs offline benchmarks of reranking models
+type BenchmarkUsecase struct {
+	rerank   *RerankUsecase
+	models   ModelRepo
+	datasets BenchmarkRepo
+	log      *log.Helper
+}
+
+// NewBenchmarkUsecase creates a new benchmark usecase
+func NewBenchmarkUsecase(rerank *RerankUsecase, models ModelRepo, datasets BenchmarkRepo, logger log.Logger) *BenchmarkUsecase {
+	return &BenchmarkUsecase{
+		rerank:   rerank,
+		models:   models,
+		datasets: datasets,
+		log:      log.NewHelper(logger),
+	}
+}
+
+// BenchmarkModel evaluates a model on the given datasets and records the
+// resulting metrics on the model.
+func (uc *BenchmarkUsecase) BenchmarkModel(ctx context.Context, req *v1.BenchmarkModelRequest) (*v1.BenchmarkModelResponse, error) {
+	startedAt := time.Now()
+	uc.log.WithContext(ctx).Infof("Benchmarking model: %s", req.ModelName)
+
+	if _, err := uc.models.GetModel(ctx, req.ModelName); err != nil {
+		return nil, err
+	}
+
+	config := req.Config
+	if config == nil {
+		config = &v1.BenchmarkConfig{}
+	}
+	iterations := int(config.NumIterations)
+	if iterations < 1 {
+		iterations = 1
+	}
+	metrics := config.Metrics
+	if len(metrics) == 0 {
+		metrics = allMetrics
+	}
+
+	// 加载数据集，未内联查询的数据集从本地文件读取。结果按名称区分，名称不能重复
+	datasets := make(map[string][]*BenchmarkCase, len(config.Datasets))
+	for _, dataset := range config.Datasets {
+		if _, ok := datasets[dataset.Name]; ok {
+			return nil, errors.BadRequest(commonv1.ErrorCode_ERROR_CODE_BAD_REQUEST.String(),
+				fmt.Sprintf("dataset %q is listed more than once", dataset.Name))
+		}
+		if len(dataset.Queries) > 0 {
+			datasets[dataset.Name] = casesFromDataset(dataset)
+			continue
+		}
+		cases, err := uc.datasets.LoadDataset(ctx, dataset.Name)
+		if err != nil {
+			uc.log.WithContext(ctx).Errorf("Failed to load dataset %s: %v", dataset.Name, err)
+			return nil, err
+		}
+		datasets[dataset.Name] = cases
+	}
+
+	var (
+		latencies      []float64
+		datasetResults []*v1.DatasetResults
+		totalQueries   int32
+		totalDocuments int32
+		consistent     int
+		sums           = make(map[string]float64)
+	)
+	for _, dataset := range config.Datasets {
+		cases := datasets[dataset.Name]
+		result := &v1.DatasetResults{
+			DatasetName: dataset.Name,
+			Metrics:     make(map[string]float32),
+		}
+		datasetSums := make(map[string]float64)
+
+		for _, c := range cases {
+			var first *queryMetrics
+			stable := true
+			for iter := 0; iter < iterations; iter++ {
+				start := time.Now()
+				ranked, err := uc.rankCase(ctx, req.ModelName, c)
+				if err != nil {
+					uc.log.WithContext(ctx).Errorf("Benchmark query %q failed: %v", c.Query, err)
+					return nil, err
+				}
+				latencies = append(latencies, float64(time.Since(start).Microseconds())/1000)
+
+				m := evaluateRanking(c, ranked)
+				if first == nil {
+					first = m
+				} else if m.rankingFingerprint != first.rankingFingerprint {
+					stable = false
+				}
+			}
+			if stable {
+				consistent++
+			}
+
+			for name, value := range first.values {
+				datasetSums[name] += value
+			}
+			result.QueryResults = append(result.QueryResults, &v1.QueryResult{
+				Query:              c.Query,
+				Score:              float32(first.values[MetricNDCG]),
+				NumRelevantFound:   first.relevantInTopK,
+				NumIrrelevantFound: first.irrelevantInTopK,
+			})
+			totalQueries++
+			totalDocuments += int32(len(c.Documents))
+		}
+
+		for _, name := range metrics {
+			if len(cases) > 0 {
+				result.Metrics[name] = float32(datasetSums[name] / float64(len(cases)))
+			}
+		}
+		for _, name := range allMetrics {
+			if len(cases) > 0 {
+				sums[name] += datasetSums[name] / float64(len(cases))
+			}
+		}
+		datasetResults = append(datasetResults, result)
+	}
+
+	overall := &v1.OverallMetrics{}
+	if n := float64(len(datasetResults)); n > 0 {
+		overall.AvgAccuracy = float32(sums[MetricAccuracy] / n)
+		overall.AvgPrecision = float32(sums[MetricPrecision] / n)
+		overall.AvgRecall = float32(sums[MetricRecall] / n)
+		overall.AvgF1Score = float32(sums[MetricF1] / n)
+		overall.AvgNdcg = float32(sums[MetricNDCG] / n)
+	}
+	if totalQueries > 0 {
+		overall.ConsistencyScore = float32(consistent) / float32(totalQueries)
+	}
+
+	var performance *v1.PerformanceResults
+	if config.IncludeLatencyTest || config.IncludeThroughputTest {
+		performance = &v1.PerformanceResults{}
+		if config.IncludeLatencyTest {
+			performance.AvgLatencyMs = float32(mean(latencies))
+			performance.P95LatencyMs = float32(percentile(latencies, 0.95))
+			performance.P99LatencyMs = float32(percentile(latencies, 0.99))
+		}
+		if config.IncludeThroughputTest {
+			performance.MaxThroughputQps = uc.measureThroughput(ctx, req.ModelName, datasets)
+		}
+		var mem runtime.MemStats
+		runtime.ReadMemStats(&mem)
+		performance.MemoryUsageMb = float32(mem.HeapAlloc) / (1 << 20)
+	}
+
+	// 记录到模型性能指标
+	completedAt := time.Now()
+	benchmarkScores := make(map[string]float32)
+	for _, result := range datasetResults {
+		for name, value := range result.Metrics {
+			benchmarkScores[result.DatasetName+"/"+name] = value
+		}
+	}
+	modelMetrics := &v1.ModelPerformanceMetrics{
+		AvgScoringTimeMs: float32(mean(latencies)),
+		AccuracyScore:    overall.AvgAccuracy,
+		PrecisionAtK:     overall.AvgPrecision,
+		NdcgAtK:          overall.AvgNdcg,
+		BenchmarkScores:  benchmarkScores,
+		LastBenchmarked:  timestamppb.New(completedAt),
+	}
+	if performance != nil {
+		modelMetrics.MaxThroughputPerSecond = performance.MaxThroughputQps
+	}
+	// 只更新性能指标，评测期间对模型配置的修改不会被覆盖
+	if err := uc.models.UpdatePerformanceMetrics(ctx, req.ModelName, modelMetrics); err != nil {
+		uc.log.WithContext(ctx).Errorf("Failed to save benchmark results: %v", err)
+		return nil, err
+	}
+
+	uc.log.WithContext(ctx).Infof("Benchmarked model %s on %d queries in %v", req.ModelName, totalQueries, completedAt.Sub(startedAt))
+	return &v1.BenchmarkModelResponse{
+		ModelName: req.ModelName,
+		Results: &v1.BenchmarkResults{
+			DatasetResults:     datasetResults,
+			OverallMetrics:     overall,
+			PerformanceResults: performance,
+		},
+		Metadata: &v1.BenchmarkMetadata{
+			TotalQueriesTested:   totalQueries,
+			TotalDocumentsTested: totalDocuments,
+			TotalBenchmarkTimeMs: completedAt.Sub(startedAt).Milliseconds(),
+			ConfigUsed:           config,
+			StartedAt:            timestamppb.New(startedAt),
+			CompletedAt:          timestamppb.New(completedAt),
+		},
+	}, nil
+}
+
+// rankCase reranks the documents of a benchmark case and returns their
+// indices in ranked order. Scores are neither cached nor shared, so every
+// iteration and every throughput worker measures the model itself.
+func (uc *BenchmarkUsecase) rankCase(ctx context.Context, modelName string, c *BenchmarkCase) ([]int, error) {
+	docs := make([]*v1.DocumentToRerank, len(c.Documents))
+	for i, doc := range c.Documents {
+		docs[i] = &v1.DocumentToRerank{
+			DocumentId:   strconv.Itoa(i),
+			Content:      doc.Content,
+			OriginalRank: int32(i + 1),
+		}
+	}
+	resp, err := uc.rerank.rerank(ctx, uc.rerank.scorers.uncached, &v1.RerankDocumentsRequest{
+		Query:     c.Query,
+		Documents: docs,
+		Options:   &v1.RerankingOptions{ModelName: modelName},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	ranked := make([]int, 0, len(resp.RankedDocuments))
+	for _, doc := range resp.RankedDocuments {
+		i, err := strconv.Atoi(doc.DocumentId)
+		if err != nil {
+			return nil, err
+		}
+		ranked = append(ranked, i)
+	}
+	return ranked, nil
+}
+
+// measureThroughput runs all benchmark queries with increasing concurrency
+// and returns the best observed queries per second.
+func (uc *BenchmarkUsecase) measureThroughput(ctx context.Context, modelName string, datasets map[string][]*BenchmarkCase) int32 {
+	var cases []*BenchmarkCase
+	for _, dataset := range datasets {
+		cases = append(cases, dataset...)
+	}
+	if len(cases) == 0 {
+		return 0
+	}
+
+	var best float64
+	for workers := 1; workers <= 2*runtime.GOMAXPROCS(0); workers *= 2 {
+		var (
+			completed int64
+			next      int64
+			wg        sync.WaitGroup
+		)
+		windowCtx, cancel := context.WithTimeout(ctx, throughputWindow)
+		start := time.Now()
+		for w := 0; w < workers; w++ {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				for windowCtx.Err() == nil {
+					c := cases[int(atomic.AddInt64(&next, 1)-1)%len(cases)]
+					if _, err := uc.rankCase(windowCtx, modelName, c); err == nil {
+						atomic.AddInt64(&completed, 1)
+					}
+				}
+			}()
+		}
+		wg.Wait()
+		cancel()
+
+		qps := float64(completed) / time.Since(start).Seconds()
+		uc.log.WithContext(ctx).Infof("Throughput with %d workers: %.1f qps", workers, qps)
+		if qps <= best {
+			break
+		}
+		best = qps
+	}
+	return int32(best)
+}
+
+// casesFromDataset converts an inline dataset to benchmark cases with binary relevance
+func casesFromDataset(dataset *v1.BenchmarkDataset) []*BenchmarkCase {
+	cases := make([]*BenchmarkCase, 0, len(dataset.Queries))
+	for i, q := range dataset.Queries {
+		c := &BenchmarkCase{QueryID: strconv.Itoa(i), Query: q.Query}
+		for _, content := range q.RelevantDocuments {
+			c.Documents = append(c.Documents, &JudgedDocument{Content: content, Relevance: 1})
+		}
+		for _, content := range q.IrrelevantDocuments {
+			c.Documents = append(c.Documents, &JudgedDocument{Content: content})
+		}
+		cases = append(cases, c)
+	}
+	return cases
+}
+
+// evaluateRanking computes IR metrics for a ranking given as document indices
+func evaluateRanking(c *BenchmarkCase, ranked []int) *queryMetrics {
+	m := &queryMetrics{values: make(map[string]float64)}
+
+	var totalRelevant, totalIrrelevant int
+	grades := make([]int, 0, len(c.Documents))
+	for _, doc := range c.Documents {
+		grades = append(grades, doc.Relevance)
+		if doc.Relevance > 0 {
+			totalRelevant++
+		} else {
+			totalIrrelevant++
+		}
+	}
+
+	k := benchmarkCutoff
+	if len(ranked) < k {
+		k = len(ranked)
+	}
+
+	var (
+		fingerprint   []byte
+		hits          int
+		sumPrecision  float64
+		reciprocal    float64
+		dcg           float64
+		correctPairs  int
+		irrelevantYet int
+	)
+	for pos, idx := range ranked {
+		fingerprint = strconv.AppendInt(fingerprint, int64(idx), 10)
+		fingerprint = append(fingerprint, ',')
+
+		grade := c.Documents[idx].Relevance
+		if grade > 0 {
+			hits++
+			sumPrecision += float64(hits) / float64(pos+1)
+			if reciprocal == 0 {
+				reciprocal = 1 / float64(pos+1)
+			}
+			// 排在该相关文档之后的不相关文档数
+			correctPairs += totalIrrelevant - irrelevantYet
+		} else {
+			irrelevantYet++
+		}
+		if pos < k {
+			dcg += gain(grade) / math.Log2(float64(pos+2))
+			if grade > 0 {
+				m.relevantInTopK++
+			} else {
+				m.irrelevantInTopK++
+			}
+		}
+	}
+	m.rankingFingerprint = string(fingerprint)
+
+	sort.Sort(sort.Reverse(sort.IntSlice(grades)))
+	var idcg float64
+	for pos := 0; pos < k && pos < len(grades); pos++ {
+		idcg += gain(grades[pos]) / math.Log2(float64(pos+2))
+	}
+
+	if k > 0 {
+		m.values[MetricPrecision] = float64(m.relevantInTopK) / float64(k)
+	}
+	if totalRelevant > 0 {
+		m.values[MetricRecall] = float64(m.relevantInTopK) / float64(totalRelevant)
+		m.values[MetricMAP] = sumPrecision / float64(totalRelevant)
+	}
+	if p, r := m.values[MetricPrecision], m.values[MetricRecall]; p+r > 0 {
+		m.values[MetricF1] = 2 * p * r / (p + r)
+	}
+	if idcg > 0 {
+		m.values[MetricNDCG] = dcg / idcg
+	}
+	m.values[MetricMRR] = reciprocal
+	// 准确率：相关文档排在不相关文档之前的文档对比例
+	if totalRelevant > 0 && totalIrrelevant > 0 {
+		m.values[MetricAccuracy] = float64(correctPairs) / float64(totalRelevant*totalIrrelevant)
+	} else {
+		m.values[MetricAccuracy] = 1
+	}
+	return m
+}
+
+// gain is the exponential NDCG gain of a relevance grade
+func gain(grade int) float64 {
+	if grade <= 0 {
+		return 0
+	}
+	return math.Exp2(float64(grade)) - 1
+}
+
+// mean returns the arithmetic mean of values
+func mean(values []float64) float64 {
+	if len(values) == 0 {
+		return 0
+	}
+	var sum float64
+	for _, v := range values {
+		sum += v
+	}
+	return sum / float64(len(values))
+}
+
+// percentile returns the nearest-rank percentile of values
+func percentile(values []float64, p float64) float64 {
+	if len(values) == 0 {
+		return 0
+	}
+	sorted := make([]float64, len(values))
+	copy(sorted, values)
+	sort.Float64s(sorted)
+	rank := int(math.Ceil(p*float64(len(sorted)))) - 1
+	if rank < 0 {
+		rank = 0
+	}
+	return sorted[rank]
+}
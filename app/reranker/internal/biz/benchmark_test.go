@@ -0,0 +1,108 @@
+package biz
+
+import (
+	"context"
+	"testing"
+
+	commonv1 "rag/api/common/v1"
+	v1 "rag/api/reranker/v1"
+
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+func TestBenchmarkBypassesScoreCache(t *testing.T) {
+	scorer := &countingScorer{}
+	uc, repo := newTestRerankUsecase(scorer)
+	bench := NewBenchmarkUsecase(uc, repo, nil, log.DefaultLogger)
+
+	const iterations = 3
+	docs := []string{"go channels", "go maps", "rust traits"}
+	_, err := bench.BenchmarkModel(context.Background(), &v1.BenchmarkModelRequest{
+		ModelName: testModel,
+		Config: &v1.BenchmarkConfig{
+			NumIterations: iterations,
+			Datasets: []*v1.BenchmarkDataset{{
+				Name: "inline",
+				Queries: []*v1.BenchmarkQuery{{
+					Query:               "go channels",
+					RelevantDocuments:   docs[:1],
+					IrrelevantDocuments: docs[1:],
+				}},
+			}},
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	// 缓存已开启，但每次迭代都应调用模型
+	if want := int64(iterations * len(docs)); scorer.calls != want {
+		t.Errorf("scorer calls = %d, want %d", scorer.calls, want)
+	}
+	if repo.model.GetPerformanceMetrics().GetLastBenchmarked() == nil {
+		t.Error("benchmark results were not recorded")
+	}
+}
+
+func TestBenchmarkRejectsDuplicateDatasets(t *testing.T) {
+	scorer := &countingScorer{}
+	uc, repo := newTestRerankUsecase(scorer)
+	bench := NewBenchmarkUsecase(uc, repo, nil, log.DefaultLogger)
+	dataset := &v1.BenchmarkDataset{
+		Name:    "inline",
+		Queries: []*v1.BenchmarkQuery{{Query: "go", RelevantDocuments: []string{"go channels"}}},
+	}
+	// 同名数据集的结果会互相覆盖
+	_, err := bench.BenchmarkModel(context.Background(), &v1.BenchmarkModelRequest{
+		ModelName: testModel,
+		Config:    &v1.BenchmarkConfig{Datasets: []*v1.BenchmarkDataset{dataset, dataset}},
+	})
+	if errors.Reason(err) != commonv1.ErrorCode_ERROR_CODE_BAD_REQUEST.String() {
+		t.Errorf("err = %v, want a bad request", err)
+	}
+	if scorer.calls != 0 {
+		t.Errorf("scored %d documents before rejecting the request", scorer.calls)
+	}
+}
+
+func TestEvaluateRanking(t *testing.T) {
+	c := &BenchmarkCase{Documents: []*JudgedDocument{
+		{Relevance: 0}, {Relevance: 2}, {Relevance: 1}, {Relevance: 0},
+	}}
+	tests := []struct {
+		name   string
+		ranked []int
+		want   map[string]float64
+	}{
+		{"ideal", []int{1, 2, 0, 3}, map[string]float64{MetricNDCG: 1, MetricMRR: 1, MetricMAP: 1, MetricAccuracy: 1}},
+		{"reversed", []int{3, 0, 2, 1}, map[string]float64{MetricMRR: 1.0 / 3, MetricAccuracy: 0}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m := evaluateRanking(c, tt.ranked)
+			for name, want := range tt.want {
+				if got := m.values[name]; !approxEqual(got, want) {
+					t.Errorf("%s = %v, want %v", name, got, want)
+				}
+			}
+		})
+	}
+}
+
+func TestPercentile(t *testing.T) {
+	values := []float64{5, 1, 4, 2, 3}
+	if got := percentile(values, 0.95); got != 5 {
+		t.Errorf("p95 = %v, want 5", got)
+	}
+	if got := percentile(values, 0.5); got != 3 {
+		t.Errorf("p50 = %v, want 3", got)
+	}
+	if got := percentile(nil, 0.5); got != 0 {
+		t.Errorf("empty p50 = %v, want 0", got)
+	}
+}
+
+func approxEqual(a, b float64) bool {
+	d := a - b
+	return d < 1e-9 && d > -1e-9
+}
@@ -0,0 +1,140 @@
+package biz
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"strconv"
+	"sync/atomic"
+	"time"
+
+	v1 "rag/api/reranker/v1"
+
+	"github.com/golang/protobuf/proto"
+	"golang.org/x/sync/singleflight"
+)
+
+// ScoreCache stores relevance scores keyed by model configuration and input
+type ScoreCache interface {
+	// 读取缓存的分数
+	Get(ctx context.Context, key string) (float32, bool)
+	// 写入分数并设置过期时间
+	Set(ctx context.Context, key string, score float32, ttl time.Duration)
+}
+
+// scorerPool shares the score cache and in-flight calls across requests
+type scorerPool struct {
+	base  RelevanceScorer
+	cache ScoreCache
+	group singleflight.Group
+}
+
+func newScorerPool(base RelevanceScorer, cache ScoreCache) *scorerPool {
+	return &scorerPool{base: base, cache: cache}
+}
+
+// session returns a request-scoped scorer honoring the model's performance settings
+func (p *scorerPool) session(model *v1.ModelInfo) *cachedScorer {
+	perf := model.GetCurrentConfiguration().GetPerformance()
+	s := &cachedScorer{
+		pool:      p,
+		namespace: modelFingerprint(model),
+		caching:   perf.GetEnableCaching() && p.cache != nil,
+		ttl:       time.Duration(perf.GetCacheTtlSeconds()) * time.Second,
+	}
+	if s.ttl <= 0 {
+		s.ttl = defaultCacheTTLSeconds * time.Second
+	}
+	return s
+}
+
+// uncached returns a scorer that calls the model for every score, without
+// the cache or coalescing with other callers
+func (p *scorerPool) uncached(model *v1.ModelInfo) *cachedScorer {
+	return &cachedScorer{
+		pool:      p,
+		namespace: modelFingerprint(model),
+		direct:    true,
+	}
+}
+
+// cachedScorer implements RelevanceScorer with caching and request coalescing
+type cachedScorer struct {
+	pool      *scorerPool
+	namespace string
+	caching   bool
+	ttl       time.Duration
+	// 直接调用模型，不经过缓存和合并
+	direct bool
+
+	hits      int64
+	misses    int64
+	coalesced int64
+}
+
+// Score returns the cached score or computes it once for all concurrent callers
+func (s *cachedScorer) Score(ctx context.Context, query, content string) (float32, error) {
+	if s.direct {
+		return s.pool.base.Score(ctx, query, content)
+	}
+	key := s.namespace + ":" + pairKey(query, content)
+	if s.caching {
+		if score, ok := s.pool.cache.Get(ctx, key); ok {
+			atomic.AddInt64(&s.hits, 1)
+			return score, nil
+		}
+		atomic.AddInt64(&s.misses, 1)
+	}
+
+	// 共享调用不受单个调用方取消的影响，调用方各自等待自己的 context
+	shared := context.WithoutCancel(ctx)
+	ch := s.pool.group.DoChan(key, func() (interface{}, error) {
+		score, err := s.pool.base.Score(shared, query, content)
+		if err == nil && s.caching {
+			s.pool.cache.Set(shared, key, score, s.ttl)
+		}
+		return score, err
+	})
+	select {
+	case <-ctx.Done():
+		return 0, ctx.Err()
+	case res := <-ch:
+		if res.Shared {
+			atomic.AddInt64(&s.coalesced, 1)
+		}
+		if res.Err != nil {
+			return 0, res.Err
+		}
+		return res.Val.(float32), nil
+	}
+}
+
+// report writes the cache statistics of this session into debug info
+func (s *cachedScorer) report(debugInfo map[string]string) {
+	debugInfo["cache_enabled"] = strconv.FormatBool(s.caching)
+	debugInfo["cache_hits"] = strconv.FormatInt(atomic.LoadInt64(&s.hits), 10)
+	debugInfo["cache_misses"] = strconv.FormatInt(atomic.LoadInt64(&s.misses), 10)
+	debugInfo["coalesced_requests"] = strconv.FormatInt(atomic.LoadInt64(&s.coalesced), 10)
+}
+
+// modelFingerprint identifies a model together with its current configuration
+func modelFingerprint(model *v1.ModelInfo) string {
+	h := sha256.New()
+	if config := model.GetCurrentConfiguration(); config != nil {
+		buf := proto.NewBuffer(nil)
+		buf.SetDeterministic(true)
+		if err := buf.Marshal(config); err == nil {
+			h.Write(buf.Bytes())
+		}
+	}
+	return model.GetName() + "@" + hex.EncodeToString(h.Sum(nil)[:8])
+}
+
+// pairKey hashes a query and document pair
+func pairKey(query, content string) string {
+	h := sha256.New()
+	h.Write([]byte(query))
+	h.Write([]byte{0})
+	h.Write([]byte(content))
+	return hex.EncodeToString(h.Sum(nil))
+}
@@ -0,0 +1,308 @@
+package biz
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"sort"
+	"strconv"
+)
+
+// 排序方法名称
+const (
+	MethodCrossEncoder = "cross_encoder"
+	MethodPointwise    = "pointwise"
+	MethodPairwise     = "pairwise"
+	MethodListwise     = "listwise"
+)
+
+const (
+	defaultPairwiseMaxDocuments = 30
+	defaultListwiseWindowSize   = 4
+	defaultListwiseStep         = 2
+	bradleyTerryIterations      = 100
+	bradleyTerryTolerance       = 1e-6
+	bradleyTerryPriorWins       = 0.01
+)
+
+// Candidate is a document being reranked
+type Candidate struct {
+	Index        int
+	DocumentID   string
+	ChunkID      string
+	Content      string
+	InitialScore float32
+	OriginalRank int32
+}
+
+// RankRequest carries the inputs of a single ranking run
+type RankRequest struct {
+	Query      string
+	Candidates []*Candidate
+	Params     map[string]string
+	// 本次请求使用的逐点评分器，带有模型级缓存
+	Scorer RelevanceScorer
+	// 基于 Scorer 的成对比较器
+	Judge PairwiseJudge
+}
+
+// RankingMethod scores candidates for a query; higher scores rank first
+type RankingMethod interface {
+	// 方法名称
+	Name() string
+	// 返回与 Candidates 一一对应的分数
+	Rank(ctx context.Context, req *RankRequest) ([]float32, error)
+}
+
+// pointwiseMethod scores each candidate independently with the request scorer
+type pointwiseMethod struct{}
+
+func (m *pointwiseMethod) Name() string { return MethodPointwise }
+
+func (m *pointwiseMethod) Rank(ctx context.Context, req *RankRequest) ([]float32, error) {
+	scores := make([]float32, len(req.Candidates))
+	for i, c := range req.Candidates {
+		score, err := req.Scorer.Score(ctx, req.Query, c.Content)
+		if err != nil {
+			return nil, err
+		}
+		scores[i] = score
+	}
+	return scores, nil
+}
+
+// pairwiseMethod compares every pair of candidates with the request judge and
+// aggregates the outcomes by Bradley–Terry strengths or tournament win counts.
+// Candidates beyond pairwise_max_documents are not compared; they rank after
+// the compared ones in their initial order.
+type pairwiseMethod struct{}
+
+func (m *pairwiseMethod) Name() string { return MethodPairwise }
+
+func (m *pairwiseMethod) Rank(ctx context.Context, req *RankRequest) ([]float32, error) {
+	// 只对初始排名靠前的文档做两两比较，其余文档保持原有顺序排在后面
+	judged := byInitialOrder(req.Candidates)
+	if limit := intParam(req.Params, "pairwise_max_documents", defaultPairwiseMaxDocuments); len(judged) > limit {
+		judged = judged[:limit]
+	}
+
+	n := len(judged)
+	wins := make([][]float64, n)
+	for i := range wins {
+		wins[i] = make([]float64, n)
+	}
+	for i := 0; i < n; i++ {
+		for j := i + 1; j < n; j++ {
+			p, err := req.Judge.Compare(ctx, req.Query, judged[i].Content, judged[j].Content)
+			if err != nil {
+				return nil, err
+			}
+			wins[i][j] = p
+			wins[j][i] = 1 - p
+		}
+	}
+
+	var strengths []float64
+	switch aggregation := req.Params["pairwise_aggregation"]; aggregation {
+	case "", "bradley_terry":
+		strengths = bradleyTerry(wins)
+	case "tournament":
+		strengths = tournament(wins)
+	default:
+		return nil, fmt.Errorf("unknown pairwise aggregation: %s", aggregation)
+	}
+
+	scores := make([]float32, len(req.Candidates))
+	rest := byInitialOrder(req.Candidates)[n:]
+	if len(rest) == 0 {
+		for i, c := range judged {
+			scores[c.Index] = float32(strengths[i])
+		}
+		return scores, nil
+	}
+	// 比较过的文档占上半区间，未比较的按初始顺序线性递减排在下半区间，
+	// 全败的文档也不会与未比较的文档并列
+	for i, c := range judged {
+		scores[c.Index] = float32(0.5 + 0.5*strengths[i])
+	}
+	for rank, c := range rest {
+		scores[c.Index] = 0.5 * float32(len(rest)-rank) / float32(len(rest)+1)
+	}
+	return scores, nil
+}
+
+// listwiseMethod slides a window from the bottom of the list to the top and
+// permutes each window, so strong documents bubble up across windows.
+type listwiseMethod struct{}
+
+func (m *listwiseMethod) Name() string { return MethodListwise }
+
+func (m *listwiseMethod) Rank(ctx context.Context, req *RankRequest) ([]float32, error) {
+	window := intParam(req.Params, "listwise_window_size", defaultListwiseWindowSize)
+	step := intParam(req.Params, "listwise_step", defaultListwiseStep)
+	if window < 2 {
+		window = 2
+	}
+	if step < 1 || step >= window {
+		step = window / 2
+	}
+
+	order := byInitialOrder(req.Candidates)
+	n := len(order)
+	for end := n; end > 0; end -= step {
+		start := end - window
+		if start < 0 {
+			start = 0
+		}
+		if err := m.permute(ctx, req.Judge, req.Query, order[start:end]); err != nil {
+			return nil, err
+		}
+		if start == 0 {
+			break
+		}
+	}
+
+	// 排列方法只给出顺序，分数按最终名次线性递减
+	scores := make([]float32, len(req.Candidates))
+	for rank, c := range order {
+		scores[c.Index] = float32(n-rank) / float32(n)
+	}
+	return scores, nil
+}
+
+// permute reorders a window in place by the number of pairwise wins
+func (m *listwiseMethod) permute(ctx context.Context, judge PairwiseJudge, query string, window []*Candidate) error {
+	wins := make([]float64, len(window))
+	for i := 0; i < len(window); i++ {
+		for j := i + 1; j < len(window); j++ {
+			p, err := judge.Compare(ctx, query, window[i].Content, window[j].Content)
+			if err != nil {
+				return err
+			}
+			wins[i] += p
+			wins[j] += 1 - p
+		}
+	}
+
+	positions := make([]int, len(window))
+	for i := range positions {
+		positions[i] = i
+	}
+	sort.SliceStable(positions, func(a, b int) bool {
+		return wins[positions[a]] > wins[positions[b]]
+	})
+
+	permuted := make([]*Candidate, len(window))
+	for i, pos := range positions {
+		permuted[i] = window[pos]
+	}
+	copy(window, permuted)
+	return nil
+}
+
+// bradleyTerry fits Bradley–Terry strengths to a soft win matrix with the
+// minorization-maximization updates and returns them scaled to [0, 1].
+func bradleyTerry(wins [][]float64) []float64 {
+	n := len(wins)
+	strengths := make([]float64, n)
+	if n == 0 {
+		return strengths
+	}
+	if n == 1 {
+		strengths[0] = 1
+		return strengths
+	}
+
+	totalWins := make([]float64, n)
+	for i := range wins {
+		for j := range wins[i] {
+			if i != j {
+				totalWins[i] += wins[i][j]
+			}
+		}
+		// 先验胜场，避免全败的文档强度收敛到 0
+		totalWins[i] += bradleyTerryPriorWins
+		strengths[i] = 1
+	}
+
+	next := make([]float64, n)
+	for iter := 0; iter < bradleyTerryIterations; iter++ {
+		var sum float64
+		for i := 0; i < n; i++ {
+			var denom float64
+			for j := 0; j < n; j++ {
+				if i != j {
+					denom += 1 / (strengths[i] + strengths[j])
+				}
+			}
+			next[i] = totalWins[i] / denom
+			sum += next[i]
+		}
+
+		var delta float64
+		for i := range next {
+			next[i] *= float64(n) / sum
+			delta = math.Max(delta, math.Abs(next[i]-strengths[i]))
+		}
+		strengths, next = next, strengths
+		if delta < bradleyTerryTolerance {
+			break
+		}
+	}
+	return scaleToUnit(strengths)
+}
+
+// tournament scores each candidate by its share of won comparisons
+func tournament(wins [][]float64) []float64 {
+	n := len(wins)
+	scores := make([]float64, n)
+	if n == 1 {
+		scores[0] = 1
+		return scores
+	}
+	for i := range wins {
+		for j := range wins[i] {
+			if i != j {
+				scores[i] += wins[i][j]
+			}
+		}
+		scores[i] /= float64(n - 1)
+	}
+	return scores
+}
+
+// scaleToUnit divides values by their maximum
+func scaleToUnit(values []float64) []float64 {
+	var max float64
+	for _, v := range values {
+		max = math.Max(max, v)
+	}
+	if max == 0 {
+		return values
+	}
+	for i := range values {
+		values[i] /= max
+	}
+	return values
+}
+
+// byInitialOrder returns candidates ordered by original rank, then initial score
+func byInitialOrder(candidates []*Candidate) []*Candidate {
+	ordered := make([]*Candidate, len(candidates))
+	copy(ordered, candidates)
+	sort.SliceStable(ordered, func(i, j int) bool {
+		if ordered[i].OriginalRank != ordered[j].OriginalRank {
+			return ordered[i].OriginalRank < ordered[j].OriginalRank
+		}
+		return ordered[i].InitialScore > ordered[j].InitialScore
+	})
+	return ordered
+}
+
+// intParam reads a positive integer parameter, falling back to def
+func intParam(params map[string]string, key string, def int) int {
+	if v, err := strconv.Atoi(params[key]); err == nil && v > 0 {
+		return v
+	}
+	return def
+}
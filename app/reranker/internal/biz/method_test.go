@@ -0,0 +1,135 @@
+package biz
+
+import (
+	"context"
+	"testing"
+)
+
+func TestBradleyTerry(t *testing.T) {
+	tests := []struct {
+		name string
+		wins [][]float64
+		want []int // 按强度从高到低的下标
+	}{
+		{"transitive", [][]float64{
+			{0, 0.1, 0.2},
+			{0.9, 0, 0.7},
+			{0.8, 0.3, 0},
+		}, []int{1, 2, 0}},
+		{"single", [][]float64{{0}}, []int{0}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			strengths := bradleyTerry(tt.wins)
+			for i := 1; i < len(tt.want); i++ {
+				if strengths[tt.want[i-1]] <= strengths[tt.want[i]] {
+					t.Errorf("strengths %v do not rank %v", strengths, tt.want)
+				}
+			}
+			if max := strengths[tt.want[0]]; max != 1 {
+				t.Errorf("strongest = %v, want 1", max)
+			}
+		})
+	}
+}
+
+func TestBradleyTerryKeepsLosersPositive(t *testing.T) {
+	strengths := bradleyTerry([][]float64{{0, 1}, {0, 0}})
+	if strengths[1] <= 0 {
+		t.Errorf("loser strength = %v, want > 0", strengths[1])
+	}
+}
+
+func TestTournament(t *testing.T) {
+	got := tournament([][]float64{
+		{0, 1, 1},
+		{0, 0, 0.5},
+		{0, 0.5, 0},
+	})
+	want := []float64{1, 0.25, 0.25}
+	for i := range want {
+		if !approxEqual(got[i], want[i]) {
+			t.Errorf("tournament = %v, want %v", got, want)
+			break
+		}
+	}
+}
+
+func TestListwiseBubblesUpAcrossWindows(t *testing.T) {
+	// 最相关的文档初始排在最后，需跨多个窗口上移
+	contents := []string{"a", "b", "c", "d", "e", "go channels"}
+	candidates := make([]*Candidate, len(contents))
+	for i, content := range contents {
+		candidates[i] = &Candidate{Index: i, Content: content, OriginalRank: int32(i + 1)}
+	}
+	scorer := &countingScorer{}
+	scores, err := (&listwiseMethod{}).Rank(context.Background(), &RankRequest{
+		Query:      "go channels",
+		Candidates: candidates,
+		Params:     map[string]string{"listwise_window_size": "3", "listwise_step": "2"},
+		Scorer:     scorer,
+		Judge:      &scoreJudge{scorer: scorer},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	for i := range scores[:len(scores)-1] {
+		if scores[i] >= scores[len(scores)-1] {
+			t.Fatalf("scores = %v, the last document should rank first", scores)
+		}
+	}
+}
+
+func TestPairwiseLimitsJudgedDocuments(t *testing.T) {
+	candidates := make([]*Candidate, 5)
+	for i := range candidates {
+		candidates[i] = &Candidate{Index: i, Content: "go", OriginalRank: int32(i + 1)}
+	}
+	scorer := &countingScorer{}
+	scores, err := (&pairwiseMethod{}).Rank(context.Background(), &RankRequest{
+		Query:      "go",
+		Candidates: candidates,
+		Params:     map[string]string{"pairwise_max_documents": "3"},
+		Scorer:     scorer,
+		Judge:      &scoreJudge{scorer: scorer},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	// 超出上限的文档不参与比较，按初始顺序排在比较过的文档之后
+	for i := 1; i < len(scores); i++ {
+		if i >= 3 && scores[i] >= scores[i-1] || scores[i] <= 0 {
+			t.Errorf("scores = %v, the last 2 should follow in their initial order", scores)
+			break
+		}
+	}
+	for _, score := range scores[:3] {
+		if score <= scores[3] {
+			t.Errorf("scores = %v, a judged document ties with an unjudged one", scores)
+			break
+		}
+	}
+}
+
+func TestPairwiseRanksUnjudgedAfterLosers(t *testing.T) {
+	// 全败的文档在锦标赛聚合下得分最低，仍排在未比较的文档之前
+	contents := []string{"go channels", "weather", "go channels goroutines", "go channels select"}
+	candidates := make([]*Candidate, len(contents))
+	for i, content := range contents {
+		candidates[i] = &Candidate{Index: i, Content: content, OriginalRank: int32(i + 1)}
+	}
+	scorer := &countingScorer{}
+	scores, err := (&pairwiseMethod{}).Rank(context.Background(), &RankRequest{
+		Query:      "go channels",
+		Candidates: candidates,
+		Params:     map[string]string{"pairwise_max_documents": "2", "pairwise_aggregation": "tournament"},
+		Scorer:     scorer,
+		Judge:      &scoreJudge{scorer: scorer},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !(scores[0] > scores[1] && scores[1] > scores[2] && scores[2] > scores[3]) {
+		t.Errorf("scores = %v, want judged winner, judged loser, then unjudged in initial order", scores)
+	}
+}
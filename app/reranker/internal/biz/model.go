@@ -0,0 +1,299 @@
+package biz
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	commonv1 "rag/api/common/v1"
+	v1 "rag/api/reranker/v1"
+
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/golang/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const (
+	defaultCacheTTLSeconds = 300
+)
+
+var (
+	// ErrModelNotFound is returned when a model is not registered.
+	ErrModelNotFound = errors.NotFound(commonv1.ErrorCode_ERROR_CODE_MODEL_NOT_AVAILABLE.String(), "model not found")
+	// ErrModelUnavailable is returned when a registered model cannot serve requests.
+	ErrModelUnavailable = errors.ServiceUnavailable(commonv1.ErrorCode_ERROR_CODE_MODEL_NOT_AVAILABLE.String(), "model is not available")
+)
+
+// 已知的聚合方法
+var aggregationMethods = map[string]bool{
+	"weighted_sum":   true,
+	"max":            true,
+	"harmonic_mean":  true,
+	"geometric_mean": true,
+}
+
+// 已知的评分维度
+var scoringDimensions = map[string]bool{
+	"semantic_relevance": true,
+	"content_quality":    true,
+	"freshness":          true,
+	"authority":          true,
+}
+
+// 排序方法识别的模型参数，值为 true 表示必须是正整数
+var modelParameters = map[string]bool{
+	"method_timeout_ms":      true,
+	"pairwise_max_documents": true,
+	"pairwise_aggregation":   false,
+	"listwise_window_size":   true,
+	"listwise_step":          true,
+}
+
+// ModelRepo defines the data access interface for reranking models
+type ModelRepo interface {
+	// 获取模型信息
+	GetModel(ctx context.Context, name string) (*v1.ModelInfo, error)
+	// 更新模型的当前配置，其余字段保持不变
+	UpdateConfiguration(ctx context.Context, name string, config *v1.ModelConfiguration) error
+	// 更新模型的性能指标，其余字段保持不变
+	UpdatePerformanceMetrics(ctx context.Context, name string, metrics *v1.ModelPerformanceMetrics) error
+	// 获取默认模型
+	GetDefaultModel(ctx context.Context) (*v1.ModelInfo, error)
+	// 设置默认模型
+	SetDefaultModel(ctx context.Context, name string) error
+	// 列出模型
+	ListModels(ctx context.Context, pagination *commonv1.PaginationRequest, filters []*commonv1.Filter) ([]*v1.ModelInfo, *commonv1.PaginationResponse, error)
+}
+
+// ModelUsecase handles reranking model registry business logic
+type ModelUsecase struct {
+	repo ModelRepo
+	log  *log.Helper
+}
+
+// NewModelUsecase creates a new model usecase
+func NewModelUsecase(repo ModelRepo, logger log.Logger) *ModelUsecase {
+	return &ModelUsecase{
+		repo: repo,
+		log:  log.NewHelper(logger),
+	}
+}
+
+// ConfigureModel validates and stores a model configuration
+func (uc *ModelUsecase) ConfigureModel(ctx context.Context, req *v1.ConfigureModelRequest) (*v1.ConfigureModelResponse, error) {
+	uc.log.WithContext(ctx).Infof("Configuring model: %s", req.ModelName)
+
+	model, err := uc.repo.GetModel(ctx, req.ModelName)
+	if err != nil {
+		return nil, err
+	}
+
+	applied, warnings, err := validateConfiguration(model.Capabilities, req.Configuration)
+	if err != nil {
+		uc.log.WithContext(ctx).Warnf("Invalid configuration for model %s: %v", req.ModelName, err)
+		return nil, err
+	}
+
+	now := timestamppb.Now()
+	if err := uc.repo.UpdateConfiguration(ctx, req.ModelName, applied); err != nil {
+		uc.log.WithContext(ctx).Errorf("Failed to save model configuration: %v", err)
+		return nil, err
+	}
+
+	statusMessage := "configuration applied"
+	if req.MakeDefault {
+		if err := uc.repo.SetDefaultModel(ctx, req.ModelName); err != nil {
+			uc.log.WithContext(ctx).Errorf("Failed to set default model: %v", err)
+			return nil, err
+		}
+		statusMessage = "configuration applied, model set as default"
+	}
+
+	uc.log.WithContext(ctx).Infof("Model %s configured with %d warnings", req.ModelName, len(warnings))
+	return &v1.ConfigureModelResponse{
+		ModelName:            req.ModelName,
+		AppliedConfiguration: applied,
+		Status: &v1.ConfigurationStatus{
+			IsConfigured:  true,
+			IsActive:      model.IsAvailable,
+			StatusMessage: statusMessage,
+			ConfiguredAt:  now,
+		},
+		ValidationWarnings: warnings,
+	}, nil
+}
+
+// GetModelInfo returns a registered model
+func (uc *ModelUsecase) GetModelInfo(ctx context.Context, req *v1.GetModelInfoRequest) (*v1.GetModelInfoResponse, error) {
+	uc.log.WithContext(ctx).Infof("Getting model info: %s", req.ModelName)
+
+	model, err := uc.repo.GetModel(ctx, req.ModelName)
+	if err != nil {
+		return nil, err
+	}
+	if !req.IncludePerformanceMetrics {
+		model.PerformanceMetrics = nil
+	}
+	return &v1.GetModelInfoResponse{ModelInfo: model}, nil
+}
+
+// ListModels lists registered models with pagination and filters
+func (uc *ModelUsecase) ListModels(ctx context.Context, req *v1.ListModelsRequest) (*v1.ListModelsResponse, error) {
+	uc.log.WithContext(ctx).Info("Listing models")
+
+	models, pagination, err := uc.repo.ListModels(ctx, req.Pagination, req.Filters)
+	if err != nil {
+		uc.log.WithContext(ctx).Errorf("Failed to list models: %v", err)
+		return nil, err
+	}
+	if !req.IncludePerformanceMetrics {
+		for _, model := range models {
+			model.PerformanceMetrics = nil
+		}
+	}
+	return &v1.ListModelsResponse{
+		Models:     models,
+		Pagination: pagination,
+	}, nil
+}
+
+// ResolveModel returns the named model, or the default model when name is empty
+func (uc *ModelUsecase) ResolveModel(ctx context.Context, name string) (*v1.ModelInfo, error) {
+	var (
+		model *v1.ModelInfo
+		err   error
+	)
+	if name == "" {
+		model, err = uc.repo.GetDefaultModel(ctx)
+	} else {
+		model, err = uc.repo.GetModel(ctx, name)
+	}
+	if err != nil {
+		return nil, err
+	}
+	if !model.IsAvailable {
+		return nil, ErrModelUnavailable
+	}
+	return model, nil
+}
+
+// validateConfiguration checks a configuration against model capabilities and
+// returns the configuration to apply along with non-fatal warnings.
+func validateConfiguration(capabilities *v1.ModelCapabilities, config *v1.ModelConfiguration) (*v1.ModelConfiguration, []string, error) {
+	if capabilities == nil {
+		capabilities = &v1.ModelCapabilities{}
+	}
+	applied := &v1.ModelConfiguration{}
+	if config != nil {
+		applied = proto.Clone(config).(*v1.ModelConfiguration)
+	}
+	var warnings []string
+
+	// 自定义参数
+	if len(applied.Parameters) > 0 && !capabilities.SupportsCustomParameters {
+		warnings = append(warnings, "model does not support custom parameters, parameters were dropped")
+		applied.Parameters = nil
+	}
+	keys := make([]string, 0, len(applied.Parameters))
+	for key := range applied.Parameters {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	for _, key := range keys {
+		positiveInt, known := modelParameters[key]
+		if !known {
+			warnings = append(warnings, fmt.Sprintf("unrecognized parameter %q", key))
+			continue
+		}
+		if positiveInt {
+			if v, err := strconv.Atoi(applied.Parameters[key]); err != nil || v <= 0 {
+				return nil, nil, errors.BadRequest(commonv1.ErrorCode_ERROR_CODE_BAD_REQUEST.String(),
+					fmt.Sprintf("parameter %q must be a positive integer", key))
+			}
+		}
+	}
+	if aggregation, ok := applied.Parameters["pairwise_aggregation"]; ok && aggregation != "bradley_terry" && aggregation != "tournament" {
+		return nil, nil, errors.BadRequest(commonv1.ErrorCode_ERROR_CODE_BAD_REQUEST.String(),
+			fmt.Sprintf("unknown pairwise aggregation %q", aggregation))
+	}
+
+	// 评分维度
+	var weightSum float32
+	for _, dim := range applied.ScoringDimensions {
+		if dim.Weight < 0 || dim.Weight > 1 {
+			return nil, nil, errors.BadRequest(commonv1.ErrorCode_ERROR_CODE_BAD_REQUEST.String(),
+				fmt.Sprintf("weight of dimension %q must be within [0, 1]", dim.Name))
+		}
+		if !scoringDimensions[dim.Name] {
+			warnings = append(warnings, fmt.Sprintf("unknown scoring dimension %q", dim.Name))
+		}
+		weightSum += dim.Weight
+	}
+	if len(applied.ScoringDimensions) > 0 && (weightSum < 0.99 || weightSum > 1.01) {
+		warnings = append(warnings, fmt.Sprintf("scoring dimension weights sum to %.2f instead of 1", weightSum))
+	}
+
+	// 聚合方式
+	if agg := applied.AggregationConfig; agg != nil {
+		if !aggregationMethods[agg.Method] {
+			return nil, nil, errors.BadRequest(commonv1.ErrorCode_ERROR_CODE_BAD_REQUEST.String(),
+				fmt.Sprintf("unknown aggregation method %q", agg.Method))
+		}
+		if len(agg.Weights) > 0 && len(agg.Weights) != len(applied.ScoringDimensions) {
+			warnings = append(warnings, fmt.Sprintf("aggregation has %d weights for %d scoring dimensions",
+				len(agg.Weights), len(applied.ScoringDimensions)))
+		}
+	}
+
+	// 性能设置
+	if perf := applied.Performance; perf != nil {
+		if perf.MaxSequenceLength < 0 || perf.BatchSize < 0 || perf.CacheTtlSeconds < 0 {
+			return nil, nil, errors.BadRequest(commonv1.ErrorCode_ERROR_CODE_BAD_REQUEST.String(),
+				"performance settings must not be negative")
+		}
+		if capabilities.MaxSequenceLength > 0 && perf.MaxSequenceLength > capabilities.MaxSequenceLength {
+			warnings = append(warnings, fmt.Sprintf("max_sequence_length %d exceeds model limit, clamped to %d",
+				perf.MaxSequenceLength, capabilities.MaxSequenceLength))
+			perf.MaxSequenceLength = capabilities.MaxSequenceLength
+		}
+		if perf.BatchSize > 1 && !capabilities.SupportsBatchProcessing {
+			warnings = append(warnings, "model does not support batch processing, batch_size set to 1")
+			perf.BatchSize = 1
+		}
+		if perf.EnableCaching && perf.CacheTtlSeconds == 0 {
+			warnings = append(warnings, fmt.Sprintf("cache_ttl_seconds not set, defaulting to %d", defaultCacheTTLSeconds))
+			perf.CacheTtlSeconds = defaultCacheTTLSeconds
+		}
+	}
+
+	return applied, warnings, nil
+}
+
+// supportsMethod reports whether the model can rank with the given method
+func supportsMethod(model *v1.ModelInfo, method string) bool {
+	methods := model.GetCapabilities().GetRankingMethods()
+	if len(methods) == 0 {
+		return true
+	}
+	for _, m := range methods {
+		if strings.EqualFold(m, method) {
+			return true
+		}
+	}
+	return false
+}
+
+// modelParametersFor merges configured model parameters with request overrides
+func modelParametersFor(model *v1.ModelInfo, overrides map[string]string) map[string]string {
+	params := make(map[string]string)
+	for k, v := range model.GetCurrentConfiguration().GetParameters() {
+		params[k] = v
+	}
+	for k, v := range overrides {
+		params[k] = v
+	}
+	return params
+}
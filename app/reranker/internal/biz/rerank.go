@@ -0,0 +1,439 @@
+package biz
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	commonv1 "rag/api/common/v1"
+	v1 "rag/api/reranker/v1"
+
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/golang/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const (
+	defaultMethodTimeout = 3 * time.Second
+)
+
+var (
+	// ErrEmptyQuery is returned when the rerank query is empty.
+	ErrEmptyQuery = errors.BadRequest(commonv1.ErrorCode_ERROR_CODE_INVALID_QUERY.String(), "query must not be empty")
+	// ErrNoDocuments is returned when there is nothing to rerank.
+	ErrNoDocuments = errors.BadRequest(commonv1.ErrorCode_ERROR_CODE_BAD_REQUEST.String(), "documents must not be empty")
+)
+
+// RelevanceScorer scores a single query-document pair
+type RelevanceScorer interface {
+	// 计算查询与文档内容的相关性分数，范围 [0, 1]
+	Score(ctx context.Context, query, content string) (float32, error)
+}
+
+// PairwiseJudge decides which of two documents better answers a query
+type PairwiseJudge interface {
+	// 返回文档 a 比文档 b 更相关的概率，范围 [0, 1]
+	Compare(ctx context.Context, query, a, b string) (float64, error)
+}
+
+// JudgeFactory builds the pairwise judge of a request on top of the
+// request's scorer, so comparisons share its cache and coalescing
+type JudgeFactory func(scorer RelevanceScorer) PairwiseJudge
+
+// RerankUsecase handles document reranking business logic
+type RerankUsecase struct {
+	methods map[string]RankingMethod
+	scorers *scorerPool
+	judges  JudgeFactory
+	models  *ModelUsecase
+	log     *log.Helper
+}
+
+// NewRerankUsecase creates a new rerank usecase
+func NewRerankUsecase(scorer RelevanceScorer, judges JudgeFactory, cache ScoreCache, models *ModelUsecase, logger log.Logger) *RerankUsecase {
+	pointwise := &pointwiseMethod{}
+	return &RerankUsecase{
+		methods: map[string]RankingMethod{
+			MethodCrossEncoder: pointwise,
+			MethodPointwise:    pointwise,
+			MethodPairwise:     &pairwiseMethod{},
+			MethodListwise:     &listwiseMethod{},
+		},
+		scorers: newScorerPool(scorer, cache),
+		judges:  judges,
+		models:  models,
+		log:     log.NewHelper(logger),
+	}
+}
+
+// RerankDocuments reorders documents by relevance, falling back through the
+// strategy's fallback methods when the primary method errors or times out.
+func (uc *RerankUsecase) RerankDocuments(ctx context.Context, req *v1.RerankDocumentsRequest) (*v1.RerankDocumentsResponse, error) {
+	return uc.rerank(ctx, uc.scorers.session, req)
+}
+
+// rerank runs RerankDocuments with the scorer that session gives for the model
+func (uc *RerankUsecase) rerank(ctx context.Context, session func(model *v1.ModelInfo) *cachedScorer, req *v1.RerankDocumentsRequest) (*v1.RerankDocumentsResponse, error) {
+	startTime := time.Now()
+	if strings.TrimSpace(req.Query) == "" {
+		return nil, ErrEmptyQuery
+	}
+	if len(req.Documents) == 0 {
+		return nil, ErrNoDocuments
+	}
+
+	options := req.Options
+	if options == nil {
+		options = &v1.RerankingOptions{}
+	}
+	model, err := uc.models.ResolveModel(ctx, options.ModelName)
+	if err != nil {
+		return nil, err
+	}
+	modelName := model.Name
+	params := modelParametersFor(model, options.ModelParameters)
+	uc.log.WithContext(ctx).Infof("Reranking %d documents with model %s", len(req.Documents), modelName)
+
+	candidates := make([]*Candidate, len(req.Documents))
+	for i, doc := range req.Documents {
+		originalRank := doc.OriginalRank
+		if originalRank <= 0 {
+			originalRank = int32(i + 1)
+		}
+		candidates[i] = &Candidate{
+			Index:        i,
+			DocumentID:   doc.DocumentId,
+			ChunkID:      doc.ChunkId,
+			Content:      doc.Content,
+			InitialScore: doc.InitialScore,
+			OriginalRank: originalRank,
+		}
+	}
+
+	// 依次尝试主方法和降级方法
+	chain := methodChain(options.Strategy)
+	scorer := session(model)
+	rankReq := &RankRequest{
+		Query:      req.Query,
+		Candidates: candidates,
+		Params:     params,
+		Scorer:     scorer,
+		Judge:      uc.judges(scorer),
+	}
+	debugInfo := make(map[string]string)
+	var (
+		scores  []float32
+		applied = -1
+		failed  []string
+	)
+	for i, name := range chain {
+		method, ok := uc.methods[name]
+		if !ok {
+			failed = append(failed, fmt.Sprintf("%s: unsupported method", name))
+			continue
+		}
+		if !supportsMethod(model, name) {
+			failed = append(failed, fmt.Sprintf("%s: not supported by model %s", name, modelName))
+			continue
+		}
+		scores, err = uc.runMethod(ctx, method, rankReq)
+		if err == nil {
+			applied = i
+			break
+		}
+		uc.log.WithContext(ctx).Warnf("Ranking method %s failed: %v", name, err)
+		failed = append(failed, fmt.Sprintf("%s: %v", name, err))
+		if ctx.Err() != nil {
+			break
+		}
+	}
+	if len(failed) > 0 {
+		debugInfo["failed_methods"] = strings.Join(failed, "; ")
+	}
+	if applied < 0 {
+		return nil, errors.InternalServer(commonv1.ErrorCode_ERROR_CODE_RERANKING_FAILED.String(),
+			"all ranking methods failed: "+strings.Join(failed, "; ")).WithMetadata(debugInfo)
+	}
+
+	appliedStrategy := &v1.RerankingStrategy{}
+	if options.Strategy != nil {
+		appliedStrategy = proto.Clone(options.Strategy).(*v1.RerankingStrategy)
+	}
+	appliedStrategy.PrimaryMethod = chain[applied]
+	appliedStrategy.FallbackMethods = chain[applied+1:]
+	if applied > 0 {
+		debugInfo["fallback_used"] = "true"
+	}
+
+	scorer.report(debugInfo)
+
+	ranked := rankCandidates(candidates, scores, options)
+	uc.log.WithContext(ctx).Infof("Reranked %d documents with %s, returning %d", len(candidates), chain[applied], len(ranked))
+
+	return &v1.RerankDocumentsResponse{
+		RankedDocuments: ranked,
+		Metadata: &v1.RerankingMetadata{
+			ModelUsed:         modelName,
+			TotalDocuments:    int32(len(req.Documents)),
+			RerankedDocuments: int32(len(ranked)),
+			RerankingTimeMs:   time.Since(startTime).Milliseconds(),
+			AppliedStrategy:   appliedStrategy,
+			DebugInfo:         debugInfo,
+			ProcessedAt:       timestamppb.Now(),
+		},
+	}, nil
+}
+
+// ScoreRelevance scores a single document against a query. Concurrent
+// identical calls share one scoring run.
+func (uc *RerankUsecase) ScoreRelevance(ctx context.Context, req *v1.ScoreRelevanceRequest) (*v1.ScoreRelevanceResponse, error) {
+	startTime := time.Now()
+	if strings.TrimSpace(req.Query) == "" {
+		return nil, ErrEmptyQuery
+	}
+
+	options := req.Options
+	if options == nil {
+		options = &v1.ScoringOptions{}
+	}
+	model, err := uc.models.ResolveModel(ctx, options.ModelName)
+	if err != nil {
+		return nil, err
+	}
+
+	scorer := uc.scorers.session(model)
+	score, err := scorer.Score(ctx, req.Query, req.DocumentContent)
+	if err != nil {
+		uc.log.WithContext(ctx).Errorf("Failed to score relevance: %v", err)
+		return nil, errors.InternalServer(commonv1.ErrorCode_ERROR_CODE_RERANKING_FAILED.String(), err.Error())
+	}
+
+	debugInfo := make(map[string]string)
+	scorer.report(debugInfo)
+	aspects, unsupported := aspectScores(model, options.ScoringAspects, score)
+	if len(unsupported) > 0 {
+		debugInfo["unsupported_aspects"] = strings.Join(unsupported, ",")
+	}
+
+	var explanation *v1.ScoringExplanation
+	if options.IncludeExplanation {
+		explanation = &v1.ScoringExplanation{
+			Summary: fmt.Sprintf("relevance %.3f scored by %s", score, model.Name),
+		}
+	}
+
+	return &v1.ScoreRelevanceResponse{
+		RelevanceScore: score,
+		AspectScores:   aspects,
+		Explanation:    explanation,
+		Metadata: &v1.ScoringMetadata{
+			ModelUsed:     model.Name,
+			ScoringTimeMs: time.Since(startTime).Milliseconds(),
+			ModelVersion:  model.Version,
+			DebugInfo:     debugInfo,
+		},
+	}, nil
+}
+
+// ScoreBatchRelevance scores many documents against one query, reporting
+// failures per document instead of failing the whole batch.
+func (uc *RerankUsecase) ScoreBatchRelevance(ctx context.Context, req *v1.ScoreBatchRelevanceRequest) (*v1.ScoreBatchRelevanceResponse, error) {
+	startedAt := time.Now()
+	if strings.TrimSpace(req.Query) == "" {
+		return nil, ErrEmptyQuery
+	}
+	if len(req.DocumentContents) == 0 {
+		return nil, ErrNoDocuments
+	}
+
+	options := req.Options
+	if options == nil {
+		options = &v1.ScoringOptions{}
+	}
+	model, err := uc.models.ResolveModel(ctx, options.ModelName)
+	if err != nil {
+		return nil, err
+	}
+	uc.log.WithContext(ctx).Infof("Scoring batch of %d documents with model %s", len(req.DocumentContents), model.Name)
+
+	scorer := uc.scorers.session(model)
+	results := make([]*v1.BatchScoringResult, len(req.DocumentContents))
+	var successful, failed int32
+	for i, content := range req.DocumentContents {
+		result := &v1.BatchScoringResult{Index: int32(i)}
+		score, err := scorer.Score(ctx, req.Query, content)
+		if err != nil {
+			result.Status = commonv1.ProcessingStatus_PROCESSING_STATUS_FAILED
+			result.ErrorMessage = err.Error()
+			failed++
+		} else {
+			result.RelevanceScore = score
+			result.AspectScores, _ = aspectScores(model, options.ScoringAspects, score)
+			result.Status = commonv1.ProcessingStatus_PROCESSING_STATUS_COMPLETED
+			successful++
+		}
+		results[i] = result
+	}
+
+	completedAt := time.Now()
+	return &v1.ScoreBatchRelevanceResponse{
+		BatchId: req.BatchId,
+		Results: results,
+		Metadata: &v1.BatchScoringMetadata{
+			TotalDocuments:     int32(len(req.DocumentContents)),
+			SuccessfulScores:   successful,
+			FailedScores:       failed,
+			ModelUsed:          model.Name,
+			TotalScoringTimeMs: completedAt.Sub(startedAt).Milliseconds(),
+			StartedAt:          timestamppb.New(startedAt),
+			CompletedAt:        timestamppb.New(completedAt),
+		},
+	}, nil
+}
+
+// runMethod runs a ranking method under its own timeout
+func (uc *RerankUsecase) runMethod(ctx context.Context, method RankingMethod, req *RankRequest) ([]float32, error) {
+	timeout := defaultMethodTimeout
+	if ms := intParam(req.Params, "method_timeout_ms", 0); ms > 0 {
+		timeout = time.Duration(ms) * time.Millisecond
+	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	scores, err := method.Rank(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	// 方法自身未检查 context 时，超时结果同样视为失败
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	return scores, nil
+}
+
+// methodChain returns the primary method followed by distinct fallback methods
+func methodChain(strategy *v1.RerankingStrategy) []string {
+	primary := MethodPointwise
+	var fallbacks []string
+	if strategy != nil {
+		if strategy.PrimaryMethod != "" {
+			primary = strategy.PrimaryMethod
+		}
+		fallbacks = strategy.FallbackMethods
+	}
+
+	chain := []string{primary}
+	seen := map[string]bool{primary: true}
+	for _, name := range fallbacks {
+		if name != "" && !seen[name] {
+			seen[name] = true
+			chain = append(chain, name)
+		}
+	}
+	return chain
+}
+
+// rankCandidates sorts candidates by score and applies normalization, threshold and top_k
+func rankCandidates(candidates []*Candidate, scores []float32, options *v1.RerankingOptions) []*v1.RankedDocument {
+	finalScores := make([]float32, len(scores))
+	copy(finalScores, scores)
+	if options.NormalizeScores {
+		normalizeMinMax(finalScores)
+	}
+
+	order := make([]*Candidate, len(candidates))
+	copy(order, candidates)
+	sort.SliceStable(order, func(i, j int) bool {
+		si, sj := finalScores[order[i].Index], finalScores[order[j].Index]
+		if si != sj {
+			return si > sj
+		}
+		if options.PreserveOrderForTies {
+			return order[i].OriginalRank < order[j].OriginalRank
+		}
+		return order[i].InitialScore > order[j].InitialScore
+	})
+
+	var ranked []*v1.RankedDocument
+	for _, c := range order {
+		score := finalScores[c.Index]
+		if options.ScoreThreshold > 0 && score < options.ScoreThreshold {
+			continue
+		}
+		if options.TopK > 0 && int32(len(ranked)) >= options.TopK {
+			break
+		}
+		ranked = append(ranked, &v1.RankedDocument{
+			DocumentId:       c.DocumentID,
+			ChunkId:          c.ChunkID,
+			Content:          c.Content,
+			RerankScore:      score,
+			InitialScore:     c.InitialScore,
+			NewRank:          int32(len(ranked) + 1),
+			OriginalRank:     c.OriginalRank,
+			ScoreImprovement: score - c.InitialScore,
+			RankingDetails: &v1.RankingDetails{
+				FeatureScores: map[string]float32{"method_score": scores[c.Index]},
+			},
+		})
+	}
+	return ranked
+}
+
+// normalizeMinMax rescales scores to [0, 1] in place
+func normalizeMinMax(scores []float32) {
+	if len(scores) == 0 {
+		return
+	}
+	min, max := scores[0], scores[0]
+	for _, s := range scores {
+		if s < min {
+			min = s
+		}
+		if s > max {
+			max = s
+		}
+	}
+	for i := range scores {
+		if max > min {
+			scores[i] = (scores[i] - min) / (max - min)
+		} else {
+			scores[i] = 1
+		}
+	}
+}
+
+// aspectScores reports the score under each requested aspect the model supports
+func aspectScores(model *v1.ModelInfo, requested []string, score float32) ([]*v1.AspectScore, []string) {
+	supported := model.GetCapabilities().GetScoringAspects()
+	if len(requested) == 0 {
+		requested = supported
+	}
+
+	var (
+		aspects     []*v1.AspectScore
+		unsupported []string
+	)
+	for _, name := range requested {
+		found := false
+		for _, s := range supported {
+			if s == name {
+				found = true
+				break
+			}
+		}
+		if !found {
+			unsupported = append(unsupported, name)
+			continue
+		}
+		aspects = append(aspects, &v1.AspectScore{
+			AspectName: name,
+			Score:      score,
+			Confidence: 1,
+		})
+	}
+	return aspects, unsupported
+}
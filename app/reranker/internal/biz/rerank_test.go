@@ -0,0 +1,202 @@
+package biz
+
+import (
+	"context"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	commonv1 "rag/api/common/v1"
+	v1 "rag/api/reranker/v1"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/golang/protobuf/proto"
+)
+
+const testModel = "test-model"
+
+// countingScorer scores by how many query words a document contains
+type countingScorer struct {
+	calls int64
+}
+
+func (s *countingScorer) Score(ctx context.Context, query, content string) (float32, error) {
+	atomic.AddInt64(&s.calls, 1)
+	var score float32
+	for _, word := range strings.Fields(query) {
+		if strings.Contains(content, word) {
+			score++
+		}
+	}
+	return score / float32(len(strings.Fields(query))), nil
+}
+
+// scoreJudge compares two documents by their scores
+type scoreJudge struct {
+	scorer RelevanceScorer
+}
+
+func (j *scoreJudge) Compare(ctx context.Context, query, a, b string) (float64, error) {
+	sa, err := j.scorer.Score(ctx, query, a)
+	if err != nil {
+		return 0, err
+	}
+	sb, err := j.scorer.Score(ctx, query, b)
+	if err != nil {
+		return 0, err
+	}
+	switch {
+	case sa > sb:
+		return 1, nil
+	case sa < sb:
+		return 0, nil
+	}
+	return 0.5, nil
+}
+
+type mapCache struct {
+	mu     sync.Mutex
+	scores map[string]float32
+}
+
+func (c *mapCache) Get(ctx context.Context, key string) (float32, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	score, ok := c.scores[key]
+	return score, ok
+}
+
+func (c *mapCache) Set(ctx context.Context, key string, score float32, ttl time.Duration) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.scores[key] = score
+}
+
+type memoryModelRepo struct {
+	mu    sync.Mutex
+	model *v1.ModelInfo
+}
+
+func (r *memoryModelRepo) GetModel(ctx context.Context, name string) (*v1.ModelInfo, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if name != r.model.Name {
+		return nil, ErrModelNotFound
+	}
+	return proto.Clone(r.model).(*v1.ModelInfo), nil
+}
+
+func (r *memoryModelRepo) UpdateConfiguration(ctx context.Context, name string, config *v1.ModelConfiguration) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.model.CurrentConfiguration = config
+	return nil
+}
+
+func (r *memoryModelRepo) UpdatePerformanceMetrics(ctx context.Context, name string, metrics *v1.ModelPerformanceMetrics) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.model.PerformanceMetrics = metrics
+	return nil
+}
+
+func (r *memoryModelRepo) GetDefaultModel(ctx context.Context) (*v1.ModelInfo, error) {
+	return r.GetModel(ctx, r.model.Name)
+}
+
+func (r *memoryModelRepo) SetDefaultModel(ctx context.Context, name string) error {
+	return nil
+}
+
+func (r *memoryModelRepo) ListModels(ctx context.Context, pagination *commonv1.PaginationRequest, filters []*commonv1.Filter) ([]*v1.ModelInfo, *commonv1.PaginationResponse, error) {
+	model, _ := r.GetModel(ctx, r.model.Name)
+	return []*v1.ModelInfo{model}, &commonv1.PaginationResponse{Total: 1}, nil
+}
+
+// newTestRerankUsecase builds a usecase whose model caches scores
+func newTestRerankUsecase(scorer RelevanceScorer) (*RerankUsecase, *memoryModelRepo) {
+	repo := &memoryModelRepo{model: &v1.ModelInfo{
+		Name:        testModel,
+		IsAvailable: true,
+		CurrentConfiguration: &v1.ModelConfiguration{
+			Performance: &v1.PerformanceSettings{EnableCaching: true, CacheTtlSeconds: 60},
+		},
+	}}
+	models := NewModelUsecase(repo, log.DefaultLogger)
+	judges := func(scorer RelevanceScorer) PairwiseJudge { return &scoreJudge{scorer: scorer} }
+	cache := &mapCache{scores: make(map[string]float32)}
+	return NewRerankUsecase(scorer, judges, cache, models, log.DefaultLogger), repo
+}
+
+func rerankRequest(method string, contents ...string) *v1.RerankDocumentsRequest {
+	req := &v1.RerankDocumentsRequest{
+		Query: "go channels",
+		Options: &v1.RerankingOptions{
+			ModelName: testModel,
+			Strategy:  &v1.RerankingStrategy{PrimaryMethod: method},
+		},
+	}
+	for _, content := range contents {
+		req.Documents = append(req.Documents, &v1.DocumentToRerank{DocumentId: content, Content: content})
+	}
+	return req
+}
+
+func TestRerankDocumentsOrdersByScore(t *testing.T) {
+	for _, method := range []string{MethodPointwise, MethodPairwise, MethodListwise} {
+		t.Run(method, func(t *testing.T) {
+			uc, _ := newTestRerankUsecase(&countingScorer{})
+			resp, err := uc.RerankDocuments(context.Background(), rerankRequest(method, "rust traits", "go maps", "go channels"))
+			if err != nil {
+				t.Fatal(err)
+			}
+			var got []string
+			for _, doc := range resp.RankedDocuments {
+				got = append(got, doc.DocumentId)
+			}
+			want := "go channels,go maps,rust traits"
+			if strings.Join(got, ",") != want {
+				t.Errorf("order = %v, want %s", got, want)
+			}
+		})
+	}
+}
+
+func TestRerankDocumentsFallsBack(t *testing.T) {
+	uc, _ := newTestRerankUsecase(&countingScorer{})
+	req := rerankRequest("unknown", "go maps", "go channels")
+	req.Options.Strategy.FallbackMethods = []string{MethodPointwise}
+	resp, err := uc.RerankDocuments(context.Background(), req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := resp.Metadata.AppliedStrategy.PrimaryMethod; got != MethodPointwise {
+		t.Errorf("applied method = %s, want %s", got, MethodPointwise)
+	}
+	if resp.Metadata.DebugInfo["fallback_used"] != "true" {
+		t.Error("fallback_used not reported")
+	}
+}
+
+func TestPairwiseJudgesShareTheScoreCache(t *testing.T) {
+	for _, method := range []string{MethodPairwise, MethodListwise} {
+		t.Run(method, func(t *testing.T) {
+			scorer := &countingScorer{}
+			uc, _ := newTestRerankUsecase(scorer)
+			docs := []string{"go channels", "go maps", "rust traits", "go generics"}
+			resp, err := uc.RerankDocuments(context.Background(), rerankRequest(method, docs...))
+			if err != nil {
+				t.Fatal(err)
+			}
+			// 每个文档只应计算一次分数，其余比较命中缓存
+			if scorer.calls != int64(len(docs)) {
+				t.Errorf("scorer calls = %d, want %d", scorer.calls, len(docs))
+			}
+			if hits := resp.Metadata.DebugInfo["cache_hits"]; hits == "0" || hits == "" {
+				t.Errorf("cache_hits = %q, want > 0", hits)
+			}
+		})
+	}
+}
@@ -226,11 +226,13 @@ func (m *Server_GRPC) GetTimeout() *durationpb.Duration {
 }
 
 type Data struct {
-	Database             *Data_Database `protobuf:"bytes,1,opt,name=database,proto3" json:"database,omitempty"`
-	Redis                *Data_Redis    `protobuf:"bytes,2,opt,name=redis,proto3" json:"redis,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
-	XXX_unrecognized     []byte         `json:"-"`
-	XXX_sizecache        int32          `json:"-"`
+	Database             *Data_Database  `protobuf:"bytes,1,opt,name=database,proto3" json:"database,omitempty"`
+	Redis                *Data_Redis     `protobuf:"bytes,2,opt,name=redis,proto3" json:"redis,omitempty"`
+	Benchmark            *Data_Benchmark `protobuf:"bytes,3,opt,name=benchmark,proto3" json:"benchmark,omitempty"`
+	Registry             *Data_Registry  `protobuf:"bytes,4,opt,name=registry,proto3" json:"registry,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
 }
 
 func (m *Data) Reset()         { *m = Data{} }
@@ -272,6 +274,20 @@ func (m *Data) GetRedis() *Data_Redis {
 	return nil
 }
 
+func (m *Data) GetBenchmark() *Data_Benchmark {
+	if m != nil {
+		return m.Benchmark
+	}
+	return nil
+}
+
+func (m *Data) GetRegistry() *Data_Registry {
+	if m != nil {
+		return m.Registry
+	}
+	return nil
+}
+
 type Data_Database struct {
 	Driver               string   `protobuf:"bytes,1,opt,name=driver,proto3" json:"driver,omitempty"`
 	Source               string   `protobuf:"bytes,2,opt,name=source,proto3" json:"source,omitempty"`
@@ -382,6 +398,85 @@ func (m *Data_Redis) GetWriteTimeout() *durationpb.Duration {
 	return nil
 }
 
+type Data_Benchmark struct {
+	DatasetDir           string   `protobuf:"bytes,1,opt,name=dataset_dir,json=datasetDir,proto3" json:"dataset_dir,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Data_Benchmark) Reset()         { *m = Data_Benchmark{} }
+func (m *Data_Benchmark) String() string { return proto.CompactTextString(m) }
+func (*Data_Benchmark) ProtoMessage()    {}
+func (*Data_Benchmark) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9c69a7f648509b54, []int{2, 2}
+}
+
+func (m *Data_Benchmark) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Data_Benchmark.Unmarshal(m, b)
+}
+func (m *Data_Benchmark) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Data_Benchmark.Marshal(b, m, deterministic)
+}
+func (m *Data_Benchmark) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Data_Benchmark.Merge(m, src)
+}
+func (m *Data_Benchmark) XXX_Size() int {
+	return xxx_messageInfo_Data_Benchmark.Size(m)
+}
+func (m *Data_Benchmark) XXX_DiscardUnknown() {
+	xxx_messageInfo_Data_Benchmark.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Data_Benchmark proto.InternalMessageInfo
+
+func (m *Data_Benchmark) GetDatasetDir() string {
+	if m != nil {
+		return m.DatasetDir
+	}
+	return ""
+}
+
+type Data_Registry struct {
+	// 模型配置和默认模型的保存文件，为空时只保存在内存中
+	Path                 string   `protobuf:"bytes,1,opt,name=path,proto3" json:"path,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Data_Registry) Reset()         { *m = Data_Registry{} }
+func (m *Data_Registry) String() string { return proto.CompactTextString(m) }
+func (*Data_Registry) ProtoMessage()    {}
+func (*Data_Registry) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9c69a7f648509b54, []int{2, 3}
+}
+
+func (m *Data_Registry) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Data_Registry.Unmarshal(m, b)
+}
+func (m *Data_Registry) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Data_Registry.Marshal(b, m, deterministic)
+}
+func (m *Data_Registry) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Data_Registry.Merge(m, src)
+}
+func (m *Data_Registry) XXX_Size() int {
+	return xxx_messageInfo_Data_Registry.Size(m)
+}
+func (m *Data_Registry) XXX_DiscardUnknown() {
+	xxx_messageInfo_Data_Registry.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Data_Registry proto.InternalMessageInfo
+
+func (m *Data_Registry) GetPath() string {
+	if m != nil {
+		return m.Path
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*Bootstrap)(nil), "kratos.api.Bootstrap")
 	proto.RegisterType((*Server)(nil), "kratos.api.Server")
@@ -390,6 +485,8 @@ func init() {
 	proto.RegisterType((*Data)(nil), "kratos.api.Data")
 	proto.RegisterType((*Data_Database)(nil), "kratos.api.Data.Database")
 	proto.RegisterType((*Data_Redis)(nil), "kratos.api.Data.Redis")
+	proto.RegisterType((*Data_Benchmark)(nil), "kratos.api.Data.Benchmark")
+	proto.RegisterType((*Data_Registry)(nil), "kratos.api.Data.Registry")
 }
 
 func init() {
@@ -397,30 +494,35 @@ func init() {
 }
 
 var fileDescriptor_9c69a7f648509b54 = []byte{
-	// 399 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x93, 0x4f, 0x6b, 0xdb, 0x40,
-	0x10, 0xc5, 0xb1, 0x2b, 0xcb, 0xd6, 0xd8, 0x85, 0xb2, 0x07, 0x57, 0xd6, 0xa1, 0x14, 0xb7, 0x85,
-	0xd2, 0x96, 0x15, 0xd4, 0xf4, 0xd2, 0x84, 0x1c, 0x1c, 0x43, 0x72, 0x34, 0x1b, 0x9f, 0x02, 0x21,
-	0xac, 0xad, 0xb5, 0xb2, 0xd8, 0xd1, 0x8a, 0xd1, 0x2a, 0xfe, 0x60, 0xb9, 0xe4, 0xcb, 0xe4, 0xbb,
-	0x84, 0x5d, 0xad, 0x9c, 0x3f, 0x0e, 0x24, 0xb9, 0xe4, 0x32, 0x78, 0x3c, 0xbf, 0xf7, 0x1e, 0x7a,
-	0x48, 0x10, 0xca, 0x4c, 0x0b, 0xcc, 0xf8, 0x3a, 0x5e, 0xa8, 0x6c, 0x69, 0x07, 0xcd, 0x51, 0x69,
-	0x45, 0x60, 0x85, 0x5c, 0xab, 0x82, 0xf2, 0x5c, 0x46, 0x5f, 0x52, 0xa5, 0xd2, 0xb5, 0x88, 0xed,
-	0x65, 0x5e, 0x2e, 0xe3, 0xa4, 0x44, 0xae, 0xa5, 0xca, 0x2a, 0x76, 0x78, 0x06, 0xc1, 0x58, 0x29,
-	0x5d, 0x68, 0xe4, 0x39, 0xf9, 0x05, 0x7e, 0x21, 0xf0, 0x4a, 0x60, 0xd8, 0xf8, 0xda, 0xf8, 0xd9,
-	0xfd, 0x4b, 0xe8, 0xbd, 0x13, 0x3d, 0xb1, 0x17, 0xe6, 0x08, 0xf2, 0x1d, 0xbc, 0x84, 0x6b, 0x1e,
-	0x36, 0x2d, 0xf9, 0xe9, 0x21, 0x39, 0xe1, 0x9a, 0x33, 0x7b, 0x1d, 0xde, 0x34, 0xc1, 0xaf, 0x84,
-	0xe4, 0x37, 0x78, 0x17, 0x5a, 0xe7, 0xce, 0xfa, 0xf3, 0xae, 0x35, 0x3d, 0x9e, 0xcd, 0xa6, 0xcc,
-	0x42, 0x06, 0x4e, 0x31, 0x5f, 0x38, 0xf7, 0xe7, 0xe0, 0x23, 0x36, 0x3d, 0x64, 0x16, 0x8a, 0x24,
-	0x78, 0x46, 0x4a, 0x42, 0x68, 0x67, 0x42, 0x6f, 0x14, 0xae, 0x6c, 0x48, 0xc0, 0xea, 0x95, 0x10,
-	0xf0, 0x78, 0x92, 0xa0, 0xb5, 0x0b, 0x98, 0xfd, 0x4d, 0x46, 0xd0, 0xd6, 0xf2, 0x52, 0xa8, 0x52,
-	0x87, 0x1f, 0x6c, 0xca, 0x80, 0x56, 0x5d, 0xd1, 0xba, 0x2b, 0x3a, 0x71, 0x5d, 0xb1, 0x9a, 0x34,
-	0x51, 0x26, 0xf8, 0x1d, 0xa2, 0x86, 0xb7, 0x4d, 0xf0, 0x4c, 0x93, 0xe4, 0x1f, 0x74, 0x4c, 0x97,
-	0x73, 0x5e, 0x08, 0x57, 0xde, 0xe0, 0x69, 0xdb, 0x76, 0x18, 0x80, 0x6d, 0x51, 0xf2, 0x07, 0x5a,
-	0x28, 0x12, 0x59, 0xb8, 0x0e, 0xfb, 0x3b, 0x1a, 0x66, 0xae, 0xac, 0x82, 0xa2, 0xff, 0xd0, 0xa9,
-	0x3d, 0x48, 0x1f, 0xfc, 0x04, 0x65, 0xfd, 0x1a, 0x04, 0xcc, 0x6d, 0xe6, 0xff, 0x42, 0x95, 0xb8,
-	0x10, 0xee, 0xe1, 0xdc, 0x16, 0x5d, 0x37, 0xa0, 0x65, 0xcd, 0xde, 0x58, 0xcb, 0x3e, 0xf4, 0x50,
-	0xf0, 0xe4, 0xfc, 0xd5, 0xdd, 0x74, 0x0d, 0x3e, 0xab, 0x68, 0x72, 0x00, 0x1f, 0x37, 0x28, 0xb5,
-	0xd8, 0xca, 0xbd, 0x97, 0xe4, 0x3d, 0xcb, 0x3b, 0xfd, 0xf8, 0xc7, 0xe9, 0x37, 0xe4, 0x69, 0xcc,
-	0xf3, 0x3c, 0x46, 0x81, 0x3c, 0x5b, 0x09, 0x8c, 0x1f, 0x7d, 0x52, 0x7b, 0x66, 0xcc, 0x7d, 0xeb,
-	0x33, 0xba, 0x0b, 0x00, 0x00, 0xff, 0xff, 0x50, 0x4d, 0xa4, 0xee, 0x6f, 0x03, 0x00, 0x00,
+	// 468 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x94, 0x51, 0x6b, 0x13, 0x41,
+	0x10, 0xc7, 0x49, 0xbc, 0xa6, 0xb9, 0x49, 0x05, 0xd9, 0x87, 0x7a, 0xbd, 0x87, 0x2a, 0x51, 0x41,
+	0xb4, 0xec, 0x81, 0x45, 0x10, 0x15, 0x1f, 0x62, 0x40, 0x1f, 0xcb, 0x9a, 0x27, 0x41, 0xca, 0x24,
+	0xb7, 0xbd, 0x2c, 0x69, 0x6f, 0x8f, 0xb9, 0x89, 0xc5, 0xef, 0xe2, 0xb7, 0xf0, 0xc5, 0x8f, 0x27,
+	0xbb, 0xb7, 0x9b, 0xaa, 0x21, 0xa8, 0x2f, 0xbe, 0x2c, 0xbb, 0x3b, 0xbf, 0xff, 0xcc, 0xec, 0x7f,
+	0x8e, 0x83, 0xcc, 0xd4, 0xac, 0xa9, 0xc6, 0xcb, 0x62, 0x61, 0xeb, 0x0b, 0xbf, 0xc8, 0x86, 0x2c,
+	0x5b, 0x01, 0x2b, 0x42, 0xb6, 0xad, 0xc4, 0xc6, 0xe4, 0xc7, 0x95, 0xb5, 0xd5, 0xa5, 0x2e, 0x7c,
+	0x64, 0xbe, 0xbe, 0x28, 0xca, 0x35, 0x21, 0x1b, 0x5b, 0x77, 0xec, 0xf8, 0x13, 0xa4, 0x13, 0x6b,
+	0xb9, 0x65, 0xc2, 0x46, 0x3c, 0x81, 0x41, 0xab, 0xe9, 0xb3, 0xa6, 0xac, 0x77, 0xbf, 0xf7, 0x78,
+	0xf4, 0x4c, 0xc8, 0x9b, 0x4c, 0xf2, 0x83, 0x8f, 0xa8, 0x40, 0x88, 0x87, 0x90, 0x94, 0xc8, 0x98,
+	0xf5, 0x3d, 0x79, 0xe7, 0x67, 0x72, 0x8a, 0x8c, 0xca, 0x47, 0xc7, 0xdf, 0xfb, 0x30, 0xe8, 0x84,
+	0xe2, 0x29, 0x24, 0x4b, 0xe6, 0x26, 0xa4, 0xbe, 0xbb, 0x9d, 0x5a, 0xbe, 0x9f, 0xcd, 0xce, 0x94,
+	0x87, 0x1c, 0x5c, 0x51, 0xb3, 0xc8, 0xfa, 0x3b, 0xe1, 0x77, 0xea, 0xec, 0xad, 0xf2, 0x50, 0x6e,
+	0x20, 0x71, 0x52, 0x91, 0xc1, 0x7e, 0xad, 0xf9, 0xda, 0xd2, 0xca, 0x17, 0x49, 0x55, 0x3c, 0x0a,
+	0x01, 0x09, 0x96, 0x25, 0xf9, 0x74, 0xa9, 0xf2, 0x7b, 0x71, 0x0a, 0xfb, 0x6c, 0xae, 0xb4, 0x5d,
+	0x73, 0x76, 0xcb, 0x57, 0x39, 0x92, 0x9d, 0x57, 0x32, 0x7a, 0x25, 0xa7, 0xc1, 0x2b, 0x15, 0x49,
+	0x57, 0xca, 0x15, 0xfe, 0x0f, 0xa5, 0xc6, 0x5f, 0x13, 0x48, 0x9c, 0x93, 0xe2, 0x39, 0x0c, 0x9d,
+	0x97, 0x73, 0x6c, 0x75, 0x30, 0xef, 0xe8, 0x77, 0xb7, 0xe5, 0x34, 0x00, 0x6a, 0x83, 0x8a, 0x13,
+	0xd8, 0x23, 0x5d, 0x9a, 0x36, 0x78, 0x78, 0xb8, 0xa5, 0x51, 0x2e, 0xaa, 0x3a, 0x48, 0xbc, 0x80,
+	0x74, 0xae, 0xeb, 0xc5, 0xf2, 0x0a, 0x69, 0x15, 0x9a, 0xcc, 0xb7, 0x14, 0x93, 0x48, 0xa8, 0x1b,
+	0xd8, 0xb5, 0x47, 0xba, 0x32, 0x2d, 0xd3, 0x97, 0x2c, 0xd9, 0xd1, 0x9e, 0x0a, 0x80, 0xda, 0xa0,
+	0xf9, 0x4b, 0x18, 0xc6, 0xa6, 0xc5, 0x21, 0x0c, 0x4a, 0x32, 0xf1, 0xbb, 0x4b, 0x55, 0x38, 0xb9,
+	0xfb, 0xd6, 0xae, 0x69, 0xa1, 0x83, 0x9b, 0xe1, 0x94, 0x7f, 0xeb, 0xc1, 0x9e, 0xef, 0xfe, 0x1f,
+	0xe7, 0xf0, 0x1a, 0x0e, 0x48, 0x63, 0x79, 0xfe, 0xd7, 0xc3, 0x18, 0x39, 0x7c, 0xd6, 0xd1, 0xe2,
+	0x0d, 0xdc, 0xbe, 0x26, 0xc3, 0x7a, 0x23, 0x4f, 0xfe, 0x24, 0x3f, 0xf0, 0x7c, 0xd0, 0xe7, 0x27,
+	0x90, 0x6e, 0x0c, 0x14, 0xf7, 0x60, 0xe4, 0x26, 0xd5, 0x6a, 0x3e, 0x2f, 0x4d, 0x7c, 0x37, 0x84,
+	0xab, 0xa9, 0xa1, 0xfc, 0x18, 0x86, 0xd1, 0x35, 0xf7, 0x96, 0x06, 0x79, 0x19, 0x28, 0xbf, 0x9f,
+	0x3c, 0xfa, 0xf8, 0x80, 0xb0, 0x2a, 0xb0, 0x69, 0x0a, 0xd2, 0x84, 0xf5, 0x4a, 0x53, 0xf1, 0xcb,
+	0x1f, 0xe1, 0x95, 0x5b, 0xe6, 0x03, 0xdf, 0xd5, 0xe9, 0x8f, 0x01, 0x00, 0xd3, 0x4d, 0xbd, 0x55,
+	0x2e, 0x04, 0x00, 0x00,
 }
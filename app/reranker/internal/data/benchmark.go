@@ -0,0 +1,210 @@
+package data
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+
+	commonv1 "rag/api/common/v1"
+	"rag/app/reranker/internal/biz"
+	"rag/app/reranker/internal/conf"
+
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+// 数据集目录中的文件
+const (
+	queriesFile   = "queries.jsonl"
+	corpusFile    = "corpus.jsonl"
+	qrelsJSONFile = "qrels.jsonl"
+	qrelsTRECFile = "qrels.txt"
+)
+
+// benchmarkRepo implements biz.BenchmarkRepo by reading datasets from local files.
+//
+// Each dataset lives in <dataset_dir>/<name>/ and consists of queries.jsonl
+// ({"_id", "text"}), corpus.jsonl ({"_id", "title", "text"}) and relevance
+// judgments in TREC qrels layout, either qrels.jsonl ({"query_id",
+// "iteration", "doc_id", "relevance"}) or whitespace separated qrels.txt.
+type benchmarkRepo struct {
+	dir string
+	log *log.Helper
+}
+
+// NewBenchmarkRepo creates a new benchmark dataset repository
+func NewBenchmarkRepo(c *conf.Data, logger log.Logger) biz.BenchmarkRepo {
+	return &benchmarkRepo{
+		dir: c.GetBenchmark().GetDatasetDir(),
+		log: log.NewHelper(logger),
+	}
+}
+
+type jsonlRecord struct {
+	ID    string `json:"_id"`
+	Title string `json:"title"`
+	Text  string `json:"text"`
+}
+
+type qrel struct {
+	QueryID   string `json:"query_id"`
+	Iteration string `json:"iteration"`
+	DocID     string `json:"doc_id"`
+	Relevance int    `json:"relevance"`
+}
+
+// LoadDataset loads the named dataset as benchmark cases
+func (r *benchmarkRepo) LoadDataset(ctx context.Context, name string) ([]*biz.BenchmarkCase, error) {
+	if r.dir == "" {
+		return nil, errors.BadRequest(commonv1.ErrorCode_ERROR_CODE_BAD_REQUEST.String(), "benchmark dataset directory is not configured")
+	}
+	// 数据集名称只能是目录下的一级子目录
+	if name == "" || name != filepath.Base(name) || name == "." || name == ".." {
+		return nil, errors.BadRequest(commonv1.ErrorCode_ERROR_CODE_BAD_REQUEST.String(), fmt.Sprintf("invalid dataset name %q", name))
+	}
+	dir := filepath.Join(r.dir, name)
+	r.log.WithContext(ctx).Infof("Loading benchmark dataset from %s", dir)
+
+	queries, err := readRecords(filepath.Join(dir, queriesFile))
+	if err != nil {
+		return nil, err
+	}
+	corpus, err := readRecords(filepath.Join(dir, corpusFile))
+	if err != nil {
+		return nil, err
+	}
+	qrels, err := readQrels(dir)
+	if err != nil {
+		return nil, err
+	}
+
+	judged := make(map[string][]qrel)
+	for _, q := range qrels {
+		judged[q.QueryID] = append(judged[q.QueryID], q)
+	}
+
+	queryIDs := make([]string, 0, len(judged))
+	for id := range judged {
+		queryIDs = append(queryIDs, id)
+	}
+	sort.Strings(queryIDs)
+
+	var cases []*biz.BenchmarkCase
+	for _, id := range queryIDs {
+		query, ok := queries[id]
+		if !ok {
+			r.log.WithContext(ctx).Warnf("Skipping qrels for unknown query %s", id)
+			continue
+		}
+		c := &biz.BenchmarkCase{QueryID: id, Query: query.Text}
+		for _, q := range judged[id] {
+			doc, ok := corpus[q.DocID]
+			if !ok {
+				r.log.WithContext(ctx).Warnf("Skipping qrel for unknown document %s", q.DocID)
+				continue
+			}
+			content := doc.Text
+			if doc.Title != "" {
+				content = doc.Title + "\n" + doc.Text
+			}
+			c.Documents = append(c.Documents, &biz.JudgedDocument{
+				DocumentID: q.DocID,
+				Content:    content,
+				Relevance:  q.Relevance,
+			})
+		}
+		if len(c.Documents) > 0 {
+			cases = append(cases, c)
+		}
+	}
+
+	if len(cases) == 0 {
+		return nil, errors.NotFound(commonv1.ErrorCode_ERROR_CODE_NOT_FOUND.String(), fmt.Sprintf("dataset %q has no judged queries", name))
+	}
+	r.log.WithContext(ctx).Infof("Loaded %d benchmark queries from dataset %s", len(cases), name)
+	return cases, nil
+}
+
+// readRecords reads a JSONL file of {"_id", "title", "text"} records keyed by
+// id. An id may appear only once.
+func readRecords(path string) (map[string]*jsonlRecord, error) {
+	records := make(map[string]*jsonlRecord)
+	err := scanLines(path, func(line string) error {
+		var record jsonlRecord
+		if err := json.Unmarshal([]byte(line), &record); err != nil {
+			return err
+		}
+		if _, ok := records[record.ID]; ok {
+			return fmt.Errorf("duplicate id %q", record.ID)
+		}
+		records[record.ID] = &record
+		return nil
+	})
+	return records, err
+}
+
+// readQrels reads qrels.jsonl, falling back to the plain TREC qrels.txt
+func readQrels(dir string) ([]qrel, error) {
+	var qrels []qrel
+	path := filepath.Join(dir, qrelsJSONFile)
+	if _, err := os.Stat(path); err == nil {
+		err := scanLines(path, func(line string) error {
+			var q qrel
+			if err := json.Unmarshal([]byte(line), &q); err != nil {
+				return err
+			}
+			qrels = append(qrels, q)
+			return nil
+		})
+		return qrels, err
+	}
+
+	// TREC 格式：query_id iteration doc_id relevance
+	err := scanLines(filepath.Join(dir, qrelsTRECFile), func(line string) error {
+		fields := strings.Fields(line)
+		if len(fields) != 4 {
+			return fmt.Errorf("expected 4 fields, got %d", len(fields))
+		}
+		relevance, err := strconv.Atoi(fields[3])
+		if err != nil {
+			return err
+		}
+		qrels = append(qrels, qrel{QueryID: fields[0], Iteration: fields[1], DocID: fields[2], Relevance: relevance})
+		return nil
+	})
+	return qrels, err
+}
+
+// scanLines calls fn for every non-blank line of the file
+func scanLines(path string, fn func(line string) error) error {
+	f, err := os.Open(path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return errors.NotFound(commonv1.ErrorCode_ERROR_CODE_NOT_FOUND.String(), fmt.Sprintf("benchmark file %s not found", filepath.Base(path)))
+		}
+		return err
+	}
+	defer f.Close()
+
+	scanner := bufio.NewScanner(f)
+	scanner.Buffer(make([]byte, 0, 64*1024), 16*1024*1024)
+	lineNo := 0
+	for scanner.Scan() {
+		lineNo++
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" {
+			continue
+		}
+		if err := fn(line); err != nil {
+			return errors.BadRequest(commonv1.ErrorCode_ERROR_CODE_BAD_REQUEST.String(),
+				fmt.Sprintf("%s:%d: %v", filepath.Base(path), lineNo, err))
+		}
+	}
+	return scanner.Err()
+}
@@ -0,0 +1,137 @@
+package data
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strconv"
+	"strings"
+	"testing"
+
+	commonv1 "rag/api/common/v1"
+	"rag/app/reranker/internal/biz"
+	"rag/app/reranker/internal/conf"
+
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+const (
+	testQueries = `{"_id": "q1", "text": "go channels"}
+{"_id": "q2", "text": "rust traits"}
+`
+	testCorpus = `{"_id": "d1", "title": "Channels", "text": "Channels connect goroutines."}
+{"_id": "d2", "text": "Maps are hash tables."}
+{"_id": "d3", "text": "Traits define shared behavior."}
+`
+)
+
+// writeDataset writes the files of dataset name under dir
+func writeDataset(t *testing.T, dir, name string, files map[string]string) {
+	t.Helper()
+	path := filepath.Join(dir, name)
+	if err := os.MkdirAll(path, 0o755); err != nil {
+		t.Fatal(err)
+	}
+	for file, content := range files {
+		if err := os.WriteFile(filepath.Join(path, file), []byte(content), 0o644); err != nil {
+			t.Fatal(err)
+		}
+	}
+}
+
+func newTestBenchmarkRepo(dir string) biz.BenchmarkRepo {
+	return NewBenchmarkRepo(&conf.Data{Benchmark: &conf.Data_Benchmark{DatasetDir: dir}}, log.DefaultLogger)
+}
+
+// relevances describes cases as query:doc=relevance lists
+func relevances(cases []*biz.BenchmarkCase) []string {
+	var out []string
+	for _, c := range cases {
+		for _, d := range c.Documents {
+			out = append(out, c.QueryID+":"+d.DocumentID+"="+strconv.Itoa(d.Relevance))
+		}
+	}
+	return out
+}
+
+func TestLoadDatasetGradedRelevance(t *testing.T) {
+	dir := t.TempDir()
+	// 未知的查询和文档被跳过，分级相关度原样保留
+	writeDataset(t, dir, "trec", map[string]string{
+		queriesFile: testQueries,
+		corpusFile:  testCorpus,
+		qrelsTRECFile: "q1 0 d1 2\n" +
+			"q1 0 d2 0\n" +
+			"\n" +
+			"q2 0 d3 1\n" +
+			"q2 0 d9 1\n" +
+			"q9 0 d1 1\n",
+	})
+	writeDataset(t, dir, "json", map[string]string{
+		queriesFile:   testQueries,
+		corpusFile:    testCorpus,
+		qrelsJSONFile: `{"query_id": "q1", "iteration": "0", "doc_id": "d1", "relevance": 3}` + "\n",
+		// qrels.jsonl 优先于 qrels.txt
+		qrelsTRECFile: "q2 0 d3 1\n",
+	})
+	repo := newTestBenchmarkRepo(dir)
+
+	tests := []struct {
+		name string
+		want []string
+	}{
+		{"trec", []string{"q1:d1=2", "q1:d2=0", "q2:d3=1"}},
+		{"json", []string{"q1:d1=3"}},
+	}
+	for _, tt := range tests {
+		cases, err := repo.LoadDataset(context.Background(), tt.name)
+		if err != nil {
+			t.Fatalf("%s: %v", tt.name, err)
+		}
+		if got := relevances(cases); !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("%s: %v, want %v", tt.name, got, tt.want)
+		}
+		if cases[0].Query != "go channels" || cases[0].Documents[0].Content != "Channels\nChannels connect goroutines." {
+			t.Errorf("%s: first case = %q with %q", tt.name, cases[0].Query, cases[0].Documents[0].Content)
+		}
+	}
+}
+
+func TestLoadDatasetErrors(t *testing.T) {
+	dir := t.TempDir()
+	datasets := map[string]map[string]string{
+		"short":        {queriesFile: testQueries, corpusFile: testCorpus, qrelsTRECFile: "q1 0 d1 1\nq1 d2 1\n"},
+		"graded":       {queriesFile: testQueries, corpusFile: testCorpus, qrelsTRECFile: "q1 0 d1 high\n"},
+		"json":         {queriesFile: testQueries, corpusFile: testCorpus, qrelsJSONFile: `{"query_id": "q1", "doc_id": "d1", "relevance": "1"}`},
+		"duplicate id": {queriesFile: testQueries, corpusFile: testCorpus + `{"_id": "d1", "text": "again"}` + "\n", qrelsTRECFile: "q1 0 d1 1\n"},
+		"no qrels":     {queriesFile: testQueries, corpusFile: testCorpus},
+		"unjudged":     {queriesFile: testQueries, corpusFile: testCorpus, qrelsTRECFile: "q9 0 d1 1\n"},
+	}
+	for name, files := range datasets {
+		writeDataset(t, dir, name, files)
+	}
+	repo := newTestBenchmarkRepo(dir)
+
+	tests := []struct {
+		name    string
+		reason  string
+		message string
+	}{
+		// 格式错误的行报告文件和行号
+		{"short", commonv1.ErrorCode_ERROR_CODE_BAD_REQUEST.String(), "qrels.txt:2: expected 4 fields, got 3"},
+		{"graded", commonv1.ErrorCode_ERROR_CODE_BAD_REQUEST.String(), "qrels.txt:1:"},
+		{"json", commonv1.ErrorCode_ERROR_CODE_BAD_REQUEST.String(), "qrels.jsonl:1:"},
+		{"duplicate id", commonv1.ErrorCode_ERROR_CODE_BAD_REQUEST.String(), `corpus.jsonl:4: duplicate id "d1"`},
+		{"no qrels", commonv1.ErrorCode_ERROR_CODE_NOT_FOUND.String(), "qrels.txt not found"},
+		{"unjudged", commonv1.ErrorCode_ERROR_CODE_NOT_FOUND.String(), "no judged queries"},
+		{"../short", commonv1.ErrorCode_ERROR_CODE_BAD_REQUEST.String(), "invalid dataset name"},
+	}
+	for _, tt := range tests {
+		_, err := repo.LoadDataset(context.Background(), tt.name)
+		if errors.Reason(err) != tt.reason || !strings.Contains(errors.FromError(err).GetMessage(), tt.message) {
+			t.Errorf("%s: err = %v, want %s %q", tt.name, err, tt.reason, tt.message)
+		}
+	}
+}
@@ -0,0 +1,80 @@
+package data
+
+import (
+	"container/list"
+	"context"
+	"sync"
+	"time"
+
+	"rag/app/reranker/internal/biz"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+// maxCachedScores bounds the number of scores kept in memory
+const maxCachedScores = 100000
+
+// scoreCache implements biz.ScoreCache as an in-memory LRU with per-entry TTL
+type scoreCache struct {
+	log *log.Helper
+
+	mu      sync.Mutex
+	entries map[string]*list.Element
+	lru     *list.List
+}
+
+type cacheEntry struct {
+	key       string
+	score     float32
+	expiresAt time.Time
+}
+
+// NewScoreCache creates a new relevance score cache
+func NewScoreCache(logger log.Logger) biz.ScoreCache {
+	return &scoreCache{
+		log:     log.NewHelper(logger),
+		entries: make(map[string]*list.Element),
+		lru:     list.New(),
+	}
+}
+
+// Get returns a cached score that has not expired
+func (c *scoreCache) Get(ctx context.Context, key string) (float32, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	elem, ok := c.entries[key]
+	if !ok {
+		return 0, false
+	}
+	entry := elem.Value.(*cacheEntry)
+	if time.Now().After(entry.expiresAt) {
+		c.lru.Remove(elem)
+		delete(c.entries, key)
+		return 0, false
+	}
+	c.lru.MoveToFront(elem)
+	return entry.score, true
+}
+
+// Set stores a score, evicting the least recently used entry when full
+func (c *scoreCache) Set(ctx context.Context, key string, score float32, ttl time.Duration) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	expiresAt := time.Now().Add(ttl)
+	if elem, ok := c.entries[key]; ok {
+		entry := elem.Value.(*cacheEntry)
+		entry.score = score
+		entry.expiresAt = expiresAt
+		c.lru.MoveToFront(elem)
+		return
+	}
+
+	c.entries[key] = c.lru.PushFront(&cacheEntry{key: key, score: score, expiresAt: expiresAt})
+	for c.lru.Len() > maxCachedScores {
+		oldest := c.lru.Back()
+		c.lru.Remove(oldest)
+		delete(c.entries, oldest.Value.(*cacheEntry).key)
+	}
+}
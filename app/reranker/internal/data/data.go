@@ -8,7 +8,7 @@ import (
 )
 
 // ProviderSet is data providers.
-var ProviderSet = wire.NewSet(NewData, NewGreeterRepo)
+var ProviderSet = wire.NewSet(NewData, NewRelevanceScorer, NewJudgeFactory, NewModelRepo, NewBenchmarkRepo, NewScoreCache)
 
 // Data .
 type Data struct {
@@ -0,0 +1,495 @@
+package data
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+
+	commonv1 "rag/api/common/v1"
+	v1 "rag/api/reranker/v1"
+	"rag/app/reranker/internal/biz"
+	"rag/app/reranker/internal/conf"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/golang/protobuf/proto"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// builtinModelName is the model served by the in-process lexical scorer
+const builtinModelName = "lexical-overlap"
+
+// registryFile is the stored form of the registry. Capabilities and
+// descriptions come from the built-in models, so only what ConfigureModel and
+// BenchmarkModel change is kept.
+type registryFile struct {
+	DefaultModel string                     `json:"default_model"`
+	Models       map[string]json.RawMessage `json:"models"`
+}
+
+// storedModel is the stored state of one model
+type storedModel struct {
+	Configuration      json.RawMessage `json:"current_configuration,omitempty"`
+	PerformanceMetrics json.RawMessage `json:"performance_metrics,omitempty"`
+	UpdatedAt          json.RawMessage `json:"updated_at,omitempty"`
+}
+
+// modelRepo implements biz.ModelRepo with a registry of the built-in models.
+// Configurations, benchmark results and the default model are loaded from
+// the registry file at startup and written through on every change; without
+// a file they are kept in memory only.
+type modelRepo struct {
+	data *Data
+	path string
+	log  *log.Helper
+
+	mu           sync.RWMutex
+	models       map[string]*v1.ModelInfo
+	defaultModel string
+}
+
+// NewModelRepo creates a new model repository seeded with the built-in models
+// and the state stored in the registry file
+func NewModelRepo(c *conf.Data, data *Data, logger log.Logger) (biz.ModelRepo, error) {
+	now := timestamppb.Now()
+	builtin := &v1.ModelInfo{
+		Name:        builtinModelName,
+		DisplayName: "Lexical Overlap",
+		Description: "词项覆盖度评分模型，无需外部推理服务",
+		Version:     "v1",
+		ModelType:   "lexical",
+		Capabilities: &v1.ModelCapabilities{
+			MaxSequenceLength:        8192,
+			SupportedLanguages:       []string{"en", "zh"},
+			ScoringAspects:           []string{"lexical"},
+			SupportsBatchProcessing:  true,
+			SupportsExplanation:      false,
+			SupportsCustomParameters: true,
+			RankingMethods:           []string{biz.MethodCrossEncoder, biz.MethodPointwise, biz.MethodPairwise, biz.MethodListwise},
+		},
+		PerformanceMetrics:   &v1.ModelPerformanceMetrics{},
+		CurrentConfiguration: &v1.ModelConfiguration{},
+		IsAvailable:          true,
+		CreatedAt:            now,
+		UpdatedAt:            now,
+	}
+
+	r := &modelRepo{
+		data:         data,
+		path:         c.GetRegistry().GetPath(),
+		log:          log.NewHelper(logger),
+		models:       map[string]*v1.ModelInfo{builtin.Name: builtin},
+		defaultModel: builtin.Name,
+	}
+	if r.path == "" {
+		r.log.Warn("registry path not configured, model configurations are kept in memory only")
+		return r, nil
+	}
+	if err := r.load(); err != nil {
+		return nil, fmt.Errorf("load model registry %s: %w", r.path, err)
+	}
+	return r, nil
+}
+
+// load applies the stored state to the registered models
+func (r *modelRepo) load() error {
+	raw, err := os.ReadFile(r.path)
+	if errors.Is(err, fs.ErrNotExist) {
+		r.log.Warnf("registry file %s not found, it will be created on first write", r.path)
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	var f registryFile
+	if err := json.Unmarshal(raw, &f); err != nil {
+		return err
+	}
+	for name, raw := range f.Models {
+		model, ok := r.models[name]
+		if !ok {
+			// 已下线的模型不再加载
+			r.log.Warnf("skipping unknown model %s in the registry file", name)
+			continue
+		}
+		var sm storedModel
+		if err := json.Unmarshal(raw, &sm); err != nil {
+			return fmt.Errorf("model %s: %w", name, err)
+		}
+		config := &v1.ModelConfiguration{}
+		metrics := &v1.ModelPerformanceMetrics{}
+		if err := unmarshalMessage(sm.Configuration, config); err != nil {
+			return fmt.Errorf("model %s: %w", name, err)
+		}
+		if err := unmarshalMessage(sm.PerformanceMetrics, metrics); err != nil {
+			return fmt.Errorf("model %s: %w", name, err)
+		}
+		model.CurrentConfiguration = config
+		model.PerformanceMetrics = metrics
+		if len(sm.UpdatedAt) > 0 {
+			model.UpdatedAt = &timestamppb.Timestamp{}
+			if err := unmarshalMessage(sm.UpdatedAt, model.UpdatedAt); err != nil {
+				return fmt.Errorf("model %s: %w", name, err)
+			}
+		}
+	}
+	if _, ok := r.models[f.DefaultModel]; ok {
+		r.defaultModel = f.DefaultModel
+	} else if f.DefaultModel != "" {
+		r.log.Warnf("default model %s in the registry file is unknown, using %s", f.DefaultModel, r.defaultModel)
+	}
+	r.log.Infof("loaded model registry from %s", r.path)
+	return nil
+}
+
+// persist writes the registry as it would be with models and defaultModel.
+// The caller holds r.mu and updates the registry only when persist succeeds.
+func (r *modelRepo) persist(models map[string]*v1.ModelInfo, defaultModel string) error {
+	if r.path == "" {
+		return nil
+	}
+	f := registryFile{
+		DefaultModel: defaultModel,
+		Models:       make(map[string]json.RawMessage, len(models)),
+	}
+	for name, model := range models {
+		var (
+			sm  storedModel
+			err error
+		)
+		if sm.Configuration, err = marshalMessage(model.CurrentConfiguration); err != nil {
+			return err
+		}
+		if sm.PerformanceMetrics, err = marshalMessage(model.PerformanceMetrics); err != nil {
+			return err
+		}
+		if sm.UpdatedAt, err = marshalMessage(model.UpdatedAt); err != nil {
+			return err
+		}
+		if f.Models[name], err = json.Marshal(sm); err != nil {
+			return err
+		}
+	}
+	raw, err := json.MarshalIndent(f, "", "  ")
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(r.path), 0o755); err != nil {
+		return err
+	}
+	return writeFileAtomic(r.path, raw)
+}
+
+// GetModel returns a copy of the named model
+func (r *modelRepo) GetModel(ctx context.Context, name string) (*v1.ModelInfo, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	model, ok := r.models[name]
+	if !ok {
+		return nil, biz.ErrModelNotFound
+	}
+	return r.snapshot(model), nil
+}
+
+// UpdateConfiguration replaces the current configuration of a model
+func (r *modelRepo) UpdateConfiguration(ctx context.Context, name string, config *v1.ModelConfiguration) error {
+	err := r.update(name, func(model *v1.ModelInfo) {
+		model.CurrentConfiguration = proto.Clone(config).(*v1.ModelConfiguration)
+	})
+	if err == nil {
+		r.log.WithContext(ctx).Infof("Model %s configured", name)
+	}
+	return err
+}
+
+// UpdatePerformanceMetrics replaces the performance metrics of a model
+func (r *modelRepo) UpdatePerformanceMetrics(ctx context.Context, name string, metrics *v1.ModelPerformanceMetrics) error {
+	err := r.update(name, func(model *v1.ModelInfo) {
+		model.PerformanceMetrics = proto.Clone(metrics).(*v1.ModelPerformanceMetrics)
+	})
+	if err == nil {
+		r.log.WithContext(ctx).Infof("Performance metrics of model %s updated", name)
+	}
+	return err
+}
+
+// update changes a copy of the named model under the lock and stores it
+func (r *modelRepo) update(name string, change func(model *v1.ModelInfo)) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	current, ok := r.models[name]
+	if !ok {
+		return biz.ErrModelNotFound
+	}
+	updated := proto.Clone(current).(*v1.ModelInfo)
+	change(updated)
+	updated.UpdatedAt = timestamppb.Now()
+
+	models := make(map[string]*v1.ModelInfo, len(r.models))
+	for n, m := range r.models {
+		models[n] = m
+	}
+	models[name] = updated
+	if err := r.persist(models, r.defaultModel); err != nil {
+		return err
+	}
+	r.models = models
+	return nil
+}
+
+// GetDefaultModel returns a copy of the default model
+func (r *modelRepo) GetDefaultModel(ctx context.Context) (*v1.ModelInfo, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	model, ok := r.models[r.defaultModel]
+	if !ok {
+		return nil, biz.ErrModelNotFound
+	}
+	return r.snapshot(model), nil
+}
+
+// SetDefaultModel points the default at the named model
+func (r *modelRepo) SetDefaultModel(ctx context.Context, name string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if _, ok := r.models[name]; !ok {
+		return biz.ErrModelNotFound
+	}
+	if err := r.persist(r.models, name); err != nil {
+		return err
+	}
+	r.defaultModel = name
+	r.log.WithContext(ctx).Infof("Default model set to %s", name)
+	return nil
+}
+
+// ListModels lists models matching all filters, sorted and paginated
+func (r *modelRepo) ListModels(ctx context.Context, pagination *commonv1.PaginationRequest, filters []*commonv1.Filter) ([]*v1.ModelInfo, *commonv1.PaginationResponse, error) {
+	r.mu.RLock()
+	var models []*v1.ModelInfo
+	for _, model := range r.models {
+		snapshot := r.snapshot(model)
+		passAllFilters := true
+		for _, filter := range filters {
+			if !r.applyFilter(snapshot, filter) {
+				passAllFilters = false
+				break
+			}
+		}
+		if passAllFilters {
+			models = append(models, snapshot)
+		}
+	}
+	r.mu.RUnlock()
+
+	// 排序
+	sortBy, sortDesc := "name", false
+	if pagination != nil {
+		if pagination.SortBy != "" {
+			sortBy = pagination.SortBy
+		}
+		sortDesc = pagination.SortDesc
+	}
+	sort.SliceStable(models, func(i, j int) bool {
+		a, b := models[i], models[j]
+		if sortDesc {
+			a, b = b, a
+		}
+		switch sortBy {
+		case "created_at":
+			return a.CreatedAt.AsTime().Before(b.CreatedAt.AsTime())
+		case "updated_at":
+			return a.UpdatedAt.AsTime().Before(b.UpdatedAt.AsTime())
+		case "model_type":
+			return a.ModelType < b.ModelType
+		default:
+			return a.Name < b.Name
+		}
+	})
+
+	// 应用分页
+	page := int32(1)
+	pageSize := int32(10)
+	if pagination != nil {
+		if pagination.Page > 0 {
+			page = pagination.Page
+		}
+		if pagination.PageSize > 0 {
+			pageSize = pagination.PageSize
+		}
+	}
+
+	total := int64(len(models))
+	totalPages := int32((total + int64(pageSize) - 1) / int64(pageSize))
+
+	// 按 int64 计算，过大的页码不会溢出为负数
+	start := max(0, min((int64(page)-1)*int64(pageSize), total))
+	end := min(start+int64(pageSize), total)
+
+	r.log.WithContext(ctx).Infof("Listed %d models (page %d/%d)", end-start, page, totalPages)
+	return models[start:end], &commonv1.PaginationResponse{
+		Page:       page,
+		PageSize:   pageSize,
+		Total:      total,
+		TotalPages: totalPages,
+	}, nil
+}
+
+// snapshot returns a copy of model with IsDefault resolved; callers must hold the lock
+func (r *modelRepo) snapshot(model *v1.ModelInfo) *v1.ModelInfo {
+	snapshot := proto.Clone(model).(*v1.ModelInfo)
+	snapshot.IsDefault = model.Name == r.defaultModel
+	return snapshot
+}
+
+// applyFilter applies a filter to a model
+func (r *modelRepo) applyFilter(model *v1.ModelInfo, filter *commonv1.Filter) bool {
+	switch filter.Field {
+	case "name":
+		return r.matchStringFilter(model.Name, filter)
+	case "display_name":
+		return r.matchStringFilter(model.DisplayName, filter)
+	case "model_type":
+		return r.matchStringFilter(model.ModelType, filter)
+	case "version":
+		return r.matchStringFilter(model.Version, filter)
+	case "is_available":
+		return r.matchStringFilter(strconv.FormatBool(model.IsAvailable), filter)
+	case "is_default":
+		return r.matchStringFilter(strconv.FormatBool(model.IsDefault), filter)
+	case "supported_languages":
+		return r.matchAnyFilter(model.GetCapabilities().GetSupportedLanguages(), filter)
+	case "ranking_methods":
+		return r.matchAnyFilter(model.GetCapabilities().GetRankingMethods(), filter)
+	case "max_sequence_length":
+		return r.matchNumberFilter(float64(model.GetCapabilities().GetMaxSequenceLength()), filter)
+	default:
+		return true // 未知字段默认通过
+	}
+}
+
+// matchAnyFilter matches if any of the values passes the filter
+func (r *modelRepo) matchAnyFilter(values []string, filter *commonv1.Filter) bool {
+	if filter.Operator == "ne" {
+		for _, value := range values {
+			if !r.matchStringFilter(value, filter) {
+				return false
+			}
+		}
+		return true
+	}
+	for _, value := range values {
+		if r.matchStringFilter(value, filter) {
+			return true
+		}
+	}
+	return false
+}
+
+// matchStringFilter matches string value against filter
+func (r *modelRepo) matchStringFilter(value string, filter *commonv1.Filter) bool {
+	if len(filter.Values) == 0 {
+		return true
+	}
+
+	switch filter.Operator {
+	case "eq", "in":
+		for _, filterValue := range filter.Values {
+			if value == filterValue {
+				return true
+			}
+		}
+		return false
+	case "ne":
+		for _, filterValue := range filter.Values {
+			if value == filterValue {
+				return false
+			}
+		}
+		return true
+	case "like":
+		for _, filterValue := range filter.Values {
+			if strings.Contains(strings.ToLower(value), strings.ToLower(filterValue)) {
+				return true
+			}
+		}
+		return false
+	case "gt", "gte", "lt", "lte":
+		if number, err := strconv.ParseFloat(value, 64); err == nil {
+			return r.matchNumberFilter(number, filter)
+		}
+		return false
+	default:
+		return true
+	}
+}
+
+// matchNumberFilter matches numeric value against filter
+func (r *modelRepo) matchNumberFilter(value float64, filter *commonv1.Filter) bool {
+	if len(filter.Values) == 0 {
+		return true
+	}
+
+	switch filter.Operator {
+	case "gt", "gte", "lt", "lte":
+		bound, err := strconv.ParseFloat(filter.Values[0], 64)
+		if err != nil {
+			return false
+		}
+		switch filter.Operator {
+		case "gt":
+			return value > bound
+		case "gte":
+			return value >= bound
+		case "lt":
+			return value < bound
+		default:
+			return value <= bound
+		}
+	default:
+		return r.matchStringFilter(strconv.FormatFloat(value, 'f', -1, 64), filter)
+	}
+}
+
+// marshalMessage encodes an API message as JSON; nil messages stay empty
+func marshalMessage(m proto.Message) (json.RawMessage, error) {
+	if m == nil || !proto.MessageReflect(m).IsValid() {
+		return nil, nil
+	}
+	return protojson.Marshal(proto.MessageV2(m))
+}
+
+func unmarshalMessage(raw json.RawMessage, m proto.Message) error {
+	if len(raw) == 0 {
+		return nil
+	}
+	return protojson.Unmarshal(raw, proto.MessageV2(m))
+}
+
+func writeFileAtomic(path string, data []byte) error {
+	tmp, err := os.CreateTemp(filepath.Dir(path), filepath.Base(path)+".*")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+	if _, err := tmp.Write(data); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+	return os.Rename(tmp.Name(), path)
+}
@@ -0,0 +1,192 @@
+package data
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"math"
+	"os"
+	"path/filepath"
+	"testing"
+
+	commonv1 "rag/api/common/v1"
+	v1 "rag/api/reranker/v1"
+	"rag/app/reranker/internal/biz"
+	"rag/app/reranker/internal/conf"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/golang/protobuf/proto"
+)
+
+func newTestModelRepo(t *testing.T, path string) *modelRepo {
+	t.Helper()
+	repo, err := NewModelRepo(&conf.Data{Registry: &conf.Data_Registry{Path: path}}, &Data{}, log.DefaultLogger)
+	if err != nil {
+		t.Fatalf("NewModelRepo: %v", err)
+	}
+	return repo.(*modelRepo)
+}
+
+func TestModelRepoPersistsConfiguration(t *testing.T) {
+	ctx := context.Background()
+	path := filepath.Join(t.TempDir(), "registry", "models.json")
+
+	repo := newTestModelRepo(t, path)
+	config := &v1.ModelConfiguration{Parameters: map[string]string{"listwise_window_size": "6"}}
+	if err := repo.UpdateConfiguration(ctx, builtinModelName, config); err != nil {
+		t.Fatal(err)
+	}
+	if err := repo.UpdatePerformanceMetrics(ctx, builtinModelName, &v1.ModelPerformanceMetrics{NdcgAtK: 0.5}); err != nil {
+		t.Fatal(err)
+	}
+	if err := repo.SetDefaultModel(ctx, builtinModelName); err != nil {
+		t.Fatal(err)
+	}
+
+	reloaded := newTestModelRepo(t, path)
+	got, err := reloaded.GetDefaultModel(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if v := got.GetCurrentConfiguration().GetParameters()["listwise_window_size"]; v != "6" {
+		t.Errorf("listwise_window_size = %q, want 6", v)
+	}
+	if v := got.GetPerformanceMetrics().GetNdcgAtK(); v != 0.5 {
+		t.Errorf("ndcg_at_k = %v, want 0.5", v)
+	}
+	if !got.IsDefault {
+		t.Error("reloaded model is not the default")
+	}
+	// 能力来自内置模型，不从文件读取
+	if len(got.GetCapabilities().GetRankingMethods()) == 0 {
+		t.Error("capabilities were not seeded")
+	}
+}
+
+func TestModelRepoUpdatesKeepOtherFields(t *testing.T) {
+	ctx := context.Background()
+	repo := newTestModelRepo(t, "")
+	config := &v1.ModelConfiguration{Parameters: map[string]string{"pairwise_aggregation": "tournament"}}
+	if err := repo.UpdateConfiguration(ctx, builtinModelName, config); err != nil {
+		t.Fatal(err)
+	}
+	// 评测结果写回时不覆盖评测期间修改的配置
+	if err := repo.UpdatePerformanceMetrics(ctx, builtinModelName, &v1.ModelPerformanceMetrics{NdcgAtK: 0.7}); err != nil {
+		t.Fatal(err)
+	}
+	got, err := repo.GetModel(ctx, builtinModelName)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if v := got.GetCurrentConfiguration().GetParameters()["pairwise_aggregation"]; v != "tournament" {
+		t.Errorf("pairwise_aggregation = %q, want tournament", v)
+	}
+	if v := got.GetPerformanceMetrics().GetNdcgAtK(); v != 0.7 {
+		t.Errorf("ndcg_at_k = %v, want 0.7", v)
+	}
+	if err := repo.UpdateConfiguration(ctx, "missing", config); err == nil {
+		t.Error("updating an unknown model succeeded")
+	}
+}
+
+func TestModelRepoSkipsUnknownModels(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "models.json")
+	raw := `{"default_model": "retired", "models": {"retired": {"current_configuration": {}}}}`
+	if err := os.WriteFile(path, []byte(raw), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	repo := newTestModelRepo(t, path)
+	if repo.defaultModel != builtinModelName {
+		t.Errorf("default model = %s, want %s", repo.defaultModel, builtinModelName)
+	}
+	if _, ok := repo.models["retired"]; ok {
+		t.Error("unknown model was loaded")
+	}
+}
+
+func TestModelRepoInMemoryWithoutPath(t *testing.T) {
+	repo := newTestModelRepo(t, "")
+	if err := repo.SetDefaultModel(context.Background(), builtinModelName); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestListModelsPaging(t *testing.T) {
+	repo := newTestModelRepo(t, "")
+	tests := []struct {
+		page, pageSize int32
+		want           int
+	}{
+		{0, 0, 1},
+		{1, 10, 1},
+		{2, 10, 0},
+		// 过大的页码不能溢出为负的下标
+		{math.MaxInt32, 10, 0},
+		{math.MaxInt32, math.MaxInt32, 0},
+		{1, math.MaxInt32, 1},
+	}
+	for _, tt := range tests {
+		models, page, err := repo.ListModels(context.Background(), &commonv1.PaginationRequest{Page: tt.page, PageSize: tt.pageSize}, nil)
+		if err != nil {
+			t.Errorf("page %d size %d: %v", tt.page, tt.pageSize, err)
+			continue
+		}
+		if len(models) != tt.want || page.Total != 1 {
+			t.Errorf("page %d size %d: %d models of %d, want %d of 1", tt.page, tt.pageSize, len(models), page.Total, tt.want)
+		}
+	}
+}
+
+func TestConfigureModelSwitchesDefault(t *testing.T) {
+	ctx := context.Background()
+	path := filepath.Join(t.TempDir(), "models.json")
+	repo := newTestModelRepo(t, path)
+	// 注册第二个模型，覆盖默认模型的切换路径
+	second := proto.Clone(repo.models[builtinModelName]).(*v1.ModelInfo)
+	second.Name = "lexical-overlap-v2"
+	repo.models[second.Name] = second
+
+	uc := biz.NewModelUsecase(repo, log.DefaultLogger)
+	if _, err := uc.ConfigureModel(ctx, &v1.ConfigureModelRequest{ModelName: second.Name, Configuration: &v1.ModelConfiguration{}}); err != nil {
+		t.Fatal(err)
+	}
+	if got, _ := repo.GetDefaultModel(ctx); got.GetName() != builtinModelName {
+		t.Errorf("default = %s before make_default, want %s", got.GetName(), builtinModelName)
+	}
+	if _, err := uc.ConfigureModel(ctx, &v1.ConfigureModelRequest{ModelName: second.Name, Configuration: &v1.ModelConfiguration{}, MakeDefault: true}); err != nil {
+		t.Fatal(err)
+	}
+
+	got, err := repo.GetDefaultModel(ctx)
+	if err != nil || got.GetName() != second.Name || !got.IsDefault {
+		t.Errorf("default = %v, %v, want %s", got.GetName(), err, second.Name)
+	}
+	models, _, err := repo.ListModels(ctx, nil, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, m := range models {
+		if m.IsDefault != (m.Name == second.Name) {
+			t.Errorf("%s: is_default = %t", m.Name, m.IsDefault)
+		}
+	}
+	raw, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var f registryFile
+	if err := json.Unmarshal(raw, &f); err != nil || f.DefaultModel != second.Name {
+		t.Errorf("stored default = %q, %v, want %s", f.DefaultModel, err, second.Name)
+	}
+
+	// 未知模型不能设为默认，原默认模型保持不变
+	if err := repo.SetDefaultModel(ctx, "missing"); !errors.Is(err, biz.ErrModelNotFound) {
+		t.Errorf("SetDefaultModel(missing) = %v, want ErrModelNotFound", err)
+	}
+	if err := repo.SetDefaultModel(ctx, builtinModelName); err != nil {
+		t.Fatal(err)
+	}
+	if got, _ := repo.GetDefaultModel(ctx); got.GetName() != builtinModelName {
+		t.Errorf("default = %s after switching back, want %s", got.GetName(), builtinModelName)
+	}
+}
@@ -0,0 +1,124 @@
+package data
+
+import (
+	"context"
+	"math"
+	"strings"
+	"unicode"
+
+	"rag/app/reranker/internal/biz"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+const (
+	// 词频饱和参数，与 BM25 的 k1 含义一致
+	lexicalSaturation = 1.2
+	// 成对比较时分数差的缩放温度
+	judgeTemperature = 0.1
+)
+
+// lexicalScorer implements biz.RelevanceScorer with a corpus-free BM25-style overlap score
+type lexicalScorer struct {
+	log *log.Helper
+}
+
+// NewRelevanceScorer creates the default pointwise relevance scorer
+func NewRelevanceScorer(logger log.Logger) biz.RelevanceScorer {
+	return &lexicalScorer{
+		log: log.NewHelper(logger),
+	}
+}
+
+// Score returns the saturated query-term coverage of content in [0, 1]
+func (s *lexicalScorer) Score(ctx context.Context, query, content string) (float32, error) {
+	if err := ctx.Err(); err != nil {
+		return 0, err
+	}
+	return float32(lexicalOverlap(query, content)), nil
+}
+
+// pairwiseJudge implements biz.PairwiseJudge on top of a pointwise scorer
+type pairwiseJudge struct {
+	scorer biz.RelevanceScorer
+	log    *log.Helper
+}
+
+// NewJudgeFactory creates the default pairwise judges, backed by the scorer
+// of each request
+func NewJudgeFactory(logger log.Logger) biz.JudgeFactory {
+	helper := log.NewHelper(logger)
+	return func(scorer biz.RelevanceScorer) biz.PairwiseJudge {
+		return &pairwiseJudge{
+			scorer: scorer,
+			log:    helper,
+		}
+	}
+}
+
+// Compare returns the probability that a is more relevant to query than b
+func (j *pairwiseJudge) Compare(ctx context.Context, query, a, b string) (float64, error) {
+	sa, err := j.scorer.Score(ctx, query, a)
+	if err != nil {
+		return 0, err
+	}
+	sb, err := j.scorer.Score(ctx, query, b)
+	if err != nil {
+		return 0, err
+	}
+	return 1 / (1 + math.Exp(-float64(sa-sb)/judgeTemperature)), nil
+}
+
+// lexicalOverlap scores each distinct query term by its saturated frequency in content
+func lexicalOverlap(query, content string) float64 {
+	queryTerms := tokenize(query)
+	if len(queryTerms) == 0 {
+		return 0
+	}
+
+	tf := make(map[string]int)
+	for _, term := range tokenize(content) {
+		tf[term]++
+	}
+
+	seen := make(map[string]bool, len(queryTerms))
+	var total, matched float64
+	for _, term := range queryTerms {
+		if seen[term] {
+			continue
+		}
+		seen[term] = true
+		total++
+		if n := float64(tf[term]); n > 0 {
+			matched += n / (n + lexicalSaturation)
+		}
+	}
+	return matched / total
+}
+
+// tokenize lowercases text and splits it into words, treating each Han character as a token
+func tokenize(text string) []string {
+	var (
+		tokens []string
+		word   strings.Builder
+	)
+	flush := func() {
+		if word.Len() > 0 {
+			tokens = append(tokens, word.String())
+			word.Reset()
+		}
+	}
+	for _, r := range strings.ToLower(text) {
+		switch {
+		case unicode.Is(unicode.Han, r):
+			flush()
+			tokens = append(tokens, string(r))
+		case unicode.IsLetter(r) || unicode.IsDigit(r):
+			word.WriteRune(r)
+		default:
+			flush()
+		}
+	}
+	flush()
+	return tokens
+}
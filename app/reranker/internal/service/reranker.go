@@ -0,0 +1,83 @@
+package service
+
+import (
+	"context"
+
+	commonv1 "rag/api/common/v1"
+	pb "rag/api/reranker/v1"
+	"rag/app/reranker/internal/biz"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+type RerankerService struct {
+	pb.UnimplementedRerankerServer
+
+	rerankUc *biz.RerankUsecase
+	modelUc  *biz.ModelUsecase
+	benchUc  *biz.BenchmarkUsecase
+	log      *log.Helper
+}
+
+func NewRerankerService(rerankUc *biz.RerankUsecase, modelUc *biz.ModelUsecase, benchUc *biz.BenchmarkUsecase, logger log.Logger) *RerankerService {
+	return &RerankerService{
+		rerankUc: rerankUc,
+		modelUc:  modelUc,
+		benchUc:  benchUc,
+		log:      log.NewHelper(logger),
+	}
+}
+
+// RerankDocuments reorders documents by relevance to the query
+func (s *RerankerService) RerankDocuments(ctx context.Context, req *pb.RerankDocumentsRequest) (*pb.RerankDocumentsResponse, error) {
+	s.log.WithContext(ctx).Info("RerankDocuments request received")
+	return s.rerankUc.RerankDocuments(ctx, req)
+}
+
+// ScoreRelevance scores a document against a query
+func (s *RerankerService) ScoreRelevance(ctx context.Context, req *pb.ScoreRelevanceRequest) (*pb.ScoreRelevanceResponse, error) {
+	s.log.WithContext(ctx).Info("ScoreRelevance request received")
+	return s.rerankUc.ScoreRelevance(ctx, req)
+}
+
+// ScoreBatchRelevance scores a batch of documents against a query
+func (s *RerankerService) ScoreBatchRelevance(ctx context.Context, req *pb.ScoreBatchRelevanceRequest) (*pb.ScoreBatchRelevanceResponse, error) {
+	s.log.WithContext(ctx).Info("ScoreBatchRelevance request received")
+	return s.rerankUc.ScoreBatchRelevance(ctx, req)
+}
+
+// ConfigureModel stores a model configuration
+func (s *RerankerService) ConfigureModel(ctx context.Context, req *pb.ConfigureModelRequest) (*pb.ConfigureModelResponse, error) {
+	s.log.WithContext(ctx).Info("ConfigureModel request received")
+	return s.modelUc.ConfigureModel(ctx, req)
+}
+
+// GetModelInfo retrieves model information
+func (s *RerankerService) GetModelInfo(ctx context.Context, req *pb.GetModelInfoRequest) (*pb.GetModelInfoResponse, error) {
+	s.log.WithContext(ctx).Info("GetModelInfo request received")
+	return s.modelUc.GetModelInfo(ctx, req)
+}
+
+// ListModels retrieves a list of models
+func (s *RerankerService) ListModels(ctx context.Context, req *pb.ListModelsRequest) (*pb.ListModelsResponse, error) {
+	s.log.WithContext(ctx).Info("ListModels request received")
+	return s.modelUc.ListModels(ctx, req)
+}
+
+// BenchmarkModel runs an offline benchmark of a model
+func (s *RerankerService) BenchmarkModel(ctx context.Context, req *pb.BenchmarkModelRequest) (*pb.BenchmarkModelResponse, error) {
+	s.log.WithContext(ctx).Info("BenchmarkModel request received")
+	return s.benchUc.BenchmarkModel(ctx, req)
+}
+
+// HealthCheck performs health check
+func (s *RerankerService) HealthCheck(ctx context.Context, req *emptypb.Empty) (*commonv1.HealthCheckResponse, error) {
+	return &commonv1.HealthCheckResponse{
+		Status:    "SERVING",
+		Service:   "reranker",
+		Version:   "v1.0.0",
+		Timestamp: timestamppb.Now(),
+	}, nil
+}
@@ -0,0 +1,185 @@
+package tokenizer
+
+import (
+	"bufio"
+	"encoding/base64"
+	"fmt"
+	"io"
+	"math"
+	"regexp"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+)
+
+// TypeBPE is the type reported by byte-level BPE tokenizers.
+const TypeBPE = "bpe"
+
+// bpePattern pre-splits text before merging, following the cl100k pattern.
+// RE2 has no lookahead, so the \s+(?!\S) alternative is applied by
+// bpeSplit instead.
+var bpePattern = regexp.MustCompile(`(?i:'s|'t|'re|'ve|'m|'ll|'d)|[^\r\n\p{L}\p{N}]?\p{L}+|\p{N}{1,3}| ?[^\s\p{L}\p{N}]+[\r\n]*|\s*[\r\n]+|\s+`)
+
+// 词表之后追加的特殊 token
+var defaultBPESpecials = []string{"<|endoftext|>", "<|fim_prefix|>", "<|fim_middle|>", "<|fim_suffix|>", "<|endofprompt|>"}
+
+// bpe is a byte-level BPE tokenizer whose merge priority is the token rank.
+type bpe struct {
+	name     string
+	version  string
+	ranks    map[string]int
+	specials map[string]int
+	order    []string
+}
+
+// LoadBPE reads a tiktoken rank file: one "<base64 token> <rank>" per line.
+func LoadBPE(name, version string, r io.Reader) (Tokenizer, error) {
+	ranks := make(map[string]int)
+	maxRank := -1
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 0, 64*1024), 1024*1024)
+	for line := 1; scanner.Scan(); line++ {
+		text := strings.TrimSpace(scanner.Text())
+		if text == "" {
+			continue
+		}
+		fields := strings.Fields(text)
+		if len(fields) != 2 {
+			return nil, fmt.Errorf("%s:%d: expected \"<token> <rank>\"", name, line)
+		}
+		token, err := base64.StdEncoding.DecodeString(fields[0])
+		if err != nil {
+			return nil, fmt.Errorf("%s:%d: %w", name, line, err)
+		}
+		rank, err := strconv.Atoi(fields[1])
+		if err != nil {
+			return nil, fmt.Errorf("%s:%d: %w", name, line, err)
+		}
+		ranks[string(token)] = rank
+		if rank > maxRank {
+			maxRank = rank
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	if len(ranks) == 0 {
+		return nil, fmt.Errorf("%s: empty rank file", name)
+	}
+
+	specials := make(map[string]int, len(defaultBPESpecials))
+	for i, s := range defaultBPESpecials {
+		specials[s] = maxRank + 1 + i
+	}
+	return &bpe{
+		name:     name,
+		version:  version,
+		ranks:    ranks,
+		specials: specials,
+		order:    defaultBPESpecials,
+	}, nil
+}
+
+func (t *bpe) Name() string    { return t.name }
+func (t *bpe) Type() string    { return TypeBPE }
+func (t *bpe) Version() string { return t.version }
+
+func (t *bpe) Config() map[string]string {
+	return map[string]string{
+		"vocab_size":     strconv.Itoa(len(t.ranks) + len(t.specials)),
+		"special_tokens": strings.Join(t.order, ","),
+	}
+}
+
+func (t *bpe) Encode(text string, addSpecial bool) []Token {
+	var tokens []Token
+	splitSpecial(text, t.order, func(segment string, special bool) {
+		if special {
+			tokens = append(tokens, Token{ID: t.specials[segment], Text: segment, Category: CategorySpecial})
+			return
+		}
+		for _, piece := range bpeSplit(segment) {
+			tokens = t.encodePiece(piece, tokens)
+		}
+	})
+	if addSpecial {
+		eot := defaultBPESpecials[0]
+		tokens = append(tokens, Token{ID: t.specials[eot], Text: eot, Category: CategorySpecial})
+	}
+	return tokens
+}
+
+// bpeSplit pre-splits a segment like cl100k. A whitespace run followed by
+// other text leaves its last character to that text, as \s+(?!\S) does, so
+// "a   b" splits into "a", "  " and " b".
+func bpeSplit(segment string) []string {
+	var pieces []string
+	for segment != "" {
+		loc := bpePattern.FindStringIndex(segment)
+		if loc == nil {
+			pieces = append(pieces, segment)
+			break
+		}
+		if loc[0] > 0 {
+			// 模式覆盖所有字符，这里只是防御
+			pieces = append(pieces, segment[:loc[0]])
+		}
+		end := loc[1]
+		piece := segment[loc[0]:end]
+		if end < len(segment) && isBPESpaceRun(piece) {
+			// 空白后还有文本时，把最后一个空白字符留给后面的片段
+			if _, size := utf8.DecodeLastRuneInString(piece); size < len(piece) {
+				end -= size
+				piece = piece[:len(piece)-size]
+			}
+		}
+		pieces = append(pieces, piece)
+		segment = segment[end:]
+	}
+	return pieces
+}
+
+// isBPESpaceRun reports whether piece was matched by the trailing \s+
+// alternative: whitespace only, not ending in a line break
+func isBPESpaceRun(piece string) bool {
+	// RE2 的 \s 只匹配 ASCII 空白
+	if strings.Trim(piece, "\t\n\f\r ") != "" {
+		return false
+	}
+	last := piece[len(piece)-1]
+	return last != '\n' && last != '\r'
+}
+
+// encodePiece merges the bytes of a pre-split piece, always applying the
+// lowest-ranked adjacent pair first.
+func (t *bpe) encodePiece(piece string, tokens []Token) []Token {
+	if rank, ok := t.ranks[piece]; ok {
+		return append(tokens, Token{ID: rank, Text: piece, Category: categorize(piece)})
+	}
+	parts := make([]string, len(piece))
+	for i := range parts {
+		parts[i] = piece[i : i+1]
+	}
+	for len(parts) > 1 {
+		best, bestRank := -1, math.MaxInt
+		for i := 0; i < len(parts)-1; i++ {
+			if rank, ok := t.ranks[parts[i]+parts[i+1]]; ok && rank < bestRank {
+				best, bestRank = i, rank
+			}
+		}
+		if best < 0 {
+			break
+		}
+		parts[best] += parts[best+1]
+		parts = append(parts[:best+1], parts[best+2:]...)
+	}
+	for _, part := range parts {
+		id, ok := t.ranks[part]
+		if !ok {
+			// 词表缺少该字节
+			id = -1
+		}
+		tokens = append(tokens, Token{ID: id, Text: part, Category: categorize(part)})
+	}
+	return tokens
+}
@@ -0,0 +1,93 @@
+package tokenizer
+
+import (
+	"encoding/base64"
+	"fmt"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+// rankFile builds a tiktoken rank file with the tokens ranked in order
+func rankFile(tokens ...string) string {
+	var b strings.Builder
+	for rank, token := range tokens {
+		fmt.Fprintf(&b, "%s %d\n", base64.StdEncoding.EncodeToString([]byte(token)), rank)
+	}
+	return b.String()
+}
+
+func TestBPESplit(t *testing.T) {
+	tests := []struct {
+		text string
+		want []string
+	}{
+		{"hello world", []string{"hello", " world"}},
+		// 多个空白时最后一个留给后面的单词
+		{"a   b", []string{"a", "  ", " b"}},
+		{"a \tb", []string{"a", " ", "\tb"}},
+		{"x   ", []string{"x", "   "}},
+		{"a  1", []string{"a", " ", " ", "1"}},
+		{"line\n  next", []string{"line", "\n", " ", " next"}},
+		{"12345 it's", []string{"123", "45", " it", "'s"}},
+		{"ok!!\n", []string{"ok", "!!\n"}},
+	}
+	for _, tt := range tests {
+		if got := bpeSplit(tt.text); !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("bpeSplit(%q) = %q, want %q", tt.text, got, tt.want)
+		}
+	}
+}
+
+func TestBPEMergesByRank(t *testing.T) {
+	tok, err := LoadBPE("test", "v1", strings.NewReader(rankFile("a", "b", "c", " ", "ab", "bc", "abc", " a")))
+	if err != nil {
+		t.Fatal(err)
+	}
+	texts := func(tokens []Token) []string {
+		out := make([]string, len(tokens))
+		for i, token := range tokens {
+			out[i] = token.Text
+		}
+		return out
+	}
+
+	// 整段在词表中时直接命中
+	if got := texts(tok.Encode("abc", false)); !reflect.DeepEqual(got, []string{"abc"}) {
+		t.Errorf("abc = %q", got)
+	}
+	// ab 的优先级高于 bc 和 " a"
+	if got := texts(tok.Encode("abcb", false)); !reflect.DeepEqual(got, []string{"abc", "b"}) {
+		t.Errorf("abcb = %q", got)
+	}
+	if got := texts(tok.Encode("cab ab", false)); !reflect.DeepEqual(got, []string{"c", "ab", " ", "ab"}) {
+		t.Errorf("cab ab = %q", got)
+	}
+
+	tokens := tok.Encode("ax<|endoftext|>", true)
+	if got := texts(tokens); !reflect.DeepEqual(got, []string{"a", "x", "<|endoftext|>", "<|endoftext|>"}) {
+		t.Fatalf("special tokens = %q", got)
+	}
+	if tokens[1].ID != -1 {
+		t.Errorf("unknown byte id = %d, want -1", tokens[1].ID)
+	}
+	if tokens[2].ID != 8 || tokens[2].Category != CategorySpecial {
+		t.Errorf("special token = %+v, want id 8 after the ranks", tokens[2])
+	}
+	if got := tok.Config()["vocab_size"]; got != "13" {
+		t.Errorf("vocab_size = %s, want 13", got)
+	}
+}
+
+func TestLoadBPERejectsMalformedFiles(t *testing.T) {
+	for name, file := range map[string]string{
+		"empty":  "",
+		"fields": "YQ==\n",
+		"base64": "!!! 0\n",
+		"rank":   "YQ== x\n",
+	} {
+		if _, err := LoadBPE(name, "v1", strings.NewReader(file)); err == nil {
+			t.Errorf("%s: expected an error", name)
+		}
+	}
+}
@@ -0,0 +1,71 @@
+package tokenizer
+
+import (
+	"unicode"
+	"unicode/utf8"
+)
+
+// HeuristicName is the name of the built-in vocabulary-free tokenizer.
+const HeuristicName = "heuristic"
+
+// 启发式估算：英文单词平均约 4 个字符一个 token
+const charsPerToken = 4
+
+// heuristic estimates tokens without a vocabulary: one per Han character or
+// punctuation mark and one per four characters of other words.
+type heuristic struct{}
+
+// NewHeuristic creates the built-in heuristic tokenizer.
+func NewHeuristic() Tokenizer {
+	return heuristic{}
+}
+
+func (heuristic) Name() string    { return HeuristicName }
+func (heuristic) Type() string    { return HeuristicName }
+func (heuristic) Version() string { return "v1" }
+
+func (heuristic) Config() map[string]string {
+	return map[string]string{"chars_per_token": "4"}
+}
+
+func (heuristic) Encode(text string, addSpecial bool) []Token {
+	var (
+		tokens    []Token
+		wordStart = -1
+	)
+	flush := func(end int) {
+		if wordStart < 0 {
+			return
+		}
+		word := text[wordStart:end]
+		wordStart = -1
+		category := categorize(word)
+		// 长单词按每 4 个字符切分
+		for len(word) > 0 {
+			n, size := 0, 0
+			for n < charsPerToken && size < len(word) {
+				_, w := utf8.DecodeRuneInString(word[size:])
+				size += w
+				n++
+			}
+			tokens = append(tokens, Token{ID: -1, Text: word[:size], Category: category})
+			word = word[size:]
+		}
+	}
+	for i, r := range text {
+		switch {
+		case unicode.IsSpace(r):
+			flush(i)
+		case unicode.Is(unicode.Han, r), unicode.IsPunct(r), unicode.IsSymbol(r):
+			flush(i)
+			s := string(r)
+			tokens = append(tokens, Token{ID: -1, Text: s, Category: categorize(s)})
+		default:
+			if wordStart < 0 {
+				wordStart = i
+			}
+		}
+	}
+	flush(len(text))
+	return tokens
+}
@@ -0,0 +1,123 @@
+package tokenizer
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+)
+
+// 词表文件扩展名
+const (
+	bpeFileExt       = ".tiktoken"
+	wordPieceFileExt = ".vocab"
+)
+
+// Registry holds tokenizers by name. It always contains the heuristic
+// tokenizer, which is the default until another default is set.
+type Registry struct {
+	mu          sync.RWMutex
+	tokenizers  map[string]Tokenizer
+	defaultName string
+}
+
+// NewRegistry creates a registry holding only the heuristic tokenizer.
+func NewRegistry() *Registry {
+	r := &Registry{
+		tokenizers:  make(map[string]Tokenizer),
+		defaultName: HeuristicName,
+	}
+	r.Register(NewHeuristic())
+	return r
+}
+
+// LoadDir creates a registry with every vocabulary found in dir:
+// <name>.tiktoken files are BPE rank files and <name>.vocab files are
+// WordPiece vocabularies. The file's modification time is the version.
+func LoadDir(dir string) (*Registry, error) {
+	r := NewRegistry()
+	if dir == "" {
+		return r, nil
+	}
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, err
+	}
+	for _, entry := range entries {
+		if entry.IsDir() {
+			continue
+		}
+		ext := filepath.Ext(entry.Name())
+		var load func(name, version string, f *os.File) (Tokenizer, error)
+		switch ext {
+		case bpeFileExt:
+			load = func(name, version string, f *os.File) (Tokenizer, error) { return LoadBPE(name, version, f) }
+		case wordPieceFileExt:
+			load = func(name, version string, f *os.File) (Tokenizer, error) { return LoadWordPiece(name, version, f) }
+		default:
+			continue
+		}
+		t, err := loadFile(filepath.Join(dir, entry.Name()), strings.TrimSuffix(entry.Name(), ext), load)
+		if err != nil {
+			return nil, err
+		}
+		r.Register(t)
+	}
+	return r, nil
+}
+
+func loadFile(path, name string, load func(name, version string, f *os.File) (Tokenizer, error)) (Tokenizer, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	info, err := f.Stat()
+	if err != nil {
+		return nil, err
+	}
+	return load(name, info.ModTime().UTC().Format("20060102150405"), f)
+}
+
+// Register adds or replaces a tokenizer.
+func (r *Registry) Register(t Tokenizer) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.tokenizers[t.Name()] = t
+}
+
+// SetDefault makes the named tokenizer the default.
+func (r *Registry) SetDefault(name string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if _, ok := r.tokenizers[name]; !ok {
+		return fmt.Errorf("tokenizer %q is not registered", name)
+	}
+	r.defaultName = name
+	return nil
+}
+
+// Get returns the named tokenizer, or the default when name is empty.
+func (r *Registry) Get(name string) (Tokenizer, bool) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	if name == "" {
+		name = r.defaultName
+	}
+	t, ok := r.tokenizers[name]
+	return t, ok
+}
+
+// Names returns the registered tokenizer names in sorted order.
+func (r *Registry) Names() []string {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	names := make([]string, 0, len(r.tokenizers))
+	for name := range r.tokenizers {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
@@ -0,0 +1,165 @@
+// Package tokenizer provides the tokenizers shared by the services so that
+// token counts agree everywhere: a vocabulary-free heuristic estimator,
+// byte-level BPE with tiktoken-style rank files and WordPiece vocabularies.
+//
+// The assembler counts and splits context with it and the orchestrator sizes
+// its generation prompts with it. Both load the same vocabulary directory
+// with LoadDir, so a tokenizer name counts the same in either service.
+package tokenizer
+
+import (
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// Category classifies a token for breakdowns.
+type Category int
+
+const (
+	CategoryRegular Category = iota
+	CategorySpecial
+	CategoryPunctuation
+	CategoryNumber
+)
+
+// String returns the category name used in token type filters.
+func (c Category) String() string {
+	switch c {
+	case CategorySpecial:
+		return "special"
+	case CategoryPunctuation:
+		return "punctuation"
+	case CategoryNumber:
+		return "numbers"
+	default:
+		return "regular"
+	}
+}
+
+// ParseCategory maps a token type name to its category.
+func ParseCategory(name string) (Category, bool) {
+	switch name {
+	case "regular":
+		return CategoryRegular, true
+	case "special":
+		return CategorySpecial, true
+	case "punctuation":
+		return CategoryPunctuation, true
+	case "numbers", "number":
+		return CategoryNumber, true
+	}
+	return CategoryRegular, false
+}
+
+// Token is a single encoded token. ID is -1 when the tokenizer has no vocabulary.
+type Token struct {
+	ID       int
+	Text     string
+	Category Category
+}
+
+// Tokenizer encodes text into tokens.
+type Tokenizer interface {
+	// 分词器名称，用于按名称查找
+	Name() string
+	// 分词器类型和词表版本
+	Type() string
+	Version() string
+	// 编码文本，addSpecial 为 true 时加上分词器的起止特殊 token
+	Encode(text string, addSpecial bool) []Token
+	// 分词器配置，用于在响应中展示
+	Config() map[string]string
+}
+
+// Counts is the number of tokens per category.
+type Counts struct {
+	Regular     int
+	Special     int
+	Punctuation int
+	Number      int
+}
+
+// Total returns the number of tokens in all categories.
+func (c Counts) Total() int {
+	return c.Regular + c.Special + c.Punctuation + c.Number
+}
+
+// Of returns the number of tokens in a category.
+func (c Counts) Of(category Category) int {
+	switch category {
+	case CategorySpecial:
+		return c.Special
+	case CategoryPunctuation:
+		return c.Punctuation
+	case CategoryNumber:
+		return c.Number
+	default:
+		return c.Regular
+	}
+}
+
+// Count encodes text and counts its tokens per category.
+func Count(t Tokenizer, text string, addSpecial bool) Counts {
+	var counts Counts
+	for _, token := range t.Encode(text, addSpecial) {
+		switch token.Category {
+		case CategorySpecial:
+			counts.Special++
+		case CategoryPunctuation:
+			counts.Punctuation++
+		case CategoryNumber:
+			counts.Number++
+		default:
+			counts.Regular++
+		}
+	}
+	return counts
+}
+
+// categorize classifies non-special token text as number, punctuation or regular
+func categorize(text string) Category {
+	text = strings.TrimSpace(text)
+	// 字节级 BPE 可能切出不完整的 UTF-8 序列
+	if text == "" || !utf8.ValidString(text) {
+		return CategoryRegular
+	}
+	digits, puncts := true, true
+	for _, r := range text {
+		if !unicode.IsDigit(r) {
+			digits = false
+		}
+		if !unicode.IsPunct(r) && !unicode.IsSymbol(r) {
+			puncts = false
+		}
+	}
+	switch {
+	case digits:
+		return CategoryNumber
+	case puncts:
+		return CategoryPunctuation
+	}
+	return CategoryRegular
+}
+
+// splitSpecial splits text around occurrences of the special tokens, calling
+// fn for each plain segment and each special token in order.
+func splitSpecial(text string, specials []string, fn func(segment string, special bool)) {
+	for text != "" {
+		at, match := -1, ""
+		for _, s := range specials {
+			if i := strings.Index(text, s); i >= 0 && (at < 0 || i < at || (i == at && len(s) > len(match))) {
+				at, match = i, s
+			}
+		}
+		if at < 0 {
+			fn(text, false)
+			return
+		}
+		if at > 0 {
+			fn(text[:at], false)
+		}
+		fn(match, true)
+		text = text[at+len(match):]
+	}
+}
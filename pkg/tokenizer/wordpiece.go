@@ -0,0 +1,179 @@
+package tokenizer
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+// TypeWordPiece is the type reported by WordPiece tokenizers.
+const TypeWordPiece = "wordpiece"
+
+const (
+	wordPiecePrefix       = "##"
+	wordPieceUnknown      = "[UNK]"
+	wordPieceClassify     = "[CLS]"
+	wordPieceSeparator    = "[SEP]"
+	maxWordPieceWordRunes = 100
+)
+
+// wordPiece is a BERT-style tokenizer: basic splitting on whitespace,
+// punctuation and Han characters followed by greedy longest-match lookup.
+type wordPiece struct {
+	name      string
+	version   string
+	vocab     map[string]int
+	specials  []string
+	lowercase bool
+}
+
+// LoadWordPiece reads a vocabulary in the BERT vocab.txt layout: one token per
+// line, the line number being the token id. Bracketed tokens such as [CLS] are
+// special, except the [unusedN] placeholders. The vocabulary is treated as
+// uncased when it holds no upper-case tokens.
+func LoadWordPiece(name, version string, r io.Reader) (Tokenizer, error) {
+	vocab := make(map[string]int)
+	var specials []string
+	lowercase := true
+	scanner := bufio.NewScanner(r)
+	for id := 0; scanner.Scan(); id++ {
+		token := strings.TrimRight(scanner.Text(), "\r")
+		if token == "" {
+			continue
+		}
+		vocab[token] = id
+		if len(token) > 2 && token[0] == '[' && token[len(token)-1] == ']' {
+			if !strings.HasPrefix(token, "[unused") {
+				specials = append(specials, token)
+			}
+		} else if lowercase && strings.ToLower(token) != token {
+			lowercase = false
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	if len(vocab) == 0 {
+		return nil, fmt.Errorf("%s: empty vocabulary", name)
+	}
+	return &wordPiece{
+		name:      name,
+		version:   version,
+		vocab:     vocab,
+		specials:  specials,
+		lowercase: lowercase,
+	}, nil
+}
+
+func (t *wordPiece) Name() string    { return t.name }
+func (t *wordPiece) Type() string    { return TypeWordPiece }
+func (t *wordPiece) Version() string { return t.version }
+
+func (t *wordPiece) Config() map[string]string {
+	return map[string]string{
+		"vocab_size":     strconv.Itoa(len(t.vocab)),
+		"do_lower_case":  strconv.FormatBool(t.lowercase),
+		"special_tokens": strings.Join(t.specials, ","),
+	}
+}
+
+func (t *wordPiece) Encode(text string, addSpecial bool) []Token {
+	var tokens []Token
+	if addSpecial {
+		tokens = t.appendSpecial(tokens, wordPieceClassify)
+	}
+	splitSpecial(text, t.specials, func(segment string, special bool) {
+		if special {
+			tokens = t.appendSpecial(tokens, segment)
+			return
+		}
+		for _, word := range t.basicSplit(segment) {
+			tokens = t.encodeWord(word, tokens)
+		}
+	})
+	if addSpecial {
+		tokens = t.appendSpecial(tokens, wordPieceSeparator)
+	}
+	return tokens
+}
+
+func (t *wordPiece) appendSpecial(tokens []Token, special string) []Token {
+	id, ok := t.vocab[special]
+	if !ok {
+		id = -1
+	}
+	return append(tokens, Token{ID: id, Text: special, Category: CategorySpecial})
+}
+
+// basicSplit splits on whitespace and isolates punctuation and Han characters
+func (t *wordPiece) basicSplit(text string) []string {
+	if t.lowercase {
+		text = strings.ToLower(text)
+	}
+	var (
+		words []string
+		word  strings.Builder
+	)
+	flush := func() {
+		if word.Len() > 0 {
+			words = append(words, word.String())
+			word.Reset()
+		}
+	}
+	for _, r := range text {
+		switch {
+		case unicode.IsSpace(r), unicode.IsControl(r):
+			flush()
+		case unicode.IsPunct(r), unicode.IsSymbol(r), unicode.Is(unicode.Han, r):
+			flush()
+			words = append(words, string(r))
+		default:
+			word.WriteRune(r)
+		}
+	}
+	flush()
+	return words
+}
+
+// encodeWord splits a word into the longest vocabulary pieces, falling back
+// to [UNK] for the whole word when any part cannot be matched.
+func (t *wordPiece) encodeWord(word string, tokens []Token) []Token {
+	runes := []rune(word)
+	if len(runes) > maxWordPieceWordRunes {
+		return t.appendUnknown(tokens, word)
+	}
+	var pieces []Token
+	for start := 0; start < len(runes); {
+		end := len(runes)
+		matched := false
+		for end > start {
+			sub := string(runes[start:end])
+			piece := sub
+			if start > 0 {
+				piece = wordPiecePrefix + sub
+			}
+			if id, ok := t.vocab[piece]; ok {
+				pieces = append(pieces, Token{ID: id, Text: piece, Category: categorize(sub)})
+				matched = true
+				break
+			}
+			end--
+		}
+		if !matched {
+			return t.appendUnknown(tokens, word)
+		}
+		start = end
+	}
+	return append(tokens, pieces...)
+}
+
+func (t *wordPiece) appendUnknown(tokens []Token, word string) []Token {
+	id, ok := t.vocab[wordPieceUnknown]
+	if !ok {
+		id = -1
+	}
+	return append(tokens, Token{ID: id, Text: wordPieceUnknown, Category: categorize(word)})
+}
@@ -0,0 +1,67 @@
+package tokenizer
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func testWordPiece(t *testing.T, vocab ...string) Tokenizer {
+	t.Helper()
+	tok, err := LoadWordPiece("test", "v1", strings.NewReader(strings.Join(vocab, "\n")))
+	if err != nil {
+		t.Fatal(err)
+	}
+	return tok
+}
+
+func TestWordPieceEncode(t *testing.T) {
+	tok := testWordPiece(t, "[PAD]", "[UNK]", "[CLS]", "[SEP]", "[unused0]", "play", "##ing", "##s", "the", "!", "中", "7")
+
+	var texts []string
+	var ids []int
+	for _, token := range tok.Encode("The playing plays! 中文 7", true) {
+		texts = append(texts, token.Text)
+		ids = append(ids, token.ID)
+	}
+	// 未加大写词表时转小写；"文" 不在词表中，整个词记为 [UNK]
+	wantTexts := []string{"[CLS]", "the", "play", "##ing", "play", "##s", "!", "中", "[UNK]", "7", "[SEP]"}
+	wantIDs := []int{2, 8, 5, 6, 5, 7, 9, 10, 1, 11, 3}
+	if !reflect.DeepEqual(texts, wantTexts) || !reflect.DeepEqual(ids, wantIDs) {
+		t.Errorf("Encode = %q %v, want %q %v", texts, ids, wantTexts, wantIDs)
+	}
+
+	counts := Count(tok, "play! 7", false)
+	if counts.Regular != 1 || counts.Punctuation != 1 || counts.Number != 1 {
+		t.Errorf("counts = %+v", counts)
+	}
+}
+
+func TestWordPieceUnknownWord(t *testing.T) {
+	tok := testWordPiece(t, "[UNK]", "play", "##ing")
+	got := tok.Encode("playx", false)
+	if len(got) != 1 || got[0].Text != "[UNK]" || got[0].ID != 0 {
+		t.Errorf("Encode(playx) = %+v, want a single [UNK]", got)
+	}
+	if got := tok.Encode(strings.Repeat("a", maxWordPieceWordRunes+1), false); len(got) != 1 || got[0].Text != "[UNK]" {
+		t.Errorf("long word = %+v, want a single [UNK]", got)
+	}
+}
+
+func TestWordPieceCasedAndSpecials(t *testing.T) {
+	tok := testWordPiece(t, "[UNK]", "[CLS]", "[SEP]", "[MASK]", "Go", "go")
+	config := tok.Config()
+	if config["do_lower_case"] != "false" {
+		t.Errorf("do_lower_case = %s, want false for a cased vocabulary", config["do_lower_case"])
+	}
+	if config["special_tokens"] != "[UNK],[CLS],[SEP],[MASK]" {
+		t.Errorf("special_tokens = %s", config["special_tokens"])
+	}
+	var texts []string
+	for _, token := range tok.Encode("Go[MASK]go", false) {
+		texts = append(texts, token.Text)
+	}
+	if !reflect.DeepEqual(texts, []string{"Go", "[MASK]", "go"}) {
+		t.Errorf("Encode = %q", texts)
+	}
+}